@@ -0,0 +1,194 @@
+// Package backup contains clients of export of database objects into S3 and import of them from S3
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+)
+
+var (
+	// ErrEmptyItems returns if export or import settings has no items
+	ErrEmptyItems = xerrors.Wrap(errors.New("ydb: empty items of export or import"))
+
+	// ErrConflictingDestinations returns if destinations of items are equal or nested into each other
+	ErrConflictingDestinations = xerrors.Wrap(errors.New("ydb: conflicting destinations of export or import items"))
+
+	// ErrInvalidSettings returns if required field of export or import settings is empty
+	ErrInvalidSettings = xerrors.Wrap(errors.New("ydb: invalid export or import settings"))
+)
+
+type (
+	// ExportClient is a client of export service
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ExportClient interface {
+		// ExportToS3 validates settings, starts export into S3 and returns id of export operation
+		ExportToS3(ctx context.Context, settings ExportToS3Settings) (operationID string, err error)
+
+		// Wait polls export operation with pollInterval until operation is ready and calls onProgress
+		// (if not nil) with metadata of every poll. Wait returns error of operation if export failed
+		Wait(
+			ctx context.Context, operationID string, pollInterval time.Duration,
+			onProgress func(metadata *operation.ExportMetadata),
+		) error
+	}
+
+	// ImportClient is a client of import service
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ImportClient interface {
+		// ImportFromS3 validates settings, starts import from S3 and returns id of import operation
+		ImportFromS3(ctx context.Context, settings ImportFromS3Settings) (operationID string, err error)
+
+		// Wait polls import operation with pollInterval until operation is ready and calls onProgress
+		// (if not nil) with metadata of every poll. Wait returns error of operation if import failed
+		Wait(
+			ctx context.Context, operationID string, pollInterval time.Duration,
+			onProgress func(metadata *operation.ImportMetadata),
+		) error
+	}
+
+	// S3 is a location of S3 bucket
+	S3 struct {
+		Endpoint string
+		Scheme   Scheme
+		Bucket   string
+		Region   string
+
+		// Credentials returns access key and secret key of bucket
+		// Credentials calls on every start of export or import. Nil Credentials means anonymous access
+		Credentials func(ctx context.Context) (accessKey, secretKey string, err error)
+	}
+
+	// Scheme is a scheme of S3 endpoint
+	Scheme int
+
+	// Compression is a codec of exported data
+	Compression string
+
+	// ExportItem is a source database object and destination prefix of objects in bucket
+	ExportItem struct {
+		SourcePath        string
+		DestinationPrefix string
+	}
+
+	// ImportItem is a source prefix of objects in bucket and destination database object
+	ImportItem struct {
+		SourcePrefix    string
+		DestinationPath string
+	}
+
+	// ExportToS3Settings is a settings of export into S3
+	ExportToS3Settings struct {
+		S3              S3
+		Items           []ExportItem
+		Description     string
+		NumberOfRetries uint32
+		Compression     Compression
+	}
+
+	// ImportFromS3Settings is a settings of import from S3
+	ImportFromS3Settings struct {
+		S3              S3
+		Items           []ImportItem
+		Description     string
+		NumberOfRetries uint32
+	}
+)
+
+const (
+	SchemeHTTPS = Scheme(iota)
+	SchemeHTTP
+)
+
+// CompressionNone disables compression of exported data
+const CompressionNone = Compression("")
+
+// CompressionZstd returns zstd codec with given level. Zero level means default level of server
+func CompressionZstd(level int) Compression {
+	if level == 0 {
+		return "zstd"
+	}
+
+	return Compression("zstd-" + strconv.Itoa(level))
+}
+
+func (s S3) validate() error {
+	if s.Endpoint == "" {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: empty S3 endpoint", ErrInvalidSettings))
+	}
+	if s.Bucket == "" {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: empty S3 bucket", ErrInvalidSettings))
+	}
+
+	return nil
+}
+
+// Validate checks settings of export on client side
+func (s ExportToS3Settings) Validate() error {
+	if err := s.S3.validate(); err != nil {
+		return err
+	}
+	if len(s.Items) == 0 {
+		return xerrors.WithStackTrace(ErrEmptyItems)
+	}
+	destinations := make([]string, 0, len(s.Items))
+	for i, item := range s.Items {
+		if item.SourcePath == "" || item.DestinationPrefix == "" {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: empty source or destination of item #%d", ErrInvalidSettings, i))
+		}
+		destinations = append(destinations, item.DestinationPrefix)
+	}
+
+	return checkDestinations(destinations)
+}
+
+// Validate checks settings of import on client side
+func (s ImportFromS3Settings) Validate() error {
+	if err := s.S3.validate(); err != nil {
+		return err
+	}
+	if len(s.Items) == 0 {
+		return xerrors.WithStackTrace(ErrEmptyItems)
+	}
+	destinations := make([]string, 0, len(s.Items))
+	for i, item := range s.Items {
+		if item.SourcePrefix == "" || item.DestinationPath == "" {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: empty source or destination of item #%d", ErrInvalidSettings, i))
+		}
+		destinations = append(destinations, item.DestinationPath)
+	}
+
+	return checkDestinations(destinations)
+}
+
+// checkDestinations checks that no destination is equal to other or nested into other
+func checkDestinations(destinations []string) error {
+	for i := range destinations {
+		destinations[i] = strings.TrimRight(destinations[i], "/")
+	}
+	for i := range destinations {
+		for j := i + 1; j < len(destinations); j++ {
+			if nested(destinations[i], destinations[j]) || nested(destinations[j], destinations[i]) {
+				return xerrors.WithStackTrace(fmt.Errorf("%w: %q and %q",
+					ErrConflictingDestinations, destinations[i], destinations[j],
+				))
+			}
+		}
+	}
+
+	return nil
+}
+
+func nested(parent, child string) bool {
+	return child == parent || strings.HasPrefix(child, parent+"/")
+}
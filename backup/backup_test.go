@@ -0,0 +1,112 @@
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportToS3SettingsValidate(t *testing.T) {
+	s3 := S3{
+		Endpoint: "storage.example.com",
+		Bucket:   "backups",
+	}
+	for _, tt := range []struct {
+		name     string
+		settings ExportToS3Settings
+		err      error
+	}{
+		{
+			name: "Valid",
+			settings: ExportToS3Settings{
+				S3: s3,
+				Items: []ExportItem{
+					{SourcePath: "/local/a", DestinationPrefix: "backup/a"},
+					{SourcePath: "/local/ab", DestinationPrefix: "backup/ab"},
+				},
+			},
+		},
+		{
+			name: "EmptyEndpoint",
+			settings: ExportToS3Settings{
+				S3:    S3{Bucket: "backups"},
+				Items: []ExportItem{{SourcePath: "/local/a", DestinationPrefix: "backup/a"}},
+			},
+			err: ErrInvalidSettings,
+		},
+		{
+			name: "EmptyItems",
+			settings: ExportToS3Settings{
+				S3: s3,
+			},
+			err: ErrEmptyItems,
+		},
+		{
+			name: "EmptyDestination",
+			settings: ExportToS3Settings{
+				S3:    s3,
+				Items: []ExportItem{{SourcePath: "/local/a"}},
+			},
+			err: ErrInvalidSettings,
+		},
+		{
+			name: "SameDestinations",
+			settings: ExportToS3Settings{
+				S3: s3,
+				Items: []ExportItem{
+					{SourcePath: "/local/a", DestinationPrefix: "backup/a"},
+					{SourcePath: "/local/b", DestinationPrefix: "backup/a/"},
+				},
+			},
+			err: ErrConflictingDestinations,
+		},
+		{
+			name: "NestedDestinations",
+			settings: ExportToS3Settings{
+				S3: s3,
+				Items: []ExportItem{
+					{SourcePath: "/local/a", DestinationPrefix: "backup/a/b"},
+					{SourcePath: "/local/c", DestinationPrefix: "backup/a-c"},
+					{SourcePath: "/local/b", DestinationPrefix: "backup/a"},
+				},
+			},
+			err: ErrConflictingDestinations,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestImportFromS3SettingsValidate(t *testing.T) {
+	s3 := S3{
+		Endpoint: "storage.example.com",
+		Bucket:   "backups",
+	}
+	require.NoError(t, ImportFromS3Settings{
+		S3: s3,
+		Items: []ImportItem{
+			{SourcePrefix: "backup/a", DestinationPath: "/local/restored/a"},
+			{SourcePrefix: "backup/b", DestinationPath: "/local/restored/b"},
+		},
+	}.Validate())
+	require.ErrorIs(t, ImportFromS3Settings{S3: s3}.Validate(), ErrEmptyItems)
+	require.ErrorIs(t, ImportFromS3Settings{
+		S3: s3,
+		Items: []ImportItem{
+			{SourcePrefix: "backup/a", DestinationPath: "/local/restored/a"},
+			{SourcePrefix: "backup/b", DestinationPath: "/local/restored/a"},
+		},
+	}.Validate(), ErrConflictingDestinations)
+}
+
+func TestCompressionZstd(t *testing.T) {
+	require.Equal(t, Compression("zstd"), CompressionZstd(0))
+	require.Equal(t, Compression("zstd-3"), CompressionZstd(3))
+}
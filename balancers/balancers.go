@@ -1,6 +1,7 @@
 package balancers
 
 import (
+	"context"
 	"sort"
 	"strings"
 
@@ -110,6 +111,61 @@ func PreferLocationsWithFallback(balancer *balancerConfig.Config, locations ...s
 	return balancer
 }
 
+type filterDetectedLocations struct{}
+
+func (filterDetectedLocations) Allow(info balancerConfig.Info, c conn.Conn) bool {
+	if len(info.Locations) == 0 {
+		return true
+	}
+
+	return filterLocations(info.Locations).Allow(info, c)
+}
+
+func (filterDetectedLocations) String() string {
+	return "DetectedLocations"
+}
+
+// PreferLocationsFunc creates balancer which use endpoints only in locations returned by detectLocations.
+// detectLocations is called on every discovery, so locations known only at runtime (such as availability
+// zone of kubernetes node) are not hardcoded. Changed locations are applied on next discovery to new calls,
+// in-flight calls are not interrupted. If detectLocations returns no locations all endpoints are used
+// Balancer "balancer" defines balancing algorithm between endpoints selected with filter by location
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func PreferLocationsFunc(
+	balancer *balancerConfig.Config,
+	detectLocations func(ctx context.Context) []string,
+) *balancerConfig.Config {
+	balancer.Filter = filterDetectedLocations{}
+	balancer.DetectLocations = func(ctx context.Context) []string {
+		detected := detectLocations(ctx)
+		locations := make([]string, len(detected))
+		for i := range detected {
+			locations[i] = strings.ToUpper(detected[i])
+		}
+		sort.Strings(locations)
+
+		return locations
+	}
+
+	return balancer
+}
+
+// PreferLocationsFuncWithFallback creates balancer which use endpoints only in locations returned by
+// detectLocations (see PreferLocationsFunc)
+// If filter returned zero endpoints from all discovery endpoints list - used all endpoint instead
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func PreferLocationsFuncWithFallback(
+	balancer *balancerConfig.Config,
+	detectLocations func(ctx context.Context) []string,
+) *balancerConfig.Config {
+	balancer = PreferLocationsFunc(balancer, detectLocations)
+	balancer.AllowFallback = true
+
+	return balancer
+}
+
 type Endpoint interface {
 	NodeID() uint32
 	Address() string
@@ -1,6 +1,7 @@
 package balancers
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -56,6 +57,38 @@ func TestPreferLocationsWithFallback(t *testing.T) {
 	require.Equal(t, []conn.Conn{conns[0], conns[2]}, applyPreferFilter(balancerConfig.Info{}, rr, conns))
 }
 
+func TestPreferLocationsFunc(t *testing.T) {
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "1", LocationField: "zero", State: conn.Online},
+		&mock.Conn{AddrField: "2", State: conn.Online, LocationField: "one"},
+		&mock.Conn{AddrField: "3", State: conn.Online, LocationField: "two"},
+	}
+
+	rr := PreferLocationsFunc(RandomChoice(), func(context.Context) []string {
+		return []string{"two", "zero"}
+	})
+	require.False(t, rr.AllowFallback)
+	info := balancerConfig.Info{Locations: rr.DetectLocations(context.Background())}
+	require.Equal(t, []string{"TWO", "ZERO"}, info.Locations)
+	require.Equal(t, []conn.Conn{conns[0], conns[2]}, applyPreferFilter(info, rr, conns))
+
+	// no detected locations means no preferred locations
+	require.Equal(t, conns, applyPreferFilter(balancerConfig.Info{}, rr, conns))
+}
+
+func TestPreferLocationsFuncWithFallback(t *testing.T) {
+	conns := []conn.Conn{
+		&mock.Conn{AddrField: "1", LocationField: "zero", State: conn.Online},
+		&mock.Conn{AddrField: "2", State: conn.Online, LocationField: "one"},
+	}
+
+	rr := PreferLocationsFuncWithFallback(RandomChoice(), LocationFromEnv("YDB_TEST_LOCATION"))
+	require.True(t, rr.AllowFallback)
+	t.Setenv("YDB_TEST_LOCATION", "one")
+	info := balancerConfig.Info{Locations: rr.DetectLocations(context.Background())}
+	require.Equal(t, []conn.Conn{conns[1]}, applyPreferFilter(info, rr, conns))
+}
+
 func applyPreferFilter(info balancerConfig.Info, b *balancerConfig.Config, conns []conn.Conn) []conn.Conn {
 	if b.Filter == nil {
 		b.Filter = filterFunc(func(info balancerConfig.Info, c conn.Conn) bool { return true })
@@ -0,0 +1,72 @@
+package balancers
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// LabelZone is a well-known kubernetes label with availability zone of node
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+const LabelZone = "topology.kubernetes.io/zone"
+
+// LocationFromEnv returns detector of location for PreferLocationsFunc which reads location from
+// environment variable name (for example filled from pod spec). Unset or empty variable detects no location
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func LocationFromEnv(name string) func(ctx context.Context) []string {
+	return func(context.Context) []string {
+		if location := strings.TrimSpace(os.Getenv(name)); location != "" {
+			return []string{location}
+		}
+
+		return nil
+	}
+}
+
+// LocationFromDownwardAPI returns detector of location for PreferLocationsFunc which reads location from
+// file of kubernetes downward API volume on every discovery. If label is empty file contains only location
+// (such as file of annotation or any other field), else file has format of labels file (lines label="value")
+// and value of label (such as LabelZone) is location. Missing file or label detects no location
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func LocationFromDownwardAPI(path, label string) func(ctx context.Context) []string {
+	return func(context.Context) []string {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		var location string
+		if label == "" {
+			location = strings.TrimSpace(string(content))
+		} else {
+			location = labelValue(string(content), label)
+		}
+		if location == "" {
+			return nil
+		}
+
+		return []string{location}
+	}
+}
+
+// labelValue returns value of label from content of downward API labels file
+func labelValue(content, label string) string {
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), "=")
+		if !ok || key != label {
+			continue
+		}
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			return unquoted
+		}
+
+		return value
+	}
+
+	return ""
+}
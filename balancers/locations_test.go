@@ -0,0 +1,62 @@
+package balancers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationFromEnv(t *testing.T) {
+	detect := LocationFromEnv("YDB_TEST_LOCATION")
+	t.Setenv("YDB_TEST_LOCATION", "")
+	require.Empty(t, detect(context.Background()))
+	t.Setenv("YDB_TEST_LOCATION", " zone-a\n")
+	require.Equal(t, []string{"zone-a"}, detect(context.Background()))
+}
+
+func TestLocationFromDownwardAPI(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		content  *string
+		label    string
+		location []string
+	}{
+		{
+			name:     "NoFile",
+			label:    LabelZone,
+			location: nil,
+		},
+		{
+			name:     "Value",
+			content:  ptr("zone-a\n"),
+			location: []string{"zone-a"},
+		},
+		{
+			name:     "Labels",
+			content:  ptr("app=\"test\"\n" + LabelZone + "=\"zone-b\"\n"),
+			label:    LabelZone,
+			location: []string{"zone-b"},
+		},
+		{
+			name:     "NoLabel",
+			content:  ptr("app=\"test\"\n"),
+			label:    LabelZone,
+			location: nil,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "labels")
+			if tt.content != nil {
+				require.NoError(t, os.WriteFile(path, []byte(*tt.content), 0o600))
+			}
+			require.Equal(t, tt.location, LocationFromDownwardAPI(path, tt.label)(context.Background()))
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
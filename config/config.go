@@ -34,6 +34,19 @@ type Config struct {
 	meta           *meta.Meta
 
 	excludeGRPCCodesForPessimization []grpcCodes.Code
+
+	maxInFlightPerEndpoint int
+	maxInFlightFailFast    bool
+
+	streamMessageSizeSampling int
+	streamInactivityTimeout   time.Duration
+
+	grpcMaxRecvMsgSize int
+	grpcMaxSendMsgSize int
+
+	defaultOperationTimeout time.Duration
+	defaultScanQueryTimeout time.Duration
+	defaultSchemeTimeout    time.Duration
 }
 
 func (c *Config) Credentials() credentials.Credentials {
@@ -48,11 +61,78 @@ func (c *Config) ExcludeGRPCCodesForPessimization() []grpcCodes.Code {
 // GrpcDialOptions reports about used grpc dialing options
 func (c *Config) GrpcDialOptions() []grpc.DialOption {
 	return append(
-		defaultGrpcOptions(c.trace, c.secure, c.tlsConfig),
+		defaultGrpcOptions(c),
 		c.grpcOptions...,
 	)
 }
 
+// MaxInFlightPerEndpoint is a limit of in-flight unary calls and opened streams per endpoint.
+//
+// If MaxInFlightPerEndpoint is zero - in-flight calls are not limited.
+func (c *Config) MaxInFlightPerEndpoint() int {
+	return c.maxInFlightPerEndpoint
+}
+
+// MaxInFlightFailFast is a flag for fail calls instead of waiting when all endpoints reached
+// MaxInFlightPerEndpoint limit
+func (c *Config) MaxInFlightFailFast() bool {
+	return c.maxInFlightFailFast
+}
+
+// StreamMessageSizeSampling is a rate of sampling of stream messages which sizes are reported
+// to trace.Driver.OnConnStreamMessageSize: every StreamMessageSizeSampling-th message is reported.
+//
+// If StreamMessageSizeSampling is zero - sizes of stream messages are not reported.
+func (c *Config) StreamMessageSizeSampling() int {
+	return c.streamMessageSizeSampling
+}
+
+// StreamInactivityTimeout is a max duration of waiting of stream message. Read table, scan query
+// and topic streams are canceled if no messages were received during StreamInactivityTimeout.
+// Streams which are idle by design (such as attach session and coordination session streams)
+// are not checked.
+//
+// If StreamInactivityTimeout is zero - inactivity of streams is not checked.
+func (c *Config) StreamInactivityTimeout() time.Duration {
+	return c.streamInactivityTimeout
+}
+
+// GrpcMaxRecvMsgSize is a limit of size of messages (unary responses and messages of streams)
+// received by every grpc connection of driver
+func (c *Config) GrpcMaxRecvMsgSize() int {
+	return c.grpcMaxRecvMsgSize
+}
+
+// GrpcMaxSendMsgSize is a limit of size of messages (unary requests and messages of streams)
+// sent by every grpc connection of driver
+func (c *Config) GrpcMaxSendMsgSize() int {
+	return c.grpcMaxSendMsgSize
+}
+
+// DefaultOperationTimeout is a client-side timeout of unary calls which applied if context
+// of call has no deadline and no operation timeout.
+//
+// If DefaultOperationTimeout is zero then no timeout is used.
+func (c *Config) DefaultOperationTimeout() time.Duration {
+	return c.defaultOperationTimeout
+}
+
+// DefaultScanQueryTimeout is a client-side timeout of scan query streams which applied if context
+// of call has no deadline and no operation timeout.
+//
+// If DefaultScanQueryTimeout is zero then no timeout is used.
+func (c *Config) DefaultScanQueryTimeout() time.Duration {
+	return c.defaultScanQueryTimeout
+}
+
+// DefaultSchemeTimeout is a client-side timeout of scheme calls which applied if context
+// of call has no deadline and no operation timeout.
+//
+// If DefaultSchemeTimeout is zero then DefaultOperationTimeout is used.
+func (c *Config) DefaultSchemeTimeout() time.Duration {
+	return c.defaultSchemeTimeout
+}
+
 // Meta reports meta information about database connection
 func (c *Config) Meta() *meta.Meta {
 	return c.meta
@@ -81,7 +161,7 @@ func (c *Config) TLSConfig() *tls.Config {
 }
 
 // DialTimeout is the maximum amount of time a dial will wait for a connect to
-// complete.
+// complete. DialTimeout bounds every attempt of dial to endpoint separately.
 //
 // If DialTimeout is zero then no timeout is used.
 func (c *Config) DialTimeout() time.Duration {
@@ -165,6 +245,15 @@ func WithRetryBudget(b budget.Budget) Option {
 	}
 }
 
+// WithQueryTextRedactor applies redactor of query text in traces and logs
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryTextRedactor(redactor func(query string) string) Option {
+	return func(c *Config) {
+		config.SetQueryTextRedactor(&c.Common, redactor)
+	}
+}
+
 func WithTraceRetry(t *trace.Retry, opts ...trace.RetryComposeOption) Option {
 	return func(c *Config) {
 		config.SetTraceRetry(&c.Common, t, opts...)
@@ -178,6 +267,33 @@ func WithApplicationName(applicationName string) Option {
 	}
 }
 
+// WithSDKBuildInfoSuffix appends provided suffix to sdk build info which sends with all api requests
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSDKBuildInfoSuffix(suffix string) Option {
+	return func(c *Config) {
+		c.metaOptions = append(c.metaOptions, meta.WithBuildInfoSuffixOption(suffix))
+	}
+}
+
+// WithSDKBuildInfoExtra appends provided extra part to sdk build info which sends with all api requests
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSDKBuildInfoExtra(extra string) Option {
+	return func(c *Config) {
+		c.metaOptions = append(c.metaOptions, meta.WithBuildInfoExtraOption(extra))
+	}
+}
+
+// WithDisableSDKBuildInfoHeader disables sending of sdk build info header with api requests
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDisableSDKBuildInfoHeader() Option {
+	return func(c *Config) {
+		c.metaOptions = append(c.metaOptions, meta.WithoutBuildInfoHeaderOption())
+	}
+}
+
 // WithUserAgent add provided user agent to all api requests
 //
 // Deprecated: use WithApplicationName instead.
@@ -213,6 +329,38 @@ func WithOperationTimeout(operationTimeout time.Duration) Option {
 	}
 }
 
+// WithDefaultOperationTimeout defines client-side timeout of unary calls (data queries, transactions
+// and other requests) which applied if context of call has no deadline and no operation timeout
+// (see ydb.WithOperationTimeout)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDefaultOperationTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.defaultOperationTimeout = timeout
+	}
+}
+
+// WithDefaultScanQueryTimeout defines client-side timeout of scan query streams which applied
+// if context of call has no deadline and no operation timeout (see ydb.WithOperationTimeout)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDefaultScanQueryTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.defaultScanQueryTimeout = timeout
+	}
+}
+
+// WithDefaultSchemeTimeout defines client-side timeout of scheme calls (create, alter, drop and
+// describe of tables, topics and directories) which applied if context of call has no deadline
+// and no operation timeout (see ydb.WithOperationTimeout)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDefaultSchemeTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.defaultSchemeTimeout = timeout
+	}
+}
+
 // WithOperationCancelAfter sets the maximum amount of time a YDB server will process an
 // operation. After timeout exceeds YDB will try to cancel operation and if
 // it succeeds appropriate error will be returned to the client; otherwise
@@ -287,6 +435,57 @@ func ExcludeGRPCCodesForPessimization(codes ...grpcCodes.Code) Option {
 	}
 }
 
+// WithMaxInFlightPerEndpoint limits in-flight unary calls and opened streams per endpoint
+func WithMaxInFlightPerEndpoint(n int) Option {
+	return func(c *Config) {
+		c.maxInFlightPerEndpoint = n
+	}
+}
+
+// WithMaxInFlightFailFast makes calls fail instead of waiting when all endpoints reached
+// in-flight limit
+func WithMaxInFlightFailFast() Option {
+	return func(c *Config) {
+		c.maxInFlightFailFast = true
+	}
+}
+
+// WithStreamMessageSizeSampling reports size of every n-th message of streams to
+// trace.Driver.OnConnStreamMessageSize
+func WithStreamMessageSizeSampling(n int) Option {
+	return func(c *Config) {
+		c.streamMessageSizeSampling = n
+	}
+}
+
+// WithStreamInactivityTimeout cancels read table, scan query and topic streams which received
+// no messages during timeout
+func WithStreamInactivityTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.streamInactivityTimeout = timeout
+	}
+}
+
+// WithGrpcMaxRecvMsgSize limits size of received grpc messages. Non-positive n resets limit to DefaultGRPCMsgSize
+func WithGrpcMaxRecvMsgSize(n int) Option {
+	return func(c *Config) {
+		if n <= 0 {
+			n = DefaultGRPCMsgSize
+		}
+		c.grpcMaxRecvMsgSize = n
+	}
+}
+
+// WithGrpcMaxSendMsgSize limits size of sent grpc messages. Non-positive n resets limit to DefaultGRPCMsgSize
+func WithGrpcMaxSendMsgSize(n int) Option {
+	return func(c *Config) {
+		if n <= 0 {
+			n = DefaultGRPCMsgSize
+		}
+		c.grpcMaxSendMsgSize = n
+	}
+}
+
 func New(opts ...Option) *Config {
 	c := defaultConfig()
 
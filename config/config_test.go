@@ -0,0 +1,76 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestGrpcMaxRecvMsgSize(t *testing.T) {
+	const partSize = 5 << 20 // greater than default limit of grpc
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+		return stream.SendMsg(wrapperspb.Bytes(make([]byte, partSize)))
+	}))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	for _, tt := range []struct {
+		name  string
+		limit int
+		err   bool
+	}{
+		{
+			name:  "GrpcDefault",
+			limit: 4 << 20,
+			err:   true,
+		},
+		{
+			name:  "Raised",
+			limit: 8 << 20,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			pool := conn.NewPool(ctx, New(
+				WithGrpcMaxRecvMsgSize(tt.limit),
+				WithGrpcOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return listener.DialContext(ctx)
+				})),
+			))
+			defer func() {
+				_ = pool.Release(context.Background())
+			}()
+
+			stream, err := pool.Get(endpoint.New("127.0.0.1:2135")).NewStream(ctx,
+				&grpc.StreamDesc{ServerStreams: true}, "/Ydb.Test.V1.TestService/StreamPart",
+			)
+			require.NoError(t, err)
+			require.NoError(t, stream.CloseSend())
+
+			var part wrapperspb.BytesValue
+			err = stream.RecvMsg(&part)
+			if tt.err {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), fmt.Sprintf("receive %d bytes", tt.limit))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, part.GetValue(), partSize)
+		})
+	}
+}
@@ -30,7 +30,7 @@ var (
 	}
 )
 
-func defaultGrpcOptions(t *trace.Driver, secure bool, tlsConfig *tls.Config) (opts []grpc.DialOption) {
+func defaultGrpcOptions(c *Config) (opts []grpc.DialOption) {
 	opts = append(opts,
 		// keep-aliving all connections
 		grpc.WithKeepaliveParams(
@@ -42,22 +42,22 @@ func defaultGrpcOptions(t *trace.Driver, secure bool, tlsConfig *tls.Config) (op
 		}`),
 		// limit size of outgoing and incoming packages
 		grpc.WithDefaultCallOptions(
-			grpc.MaxCallRecvMsgSize(DefaultGRPCMsgSize),
-			grpc.MaxCallSendMsgSize(DefaultGRPCMsgSize),
+			grpc.MaxCallRecvMsgSize(c.grpcMaxRecvMsgSize),
+			grpc.MaxCallSendMsgSize(c.grpcMaxSendMsgSize),
 		),
 		// use proxy-resolvers
 		// 1) for interpret schemas `ydb`, `grpc` and `grpcs` in node URLs as for dns resolver
 		// 2) for observe resolving events
 		grpc.WithResolvers(
-			xresolver.New("", t),
-			xresolver.New("ydb", t),
-			xresolver.New("grpc", t),
-			xresolver.New("grpcs", t),
+			xresolver.New("", c.trace),
+			xresolver.New("ydb", c.trace),
+			xresolver.New("grpc", c.trace),
+			xresolver.New("grpcs", c.trace),
 		),
 	)
-	if secure {
+	if c.secure {
 		opts = append(opts, grpc.WithTransportCredentials(
-			grpcCredentials.NewTLS(tlsConfig),
+			grpcCredentials.NewTLS(c.tlsConfig),
 		))
 	} else {
 		opts = append(opts, grpc.WithTransportCredentials(
@@ -93,5 +93,13 @@ func defaultConfig() (c *Config) {
 		tlsConfig:      defaultTLSConfig(),
 		dialTimeout:    DefaultDialTimeout,
 		trace:          &trace.Driver{},
+
+		streamMessageSizeSampling: DefaultStreamMessageSizeSampling,
+
+		grpcMaxRecvMsgSize: DefaultGRPCMsgSize,
+		grpcMaxSendMsgSize: DefaultGRPCMsgSize,
 	}
 }
+
+// DefaultStreamMessageSizeSampling contains default rate of sampling of sizes of stream messages
+const DefaultStreamMessageSizeSampling = 100
@@ -66,7 +66,8 @@ type Session interface {
 
 	// Context returns the context of the session. It is canceled when the underlying server session is over or if the
 	// client could not get any successful response from the server before the session timeout (see
-	// options.WithSessionTimeout).
+	// options.WithSessionTimeout). Broken gRPC streams are reconnected while the session is alive, so the context is
+	// not canceled if the session is restored on reconnect. Requests of the lost session fail with ErrSessionExpired.
 	Context() context.Context
 
 	// CreateSemaphore creates a new semaphore. This method waits until the server successfully creates a new semaphore
@@ -144,6 +145,45 @@ type Lease interface {
 	Session() Session
 }
 
+// Membership is a live view of members of the group joined by JoinGroup. Membership keeps the list of members
+// consistent with the server: it is refreshed on every change of the group and after every reconnect of the underlying
+// gRPC stream, because changes may have been missed while the stream was broken.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Membership interface {
+	// Members returns the last received list of members of the group (including the current member) in order of
+	// joining the group.
+	Members() []Member
+
+	// Changed returns the channel which receives a notification after every change of the list of members. Several
+	// changes may be merged into one notification, call Members to get the actual list.
+	Changed() <-chan struct{}
+
+	// Self returns the current member of the group.
+	Self() Member
+
+	// Update replaces data of the current member. Other members receive the change notification.
+	Update(ctx context.Context, data []byte) error
+
+	// Context returns the context of the membership. It is canceled when the current member left the group: the
+	// session was lost or the membership was closed.
+	Context() context.Context
+
+	// Close leaves the group and closes the session of the membership.
+	Close(ctx context.Context) error
+}
+
+// Member describes a member of the group.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Member struct {
+	// SessionID is the id of the session of the member.
+	SessionID uint64
+
+	// Data is user-defined data attached by the member.
+	Data []byte
+}
+
 // SemaphoreDescription describes the state of a semaphore.
 type SemaphoreDescription struct {
 	// Name is the name of the semaphore.
@@ -1,6 +1,9 @@
 package coordination
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrOperationStatusUnknown indicates that the request has been sent to the server but no reply has been received.
@@ -12,6 +15,10 @@ var (
 	// ErrSessionClosed indicates that the Session object is closed.
 	ErrSessionClosed = errors.New("session is closed")
 
+	// ErrSessionExpired indicates that the session was lost: the server expired the session or the client could not
+	// restore it before the session timeout (see options.WithSessionTimeout). ErrSessionExpired wraps ErrSessionClosed.
+	ErrSessionExpired = fmt.Errorf("%w: session expired", ErrSessionClosed)
+
 	// ErrAcquireTimeout indicates that the Session.AcquireSemaphore method could not acquire the semaphore before the
 	// operation timeout (see options.WithAcquireTimeout).
 	ErrAcquireTimeout = errors.New("acquire semaphore timeout")
@@ -145,3 +145,35 @@ func Example_semaphore() {
 	}
 	fmt.Printf("deleted semaphore my-semaphore\n")
 }
+
+//nolint:errcheck
+func Example_membership() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed to connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+
+	// node /local/test must exist
+	m, err := coordination.JoinGroup(ctx, db.Coordination(), "/local/test", "workers", []byte("host-1:8080"))
+	if err != nil {
+		fmt.Printf("failed to join group: %v", err)
+
+		return
+	}
+	defer m.Close(ctx) // leave group
+
+	for {
+		fmt.Printf("members of group: %+v\n", m.Members())
+		select {
+		case <-m.Changed():
+		case <-m.Context().Done():
+			fmt.Println("membership is lost")
+
+			return
+		}
+	}
+}
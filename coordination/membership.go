@@ -0,0 +1,68 @@
+package coordination
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// WatchSemaphore returns the state of the semaphore like Session.DescribeSemaphore and the channel which is closed on
+// the first change of the semaphore data or owners (see options.WithWatchData and options.WithWatchOwners, both are
+// enabled by default). The channel is also closed if the change may have been missed: the underlying gRPC stream
+// was reconnected or the session was closed. Changes are not reported in detail so the caller must call
+// WatchSemaphore again to get the actual state.
+//
+// This method is idempotent. The client will automatically retry in the case of network or server failure.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WatchSemaphore(
+	ctx context.Context,
+	s Session,
+	name string,
+	opts ...options.DescribeSemaphoreOption,
+) (*SemaphoreDescription, <-chan struct{}, error) {
+	if ss, has := s.(interface {
+		WatchSemaphore(
+			ctx context.Context,
+			name string,
+			opts ...options.DescribeSemaphoreOption,
+		) (*SemaphoreDescription, <-chan struct{}, error)
+	}); has {
+		return ss.WatchSemaphore(ctx, name, opts...)
+	}
+
+	return nil, nil, xerrors.WithStackTrace(fmt.Errorf("session %T not supported watching of semaphores", s))
+}
+
+// JoinGroup joins the group groupName of the coordination node path with attached data and returns a live view of
+// the group members. Membership is based on the ephemeral semaphore groupName which is acquired by every member
+// in the shared mode within its own session, so a member leaves the group with close or loss of its session.
+// This method blocks until the group is joined and the list of members is received.
+//
+// To ensure resources are not leaked, call Close on the Membership or close the Client.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func JoinGroup(
+	ctx context.Context,
+	c Client,
+	path string,
+	groupName string,
+	data []byte,
+	opts ...options.SessionOption,
+) (Membership, error) {
+	if cc, has := c.(interface {
+		Membership(
+			ctx context.Context,
+			path string,
+			groupName string,
+			data []byte,
+			opts ...options.SessionOption,
+		) (Membership, error)
+	}); has {
+		return cc.Membership(ctx, path, groupName, data, opts...)
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("client %T not supported group membership", c))
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 )
 
 // WithDescription returns an SessionOption that specifies a user-defined description that may be used to describe
@@ -42,7 +44,7 @@ func WithSessionStartTimeout(timeout time.Duration) SessionOption {
 // If this is not set, the client uses the default time 1 second.
 func WithSessionStopTimeout(timeout time.Duration) SessionOption {
 	return func(c *CreateSessionOptions) {
-		c.SessionStartTimeout = timeout
+		c.SessionStopTimeout = timeout
 	}
 }
 
@@ -66,6 +68,20 @@ func WithSessionReconnectDelay(delay time.Duration) SessionOption {
 	}
 }
 
+// WithSessionReconnectBackoff returns an SessionOption that specifies the backoff of reconnections of the underlying
+// gRPC stream in case of error. Delay of the i-th reconnection attempt since the last successful connection is
+// b.Delay(i). Reconnections are made while the session is alive, see WithSessionTimeout. Use retry.Backoff to make
+// backoff with custom params.
+//
+// If this is not set, the client uses constant delay from WithSessionReconnectDelay.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionReconnectBackoff(b backoff.Backoff) SessionOption {
+	return func(c *CreateSessionOptions) {
+		c.SessionReconnectBackoff = b
+	}
+}
+
 // SessionOption configures how we create a new session.
 type SessionOption func(c *CreateSessionOptions)
 
@@ -78,6 +94,7 @@ type CreateSessionOptions struct {
 	SessionStopTimeout      time.Duration
 	SessionKeepAliveTimeout time.Duration
 	SessionReconnectDelay   time.Duration
+	SessionReconnectBackoff backoff.Backoff
 }
 
 // WithEphemeral returns an AcquireSemaphoreOption that causes to create an ephemeral semaphore.
@@ -169,5 +186,21 @@ func WithDescribeWaiters(describeWaiters bool) DescribeSemaphoreOption {
 	}
 }
 
+// WithWatchData return a DescribeSemaphoreOption which causes server notify about changes of the semaphore data. It
+// is used by the coordination.WatchSemaphore function.
+func WithWatchData(watchData bool) DescribeSemaphoreOption {
+	return func(c *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		c.WatchData = watchData
+	}
+}
+
+// WithWatchOwners return a DescribeSemaphoreOption which causes server notify about changes of the semaphore owners
+// (including data of owners). It is used by the coordination.WatchSemaphore function.
+func WithWatchOwners(watchOwners bool) DescribeSemaphoreOption {
+	return func(c *Ydb_Coordination.SessionRequest_DescribeSemaphore) {
+		c.WatchOwners = watchOwners
+	}
+}
+
 // DescribeSemaphoreOption configures how we update a semaphore.
 type DescribeSemaphoreOption func(c *Ydb_Coordination.SessionRequest_DescribeSemaphore)
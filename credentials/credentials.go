@@ -52,3 +52,16 @@ func NewJWTTokenSource(opts ...credentials.JWTTokenSourceOption) (credentials.To
 func NewFixedTokenSource(token, tokenType string) credentials.TokenSource {
 	return credentials.NewFixedTokenSource(token, tokenType)
 }
+
+// Refreshable is an interface of credentials with cached token which may be invalidated.
+// If server rejects token of Refreshable credentials (as revoked or expired token),
+// driver calls Invalidate once and retries request with a fresh token from Token.
+// Static and OAuth 2.0 token exchange credentials are Refreshable
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Refreshable interface {
+	Credentials
+
+	// Invalidate drops cached token, so next call of Token re-reads source of credentials
+	Invalidate()
+}
@@ -55,6 +55,13 @@ func WithRequestTimeout(timeout time.Duration) Oauth2TokenExchangeCredentialsOpt
 	return credentials.WithRequestTimeout(timeout)
 }
 
+// UserAgent sets User-Agent header of token exchange requests
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithUserAgent(userAgent string) Oauth2TokenExchangeCredentialsOption {
+	return credentials.WithUserAgent(userAgent)
+}
+
 // SubjectTokenSource
 func WithSubjectToken(subjectToken credentials.TokenSource) Oauth2TokenExchangeCredentialsOption {
 	return credentials.WithSubjectToken(subjectToken)
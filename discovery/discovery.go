@@ -18,6 +18,10 @@ func (w WhoAmI) String() string {
 }
 
 type Client interface {
+	// Discover returns endpoints of database. Each endpoint reports node id, address, location, load factor,
+	// list of services advertised by node and ssl flag
 	Discover(ctx context.Context) ([]endpoint.Endpoint, error)
+
+	// WhoAmI returns user and groups of current credentials as server sees them
 	WhoAmI(ctx context.Context) (*WhoAmI, error)
 }
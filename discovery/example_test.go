@@ -24,7 +24,7 @@ func Example_discoverCluster() {
 	}
 	fmt.Printf("%s endpoints:\n", db.Name())
 	for i, e := range endpoints {
-		fmt.Printf("%d) %s\n", i, e.String())
+		fmt.Printf("%d) %s, services: %v, ssl: %t\n", i, e.String(), e.Services(), e.SSL())
 	}
 }
 
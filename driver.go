@@ -8,9 +8,12 @@ import (
 
 	"google.golang.org/grpc"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/backup"
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
 	"github.com/ydb-platform/ydb-go-sdk/v3/discovery"
+	internalBackup "github.com/ydb-platform/ydb-go-sdk/v3/internal/backup"
+	backupConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/backup/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	internalCoordination "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination"
@@ -20,6 +23,10 @@ import (
 	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	internalMonitoring "github.com/ydb-platform/ydb-go-sdk/v3/internal/monitoring"
+	monitoringConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/monitoring/config"
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/client"
+	operationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
 	internalQuery "github.com/ydb-platform/ydb-go-sdk/v3/internal/query"
 	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	internalRatelimiter "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter"
@@ -37,6 +44,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/log"
+	"github.com/ydb-platform/ydb-go-sdk/v3/monitoring"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
@@ -55,6 +64,8 @@ type Driver struct {
 
 	userInfo *dsn.UserInfo
 
+	oauth2Options []credentials.Oauth2TokenExchangeCredentialsOption
+
 	logger        log.Logger
 	loggerOpts    []log.Option
 	loggerDetails trace.Detailer
@@ -85,6 +96,13 @@ type Driver struct {
 	ratelimiter        *xsync.Once[*internalRatelimiter.Client]
 	ratelimiterOptions []ratelimiterConfig.Option
 
+	operation *xsync.Once[*internalOperation.Client]
+
+	export *xsync.Once[*internalBackup.ExportClient]
+	imprt  *xsync.Once[*internalBackup.ImportClient]
+
+	monitoring *xsync.Once[*internalMonitoring.Client]
+
 	topic        *xsync.Once[*topicclientinternal.Client]
 	topicOptions []topicoptions.TopicOption
 
@@ -93,12 +111,17 @@ type Driver struct {
 	pool *conn.Pool
 
 	mtx      sync.Mutex
-	balancer *balancer.Balancer
+	balancer driverBalancer
+	// shared is a balancer shared with child drivers of other databases
+	shared *sharedBalancer
 
 	children    map[uint64]*Driver
 	childrenMtx xsync.Mutex
 	onClose     []func(c *Driver)
 
+	closeOnce sync.Once
+	closeErr  error
+
 	panicCallback func(e interface{})
 }
 
@@ -112,8 +135,21 @@ func (d *Driver) trace() *trace.Driver {
 
 // Close closes Driver and clear resources
 //
+// Close works in deterministic order: child drivers (created with Driver.With) are closed first,
+// then clients which hold long-living streams (topic readers and writers, query and table sessions)
+// are flushed and closed with provided ctx, then other clients, and conns of database at last.
+// Close is idempotent and safe for concurrent calls: repeated calls wait for the first one
+// and return the same error
+func (d *Driver) Close(ctx context.Context) error {
+	d.closeOnce.Do(func() {
+		d.closeErr = d.close(ctx)
+	})
+
+	return d.closeErr
+}
+
 //nolint:nonamedreturns
-func (d *Driver) Close(ctx context.Context) (finalErr error) {
+func (d *Driver) close(ctx context.Context) (finalErr error) {
 	onDone := trace.DriverOnClose(d.trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/ydb.(*Driver).Close"),
 	)
@@ -143,13 +179,17 @@ func (d *Driver) Close(ctx context.Context) (finalErr error) {
 
 	closes = append(
 		closes,
-		d.ratelimiter.Close,
-		d.coordination.Close,
-		d.scheme.Close,
-		d.scripting.Close,
-		d.table.Close,
-		d.query.Close,
 		d.topic.Close,
+		d.query.Close,
+		d.table.Close,
+		d.scripting.Close,
+		d.scheme.Close,
+		d.coordination.Close,
+		d.ratelimiter.Close,
+		d.operation.Close,
+		d.imprt.Close,
+		d.export.Close,
+		d.monitoring.Close,
 		d.balancer.Close,
 		d.pool.Release,
 	)
@@ -210,11 +250,83 @@ func (d *Driver) Ratelimiter() ratelimiter.Client {
 	return d.ratelimiter.Get()
 }
 
+// Operation returns client of long-running operations
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Operation() operation.Client {
+	return d.operation.Get()
+}
+
+// Export returns client of export into S3
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Export() backup.ExportClient {
+	return d.export.Get()
+}
+
+// Import returns client of import from S3
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Import() backup.ImportClient {
+	return d.imprt.Get()
+}
+
+// Monitoring returns client of database self-check service
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Monitoring() monitoring.Client {
+	return d.monitoring.Get()
+}
+
 // Discovery returns discovery client
 func (d *Driver) Discovery() discovery.Client {
 	return d.discovery.Get()
 }
 
+// HasService reports whether discovered endpoints of database advertise service with given name
+// (for example "topic", "kesus", "rate_limiter", "scripting", "query_service").
+// HasService returns true if discovered endpoints have no info about services.
+// HasService does not make any I/O
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) HasService(name string) bool {
+	return d.balancer.HasService(name)
+}
+
+// EndpointStats is a snapshot of state and cumulative traffic of connection to endpoint of database
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type EndpointStats struct {
+	Endpoint trace.EndpointInfo
+	// State is a state of connection to endpoint (for example "Online" or "Banned")
+	State string
+	// InFlight is a number of calls and streams which are in progress on connection
+	InFlight int
+	// SentBytes and ReceivedBytes are sizes of messages which are sent and received over connection
+	SentBytes     uint64
+	ReceivedBytes uint64
+}
+
+// Endpoints returns stats of connections to discovered endpoints of database.
+// Endpoints does not make any I/O
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Endpoints() []EndpointStats {
+	snapshots := d.balancer.Snapshot()
+	stats := make([]EndpointStats, 0, len(snapshots))
+	for _, s := range snapshots {
+		stats = append(stats, EndpointStats{
+			Endpoint:      s.Endpoint,
+			State:         s.State.String(),
+			InFlight:      s.InFlight,
+			SentBytes:     s.Traffic.SentBytes,
+			ReceivedBytes: s.Traffic.ReceivedBytes,
+		})
+	}
+
+	return stats
+}
+
 // Scripting returns scripting client
 func (d *Driver) Scripting() scripting.Client {
 	return d.scripting.Get()
@@ -248,7 +360,7 @@ func Open(ctx context.Context, dsn string, opts ...Option) (_ *Driver, err error
 	onDone := trace.DriverOnInit(
 		d.trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/ydb.Open"),
-		d.config.Endpoint(), d.config.Database(), d.config.Secure(),
+		d.config.Endpoint(), d.config.Database(), d.config.Secure(), d.config.Meta().ApplicationName(),
 	)
 	defer func() {
 		onDone(err)
@@ -286,7 +398,7 @@ func New(ctx context.Context, opts ...Option) (_ *Driver, err error) { //nolint:
 	onDone := trace.DriverOnInit(
 		d.trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/ydb.New"),
-		d.config.Endpoint(), d.config.Database(), d.config.Secure(),
+		d.config.Endpoint(), d.config.Database(), d.config.Secure(), d.config.Meta().ApplicationName(),
 	)
 	defer func() {
 		onDone(err)
@@ -391,33 +503,60 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 		))
 	}
 
+	if d.oauth2Options != nil {
+		creds, err := credentials.NewOauth2TokenExchangeCredentials(append(
+			// prepend default user agent for override it by user options
+			[]credentials.Oauth2TokenExchangeCredentialsOption{
+				credentials.WithUserAgent(d.config.Meta().UserAgent()),
+			},
+			d.oauth2Options...,
+		)...)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		d.config = d.config.With(config.WithCredentials(creds))
+	}
+
 	if d.pool == nil {
 		d.pool = conn.NewPool(ctx, d.config)
 	}
 
-	d.balancer, err = balancer.New(ctx, d.config, d.pool, d.discoveryOptions...)
-	if err != nil {
-		return xerrors.WithStackTrace(err)
+	if d.shared != nil {
+		// child driver of other database uses balancer of parent driver
+		d.balancer = &databaseBalancer{
+			sharedBalancer: d.shared,
+			database:       d.config.Database(),
+		}
+	} else {
+		var b *balancer.Balancer
+		b, err = balancer.New(ctx, d.config, d.pool, d.discoveryOptions...)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		d.shared = newSharedBalancer(b)
+		d.balancer = d.shared
 	}
 
+	tableCfg := tableConfig.New(
+		append(
+			// prepend common params from root config
+			[]tableConfig.Option{
+				tableConfig.With(d.config.Common),
+			},
+			d.tableOptions...,
+		)...,
+	)
 	d.table = xsync.OnceValue(func() *internalTable.Client {
-		return internalTable.New(xcontext.ValueOnly(ctx),
-			d.balancer,
-			tableConfig.New(
-				append(
-					// prepend common params from root config
-					[]tableConfig.Option{
-						tableConfig.With(d.config.Common),
-					},
-					d.tableOptions...,
-				)...,
-			),
-		)
+		return internalTable.New(xcontext.ValueOnly(ctx), d.balancer, tableCfg)
 	})
+	if tableCfg.WarmUp() > 0 {
+		// warm-up of sessions pool starts on creation of table client
+		d.table.Get()
+	}
 
 	d.query = xsync.OnceValue(func() *internalQuery.Client {
 		return internalQuery.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			withServiceCheck(d.balancer, serviceQuery),
 			queryConfig.New(
 				append(
 					// prepend common params from root config
@@ -449,9 +588,47 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 		)
 	})
 
+	d.operation = xsync.OnceValue(func() *internalOperation.Client {
+		return internalOperation.New(xcontext.ValueOnly(ctx),
+			d.balancer,
+			operationConfig.New(
+				operationConfig.With(d.config.Common),
+			),
+		)
+	})
+
+	d.export = xsync.OnceValue(func() *internalBackup.ExportClient {
+		return internalBackup.NewExportClient(xcontext.ValueOnly(ctx),
+			withServiceCheck(d.balancer, serviceExport),
+			d.operation.Get(),
+			backupConfig.New(
+				backupConfig.With(d.config.Common),
+			),
+		)
+	})
+
+	d.imprt = xsync.OnceValue(func() *internalBackup.ImportClient {
+		return internalBackup.NewImportClient(xcontext.ValueOnly(ctx),
+			withServiceCheck(d.balancer, serviceImport),
+			d.operation.Get(),
+			backupConfig.New(
+				backupConfig.With(d.config.Common),
+			),
+		)
+	})
+
+	d.monitoring = xsync.OnceValue(func() *internalMonitoring.Client {
+		return internalMonitoring.New(xcontext.ValueOnly(ctx),
+			withServiceCheck(d.balancer, serviceMonitoring),
+			monitoringConfig.New(
+				monitoringConfig.With(d.config.Common),
+			),
+		)
+	})
+
 	d.coordination = xsync.OnceValue(func() *internalCoordination.Client {
 		return internalCoordination.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			withServiceCheck(d.balancer, serviceCoordination),
 			coordinationConfig.New(
 				append(
 					// prepend common params from root config
@@ -466,7 +643,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 
 	d.ratelimiter = xsync.OnceValue(func() *internalRatelimiter.Client {
 		return internalRatelimiter.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			withServiceCheck(d.balancer, serviceRatelimiter),
 			ratelimiterConfig.New(
 				append(
 					// prepend common params from root config
@@ -500,7 +677,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 
 	d.scripting = xsync.OnceValue(func() *internalScripting.Client {
 		return internalScripting.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			withServiceCheck(d.balancer, serviceScripting),
 			scriptingConfig.New(
 				append(
 					// prepend common params from root config
@@ -515,7 +692,7 @@ func (d *Driver) connect(ctx context.Context) (err error) {
 
 	d.topic = xsync.OnceValue(func() *topicclientinternal.Client {
 		return topicclientinternal.New(xcontext.ValueOnly(ctx),
-			d.balancer,
+			withServiceCheck(d.balancer, serviceTopic),
 			d.config.Credentials(),
 			append(
 				// prepend common params from root config
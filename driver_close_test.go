@@ -0,0 +1,103 @@
+package ydb //nolint:testpackage
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rekby/fixenv"
+	"github.com/rekby/fixenv/sf"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/backup"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+)
+
+type unimplementedTopicService struct {
+	Ydb_Topic_V1.UnimplementedTopicServiceServer
+}
+
+func TestDriverCloseGoroutinesLeak(t *testing.T) {
+	e := fixenv.New(t)
+	connString := xtest.GrpcMockTopicConnString(e, &unimplementedTopicService{})
+
+	check := xtest.CheckGoroutinesLeak(t)
+
+	db, err := Open(sf.Context(e), connString)
+	require.NoError(t, err)
+
+	callCtx, cancel := context.WithTimeout(sf.Context(e), 100*time.Millisecond)
+	defer cancel()
+
+	// nolint:errcheck
+	{
+		_ = db.Table().Do(callCtx, func(ctx context.Context, s table.Session) error {
+			return nil
+		})
+		_ = db.Query().Do(callCtx, func(ctx context.Context, s query.Session) error {
+			return nil
+		})
+		_, _ = db.Scheme().ListDirectory(callCtx, db.Name())
+		_, _ = db.Scripting().Explain(callCtx, "SELECT 1", 0)
+		_ = db.Coordination().DropNode(callCtx, "node")
+		_ = db.Ratelimiter().DropResource(callCtx, "coordination", "resource")
+		_ = db.Topic().Drop(callCtx, "topic")
+		_, _ = db.Operation().List(callCtx, operation.KindExportToS3, 10, "")
+		_, _ = db.Export().ExportToS3(callCtx, backup.ExportToS3Settings{
+			S3:    backup.S3{Endpoint: "s3", Bucket: "bucket"},
+			Items: []backup.ExportItem{{SourcePath: "table", DestinationPrefix: "prefix"}},
+		})
+		_, _ = db.Import().ImportFromS3(callCtx, backup.ImportFromS3Settings{
+			S3:    backup.S3{Endpoint: "s3", Bucket: "bucket"},
+			Items: []backup.ImportItem{{SourcePrefix: "prefix", DestinationPath: "table"}},
+		})
+		_, _ = db.Monitoring().SelfCheck(callCtx)
+	}
+
+	reader, err := db.Topic().StartReader("consumer", topicoptions.ReadTopic("topic"))
+	require.NoError(t, err)
+	_ = reader
+
+	writer, err := db.Topic().StartWriter("topic")
+	require.NoError(t, err)
+	_ = writer
+
+	require.NoError(t, db.Close(sf.Context(e)))
+
+	check()
+
+	_, err = db.Topic().StartReader("consumer", topicoptions.ReadTopic("topic"))
+	require.Error(t, err)
+}
+
+func TestDriverCloseIdempotent(t *testing.T) {
+	e := fixenv.New(t)
+	connString := xtest.GrpcMockTopicConnString(e, &unimplementedTopicService{})
+
+	db, err := Open(sf.Context(e), connString)
+	require.NoError(t, err)
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, 10)
+	)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = db.Close(sf.Context(e))
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.Equal(t, errs[0], err)
+	}
+	require.Equal(t, errs[0], db.Close(sf.Context(e)))
+}
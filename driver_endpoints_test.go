@@ -0,0 +1,53 @@
+package ydb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+)
+
+// snapshotBalancerStub is a balancer with given snapshot of connections
+type snapshotBalancerStub struct {
+	driverBalancer
+
+	snapshot []balancer.EndpointSnapshot
+}
+
+func (b *snapshotBalancerStub) Snapshot() []balancer.EndpointSnapshot {
+	return b.snapshot
+}
+
+func TestDriverEndpoints(t *testing.T) {
+	require.Empty(t, (&Driver{balancer: &snapshotBalancerStub{}}).Endpoints())
+
+	d := &Driver{balancer: &snapshotBalancerStub{snapshot: []balancer.EndpointSnapshot{
+		{
+			Endpoint: endpoint.New("node-1:2135", endpoint.WithID(1)),
+			State:    conn.Online,
+			InFlight: 2,
+			Traffic: conn.Traffic{
+				SentBytes:     10,
+				ReceivedBytes: 20,
+			},
+		},
+		{
+			Endpoint: endpoint.New("node-2:2135", endpoint.WithID(2)),
+			State:    conn.Banned,
+		},
+	}}}
+	endpoints := d.Endpoints()
+	require.Len(t, endpoints, 2)
+	require.Equal(t, "node-1:2135", endpoints[0].Endpoint.Address())
+	require.EqualValues(t, 1, endpoints[0].Endpoint.NodeID())
+	require.Equal(t, conn.Online.String(), endpoints[0].State)
+	require.Equal(t, 2, endpoints[0].InFlight)
+	require.EqualValues(t, 10, endpoints[0].SentBytes)
+	require.EqualValues(t, 20, endpoints[0].ReceivedBytes)
+	require.Equal(t, "node-2:2135", endpoints[1].Endpoint.Address())
+	require.Equal(t, conn.Banned.String(), endpoints[1].State)
+	require.Zero(t, endpoints[1].SentBytes)
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	grpcCodes "google.golang.org/grpc/codes"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
 	ratelimiterErrors "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/errors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
@@ -83,6 +85,9 @@ func IsOperationErrorSchemeError(err error) bool {
 }
 
 // IsOperationErrorTransactionLocksInvalidated checks does err a TLI issue
+// Check passes through all wrapping layers of SDK, including errors of database/sql driver and
+// joined errors of retries. Retriers (DoTx of table and query clients, retry.DoTx) retry TLI errors
+// with new transaction even if operation is not marked as idempotent
 //
 //nolint:nonamedreturns
 func IsOperationErrorTransactionLocksInvalidated(err error) (isTLI bool) {
@@ -99,3 +104,104 @@ func IsRatelimiterAcquireError(err error) bool {
 func ToRatelimiterAcquireError(err error) ratelimiter.AcquireError {
 	return ratelimiterErrors.ToAcquireError(err)
 }
+
+// OperationStatus returns status code of operation error from err.
+// It passes through wrapping of errors with fmt.Errorf("%w") and wrapping of database/sql driver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func OperationStatus(err error) (Ydb.StatusIds_StatusCode, bool) {
+	return xerrors.OperationStatus(err)
+}
+
+// TransportStatus returns grpc code of transport error from err.
+// It passes through wrapping of errors with fmt.Errorf("%w") and wrapping of database/sql driver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func TransportStatus(err error) (grpcCodes.Code, bool) {
+	return xerrors.TransportStatus(err)
+}
+
+// NodeAddress returns address of node which returned operation or transport error.
+// It passes through wrapping of errors with fmt.Errorf("%w") and wrapping of database/sql driver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NodeAddress(err error) (string, bool) {
+	return xerrors.NodeAddress(err)
+}
+
+// NodeID returns id of node which returned operation or transport error.
+// It passes through wrapping of errors with fmt.Errorf("%w") and wrapping of database/sql driver.
+// For error of retry loop it returns id of node of the last failed attempt
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NodeID(err error) (uint32, bool) {
+	return xerrors.NodeID(err)
+}
+
+// IssuePosition is a position of issue in query text
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type IssuePosition = issues.Position
+
+// Issue is an issue of operation error or a non-fatal issue (warning) of successful operation
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Issue = issues.Issue
+
+// Issues returns issues of operation error from err with nested issues.
+// It passes through wrapping of errors with fmt.Errorf("%w") and wrapping of database/sql driver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Issues(err error) []Issue {
+	return issues.FromYDB(xerrors.OperationIssues(err))
+}
+
+// DeadlineSource is a source of expired deadline which caused error (see DeadlineCause)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type DeadlineSource = xerrors.DeadlineSource
+
+const (
+	// DeadlineSourceCaller is a deadline of context passed by caller or other deadline which is not set by driver
+	DeadlineSourceCaller = xerrors.DeadlineSourceCaller
+	// DeadlineSourceDefaultOperationTimeout is a client-side default timeout of calls (see WithDefaultOperationTimeout)
+	DeadlineSourceDefaultOperationTimeout = xerrors.DeadlineSourceDefaultOperationTimeout
+	// DeadlineSourceDefaultSchemeTimeout is a client-side default timeout of scheme calls
+	DeadlineSourceDefaultSchemeTimeout = xerrors.DeadlineSourceDefaultSchemeTimeout
+	// DeadlineSourceDefaultScanQueryTimeout is a client-side default timeout of scan queries
+	DeadlineSourceDefaultScanQueryTimeout = xerrors.DeadlineSourceDefaultScanQueryTimeout
+	// DeadlineSourceAttemptTimeout is a timeout of attempt of retry loop (see retry.WithAttemptTimeout)
+	DeadlineSourceAttemptTimeout = xerrors.DeadlineSourceAttemptTimeout
+	// DeadlineSourceOperationTimeout is a server-side operation timeout (see WithOperationTimeout)
+	DeadlineSourceOperationTimeout = xerrors.DeadlineSourceOperationTimeout
+	// DeadlineSourceOperationCancelAfter is a server-side operation cancel after timeout (see WithOperationCancelAfter)
+	DeadlineSourceOperationCancelAfter = xerrors.DeadlineSourceOperationCancelAfter
+	// DeadlineSourceDialTimeout is a timeout of dial of connection (see WithDialTimeout)
+	DeadlineSourceDialTimeout = xerrors.DeadlineSourceDialTimeout
+	// DeadlineSourceSessionCreateTimeout is a timeout of creation of session of session pool
+	DeadlineSourceSessionCreateTimeout = xerrors.DeadlineSourceSessionCreateTimeout
+	// DeadlineSourceSessionDeleteTimeout is a timeout of deletion of session of session pool
+	DeadlineSourceSessionDeleteTimeout = xerrors.DeadlineSourceSessionDeleteTimeout
+)
+
+// Deadline describes expired deadline: source of deadline and configured timeout.
+// Timeout is zero for DeadlineSourceCaller
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Deadline = xerrors.Deadline
+
+// DeadlineCause returns expired deadline which caused err, such as context deadline exceeded or
+// operation TIMEOUT status. It returns false if err is not caused by expired deadline.
+// It passes through wrapping of errors with fmt.Errorf("%w") and wrapping of database/sql driver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DeadlineCause(err error) (Deadline, bool) {
+	return xerrors.DeadlineCause(err)
+}
+
+// ErrStreamInactivityTimeout is an error of grpc stream which received no messages during
+// stream inactivity timeout (see WithStreamInactivityTimeout). Such error is a transport error
+// with code Unavailable
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrStreamInactivityTimeout = conn.ErrStreamInactivityTimeout
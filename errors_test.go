@@ -0,0 +1,122 @@
+package ydb
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
+)
+
+func wrappings(err error) map[string]error {
+	return map[string]error{
+		"Plain":       err,
+		"Wrapped":     fmt.Errorf("wrapped: %w", xerrors.WithStackTrace(err)),
+		"DatabaseSQL": fmt.Errorf("sql: %w", badconn.Map(xerrors.WithStackTrace(err))),
+		"Retried": xerrors.WithStackTrace(xerrors.Join(
+			fmt.Errorf("attempt No.%d: %w", 2, context.DeadlineExceeded), err,
+		)),
+	}
+}
+
+func TestOperationErrorInspection(t *testing.T) {
+	opErr := xerrors.Operation(
+		xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION), // bad session is mapped to driver.ErrBadConn
+		xerrors.WithAddress("localhost:2135"),
+		xerrors.WithNodeID(7),
+		xerrors.WithLocation("vla"),
+		xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+			Message:   "top",
+			IssueCode: 1,
+			Severity:  2,
+			Position:  &Ydb_Issue.IssueMessage_Position{Row: 3, Column: 4, File: "query.yql"},
+			Issues: []*Ydb_Issue.IssueMessage{{
+				Message:   "nested",
+				IssueCode: 5,
+			}},
+		}}),
+	)
+	for name, err := range wrappings(opErr) {
+		t.Run(name, func(t *testing.T) {
+			code, ok := OperationStatus(err)
+			require.True(t, ok)
+			require.Equal(t, Ydb.StatusIds_BAD_SESSION, code)
+
+			address, ok := NodeAddress(err)
+			require.True(t, ok)
+			require.Equal(t, "localhost:2135", address)
+
+			nodeID, ok := NodeID(err)
+			require.True(t, ok)
+			require.EqualValues(t, 7, nodeID)
+
+			require.Equal(t, []Issue{{
+				Code:     1,
+				Message:  "top",
+				Severity: 2,
+				Position: IssuePosition{Row: 3, Column: 4, File: "query.yql"},
+				Issues: []Issue{{
+					Code:    5,
+					Message: "nested",
+				}},
+			}}, Issues(err))
+
+			_, ok = TransportStatus(err)
+			require.False(t, ok)
+		})
+	}
+	require.ErrorIs(t, wrappings(opErr)["DatabaseSQL"], driver.ErrBadConn)
+	require.Contains(t, opErr.Error(), "address = localhost:2135, nodeID = 7, location = vla")
+}
+
+func TestTransportErrorInspection(t *testing.T) {
+	transportErr := xerrors.Transport(
+		grpcStatus.Error(grpcCodes.Unavailable, "unavailable"),
+		xerrors.WithAddress("localhost:2135"),
+		xerrors.WithNodeID(7),
+		xerrors.WithLocation("vla"),
+	)
+	for name, err := range wrappings(transportErr) {
+		t.Run(name, func(t *testing.T) {
+			code, ok := TransportStatus(err)
+			require.True(t, ok)
+			require.Equal(t, grpcCodes.Unavailable, code)
+
+			address, ok := NodeAddress(err)
+			require.True(t, ok)
+			require.Equal(t, "localhost:2135", address)
+
+			nodeID, ok := NodeID(err)
+			require.True(t, ok)
+			require.EqualValues(t, 7, nodeID)
+
+			_, ok = OperationStatus(err)
+			require.False(t, ok)
+			require.Nil(t, Issues(err))
+		})
+	}
+	require.Contains(t, transportErr.Error(), `address: "localhost:2135", nodeID: 7, location: "vla"`)
+}
+
+func TestNotYdbErrorInspection(t *testing.T) {
+	err := errors.New("test")
+	_, ok := OperationStatus(err)
+	require.False(t, ok)
+	_, ok = TransportStatus(err)
+	require.False(t, ok)
+	_, ok = NodeAddress(err)
+	require.False(t, ok)
+	_, ok = NodeID(err)
+	require.False(t, ok)
+	require.Nil(t, Issues(err))
+	require.Nil(t, Issues(nil))
+}
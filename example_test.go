@@ -370,6 +370,70 @@ func Example_databaseSQLBindAutoDeclareOverConnector() {
 	}
 }
 
+//nolint:testableexamples
+func Example_databaseSQLSchemeAndScriptingQueryModes() {
+	db, err := sql.Open("ydb", "grpc://localhost:2136/local")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() { _ = db.Close() }() // cleanup resources
+
+	// DDL queries must be executed with scheme query mode and outside of transaction
+	_, err = db.ExecContext(ydb.WithQueryMode(context.TODO(), ydb.SchemeQueryMode), `
+		CREATE TABLE series (
+			id Uint64,
+			title Text,
+			PRIMARY KEY (id)
+		)`,
+	)
+	if err != nil {
+		log.Printf("create table failed: %v", err)
+	}
+
+	// scripting query mode allows to execute several DDL and DML queries as one script
+	_, err = db.ExecContext(ydb.WithQueryMode(context.TODO(), ydb.ScriptingQueryMode), `
+		ALTER TABLE series ADD COLUMN release_date Date;
+		COMMIT;
+		UPSERT INTO series (id, title) VALUES (1, "IT Crowd");`,
+	)
+	if err != nil {
+		log.Printf("script failed: %v", err)
+	}
+}
+
+//nolint:testableexamples
+func Example_databaseSQLReturning() {
+	db, err := sql.Open("ydb", "grpc://localhost:2136/local?go_query_bind=declare")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() { _ = db.Close() }() // cleanup resources
+
+	// statements with RETURNING must be executed with Query methods for reading of returned rows
+	var id uint64
+	row := db.QueryRowContext(context.TODO(),
+		"INSERT INTO series (id, title) VALUES (RandomNumber($title), $title) RETURNING id",
+		sql.Named("title", "IT Crowd"),
+	)
+	if err = row.Scan(&id); err != nil {
+		log.Printf("insert failed: %v", err)
+	} else {
+		log.Printf("id=%v\n", id)
+	}
+
+	// also values of first returned row can be scanned into sql.Out arguments by column names
+	_, err = db.ExecContext(context.TODO(),
+		"INSERT INTO series (id, title) VALUES (RandomNumber($title), $title) RETURNING id",
+		sql.Named("title", "Silicon Valley"),
+		sql.Named("id", sql.Out{Dest: &id}),
+	)
+	if err != nil {
+		log.Printf("insert failed: %v", err)
+	} else {
+		log.Printf("id=%v\n", id)
+	}
+}
+
 //nolint:testableexamples
 func Example_topic() {
 	ctx := context.TODO()
@@ -0,0 +1,14 @@
+package ydb
+
+import "github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+
+// QueryFingerprint returns short stable identifier of YQL query text for grouping of queries in metrics and logs.
+// Query text is normalized before hashing: comments are stripped, whitespaces are normalized and literal values
+// (strings and numbers) are replaced with placeholders. So queries which differ only in literal values, comments
+// and formatting have same fingerprint.
+// Fingerprint is reported as QueryFingerprint field of table and database/sql query trace events
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func QueryFingerprint(query string) string {
+	return fingerprint.Query(query)
+}
@@ -0,0 +1,89 @@
+package ydb
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errNoBalancer = xerrors.Wrap(errors.New("driver is not connected"))
+
+type grpcConnOptions struct {
+	nodeID  uint32
+	service string
+}
+
+// GRPCConnOption is an option of Driver.GRPCConn
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type GRPCConnOption func(opts *grpcConnOptions)
+
+// WithPreferredNodeID makes calls of connection to node with given id if node is available,
+// otherwise calls are balanced as usual
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPreferredNodeID(id uint32) GRPCConnOption {
+	return func(opts *grpcConnOptions) {
+		opts.nodeID = id
+	}
+}
+
+// WithServiceRequired makes calls of connection to endpoints which advertise service with given name
+// (see endpoint.Info.Services), if any
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithServiceRequired(name string) GRPCConnOption {
+	return func(opts *grpcConnOptions) {
+		opts.service = name
+	}
+}
+
+type nodeEndpoint uint32
+
+func (id nodeEndpoint) NodeID() uint32 {
+	return uint32(id)
+}
+
+// GRPCConn returns grpc.ClientConnInterface over internal driver balancer for calls of YDB services
+// unsupported by driver with generated grpc stubs. Calls use discovered endpoints, credentials and
+// metadata of driver and are traced with trace.Driver conn events as calls of driver clients.
+// Errors of calls are not wrapped, so generated stubs get grpc status errors
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) GRPCConn(ctx context.Context, opts ...GRPCConnOption) (grpc.ClientConnInterface, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	d.mtx.Lock()
+	b := d.balancer
+	d.mtx.Unlock()
+
+	if b == nil {
+		return nil, xerrors.WithStackTrace(errNoBalancer)
+	}
+
+	var options grpcConnOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	return conn.WithContextModifier(b, func(ctx context.Context) context.Context {
+		ctx = conn.WithoutWrapping(ctx)
+		if options.nodeID != 0 {
+			ctx = balancer.WithEndpoint(ctx, nodeEndpoint(options.nodeID))
+		}
+		if options.service != "" {
+			ctx = balancer.WithRequiredService(ctx, options.service)
+		}
+
+		return ctx
+	}), nil
+}
@@ -0,0 +1,102 @@
+package ydb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// contextBalancerStub is a balancer which keeps contexts of calls
+type contextBalancerStub struct {
+	driverBalancer
+
+	contexts []context.Context
+}
+
+func (b *contextBalancerStub) Invoke(
+	ctx context.Context, _ string, _ interface{}, _ interface{}, _ ...grpc.CallOption,
+) error {
+	b.contexts = append(b.contexts, ctx)
+
+	return nil
+}
+
+func (b *contextBalancerStub) NewStream(
+	ctx context.Context, _ *grpc.StreamDesc, _ string, _ ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	b.contexts = append(b.contexts, ctx)
+
+	return nil, nil //nolint:nilnil
+}
+
+func TestDriverGRPCConn(t *testing.T) {
+	t.Run("NotConnected", func(t *testing.T) {
+		_, err := (&Driver{}).GRPCConn(xtest.Context(t))
+		require.ErrorIs(t, err, errNoBalancer)
+	})
+	t.Run("CanceledContext", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(xtest.Context(t))
+		cancel()
+		_, err := (&Driver{balancer: &contextBalancerStub{}}).GRPCConn(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+	for _, tt := range []struct {
+		name    string
+		opts    []GRPCConnOption
+		nodeID  uint32
+		service string
+	}{
+		{
+			name: "WithoutOptions",
+		},
+		{
+			name:   "WithPreferredNodeID",
+			opts:   []GRPCConnOption{WithPreferredNodeID(42)},
+			nodeID: 42,
+		},
+		{
+			name:    "WithServiceRequired",
+			opts:    []GRPCConnOption{WithServiceRequired("custom_service"), nil},
+			service: "custom_service",
+		},
+		{
+			name:    "AllOptions",
+			opts:    []GRPCConnOption{WithPreferredNodeID(42), WithServiceRequired("custom_service")},
+			nodeID:  42,
+			service: "custom_service",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &contextBalancerStub{}
+			cc, err := (&Driver{balancer: stub}).GRPCConn(xtest.Context(t), tt.opts...)
+			require.NoError(t, err)
+
+			ctx := xtest.Context(t)
+			require.NoError(t, cc.Invoke(ctx, "/Custom.V1.CustomService/Call", nil, nil))
+			_, err = cc.NewStream(ctx, &grpc.StreamDesc{}, "/Custom.V1.CustomService/Stream")
+			require.NoError(t, err)
+
+			require.Len(t, stub.contexts, 2)
+			for _, ctx := range stub.contexts {
+				// errors of calls are not wrapped for generated grpc stubs
+				require.False(t, conn.UseWrapping(ctx))
+
+				e, has := balancer.ContextEndpoint(ctx)
+				require.Equal(t, tt.nodeID != 0, has)
+				if has {
+					require.Equal(t, tt.nodeID, e.NodeID())
+				}
+
+				service, has := balancer.ContextRequiredService(ctx)
+				require.Equal(t, tt.service != "", has)
+				require.Equal(t, tt.service, service)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Type ids of union Type and enums of Arrow schema (https://github.com/apache/arrow/blob/main/format/Schema.fbs)
+const (
+	typeInt             = 2
+	typeFloatingPoint   = 3
+	typeBinary          = 4
+	typeUtf8            = 5
+	typeBool            = 6
+	typeDecimal         = 7
+	typeDate            = 8
+	typeTimestamp       = 10
+	typeFixedSizeBinary = 15
+	typeDuration        = 18
+
+	precisionSingle = 1
+	precisionDouble = 2
+
+	dateUnitDay = 0
+
+	timeUnitSecond      = 0
+	timeUnitMicrosecond = 2
+
+	decimalBitWidth = 128
+	uuidByteWidth   = 16
+)
+
+// column accumulates items of one column of result set in Arrow layout
+type column struct {
+	name     string
+	nullable bool
+
+	typeID    uint8
+	typeTable fbTable
+
+	// width is a size of fixed width value in bytes, 0 for bool and variable width values
+	width int
+	// fixed appends fixed width value to data
+	fixed func(data []byte, v *item) []byte
+	// varying appends variable width value to data
+	varying func(data []byte, v *item) []byte
+	// bit returns value of bool column
+	bit func(v *item) bool
+
+	length    int
+	nullCount int
+	validity  []byte
+	offsets   []byte
+	data      []byte
+}
+
+func newColumn(c *Ydb.Column) (*column, error) {
+	col := &column{name: c.GetName()}
+	t := c.GetType()
+	for t.GetOptionalType() != nil {
+		col.nullable = true
+		t = t.GetOptionalType().GetItem()
+	}
+	if d := t.GetDecimalType(); d != nil {
+		col.setDecimal(d)
+
+		return col, nil
+	}
+	if _, ok := t.GetType().(*Ydb.Type_TypeId); !ok {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: column %q of type %s",
+			ErrUnsupportedType, c.GetName(), types.TypeFromYDB(t).Yql()),
+		)
+	}
+	if !col.setPrimitive(t.GetTypeId()) {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: column %q of type %s",
+			ErrUnsupportedType, c.GetName(), types.TypeFromYDB(t).Yql()),
+		)
+	}
+
+	return col, nil
+}
+
+func (c *column) setInt(bitWidth int, signed bool, fixed func(data []byte, v *item) []byte) {
+	c.typeID = typeInt
+	c.typeTable = fbTable{fbInt32(0, int32(bitWidth)), fbBool(1, signed)}
+	c.width = bitWidth / 8 //nolint:gomnd
+	c.fixed = fixed
+}
+
+func (c *column) setTimestamp(unit int16, fixed func(data []byte, v *item) []byte) {
+	c.typeID = typeTimestamp
+	c.typeTable = fbTable{fbInt16(0, unit), fbChild(1, fbString("UTC"))}
+	c.width = 8
+	c.fixed = fixed
+}
+
+func (c *column) setDecimal(d *Ydb.DecimalType) {
+	c.typeID = typeDecimal
+	c.typeTable = fbTable{
+		fbInt32(0, int32(d.GetPrecision())),
+		fbInt32(1, int32(d.GetScale())),
+		fbInt32(2, decimalBitWidth), //nolint:gomnd
+	}
+	c.width = 16
+	// Decimal128 is a little-endian two's complement integer as YDB decimal
+	c.fixed = func(data []byte, v *item) []byte {
+		data = binary.LittleEndian.AppendUint64(data, v.low)
+
+		return binary.LittleEndian.AppendUint64(data, v.high)
+	}
+}
+
+//nolint:funlen
+func (c *column) setPrimitive(id Ydb.Type_PrimitiveTypeId) bool {
+	switch id {
+	case Ydb.Type_BOOL:
+		c.typeID = typeBool
+		c.bit = func(v *item) bool {
+			return v.varint != 0
+		}
+	case Ydb.Type_INT8:
+		c.setInt(8, true, func(data []byte, v *item) []byte { //nolint:gomnd
+			return append(data, byte(v.varint))
+		})
+	case Ydb.Type_UINT8:
+		c.setInt(8, false, func(data []byte, v *item) []byte { //nolint:gomnd
+			return append(data, byte(v.varint))
+		})
+	case Ydb.Type_INT16:
+		c.setInt(16, true, func(data []byte, v *item) []byte { //nolint:gomnd
+			return binary.LittleEndian.AppendUint16(data, uint16(v.varint))
+		})
+	case Ydb.Type_UINT16:
+		c.setInt(16, false, func(data []byte, v *item) []byte { //nolint:gomnd
+			return binary.LittleEndian.AppendUint16(data, uint16(v.varint))
+		})
+	case Ydb.Type_INT32:
+		c.setInt(32, true, func(data []byte, v *item) []byte { //nolint:gomnd
+			return binary.LittleEndian.AppendUint32(data, uint32(v.varint))
+		})
+	case Ydb.Type_UINT32:
+		c.setInt(32, false, func(data []byte, v *item) []byte { //nolint:gomnd
+			return binary.LittleEndian.AppendUint32(data, uint32(v.varint))
+		})
+	case Ydb.Type_INT64:
+		c.setInt(64, true, func(data []byte, v *item) []byte { //nolint:gomnd
+			return binary.LittleEndian.AppendUint64(data, v.varint)
+		})
+	case Ydb.Type_UINT64:
+		c.setInt(64, false, func(data []byte, v *item) []byte { //nolint:gomnd
+			return binary.LittleEndian.AppendUint64(data, v.varint)
+		})
+	case Ydb.Type_FLOAT:
+		c.typeID = typeFloatingPoint
+		c.typeTable = fbTable{fbInt16(0, precisionSingle)}
+		c.width = 4
+		c.fixed = func(data []byte, v *item) []byte {
+			return binary.LittleEndian.AppendUint32(data, uint32(v.bits))
+		}
+	case Ydb.Type_DOUBLE:
+		c.typeID = typeFloatingPoint
+		c.typeTable = fbTable{fbInt16(0, precisionDouble)}
+		c.width = 8
+		c.fixed = func(data []byte, v *item) []byte {
+			return binary.LittleEndian.AppendUint64(data, v.bits)
+		}
+	case Ydb.Type_DATE:
+		c.typeID = typeDate
+		c.typeTable = fbTable{fbInt16(0, dateUnitDay)}
+		c.width = 4
+		c.fixed = func(data []byte, v *item) []byte {
+			return binary.LittleEndian.AppendUint32(data, uint32(v.varint))
+		}
+	case Ydb.Type_DATETIME:
+		c.setTimestamp(timeUnitSecond, func(data []byte, v *item) []byte {
+			return binary.LittleEndian.AppendUint64(data, v.varint)
+		})
+	case Ydb.Type_TIMESTAMP:
+		c.setTimestamp(timeUnitMicrosecond, func(data []byte, v *item) []byte {
+			return binary.LittleEndian.AppendUint64(data, v.varint)
+		})
+	case Ydb.Type_INTERVAL:
+		c.typeID = typeDuration
+		c.typeTable = fbTable{fbInt16(0, timeUnitMicrosecond)}
+		c.width = 8
+		c.fixed = func(data []byte, v *item) []byte {
+			return binary.LittleEndian.AppendUint64(data, v.varint)
+		}
+	case Ydb.Type_UUID:
+		c.typeID = typeFixedSizeBinary
+		c.typeTable = fbTable{fbInt32(0, uuidByteWidth)}
+		c.width = uuidByteWidth
+		c.fixed = func(data []byte, v *item) []byte {
+			uuid := value.UUIDFromHiLoPair(v.high, v.low)
+
+			return append(data, uuid[:]...)
+		}
+	case Ydb.Type_STRING, Ydb.Type_YSON:
+		c.typeID = typeBinary
+		c.varying = func(data []byte, v *item) []byte {
+			return append(data, v.bytes...)
+		}
+	case Ydb.Type_UTF8, Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT, Ydb.Type_DYNUMBER,
+		Ydb.Type_TZ_DATE, Ydb.Type_TZ_DATETIME, Ydb.Type_TZ_TIMESTAMP:
+		c.typeID = typeUtf8
+		c.varying = func(data []byte, v *item) []byte {
+			return append(append(data, v.bytes...), v.text...)
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+// field returns description of column in Arrow schema
+func (c *column) field() fbTable {
+	typeTable := c.typeTable
+	if typeTable == nil {
+		typeTable = fbTable{}
+	}
+
+	return fbTable{
+		fbChild(0, fbString(c.name)),
+		fbBool(1, c.nullable),
+		fbUint8(2, c.typeID),  //nolint:gomnd
+		fbChild(3, typeTable), //nolint:gomnd
+		// readers require vector of children even for primitive types
+		fbChild(5, fbTables{}), //nolint:gomnd
+	}
+}
+
+func (c *column) reset() {
+	c.length = 0
+	c.nullCount = 0
+	c.validity = c.validity[:0]
+	c.data = c.data[:0]
+	c.offsets = c.offsets[:0]
+	if c.varying != nil {
+		c.offsets = binary.LittleEndian.AppendUint32(c.offsets, 0)
+	}
+}
+
+// append appends item of row to column
+func (c *column) append(v *item) error {
+	if v.null && !c.nullable {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: column %q", errNullValue, c.name))
+	}
+
+	if c.length%8 == 0 {
+		c.validity = append(c.validity, 0)
+	}
+	if v.null {
+		c.nullCount++
+	} else {
+		c.validity[c.length/8] |= 1 << (c.length % 8)
+	}
+
+	switch {
+	case c.bit != nil:
+		if c.length%8 == 0 {
+			c.data = append(c.data, 0)
+		}
+		if !v.null && c.bit(v) {
+			c.data[c.length/8] |= 1 << (c.length % 8)
+		}
+	case c.varying != nil:
+		if !v.null {
+			c.data = c.varying(c.data, v)
+		}
+		if len(c.data) > math.MaxInt32 {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: column %q", errTooLargeData, c.name))
+		}
+		c.offsets = binary.LittleEndian.AppendUint32(c.offsets, uint32(len(c.data)))
+	default:
+		if v.null {
+			c.data = append(c.data, make([]byte, c.width)...)
+		} else {
+			c.data = c.fixed(c.data, v)
+		}
+	}
+	c.length++
+
+	return nil
+}
+
+// buffers returns buffers of column in order of Arrow columnar format
+func (c *column) buffers() [][]byte {
+	validity := c.validity
+	if c.nullCount == 0 {
+		// validity bitmap may be omitted if column has no NULL values
+		validity = nil
+	}
+	if c.varying != nil {
+		return [][]byte{validity, c.offsets, c.data}
+	}
+
+	return [][]byte{validity, c.data}
+}
@@ -0,0 +1,16 @@
+package arrow
+
+import (
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	ErrUnsupportedType = xerrors.Wrap(errors.New("type is not supported by conversion to arrow"))
+
+	errNullValue     = xerrors.Wrap(errors.New("NULL value in column of not optional type"))
+	errTooLargeData  = xerrors.Wrap(errors.New("data of column exceeds 2GB limit of arrow record batch"))
+	errSchemaChanged = xerrors.Wrap(errors.New("columns differ from schema of arrow stream"))
+	errClosedWriter  = xerrors.Wrap(errors.New("arrow stream writer is closed"))
+)
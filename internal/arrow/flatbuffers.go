@@ -0,0 +1,172 @@
+package arrow
+
+import (
+	"encoding/binary"
+)
+
+// fbBuilder is a minimal flatbuffers encoder for Arrow IPC metadata.
+// Objects are written forward: parent table first and its children after it,
+// so offsets to children are always positive as flatbuffers requires
+type fbBuilder struct {
+	buf []byte
+}
+
+// fbObject is a flatbuffers object which may be referenced by offset (table, string or vector)
+type fbObject interface {
+	fbWrite(b *fbBuilder) (pos int)
+}
+
+type fbField struct {
+	slot   int
+	scalar []byte
+	child  fbObject
+}
+
+type fbTable []fbField
+
+type fbString string
+
+type fbTables []fbObject
+
+// fbStructs is a vector of structs of two longs (such as FieldNode and Buffer)
+type fbStructs []byte
+
+func fbInt16(slot int, v int16) fbField {
+	return fbField{slot: slot, scalar: binary.LittleEndian.AppendUint16(nil, uint16(v))}
+}
+
+func fbInt32(slot int, v int32) fbField {
+	return fbField{slot: slot, scalar: binary.LittleEndian.AppendUint32(nil, uint32(v))}
+}
+
+func fbInt64(slot int, v int64) fbField {
+	return fbField{slot: slot, scalar: binary.LittleEndian.AppendUint64(nil, uint64(v))}
+}
+
+func fbUint8(slot int, v uint8) fbField {
+	return fbField{slot: slot, scalar: []byte{v}}
+}
+
+func fbBool(slot int, v bool) fbField {
+	if v {
+		return fbUint8(slot, 1)
+	}
+
+	return fbUint8(slot, 0)
+}
+
+func fbChild(slot int, child fbObject) fbField {
+	return fbField{slot: slot, child: child}
+}
+
+// fbFinish encodes root table into flatbuffer
+func fbFinish(root fbTable) []byte {
+	b := &fbBuilder{buf: make([]byte, 4, 256)} //nolint:gomnd
+	pos := root.fbWrite(b)
+	binary.LittleEndian.PutUint32(b.buf[0:4], uint32(pos))
+
+	return b.buf
+}
+
+func (b *fbBuilder) pad(alignment int) {
+	for len(b.buf)%alignment != 0 {
+		b.buf = append(b.buf, 0)
+	}
+}
+
+// patch writes offset from position of offset field to target object
+func (b *fbBuilder) patch(at, target int) {
+	binary.LittleEndian.PutUint32(b.buf[at:at+4], uint32(target-at))
+}
+
+func (t fbTable) fbWrite(b *fbBuilder) int {
+	slots := 0
+	for _, f := range t {
+		if f.slot+1 > slots {
+			slots = f.slot + 1
+		}
+	}
+
+	// layout of inline fields: soffset to vtable, then fields aligned by their sizes
+	offsets := make([]int, len(t))
+	size := 4
+	for i, f := range t {
+		n := 4
+		if f.child == nil {
+			n = len(f.scalar)
+		}
+		for size%n != 0 {
+			size++
+		}
+		offsets[i] = size
+		size += n
+	}
+
+	b.pad(2) //nolint:gomnd
+	vtable := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint16(b.buf, uint16(4+2*slots))
+	b.buf = binary.LittleEndian.AppendUint16(b.buf, uint16(size))
+	vtableSlots := len(b.buf)
+	b.buf = append(b.buf, make([]byte, 2*slots)...)
+	for i, f := range t {
+		binary.LittleEndian.PutUint16(b.buf[vtableSlots+2*f.slot:], uint16(offsets[i]))
+	}
+
+	b.pad(8) //nolint:gomnd
+	pos := len(b.buf)
+	b.buf = append(b.buf, make([]byte, size)...)
+	binary.LittleEndian.PutUint32(b.buf[pos:], uint32(int32(pos-vtable)))
+	for i, f := range t {
+		if f.child == nil {
+			copy(b.buf[pos+offsets[i]:], f.scalar)
+		}
+	}
+	for i, f := range t {
+		if f.child != nil {
+			b.patch(pos+offsets[i], f.child.fbWrite(b))
+		}
+	}
+
+	return pos
+}
+
+func (s fbString) fbWrite(b *fbBuilder) int {
+	b.pad(4) //nolint:gomnd
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(len(s)))
+	b.buf = append(b.buf, s...)
+	b.buf = append(b.buf, 0)
+
+	return pos
+}
+
+func (v fbTables) fbWrite(b *fbBuilder) int {
+	b.pad(4) //nolint:gomnd
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(len(v)))
+	b.buf = append(b.buf, make([]byte, 4*len(v))...)
+	for i, t := range v {
+		b.patch(pos+4+4*i, t.fbWrite(b))
+	}
+
+	return pos
+}
+
+func (v fbStructs) fbWrite(b *fbBuilder) int {
+	// elements of vector are aligned by 8 bytes, so length of vector is placed just before aligned position
+	for len(b.buf)%8 != 4 {
+		b.buf = append(b.buf, 0)
+	}
+	pos := len(b.buf)
+	b.buf = binary.LittleEndian.AppendUint32(b.buf, uint32(len(v)/16)) //nolint:gomnd
+	b.buf = append(b.buf, v...)
+
+	return pos
+}
+
+// appendStruct appends struct of two longs (FieldNode or Buffer) to vector
+func (v fbStructs) appendStruct(a, c int64) fbStructs {
+	v = binary.LittleEndian.AppendUint64(v, uint64(a))
+
+	return binary.LittleEndian.AppendUint64(v, uint64(c))
+}
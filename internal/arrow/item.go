@@ -0,0 +1,184 @@
+package arrow
+
+import (
+	"math"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// item is a primitive value of row in form of scalars of protobuf wire format, so columns are filled
+// both from decoded Ydb.Value and directly from encoded rows without decoding of Ydb.Value
+type item struct {
+	null bool
+
+	// varint is a value of integer fields, int32 value is sign-extended
+	varint uint64
+	// bits is a value of float (low 32 bits) and double fields
+	bits uint64
+
+	// bytes is a value of bytes field or text field of encoded item, text is a value of text field of Ydb.Value
+	bytes []byte
+	text  string
+
+	low, high uint64
+}
+
+var (
+	boolFieldNum   = valueField("bool_value")
+	int32FieldNum  = valueField("int32_value")
+	uint32FieldNum = valueField("uint32_value")
+	int64FieldNum  = valueField("int64_value")
+	uint64FieldNum = valueField("uint64_value")
+	floatFieldNum  = valueField("float_value")
+	doubleFieldNum = valueField("double_value")
+	bytesFieldNum  = valueField("bytes_value")
+	textFieldNum   = valueField("text_value")
+	nestedFieldNum = valueField("nested_value")
+	lowFieldNum    = valueField("low_128")
+	highFieldNum   = valueField("high_128")
+	itemsFieldNum  = valueField("items")
+)
+
+func valueField(name protoreflect.Name) protowire.Number {
+	return (&Ydb.Value{}).ProtoReflect().Descriptor().Fields().ByName(name).Number()
+}
+
+// fromValue fills item by value of optional or primitive type
+func (it *item) fromValue(v *Ydb.Value) {
+	*it = item{high: v.GetHigh_128()}
+	switch vv := v.GetValue().(type) {
+	case nil:
+		it.null = true
+	case *Ydb.Value_NullFlagValue:
+		it.null = true
+	case *Ydb.Value_NestedValue:
+		it.fromValue(vv.NestedValue)
+	case *Ydb.Value_BoolValue:
+		if vv.BoolValue {
+			it.varint = 1
+		}
+	case *Ydb.Value_Int32Value:
+		it.varint = uint64(int64(vv.Int32Value))
+	case *Ydb.Value_Uint32Value:
+		it.varint = uint64(vv.Uint32Value)
+	case *Ydb.Value_Int64Value:
+		it.varint = uint64(vv.Int64Value)
+	case *Ydb.Value_Uint64Value:
+		it.varint = vv.Uint64Value
+	case *Ydb.Value_FloatValue:
+		it.bits = uint64(math.Float32bits(vv.FloatValue))
+	case *Ydb.Value_DoubleValue:
+		it.bits = math.Float64bits(vv.DoubleValue)
+	case *Ydb.Value_BytesValue:
+		it.bytes = vv.BytesValue
+	case *Ydb.Value_TextValue:
+		it.text = vv.TextValue
+	case *Ydb.Value_Low_128:
+		it.low = vv.Low_128
+	}
+}
+
+// decode fills item by encoded value of optional or primitive type.
+// Bytes of item refer to b, unknown and complex fields are skipped
+func (it *item) decode(b []byte) error {
+	*it = item{null: true}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return xerrors.WithStackTrace(protowire.ParseError(n))
+		}
+		b = b[n:]
+		switch typ {
+		case protowire.VarintType, protowire.Fixed32Type, protowire.Fixed64Type:
+			v, n := consumeScalar(typ, b)
+			if n < 0 {
+				return xerrors.WithStackTrace(protowire.ParseError(n))
+			}
+			b = b[n:]
+			switch num {
+			case boolFieldNum, uint32FieldNum, int64FieldNum, uint64FieldNum:
+				it.null = false
+				it.varint = v
+			case int32FieldNum:
+				it.null = false
+				it.varint = uint64(int64(int32(v)))
+			case floatFieldNum, doubleFieldNum:
+				it.null = false
+				it.bits = v
+			case lowFieldNum:
+				it.null = false
+				it.low = v
+			case highFieldNum:
+				it.high = v
+			}
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return xerrors.WithStackTrace(protowire.ParseError(n))
+			}
+			b = b[n:]
+			switch num {
+			case bytesFieldNum, textFieldNum:
+				it.null = false
+				it.bytes = v
+			case nestedFieldNum:
+				// value of nested optional
+				if err := it.decode(v); err != nil {
+					return xerrors.WithStackTrace(err)
+				}
+
+				return nil
+			}
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return xerrors.WithStackTrace(protowire.ParseError(n))
+			}
+			b = b[n:]
+		}
+	}
+
+	return nil
+}
+
+// consumeScalar parses value of scalar field with wire type typ
+func consumeScalar(typ protowire.Type, b []byte) (uint64, int) {
+	switch typ {
+	case protowire.VarintType:
+		return protowire.ConsumeVarint(b)
+	case protowire.Fixed32Type:
+		v, n := protowire.ConsumeFixed32(b)
+
+		return uint64(v), n
+	default:
+		return protowire.ConsumeFixed64(b)
+	}
+}
+
+// decodeItems calls f for every encoded item of encoded row
+func decodeItems(row []byte, f func(i int, item []byte) error) (count int, _ error) {
+	for len(row) > 0 {
+		num, typ, n := protowire.ConsumeTag(row)
+		if n < 0 {
+			return count, xerrors.WithStackTrace(protowire.ParseError(n))
+		}
+		l := protowire.ConsumeFieldValue(num, typ, row[n:])
+		if l < 0 {
+			return count, xerrors.WithStackTrace(protowire.ParseError(l))
+		}
+		if num == itemsFieldNum && typ == protowire.BytesType {
+			v, _ := protowire.ConsumeBytes(row[n:])
+			if err := f(count, v); err != nil {
+				return count, xerrors.WithStackTrace(err)
+			}
+			count++
+		}
+		row = row[n+l:]
+	}
+
+	return count, nil
+}
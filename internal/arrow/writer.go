@@ -0,0 +1,225 @@
+package arrow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Constants of Arrow IPC format (https://github.com/apache/arrow/blob/main/format/Message.fbs)
+const (
+	metadataVersionV5 = 4
+
+	headerSchema      = 1
+	headerRecordBatch = 3
+
+	endiannessLittle = 0
+
+	continuation = 0xFFFFFFFF
+
+	// alignment of messages and buffers of record batches
+	alignment = 8
+)
+
+// Writer writes result sets into Arrow IPC stream (https://arrow.apache.org/docs/format/Columnar.html#ipc-streaming-format).
+// Schema of stream is defined by columns of first written result set and every result set is a record batch
+type Writer struct {
+	w       io.Writer
+	schema  []*Ydb.Column
+	columns []*column
+	closed  bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes rows of result set as record batch. Schema of stream is written before first record batch
+func (w *Writer) Write(columns []*Ydb.Column, rowCount int, row func(i int) (*Ydb.Value, error)) error {
+	if err := w.begin(columns); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	var v item
+	for i := 0; i < rowCount; i++ {
+		r, err := row(i)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		items := r.GetItems()
+		if len(items) != len(w.columns) {
+			return xerrors.WithStackTrace(errItemsCount(i, len(items), len(w.columns)))
+		}
+		for j, c := range w.columns {
+			v.fromValue(items[j])
+			if err = c.append(&v); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+		}
+	}
+
+	return xerrors.WithStackTrace(w.writeRecordBatch(rowCount))
+}
+
+// WriteEncoded writes encoded rows (protobuf wire format of Ydb.Value) of result set as record batch.
+// Items of rows are converted to columns directly from wire format without decoding of Ydb.Value
+func (w *Writer) WriteEncoded(columns []*Ydb.Column, rows [][]byte) error {
+	if err := w.begin(columns); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	var v item
+	for i, r := range rows {
+		count, err := decodeItems(r, func(j int, b []byte) error {
+			if j >= len(w.columns) {
+				return nil
+			}
+			if err := v.decode(b); err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+
+			return xerrors.WithStackTrace(w.columns[j].append(&v))
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if count != len(w.columns) {
+			return xerrors.WithStackTrace(errItemsCount(i, count, len(w.columns)))
+		}
+	}
+
+	return xerrors.WithStackTrace(w.writeRecordBatch(len(rows)))
+}
+
+// begin writes schema of stream on first call and resets columns for next record batch
+func (w *Writer) begin(columns []*Ydb.Column) error {
+	if w.closed {
+		return xerrors.WithStackTrace(errClosedWriter)
+	}
+	if w.columns == nil {
+		if err := w.writeSchema(columns); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	} else if !sameColumns(w.schema, columns) {
+		return xerrors.WithStackTrace(errSchemaChanged)
+	}
+
+	for _, c := range w.columns {
+		c.reset()
+	}
+
+	return nil
+}
+
+func errItemsCount(row, count, want int) error {
+	return fmt.Errorf("row %d has %d items, want %d items", row, count, want)
+}
+
+// Close writes end of stream marker
+func (w *Writer) Close() error {
+	if w.closed {
+		return xerrors.WithStackTrace(errClosedWriter)
+	}
+	w.closed = true
+
+	var eos [8]byte
+	binary.LittleEndian.PutUint32(eos[0:4], continuation)
+	if _, err := w.w.Write(eos[:]); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (w *Writer) writeSchema(columns []*Ydb.Column) error {
+	w.columns = make([]*column, 0, len(columns))
+	fields := make(fbTables, 0, len(columns))
+	for _, c := range columns {
+		col, err := newColumn(c)
+		if err != nil {
+			w.columns = nil
+
+			return xerrors.WithStackTrace(err)
+		}
+		w.columns = append(w.columns, col)
+		fields = append(fields, col.field())
+	}
+	w.schema = columns
+
+	return w.writeMessage(headerSchema, fbTable{
+		fbInt16(0, endiannessLittle),
+		fbChild(1, fields),
+	}, nil)
+}
+
+func (w *Writer) writeRecordBatch(rowCount int) error {
+	var (
+		nodes   fbStructs
+		buffers fbStructs
+		body    []byte
+	)
+	for _, c := range w.columns {
+		nodes = nodes.appendStruct(int64(c.length), int64(c.nullCount))
+		for _, buf := range c.buffers() {
+			buffers = buffers.appendStruct(int64(len(body)), int64(len(buf)))
+			body = append(body, buf...)
+			body = pad(body)
+		}
+	}
+
+	return w.writeMessage(headerRecordBatch, fbTable{
+		fbInt64(0, int64(rowCount)),
+		fbChild(1, nodes),
+		fbChild(2, buffers), //nolint:gomnd
+	}, body)
+}
+
+// writeMessage writes encapsulated message with metadata of header and body
+func (w *Writer) writeMessage(headerType uint8, header fbTable, body []byte) error {
+	metadata := pad(fbFinish(fbTable{
+		fbInt16(0, metadataVersionV5),
+		fbUint8(1, headerType),
+		fbChild(2, header),           //nolint:gomnd
+		fbInt64(3, int64(len(body))), //nolint:gomnd
+	}))
+
+	prefix := make([]byte, 8, 8+len(metadata)) //nolint:gomnd
+	binary.LittleEndian.PutUint32(prefix[0:4], continuation)
+	binary.LittleEndian.PutUint32(prefix[4:8], uint32(len(metadata)))
+	if _, err := w.w.Write(append(prefix, metadata...)); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	if len(body) > 0 {
+		if _, err := w.w.Write(body); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+func pad(b []byte) []byte {
+	for len(b)%alignment != 0 {
+		b = append(b, 0)
+	}
+
+	return b
+}
+
+func sameColumns(lhs, rhs []*Ydb.Column) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if !proto.Equal(lhs[i], rhs[i]) {
+			return false
+		}
+	}
+
+	return true
+}
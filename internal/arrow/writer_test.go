@@ -0,0 +1,439 @@
+package arrow
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// fbReader reads flatbuffers written by fbBuilder according to flatbuffers specification
+type fbReader []byte
+
+func (b fbReader) u16(pos int) int { return int(binary.LittleEndian.Uint16(b[pos:])) }
+func (b fbReader) u32(pos int) int { return int(binary.LittleEndian.Uint32(b[pos:])) }
+func (b fbReader) i64(pos int) int64 {
+	return int64(binary.LittleEndian.Uint64(b[pos:]))
+}
+
+// field returns position of field of table at pos or 0 if field is absent
+func (b fbReader) field(table, slot int) int {
+	vtable := table - int(int32(binary.LittleEndian.Uint32(b[table:])))
+	if 4+2*slot >= b.u16(vtable) {
+		return 0
+	}
+	if off := b.u16(vtable + 4 + 2*slot); off != 0 {
+		return table + off
+	}
+
+	return 0
+}
+
+func (b fbReader) child(table, slot int) int {
+	pos := b.field(table, slot)
+	if pos == 0 {
+		return 0
+	}
+
+	return pos + b.u32(pos)
+}
+
+func (b fbReader) string(table, slot int) string {
+	pos := b.child(table, slot)
+
+	return string(b[pos+4 : pos+4+b.u32(pos)])
+}
+
+// tables returns positions of tables of vector
+func (b fbReader) tables(table, slot int) (tables []int) {
+	pos := b.child(table, slot)
+	for i := 0; i < b.u32(pos); i++ {
+		elem := pos + 4 + 4*i
+		tables = append(tables, elem+b.u32(elem))
+	}
+
+	return tables
+}
+
+// structs returns structs of two longs of vector
+func (b fbReader) structs(t *testing.T, table, slot int) (structs [][2]int64) {
+	pos := b.child(table, slot)
+	require.Zero(t, (pos+4)%8, "vector of structs must be aligned")
+	for i := 0; i < b.u32(pos); i++ {
+		elem := pos + 4 + 16*i
+		structs = append(structs, [2]int64{b.i64(elem), b.i64(elem + 8)})
+	}
+
+	return structs
+}
+
+type testField struct {
+	name      string
+	nullable  bool
+	typeID    int
+	typeTable int
+}
+
+type testBatch struct {
+	length  int64
+	nodes   [][2]int64
+	buffers [][]byte
+}
+
+type testStream struct {
+	meta    fbReader
+	fields  []testField
+	batches []testBatch
+}
+
+// readStream reads Arrow IPC stream and checks framing of messages
+func readStream(t *testing.T, data []byte) (stream testStream) {
+	t.Helper()
+
+	for {
+		require.GreaterOrEqual(t, len(data), 8)
+		require.EqualValues(t, uint32(continuation), binary.LittleEndian.Uint32(data[0:4]))
+		size := int(binary.LittleEndian.Uint32(data[4:8]))
+		if size == 0 {
+			require.Len(t, data, 8, "end of stream must be last")
+
+			return stream
+		}
+		require.Zero(t, (8+size)%8, "message must be aligned")
+		meta := fbReader(data[8 : 8+size])
+		message := meta.u32(0)
+		require.EqualValues(t, metadataVersionV5, meta.u16(meta.field(message, 0)))
+		headerType := int(meta[meta.field(message, 1)])
+		header := meta.child(message, 2)
+		bodyLength := int(meta.i64(meta.field(message, 3)))
+		require.Zero(t, bodyLength%8)
+		body := data[8+size : 8+size+bodyLength]
+		data = data[8+size+bodyLength:]
+
+		switch headerType {
+		case headerSchema:
+			require.Nil(t, stream.fields, "schema must be first and single")
+			require.Zero(t, bodyLength)
+			stream.meta = meta
+			for _, f := range meta.tables(header, 1) {
+				require.NotZero(t, meta.child(f, 5), "children of field are required")
+				stream.fields = append(stream.fields, testField{
+					name:      meta.string(f, 0),
+					nullable:  meta[meta.field(f, 1)] == 1,
+					typeID:    int(meta[meta.field(f, 2)]),
+					typeTable: meta.child(f, 3),
+				})
+			}
+		case headerRecordBatch:
+			require.NotNil(t, stream.fields, "schema must be before record batches")
+			batch := testBatch{
+				length: meta.i64(meta.field(header, 0)),
+				nodes:  meta.structs(t, header, 1),
+			}
+			for _, buf := range meta.structs(t, header, 2) {
+				require.Zero(t, buf[0]%8, "buffer must be aligned")
+				batch.buffers = append(batch.buffers, body[buf[0]:buf[0]+buf[1]])
+			}
+			stream.batches = append(stream.batches, batch)
+		default:
+			t.Fatalf("unexpected header type %d", headerType)
+		}
+	}
+}
+
+func typeOf(id Ydb.Type_PrimitiveTypeId) *Ydb.Type {
+	return &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: id}}
+}
+
+func optionalOf(t *Ydb.Type) *Ydb.Type {
+	return &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: t}}}
+}
+
+var nullValue = &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}
+
+func TestWriter(t *testing.T) {
+	uuid := [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	uuidHigh, uuidLow := value.UUIDToHiLoPair(uuid)
+	decimalLow := int64(-1500000000)
+	columns := []*Ydb.Column{
+		{Name: "bool", Type: typeOf(Ydb.Type_BOOL)},
+		{Name: "int8", Type: typeOf(Ydb.Type_INT8)},
+		{Name: "uint16", Type: typeOf(Ydb.Type_UINT16)},
+		{Name: "int64", Type: typeOf(Ydb.Type_INT64)},
+		{Name: "double", Type: typeOf(Ydb.Type_DOUBLE)},
+		{Name: "date", Type: typeOf(Ydb.Type_DATE)},
+		{Name: "datetime", Type: typeOf(Ydb.Type_DATETIME)},
+		{Name: "utf8", Type: typeOf(Ydb.Type_UTF8)},
+		{Name: "uuid", Type: typeOf(Ydb.Type_UUID)},
+		{Name: "decimal", Type: &Ydb.Type{Type: &Ydb.Type_DecimalType{DecimalType: &Ydb.DecimalType{
+			Precision: 22,
+			Scale:     9,
+		}}}},
+		{Name: "optional", Type: optionalOf(typeOf(Ydb.Type_INT32))},
+		{Name: "nested", Type: optionalOf(optionalOf(typeOf(Ydb.Type_STRING)))},
+	}
+	rows := []*Ydb.Value{
+		{Items: []*Ydb.Value{
+			{Value: &Ydb.Value_BoolValue{BoolValue: true}},
+			{Value: &Ydb.Value_Int32Value{Int32Value: -1}},
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: 65535}},
+			{Value: &Ydb.Value_Int64Value{Int64Value: -42}},
+			{Value: &Ydb.Value_DoubleValue{DoubleValue: 3.14}},
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: 19000}},
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: 1700000000}},
+			{Value: &Ydb.Value_TextValue{TextValue: "привет"}},
+			{Value: &Ydb.Value_Low_128{Low_128: uuidLow}, High_128: uuidHigh},
+			// -1.5 with scale 9
+			{Value: &Ydb.Value_Low_128{Low_128: uint64(decimalLow)}, High_128: math.MaxUint64},
+			{Value: &Ydb.Value_Int32Value{Int32Value: 7}},
+			{Value: &Ydb.Value_NestedValue{NestedValue: nullValue}},
+		}},
+		{Items: []*Ydb.Value{
+			{Value: &Ydb.Value_BoolValue{BoolValue: false}},
+			{Value: &Ydb.Value_Int32Value{Int32Value: 127}},
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: 0}},
+			{Value: &Ydb.Value_Int64Value{Int64Value: math.MaxInt64}},
+			{Value: &Ydb.Value_DoubleValue{DoubleValue: -0.5}},
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: 0}},
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: 0}},
+			{Value: &Ydb.Value_TextValue{TextValue: ""}},
+			{Value: &Ydb.Value_Low_128{Low_128: 0}, High_128: 0},
+			{Value: &Ydb.Value_Low_128{Low_128: 1}, High_128: 0},
+			nullValue,
+			{Value: &Ydb.Value_BytesValue{BytesValue: []byte("bytes")}},
+		}},
+		{Items: []*Ydb.Value{
+			{Value: &Ydb.Value_BoolValue{BoolValue: true}},
+			{Value: &Ydb.Value_Int32Value{Int32Value: -128}},
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: 1}},
+			{Value: &Ydb.Value_Int64Value{Int64Value: 0}},
+			{Value: &Ydb.Value_DoubleValue{DoubleValue: math.Inf(1)}},
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: 1}},
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: 1}},
+			{Value: &Ydb.Value_TextValue{TextValue: "text"}},
+			{Value: &Ydb.Value_Low_128{Low_128: 0}, High_128: 0},
+			{Value: &Ydb.Value_Low_128{Low_128: 0}, High_128: 0},
+			{Value: &Ydb.Value_Int32Value{Int32Value: -7}},
+			nullValue,
+		}},
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	row := func(i int) (*Ydb.Value, error) {
+		return rows[i], nil
+	}
+	require.NoError(t, w.Write(columns, len(rows), row))
+	require.NoError(t, w.Write(columns, 1, row))
+	require.NoError(t, w.Close())
+	require.ErrorIs(t, w.Write(columns, 1, row), errClosedWriter)
+
+	stream := readStream(t, buf.Bytes())
+	meta := stream.meta
+
+	t.Run("Schema", func(t *testing.T) {
+		require.Len(t, stream.fields, len(columns))
+		for i, f := range stream.fields {
+			require.Equal(t, columns[i].GetName(), f.name)
+			require.Equal(t, columns[i].GetType().GetOptionalType() != nil, f.nullable, f.name)
+		}
+		typeIDs := []int{
+			typeBool, typeInt, typeInt, typeInt, typeFloatingPoint, typeDate, typeTimestamp,
+			typeUtf8, typeFixedSizeBinary, typeDecimal, typeInt, typeBinary,
+		}
+		for i, f := range stream.fields {
+			require.Equal(t, typeIDs[i], f.typeID, f.name)
+		}
+		// Int(8, signed), Int(16, unsigned)
+		require.EqualValues(t, 8, meta.u32(meta.field(stream.fields[1].typeTable, 0)))
+		require.EqualValues(t, 1, meta[meta.field(stream.fields[1].typeTable, 1)])
+		require.EqualValues(t, 16, meta.u32(meta.field(stream.fields[2].typeTable, 0)))
+		require.EqualValues(t, 0, meta[meta.field(stream.fields[2].typeTable, 1)])
+		// FloatingPoint(DOUBLE)
+		require.EqualValues(t, precisionDouble, meta.u16(meta.field(stream.fields[4].typeTable, 0)))
+		// Date(DAY) is written explicitly because default unit of Date is MILLISECOND
+		require.NotZero(t, meta.field(stream.fields[5].typeTable, 0))
+		require.EqualValues(t, dateUnitDay, meta.u16(meta.field(stream.fields[5].typeTable, 0)))
+		// Timestamp(SECOND, UTC)
+		require.EqualValues(t, timeUnitSecond, meta.u16(meta.field(stream.fields[6].typeTable, 0)))
+		require.Equal(t, "UTC", meta.string(stream.fields[6].typeTable, 1))
+		// FixedSizeBinary(16)
+		require.EqualValues(t, 16, meta.u32(meta.field(stream.fields[8].typeTable, 0)))
+		// Decimal(22, 9, 128)
+		require.EqualValues(t, 22, meta.u32(meta.field(stream.fields[9].typeTable, 0)))
+		require.EqualValues(t, 9, meta.u32(meta.field(stream.fields[9].typeTable, 1)))
+		require.EqualValues(t, 128, meta.u32(meta.field(stream.fields[9].typeTable, 2)))
+	})
+	t.Run("RecordBatches", func(t *testing.T) {
+		require.Len(t, stream.batches, 2)
+		batch := stream.batches[0]
+		require.EqualValues(t, 3, batch.length)
+		require.Len(t, batch.nodes, len(columns))
+		// validity, data and offsets of utf8 and nested binary columns
+		require.Len(t, batch.buffers, 2*len(columns)+2)
+
+		buffers := batch.buffers
+		next := func(n int) [][]byte {
+			b := buffers[:n]
+			buffers = buffers[n:]
+
+			return b
+		}
+
+		// bool
+		b := next(2)
+		require.Equal(t, [2]int64{3, 0}, batch.nodes[0])
+		require.Empty(t, b[0])
+		require.Equal(t, []byte{0b101}, b[1])
+		// int8
+		b = next(2)
+		require.Equal(t, []byte{0xff, 0x7f, 0x80}, b[1])
+		// uint16
+		b = next(2)
+		require.Equal(t, []byte{0xff, 0xff, 0, 0, 1, 0}, b[1])
+		// int64
+		b = next(2)
+		require.EqualValues(t, -42, int64(binary.LittleEndian.Uint64(b[1][0:])))
+		require.EqualValues(t, int64(math.MaxInt64), int64(binary.LittleEndian.Uint64(b[1][8:])))
+		// double
+		b = next(2)
+		require.Equal(t, 3.14, math.Float64frombits(binary.LittleEndian.Uint64(b[1][0:])))
+		require.True(t, math.IsInf(math.Float64frombits(binary.LittleEndian.Uint64(b[1][16:])), 1))
+		// date
+		b = next(2)
+		require.EqualValues(t, 19000, binary.LittleEndian.Uint32(b[1][0:]))
+		// datetime
+		b = next(2)
+		require.Len(t, b[1], 24)
+		require.EqualValues(t, 1700000000, binary.LittleEndian.Uint64(b[1][0:]))
+		// utf8
+		b = next(3)
+		require.Equal(t, []byte{0, 0, 0, 0, 12, 0, 0, 0, 12, 0, 0, 0, 16, 0, 0, 0}, b[1])
+		require.Equal(t, "приветtext", string(b[2]))
+		// uuid
+		b = next(2)
+		require.Equal(t, uuid[:], b[1][0:16])
+		// decimal
+		b = next(2)
+		require.EqualValues(t, -1500000000, int64(binary.LittleEndian.Uint64(b[1][0:])))
+		require.EqualValues(t, uint64(math.MaxUint64), binary.LittleEndian.Uint64(b[1][8:]))
+		require.EqualValues(t, 1, binary.LittleEndian.Uint64(b[1][16:]))
+		// optional
+		b = next(2)
+		require.Equal(t, [2]int64{3, 1}, batch.nodes[10])
+		require.Equal(t, []byte{0b101}, b[0])
+		require.EqualValues(t, 7, int32(binary.LittleEndian.Uint32(b[1][0:])))
+		require.EqualValues(t, -7, int32(binary.LittleEndian.Uint32(b[1][8:])))
+		// nested optional
+		b = next(3)
+		require.Equal(t, [2]int64{3, 2}, batch.nodes[11])
+		require.Equal(t, []byte{0b010}, b[0])
+		require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 5, 0, 0, 0}, b[1])
+		require.Equal(t, "bytes", string(b[2]))
+		require.Empty(t, buffers)
+
+		require.EqualValues(t, 1, stream.batches[1].length)
+	})
+	t.Run("Golden", func(t *testing.T) {
+		// golden stream pins byte layout of messages, so any change of layout must be checked by reading
+		// of regenerated stream (go test -run TestWriter/Golden -update) with Arrow implementation, for
+		// example with pyarrow.ipc.open_stream
+		const golden = "testdata/writer.arrows"
+		if *update {
+			require.NoError(t, os.WriteFile(golden, buf.Bytes(), 0o600))
+		}
+		expected, err := os.ReadFile(golden)
+		require.NoError(t, err)
+		require.Equal(t, expected, buf.Bytes())
+	})
+	t.Run("Encoded", func(t *testing.T) {
+		encoded := make([][]byte, 0, len(rows))
+		for _, r := range rows {
+			b, err := proto.Marshal(r)
+			require.NoError(t, err)
+			encoded = append(encoded, b)
+		}
+		var encodedBuf bytes.Buffer
+		w := NewWriter(&encodedBuf)
+		require.NoError(t, w.WriteEncoded(columns, encoded))
+		require.NoError(t, w.WriteEncoded(columns, encoded[:1]))
+		require.NoError(t, w.Close())
+		// rows in wire format are converted into the same stream as decoded rows
+		require.Equal(t, buf.Bytes(), encodedBuf.Bytes())
+	})
+}
+
+func TestWriterErrors(t *testing.T) {
+	row := func(v *Ydb.Value) func(i int) (*Ydb.Value, error) {
+		return func(i int) (*Ydb.Value, error) {
+			return &Ydb.Value{Items: []*Ydb.Value{v}}, nil
+		}
+	}
+	t.Run("UnsupportedType", func(t *testing.T) {
+		w := NewWriter(&bytes.Buffer{})
+		err := w.Write([]*Ydb.Column{{
+			Name: "list",
+			Type: &Ydb.Type{Type: &Ydb.Type_ListType{ListType: &Ydb.ListType{Item: typeOf(Ydb.Type_INT32)}}},
+		}}, 0, nil)
+		require.ErrorIs(t, err, ErrUnsupportedType)
+		require.ErrorContains(t, err, "List<Int32>")
+	})
+	t.Run("NullInRequiredColumn", func(t *testing.T) {
+		w := NewWriter(&bytes.Buffer{})
+		err := w.Write([]*Ydb.Column{{Name: "id", Type: typeOf(Ydb.Type_INT32)}}, 1, row(nullValue))
+		require.ErrorIs(t, err, errNullValue)
+	})
+	t.Run("SchemaChanged", func(t *testing.T) {
+		w := NewWriter(&bytes.Buffer{})
+		v := &Ydb.Value{Value: &Ydb.Value_Int32Value{Int32Value: 1}}
+		require.NoError(t, w.Write([]*Ydb.Column{{Name: "id", Type: typeOf(Ydb.Type_INT32)}}, 1, row(v)))
+		err := w.Write([]*Ydb.Column{{Name: "id", Type: typeOf(Ydb.Type_INT64)}}, 1, row(v))
+		require.ErrorIs(t, err, errSchemaChanged)
+	})
+	t.Run("ItemsCount", func(t *testing.T) {
+		w := NewWriter(&bytes.Buffer{})
+		b, err := proto.Marshal(&Ydb.Value{Items: []*Ydb.Value{
+			{Value: &Ydb.Value_Int32Value{Int32Value: 1}},
+			{Value: &Ydb.Value_Int32Value{Int32Value: 2}},
+		}})
+		require.NoError(t, err)
+		err = w.WriteEncoded([]*Ydb.Column{{Name: "id", Type: typeOf(Ydb.Type_INT32)}}, [][]byte{b})
+		require.ErrorContains(t, err, "row 0 has 2 items, want 1 items")
+	})
+}
+
+func TestFlatbuffers(t *testing.T) {
+	data := fbFinish(fbTable{
+		fbInt16(0, -2),
+		fbChild(1, fbString("name")),
+		fbInt64(2, math.MaxInt64),
+		fbBool(4, true),
+		fbChild(5, fbTables{fbTable{fbInt32(0, 42)}, fbTable{}}),
+		fbChild(6, fbStructs(nil).appendStruct(1, 2).appendStruct(3, 4)),
+	})
+	b := fbReader(data)
+	root := b.u32(0)
+	require.Zero(t, root%8)
+	require.EqualValues(t, -2, int16(b.u16(b.field(root, 0))))
+	require.Equal(t, "name", b.string(root, 1))
+	require.Zero(t, b.field(root, 2)%8)
+	require.EqualValues(t, math.MaxInt64, b.i64(b.field(root, 2)))
+	require.Zero(t, b.field(root, 3), "absent field")
+	require.EqualValues(t, 1, b[b.field(root, 4)])
+	tables := b.tables(root, 5)
+	require.Len(t, tables, 2)
+	require.EqualValues(t, 42, b.u32(b.field(tables[0], 0)))
+	require.Zero(t, b.field(tables[1], 0))
+	require.Equal(t, [][2]int64{{1, 2}, {3, 4}}, b.structs(t, root, 6))
+	require.Zero(t, b.field(root, 100), "slot out of vtable")
+}
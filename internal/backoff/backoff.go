@@ -52,37 +52,49 @@ type logBackoff struct {
 	// duration D; and R is a random sized part from [0,(D - F)].
 	jitterLimit float64
 
+	// maxDelay is a maximum of Backoff Delay applied after jitter.
+	// If maxDelay is less or equal to zero, then Delay is not limited.
+	maxDelay time.Duration
+
 	// generator of jitter
 	r xrand.Rand
 }
 
-type option func(b *logBackoff)
+// Option is an option of New
+type Option func(b *logBackoff)
 
-func WithSlotDuration(slotDuration time.Duration) option {
+func WithSlotDuration(slotDuration time.Duration) Option {
 	return func(b *logBackoff) {
 		b.slotDuration = slotDuration
 	}
 }
 
-func WithCeiling(ceiling uint) option {
+func WithCeiling(ceiling uint) Option {
 	return func(b *logBackoff) {
 		b.ceiling = ceiling
 	}
 }
 
-func WithJitterLimit(jitterLimit float64) option {
+func WithJitterLimit(jitterLimit float64) Option {
 	return func(b *logBackoff) {
 		b.jitterLimit = jitterLimit
 	}
 }
 
-func WithSeed(seed int64) option {
+// WithMaxDelay limits Delay regardless of ceiling, limit is applied after jitter
+func WithMaxDelay(maxDelay time.Duration) Option {
+	return func(b *logBackoff) {
+		b.maxDelay = maxDelay
+	}
+}
+
+func WithSeed(seed int64) Option {
 	return func(b *logBackoff) {
 		b.r = xrand.New(xrand.WithLock(), xrand.WithSeed(seed))
 	}
 }
 
-func New(opts ...option) logBackoff {
+func New(opts ...Option) logBackoff {
 	b := logBackoff{
 		r: xrand.New(xrand.WithLock()),
 	}
@@ -104,11 +116,14 @@ func (b logBackoff) Delay(i int) time.Duration {
 	n := 1 << min(uint(i), max(1, b.ceiling))
 	d := s * time.Duration(n)
 	f := time.Duration(math.Min(1, math.Abs(b.jitterLimit)) * float64(d))
-	if f == d {
-		return f
+	if f != d {
+		d = f + time.Duration(b.r.Int64(int64(d-f)+1))
+	}
+	if b.maxDelay > 0 && d > b.maxDelay {
+		return b.maxDelay
 	}
 
-	return f + time.Duration(b.r.Int64(int64(d-f)+1))
+	return d
 }
 
 func min(a, b uint) uint {
@@ -224,3 +224,25 @@ func TestFastSlowDelaysWithoutJitter(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxDelay(t *testing.T) {
+	for _, jitterLimit := range []float64{0, 0.5, 1} {
+		t.Run(strconv.FormatFloat(jitterLimit, 'f', -1, 64), func(t *testing.T) {
+			b := New(
+				WithSlotDuration(time.Second),
+				WithCeiling(6),
+				WithJitterLimit(jitterLimit),
+				WithMaxDelay(5*time.Second),
+				WithSeed(0),
+			)
+			for i := 0; i < 64; i++ {
+				require.LessOrEqual(t, b.Delay(i), 5*time.Second, i)
+			}
+			if jitterLimit == 1 {
+				require.Equal(t, 4*time.Second, b.Delay(2))
+				require.Equal(t, 5*time.Second, b.Delay(3))
+				require.Equal(t, 5*time.Second, b.Delay(10))
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Export_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/backup"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backup/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+//nolint:gofumpt
+//nolint:nolintlint
+var errNilExportClient = xerrors.Wrap(errors.New("export client is not initialized"))
+
+var _ backup.ExportClient = (*ExportClient)(nil)
+
+type ExportClient struct {
+	config     *config.Config
+	service    Ydb_Export_V1.ExportServiceClient
+	operations operationGetter
+}
+
+func NewExportClient(
+	ctx context.Context, cc grpc.ClientConnInterface, operations operationGetter, config *config.Config,
+) *ExportClient {
+	return &ExportClient{
+		config:     config,
+		service:    Ydb_Export_V1.NewExportServiceClient(cc),
+		operations: operations,
+	}
+}
+
+func (c *ExportClient) Close(_ context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilExportClient)
+	}
+
+	return nil
+}
+
+func (c *ExportClient) ExportToS3(ctx context.Context, settings backup.ExportToS3Settings) (id string, _ error) {
+	if c == nil {
+		return "", xerrors.WithStackTrace(errNilExportClient)
+	}
+	if err := settings.Validate(); err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	call := func(ctx context.Context) (err error) {
+		id, err = c.exportToS3(ctx, settings)
+
+		return xerrors.WithStackTrace(err)
+	}
+	if !c.config.AutoRetry() {
+		return id, call(ctx)
+	}
+
+	err := retry.Retry(ctx, call,
+		retry.WithStackTrace(),
+		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+	)
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	return id, nil
+}
+
+func (c *ExportClient) exportToS3(ctx context.Context, settings backup.ExportToS3Settings) (string, error) {
+	request := &Ydb_Export.ExportToS3Request{
+		OperationParams: internalOperation.Params(ctx,
+			c.config.OperationTimeout(),
+			c.config.OperationCancelAfter(),
+			internalOperation.ModeAsync,
+		),
+		Settings: &Ydb_Export.ExportToS3Settings{
+			Endpoint:        settings.S3.Endpoint,
+			Scheme:          Ydb_Export.ExportToS3Settings_HTTPS,
+			Bucket:          settings.S3.Bucket,
+			Region:          settings.S3.Region,
+			Items:           make([]*Ydb_Export.ExportToS3Settings_Item, 0, len(settings.Items)),
+			Description:     settings.Description,
+			NumberOfRetries: settings.NumberOfRetries,
+			Compression:     string(settings.Compression),
+		},
+	}
+	if settings.S3.Scheme == backup.SchemeHTTP {
+		request.Settings.Scheme = Ydb_Export.ExportToS3Settings_HTTP
+	}
+	if settings.S3.Credentials != nil {
+		accessKey, secretKey, err := settings.S3.Credentials(ctx)
+		if err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+		request.Settings.AccessKey, request.Settings.SecretKey = accessKey, secretKey
+	}
+	for _, item := range settings.Items {
+		request.Settings.Items = append(request.Settings.Items, &Ydb_Export.ExportToS3Settings_Item{
+			SourcePath:        item.SourcePath,
+			DestinationPrefix: item.DestinationPrefix,
+		})
+	}
+
+	// connection wrapping treats not ready operation as error, but export operation is not ready after start
+	return startedOperationID(c.service.ExportToS3(conn.WithoutWrapping(ctx), request))
+}
+
+func (c *ExportClient) Wait(
+	ctx context.Context, operationID string, pollInterval time.Duration,
+	onProgress func(metadata *operation.ExportMetadata),
+) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilExportClient)
+	}
+
+	return wait(ctx, c.operations, operationID, pollInterval, func(metadata operation.Metadata) {
+		if md, ok := metadata.(*operation.ExportMetadata); ok && onProgress != nil {
+			onProgress(md)
+		}
+	})
+}
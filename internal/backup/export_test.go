@@ -0,0 +1,143 @@
+package backup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Export_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/backup"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backup/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+)
+
+type exportServiceStub struct {
+	Ydb_Export_V1.ExportServiceClient
+
+	exportToS3 func(r *Ydb_Export.ExportToS3Request) (*Ydb_Export.ExportToS3Response, error)
+}
+
+func (s *exportServiceStub) ExportToS3(
+	ctx context.Context, in *Ydb_Export.ExportToS3Request, opts ...grpc.CallOption,
+) (*Ydb_Export.ExportToS3Response, error) {
+	return s.exportToS3(in)
+}
+
+type operationsStub func(id string) (*operation.Operation, error)
+
+func (f operationsStub) Get(ctx context.Context, id string) (*operation.Operation, error) {
+	return f(id)
+}
+
+func TestExportClientExportToS3(t *testing.T) {
+	ctx := xtest.Context(t)
+	settings := backup.ExportToS3Settings{
+		S3: backup.S3{
+			Endpoint: "minio:9000",
+			Scheme:   backup.SchemeHTTP,
+			Bucket:   "backups",
+			Credentials: func(ctx context.Context) (accessKey, secretKey string, err error) {
+				return "access", "secret", nil
+			},
+		},
+		Items: []backup.ExportItem{
+			{SourcePath: "/local/table", DestinationPrefix: "backup/table"},
+		},
+		Compression: backup.CompressionZstd(3),
+	}
+	t.Run("Started", func(t *testing.T) {
+		c := &ExportClient{
+			config: config.New(),
+			service: &exportServiceStub{
+				exportToS3: func(r *Ydb_Export.ExportToS3Request) (*Ydb_Export.ExportToS3Response, error) {
+					require.Equal(t, Ydb_Operations.OperationParams_ASYNC, r.GetOperationParams().GetOperationMode())
+					require.Equal(t, "minio:9000", r.GetSettings().GetEndpoint())
+					require.Equal(t, Ydb_Export.ExportToS3Settings_HTTP, r.GetSettings().GetScheme())
+					require.Equal(t, "backups", r.GetSettings().GetBucket())
+					require.Equal(t, "access", r.GetSettings().GetAccessKey())
+					require.Equal(t, "secret", r.GetSettings().GetSecretKey())
+					require.Equal(t, "zstd-3", r.GetSettings().GetCompression())
+					require.Len(t, r.GetSettings().GetItems(), 1)
+					require.Equal(t, "/local/table", r.GetSettings().GetItems()[0].GetSourcePath())
+					require.Equal(t, "backup/table", r.GetSettings().GetItems()[0].GetDestinationPrefix())
+
+					return &Ydb_Export.ExportToS3Response{
+						Operation: &Ydb_Operations.Operation{
+							Id:    "export",
+							Ready: false,
+						},
+					}, nil
+				},
+			},
+		}
+		id, err := c.ExportToS3(ctx, settings)
+		require.NoError(t, err)
+		require.Equal(t, "export", id)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		c := &ExportClient{
+			config: config.New(),
+			service: &exportServiceStub{
+				exportToS3: func(r *Ydb_Export.ExportToS3Request) (*Ydb_Export.ExportToS3Response, error) {
+					return &Ydb_Export.ExportToS3Response{
+						Operation: &Ydb_Operations.Operation{
+							Ready:  true,
+							Status: Ydb.StatusIds_BAD_REQUEST,
+						},
+					}, nil
+				},
+			},
+		}
+		_, err := c.ExportToS3(ctx, settings)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+	})
+	t.Run("InvalidSettings", func(t *testing.T) {
+		c := &ExportClient{
+			config: config.New(),
+		}
+		_, err := c.ExportToS3(ctx, backup.ExportToS3Settings{S3: settings.S3})
+		require.ErrorIs(t, err, backup.ErrEmptyItems)
+	})
+}
+
+func TestExportClientWait(t *testing.T) {
+	ctx := xtest.Context(t)
+	polls := 0
+	c := &ExportClient{
+		config: config.New(),
+		operations: operationsStub(func(id string) (*operation.Operation, error) {
+			require.Equal(t, "export", id)
+			polls++
+			progress := operation.BackupProgressTransferData
+			if polls == 3 {
+				progress = operation.BackupProgressDone
+			}
+
+			return &operation.Operation{
+				ID:    id,
+				Ready: polls == 3,
+				Metadata: &operation.ExportMetadata{
+					Progress: progress,
+				},
+			}, nil
+		}),
+	}
+	var progress []operation.BackupProgress
+	err := c.Wait(ctx, "export", time.Millisecond, func(metadata *operation.ExportMetadata) {
+		progress = append(progress, metadata.Progress)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []operation.BackupProgress{
+		operation.BackupProgressTransferData,
+		operation.BackupProgressTransferData,
+		operation.BackupProgressDone,
+	}, progress)
+}
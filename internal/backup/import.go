@@ -0,0 +1,131 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Import_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Import"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/backup"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backup/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+//nolint:gofumpt
+//nolint:nolintlint
+var errNilImportClient = xerrors.Wrap(errors.New("import client is not initialized"))
+
+var _ backup.ImportClient = (*ImportClient)(nil)
+
+type ImportClient struct {
+	config     *config.Config
+	service    Ydb_Import_V1.ImportServiceClient
+	operations operationGetter
+}
+
+func NewImportClient(
+	ctx context.Context, cc grpc.ClientConnInterface, operations operationGetter, config *config.Config,
+) *ImportClient {
+	return &ImportClient{
+		config:     config,
+		service:    Ydb_Import_V1.NewImportServiceClient(cc),
+		operations: operations,
+	}
+}
+
+func (c *ImportClient) Close(_ context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilImportClient)
+	}
+
+	return nil
+}
+
+func (c *ImportClient) ImportFromS3(ctx context.Context, settings backup.ImportFromS3Settings) (id string, _ error) {
+	if c == nil {
+		return "", xerrors.WithStackTrace(errNilImportClient)
+	}
+	if err := settings.Validate(); err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	call := func(ctx context.Context) (err error) {
+		id, err = c.importFromS3(ctx, settings)
+
+		return xerrors.WithStackTrace(err)
+	}
+	if !c.config.AutoRetry() {
+		return id, call(ctx)
+	}
+
+	err := retry.Retry(ctx, call,
+		retry.WithStackTrace(),
+		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+	)
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	return id, nil
+}
+
+func (c *ImportClient) importFromS3(ctx context.Context, settings backup.ImportFromS3Settings) (string, error) {
+	request := &Ydb_Import.ImportFromS3Request{
+		OperationParams: internalOperation.Params(ctx,
+			c.config.OperationTimeout(),
+			c.config.OperationCancelAfter(),
+			internalOperation.ModeAsync,
+		),
+		Settings: &Ydb_Import.ImportFromS3Settings{
+			Endpoint:        settings.S3.Endpoint,
+			Scheme:          Ydb_Import.ImportFromS3Settings_HTTPS,
+			Bucket:          settings.S3.Bucket,
+			Region:          settings.S3.Region,
+			Items:           make([]*Ydb_Import.ImportFromS3Settings_Item, 0, len(settings.Items)),
+			Description:     settings.Description,
+			NumberOfRetries: settings.NumberOfRetries,
+		},
+	}
+	if settings.S3.Scheme == backup.SchemeHTTP {
+		request.Settings.Scheme = Ydb_Import.ImportFromS3Settings_HTTP
+	}
+	if settings.S3.Credentials != nil {
+		accessKey, secretKey, err := settings.S3.Credentials(ctx)
+		if err != nil {
+			return "", xerrors.WithStackTrace(err)
+		}
+		request.Settings.AccessKey, request.Settings.SecretKey = accessKey, secretKey
+	}
+	for _, item := range settings.Items {
+		request.Settings.Items = append(request.Settings.Items, &Ydb_Import.ImportFromS3Settings_Item{
+			SourcePrefix:    item.SourcePrefix,
+			DestinationPath: item.DestinationPath,
+		})
+	}
+
+	// connection wrapping treats not ready operation as error, but import operation is not ready after start
+	return startedOperationID(c.service.ImportFromS3(conn.WithoutWrapping(ctx), request))
+}
+
+func (c *ImportClient) Wait(
+	ctx context.Context, operationID string, pollInterval time.Duration,
+	onProgress func(metadata *operation.ImportMetadata),
+) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilImportClient)
+	}
+
+	return wait(ctx, c.operations, operationID, pollInterval, func(metadata operation.Metadata) {
+		if md, ok := metadata.(*operation.ImportMetadata); ok && onProgress != nil {
+			onProgress(md)
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+)
+
+type operationGetter interface {
+	Get(ctx context.Context, id string) (*operation.Operation, error)
+}
+
+// wait polls operation until it is ready and calls onMetadata with metadata of every poll
+func wait(
+	ctx context.Context, operations operationGetter, id string, pollInterval time.Duration,
+	onMetadata func(metadata operation.Metadata),
+) error {
+	for {
+		op, err := operations.Get(ctx, id)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if op.Metadata != nil {
+			onMetadata(op.Metadata)
+		}
+		if op.Ready {
+			return xerrors.WithStackTrace(op.Err)
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return xerrors.WithStackTrace(ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+type operationResponse interface {
+	GetOperation() *Ydb_Operations.Operation
+}
+
+// startedOperationID returns id of started long-running operation or error of start
+func startedOperationID(response operationResponse, err error) (string, error) {
+	if err != nil {
+		if xerrors.IsContextError(err) {
+			return "", xerrors.WithStackTrace(err)
+		}
+
+		return "", xerrors.WithStackTrace(xerrors.Transport(err))
+	}
+	op := response.GetOperation()
+	if op.GetReady() && op.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return "", xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(op)))
+	}
+
+	return op.GetId(), nil
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/jonboulle/clockwork"
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/closer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
@@ -24,7 +26,12 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
-var ErrNoEndpoints = xerrors.Wrap(fmt.Errorf("no endpoints"))
+var (
+	ErrNoEndpoints = xerrors.Wrap(fmt.Errorf("no endpoints"))
+
+	// ErrMaxInFlight returns if all endpoints reached in-flight limit and balancer configured for fail fast
+	ErrMaxInFlight = xerrors.Wrap(fmt.Errorf("all endpoints reached limit of in-flight calls"))
+)
 
 type discoveryClient interface {
 	closer.Closer
@@ -39,6 +46,7 @@ type Balancer struct {
 	discoveryClient   discoveryClient
 	discoveryRepeater repeater.Repeater
 	localDCDetector   func(ctx context.Context, endpoints []endpoint.Endpoint) (string, error)
+	clock             clockwork.Clock
 
 	mu               xsync.RWMutex
 	connectionsState *connectionsState
@@ -52,13 +60,75 @@ func (b *Balancer) HasNode(id uint32) bool {
 	}
 	b.mu.RLock()
 	defer b.mu.RUnlock()
-	if _, has := b.connectionsState.connByNodeID[id]; has {
+	if c, has := b.connectionsState.connByNodeID[id]; has {
+		// sessions of draining node must be recycled
+		return c.GetState() != conn.Draining
+	}
+
+	return false
+}
+
+// Ready reports whether balancer have at least one connection, which allowed for requests.
+// Ready does not make any I/O
+func (b *Balancer) Ready() bool {
+	state := b.connections()
+	if state == nil {
+		return false
+	}
+	for _, c := range state.all {
+		if isOkConnection(c, false) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasService reports whether at least one of discovered endpoints advertises service with given name.
+// HasService returns true if endpoints are not discovered yet or discovered endpoints have no info about services.
+// HasService does not make any I/O
+func (b *Balancer) HasService(service string) bool {
+	state := b.connections()
+	if state == nil || len(state.all) == 0 {
 		return true
 	}
+	for _, c := range state.all {
+		if c.Endpoint().HasService(service) {
+			return true
+		}
+	}
 
 	return false
 }
 
+// EndpointSnapshot contains state and cumulative traffic of connection to endpoint
+type EndpointSnapshot struct {
+	Endpoint endpoint.Endpoint
+	State    conn.State
+	InFlight int
+	Traffic  conn.Traffic
+}
+
+// Snapshot returns snapshots of current connections of balancer.
+// Snapshot does not make any I/O
+func (b *Balancer) Snapshot() []EndpointSnapshot {
+	state := b.connections()
+	if state == nil {
+		return nil
+	}
+	snapshots := make([]EndpointSnapshot, 0, len(state.all))
+	for _, c := range state.all {
+		snapshots = append(snapshots, EndpointSnapshot{
+			Endpoint: c.Endpoint().Copy(),
+			State:    c.GetState(),
+			InFlight: c.InFlight(),
+			Traffic:  c.Traffic(),
+		})
+	}
+
+	return snapshots
+}
+
 func (b *Balancer) OnUpdate(onApplyDiscoveredEndpoints func(ctx context.Context, endpoints []endpoint.Info)) {
 	b.mu.WithLock(func() {
 		b.onApplyDiscoveredEndpoints = append(b.onApplyDiscoveredEndpoints, onApplyDiscoveredEndpoints)
@@ -111,7 +181,7 @@ func (b *Balancer) clusterDiscoveryAttempt(ctx context.Context) (err error) {
 	}()
 
 	if dialTimeout := b.driverConfig.DialTimeout(); dialTimeout > 0 {
-		ctx, cancel = xcontext.WithTimeout(ctx, dialTimeout)
+		ctx, cancel = xcontext.WithNamedTimeout(ctx, xerrors.DeadlineSourceDialTimeout, dialTimeout)
 	} else {
 		ctx, cancel = xcontext.WithCancel(ctx)
 	}
@@ -129,7 +199,12 @@ func (b *Balancer) clusterDiscoveryAttempt(ctx context.Context) (err error) {
 		}
 	}
 
-	b.applyDiscoveredEndpoints(ctx, endpoints, localDC)
+	info := balancerConfig.Info{SelfLocation: localDC}
+	if b.config.DetectLocations != nil {
+		info.Locations = b.config.DetectLocations(ctx)
+	}
+
+	b.applyDiscoveredEndpoints(ctx, endpoints, info)
 
 	return nil
 }
@@ -171,7 +246,11 @@ func endpointsDiff(newestEndpoints []endpoint.Endpoint, previousConns []conn.Con
 	return nodes, added, dropped
 }
 
-func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, endpoints []endpoint.Endpoint, localDC string) {
+func (b *Balancer) applyDiscoveredEndpoints(
+	ctx context.Context,
+	endpoints []endpoint.Endpoint,
+	info balancerConfig.Info,
+) {
 	var (
 		onDone = trace.DriverOnBalancerUpdate(
 			b.driverConfig.Trace(), &ctx,
@@ -183,7 +262,7 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, endpoints []end
 	)
 	defer func() {
 		nodes, added, dropped := endpointsDiff(endpoints, previousConns)
-		onDone(nodes, added, dropped, localDC)
+		onDone(nodes, added, dropped, info.SelfLocation)
 	}()
 
 	connections := endpointsToConnections(b.pool, endpoints)
@@ -192,8 +271,8 @@ func (b *Balancer) applyDiscoveredEndpoints(ctx context.Context, endpoints []end
 		c.Endpoint().Touch()
 	}
 
-	info := balancerConfig.Info{SelfLocation: localDC}
 	state := newConnectionsState(connections, b.config.Filter, info, b.config.AllowFallback)
+	state.maxInFlight = b.driverConfig.MaxInFlightPerEndpoint()
 
 	endpointsInfo := make([]endpoint.Info, len(endpoints))
 	for i, e := range endpoints {
@@ -259,6 +338,7 @@ func New(
 		driverConfig:    driverConfig,
 		pool:            pool,
 		localDCDetector: detectLocalDC,
+		clock:           clockwork.NewRealClock(),
 	}
 	d := internalDiscovery.New(ctx, pool.Get(
 		endpoint.New(driverConfig.Endpoint()),
@@ -275,12 +355,14 @@ func New(
 	if b.config.SingleConn {
 		b.applyDiscoveredEndpoints(ctx, []endpoint.Endpoint{
 			endpoint.New(driverConfig.Endpoint()),
-		}, "")
+		}, balancerConfig.Info{})
 	} else {
 		// initialization of balancer state
 		if err := b.clusterDiscovery(ctx); err != nil {
 			return nil, xerrors.WithStackTrace(err)
 		}
+		// errors of dials are not fatal: banned connections are used by balancer if all connections are banned
+		_ = b.dialFirst(ctx, b.connections().dialOrder(b.config.AllowFallback))
 		// run background discovering
 		if d := discoveryConfig.Interval(); d > 0 {
 			b.discoveryRepeater = repeater.New(xcontext.ValueOnly(ctx),
@@ -288,6 +370,9 @@ func New(
 				repeater.WithName("discovery"),
 				repeater.WithTrace(b.driverConfig.Trace()),
 			)
+			pool.OnDrain(func(ctx context.Context, cc conn.Conn) {
+				b.discoveryRepeater.Force()
+			})
 		}
 	}
 
@@ -346,6 +431,7 @@ func (b *Balancer) wrapCall(ctx context.Context, f func(ctx context.Context, cc
 	}
 
 	if err = f(ctx, cc); err != nil {
+		err = b.driverConfig.Meta().RecoverCredentials(ctx, err)
 		if conn.UseWrapping(ctx) {
 			if credentials.IsAccessError(err) {
 				err = credentials.AccessError("no access", err,
@@ -399,14 +485,45 @@ func (b *Balancer) getConn(ctx context.Context) (c conn.Conn, err error) {
 		}
 	}()
 
-	c, failedCount = state.GetConnection(ctx)
-	if c == nil {
-		return nil, xerrors.WithStackTrace(
-			fmt.Errorf("%w: cannot get connection from Balancer after %d attempts", ErrNoEndpoints, failedCount),
-		)
-	}
+	for {
+		waiter, unsubscribe := b.pool.InFlightDone()
+
+		c, failedCount = state.GetConnection(ctx)
+		if c != nil {
+			unsubscribe()
+
+			return c, nil
+		}
 
-	return c, nil
+		if !state.InFlightLimited() {
+			unsubscribe()
+
+			return nil, xerrors.WithStackTrace(
+				fmt.Errorf("%w: cannot get connection from Balancer after %d attempts", ErrNoEndpoints, failedCount),
+			)
+		}
+
+		if b.driverConfig.MaxInFlightFailFast() {
+			unsubscribe()
+
+			return nil, xerrors.WithStackTrace(xerrors.Retryable(
+				fmt.Errorf("%w (%d)", ErrMaxInFlight, state.maxInFlight),
+				xerrors.WithBackoff(backoff.TypeFast),
+				xerrors.WithName("MaxInFlight"),
+			))
+		}
+
+		select {
+		case <-ctx.Done():
+			unsubscribe()
+
+			return nil, xerrors.WithStackTrace(ctx.Err())
+		case <-waiter.Done():
+			unsubscribe()
+		}
+
+		state = b.connections()
+	}
 }
 
 func endpointsToConnections(p *conn.Pool, endpoints []endpoint.Endpoint) []conn.Conn {
@@ -1,14 +1,25 @@
 package balancer
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	balancerConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/mock"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -123,3 +134,306 @@ func TestEndpointsDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestBalancerReady(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		state *connectionsState
+		ready bool
+	}{
+		{
+			name:  "NoState",
+			state: nil,
+			ready: false,
+		},
+		{
+			name:  "NoConnections",
+			state: newConnectionsState(nil, nil, balancerConfig.Info{}, false),
+			ready: false,
+		},
+		{
+			name: "BannedConnections",
+			state: newConnectionsState([]conn.Conn{
+				&mock.Conn{AddrField: "1", State: conn.Banned},
+				&mock.Conn{AddrField: "2", State: conn.Destroyed},
+			}, nil, balancerConfig.Info{}, false),
+			ready: false,
+		},
+		{
+			name: "OnlineConnection",
+			state: newConnectionsState([]conn.Conn{
+				&mock.Conn{AddrField: "1", State: conn.Banned},
+				&mock.Conn{AddrField: "2", State: conn.Online},
+			}, nil, balancerConfig.Info{}, false),
+			ready: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Balancer{connectionsState: tt.state}
+			require.Equal(t, tt.ready, b.Ready())
+		})
+	}
+}
+
+func TestBalancerHasService(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		state *connectionsState
+		has   bool
+	}{
+		{
+			name:  "NoState",
+			state: nil,
+			has:   true,
+		},
+		{
+			name: "UnknownServices",
+			state: newConnectionsState([]conn.Conn{
+				&mock.Conn{AddrField: "1", State: conn.Online},
+			}, nil, balancerConfig.Info{}, false),
+			has: true,
+		},
+		{
+			name: "Advertised",
+			state: newConnectionsState([]conn.Conn{
+				&mock.Conn{AddrField: "1", State: conn.Online, ServicesField: []string{"table_service"}},
+				&mock.Conn{AddrField: "2", State: conn.Online, ServicesField: []string{"table_service", "topic"}},
+			}, nil, balancerConfig.Info{}, false),
+			has: true,
+		},
+		{
+			name: "NotAdvertised",
+			state: newConnectionsState([]conn.Conn{
+				&mock.Conn{AddrField: "1", State: conn.Online, ServicesField: []string{"table_service"}},
+			}, nil, balancerConfig.Info{}, false),
+			has: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &Balancer{connectionsState: tt.state}
+			require.Equal(t, tt.has, b.HasService("topic"))
+		})
+	}
+}
+
+func TestBalancerSnapshot(t *testing.T) {
+	require.Empty(t, (&Balancer{}).Snapshot())
+
+	b := &Balancer{connectionsState: newConnectionsState([]conn.Conn{
+		&mock.Conn{AddrField: "1", State: conn.Online, InFlightField: 2, TrafficField: conn.Traffic{
+			SentBytes:     10,
+			ReceivedBytes: 20,
+		}},
+		&mock.Conn{AddrField: "2", State: conn.Banned},
+	}, nil, balancerConfig.Info{}, false)}
+	snapshot := b.Snapshot()
+	require.Len(t, snapshot, 2)
+	require.Equal(t, "1", snapshot[0].Endpoint.Address())
+	require.Equal(t, conn.Online, snapshot[0].State)
+	require.Equal(t, 2, snapshot[0].InFlight)
+	require.Equal(t, conn.Traffic{SentBytes: 10, ReceivedBytes: 20}, snapshot[0].Traffic)
+	require.Equal(t, "2", snapshot[1].Endpoint.Address())
+	require.Equal(t, conn.Banned, snapshot[1].State)
+	require.Equal(t, conn.Traffic{}, snapshot[1].Traffic)
+}
+
+func TestBalancerGetConnMaxInFlight(t *testing.T) {
+	state := func() *connectionsState {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online, InFlightField: 1},
+			&mock.Conn{AddrField: "2", State: conn.Online, InFlightField: 1},
+		}, nil, balancerConfig.Info{}, false)
+		s.maxInFlight = 1
+
+		return s
+	}
+	t.Run("FailFast", func(t *testing.T) {
+		driverConfig := config.New(config.WithMaxInFlightPerEndpoint(1), config.WithMaxInFlightFailFast())
+		b := &Balancer{
+			driverConfig:     driverConfig,
+			pool:             conn.NewPool(context.Background(), driverConfig),
+			connectionsState: state(),
+		}
+		_, err := b.getConn(context.Background())
+		require.ErrorIs(t, err, ErrMaxInFlight)
+		require.NotNil(t, xerrors.RetryableError(err))
+	})
+	t.Run("WaitContext", func(t *testing.T) {
+		driverConfig := config.New(config.WithMaxInFlightPerEndpoint(1))
+		b := &Balancer{
+			driverConfig:     driverConfig,
+			pool:             conn.NewPool(context.Background(), driverConfig),
+			connectionsState: state(),
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := b.getConn(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+	t.Run("NoEndpoints", func(t *testing.T) {
+		driverConfig := config.New(config.WithMaxInFlightPerEndpoint(1))
+		b := &Balancer{
+			driverConfig:     driverConfig,
+			pool:             conn.NewPool(context.Background(), driverConfig),
+			connectionsState: newConnectionsState(nil, nil, balancerConfig.Info{}, false),
+		}
+		_, err := b.getConn(context.Background())
+		require.ErrorIs(t, err, ErrNoEndpoints)
+	})
+}
+
+func TestBalancerHasNodeDraining(t *testing.T) {
+	b := &Balancer{
+		connectionsState: newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "1", NodeIDField: 1, State: conn.Online},
+			&mock.Conn{AddrField: "2", NodeIDField: 2, State: conn.Draining},
+		}, nil, balancerConfig.Info{}, false),
+	}
+	require.True(t, b.HasNode(1))
+	require.False(t, b.HasNode(2))
+	require.False(t, b.HasNode(3))
+	for i := 0; i < 100; i++ {
+		c, failedCount := b.connectionsState.GetConnection(context.Background())
+		require.Equal(t, "1", c.Endpoint().Address())
+		require.Equal(t, 0, failedCount)
+	}
+}
+
+type discoveryClientStub struct {
+	endpoints []endpoint.Endpoint
+}
+
+func (d discoveryClientStub) Discover(context.Context) ([]endpoint.Endpoint, error) {
+	return d.endpoints, nil
+}
+
+func (d discoveryClientStub) Close(context.Context) error {
+	return nil
+}
+
+type filterInfoLocations struct{}
+
+func (filterInfoLocations) Allow(info balancerConfig.Info, c conn.Conn) bool {
+	for _, location := range info.Locations {
+		if c.Endpoint().Location() == location {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (filterInfoLocations) String() string {
+	return "InfoLocations"
+}
+
+func TestBalancerDetectLocations(t *testing.T) {
+	ctx := xtest.Context(t)
+	driverConfig := config.New()
+	location := "a"
+	b := &Balancer{
+		driverConfig: driverConfig,
+		pool:         conn.NewPool(ctx, driverConfig),
+		config: balancerConfig.Config{
+			Filter:        filterInfoLocations{},
+			AllowFallback: true,
+			DetectLocations: func(context.Context) []string {
+				return []string{location}
+			},
+		},
+		discoveryClient: discoveryClientStub{endpoints: []endpoint.Endpoint{
+			endpoint.New("1", endpoint.WithID(1), endpoint.WithLocation("a")),
+			endpoint.New("2", endpoint.WithID(2), endpoint.WithLocation("b")),
+		}},
+	}
+
+	require.NoError(t, b.clusterDiscoveryAttempt(ctx))
+	require.Len(t, b.connectionsState.prefer, 1)
+	inflight := b.connectionsState.prefer[0]
+	require.Equal(t, "1", inflight.Endpoint().Address())
+
+	// changed location is applied on next discovery, connection of in-flight call stays in state as fallback
+	location = "b"
+	require.NoError(t, b.clusterDiscoveryAttempt(ctx))
+	require.Len(t, b.connectionsState.prefer, 1)
+	require.Equal(t, "2", b.connectionsState.prefer[0].Endpoint().Address())
+	require.Equal(t, []conn.Conn{inflight}, b.connectionsState.fallback)
+	require.NotEqual(t, conn.Destroyed, inflight.GetState())
+}
+
+// rotatedCredentials is a provider whose first token is rejected by server
+type rotatedCredentials struct {
+	tokens       []string
+	invalidation int
+}
+
+func (c *rotatedCredentials) Token(context.Context) (string, error) {
+	return c.tokens[c.invalidation], nil
+}
+
+func (c *rotatedCredentials) Invalidate() {
+	c.invalidation++
+}
+
+func TestBalancerRecoverCredentials(t *testing.T) {
+	newBalancer := func(creds credentials.Credentials) *Balancer {
+		driverConfig := config.New(config.WithCredentials(creds))
+
+		return &Balancer{
+			driverConfig: driverConfig,
+			pool:         conn.NewPool(context.Background(), driverConfig),
+			connectionsState: newConnectionsState([]conn.Conn{
+				&mock.Conn{AddrField: "1", State: conn.Online},
+			}, nil, balancerConfig.Info{}, false),
+		}
+	}
+	call := func(tokens *[]string) func(ctx context.Context, cc conn.Conn) error {
+		return func(ctx context.Context, cc conn.Conn) error {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			token := md.Get(meta.HeaderTicket)[0]
+			*tokens = append(*tokens, token)
+			if token == "revoked" {
+				return xerrors.Transport(grpcStatus.Error(grpcCodes.Unauthenticated, "token is revoked"))
+			}
+
+			return nil
+		}
+	}
+	t.Run("Refreshable", func(t *testing.T) {
+		creds := &rotatedCredentials{tokens: []string{"revoked", "fresh", "unused"}}
+		b := newBalancer(creds)
+		var tokens []string
+		err := retry.Retry(xtest.Context(t), func(ctx context.Context) error {
+			return b.wrapCall(conn.WithoutWrapping(ctx), call(&tokens))
+		}, retry.WithFastBackoff(constantBackoff(0)))
+		require.NoError(t, err)
+		require.Equal(t, []string{"revoked", "fresh"}, tokens)
+		require.Equal(t, 1, creds.invalidation)
+	})
+	t.Run("RejectedAfterRefresh", func(t *testing.T) {
+		creds := &rotatedCredentials{tokens: []string{"revoked", "revoked", "unused"}}
+		b := newBalancer(creds)
+		var tokens []string
+		err := retry.Retry(xtest.Context(t), func(ctx context.Context) error {
+			return b.wrapCall(conn.WithoutWrapping(ctx), call(&tokens))
+		}, retry.WithFastBackoff(constantBackoff(0)))
+		require.True(t, credentials.IsTokenError(err))
+		require.Equal(t, []string{"revoked", "revoked"}, tokens)
+		require.Equal(t, 1, creds.invalidation)
+	})
+	t.Run("NotRefreshable", func(t *testing.T) {
+		b := newBalancer(credentials.NewAccessTokenCredentials("revoked"))
+		var tokens []string
+		err := retry.Retry(xtest.Context(t), func(ctx context.Context) error {
+			return b.wrapCall(conn.WithoutWrapping(ctx), call(&tokens))
+		})
+		require.True(t, credentials.IsAccessError(err))
+		require.Equal(t, []string{"revoked"}, tokens)
+	})
+}
+
+type constantBackoff time.Duration
+
+func (b constantBackoff) Delay(int) time.Duration {
+	return time.Duration(b)
+}
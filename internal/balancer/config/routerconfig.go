@@ -1,6 +1,7 @@
 package config
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
@@ -14,6 +15,10 @@ type Config struct {
 	AllowFallback bool
 	SingleConn    bool
 	DetectLocalDC bool
+
+	// DetectLocations resolves preferred locations on every discovery, resolved locations are passed
+	// to Filter as Info.Locations
+	DetectLocations func(ctx context.Context) []string
 }
 
 func (c Config) String() string {
@@ -44,6 +49,9 @@ func (c Config) String() string {
 
 type Info struct {
 	SelfLocation string
+
+	// Locations are preferred locations resolved with Config.DetectLocations
+	Locations []string
 }
 
 type Filter interface {
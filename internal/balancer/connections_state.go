@@ -15,6 +15,9 @@ type connectionsState struct {
 	fallback []conn.Conn
 	all      []conn.Conn
 
+	// maxInFlight is a limit of in-flight calls per connection. Zero value means no limit
+	maxInFlight int
+
 	rand xrand.Rand
 }
 
@@ -39,6 +42,17 @@ func newConnectionsState(
 	return res
 }
 
+// dialOrder returns connections in order of preference for dialing
+func (s *connectionsState) dialOrder(allowFallback bool) []conn.Conn {
+	conns := make([]conn.Conn, 0, len(s.prefer)+len(s.fallback))
+	conns = append(conns, s.prefer...)
+	if allowFallback {
+		conns = append(conns, s.fallback...)
+	}
+
+	return conns
+}
+
 func (s *connectionsState) PreferredCount() int {
 	return len(s.prefer)
 }
@@ -52,6 +66,11 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 		return c, 0
 	}
 
+	prefer, fallback, all := s.prefer, s.fallback, s.all
+	if service, has := ContextRequiredService(ctx); has {
+		prefer, fallback, all = s.withService(service)
+	}
+
 	try := func(conns []conn.Conn) conn.Conn {
 		c, tryFailed := s.selectRandomConnection(conns, false)
 		failedCount += tryFailed
@@ -59,15 +78,15 @@ func (s *connectionsState) GetConnection(ctx context.Context) (_ conn.Conn, fail
 		return c
 	}
 
-	if c := try(s.prefer); c != nil {
+	if c := try(prefer); c != nil {
 		return c, failedCount
 	}
 
-	if c := try(s.fallback); c != nil {
+	if c := try(fallback); c != nil {
 		return c, failedCount
 	}
 
-	c, _ := s.selectRandomConnection(s.all, true)
+	c, _ := s.selectRandomConnection(all, true)
 
 	return c, failedCount
 }
@@ -83,6 +102,28 @@ func (s *connectionsState) preferConnection(ctx context.Context) conn.Conn {
 	return nil
 }
 
+// withService returns prefer, fallback and all connections to endpoints with given service.
+// If no endpoint advertises the service, the service is ignored for keeping calls working
+func (s *connectionsState) withService(service string) (prefer, fallback, all []conn.Conn) {
+	filter := func(conns []conn.Conn) []conn.Conn {
+		filtered := make([]conn.Conn, 0, len(conns))
+		for _, c := range conns {
+			if c.Endpoint().HasService(service) {
+				filtered = append(filtered, c)
+			}
+		}
+
+		return filtered
+	}
+
+	all = filter(s.all)
+	if len(all) == 0 {
+		return s.prefer, s.fallback, s.all
+	}
+
+	return filter(s.prefer), filter(s.fallback), all
+}
+
 func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned bool) (c conn.Conn, failedConns int) {
 	connCount := len(conns)
 	if connCount == 0 {
@@ -91,7 +132,7 @@ func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned
 	}
 
 	// fast path
-	if c := conns[s.rand.Int(connCount)]; isOkConnection(c, allowBanned) {
+	if c := conns[s.rand.Int(connCount)]; isOkConnection(c, allowBanned) && !s.isMaxInFlight(c) {
 		return c, 0
 	}
 
@@ -106,15 +147,40 @@ func (s *connectionsState) selectRandomConnection(conns []conn.Conn, allowBanned
 
 	for _, index := range indexes {
 		c := conns[index]
-		if isOkConnection(c, allowBanned) {
+		if !isOkConnection(c, allowBanned) {
+			failedConns++
+
+			continue
+		}
+		if !s.isMaxInFlight(c) {
 			return c, 0
 		}
-		failedConns++
 	}
 
 	return nil, failedConns
 }
 
+func (s *connectionsState) isMaxInFlight(c conn.Conn) bool {
+	return s.maxInFlight > 0 && c.InFlight() >= s.maxInFlight
+}
+
+// InFlightLimited reports whether in-flight limit is enabled and state has suitable connections.
+// So connection not found by GetConnection of limited state means that all suitable connections
+// reached in-flight limit
+func (s *connectionsState) InFlightLimited() bool {
+	if s.maxInFlight <= 0 {
+		return false
+	}
+
+	for _, c := range s.all {
+		if isOkConnection(c, true) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func connsToNodeIDMap(conns []conn.Conn) (nodes map[uint32]conn.Conn) {
 	if len(conns) == 0 {
 		return nil
@@ -157,7 +223,7 @@ func isOkConnection(c conn.Conn, bannedIsOk bool) bool {
 	switch c.GetState() {
 	case conn.Online, conn.Created, conn.Offline:
 		return true
-	case conn.Banned:
+	case conn.Banned, conn.Draining:
 		return bannedIsOk
 	default:
 		return false
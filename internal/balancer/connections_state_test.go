@@ -460,4 +460,68 @@ func TestConnection(t *testing.T) {
 		require.Equal(t, &mock.Conn{AddrField: "1", State: conn.Online, NodeIDField: 1}, c)
 		require.Equal(t, 0, failed)
 	})
+	t.Run("RequiredService", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online, ServicesField: []string{"table"}},
+			&mock.Conn{AddrField: "2", State: conn.Online, ServicesField: []string{"table", "topic"}},
+			&mock.Conn{AddrField: "3", State: conn.Online, ServicesField: []string{"table"}},
+		}, nil, balancerConfig.Info{}, false)
+		for i := 0; i < 10; i++ {
+			c, failed := s.GetConnection(WithRequiredService(context.Background(), "topic"))
+			require.Equal(t, "2", c.Endpoint().Address())
+			require.Equal(t, 0, failed)
+		}
+	})
+	t.Run("RequiredServiceBanned", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online, ServicesField: []string{"table"}},
+			&mock.Conn{AddrField: "2", State: conn.Banned, ServicesField: []string{"topic"}},
+		}, nil, balancerConfig.Info{}, false)
+		c, failed := s.GetConnection(WithRequiredService(context.Background(), "topic"))
+		require.Equal(t, "2", c.Endpoint().Address())
+		require.Equal(t, 1, failed)
+	})
+	t.Run("RequiredServiceNotAdvertised", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online, ServicesField: []string{"table"}},
+		}, nil, balancerConfig.Info{}, false)
+		c, failed := s.GetConnection(WithRequiredService(context.Background(), "topic"))
+		require.Equal(t, "1", c.Endpoint().Address())
+		require.Equal(t, 0, failed)
+	})
+}
+
+func TestConnectionsStateMaxInFlight(t *testing.T) {
+	t.Run("PreferBelowLimit", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online, InFlightField: 2},
+			&mock.Conn{AddrField: "2", State: conn.Online, InFlightField: 1},
+			&mock.Conn{AddrField: "3", State: conn.Online, InFlightField: 3},
+		}, nil, balancerConfig.Info{}, false)
+		s.maxInFlight = 2
+		for i := 0; i < 100; i++ {
+			c, failedCount := s.GetConnection(context.Background())
+			require.Equal(t, "2", c.Endpoint().Address())
+			require.Equal(t, 0, failedCount)
+		}
+	})
+	t.Run("AllAtLimit", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online, InFlightField: 2},
+			&mock.Conn{AddrField: "2", State: conn.Banned, InFlightField: 2},
+		}, nil, balancerConfig.Info{}, false)
+		s.maxInFlight = 2
+		c, failedCount := s.GetConnection(context.Background())
+		require.Nil(t, c)
+		require.Equal(t, 1, failedCount)
+		require.True(t, s.InFlightLimited())
+	})
+	t.Run("NoLimit", func(t *testing.T) {
+		s := newConnectionsState([]conn.Conn{
+			&mock.Conn{AddrField: "1", State: conn.Online, InFlightField: 100},
+		}, nil, balancerConfig.Info{}, false)
+		c, _ := s.GetConnection(context.Background())
+		require.Equal(t, "1", c.Endpoint().Address())
+		require.False(t, s.InFlightLimited())
+	})
 }
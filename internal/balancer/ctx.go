@@ -3,7 +3,8 @@ package balancer
 import "context"
 
 type (
-	ctxEndpointKey struct{}
+	ctxEndpointKey        struct{}
+	ctxRequiredServiceKey struct{}
 )
 
 type Endpoint interface {
@@ -21,3 +22,17 @@ func ContextEndpoint(ctx context.Context) (e Endpoint, ok bool) {
 
 	return nil, false
 }
+
+// WithRequiredService returns a copy of parent context with name of service which must be advertised by endpoint of
+// call. Endpoints without advertised services are considered as supporting all services
+func WithRequiredService(ctx context.Context, service string) context.Context {
+	return context.WithValue(ctx, ctxRequiredServiceKey{}, service)
+}
+
+func ContextRequiredService(ctx context.Context) (service string, ok bool) {
+	if service, ok = ctx.Value(ctxRequiredServiceKey{}).(string); ok {
+		return service, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,161 @@
+package balancer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// initialDialFanOut is a limit of concurrent dials of discovered endpoints on initialization of balancer
+const initialDialFanOut = 3
+
+// dialFirst dials connections in order of preference with up to initialDialFanOut concurrent dials and
+// returns as soon as one of connections is ready. Every dial is bounded by dial timeout of driver config,
+// dialFirst waits for ready connection no longer than one dial timeout too, so silent endpoints delay
+// initialization of balancer at most by dial timeout regardless of number of endpoints. Dials which are
+// in progress on return continue in background up to dial timeout, so connections which failed to dial
+// are banned and balancer prefers available endpoints from first calls. Not dialed connections are
+// established on first use
+func (b *Balancer) dialFirst(ctx context.Context, conns []conn.Conn) error {
+	if len(conns) == 0 {
+		return xerrors.WithStackTrace(ErrNoEndpoints)
+	}
+
+	var (
+		dialTimeout = b.driverConfig.DialTimeout()
+		deadline    <-chan time.Time
+		expiresAt   = b.clock.Now().Add(dialTimeout)
+
+		dialCtx, cancelDials = xcontext.WithCancel(xcontext.ValueOnly(ctx))
+
+		slots   = make(chan struct{}, initialDialFanOut)
+		results = make(chan error, len(conns))
+		stop    = make(chan struct{})
+		stopped sync.Once
+	)
+	if dialTimeout > 0 {
+		timer := b.clock.NewTimer(dialTimeout)
+		defer timer.Stop()
+		deadline = timer.Chan()
+	}
+	stopDials := func() {
+		stopped.Do(func() {
+			close(stop)
+		})
+	}
+	defer stopDials()
+
+	go func() {
+		var wg sync.WaitGroup
+		defer func() {
+			wg.Wait()
+			cancelDials()
+		}()
+		for _, cc := range conns {
+			select {
+			case <-dialCtx.Done():
+				return
+			case <-stop:
+				return
+			case slots <- struct{}{}:
+			}
+			// stop is checked again because select chooses randomly between ready cases. Dials are not
+			// started after the deadline too, even if main loop has not noticed the deadline yet
+			select {
+			case <-stop:
+				<-slots
+
+				return
+			default:
+				if dialTimeout > 0 && !b.clock.Now().Before(expiresAt) {
+					<-slots
+
+					return
+				}
+			}
+			wg.Add(1)
+			go func(cc conn.Conn) {
+				defer func() {
+					<-slots
+					wg.Done()
+				}()
+				results <- b.dial(dialCtx, cc)
+			}(cc)
+		}
+	}()
+
+	errs := make([]error, 0, len(conns))
+	for range conns {
+		select {
+		case <-ctx.Done():
+			cancelDials()
+
+			return xerrors.WithStackTrace(ctx.Err())
+		case <-deadline:
+			return xerrors.WithStackTrace(xerrors.WithDeadlineCause(
+				xerrors.Join(append(errs,
+					fmt.Errorf("no ready connections during dial timeout %v", dialTimeout),
+				)...),
+				xerrors.Deadline{Source: xerrors.DeadlineSourceDialTimeout, Timeout: dialTimeout},
+			))
+		case err := <-results:
+			if err == nil {
+				if dialTimeout <= 0 {
+					// without dial timeout background dials are not bounded
+					cancelDials()
+				}
+
+				return nil
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	return xerrors.WithStackTrace(xerrors.Join(errs...))
+}
+
+// dial establishes connection with dial timeout and bans connection on failure. Canceled dials
+// (after first success without dial timeout) are not failures of endpoint. Errors of dials
+// interrupted by dial timeout are tagged with dial timeout deadline cause
+func (b *Balancer) dial(ctx context.Context, cc conn.Conn) error {
+	var (
+		expired     atomic.Bool
+		dialTimeout = b.driverConfig.DialTimeout()
+	)
+	if dialTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = xcontext.WithCancel(ctx)
+		defer cancel()
+		timer := b.clock.NewTimer(dialTimeout)
+		defer timer.Stop()
+		go func() {
+			select {
+			case <-ctx.Done():
+			case <-timer.Chan():
+				expired.Store(true)
+				cancel()
+			}
+		}()
+	}
+
+	if err := cc.Ping(ctx); err != nil {
+		if expired.Load() {
+			err = xerrors.WithDeadlineCause(err,
+				xerrors.Deadline{Source: xerrors.DeadlineSourceDialTimeout, Timeout: dialTimeout},
+			)
+		}
+		if ctx.Err() == nil || expired.Load() {
+			b.pool.Ban(xcontext.ValueOnly(ctx), cc, err)
+		}
+
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
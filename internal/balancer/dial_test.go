@@ -0,0 +1,203 @@
+package balancer
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// pingConn is a connection of pool with emulated result of dial
+type pingConn struct {
+	conn.Conn
+
+	ping  func(ctx context.Context) error
+	pings atomic.Int32
+}
+
+func (c *pingConn) Ping(ctx context.Context) error {
+	c.pings.Add(1)
+
+	return c.ping(ctx)
+}
+
+func unavailable(msg string) error {
+	return xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, msg))
+}
+
+// silentPing emulates endpoint which accepts connections but never responds
+func silentPing(ctx context.Context) error {
+	<-ctx.Done()
+
+	return unavailable("connection is not ready")
+}
+
+func refusedPing(context.Context) error {
+	return unavailable("connection refused")
+}
+
+func readyPing(context.Context) error {
+	return nil
+}
+
+func TestBalancerDialFirst(t *testing.T) {
+	const dialTimeout = 5 * time.Second
+
+	newBalancer := func(t *testing.T, pings ...func(ctx context.Context) error) (
+		*Balancer, clockwork.FakeClock, []*pingConn,
+	) {
+		cfg := config.New(
+			config.WithSecure(false),
+			config.WithDialTimeout(dialTimeout),
+		)
+		clock := clockwork.NewFakeClock()
+		b := &Balancer{
+			driverConfig: cfg,
+			pool:         conn.NewPool(context.Background(), cfg),
+			clock:        clock,
+		}
+		t.Cleanup(func() {
+			_ = b.pool.Release(context.Background())
+		})
+		conns := make([]*pingConn, 0, len(pings))
+		for i, ping := range pings {
+			conns = append(conns, &pingConn{
+				Conn: b.pool.Get(endpoint.New(fmt.Sprintf("node-%d:2135", i))),
+				ping: ping,
+			})
+		}
+
+		return b, clock, conns
+	}
+	dialOrder := func(conns []*pingConn) []conn.Conn {
+		order := make([]conn.Conn, 0, len(conns))
+		for _, cc := range conns {
+			order = append(order, cc)
+		}
+
+		return order
+	}
+
+	t.Run("FirstSuccess", func(t *testing.T) {
+		b, clock, conns := newBalancer(t, silentPing, silentPing, readyPing)
+
+		// ready endpoint is dialed concurrently with silent endpoints
+		require.NoError(t, b.dialFirst(xtest.Context(t), dialOrder(conns)))
+		require.NotEqual(t, conn.Banned, conns[2].GetState())
+
+		// failures of dials which are started before first success are recorded in background.
+		// Fake clock keeps stopped timers as sleepers: timer of dialFirst and timers of three dials
+		clock.BlockUntil(4)
+		clock.Advance(dialTimeout)
+		require.Eventually(t, func() bool {
+			return conns[0].GetState() == conn.Banned && conns[1].GetState() == conn.Banned
+		}, time.Second, time.Millisecond)
+		require.NotEqual(t, conn.Banned, conns[2].GetState())
+	})
+	t.Run("FailedDialsAreReplaced", func(t *testing.T) {
+		b, _, conns := newBalancer(t, refusedPing, refusedPing, refusedPing, refusedPing, readyPing)
+
+		require.NoError(t, b.dialFirst(xtest.Context(t), dialOrder(conns)))
+		// refused dial may be still in progress when ready endpoint is dialed concurrently
+		require.Eventually(t, func() bool {
+			for _, cc := range conns[:4] {
+				if cc.GetState() != conn.Banned {
+					return false
+				}
+			}
+
+			return true
+		}, time.Second, time.Millisecond)
+		require.NotEqual(t, conn.Banned, conns[4].GetState())
+	})
+	t.Run("SilentEndpointsDelayAtMostDialTimeout", func(t *testing.T) {
+		b, clock, conns := newBalancer(t, silentPing, silentPing, silentPing, silentPing, readyPing)
+
+		errs := make(chan error, 1)
+		go func() {
+			errs <- b.dialFirst(xtest.Context(t), dialOrder(conns))
+		}()
+		// timer of dialFirst and timers of three concurrent dials
+		clock.BlockUntil(4)
+		clock.Advance(dialTimeout)
+		err := <-errs
+		require.Error(t, err)
+		cause, has := xerrors.DeadlineCause(err)
+		require.True(t, has)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceDialTimeout, Timeout: dialTimeout}, cause)
+		require.Eventually(t, func() bool {
+			for _, cc := range conns[:3] {
+				if cc.GetState() != conn.Banned {
+					return false
+				}
+			}
+
+			return true
+		}, time.Second, time.Millisecond)
+		// rest of endpoints are not dialed on initialization
+		for _, cc := range conns[3:] {
+			require.Zero(t, cc.pings.Load())
+			require.NotEqual(t, conn.Banned, cc.GetState())
+		}
+	})
+	t.Run("AllFailed", func(t *testing.T) {
+		b, _, conns := newBalancer(t, refusedPing, refusedPing)
+
+		require.Error(t, b.dialFirst(xtest.Context(t), dialOrder(conns)))
+		for _, cc := range conns {
+			require.Equal(t, conn.Banned, cc.GetState())
+		}
+	})
+	t.Run("NoEndpoints", func(t *testing.T) {
+		b, _, _ := newBalancer(t)
+
+		require.ErrorIs(t, b.dialFirst(xtest.Context(t), nil), ErrNoEndpoints)
+	})
+}
+
+func TestBalancerDialDeadlineCause(t *testing.T) {
+	const dialTimeout = 5 * time.Second
+	cfg := config.New(
+		config.WithSecure(false),
+		config.WithDialTimeout(dialTimeout),
+	)
+	clock := clockwork.NewFakeClock()
+	b := &Balancer{
+		driverConfig: cfg,
+		pool:         conn.NewPool(context.Background(), cfg),
+		clock:        clock,
+	}
+	defer func() {
+		_ = b.pool.Release(context.Background())
+	}()
+	t.Run("DialTimeout", func(t *testing.T) {
+		cc := &pingConn{Conn: b.pool.Get(endpoint.New("silent:2135")), ping: silentPing}
+		errs := make(chan error, 1)
+		go func() {
+			errs <- b.dial(xtest.Context(t), cc)
+		}()
+		clock.BlockUntil(1)
+		clock.Advance(dialTimeout)
+		cause, has := xerrors.DeadlineCause(<-errs)
+		require.True(t, has)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceDialTimeout, Timeout: dialTimeout}, cause)
+		require.Equal(t, conn.Banned, cc.GetState())
+	})
+	t.Run("Refused", func(t *testing.T) {
+		cc := &pingConn{Conn: b.pool.Get(endpoint.New("refused:2135")), ping: refusedPing}
+		_, has := xerrors.DeadlineCause(b.dial(xtest.Context(t), cc))
+		require.False(t, has)
+	})
+}
@@ -6,9 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"reflect"
 	"sort"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -23,6 +26,27 @@ var (
 
 //nolint:gocyclo,funlen
 func toValue(v interface{}) (_ types.Value, err error) {
+	// uuid.UUID implements driver.Valuer as text, but must be sent as native UUID
+	switch x := v.(type) {
+	case uuid.UUID:
+		return types.UUIDValue(x), nil
+	case *uuid.UUID:
+		if x == nil {
+			return types.NullValue(types.TypeUUID), nil
+		}
+
+		return types.OptionalValue(types.UUIDValue(*x)), nil
+	}
+
+	if encoder, ok := v.(value.Encoder); ok {
+		encoded, err := encoder.YDBValue()
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return encoded, nil
+	}
+
 	if valuer, ok := v.(driver.Valuer); ok {
 		v, err = valuer.Value()
 		if err != nil {
@@ -33,6 +57,8 @@ func toValue(v interface{}) (_ types.Value, err error) {
 	switch x := v.(type) {
 	case nil:
 		return types.VoidValue(), nil
+	case JSON:
+		return x.toValue()
 	case value.Value:
 		return x, nil
 	case bool:
@@ -125,6 +151,16 @@ func toValue(v interface{}) (_ types.Value, err error) {
 	case *time.Duration:
 		return types.NullableIntervalValueFromDuration(x), nil
 	default:
+		if isContainer(x) {
+			v, err := value.FromGo(x, nil, value.DefaultFromGoTagName)
+			if err == nil {
+				return v, nil
+			}
+			if !xerrors.Is(err, value.ErrUnsupportedGoType) {
+				return nil, xerrors.WithStackTrace(err)
+			}
+		}
+
 		return nil, xerrors.WithStackTrace(
 			fmt.Errorf("%T: %w. Create issue for support new type %s",
 				x, errUnsupportedType, supportNewTypeLink(x),
@@ -133,6 +169,56 @@ func toValue(v interface{}) (_ types.Value, err error) {
 	}
 }
 
+// isContainer checks that x is a struct, slice, array or map (or pointer to them),
+// which are converted to YDB value with reflection
+func isContainer(x interface{}) bool {
+	t := reflect.TypeOf(x)
+	if t == nil {
+		return false
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	default:
+		return false
+	}
+}
+
+// JSON is a wrapper of Go value for passing as query arg of Json or JsonDocument type
+type JSON struct {
+	v        interface{}
+	document bool
+}
+
+// NewJSON wraps Go value v which is marshaled to query arg of Json (or JsonDocument if document is true) type
+func NewJSON(v interface{}, document bool) JSON {
+	return JSON{
+		v:        v,
+		document: document,
+	}
+}
+
+func (j JSON) toValue() (types.Value, error) {
+	if j.document {
+		v, err := value.JSONDocumentValueFromObject(j.v)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return v, nil
+	}
+
+	v, err := value.JSONValueFromObject(j.v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return v, nil
+}
+
 func supportNewTypeLink(x interface{}) string {
 	v := url.Values{}
 	v.Add("labels", "enhancement,database/sql")
@@ -162,6 +248,10 @@ func toYdbParam(name string, value interface{}) (*params.Parameter, error) {
 	}
 	v, err := toValue(value)
 	if err != nil {
+		if name != "" {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("query arg %q: %w", name, err))
+		}
+
 		return nil, xerrors.WithStackTrace(err)
 	}
 	if name == "" {
@@ -3,17 +3,32 @@ package bind
 import (
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
 
+type testEnum int
+
+func (e testEnum) YDBValue() (value.Value, error) {
+	if e < 0 {
+		return nil, errTestEnum
+	}
+
+	return value.TextValue(fmt.Sprintf("enum%d", e)), nil
+}
+
+var errTestEnum = errors.New("unknown enum")
+
 func TestToValue(t *testing.T) {
 	for _, tt := range []struct {
 		src interface{}
@@ -25,6 +40,16 @@ func TestToValue(t *testing.T) {
 			dst: types.BoolValue(true),
 			err: nil,
 		},
+		{
+			src: testEnum(1),
+			dst: types.TextValue("enum1"),
+			err: nil,
+		},
+		{
+			src: testEnum(-1),
+			dst: nil,
+			err: errTestEnum,
+		},
 
 		{
 			src: nil,
@@ -293,6 +318,21 @@ func TestToValue(t *testing.T) {
 			dst: types.NullValue(types.TypeUUID),
 			err: nil,
 		},
+		{
+			src: uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff"),
+			dst: types.UUIDValue(uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff")),
+			err: nil,
+		},
+		{
+			src: func(v uuid.UUID) *uuid.UUID { return &v }(uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff")),
+			dst: types.OptionalValue(types.UUIDValue(uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff"))),
+			err: nil,
+		},
+		{
+			src: func() *uuid.UUID { return nil }(),
+			dst: types.NullValue(types.TypeUUID),
+			err: nil,
+		},
 
 		{
 			src: time.Unix(42, 43),
@@ -325,6 +365,43 @@ func TestToValue(t *testing.T) {
 			dst: types.NullValue(types.TypeInterval),
 			err: nil,
 		},
+
+		{
+			src: []struct {
+				ID   uint64 `ydb:"id"`
+				Name string `ydb:"name"`
+			}{{ID: 1, Name: "a"}},
+			dst: types.ListValue(types.StructValue(
+				types.StructFieldValue("id", types.Uint64Value(1)),
+				types.StructFieldValue("name", types.TextValue("a")),
+			)),
+			err: nil,
+		},
+		{
+			src: map[string]int32{"a": 1},
+			dst: types.DictValue(types.DictFieldValue(types.TextValue("a"), types.Int32Value(1))),
+			err: nil,
+		},
+		{
+			src: []chan int{make(chan int)},
+			dst: nil,
+			err: errUnsupportedType,
+		},
+		{
+			src: NewJSON(map[string]int{"a": 1}, false),
+			dst: types.JSONValue(`{"a":1}`),
+			err: nil,
+		},
+		{
+			src: NewJSON(`{"a":1}`, true),
+			dst: types.JSONDocumentValue(`{"a":1}`),
+			err: nil,
+		},
+		{
+			src: NewJSON(`{"a":`, false),
+			dst: nil,
+			err: value.ErrInvalidJSON,
+		},
 	} {
 		t.Run(fmt.Sprintf("%T(%v)", tt.src, tt.src), func(t *testing.T) {
 			dst, err := toValue(tt.src)
@@ -528,3 +605,9 @@ func TestArgsToParams(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsErrorWithName(t *testing.T) {
+	_, err := Params(sql.Named("data", NewJSON([]byte{'"', 0xff, '"'}, false)))
+	require.ErrorIs(t, err, value.ErrInvalidJSON)
+	require.Contains(t, err.Error(), `"data"`)
+}
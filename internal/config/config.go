@@ -17,6 +17,10 @@ type Common struct {
 	retryBudget          budget.Budget
 
 	panicCallback func(e interface{})
+
+	queryTextRedactor func(query string) string
+
+	streamInactivityTimeout *time.Duration
 }
 
 // AutoRetry defines auto-retry flag
@@ -48,6 +52,26 @@ func (c *Common) OperationCancelAfter() time.Duration {
 	return c.operationCancelAfter
 }
 
+// RedactQueryText returns query text for traces and logs
+// If query text redactor not defined - returns query text as is
+func (c *Common) RedactQueryText(query string) string {
+	if c.queryTextRedactor == nil {
+		return query
+	}
+
+	return c.queryTextRedactor(query)
+}
+
+// StreamInactivityTimeout returns stream inactivity timeout of client streams.
+// If timeout is not defined for client - stream inactivity timeout of driver is used
+func (c *Common) StreamInactivityTimeout() (_ time.Duration, ok bool) {
+	if c.streamInactivityTimeout == nil {
+		return 0, false
+	}
+
+	return *c.streamInactivityTimeout, true
+}
+
 func (c *Common) TraceRetry() *trace.Retry {
 	return &c.traceRetry
 }
@@ -97,3 +121,14 @@ func SetTraceRetry(c *Common, t *trace.Retry, opts ...trace.RetryComposeOption)
 func SetRetryBudget(c *Common, b budget.Budget) {
 	c.retryBudget = b
 }
+
+// SetQueryTextRedactor applies redactor of query text in traces and logs
+func SetQueryTextRedactor(c *Common, redactor func(query string) string) {
+	c.queryTextRedactor = redactor
+}
+
+// SetStreamInactivityTimeout overrides stream inactivity timeout of driver for client streams.
+// Zero timeout disables checking of inactivity of client streams
+func SetStreamInactivityTimeout(c *Common, timeout time.Duration) {
+	c.streamInactivityTimeout = &timeout
+}
@@ -13,4 +13,11 @@ type Config interface {
 	ConnectionTTL() time.Duration
 	Trace() *trace.Driver
 	GrpcDialOptions() []grpc.DialOption
+	DefaultOperationTimeout() time.Duration
+	DefaultScanQueryTimeout() time.Duration
+	DefaultSchemeTimeout() time.Duration
+	StreamMessageSizeSampling() int
+	StreamInactivityTimeout() time.Duration
+	GrpcMaxRecvMsgSize() int
+	GrpcMaxSendMsgSize() int
 }
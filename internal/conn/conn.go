@@ -3,15 +3,19 @@ package conn
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"google.golang.org/grpc"
+	grpcBackoff "google.golang.org/grpc/backoff"
+	grpcCodes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/stats"
+	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
@@ -41,6 +45,12 @@ type Conn interface {
 
 	LastUsage() time.Time
 
+	// InFlight returns number of in-flight unary calls and opened streams
+	InFlight() int
+
+	// Traffic returns cumulative sizes of sent and received messages
+	Traffic() Traffic
+
 	Ping(ctx context.Context) error
 	IsState(states ...State) bool
 	GetState() State
@@ -48,6 +58,12 @@ type Conn interface {
 	Unban(ctx context.Context) State
 }
 
+// Traffic contains cumulative sizes of messages on wire
+type Traffic struct {
+	SentBytes     uint64
+	ReceivedBytes uint64
+}
+
 type conn struct {
 	mtx               sync.RWMutex
 	config            Config // ro access
@@ -58,24 +74,55 @@ type conn struct {
 	state             atomic.Uint32
 	childStreams      *xcontext.CancelsGuard
 	lastUsage         xsync.LastUsage
+	inFlight          atomic.Int64
+	sentBytes         atomic.Uint64
+	receivedBytes     atomic.Uint64
+	draining          atomic.Bool
 	onClose           []func(*conn)
 	onTransportErrors []func(ctx context.Context, cc Conn, cause error)
+	onInFlightDone    []func(*conn)
+	onDrain           []func(ctx context.Context, cc Conn)
 }
 
 func (c *conn) Address() string {
 	return c.endpoint.Address()
 }
 
+// Ping establishes grpc connection to endpoint and waits for result of connection attempt or done ctx.
+// Ping does not wait for reconnects after failed attempt: failure of attempt (including expired dial
+// timeout of attempt) is returned immediately as transport error with code Unavailable which leads
+// to pessimization of endpoint
 func (c *conn) Ping(ctx context.Context) error {
 	cc, err := c.realConn(ctx)
 	if err != nil {
 		return c.wrapError(err)
 	}
-	if !isAvailable(cc) {
-		return c.wrapError(errUnavailableConnection)
+	state := cc.GetState()
+	if state == connectivity.Idle {
+		cc.Connect()
+	}
+	// state changes from idle to connecting and from connecting to result of attempt
+	for state == connectivity.Idle || state == connectivity.Connecting {
+		if !cc.WaitForStateChange(ctx, state) {
+			break
+		}
+		state = cc.GetState()
+	}
+	if state == connectivity.Ready {
+		return nil
 	}
 
-	return nil
+	reason := state.String()
+	if ctx.Err() != nil {
+		reason = fmt.Sprintf("%s: %v", state, ctx.Err())
+	}
+
+	return c.wrapError(xerrors.Transport(
+		grpcStatus.Error(grpcCodes.Unavailable, "connection is not ready ("+reason+")"),
+		xerrors.WithAddress(c.endpoint.Address()),
+		xerrors.WithNodeID(c.NodeID()),
+		xerrors.WithLocation(c.endpoint.Location()),
+	))
 }
 
 func (c *conn) LastUsage() time.Time {
@@ -85,6 +132,41 @@ func (c *conn) LastUsage() time.Time {
 	return c.lastUsage.Get()
 }
 
+func (c *conn) InFlight() int {
+	return int(c.inFlight.Load())
+}
+
+func (c *conn) Traffic() Traffic {
+	return Traffic{
+		SentBytes:     c.sentBytes.Load(),
+		ReceivedBytes: c.receivedBytes.Load(),
+	}
+}
+
+// takeInFlight counts call as in-flight until returned func is called
+func (c *conn) takeInFlight(ctx context.Context) (done func()) {
+	c.onInFlightChange(ctx, c.inFlight.Add(1))
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			c.onInFlightChange(ctx, c.inFlight.Add(-1))
+			for _, onInFlightDone := range c.onInFlightDone {
+				onInFlightDone(c)
+			}
+		})
+	}
+}
+
+func (c *conn) onInFlightChange(ctx context.Context, inFlight int64) {
+	trace.DriverOnConnInFlightChange(
+		c.config.Trace(), ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/conn.(*conn).onInFlightChange"),
+		c.endpoint, int(inFlight),
+	)
+}
+
 func (c *conn) IsState(states ...State) bool {
 	state := State(c.state.Load())
 	for _, s := range states {
@@ -159,6 +241,10 @@ func (c *conn) setState(ctx context.Context, s State) State {
 }
 
 func (c *conn) Unban(ctx context.Context) State {
+	if c.IsState(Draining) {
+		return Draining
+	}
+
 	var newState State
 	c.mtx.RLock()
 	cc := c.grpcConn
@@ -192,7 +278,7 @@ func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
 
 	if dialTimeout := c.config.DialTimeout(); dialTimeout > 0 {
 		var cancel context.CancelFunc
-		ctx, cancel = xcontext.WithTimeout(ctx, dialTimeout)
+		ctx, cancel = xcontext.WithNamedTimeout(ctx, xerrors.DeadlineSourceDialTimeout, dialTimeout)
 		defer cancel()
 	}
 
@@ -209,11 +295,18 @@ func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
 	// three slashes in "ydb:///" is ok. It needs for good parse scheme in grpc resolver.
 	address := "ydb:///" + c.endpoint.Address()
 
-	cc, err = grpc.DialContext(ctx, address, append(
-		[]grpc.DialOption{
-			grpc.WithStatsHandler(statsHandler{}),
-		}, c.config.GrpcDialOptions()...,
-	)...)
+	dialOptions := []grpc.DialOption{
+		grpc.WithStatsHandler(statsHandler{conn: c}),
+	}
+	if dialTimeout := c.config.DialTimeout(); dialTimeout > 0 {
+		// every attempt of connecting to address of endpoint is bounded by dial timeout
+		dialOptions = append(dialOptions, grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           grpcBackoff.DefaultConfig,
+			MinConnectTimeout: dialTimeout,
+		}))
+	}
+
+	cc, err = grpc.DialContext(ctx, address, append(dialOptions, c.config.GrpcDialOptions()...)...)
 	if err != nil {
 		if xerrors.IsContextError(err) {
 			return nil, xerrors.WithStackTrace(err)
@@ -225,6 +318,8 @@ func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
 
 		err = xerrors.Transport(err,
 			xerrors.WithAddress(address),
+			xerrors.WithNodeID(c.NodeID()),
+			xerrors.WithLocation(c.endpoint.Location()),
 		)
 
 		return nil, c.wrapError(
@@ -241,11 +336,90 @@ func (c *conn) realConn(ctx context.Context) (cc *grpc.ClientConn, err error) {
 }
 
 func (c *conn) onTransportError(ctx context.Context, cause error) {
+	if isGoAwayError(cause) {
+		c.drain(ctx)
+
+		return
+	}
+
 	for _, onTransportError := range c.onTransportErrors {
 		onTransportError(ctx, c, cause)
 	}
 }
 
+// checkShutdownHint drains conn if server hints about shutdown of node
+func (c *conn) checkShutdownHint(ctx context.Context, md metadata.MD) {
+	for _, hint := range md.Get(meta.HeaderServerHints) {
+		if hint == meta.HintNodeShutdown {
+			c.drain(ctx)
+
+			return
+		}
+	}
+}
+
+// isGoAwayError checks that transport error caused by GOAWAY frame of server
+func isGoAwayError(err error) bool {
+	if !xerrors.IsTransportError(err, grpcCodes.Unavailable) {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+
+	return strings.Contains(msg, "goaway") || strings.Contains(msg, "draining")
+}
+
+// drain marks conn as draining until connection to shut down node is lost and established again.
+// Balancer does not route new calls to draining conn, but existing streams are not interrupted
+func (c *conn) drain(ctx context.Context) {
+	c.mtx.RLock()
+	cc := c.grpcConn
+	closed := c.closed
+	c.mtx.RUnlock()
+
+	if closed || cc == nil || !c.draining.CompareAndSwap(false, true) {
+		return
+	}
+
+	c.setState(ctx, Draining)
+
+	for _, onDrain := range c.onDrain {
+		onDrain(ctx, c)
+	}
+
+	watchCtx, cancel := c.childStreams.WithCancel(xcontext.ValueOnly(ctx))
+	go func() {
+		defer cancel()
+		defer c.draining.Store(false)
+
+		if waitReconnect(watchCtx, cc) && c.IsState(Draining) {
+			c.setState(watchCtx, Online)
+		}
+	}()
+}
+
+// waitReconnect waits for lost of ready connection and for next ready state
+func waitReconnect(ctx context.Context, cc *grpc.ClientConn) bool {
+	state := cc.GetState()
+	for ; state == connectivity.Ready; state = cc.GetState() {
+		if !cc.WaitForStateChange(ctx, state) {
+			return false
+		}
+	}
+	for ; state != connectivity.Ready; state = cc.GetState() {
+		switch state {
+		case connectivity.Shutdown:
+			return false
+		case connectivity.Idle:
+			cc.Connect()
+		}
+		if !cc.WaitForStateChange(ctx, state) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func isAvailable(raw *grpc.ClientConn) bool {
 	return raw != nil && raw.GetState() == connectivity.Ready
 }
@@ -306,6 +480,9 @@ func (c *conn) Invoke(
 	res interface{},
 	opts ...grpc.CallOption,
 ) (err error) {
+	ctx, cancel := withDefaultTimeout(ctx, c.config, method, false)
+	defer cancel()
+
 	var (
 		opID        string
 		issues      []trace.Issue
@@ -313,14 +490,19 @@ func (c *conn) Invoke(
 		onDone      = trace.DriverOnConnInvoke(
 			c.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/conn.(*conn).Invoke"),
-			c.endpoint, trace.Method(method),
+			c.endpoint, trace.Method(method), deadline(ctx),
 		)
-		cc *grpc.ClientConn
-		md = metadata.MD{}
+		cc       *grpc.ClientConn
+		md       = metadata.MD{}
+		sentMark = &modificationMark{}
 	)
 	defer func() {
 		meta.CallTrailerCallback(ctx, md)
-		onDone(err, issues, opID, c.GetState(), md)
+		c.checkShutdownHint(ctx, md)
+		deadlineSource, deadlineTimeout := deadlineCauseOf(err)
+		onDone(err, issues, opID, c.GetState(), md, sentMark.sent(), sentMark.received(),
+			deadlineSource, deadlineTimeout,
+		)
 	}()
 
 	cc, err = c.realConn(ctx)
@@ -331,17 +513,19 @@ func (c *conn) Invoke(
 	stop := c.lastUsage.Start()
 	defer stop()
 
+	defer c.takeInFlight(ctx)()
+
 	ctx, traceID, err := meta.TraceID(ctx)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
 
-	ctx, sentMark := markContext(meta.WithTraceID(ctx, traceID))
+	ctx, sentMark = markContext(meta.WithTraceID(ctx, traceID))
 
 	err = cc.Invoke(ctx, method, req, res, append(opts, grpc.Trailer(&md))...)
 	if err != nil {
 		if xerrors.IsContextError(err) {
-			return xerrors.WithStackTrace(err)
+			return xerrors.WithStackTrace(withDeadlineCause(ctx, err))
 		}
 
 		defer func() {
@@ -349,10 +533,12 @@ func (c *conn) Invoke(
 		}()
 
 		if useWrapping {
-			err = xerrors.Transport(err,
+			err = withDeadlineCause(ctx, xerrors.Transport(withMessageSizeLimits(err, c.config),
 				xerrors.WithAddress(c.Address()),
+				xerrors.WithNodeID(c.NodeID()),
+				xerrors.WithLocation(c.endpoint.Location()),
 				xerrors.WithTraceID(traceID),
-			)
+			))
 			if sentMark.canRetry() {
 				return c.wrapError(xerrors.Retryable(err, xerrors.WithName("Invoke")))
 			}
@@ -375,11 +561,13 @@ func (c *conn) Invoke(
 
 			case o.GetOperation().GetStatus() != Ydb.StatusIds_SUCCESS:
 				return c.wrapError(
-					xerrors.Operation(
+					withOperationDeadlineCause(req, xerrors.Operation(
 						xerrors.FromOperation(o.GetOperation()),
 						xerrors.WithAddress(c.Address()),
+						xerrors.WithNodeID(c.NodeID()),
+						xerrors.WithLocation(c.endpoint.Location()),
 						xerrors.WithTraceID(traceID),
-					),
+					)),
 				)
 			}
 		}
@@ -395,17 +583,23 @@ func (c *conn) NewStream(
 	method string,
 	opts ...grpc.CallOption,
 ) (_ grpc.ClientStream, finalErr error) {
+	ctx, cancelTimeout := withDefaultTimeout(ctx, c.config, method, true)
+
 	var (
 		onDone = trace.DriverOnConnNewStream(
 			c.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/conn.(*conn).NewStream"),
-			c.endpoint.Copy(), trace.Method(method),
+			c.endpoint.Copy(), trace.Method(method), deadline(ctx),
 		)
 		useWrapping = UseWrapping(ctx)
 	)
 
 	defer func() {
-		onDone(finalErr, c.GetState())
+		if finalErr != nil {
+			cancelTimeout()
+		}
+		deadlineSource, deadlineTimeout := deadlineCauseOf(finalErr)
+		onDone(finalErr, c.GetState(), deadlineSource, deadlineTimeout)
 	}()
 
 	cc, err := c.realConn(ctx)
@@ -423,26 +617,37 @@ func (c *conn) NewStream(
 
 	ctx, sentMark := markContext(meta.WithTraceID(ctx, traceID))
 
-	ctx, cancel := c.childStreams.WithCancel(ctx)
-	defer func() {
-		if finalErr != nil {
-			cancel()
-		}
-	}()
+	ctx, cancelStream := c.childStreams.WithCancel(ctx)
+	cancel := func() {
+		cancelStream()
+		cancelTimeout()
+	}
 
 	s := &grpcClientStream{
 		parentConn:   c,
+		method:       trace.Method(method),
 		streamCtx:    ctx,
 		streamCancel: cancel,
 		wrapping:     useWrapping,
 		traceID:      traceID,
 		sentMark:     sentMark,
+		inFlightDone: c.takeInFlight(ctx),
+
+		inactivityTimeout: streamInactivityTimeout(ctx, c.config),
 	}
+	s.lastActivity.Store(time.Now().UnixNano())
+
+	defer func() {
+		if finalErr != nil {
+			cancel()
+			s.inFlightDone()
+		}
+	}()
 
 	s.stream, err = cc.NewStream(ctx, desc, method, append(opts, grpc.OnFinish(s.finish))...)
 	if err != nil {
 		if xerrors.IsContextError(err) {
-			return nil, xerrors.WithStackTrace(err)
+			return nil, xerrors.WithStackTrace(withDeadlineCause(ctx, err))
 		}
 
 		defer func() {
@@ -450,10 +655,12 @@ func (c *conn) NewStream(
 		}()
 
 		if useWrapping {
-			err = xerrors.Transport(err,
+			err = withDeadlineCause(ctx, xerrors.Transport(err,
 				xerrors.WithAddress(c.Address()),
+				xerrors.WithNodeID(c.NodeID()),
+				xerrors.WithLocation(c.endpoint.Location()),
 				xerrors.WithTraceID(traceID),
-			)
+			))
 			if sentMark.canRetry() {
 				return nil, c.wrapError(xerrors.Retryable(err, xerrors.WithName("NewStream")))
 			}
@@ -494,6 +701,22 @@ func withOnTransportError(onTransportError func(ctx context.Context, cc Conn, ca
 	}
 }
 
+func withOnDrain(onDrain func(ctx context.Context, cc Conn)) option {
+	return func(c *conn) {
+		if onDrain != nil {
+			c.onDrain = append(c.onDrain, onDrain)
+		}
+	}
+}
+
+func withOnInFlightDone(onInFlightDone func(*conn)) option {
+	return func(c *conn) {
+		if onInFlightDone != nil {
+			c.onInFlightDone = append(c.onInFlightDone, onInFlightDone)
+		}
+	}
+}
+
 func newConn(e endpoint.Endpoint, config Config, opts ...option) *conn {
 	c := &conn{
 		endpoint:     e,
@@ -523,15 +746,28 @@ func New(e endpoint.Endpoint, config Config, opts ...option) Conn {
 
 var _ stats.Handler = statsHandler{}
 
-type statsHandler struct{}
+// statsHandler marks calls as modified by sending of any data and counts sizes of messages on wire
+type statsHandler struct {
+	conn *conn
+}
 
 func (statsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
 	return ctx
 }
 
-func (statsHandler) HandleRPC(ctx context.Context, rpcStats stats.RPCStats) {
-	switch rpcStats.(type) {
+func (h statsHandler) HandleRPC(ctx context.Context, rpcStats stats.RPCStats) {
+	switch s := rpcStats.(type) {
 	case *stats.Begin, *stats.End:
+	case *stats.OutPayload:
+		getContextMark(ctx).markSent(s.WireLength)
+		if h.conn != nil {
+			h.conn.sentBytes.Add(uint64(s.WireLength))
+		}
+	case *stats.InPayload:
+		getContextMark(ctx).markReceived(s.WireLength)
+		if h.conn != nil {
+			h.conn.receivedBytes.Add(uint64(s.WireLength))
+		}
 	default:
 		getContextMark(ctx).markDirty()
 	}
@@ -562,8 +798,11 @@ func getContextMark(ctx context.Context) *modificationMark {
 	return v.(*modificationMark)
 }
 
+// modificationMark tracks sending of any data of call and sizes of messages of call on wire
 type modificationMark struct {
-	dirty atomic.Bool
+	dirty         atomic.Bool
+	sentBytes     atomic.Int64
+	receivedBytes atomic.Int64
 }
 
 func (m *modificationMark) canRetry() bool {
@@ -573,3 +812,21 @@ func (m *modificationMark) canRetry() bool {
 func (m *modificationMark) markDirty() {
 	m.dirty.Store(true)
 }
+
+func (m *modificationMark) markSent(size int) {
+	m.markDirty()
+	m.sentBytes.Add(int64(size))
+}
+
+func (m *modificationMark) markReceived(size int) {
+	m.markDirty()
+	m.receivedBytes.Add(int64(size))
+}
+
+func (m *modificationMark) sent() int {
+	return int(m.sentBytes.Load())
+}
+
+func (m *modificationMark) received() int {
+	return int(m.receivedBytes.Load())
+}
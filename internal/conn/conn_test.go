@@ -0,0 +1,210 @@
+package conn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type configStub struct {
+	trace *trace.Driver
+
+	defaultOperationTimeout time.Duration
+	defaultScanQueryTimeout time.Duration
+	defaultSchemeTimeout    time.Duration
+
+	streamMessageSizeSampling int
+	streamInactivityTimeout   time.Duration
+
+	grpcMaxRecvMsgSize int
+	grpcMaxSendMsgSize int
+
+	grpcDialOptions []grpc.DialOption
+}
+
+func (c configStub) DialTimeout() time.Duration {
+	return 0
+}
+
+func (c configStub) ConnectionTTL() time.Duration {
+	return 0
+}
+
+func (c configStub) Trace() *trace.Driver {
+	return c.trace
+}
+
+func (c configStub) GrpcDialOptions() []grpc.DialOption {
+	return c.grpcDialOptions
+}
+
+func (c configStub) DefaultOperationTimeout() time.Duration {
+	return c.defaultOperationTimeout
+}
+
+func (c configStub) DefaultScanQueryTimeout() time.Duration {
+	return c.defaultScanQueryTimeout
+}
+
+func (c configStub) DefaultSchemeTimeout() time.Duration {
+	return c.defaultSchemeTimeout
+}
+
+func (c configStub) StreamInactivityTimeout() time.Duration {
+	return c.streamInactivityTimeout
+}
+
+func (c configStub) StreamMessageSizeSampling() int {
+	return c.streamMessageSizeSampling
+}
+
+func (c configStub) GrpcMaxRecvMsgSize() int {
+	return c.grpcMaxRecvMsgSize
+}
+
+func (c configStub) GrpcMaxSendMsgSize() int {
+	return c.grpcMaxSendMsgSize
+}
+
+func TestConnInFlight(t *testing.T) {
+	var changes []int
+	p := NewPool(context.Background(), configStub{
+		trace: &trace.Driver{
+			OnConnInFlightChange: func(info trace.DriverConnInFlightChangeInfo) {
+				require.Equal(t, "a:2135", info.Endpoint.Address())
+				changes = append(changes, info.InFlight)
+			},
+		},
+	})
+	c, ok := p.Get(endpoint.New("a:2135")).(*conn)
+	require.True(t, ok)
+
+	first := c.takeInFlight(context.Background())
+	second := c.takeInFlight(context.Background())
+	require.Equal(t, 2, c.InFlight())
+
+	waiter, unsubscribe := p.InFlightDone()
+	defer unsubscribe()
+	select {
+	case <-waiter.Done():
+		t.Fatal("waiter must not be done before in-flight call done")
+	default:
+	}
+
+	first()
+	first()
+	require.Equal(t, 1, c.InFlight())
+	select {
+	case <-waiter.Done():
+	default:
+		t.Fatal("waiter must be done after in-flight call done")
+	}
+
+	second()
+	require.Equal(t, 0, c.InFlight())
+	require.Equal(t, []int{1, 2, 1, 0}, changes)
+}
+
+func TestConnDrain(t *testing.T) {
+	ctx := context.Background()
+	p := NewPool(ctx, configStub{trace: &trace.Driver{}})
+	var drained []string
+	p.OnDrain(func(ctx context.Context, cc Conn) {
+		drained = append(drained, cc.Endpoint().Address())
+	})
+	c, ok := p.Get(endpoint.New("127.0.0.1:1")).(*conn)
+	require.True(t, ok)
+
+	// conn without grpc connection has nothing to drain
+	c.checkShutdownHint(ctx, metadata.Pairs(meta.HeaderServerHints, meta.HintNodeShutdown))
+	require.Equal(t, Created, c.GetState())
+
+	cc, err := grpc.Dial("127.0.0.1:1", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	c.grpcConn = cc
+	c.setState(ctx, Online)
+
+	c.checkShutdownHint(ctx, metadata.Pairs(meta.HeaderServerHints, meta.HintSessionClose))
+	require.Equal(t, Online, c.GetState())
+
+	c.checkShutdownHint(ctx, metadata.Pairs(meta.HeaderServerHints, meta.HintNodeShutdown))
+	require.Equal(t, Draining, c.GetState())
+	require.Equal(t, []string{"127.0.0.1:1"}, drained)
+
+	// repeated hint and unban do not change draining conn
+	c.checkShutdownHint(ctx, metadata.Pairs(meta.HeaderServerHints, meta.HintNodeShutdown))
+	require.Equal(t, Draining, c.Unban(ctx))
+	require.Equal(t, []string{"127.0.0.1:1"}, drained)
+
+	require.NoError(t, c.Close(ctx))
+	require.Eventually(t, func() bool {
+		return !c.draining.Load()
+	}, time.Second, time.Millisecond)
+	require.Equal(t, Destroyed, c.GetState())
+}
+
+func TestIsGoAwayError(t *testing.T) {
+	for _, tt := range []struct {
+		err    error
+		goAway bool
+	}{
+		{
+			err:    xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "received prior goaway: code: NO_ERROR")),
+			goAway: true,
+		},
+		{
+			err:    xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "the connection is draining")),
+			goAway: true,
+		},
+		{
+			err:    xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "connection refused")),
+			goAway: false,
+		},
+		{
+			err:    xerrors.Transport(grpcStatus.Error(grpcCodes.Internal, "goaway")),
+			goAway: false,
+		},
+		{
+			err:    errors.New("goaway"),
+			goAway: false,
+		},
+	} {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			require.Equal(t, tt.goAway, isGoAwayError(tt.err))
+		})
+	}
+}
+
+func TestStatsHandlerTraffic(t *testing.T) {
+	c := newConn(endpoint.New("a:2135"), configStub{trace: &trace.Driver{}})
+	h := statsHandler{conn: c}
+	ctx, mark := markContext(context.Background())
+
+	h.HandleRPC(ctx, &stats.Begin{})
+	require.True(t, mark.canRetry())
+
+	h.HandleRPC(ctx, &stats.OutPayload{WireLength: 10})
+	require.False(t, mark.canRetry())
+	h.HandleRPC(ctx, &stats.InPayload{WireLength: 20})
+	h.HandleRPC(ctx, &stats.InPayload{WireLength: 30})
+	require.Equal(t, 10, mark.sent())
+	require.Equal(t, 50, mark.received())
+
+	// calls without mark are counted in traffic of conn only
+	h.HandleRPC(context.Background(), &stats.OutPayload{WireLength: 5})
+	require.Equal(t, Traffic{SentBytes: 15, ReceivedBytes: 50}, c.Traffic())
+}
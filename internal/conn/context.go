@@ -1,8 +1,15 @@
 package conn
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
-type ctxNoWrappingKey struct{}
+type (
+	ctxNoWrappingKey              struct{}
+	ctxStreamInactivityTimeoutKey struct{}
+	ctxStreamInactivityCheckKey   struct{}
+)
 
 func WithoutWrapping(ctx context.Context) context.Context {
 	return context.WithValue(ctx, ctxNoWrappingKey{}, true)
@@ -13,3 +20,28 @@ func UseWrapping(ctx context.Context) bool {
 
 	return !ok || !b
 }
+
+// WithStreamInactivityCheck marks ctx of stream which must be canceled after stream inactivity timeout
+// of driver config. Streams opened without such mark (for example, attach session stream of query
+// service or coordination session stream, which are idle by design) are never checked
+func WithStreamInactivityCheck(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxStreamInactivityCheckKey{}, true)
+}
+
+// WithStreamInactivityTimeout overrides stream inactivity timeout of driver config for streams
+// which are opened with ctx. Zero timeout disables checking of inactivity of streams
+func WithStreamInactivityTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, ctxStreamInactivityTimeoutKey{}, timeout)
+}
+
+func streamInactivityTimeout(ctx context.Context, config Config) time.Duration {
+	if timeout, ok := ctx.Value(ctxStreamInactivityTimeoutKey{}).(time.Duration); ok {
+		return timeout
+	}
+
+	if check, ok := ctx.Value(ctxStreamInactivityCheckKey{}).(bool); ok && check {
+		return config.StreamInactivityTimeout()
+	}
+
+	return 0
+}
@@ -0,0 +1,116 @@
+package conn
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+const schemeServicePrefix = "/Ydb.Scheme.V1.SchemeService/"
+
+var schemeMethods = map[string]struct{}{
+	Ydb_Table_V1.TableService_CreateTable_FullMethodName:        {},
+	Ydb_Table_V1.TableService_DropTable_FullMethodName:          {},
+	Ydb_Table_V1.TableService_AlterTable_FullMethodName:         {},
+	Ydb_Table_V1.TableService_CopyTable_FullMethodName:          {},
+	Ydb_Table_V1.TableService_CopyTables_FullMethodName:         {},
+	Ydb_Table_V1.TableService_RenameTables_FullMethodName:       {},
+	Ydb_Table_V1.TableService_DescribeTable_FullMethodName:      {},
+	Ydb_Table_V1.TableService_ExecuteSchemeQuery_FullMethodName: {},
+	Ydb_Topic_V1.TopicService_CreateTopic_FullMethodName:        {},
+	Ydb_Topic_V1.TopicService_AlterTopic_FullMethodName:         {},
+	Ydb_Topic_V1.TopicService_DropTopic_FullMethodName:          {},
+	Ydb_Topic_V1.TopicService_DescribeTopic_FullMethodName:      {},
+}
+
+// defaultTimeout returns default client-side timeout for call of method and source of its deadline.
+// Streams except of scan queries and queries of query service have no default timeout
+// because other streams (topic, coordination, read table) are long-lived
+func defaultTimeout(config Config, method string, stream bool) (time.Duration, xerrors.DeadlineSource) {
+	if stream {
+		switch method {
+		case Ydb_Table_V1.TableService_StreamExecuteScanQuery_FullMethodName:
+			return config.DefaultScanQueryTimeout(), xerrors.DeadlineSourceDefaultScanQueryTimeout
+		case Ydb_Query_V1.QueryService_ExecuteQuery_FullMethodName:
+			return config.DefaultOperationTimeout(), xerrors.DeadlineSourceDefaultOperationTimeout
+		default:
+			return 0, xerrors.DeadlineSourceCaller
+		}
+	}
+	if _, has := schemeMethods[method]; has || strings.HasPrefix(method, schemeServicePrefix) {
+		if timeout := config.DefaultSchemeTimeout(); timeout > 0 {
+			return timeout, xerrors.DeadlineSourceDefaultSchemeTimeout
+		}
+	}
+
+	return config.DefaultOperationTimeout(), xerrors.DeadlineSourceDefaultOperationTimeout
+}
+
+// withDefaultTimeout applies default timeout of method to context if context has no deadline
+// and no explicit operation timeout
+func withDefaultTimeout(
+	ctx context.Context, config Config, method string, stream bool,
+) (context.Context, context.CancelFunc) {
+	if _, has := ctx.Deadline(); has || operation.HasTimeout(ctx) {
+		return ctx, func() {}
+	}
+	if timeout, source := defaultTimeout(config, method, stream); timeout > 0 {
+		return xcontext.WithNamedTimeout(ctx, source, timeout)
+	}
+
+	return ctx, func() {}
+}
+
+// withDeadlineCause tags error of call interrupted by expired deadline of ctx with cause of deadline
+func withDeadlineCause(ctx context.Context, err error) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	cause, _ := xerrors.DeadlineCause(ctx.Err())
+
+	return xerrors.WithDeadlineCause(err, cause)
+}
+
+// deadlineCauseOf returns source and configured timeout of expired deadline which caused err
+// for trace events, source is empty if err is not caused by expired deadline
+func deadlineCauseOf(err error) (source string, timeout time.Duration) {
+	cause, has := xerrors.DeadlineCause(err)
+	if !has {
+		return "", 0
+	}
+
+	return cause.Source.String(), cause.Timeout
+}
+
+func deadline(ctx context.Context) time.Time {
+	d, _ := ctx.Deadline()
+
+	return d
+}
+
+// withOperationDeadlineCause tags operation error of expired server-side timeout of request
+// with timeout from operation params of request
+func withOperationDeadlineCause(req interface{}, err error) error {
+	r, ok := req.(interface {
+		GetOperationParams() *Ydb_Operations.OperationParams
+	})
+	if !ok {
+		return err
+	}
+	params := r.GetOperationParams()
+
+	return xerrors.WithOperationDeadlineCause(err,
+		params.GetOperationTimeout().AsDuration(),
+		params.GetCancelAfter().AsDuration(),
+	)
+}
@@ -0,0 +1,160 @@
+package conn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestDefaultTimeout(t *testing.T) {
+	config := configStub{
+		defaultOperationTimeout: 5 * time.Second,
+		defaultScanQueryTimeout: 300 * time.Second,
+		defaultSchemeTimeout:    30 * time.Second,
+	}
+	for _, tt := range []struct {
+		method  string
+		stream  bool
+		config  configStub
+		timeout time.Duration
+		source  xerrors.DeadlineSource
+	}{
+		{
+			method:  Ydb_Table_V1.TableService_ExecuteDataQuery_FullMethodName,
+			config:  config,
+			timeout: 5 * time.Second,
+			source:  xerrors.DeadlineSourceDefaultOperationTimeout,
+		},
+		{
+			method:  Ydb_Table_V1.TableService_CreateTable_FullMethodName,
+			config:  config,
+			timeout: 30 * time.Second,
+			source:  xerrors.DeadlineSourceDefaultSchemeTimeout,
+		},
+		{
+			method:  Ydb_Scheme_V1.SchemeService_ListDirectory_FullMethodName,
+			config:  config,
+			timeout: 30 * time.Second,
+			source:  xerrors.DeadlineSourceDefaultSchemeTimeout,
+		},
+		{
+			method:  Ydb_Topic_V1.TopicService_DescribeTopic_FullMethodName,
+			config:  configStub{defaultOperationTimeout: 5 * time.Second},
+			timeout: 5 * time.Second,
+			source:  xerrors.DeadlineSourceDefaultOperationTimeout,
+		},
+		{
+			method:  Ydb_Table_V1.TableService_StreamExecuteScanQuery_FullMethodName,
+			stream:  true,
+			config:  config,
+			timeout: 300 * time.Second,
+			source:  xerrors.DeadlineSourceDefaultScanQueryTimeout,
+		},
+		{
+			method:  Ydb_Query_V1.QueryService_ExecuteQuery_FullMethodName,
+			stream:  true,
+			config:  config,
+			timeout: 5 * time.Second,
+			source:  xerrors.DeadlineSourceDefaultOperationTimeout,
+		},
+		{
+			method:  Ydb_Topic_V1.TopicService_StreamRead_FullMethodName,
+			stream:  true,
+			config:  config,
+			timeout: 0,
+			source:  xerrors.DeadlineSourceCaller,
+		},
+	} {
+		t.Run(tt.method, func(t *testing.T) {
+			timeout, source := defaultTimeout(tt.config, tt.method, tt.stream)
+			require.Equal(t, tt.timeout, timeout)
+			require.Equal(t, tt.source, source)
+		})
+	}
+}
+
+func TestWithDefaultTimeout(t *testing.T) {
+	config := configStub{defaultOperationTimeout: 5 * time.Second}
+	method := Ydb_Table_V1.TableService_ExecuteDataQuery_FullMethodName
+	t.Run("Default", func(t *testing.T) {
+		ctx, cancel := withDefaultTimeout(context.Background(), config, method, false)
+		defer cancel()
+		require.WithinDuration(t, time.Now().Add(5*time.Second), deadline(ctx), time.Second)
+	})
+	t.Run("ContextDeadline", func(t *testing.T) {
+		parentCtx, parentCancel := context.WithTimeout(context.Background(), time.Minute)
+		defer parentCancel()
+		ctx, cancel := withDefaultTimeout(parentCtx, config, method, false)
+		defer cancel()
+		require.Equal(t, deadline(parentCtx), deadline(ctx))
+	})
+	t.Run("OperationTimeout", func(t *testing.T) {
+		ctx, cancel := withDefaultTimeout(
+			operation.WithTimeout(context.Background(), time.Minute), config, method, false,
+		)
+		defer cancel()
+		require.True(t, deadline(ctx).IsZero())
+	})
+	t.Run("NoDefault", func(t *testing.T) {
+		ctx, cancel := withDefaultTimeout(context.Background(), configStub{}, method, false)
+		defer cancel()
+		require.True(t, deadline(ctx).IsZero())
+	})
+}
+
+func TestWithDeadlineCause(t *testing.T) {
+	config := configStub{defaultOperationTimeout: time.Millisecond}
+	method := Ydb_Table_V1.TableService_ExecuteDataQuery_FullMethodName
+	t.Run("DefaultTimeout", func(t *testing.T) {
+		ctx, cancel := withDefaultTimeout(context.Background(), config, method, false)
+		defer cancel()
+		<-ctx.Done()
+		cause, ok := xerrors.DeadlineCause(withDeadlineCause(ctx, xerrors.WithStackTrace(ctx.Err())))
+		require.True(t, ok)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceDefaultOperationTimeout, Timeout: time.Millisecond}, cause)
+	})
+	t.Run("Caller", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		<-ctx.Done()
+		cause, ok := xerrors.DeadlineCause(withDeadlineCause(ctx, xerrors.Transport(ctx.Err())))
+		require.True(t, ok)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceCaller}, cause)
+	})
+	t.Run("NotExpired", func(t *testing.T) {
+		err := errors.New("test")
+		require.Equal(t, err, withDeadlineCause(context.Background(), err))
+	})
+}
+
+func TestWithOperationDeadlineCause(t *testing.T) {
+	err := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_TIMEOUT))
+	t.Run("OperationTimeout", func(t *testing.T) {
+		req := &Ydb_Table.ExecuteDataQueryRequest{
+			OperationParams: &Ydb_Operations.OperationParams{
+				OperationTimeout: durationpb.New(time.Second),
+			},
+		}
+		cause, ok := xerrors.DeadlineCause(withOperationDeadlineCause(req, err))
+		require.True(t, ok)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceOperationTimeout, Timeout: time.Second}, cause)
+	})
+	t.Run("NoOperationParams", func(t *testing.T) {
+		_, ok := xerrors.DeadlineCause(withOperationDeadlineCause(&Ydb_Table.ExecuteDataQueryRequest{}, err))
+		require.False(t, ok)
+	})
+}
@@ -1,6 +1,19 @@
 package conn
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrStreamInactivityTimeout is returned from RecvMsg of stream which received no messages
+// during stream inactivity timeout
+var ErrStreamInactivityTimeout = xerrors.Wrap(errors.New("stream inactivity timeout"))
 
 type connError struct {
 	nodeID   uint32
@@ -23,3 +36,16 @@ func (n connError) Error() string {
 func (n connError) Unwrap() error {
 	return n.err
 }
+
+// withMessageSizeLimits adds configured limits of grpc message sizes to error about too large message
+func withMessageSizeLimits(err error, config Config) error {
+	s, ok := grpcStatus.FromError(err)
+	if !ok || s.Code() != grpcCodes.ResourceExhausted || !strings.Contains(s.Message(), "larger than max") {
+		return err
+	}
+
+	return fmt.Errorf("%w (configured grpc message size limits: receive %d bytes, send %d bytes,"+
+		" use ydb.WithGrpcMaxRecvMsgSize or ydb.WithGrpcMaxSendMsgSize for change)",
+		err, config.GrpcMaxRecvMsgSize(), config.GrpcMaxSendMsgSize(),
+	)
+}
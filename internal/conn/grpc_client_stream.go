@@ -2,11 +2,16 @@ package conn
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sync/atomic"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -17,12 +22,18 @@ import (
 
 type grpcClientStream struct {
 	parentConn   *conn
+	method       trace.Method
 	stream       grpc.ClientStream
 	streamCtx    context.Context //nolint:containedctx
 	streamCancel context.CancelFunc
 	wrapping     bool
 	traceID      string
 	sentMark     *modificationMark
+	inFlightDone func()
+	messages     atomic.Uint64
+
+	inactivityTimeout time.Duration
+	lastActivity      atomic.Int64 // unix time in nanoseconds of the last received message or opening of stream
 }
 
 func (s *grpcClientStream) Header() (metadata.MD, error) {
@@ -63,6 +74,8 @@ func (s *grpcClientStream) CloseSend() (err error) {
 				xerrors.Transport(
 					err,
 					xerrors.WithAddress(s.parentConn.Address()),
+					xerrors.WithNodeID(s.parentConn.NodeID()),
+					xerrors.WithLocation(s.parentConn.endpoint.Location()),
 					xerrors.WithTraceID(s.traceID),
 				),
 			)
@@ -88,8 +101,12 @@ func (s *grpcClientStream) SendMsg(m interface{}) (err error) {
 	stop := s.parentConn.lastUsage.Start()
 	defer stop()
 
+	sentBytes := s.sentMark.sent()
+
 	err = s.stream.SendMsg(m)
 
+	s.onMessage(true, s.sentMark.sent()-sentBytes)
+
 	if err != nil {
 		if xerrors.IsContextError(err) {
 			return xerrors.WithStackTrace(err)
@@ -100,8 +117,10 @@ func (s *grpcClientStream) SendMsg(m interface{}) (err error) {
 		}()
 
 		if s.wrapping {
-			err = xerrors.Transport(err,
+			err = xerrors.Transport(withMessageSizeLimits(err, s.parentConn.config),
 				xerrors.WithAddress(s.parentConn.Address()),
+				xerrors.WithNodeID(s.parentConn.NodeID()),
+				xerrors.WithLocation(s.parentConn.endpoint.Location()),
 				xerrors.WithTraceID(s.traceID),
 			)
 			if s.sentMark.canRetry() {
@@ -120,9 +139,30 @@ func (s *grpcClientStream) SendMsg(m interface{}) (err error) {
 }
 
 func (s *grpcClientStream) finish(err error) {
+	// error of finished stream comes from grpc as is, so cause of deadline is taken from stream context
+	// before stream context is canceled
+	deadlineSource, deadlineTimeout := deadlineCauseOf(withDeadlineCause(s.streamCtx, err))
 	s.streamCancel()
+	s.inFlightDone()
 	trace.DriverOnConnStreamFinish(s.parentConn.config.Trace(), s.streamCtx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/conn.(*grpcClientStream).finish"), err,
+		s.sentMark.sent(), s.sentMark.received(), deadlineSource, deadlineTimeout,
+	)
+}
+
+// onMessage reports size of every n-th sent or received message of stream (n is sampling rate from config)
+func (s *grpcClientStream) onMessage(sent bool, size int) {
+	t := s.parentConn.config.Trace()
+	if size == 0 || t == nil || t.OnConnStreamMessageSize == nil {
+		return
+	}
+	sampling := s.parentConn.config.StreamMessageSizeSampling()
+	if sampling <= 0 || (s.messages.Add(1)-1)%uint64(sampling) != 0 {
+		return
+	}
+	trace.DriverOnConnStreamMessageSize(t, s.streamCtx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/conn.(*grpcClientStream).onMessage"),
+		s.parentConn.endpoint, s.method, sent, size,
 	)
 }
 
@@ -137,17 +177,28 @@ func (s *grpcClientStream) RecvMsg(m interface{}) (err error) {
 		onDone(err)
 		if err != nil {
 			meta.CallTrailerCallback(s.streamCtx, s.stream.Trailer())
+			s.parentConn.checkShutdownHint(s.streamCtx, s.stream.Trailer())
 		}
 	}()
 
 	stop := s.parentConn.lastUsage.Start()
 	defer stop()
 
+	receivedBytes := s.sentMark.received()
+
+	stopWatchdog := s.watchInactivity()
+
 	err = s.stream.RecvMsg(m)
 
+	if stopWatchdog() {
+		return s.inactivityError()
+	}
+
+	s.onMessage(false, s.sentMark.received()-receivedBytes)
+
 	if err != nil { //nolint:nestif
 		if xerrors.IsContextError(err) {
-			return xerrors.WithStackTrace(err)
+			return xerrors.WithStackTrace(withDeadlineCause(s.streamCtx, err))
 		}
 
 		defer func() {
@@ -157,9 +208,12 @@ func (s *grpcClientStream) RecvMsg(m interface{}) (err error) {
 		}()
 
 		if s.wrapping {
-			err = xerrors.Transport(err,
+			err = withDeadlineCause(s.streamCtx, xerrors.Transport(withMessageSizeLimits(err, s.parentConn.config),
 				xerrors.WithAddress(s.parentConn.Address()),
-			)
+				xerrors.WithNodeID(s.parentConn.NodeID()),
+				xerrors.WithLocation(s.parentConn.endpoint.Location()),
+				xerrors.WithTraceID(s.traceID),
+			))
 			if s.sentMark.canRetry() {
 				return s.wrapError(xerrors.Retryable(err,
 					xerrors.WithName("RecvMsg"),
@@ -172,6 +226,10 @@ func (s *grpcClientStream) RecvMsg(m interface{}) (err error) {
 		return err
 	}
 
+	if s.inactivityTimeout > 0 {
+		s.lastActivity.Store(time.Now().UnixNano())
+	}
+
 	if s.wrapping {
 		if operation, ok := m.(wrap.StreamOperationResponse); ok {
 			if status := operation.GetStatus(); status != Ydb.StatusIds_SUCCESS {
@@ -179,6 +237,8 @@ func (s *grpcClientStream) RecvMsg(m interface{}) (err error) {
 					xerrors.Operation(
 						xerrors.FromOperation(operation),
 						xerrors.WithAddress(s.parentConn.Address()),
+						xerrors.WithNodeID(s.parentConn.NodeID()),
+						xerrors.WithLocation(s.parentConn.endpoint.Location()),
 					),
 				)
 			}
@@ -188,6 +248,44 @@ func (s *grpcClientStream) RecvMsg(m interface{}) (err error) {
 	return nil
 }
 
+// watchInactivity cancels stream if RecvMsg waits for message longer than stream inactivity timeout.
+// Returned stop func reports whether stream was canceled by watchdog
+func (s *grpcClientStream) watchInactivity() (stop func() (inactive bool)) {
+	if s.inactivityTimeout <= 0 {
+		return func() bool { return false }
+	}
+	watchdog := time.AfterFunc(s.inactivityTimeout, func() {
+		trace.DriverOnConnStreamInactivityTimeout(s.parentConn.config.Trace(), s.streamCtx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/conn.(*grpcClientStream).watchInactivity"),
+			s.parentConn.endpoint, s.method, time.Unix(0, s.lastActivity.Load()), s.inactivityTimeout,
+		)
+		s.streamCancel()
+	})
+
+	return func() bool {
+		return !watchdog.Stop()
+	}
+}
+
+// inactivityError returns transport error with code Unavailable which is retryable for idempotent operations
+func (s *grpcClientStream) inactivityError() error {
+	err := xerrors.Transport(
+		fmt.Errorf("%w: %w", ErrStreamInactivityTimeout, grpcStatus.Errorf(grpcCodes.Unavailable,
+			"no messages of stream %q since %s during %v", s.method,
+			time.Unix(0, s.lastActivity.Load()).Format(time.RFC3339Nano), s.inactivityTimeout,
+		)),
+		xerrors.WithAddress(s.parentConn.Address()),
+		xerrors.WithNodeID(s.parentConn.NodeID()),
+		xerrors.WithLocation(s.parentConn.endpoint.Location()),
+		xerrors.WithTraceID(s.traceID),
+	)
+	if s.wrapping {
+		return s.wrapError(err)
+	}
+
+	return err
+}
+
 func (s *grpcClientStream) wrapError(err error) error {
 	if err == nil {
 		return nil
@@ -0,0 +1,377 @@
+package conn
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// clientStreamStub emulates grpc stream which reports sizes of messages to stats handler
+type clientStreamStub struct {
+	grpc.ClientStream
+
+	ctx     context.Context //nolint:containedctx
+	handler stats.Handler
+	size    int
+}
+
+func (s *clientStreamStub) SendMsg(interface{}) error {
+	s.handler.HandleRPC(s.ctx, &stats.OutPayload{WireLength: s.size})
+
+	return nil
+}
+
+func (s *clientStreamStub) RecvMsg(interface{}) error {
+	if s.size == 0 {
+		return io.EOF
+	}
+	s.handler.HandleRPC(s.ctx, &stats.InPayload{WireLength: s.size * 2})
+
+	return nil
+}
+
+const streamStubMethod = "/Ydb.Table.V1.TableService/StreamExecuteScanQuery"
+
+func newStreamStub(config configStub, size int) *grpcClientStream {
+	c := newConn(endpoint.New("a:2135"), config)
+	ctx, mark := markContext(context.Background())
+
+	return &grpcClientStream{
+		parentConn:   c,
+		method:       streamStubMethod,
+		streamCtx:    ctx,
+		streamCancel: func() {},
+		sentMark:     mark,
+		inFlightDone: func() {},
+		stream: &clientStreamStub{
+			ctx:     ctx,
+			handler: statsHandler{conn: c},
+			size:    size,
+		},
+	}
+}
+
+func TestGrpcClientStreamMessageSize(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		sampling int
+		sizes    []int
+	}{
+		{
+			name:     "NoSampling",
+			sampling: 0,
+		},
+		{
+			name:     "EveryMessage",
+			sampling: 1,
+			sizes:    []int{10, 20, 10, 20},
+		},
+		{
+			name:     "EverySecondMessage",
+			sampling: 2,
+			sizes:    []int{10, 10},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				sizes     []int
+				finish    trace.DriverConnStreamFinishInfo
+				sentFlags []bool
+			)
+			s := newStreamStub(configStub{
+				trace: &trace.Driver{
+					OnConnStreamMessageSize: func(info trace.DriverConnStreamMessageSizeInfo) {
+						require.Equal(t, "a:2135", info.Endpoint.Address())
+						require.Equal(t, trace.Method(streamStubMethod), info.Method)
+						sizes = append(sizes, info.Size)
+						sentFlags = append(sentFlags, info.Sent)
+					},
+					OnConnStreamFinish: func(info trace.DriverConnStreamFinishInfo) {
+						finish = info
+					},
+				},
+				streamMessageSizeSampling: tt.sampling,
+			}, 10)
+			for i := 0; i < 2; i++ {
+				require.NoError(t, s.SendMsg(nil))
+				require.NoError(t, s.RecvMsg(nil))
+			}
+			s.finish(nil)
+			require.Equal(t, tt.sizes, sizes)
+			for i, sent := range sentFlags {
+				require.Equal(t, tt.sizes[i] == 10, sent)
+			}
+			require.Equal(t, 20, finish.SentBytes)
+			require.Equal(t, 40, finish.ReceivedBytes)
+			require.Equal(t, Traffic{SentBytes: 20, ReceivedBytes: 40}, s.parentConn.Traffic())
+		})
+	}
+}
+
+// silentStreamStub emulates grpc stream which receives messages with delay or hangs if delay is negative
+type silentStreamStub struct {
+	grpc.ClientStream
+
+	ctx   context.Context //nolint:containedctx
+	delay time.Duration
+}
+
+func (s *silentStreamStub) RecvMsg(interface{}) error {
+	if s.delay < 0 {
+		<-s.ctx.Done()
+
+		return grpcStatus.Error(grpcCodes.Canceled, s.ctx.Err().Error())
+	}
+	time.Sleep(s.delay)
+
+	return nil
+}
+
+func (s *silentStreamStub) Trailer() metadata.MD {
+	return nil
+}
+
+func TestGrpcClientStreamInactivityTimeout(t *testing.T) {
+	newSilentStream := func(t *testing.T, delay time.Duration, trace *trace.Driver) *grpcClientStream {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		ctx, mark := markContext(ctx)
+		s := &grpcClientStream{
+			parentConn:        newConn(endpoint.New("a:2135"), configStub{trace: trace}),
+			method:            streamStubMethod,
+			streamCtx:         ctx,
+			streamCancel:      cancel,
+			wrapping:          true,
+			sentMark:          mark,
+			inFlightDone:      func() {},
+			stream:            &silentStreamStub{ctx: ctx, delay: delay},
+			inactivityTimeout: 50 * time.Millisecond,
+		}
+		s.lastActivity.Store(time.Now().UnixNano())
+
+		return s
+	}
+	t.Run("Silent", func(t *testing.T) {
+		var timeouts []trace.DriverConnStreamInactivityTimeoutInfo
+		s := newSilentStream(t, -1, &trace.Driver{
+			OnConnStreamInactivityTimeout: func(info trace.DriverConnStreamInactivityTimeoutInfo) {
+				timeouts = append(timeouts, info)
+			},
+		})
+		opened := time.Unix(0, s.lastActivity.Load())
+		err := s.RecvMsg(nil)
+		require.ErrorIs(t, err, ErrStreamInactivityTimeout)
+		require.True(t, xerrors.IsTransportError(err, grpcCodes.Unavailable))
+		_, errType, _, _ := xerrors.Check(err)
+		require.Equal(t, xerrors.TypeConditionallyRetryable, errType)
+		require.ErrorIs(t, s.streamCtx.Err(), context.Canceled)
+		require.Len(t, timeouts, 1)
+		require.Equal(t, "a:2135", timeouts[0].Endpoint.Address())
+		require.Equal(t, trace.Method(streamStubMethod), timeouts[0].Method)
+		require.Equal(t, opened, timeouts[0].LastActivity)
+		require.Equal(t, 50*time.Millisecond, timeouts[0].Timeout)
+	})
+	t.Run("Active", func(t *testing.T) {
+		s := newSilentStream(t, time.Millisecond, &trace.Driver{
+			OnConnStreamInactivityTimeout: func(info trace.DriverConnStreamInactivityTimeoutInfo) {
+				t.Fatal("unexpected inactivity timeout")
+			},
+		})
+		opened := s.lastActivity.Load()
+		for i := 0; i < 5; i++ {
+			require.NoError(t, s.RecvMsg(nil))
+		}
+		require.Greater(t, s.lastActivity.Load(), opened)
+		require.NoError(t, s.streamCtx.Err())
+	})
+	t.Run("ContextOverride", func(t *testing.T) {
+		config := configStub{streamInactivityTimeout: time.Minute}
+		require.Zero(t, streamInactivityTimeout(context.Background(), config))
+		require.Equal(t, time.Minute, streamInactivityTimeout(WithStreamInactivityCheck(context.Background()), config))
+		require.Equal(t, time.Second, streamInactivityTimeout(
+			WithStreamInactivityTimeout(context.Background(), time.Second), config,
+		))
+		require.Zero(t, streamInactivityTimeout(WithStreamInactivityTimeout(context.Background(), 0), config))
+	})
+}
+
+// BenchmarkGrpcClientStreamMessageSize compares overhead of accounting of sizes of stream messages
+// without trace and with sampled trace callback
+func BenchmarkGrpcClientStreamMessageSize(b *testing.B) {
+	for _, bb := range []struct {
+		name  string
+		trace *trace.Driver
+	}{
+		{
+			name:  "NoTrace",
+			trace: &trace.Driver{},
+		},
+		{
+			name: "Trace",
+			trace: &trace.Driver{
+				OnConnStreamMessageSize: func(trace.DriverConnStreamMessageSizeInfo) {},
+			},
+		},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			s := newStreamStub(configStub{
+				trace:                     bb.trace,
+				streamMessageSizeSampling: 100,
+			}, 10)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = s.RecvMsg(nil)
+			}
+		})
+	}
+}
+
+func TestConnStreamInactivityTimeout(t *testing.T) {
+	const timeout = 50 * time.Millisecond
+	listener := bufconn.Listen(1 << 20)
+	release := make(chan struct{})
+	server := grpc.NewServer(grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+		// stream stays idle until release, as attach session stream of idle query session
+		select {
+		case <-release:
+			return stream.SendMsg(&emptypb.Empty{})
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	var timeouts []trace.DriverConnStreamInactivityTimeoutInfo
+	c := newConn(endpoint.New("bufnet"), configStub{
+		trace: &trace.Driver{
+			OnConnStreamInactivityTimeout: func(info trace.DriverConnStreamInactivityTimeoutInfo) {
+				timeouts = append(timeouts, info)
+			},
+		},
+		streamInactivityTimeout: timeout,
+		grpcDialOptions: []grpc.DialOption{
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	})
+	defer func() {
+		_ = c.Close(context.Background())
+	}()
+	newStream := func(ctx context.Context, method string) grpc.ClientStream {
+		stream, err := c.NewStream(WithoutWrapping(ctx), &grpc.StreamDesc{ServerStreams: true}, method)
+		require.NoError(t, err)
+		require.NoError(t, stream.SendMsg(&emptypb.Empty{}))
+		require.NoError(t, stream.CloseSend())
+
+		return stream
+	}
+	t.Run("IdleQuerySession", func(t *testing.T) {
+		stream := newStream(xtest.Context(t), "/Ydb.Query.V1.QueryService/AttachSession")
+		time.AfterFunc(4*timeout, func() {
+			release <- struct{}{}
+		})
+		require.NoError(t, stream.RecvMsg(&emptypb.Empty{}))
+		require.Empty(t, timeouts)
+	})
+	t.Run("OptedIn", func(t *testing.T) {
+		stream := newStream(WithStreamInactivityCheck(xtest.Context(t)), streamStubMethod)
+		err := stream.RecvMsg(&emptypb.Empty{})
+		require.ErrorIs(t, err, ErrStreamInactivityTimeout)
+		require.Len(t, timeouts, 1)
+		require.Equal(t, trace.Method(streamStubMethod), timeouts[0].Method)
+	})
+}
+
+func TestConnDeadlineCauseInTrace(t *testing.T) {
+	const timeout = 50 * time.Millisecond
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+		// server never responds, so calls are interrupted by default timeouts
+		<-stream.Context().Done()
+
+		return stream.Context().Err()
+	}))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	var (
+		invoke    trace.DriverConnInvokeDoneInfo
+		newStream trace.DriverConnNewStreamDoneInfo
+		finish    trace.DriverConnStreamFinishInfo
+	)
+	c := newConn(endpoint.New("bufnet"), configStub{
+		trace: &trace.Driver{
+			OnConnInvoke: func(trace.DriverConnInvokeStartInfo) func(trace.DriverConnInvokeDoneInfo) {
+				return func(info trace.DriverConnInvokeDoneInfo) {
+					invoke = info
+				}
+			},
+			OnConnNewStream: func(trace.DriverConnNewStreamStartInfo) func(trace.DriverConnNewStreamDoneInfo) {
+				return func(info trace.DriverConnNewStreamDoneInfo) {
+					newStream = info
+				}
+			},
+			OnConnStreamFinish: func(info trace.DriverConnStreamFinishInfo) {
+				finish = info
+			},
+		},
+		defaultOperationTimeout: timeout,
+		defaultScanQueryTimeout: 2 * timeout,
+		grpcDialOptions: []grpc.DialOption{
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	})
+	defer func() {
+		_ = c.Close(context.Background())
+	}()
+	t.Run("Invoke", func(t *testing.T) {
+		err := c.Invoke(xtest.Context(t), "/Ydb.Table.V1.TableService/ExecuteDataQuery",
+			&emptypb.Empty{}, &emptypb.Empty{},
+		)
+		require.Error(t, err)
+		require.Equal(t, err, invoke.Error)
+		require.Equal(t, xerrors.DeadlineSourceDefaultOperationTimeout.String(), invoke.DeadlineSource)
+		require.Equal(t, timeout, invoke.DeadlineTimeout)
+	})
+	t.Run("Stream", func(t *testing.T) {
+		stream, err := c.NewStream(xtest.Context(t), &grpc.StreamDesc{ServerStreams: true}, streamStubMethod)
+		require.NoError(t, err)
+		require.NoError(t, newStream.Error)
+		require.Empty(t, newStream.DeadlineSource)
+		require.Zero(t, newStream.DeadlineTimeout)
+		require.NoError(t, stream.SendMsg(&emptypb.Empty{}))
+		require.NoError(t, stream.CloseSend())
+		require.Error(t, stream.RecvMsg(&emptypb.Empty{}))
+		require.Error(t, finish.Error)
+		require.Equal(t, xerrors.DeadlineSourceDefaultScanQueryTimeout.String(), finish.DeadlineSource)
+		require.Equal(t, 2*timeout, finish.DeadlineTimeout)
+	})
+}
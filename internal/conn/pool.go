@@ -30,6 +30,11 @@ type Pool struct {
 	opts   []grpc.DialOption
 	conns  map[connsKey]*conn
 	done   chan struct{}
+
+	inFlightDone    xsync.EventBroadcast
+	inFlightWaiters atomic.Int64
+
+	onDrain []func(ctx context.Context, cc Conn)
 }
 
 func (p *Pool) Get(endpoint endpoint.Endpoint) Conn {
@@ -53,6 +58,12 @@ func (p *Pool) Get(endpoint endpoint.Endpoint) Conn {
 		p.config,
 		withOnClose(p.remove),
 		withOnTransportError(p.Ban),
+		withOnDrain(p.drained),
+		withOnInFlightDone(func(*conn) {
+			if p.inFlightWaiters.Load() > 0 {
+				p.inFlightDone.Broadcast()
+			}
+		}),
 	)
 
 	p.conns[key] = cc
@@ -60,6 +71,33 @@ func (p *Pool) Get(endpoint endpoint.Endpoint) Conn {
 	return cc
 }
 
+// InFlightDone returns waiter which will be done when any in-flight call of any conn of pool is done.
+// Client code must subscribe at first, then check in-flight calls of conns and call unsubscribe after waiting
+func (p *Pool) InFlightDone() (waiter xsync.OneTimeWaiter, unsubscribe func()) {
+	p.inFlightWaiters.Add(1)
+
+	return p.inFlightDone.Waiter(), func() {
+		p.inFlightWaiters.Add(-1)
+	}
+}
+
+// OnDrain registers callback which is called when conn of pool becomes draining because of node shutdown
+func (p *Pool) OnDrain(onDrain func(ctx context.Context, cc Conn)) {
+	p.mtx.WithLock(func() {
+		p.onDrain = append(p.onDrain, onDrain)
+	})
+}
+
+func (p *Pool) drained(ctx context.Context, cc Conn) {
+	var onDrain []func(ctx context.Context, cc Conn)
+	p.mtx.WithRLock(func() {
+		onDrain = p.onDrain
+	})
+	for _, f := range onDrain {
+		f(ctx, cc)
+	}
+}
+
 func (p *Pool) remove(c *conn) {
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
@@ -9,6 +9,7 @@ const (
 	Banned
 	Offline
 	Destroyed
+	Draining
 )
 
 func (s State) Code() int {
@@ -27,6 +28,8 @@ func (s State) String() string {
 		return "offline"
 	case Destroyed:
 		return "destroyed"
+	case Draining:
+		return "draining"
 	default:
 		return "unknown"
 	}
@@ -388,6 +388,28 @@ func (c *Client) Session(
 	return createSession(ctx, c, path, newCreateSessionConfig(opts...))
 }
 
+func (c *Client) Membership(
+	ctx context.Context,
+	path string,
+	groupName string,
+	data []byte,
+	opts ...options.SessionOption,
+) (coordination.Membership, error) {
+	s, err := c.Session(ctx, path, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	m, err := newMembership(ctx, s, groupName, data)
+	if err != nil {
+		_ = s.Close(ctx)
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return m, nil
+}
+
 func (c *Client) Close(ctx context.Context) (finalErr error) {
 	if c == nil {
 		return xerrors.WithStackTrace(errNilClient)
@@ -100,6 +100,7 @@ type Controller struct {
 
 	notifyChan chan struct{}
 	closed     bool
+	closeErr   error // the error returned for conversations after close, ErrSessionClosed if nil
 }
 
 // ResponseFilter defines the filter function called by the controller to know if a received message relates to the
@@ -218,7 +219,7 @@ func (c *Controller) PushBack(conversation *Conversation) error {
 	defer c.mutex.Unlock()
 
 	if c.closed {
-		return coordination.ErrSessionClosed
+		return c.closeError()
 	}
 
 	conversation.enqueue()
@@ -234,7 +235,7 @@ func (c *Controller) PushFront(conversation *Conversation) error {
 	defer c.mutex.Unlock()
 
 	if c.closed {
-		return coordination.ErrSessionClosed
+		return c.closeError()
 	}
 
 	conversation.enqueue()
@@ -390,15 +391,24 @@ func (c *Controller) OnDetach() {
 // Close fails all conversations if there are any in the queue. It also does not allow pushing more conversations to the
 // queue anymore. You may optionally specify the final conversation if needed.
 func (c *Controller) Close(byeConversation *Conversation) {
+	c.CloseWithError(byeConversation, nil)
+}
+
+// CloseWithError is like Close but fails conversations with the specified error. If err is nil, ErrSessionClosed is
+// used.
+func (c *Controller) CloseWithError(byeConversation *Conversation, err error) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
 	c.closed = true
+	if err != nil && c.closeErr == nil {
+		c.closeErr = err
+	}
 
 	for i := len(c.queue) - 1; i >= 0; i-- {
 		req := c.queue[i]
 		if !req.canceled {
-			req.fail(coordination.ErrSessionClosed)
+			req.fail(c.closeError())
 		}
 	}
 
@@ -410,6 +420,14 @@ func (c *Controller) Close(byeConversation *Conversation) {
 	c.notify()
 }
 
+func (c *Controller) closeError() error {
+	if c.closeErr != nil {
+		return c.closeErr
+	}
+
+	return coordination.ErrSessionClosed
+}
+
 // OnAttach retries all idempotent conversations if there are any in the queue. You should call this method when the
 // underlying gRPC stream of the session is connected.
 func (c *Controller) OnAttach() {
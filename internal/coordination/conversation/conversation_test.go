@@ -0,0 +1,57 @@
+package conversation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+)
+
+func TestControllerClose(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		err  error
+		exp  error
+	}{
+		{
+			name: "Closed",
+			err:  nil,
+			exp:  coordination.ErrSessionClosed,
+		},
+		{
+			name: "Expired",
+			err:  coordination.ErrSessionExpired,
+			exp:  coordination.ErrSessionExpired,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			controller := NewController()
+			newPing := func() *Conversation {
+				return NewConversation(func() *Ydb_Coordination.SessionRequest {
+					return &Ydb_Coordination.SessionRequest{
+						Request: &Ydb_Coordination.SessionRequest_Ping{
+							Ping: &Ydb_Coordination.SessionRequest_PingPong{},
+						},
+					}
+				})
+			}
+
+			pending := newPing()
+			require.NoError(t, controller.PushBack(pending))
+
+			controller.CloseWithError(nil, tt.err)
+
+			_, err := controller.Await(ctx, pending)
+			require.ErrorIs(t, err, tt.exp)
+			require.ErrorIs(t, err, coordination.ErrSessionClosed)
+
+			err = controller.PushBack(newPing())
+			require.ErrorIs(t, err, tt.exp)
+			require.ErrorIs(t, controller.PushFront(newPing()), tt.exp)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package coordination
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type membership struct {
+	session   coordination.Session
+	groupName string
+
+	ctx     context.Context //nolint:containedctx
+	cancel  context.CancelFunc
+	done    chan struct{}
+	changed chan struct{}
+
+	mutex   sync.Mutex // guards the fields below
+	self    coordination.Member
+	members []coordination.Member
+}
+
+func newMembership(
+	ctx context.Context,
+	s coordination.Session,
+	groupName string,
+	data []byte,
+) (*membership, error) {
+	m := &membership{
+		session:   s,
+		groupName: groupName,
+		done:      make(chan struct{}),
+		changed:   make(chan struct{}, 1),
+		self: coordination.Member{
+			SessionID: s.SessionID(),
+			Data:      data,
+		},
+	}
+
+	if err := m.acquire(ctx, data); err != nil {
+		return nil, err
+	}
+
+	desc, changed, err := coordination.WatchSemaphore(ctx, s, groupName, options.WithDescribeOwners(true))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	m.setMembers(desc)
+
+	m.ctx, m.cancel = xcontext.WithCancel(s.Context())
+	go m.watchLoop(changed)
+
+	return m, nil
+}
+
+// acquire joins the group or replaces data of the current member.
+func (m *membership) acquire(ctx context.Context, data []byte) error {
+	_, err := m.session.AcquireSemaphore(ctx, m.groupName, coordination.Shared,
+		options.WithEphemeral(true),
+		options.WithAcquireData(data),
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.self.Data = data
+
+	return nil
+}
+
+// watchLoop refreshes the list of members after every notification of the watch. The watch is notified also after
+// reconnect of the session stream, so changes missed during reconnect are received with the next describe.
+func (m *membership) watchLoop(changed <-chan struct{}) {
+	defer close(m.done)
+	defer m.cancel()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-changed:
+		}
+
+		var (
+			desc *coordination.SemaphoreDescription
+			err  error
+		)
+		desc, changed, err = coordination.WatchSemaphore(m.ctx, m.session, m.groupName, options.WithDescribeOwners(true))
+		if err != nil {
+			// Describe requests are retried by the session, so the error means that the session is closed or lost.
+			return
+		}
+		if m.setMembers(desc) {
+			select {
+			case m.changed <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// setMembers returns true if the list of members is changed.
+func (m *membership) setMembers(desc *coordination.SemaphoreDescription) bool {
+	owners := make([]*coordination.SemaphoreSession, len(desc.Owners))
+	copy(owners, desc.Owners)
+	sort.Slice(owners, func(i, j int) bool {
+		return owners[i].OrderID < owners[j].OrderID
+	})
+
+	members := make([]coordination.Member, 0, len(owners))
+	for _, owner := range owners {
+		members = append(members, coordination.Member{
+			SessionID: owner.SessionID,
+			Data:      owner.Data,
+		})
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if equalMembers(m.members, members) {
+		return false
+	}
+	m.members = members
+
+	return true
+}
+
+func equalMembers(lhs, rhs []coordination.Member) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i].SessionID != rhs[i].SessionID || string(lhs[i].Data) != string(rhs[i].Data) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *membership) Members() []coordination.Member {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	members := make([]coordination.Member, len(m.members))
+	copy(members, m.members)
+
+	return members
+}
+
+func (m *membership) Changed() <-chan struct{} {
+	return m.changed
+}
+
+func (m *membership) Self() coordination.Member {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.self
+}
+
+func (m *membership) Update(ctx context.Context, data []byte) error {
+	if m.ctx.Err() != nil {
+		return xerrors.WithStackTrace(coordination.ErrSessionClosed)
+	}
+
+	return m.acquire(ctx, data)
+}
+
+func (m *membership) Context() context.Context {
+	return m.ctx
+}
+
+func (m *membership) Close(ctx context.Context) error {
+	m.cancel()
+	<-m.done
+
+	// The ephemeral semaphore is released with the session.
+	return m.session.Close(ctx)
+}
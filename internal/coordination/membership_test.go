@@ -0,0 +1,407 @@
+package coordination
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Coordination_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Coordination"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination"
+	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+const testSessionID = 1
+
+// semaphoreServer is a fake coordination service with the single semaphore. Watches of the semaphore are lost with
+// the stream, such as changes of the semaphore during reconnect of the session.
+type semaphoreServer struct {
+	mutex       sync.Mutex
+	owners      map[uint64]*Ydb_Coordination.SemaphoreSession
+	lastOrderID uint64
+	stream      *sessionStream
+	watches     []uint64
+	online      chan struct{} // closed if server accepts new streams
+}
+
+type sessionStream struct {
+	grpc.ClientStream
+
+	ctx       context.Context //nolint:containedctx
+	server    *semaphoreServer
+	responses chan *Ydb_Coordination.SessionResponse
+}
+
+func newSemaphoreServer(t testing.TB) (*semaphoreServer, *Client) {
+	server := &semaphoreServer{
+		owners: make(map[uint64]*Ydb_Coordination.SemaphoreSession),
+		online: make(chan struct{}),
+	}
+	close(server.online)
+
+	grpcClient := NewMockCoordinationServiceClient(gomock.NewController(t))
+	grpcClient.EXPECT().Session(gomock.Any()).DoAndReturn(func(
+		ctx context.Context, _ ...grpc.CallOption,
+	) (Ydb_Coordination_V1.CoordinationService_SessionClient, error) {
+		return server.newStream(ctx)
+	}).AnyTimes()
+
+	return server, &Client{
+		config:   config.New(),
+		client:   grpcClient,
+		sessions: make(map[*session]struct{}),
+	}
+}
+
+func (s *semaphoreServer) newStream(ctx context.Context) (*sessionStream, error) {
+	s.mutex.Lock()
+	online := s.online
+	s.mutex.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-online:
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.stream = &sessionStream{
+		ctx:       ctx,
+		server:    s,
+		responses: make(chan *Ydb_Coordination.SessionResponse, 100),
+	}
+	s.watches = nil
+
+	return s.stream, nil
+}
+
+// disconnect breaks the current stream and does not accept new streams until connect.
+func (s *semaphoreServer) disconnect() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.online = make(chan struct{})
+	s.stream.responses <- nil
+	s.stream, s.watches = nil, nil
+}
+
+func (s *semaphoreServer) connect() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	close(s.online)
+}
+
+// acquire acquires the semaphore by the session, such as another member joins the group.
+func (s *semaphoreServer) acquire(sessionID uint64, data string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.acquireLocked(sessionID, []byte(data))
+}
+
+// release releases the semaphore by the session, such as another member leaves the group.
+func (s *semaphoreServer) release(sessionID uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.owners, sessionID)
+	s.notifyLocked()
+}
+
+func (s *semaphoreServer) expire() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.stream.responses <- &Ydb_Coordination.SessionResponse{
+		Response: &Ydb_Coordination.SessionResponse_Failure_{
+			Failure: &Ydb_Coordination.SessionResponse_Failure{
+				Status: Ydb.StatusIds_SESSION_EXPIRED,
+			},
+		},
+	}
+}
+
+func (s *semaphoreServer) acquireLocked(sessionID uint64, data []byte) {
+	owner, has := s.owners[sessionID]
+	if !has {
+		s.lastOrderID++
+		owner = &Ydb_Coordination.SemaphoreSession{
+			SessionId: sessionID,
+			OrderId:   s.lastOrderID,
+			Count:     coordination.Shared,
+		}
+		s.owners[sessionID] = owner
+	}
+	owner.Data = data
+	s.notifyLocked()
+}
+
+func (s *semaphoreServer) notifyLocked() {
+	for _, reqID := range s.watches {
+		s.stream.responses <- &Ydb_Coordination.SessionResponse{
+			Response: &Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged_{
+				DescribeSemaphoreChanged: &Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged{
+					ReqId:         reqID,
+					OwnersChanged: true,
+				},
+			},
+		}
+	}
+	s.watches = nil
+}
+
+func (s *semaphoreServer) handle(stream *sessionStream, request *Ydb_Coordination.SessionRequest) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if stream != s.stream {
+		return
+	}
+
+	var response Ydb_Coordination.SessionResponse
+	switch request.GetRequest().(type) {
+	case *Ydb_Coordination.SessionRequest_SessionStart_:
+		response.Response = &Ydb_Coordination.SessionResponse_SessionStarted_{
+			SessionStarted: &Ydb_Coordination.SessionResponse_SessionStarted{
+				SessionId: testSessionID,
+			},
+		}
+	case *Ydb_Coordination.SessionRequest_SessionStop_:
+		response.Response = &Ydb_Coordination.SessionResponse_SessionStopped_{
+			SessionStopped: &Ydb_Coordination.SessionResponse_SessionStopped{
+				SessionId: testSessionID,
+			},
+		}
+	case *Ydb_Coordination.SessionRequest_AcquireSemaphore_:
+		s.acquireLocked(testSessionID, request.GetAcquireSemaphore().GetData())
+		response.Response = &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult_{
+			AcquireSemaphoreResult: &Ydb_Coordination.SessionResponse_AcquireSemaphoreResult{
+				ReqId:    request.GetAcquireSemaphore().GetReqId(),
+				Status:   Ydb.StatusIds_SUCCESS,
+				Acquired: true,
+			},
+		}
+	case *Ydb_Coordination.SessionRequest_DescribeSemaphore_:
+		describe := request.GetDescribeSemaphore()
+		owners := make([]*Ydb_Coordination.SemaphoreSession, 0, len(s.owners))
+		for _, owner := range s.owners {
+			owners = append(owners, owner)
+		}
+		sort.Slice(owners, func(i, j int) bool {
+			return owners[i].GetSessionId() < owners[j].GetSessionId()
+		})
+		if describe.GetWatchOwners() {
+			s.watches = append(s.watches, describe.GetReqId())
+		}
+		response.Response = &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult_{
+			DescribeSemaphoreResult: &Ydb_Coordination.SessionResponse_DescribeSemaphoreResult{
+				ReqId:  describe.GetReqId(),
+				Status: Ydb.StatusIds_SUCCESS,
+				SemaphoreDescription: &Ydb_Coordination.SemaphoreDescription{
+					Name:      describe.GetName(),
+					Ephemeral: true,
+					Owners:    owners,
+				},
+				WatchAdded: describe.GetWatchOwners(),
+			},
+		}
+	default:
+		return
+	}
+	stream.responses <- &response
+}
+
+func (s *sessionStream) Send(request *Ydb_Coordination.SessionRequest) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	s.server.handle(s, request)
+
+	return nil
+}
+
+func (s *sessionStream) Recv() (*Ydb_Coordination.SessionResponse, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	case response := <-s.responses:
+		if response == nil {
+			return nil, context.Canceled
+		}
+
+		return response, nil
+	}
+}
+
+func (s *sessionStream) CloseSend() error {
+	return nil
+}
+
+func (s *sessionStream) Context() context.Context {
+	return s.ctx
+}
+
+func waitMembers(t testing.TB, m coordination.Membership, expected ...coordination.Member) {
+	t.Helper()
+
+	for {
+		if equalMembers(expected, m.Members()) {
+			return
+		}
+		select {
+		case <-m.Changed():
+		case <-time.After(time.Second):
+			require.Equal(t, expected, m.Members())
+
+			return
+		}
+	}
+}
+
+func TestMembership(t *testing.T) {
+	t.Run("JoinAndLeave", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		server, client := newSemaphoreServer(t)
+		server.acquire(2, "second")
+
+		m, err := coordination.JoinGroup(ctx, client, "/local/node", "group", []byte("self"))
+		require.NoError(t, err)
+		require.Equal(t, coordination.Member{SessionID: testSessionID, Data: []byte("self")}, m.Self())
+		require.Equal(t, []coordination.Member{
+			{SessionID: 2, Data: []byte("second")},
+			{SessionID: testSessionID, Data: []byte("self")},
+		}, m.Members())
+
+		server.acquire(3, "third")
+		waitMembers(t, m,
+			coordination.Member{SessionID: 2, Data: []byte("second")},
+			coordination.Member{SessionID: testSessionID, Data: []byte("self")},
+			coordination.Member{SessionID: 3, Data: []byte("third")},
+		)
+
+		server.release(2)
+		waitMembers(t, m,
+			coordination.Member{SessionID: testSessionID, Data: []byte("self")},
+			coordination.Member{SessionID: 3, Data: []byte("third")},
+		)
+
+		require.NoError(t, m.Close(ctx))
+		require.Error(t, m.Context().Err())
+	})
+	t.Run("Update", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		server, client := newSemaphoreServer(t)
+		server.acquire(2, "second")
+
+		m, err := coordination.JoinGroup(ctx, client, "/local/node", "group", []byte("self"))
+		require.NoError(t, err)
+		defer func() {
+			_ = m.Close(ctx)
+		}()
+
+		require.NoError(t, m.Update(ctx, []byte("updated")))
+		require.Equal(t, []byte("updated"), m.Self().Data)
+		xtest.WaitChannelClosed(t, changedOnce(m))
+		require.Equal(t, []coordination.Member{
+			{SessionID: 2, Data: []byte("second")},
+			{SessionID: testSessionID, Data: []byte("updated")},
+		}, m.Members())
+	})
+	t.Run("ChangesDuringReconnect", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		server, client := newSemaphoreServer(t)
+		server.acquire(2, "second")
+
+		m, err := coordination.JoinGroup(ctx, client, "/local/node", "group", []byte("self"),
+			options.WithSessionReconnectDelay(time.Millisecond),
+		)
+		require.NoError(t, err)
+		defer func() {
+			_ = m.Close(ctx)
+		}()
+
+		// notifications of changes are lost with the stream
+		server.disconnect()
+		server.release(2)
+		server.acquire(3, "third")
+		server.connect()
+
+		waitMembers(t, m,
+			coordination.Member{SessionID: testSessionID, Data: []byte("self")},
+			coordination.Member{SessionID: 3, Data: []byte("third")},
+		)
+		require.NoError(t, m.Context().Err())
+
+		// watch is restored after reconnect
+		server.acquire(4, "fourth")
+		waitMembers(t, m,
+			coordination.Member{SessionID: testSessionID, Data: []byte("self")},
+			coordination.Member{SessionID: 3, Data: []byte("third")},
+			coordination.Member{SessionID: 4, Data: []byte("fourth")},
+		)
+	})
+	t.Run("SessionExpired", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		server, client := newSemaphoreServer(t)
+
+		m, err := coordination.JoinGroup(ctx, client, "/local/node", "group", []byte("self"))
+		require.NoError(t, err)
+
+		server.expire()
+		xtest.WaitChannelClosed(t, m.Context().Done())
+		require.ErrorIs(t, m.Update(ctx, []byte("updated")), coordination.ErrSessionClosed)
+		require.NoError(t, m.Close(ctx))
+	})
+}
+
+// changedOnce returns the channel which is closed after the next notification of membership
+func changedOnce(m coordination.Membership) <-chan struct{} {
+	ch := make(chan struct{})
+	go func() {
+		<-m.Changed()
+		close(ch)
+	}()
+
+	return ch
+}
+
+func TestSessionWatchSemaphore(t *testing.T) {
+	ctx := xtest.Context(t)
+	server, client := newSemaphoreServer(t)
+
+	s, err := client.Session(ctx, "/local/node", options.WithSessionReconnectDelay(time.Millisecond))
+	require.NoError(t, err)
+	defer func() {
+		_ = s.Close(ctx)
+	}()
+
+	server.acquire(2, "second")
+	desc, changed, err := coordination.WatchSemaphore(ctx, s, "group", options.WithDescribeOwners(true))
+	require.NoError(t, err)
+	require.Len(t, desc.Owners, 1)
+
+	server.acquire(3, "third")
+	xtest.WaitChannelClosed(t, changed)
+
+	_, changed, err = coordination.WatchSemaphore(ctx, s, "group", options.WithDescribeOwners(true))
+	require.NoError(t, err)
+	s.Reconnect()
+	xtest.WaitChannelClosed(t, changed)
+
+	_, changed, err = coordination.WatchSemaphore(ctx, s, "group", options.WithDescribeOwners(true))
+	require.NoError(t, err)
+	require.NoError(t, s.Close(ctx))
+	xtest.WaitChannelClosed(t, changed)
+}
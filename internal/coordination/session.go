@@ -3,6 +3,8 @@ package coordination
 import (
 	"context"
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"math"
 	"math/rand"
 	"sync"
@@ -16,6 +18,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/coordination/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/conversation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -29,9 +32,28 @@ type session struct {
 	controller        *conversation.Controller
 	sessionID         uint64
 
+	// reconnectAttempt is a number of failed attempts to create a stream since the session was last started, it is
+	// accessed by the main loop only.
+	reconnectAttempt int
+
 	mutex                sync.Mutex // guards the field below
 	lastGoodResponseTime time.Time
 	cancelStream         context.CancelFunc
+	expireReason         error                        // not nil if the session is lost
+	watchers             map[uint64]*semaphoreWatcher // by ids of sent describe requests
+}
+
+// semaphoreWatcher is a watch of the semaphore started by WatchSemaphore. The watcher may be registered with several
+// request ids because describe requests are sent again with new ids after reconnect.
+type semaphoreWatcher struct {
+	changed chan struct{}
+	once    sync.Once
+}
+
+func (w *semaphoreWatcher) notify() {
+	w.once.Do(func() {
+		close(w.changed)
+	})
 }
 
 type lease struct {
@@ -55,6 +77,7 @@ func createSession(
 		cancel:            cancel,
 		sessionClosedChan: make(chan struct{}),
 		controller:        conversation.NewController(),
+		watchers:          make(map[uint64]*semaphoreWatcher),
 	}
 	client.sessionCreated(&s)
 
@@ -67,6 +90,12 @@ func createSession(
 
 		return nil, ctx.Err()
 	case <-sessionStartedChan:
+	case <-s.sessionClosedChan:
+		if reason := s.getExpireReason(); reason != nil {
+			return nil, reason
+		}
+
+		return nil, xerrors.WithStackTrace(coordination.ErrSessionClosed)
 	}
 
 	return &s, nil
@@ -108,6 +137,94 @@ func (s *session) updateCancelStream(cancel context.CancelFunc) {
 	s.cancelStream = cancel
 }
 
+// markExpired remembers the reason of the session loss. The first reason wins.
+func (s *session) markExpired(reason error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.expireReason == nil {
+		s.expireReason = reason
+	}
+}
+
+func (s *session) getExpireReason() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.expireReason
+}
+
+// expire fails all conversations with coordination.ErrSessionExpired. It must be called by the main loop only.
+func (s *session) expire() {
+	trace.CoordinationOnSessionExpired(s.client.config.Trace(), s.sessionID, s.getExpireReason())
+	s.controller.CloseWithError(nil, coordination.ErrSessionExpired)
+}
+
+// addWatcher registers the watcher for the describe request with id reqID.
+func (s *session) addWatcher(reqID uint64, w *semaphoreWatcher) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	select {
+	case <-w.changed:
+		// The watcher is already notified (for example, about reconnect before the describe request is sent again).
+	default:
+		s.watchers[reqID] = w
+	}
+}
+
+// removeWatcher notifies the watcher and forgets all its request ids.
+func (s *session) removeWatcher(w *semaphoreWatcher) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id, watcher := range s.watchers {
+		if watcher == w {
+			delete(s.watchers, id)
+		}
+	}
+	w.notify()
+}
+
+// semaphoreChanged notifies the watcher of the describe request with id reqID.
+func (s *session) semaphoreChanged(reqID uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if w, has := s.watchers[reqID]; has {
+		for id, watcher := range s.watchers {
+			if watcher == w {
+				delete(s.watchers, id)
+			}
+		}
+		w.notify()
+	}
+}
+
+// notifyWatchers notifies all watchers. Changes of semaphores may be missed without the stream, so it must be called
+// after the stream is detached or the session is closed.
+func (s *session) notifyWatchers() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for id, w := range s.watchers {
+		delete(s.watchers, id)
+		w.notify()
+	}
+}
+
+func (s *session) reconnectDelay() time.Duration {
+	defer func() {
+		s.reconnectAttempt++
+	}()
+
+	if s.options.SessionReconnectBackoff != nil {
+		return s.options.SessionReconnectBackoff.Delay(s.reconnectAttempt)
+	}
+
+	return s.options.SessionReconnectDelay
+}
+
 // Create a new gRPC stream using an independent context.
 func (s *session) newStream(
 	streamCtx context.Context,
@@ -167,8 +284,12 @@ func (s *session) newStream(
 				s.options.SessionTimeout,
 			)
 			cancelStream()
+			s.markExpired(xerrors.WithStackTrace(fmt.Errorf(
+				"%w: no successful responses from server during session timeout %v",
+				coordination.ErrSessionExpired, s.options.SessionTimeout,
+			)))
 
-			return nil, coordination.ErrSessionClosed
+			return nil, coordination.ErrSessionExpired
 		}
 		timer.Stop()
 
@@ -177,7 +298,7 @@ func (s *session) newStream(
 		}
 
 		// Waiting for some time before trying to reconnect.
-		sessionReconnectDelay := time.NewTimer(s.options.SessionReconnectDelay)
+		sessionReconnectDelay := time.NewTimer(s.reconnectDelay())
 		select {
 		case <-sessionReconnectDelay.C:
 		case <-s.ctx.Done():
@@ -200,12 +321,14 @@ func (s *session) newStream(
 func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 	defer s.client.sessionClosed(s)
 	defer close(s.sessionClosedChan)
+	defer s.notifyWatchers()
 	defer s.cancel()
 
 	var seqNo uint64
 
 	protectionKey := newProtectionKey()
 	closing := false
+	reconnects := 0
 
 	for {
 		// Create a new grpc stream and start the receiver and sender loops.
@@ -221,7 +344,11 @@ func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 		sessionClient, err := s.newStream(streamCtx, cancelStream)
 		if err != nil {
 			// Giving up, we can do nothing without a stream.
-			s.controller.Close(nil)
+			if errors.Is(err, coordination.ErrSessionExpired) {
+				s.expire()
+			} else {
+				s.controller.Close(nil)
+			}
 
 			return
 		}
@@ -255,12 +382,21 @@ func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 		select {
 		case start := <-sessionStarted:
 			trace.CoordinationOnSessionStarted(s.client.config.Trace(), start.GetSessionId(), s.sessionID)
-			if s.sessionID == 0 {
+			switch {
+			case s.sessionID == 0:
 				s.sessionID = start.GetSessionId()
+				s.reconnectAttempt = 0
 				close(sessionStartedChan)
-			} else if start.GetSessionId() != s.sessionID {
+			case start.GetSessionId() != s.sessionID:
 				// Reconnect if the server response is invalid.
 				cancelStream()
+			default:
+				// The session survived the reconnect, all its semaphores and leases are kept.
+				s.reconnectAttempt = 0
+				if reconnects > 0 {
+					trace.CoordinationOnSessionRestored(s.client.config.Trace(), s.sessionID, reconnects)
+					reconnects = 0
+				}
 			}
 			close(startSending)
 		case <-sessionStartTimer.C:
@@ -306,6 +442,14 @@ func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 		}
 
 		if closing {
+			// No need to stop the session if it is lost.
+			if s.getExpireReason() != nil {
+				s.expire()
+				cancelStream()
+
+				return
+			}
+
 			// No need to stop the session if it was not started.
 			if s.sessionID == 0 {
 				s.controller.Close(nil)
@@ -359,7 +503,9 @@ func (s *session) mainLoop(path string, sessionStartedChan chan struct{}) {
 		wg.Wait()
 
 		s.controller.OnDetach()
+		s.notifyWatchers()
 		seqNo++
+		reconnects++
 	}
 }
 
@@ -393,6 +539,10 @@ func (s *session) receiveLoop(
 				message.GetFailure().GetStatus() == Ydb.StatusIds_NOT_FOUND {
 				// Consider the session expired if we got an unrecoverable status.
 				trace.CoordinationOnSessionServerExpire(s.client.config.Trace(), message.GetFailure())
+				s.markExpired(xerrors.WithStackTrace(xerrors.Operation(
+					xerrors.FromOperation(message.GetFailure()),
+				)))
+				s.cancel()
 
 				return
 			}
@@ -428,6 +578,9 @@ func (s *session) receiveLoop(
 			s.updateLastGoodResponseTime()
 		case *Ydb_Coordination.SessionResponse_Pong:
 			// Ignore pongs since we do not ping the server.
+		case *Ydb_Coordination.SessionResponse_DescribeSemaphoreChanged_:
+			s.semaphoreChanged(message.GetDescribeSemaphoreChanged().GetReqId())
+			s.updateLastGoodResponseTime()
 		default:
 			if !s.controller.OnRecv(message) {
 				// Reconnect if the message is not from any known conversation.
@@ -671,6 +824,38 @@ func (s *session) DescribeSemaphore(
 	ctx context.Context,
 	name string,
 	opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, error) {
+	return s.describeSemaphore(ctx, name, nil, opts...)
+}
+
+func (s *session) WatchSemaphore(
+	ctx context.Context,
+	name string,
+	opts ...options.DescribeSemaphoreOption,
+) (*coordination.SemaphoreDescription, <-chan struct{}, error) {
+	w := &semaphoreWatcher{
+		changed: make(chan struct{}),
+	}
+	desc, err := s.describeSemaphore(ctx, name, w, append([]options.DescribeSemaphoreOption{
+		options.WithWatchData(true),
+		options.WithWatchOwners(true),
+	}, opts...)...)
+	if err != nil {
+		s.removeWatcher(w)
+
+		return nil, nil, err
+	}
+
+	return desc, w.changed, nil
+}
+
+// describeSemaphore sends the describe request, the watcher w (if not nil) is registered on every sending of request
+// before the response can be received.
+func (s *session) describeSemaphore(
+	ctx context.Context,
+	name string,
+	w *semaphoreWatcher,
+	opts ...options.DescribeSemaphoreOption,
 ) (*coordination.SemaphoreDescription, error) {
 	req := conversation.NewConversation(
 		func() *Ydb_Coordination.SessionRequest {
@@ -683,6 +868,9 @@ func (s *session) DescribeSemaphore(
 					o(&describeSemaphore)
 				}
 			}
+			if w != nil {
+				s.addWatcher(describeSemaphore.GetReqId(), w)
+			}
 
 			return &Ydb_Coordination.SessionRequest{
 				Request: &Ydb_Coordination.SessionRequest_DescribeSemaphore_{
@@ -121,3 +121,13 @@ func IsAccessError(err error) bool {
 
 	return false
 }
+
+// IsTokenError reports whether err is a rejection of token by server (such as expired or revoked token).
+// Unlike IsAccessError, denied access to object (PERMISSION_DENIED) is not a token error
+func IsTokenError(err error) bool {
+	if xerrors.IsTransportError(err, grpcCodes.Unauthenticated) {
+		return true
+	}
+
+	return xerrors.IsOperationError(err, Ydb.StatusIds_UNAUTHORIZED)
+}
@@ -203,3 +203,35 @@ func TestIsAccessError(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTokenError(t *testing.T) {
+	for _, tt := range []struct {
+		error error
+		is    bool
+	}{
+		{
+			error: grpcStatus.Error(grpcCodes.Unauthenticated, ""),
+			is:    true,
+		},
+		{
+			error: xerrors.Transport(grpcStatus.Error(grpcCodes.Unauthenticated, "")),
+			is:    true,
+		},
+		{
+			error: xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAUTHORIZED)),
+			is:    true,
+		},
+		{
+			error: xerrors.Transport(grpcStatus.Error(grpcCodes.PermissionDenied, "")),
+			is:    false,
+		},
+		{
+			error: errors.New("some error"),
+			is:    false,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.Equal(t, tt.is, IsTokenError(tt.error), tt.error)
+		})
+	}
+}
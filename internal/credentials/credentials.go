@@ -9,3 +9,12 @@ type Credentials interface {
 	// Token must return actual token or error
 	Token(ctx context.Context) (string, error)
 }
+
+// Refreshable is an interface of credentials with cached token which may be invalidated.
+// Next call of Token after Invalidate re-reads source of credentials (such as login or token exchange)
+type Refreshable interface {
+	Credentials
+
+	// Invalidate drops cached token
+	Invalidate()
+}
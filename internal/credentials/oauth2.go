@@ -21,6 +21,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/secret"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 )
@@ -145,6 +146,19 @@ func WithRequestTimeout(timeout time.Duration) requestTimeoutOption {
 	return requestTimeoutOption(timeout)
 }
 
+// UserAgent
+type userAgentOption string
+
+func (userAgent userAgentOption) ApplyOauth2CredentialsOption(c *oauth2TokenExchange) error {
+	c.userAgent = string(userAgent)
+
+	return nil
+}
+
+func WithUserAgent(userAgent string) userAgentOption {
+	return userAgentOption(userAgent)
+}
+
 const (
 	SubjectTokenSourceType = 1
 	ActorTokenSourceType   = 2
@@ -227,6 +241,8 @@ func WithJWTActorToken(opts ...JWTTokenSourceOption) *tokenSourceOption {
 	}
 }
 
+var _ Refreshable = (*oauth2TokenExchange)(nil)
+
 type oauth2TokenExchange struct {
 	tokenEndpoint string
 
@@ -250,6 +266,10 @@ type oauth2TokenExchange struct {
 	// 10 by default
 	requestTimeout time.Duration
 
+	// User-Agent header of http requests
+	// ydb-go-sdk/{version} by default
+	userAgent string
+
 	// Received data
 	receivedToken           string
 	updateTokenTime         time.Time
@@ -268,6 +288,7 @@ func NewOauth2TokenExchangeCredentials(
 		grantType:          "urn:ietf:params:oauth:grant-type:token-exchange",
 		requestedTokenType: "urn:ietf:params:oauth:token-type:access_token",
 		requestTimeout:     defaultRequestTimeout,
+		userAgent:          version.FullVersion,
 		sourceInfo:         stack.Record(1),
 	}
 
@@ -449,6 +470,7 @@ func (provider *oauth2TokenExchange) exchangeToken(ctx context.Context, now time
 	}
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Add("Content-Length", strconv.Itoa(len(body)))
+	req.Header.Set("User-Agent", provider.userAgent)
 	req.Close = true
 
 	client := http.Client{
@@ -532,6 +554,16 @@ func (provider *oauth2TokenExchange) Token(ctx context.Context) (string, error)
 	return provider.receivedToken, nil
 }
 
+// Invalidate drops received token, so next call of Token exchanges token again
+func (provider *oauth2TokenExchange) Invalidate() {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+
+	provider.receivedToken = ""
+	provider.receivedTokenExpireTime = time.Time{}
+	provider.updateTokenTime = time.Time{}
+}
+
 func (provider *oauth2TokenExchange) String() string {
 	buffer := xstring.Buffer()
 	defer buffer.Free()
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"reflect"
@@ -16,6 +17,8 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
 )
 
 var (
@@ -369,6 +372,75 @@ func TestErrorInHTTPRequest(t *testing.T) {
 	require.Equal(t, `OAuth2TokenExchange{Endpoint:"http://invalid_host:42/exchange",GrantType:urn:ietf:params:oauth:grant-type:token-exchange,Resource:,Audience:[],Scope:[1 2 3],RequestedTokenType:urn:ietf:params:oauth:token-type:access_token,SubjectToken:JWTTokenSource{Method:RS256,KeyID:key_id,Issuer:"test_issuer",Subject:"",Audience:[test_audience],ID:,TokenTTL:1h0m0s},ActorToken:JWTTokenSource{Method:RS256,KeyID:key_id,Issuer:"test_issuer",Subject:"",Audience:[],ID:,TokenTTL:1h0m0s},From:"TestErrorInHTTPRequest"}`, formatted) //nolint:lll
 }
 
+func TestOauth2TokenExchangeUserAgent(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		opts      []Oauth2TokenExchangeCredentialsOption
+		userAgent string
+	}{
+		{
+			name:      "Default",
+			userAgent: version.FullVersion,
+		},
+		{
+			name:      "Custom",
+			opts:      []Oauth2TokenExchangeCredentialsOption{WithUserAgent("test-app " + version.FullVersion)},
+			userAgent: "test-app " + version.FullVersion,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			userAgent := make(chan string, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				userAgent <- r.UserAgent()
+				WriteResponse(w, http.StatusOK,
+					`{"access_token":"test_token","token_type":"Bearer","expires_in":3600}`, "application/json",
+				)
+			}))
+			defer server.Close()
+
+			client, err := NewOauth2TokenExchangeCredentials(append([]Oauth2TokenExchangeCredentialsOption{
+				WithTokenEndpoint(server.URL),
+				WithFixedSubjectToken("test_source_token", "urn:ietf:params:oauth:token-type:test_jwt"),
+			}, tt.opts...)...)
+			require.NoError(t, err)
+
+			token, err := client.Token(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, "Bearer test_token", token)
+			require.Equal(t, tt.userAgent, <-userAgent)
+		})
+	}
+}
+
+func TestOauth2TokenExchangeInvalidate(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		WriteResponse(w, http.StatusOK,
+			fmt.Sprintf(`{"access_token":"test_token_%d","token_type":"Bearer","expires_in":3600}`, requests),
+			"application/json",
+		)
+	}))
+	defer server.Close()
+
+	client, err := NewOauth2TokenExchangeCredentials(
+		WithTokenEndpoint(server.URL),
+		WithFixedSubjectToken("test_source_token", "urn:ietf:params:oauth:token-type:test_jwt"),
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		token, err := client.Token(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "Bearer test_token_1", token)
+	}
+	client.Invalidate()
+	token, err := client.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "Bearer test_token_2", token)
+	require.Equal(t, 2, requests)
+}
+
 func TestJWTTokenSource(t *testing.T) {
 	publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(testPublicKeyContent))
 	require.NoError(t, err)
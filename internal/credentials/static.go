@@ -23,6 +23,7 @@ const TokenRefreshDivisor = 10
 
 var (
 	_ Credentials             = (*Static)(nil)
+	_ Refreshable             = (*Static)(nil)
 	_ fmt.Stringer            = (*Static)(nil)
 	_ StaticCredentialsOption = grpcDialOptionsOption(nil)
 )
@@ -141,6 +142,14 @@ func (c *Static) Token(ctx context.Context) (token string, err error) {
 	return c.token, nil
 }
 
+// Invalidate drops cached token, so next call of Token makes login request
+func (c *Static) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = ""
+	c.requestAt = time.Time{}
+}
+
 func parseExpiresAt(raw string) (expiresAt time.Time, err error) {
 	var claims jwt.RegisteredClaims
 	if _, _, err = jwt.NewParser().ParseUnverified(raw, &claims); err != nil {
@@ -13,3 +13,13 @@ func Test_parseExpiresAt(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, time.Unix(1660695322, 0), expiresAt)
 }
+
+func TestStaticInvalidate(t *testing.T) {
+	c := NewStaticCredentials("user", "password", "localhost:2135")
+	c.token = "token"
+	c.requestAt = time.Now().Add(time.Hour)
+	c.Invalidate()
+	require.Empty(t, c.token)
+	// next call of Token makes login request
+	require.False(t, time.Until(c.requestAt) > 0)
+}
@@ -0,0 +1,115 @@
+package deadline
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Source is a source of deadline which expired
+type Source int
+
+const (
+	// SourceCaller is a deadline of context of caller or other deadline which is not derived by SDK
+	SourceCaller = Source(iota)
+	// SourceDefaultOperationTimeout is a client-side default timeout of calls of driver
+	SourceDefaultOperationTimeout
+	// SourceDefaultSchemeTimeout is a client-side default timeout of scheme calls of driver
+	SourceDefaultSchemeTimeout
+	// SourceDefaultScanQueryTimeout is a client-side default timeout of scan queries of driver
+	SourceDefaultScanQueryTimeout
+	// SourceAttemptTimeout is a timeout of attempt of retry loop
+	SourceAttemptTimeout
+	// SourceOperationTimeout is a server-side operation timeout
+	SourceOperationTimeout
+	// SourceOperationCancelAfter is a server-side operation cancel after timeout
+	SourceOperationCancelAfter
+	// SourceDialTimeout is a timeout of dial of connection
+	SourceDialTimeout
+	// SourceSessionCreateTimeout is a timeout of creation of session
+	SourceSessionCreateTimeout
+	// SourceSessionDeleteTimeout is a timeout of deletion of session
+	SourceSessionDeleteTimeout
+)
+
+func (s Source) String() string {
+	switch s {
+	case SourceCaller:
+		return "caller"
+	case SourceDefaultOperationTimeout:
+		return "default operation timeout"
+	case SourceDefaultSchemeTimeout:
+		return "default scheme timeout"
+	case SourceDefaultScanQueryTimeout:
+		return "default scan query timeout"
+	case SourceAttemptTimeout:
+		return "attempt timeout"
+	case SourceOperationTimeout:
+		return "operation timeout"
+	case SourceOperationCancelAfter:
+		return "operation cancel after"
+	case SourceDialTimeout:
+		return "dial timeout"
+	case SourceSessionCreateTimeout:
+		return "session create timeout"
+	case SourceSessionDeleteTimeout:
+		return "session delete timeout"
+	default:
+		return fmt.Sprintf("unknown deadline source %d", int(s))
+	}
+}
+
+// Deadline describes expired deadline: its source and configured timeout.
+// Timeout is zero for deadline of caller because SDK does not know how timeout was configured
+type Deadline struct {
+	Source  Source
+	Timeout time.Duration
+}
+
+func (d Deadline) String() string {
+	if d.Timeout <= 0 {
+		return d.Source.String()
+	}
+
+	return d.Source.String() + " " + d.Timeout.String()
+}
+
+type deadlineError struct {
+	err      error
+	deadline Deadline
+}
+
+func (e *deadlineError) Error() string {
+	return e.err.Error() + " (expired deadline: " + e.deadline.String() + ")"
+}
+
+func (e *deadlineError) Unwrap() error {
+	return e.err
+}
+
+// WithCause tags err with deadline which caused err.
+// Already tagged err is returned as is, because the innermost tag is the most precise
+func WithCause(err error, deadline Deadline) error {
+	if err == nil {
+		return nil
+	}
+	var de *deadlineError
+	if errors.As(err, &de) {
+		return err
+	}
+
+	return &deadlineError{
+		err:      err,
+		deadline: deadline,
+	}
+}
+
+// Cause returns tag of err. It returns false if err is not tagged with WithCause
+func Cause(err error) (Deadline, bool) {
+	var de *deadlineError
+	if errors.As(err, &de) {
+		return de.deadline, true
+	}
+
+	return Deadline{}, false
+}
@@ -8,10 +8,10 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
-var (
-	insecureSchema = "grpc"
-	databaseParam  = "database"
-)
+var insecureSchema = "grpc"
+
+// DatabaseParam is a name of query param of connection string which overrides path of connection string
+const DatabaseParam = "database"
 
 type UserInfo struct {
 	User     string
@@ -45,11 +45,11 @@ func Parse(dsn string) (info parsedInfo, err error) {
 		}
 	}
 	info.Params = uri.Query()
-	if database, has := info.Params[databaseParam]; has && len(database) > 0 {
+	if database, has := info.Params[DatabaseParam]; has && len(database) > 0 {
 		info.Options = append(info.Options,
 			config.WithDatabase(database[0]),
 		)
-		delete(info.Params, databaseParam)
+		delete(info.Params, DatabaseParam)
 	}
 
 	return info, nil
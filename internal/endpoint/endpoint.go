@@ -13,6 +13,16 @@ type Info interface {
 	LastUpdated() time.Time
 	LoadFactor() float32
 
+	// Services returns names of services advertised by endpoint in discovery
+	Services() []string
+
+	// HasService checks that endpoint advertises service with given name.
+	// Endpoint without advertised services is considered as supporting all services
+	HasService(name string) bool
+
+	// SSL reports that endpoint requires secure connection
+	SSL() bool
+
 	// Deprecated: LocalDC check "local" by compare endpoint location with discovery "selflocation" field.
 	// It work good only if connection url always point to local dc.
 	// Will be removed after Oct 2024.
@@ -34,6 +44,7 @@ type endpoint struct { //nolint:maligned
 	address  string
 	location string
 	services []string
+	ssl      bool
 
 	loadFactor  float32
 	lastUpdated time.Time
@@ -50,6 +61,7 @@ func (e *endpoint) Copy() Endpoint {
 		address:     e.address,
 		location:    e.location,
 		services:    append(make([]string, 0, len(e.services)), e.services...),
+		ssl:         e.ssl,
 		loadFactor:  e.loadFactor,
 		local:       e.local,
 		lastUpdated: e.lastUpdated,
@@ -109,6 +121,37 @@ func (e *endpoint) LoadFactor() float32 {
 	return e.loadFactor
 }
 
+func (e *endpoint) Services() []string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return append(make([]string, 0, len(e.services)), e.services...)
+}
+
+func (e *endpoint) HasService(name string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if len(e.services) == 0 {
+		return true
+	}
+
+	for _, service := range e.services {
+		if service == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *endpoint) SSL() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.ssl
+}
+
 func (e *endpoint) LastUpdated() time.Time {
 	e.mu.RLock()
 	defer e.mu.RUnlock()
@@ -158,6 +201,12 @@ func WithServices(services []string) Option {
 	}
 }
 
+func WithSSL(ssl bool) Option {
+	return func(e *endpoint) {
+		e.ssl = ssl
+	}
+}
+
 func withLastUpdated(ts time.Time) Option {
 	return func(e *endpoint) {
 		e.lastUpdated = ts
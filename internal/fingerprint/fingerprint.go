@@ -0,0 +1,212 @@
+package fingerprint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// placeholder replaces literal values in normalized query text
+const placeholder = '?'
+
+// fingerprintSize is a number of bytes of query text hash used as fingerprint
+const fingerprintSize = 8
+
+// Query returns short stable identifier of query text. Queries which differ only in whitespaces,
+// comments and literal values have same fingerprint
+func Query(query string) string {
+	h := sha256.Sum256([]byte(Normalize(query)))
+
+	return hex.EncodeToString(h[:fingerprintSize])
+}
+
+// QueryIf returns fingerprint of query text if traced is true and empty string otherwise.
+// Normalization of query text is not free for large queries, so callers compute fingerprint
+// only for trace events with handlers
+func QueryIf(traced bool, query string) string {
+	if !traced {
+		return ""
+	}
+
+	return Query(query)
+}
+
+// Normalize strips comments, replaces literal values (strings and numbers) with placeholder
+// and normalizes whitespaces of YQL query text.
+// Whitespaces are kept only between words (identifiers, keywords and placeholders)
+func Normalize(query string) string {
+	l := lexer{src: query}
+	l.buf.Grow(len(query))
+	for l.pos < len(l.src) {
+		l.next()
+	}
+
+	return l.buf.String()
+}
+
+type lexer struct {
+	src string
+	pos int
+	buf strings.Builder
+
+	// space reports that whitespace or comment was skipped after last written token
+	space bool
+	// word reports that last written token was word
+	word bool
+}
+
+func isIdentStart(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_' || c == '$' || c >= 0x80
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdent(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+func (l *lexer) peek(offset int) byte {
+	if l.pos+offset < len(l.src) {
+		return l.src[l.pos+offset]
+	}
+
+	return 0
+}
+
+func (l *lexer) writeWord(s string) {
+	if l.word && l.space {
+		l.buf.WriteByte(' ')
+	}
+	l.buf.WriteString(s)
+	l.word = true
+	l.space = false
+}
+
+func (l *lexer) writePunct(c byte) {
+	l.buf.WriteByte(c)
+	l.word = false
+	l.space = false
+}
+
+func (l *lexer) next() {
+	c := l.src[l.pos]
+	switch {
+	case isSpace(c):
+		l.pos++
+		l.space = true
+	case c == '-' && l.peek(1) == '-':
+		l.skipLineComment()
+	case c == '/' && l.peek(1) == '*':
+		l.skipMultilineComment()
+	case c == '\'' || c == '"':
+		l.skipQuoted(c)
+		l.skipSuffix()
+		l.writeWord(string(placeholder))
+	case c == '@' && l.peek(1) == '@':
+		l.skipRawString()
+		l.skipSuffix()
+		l.writeWord(string(placeholder))
+	case c == '`':
+		start := l.pos
+		l.skipQuoted(c)
+		l.writeWord(l.src[start:l.pos])
+	case isDigit(c) || (c == '.' && isDigit(l.peek(1)) && !l.word):
+		l.skipNumber()
+		l.writeWord(string(placeholder))
+	case isIdentStart(c):
+		start := l.pos
+		for l.pos < len(l.src) && isIdent(l.src[l.pos]) {
+			l.pos++
+		}
+		l.writeWord(l.src[start:l.pos])
+	default:
+		l.pos++
+		l.writePunct(c)
+	}
+}
+
+func (l *lexer) skipLineComment() {
+	for l.pos < len(l.src) && l.src[l.pos] != '\n' && l.src[l.pos] != '\r' {
+		l.pos++
+	}
+	l.space = true
+}
+
+func (l *lexer) skipMultilineComment() {
+	end := strings.Index(l.src[l.pos+2:], "*/")
+	if end < 0 {
+		l.pos = len(l.src)
+	} else {
+		l.pos += 2 + end + 2
+	}
+	l.space = true
+}
+
+// skipQuoted skips string literal or quoted identifier with backslash escapes
+// and doubled quote escapes
+func (l *lexer) skipQuoted(quote byte) {
+	l.pos++
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '\\':
+			l.pos += 2
+		case quote:
+			l.pos++
+			if l.peek(0) != quote {
+				return
+			}
+			l.pos++
+		default:
+			l.pos++
+		}
+	}
+	l.pos = len(l.src)
+}
+
+// skipRawString skips multiline string literal @@...@@ where @@@@ is escaped @@
+func (l *lexer) skipRawString() {
+	l.pos += 2
+	for l.pos < len(l.src) {
+		if l.src[l.pos] == '@' && l.peek(1) == '@' {
+			if l.peek(2) == '@' && l.peek(3) == '@' {
+				l.pos += 4
+
+				continue
+			}
+			l.pos += 2
+
+			return
+		}
+		l.pos++
+	}
+}
+
+// skipSuffix skips type suffix of literal such as 'text'u or 'bytes'y
+func (l *lexer) skipSuffix() {
+	for l.pos < len(l.src) && isIdent(l.src[l.pos]) {
+		l.pos++
+	}
+}
+
+// skipNumber skips numeric literal with type suffix such as 1u, 0xFFul, 1.5e-3f
+func (l *lexer) skipNumber() {
+	hex := l.peek(0) == '0' && (l.peek(1) == 'x' || l.peek(1) == 'X')
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case isIdent(c) || c == '.':
+			l.pos++
+			if !hex && (c == 'e' || c == 'E') && (l.peek(0) == '+' || l.peek(0) == '-') {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package fingerprint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		query      string
+		normalized string
+	}{
+		{
+			name:       "Whitespaces",
+			query:      "  SELECT\n\t*   FROM  `table`\r\n WHERE a = $a ;  ",
+			normalized: "SELECT*FROM `table` WHERE a=$a;",
+		},
+		{
+			name:       "Numbers",
+			query:      "SELECT 1, -2.5, 1e-3, .5, 0xFFul, 10u, col1 FROM t WHERE id IN (1,2,3)",
+			normalized: "SELECT ?,-?,?,?,?,?,col1 FROM t WHERE id IN(?,?,?)",
+		},
+		{
+			name:       "Strings",
+			query:      `SELECT 'a', "b", 'c'u, "d"y, 'it''s', 'x\'y', "q\"q" FROM t`,
+			normalized: "SELECT ?,?,?,?,?,?,? FROM t",
+		},
+		{
+			name:       "CommentMarkersInsideStrings",
+			query:      "SELECT '-- not a comment', \"/* not a comment */\", `a--b` FROM t -- comment",
+			normalized: "SELECT ?,?,`a--b` FROM t",
+		},
+		{
+			name:       "QuoteInsideComment",
+			query:      "SELECT 1 -- it's comment\nFROM t /* multi\nline ' comment */ WHERE a = 'x'",
+			normalized: "SELECT ? FROM t WHERE a=?",
+		},
+		{
+			name:       "RawMultilineString",
+			query:      "SELECT @@multi\nline -- @@@@ string@@ FROM t",
+			normalized: "SELECT ? FROM t",
+		},
+		{
+			name:       "EscapedBacktick",
+			query:      "SELECT * FROM `my\\`table` WHERE `a``b` = 1",
+			normalized: "SELECT*FROM `my\\`table` WHERE `a``b`=?",
+		},
+		{
+			name:       "UnterminatedString",
+			query:      "SELECT 'abc",
+			normalized: "SELECT ?",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.normalized, Normalize(tt.query))
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	fingerprint := Query("SELECT * FROM t WHERE id = 1 AND name = 'a'")
+	require.Len(t, fingerprint, 2*fingerprintSize)
+	require.Equal(t, fingerprint, Query("SELECT *\nFROM t -- comment\nWHERE id=42 AND name=\"b\""))
+	require.Equal(t, fingerprint, Query("/* comment */ SELECT * FROM t WHERE id = 100500 AND name = 'it''s'"))
+	require.NotEqual(t, fingerprint, Query("SELECT * FROM t2 WHERE id = 1 AND name = 'a'"))
+	require.NotEqual(t, fingerprint, Query("SELECT * FROM t WHERE id = $id AND name = 'a'"))
+}
+
+func TestQueryIf(t *testing.T) {
+	const query = "SELECT * FROM t WHERE id = 1"
+	require.Equal(t, Query(query), QueryIf(true, query))
+	require.Empty(t, QueryIf(false, query))
+}
@@ -6,11 +6,15 @@ import (
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
+// topicService is a name of topic service in endpoint services list of discovery
+const topicService = "topic"
+
 type Client struct {
 	service Ydb_Topic_V1.TopicServiceClient
 }
@@ -54,6 +58,21 @@ func (c *Client) DescribeTopic(ctx context.Context, req DescribeTopicRequest) (r
 	return res, err
 }
 
+func (c *Client) DescribeConsumer(
+	ctx context.Context,
+	req DescribeConsumerRequest,
+) (res DescribeConsumerResult, err error) {
+	resp, err := c.service.DescribeConsumer(ctx, req.ToProto())
+	if err != nil {
+		return DescribeConsumerResult{}, xerrors.WithStackTrace(xerrors.Wrap(
+			fmt.Errorf("ydb: describe consumer grpc failed: %w", err),
+		))
+	}
+	err = res.FromProto(resp)
+
+	return res, err
+}
+
 func (c *Client) DropTopic(
 	ctx context.Context,
 	req DropTopicRequest,
@@ -68,7 +87,7 @@ func (c *Client) DropTopic(
 }
 
 func (c *Client) StreamRead(ctxStreamLifeTime context.Context) (rawtopicreader.StreamReader, error) {
-	protoResp, err := c.service.StreamRead(ctxStreamLifeTime)
+	protoResp, err := c.service.StreamRead(balancer.WithRequiredService(ctxStreamLifeTime, topicService))
 	if err != nil {
 		return rawtopicreader.StreamReader{}, xerrors.WithStackTrace(
 			xerrors.Wrap(
@@ -81,7 +100,7 @@ func (c *Client) StreamRead(ctxStreamLifeTime context.Context) (rawtopicreader.S
 }
 
 func (c *Client) StreamWrite(ctxStreamLifeTime context.Context) (*rawtopicwriter.StreamWriter, error) {
-	protoResp, err := c.service.StreamWrite(ctxStreamLifeTime)
+	protoResp, err := c.service.StreamWrite(balancer.WithRequiredService(ctxStreamLifeTime, topicService))
 	if err != nil {
 		return nil, xerrors.WithStackTrace(
 			xerrors.Wrap(
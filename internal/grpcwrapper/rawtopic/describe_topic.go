@@ -9,6 +9,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/clone"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawscheme"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
@@ -16,12 +17,14 @@ import (
 type DescribeTopicRequest struct {
 	OperationParams rawydb.OperationParams
 	Path            string
+	IncludeStats    bool
 }
 
 func (req *DescribeTopicRequest) ToProto() *Ydb_Topic.DescribeTopicRequest {
 	return &Ydb_Topic.DescribeTopicRequest{
 		OperationParams: req.OperationParams.ToProto(),
 		Path:            req.Path,
+		IncludeStats:    req.IncludeStats,
 	}
 }
 
@@ -92,6 +95,16 @@ type PartitionInfo struct {
 	Active             bool
 	ChildPartitionIDs  []int64
 	ParentPartitionIDs []int64
+
+	// PartitionStats filled only if stats are requested with DescribeTopicRequest.IncludeStats
+	PartitionStats PartitionStats
+}
+
+type PartitionStats struct {
+	PartitionsOffset rawtopicreader.OffsetRange
+	StoreSizeBytes   int64
+	LastWriteTime    time.Time
+	PartitionNodeID  int32
 }
 
 func (pi *PartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeTopicResult_PartitionInfo) {
@@ -100,4 +113,18 @@ func (pi *PartitionInfo) mustFromProto(proto *Ydb_Topic.DescribeTopicResult_Part
 
 	pi.ChildPartitionIDs = clone.Int64Slice(proto.GetChildPartitionIds())
 	pi.ParentPartitionIDs = clone.Int64Slice(proto.GetParentPartitionIds())
+
+	if stats := proto.GetPartitionStats(); stats != nil {
+		pi.PartitionStats.mustFromProto(stats)
+	}
+}
+
+func (ps *PartitionStats) mustFromProto(proto *Ydb_Topic.PartitionStats) {
+	ps.PartitionsOffset.Start.FromInt64(proto.GetPartitionOffsets().GetStart())
+	ps.PartitionsOffset.End.FromInt64(proto.GetPartitionOffsets().GetEnd())
+	ps.StoreSizeBytes = proto.GetStoreSizeBytes()
+	if proto.GetLastWriteTime() != nil {
+		ps.LastWriteTime = proto.GetLastWriteTime().AsTime()
+	}
+	ps.PartitionNodeID = proto.GetPartitionNodeId()
 }
@@ -0,0 +1,46 @@
+package issues
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+)
+
+// Position is a position of issue in query text
+type Position struct {
+	Row    uint32
+	Column uint32
+	File   string
+}
+
+// Issue is an issue of operation with nested issues.
+// Failed operations report reasons of failure with issues, successful operations may report
+// non-fatal issues such as warnings
+type Issue struct {
+	Code     uint32
+	Message  string
+	Severity uint32
+	Position Position
+	Issues   []Issue
+}
+
+// FromYDB converts issues of operation with nested issues. It returns nil for empty issues
+func FromYDB(issues []*Ydb_Issue.IssueMessage) []Issue {
+	if len(issues) == 0 {
+		return nil
+	}
+	result := make([]Issue, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, Issue{
+			Code:     issue.GetIssueCode(),
+			Message:  issue.GetMessage(),
+			Severity: issue.GetSeverity(),
+			Position: Position{
+				Row:    issue.GetPosition().GetRow(),
+				Column: issue.GetPosition().GetColumn(),
+				File:   issue.GetPosition().GetFile(),
+			},
+			Issues: FromYDB(issue.GetIssues()),
+		})
+	}
+
+	return result
+}
@@ -34,6 +34,18 @@ func WithApplicationName(ctx context.Context, applicationName string) context.Co
 	return metadata.NewOutgoingContext(ctx, md)
 }
 
+// WithDatabase returns a copy of parent context with database header which
+// overrides database of driver
+func WithDatabase(ctx context.Context, database string) context.Context {
+	md, has := metadata.FromOutgoingContext(ctx)
+	if !has {
+		md = metadata.MD{}
+	}
+	md.Set(HeaderDatabase, database)
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
 // WithRequestType returns a copy of parent context with custom request type
 func WithRequestType(ctx context.Context, requestType string) context.Context {
 	return metadata.AppendToOutgoingContext(ctx, HeaderRequestType, requestType)
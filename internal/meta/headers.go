@@ -20,4 +20,5 @@ const (
 
 	// incoming hints
 	HintSessionClose = "session-close"
+	HintNodeShutdown = "node-shutdown"
 )
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 
 	"google.golang.org/grpc/metadata"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -46,6 +49,26 @@ func WithApplicationNameOption(applicationName string) Option {
 	}
 }
 
+func WithBuildInfoSuffixOption(suffix string) Option {
+	return func(m *Meta) {
+		m.buildInfoSuffix = suffix
+	}
+}
+
+// WithBuildInfoExtraOption appends extra part to sdk build info, extra parts are separated with ';'
+func WithBuildInfoExtraOption(extra string) Option {
+	return func(m *Meta) {
+		m.buildInfoExtras = append(m.buildInfoExtras, extra)
+	}
+}
+
+// WithoutBuildInfoHeaderOption disables sending of sdk build info header with requests
+func WithoutBuildInfoHeaderOption() Option {
+	return func(m *Meta) {
+		m.buildInfoHeaderDisabled = true
+	}
+}
+
 func WithRequestTypeOption(requestType string) Option {
 	return func(m *Meta) {
 		m.requestsType = requestType
@@ -72,13 +95,48 @@ func ForbidOption(feature string) Option {
 }
 
 type Meta struct {
-	pid             string
-	trace           *trace.Driver
-	credentials     credentials.Credentials
-	database        string
-	requestsType    string
-	applicationName string
-	capabilities    []string
+	pid                     string
+	trace                   *trace.Driver
+	credentials             credentials.Credentials
+	database                string
+	requestsType            string
+	applicationName         string
+	buildInfoSuffix         string
+	buildInfoExtras         []string
+	buildInfoHeaderDisabled bool
+	capabilities            []string
+
+	// invalidatedToken is a last rejected token which was invalidated in credentials
+	invalidatedToken   string
+	invalidatedTokenMu sync.Mutex
+}
+
+// ApplicationName returns application name which sends with every request
+func (m *Meta) ApplicationName() string {
+	return m.applicationName
+}
+
+// BuildInfo returns value of sdk build info header: version of SDK with optional suffix and extra parts
+func (m *Meta) BuildInfo() string {
+	buildInfo := version.FullVersion
+	if m.buildInfoSuffix != "" {
+		buildInfo += ";" + m.buildInfoSuffix
+	}
+	for _, extra := range m.buildInfoExtras {
+		buildInfo += ";" + extra
+	}
+
+	return buildInfo
+}
+
+// UserAgent returns user agent for http-based flows (such as credentials) in form
+// "{applicationName} {buildInfo}" or "{buildInfo}" if application name is empty
+func (m *Meta) UserAgent() string {
+	if m.applicationName == "" {
+		return m.BuildInfo()
+	}
+
+	return m.applicationName + " " + m.BuildInfo()
 }
 
 func (m *Meta) meta(ctx context.Context) (_ metadata.MD, err error) {
@@ -93,8 +151,8 @@ func (m *Meta) meta(ctx context.Context) (_ metadata.MD, err error) {
 		md.Set(HeaderDatabase, m.database)
 	}
 
-	if len(md.Get(HeaderVersion)) == 0 {
-		md.Set(HeaderVersion, version.FullVersion)
+	if !m.buildInfoHeaderDisabled && len(md.Get(HeaderVersion)) == 0 {
+		md.Set(HeaderVersion, m.BuildInfo())
 	}
 
 	if m.requestsType != "" {
@@ -146,3 +204,43 @@ func (m *Meta) Context(ctx context.Context) (_ context.Context, err error) {
 
 	return metadata.NewOutgoingContext(ctx, md), nil
 }
+
+// RecoverCredentials handles rejection of token by server (such as revoked or expired token).
+// If err of request with context ctx (made by Context) is a token error and credentials are
+// credentials.Refreshable, credentials are invalidated once per rejected token. On first rejection
+// in the retry loop of ctx err is returned as retryable error, so the request is retried with a fresh
+// token. If the fresh token is rejected too (or ctx has no retry loop) err is returned as is, so
+// repeatedly rejected credentials cannot cause endless fast retries
+func (m *Meta) RecoverCredentials(ctx context.Context, err error) error {
+	if !credentials.IsTokenError(err) {
+		return err
+	}
+	refreshable, ok := m.credentials.(credentials.Refreshable)
+	if !ok {
+		return err
+	}
+	md, has := metadata.FromOutgoingContext(ctx)
+	if !has || len(md.Get(HeaderTicket)) == 0 {
+		return err
+	}
+	token := md.Get(HeaderTicket)[0]
+
+	m.invalidatedTokenMu.Lock()
+	invalidate := m.invalidatedToken != token
+	m.invalidatedToken = token
+	m.invalidatedTokenMu.Unlock()
+
+	// concurrent requests with the same rejected token are retried without repeated invalidation
+	if invalidate {
+		refreshable.Invalidate()
+	}
+
+	if !xcontext.MarkCredentialsRecovered(ctx) {
+		return err
+	}
+
+	return xerrors.Retryable(err,
+		xerrors.WithBackoff(backoff.TypeFast),
+		xerrors.WithName("RejectedToken"),
+	)
+}
@@ -2,14 +2,24 @@ package test
 
 import (
 	"context"
+	"net"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
 	internal "github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/version"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -50,3 +60,182 @@ func TestMetaRequiredHeaders(t *testing.T) {
 	}, md.Get(internal.HeaderVersion))
 	require.Equal(t, []string{"some-user-value"}, md.Get("some-user-header"))
 }
+
+func TestMetaBuildInfoSuffix(t *testing.T) {
+	m := internal.New(
+		"database",
+		nil,
+		&trace.Driver{},
+		internal.WithApplicationNameOption("test-app"),
+		internal.WithBuildInfoSuffixOption("my-framework/1.2.3"),
+	)
+
+	ctx, err := m.Context(context.Background())
+	require.NoError(t, err)
+	md, has := metadata.FromOutgoingContext(ctx)
+	require.True(t, has)
+
+	require.Equal(t, []string{version.FullVersion + ";my-framework/1.2.3"}, md.Get(internal.HeaderVersion))
+	require.Equal(t, "test-app", m.ApplicationName())
+	require.Equal(t, "test-app "+version.FullVersion+";my-framework/1.2.3", m.UserAgent())
+	require.Equal(t, version.FullVersion, internal.New("database", nil, &trace.Driver{}).UserAgent())
+}
+
+func TestMetaBuildInfoHeaderOnWire(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		opts      []internal.Option
+		buildInfo []string
+	}{
+		{
+			name:      "Default",
+			buildInfo: []string{version.FullVersion},
+		},
+		{
+			name: "Extra",
+			opts: []internal.Option{
+				internal.WithBuildInfoSuffixOption("my-framework/1.2.3"),
+				internal.WithBuildInfoExtraOption("build-id/42"),
+			},
+			buildInfo: []string{version.FullVersion + ";my-framework/1.2.3;build-id/42"},
+		},
+		{
+			name: "Disabled",
+			opts: []internal.Option{
+				internal.WithBuildInfoExtraOption("build-id/42"),
+				internal.WithoutBuildInfoHeaderOption(),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				received []metadata.MD
+			)
+			listener := bufconn.Listen(1 << 20)
+			server := grpc.NewServer(grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+				md, _ := metadata.FromIncomingContext(stream.Context())
+				mu.Lock()
+				received = append(received, md)
+				mu.Unlock()
+
+				return stream.SendMsg(&emptypb.Empty{})
+			}))
+			go func() {
+				_ = server.Serve(listener)
+			}()
+			defer server.Stop()
+
+			m := internal.New("database", nil, &trace.Driver{}, tt.opts...)
+
+			// every connection (such as new connection after re-discovery) sends same metadata of requests
+			for i := 0; i < 2; i++ {
+				cc, err := grpc.DialContext(context.Background(), "bufnet",
+					grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+						return listener.DialContext(ctx)
+					}),
+					grpc.WithTransportCredentials(insecure.NewCredentials()),
+				)
+				require.NoError(t, err)
+
+				ctx, err := m.Context(context.Background())
+				require.NoError(t, err)
+				require.NoError(t, cc.Invoke(ctx, "/Ydb.Test.V1.TestService/Unary", &emptypb.Empty{}, &emptypb.Empty{}))
+
+				ctx, err = m.Context(context.Background())
+				require.NoError(t, err)
+				stream, err := cc.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/Ydb.Test.V1.TestService/Stream")
+				require.NoError(t, err)
+				require.NoError(t, stream.SendMsg(&emptypb.Empty{}))
+				require.NoError(t, stream.CloseSend())
+				require.NoError(t, stream.RecvMsg(&emptypb.Empty{}))
+
+				require.NoError(t, cc.Close())
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Len(t, received, 4)
+			for _, md := range received {
+				require.Equal(t, tt.buildInfo, md.Get(internal.HeaderVersion))
+				require.Equal(t, []string{"database"}, md.Get(internal.HeaderDatabase))
+			}
+		})
+	}
+}
+
+type rotatedCredentials struct {
+	tokens       []string
+	invalidation int
+}
+
+func (c *rotatedCredentials) Token(context.Context) (string, error) {
+	return c.tokens[c.invalidation%len(c.tokens)], nil
+}
+
+func (c *rotatedCredentials) Invalidate() {
+	c.invalidation++
+}
+
+func TestMetaRecoverCredentials(t *testing.T) {
+	rejected := xerrors.Transport(grpcStatus.Error(grpcCodes.Unauthenticated, "token is revoked"))
+	t.Run("OncePerToken", func(t *testing.T) {
+		creds := &rotatedCredentials{tokens: []string{"revoked", "fresh"}}
+		m := internal.New("database", creds, &trace.Driver{})
+		// concurrent requests (each in own retry loop) with the same rejected token invalidate credentials once
+		requests := make([]context.Context, 3)
+		for i := range requests {
+			ctx, err := m.Context(xcontext.WithCredentialsRecovery(context.Background()))
+			require.NoError(t, err)
+			requests[i] = ctx
+		}
+		for _, ctx := range requests {
+			err := m.RecoverCredentials(ctx, rejected)
+			require.ErrorIs(t, err, rejected)
+			require.NotNil(t, xerrors.RetryableError(err))
+		}
+		require.Equal(t, 1, creds.invalidation)
+	})
+	t.Run("OncePerRetryLoop", func(t *testing.T) {
+		creds := &rotatedCredentials{tokens: []string{"revoked", "fresh"}}
+		m := internal.New("database", creds, &trace.Driver{})
+		retryCtx := xcontext.WithCredentialsRecovery(context.Background())
+		ctx, err := m.Context(retryCtx)
+		require.NoError(t, err)
+		require.NotNil(t, xerrors.RetryableError(m.RecoverCredentials(ctx, rejected)))
+
+		// fresh token is rejected too, so original error is returned without retry
+		ctx, err = m.Context(retryCtx)
+		require.NoError(t, err)
+		md, _ := metadata.FromOutgoingContext(ctx)
+		require.Equal(t, []string{"fresh"}, md.Get(internal.HeaderTicket))
+		err = m.RecoverCredentials(ctx, rejected)
+		require.Equal(t, rejected, err)
+		require.Nil(t, xerrors.RetryableError(err))
+		require.Equal(t, 2, creds.invalidation)
+	})
+	t.Run("WithoutRetryLoop", func(t *testing.T) {
+		creds := &rotatedCredentials{tokens: []string{"revoked", "fresh"}}
+		m := internal.New("database", creds, &trace.Driver{})
+		ctx, err := m.Context(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, rejected, m.RecoverCredentials(ctx, rejected))
+		require.Equal(t, 1, creds.invalidation)
+	})
+	t.Run("NotTokenError", func(t *testing.T) {
+		creds := &rotatedCredentials{tokens: []string{"token"}}
+		m := internal.New("database", creds, &trace.Driver{})
+		ctx, err := m.Context(context.Background())
+		require.NoError(t, err)
+		denied := xerrors.Transport(grpcStatus.Error(grpcCodes.PermissionDenied, "access denied"))
+		require.Equal(t, denied, m.RecoverCredentials(ctx, denied))
+		require.NoError(t, m.RecoverCredentials(ctx, nil))
+		require.Zero(t, creds.invalidation)
+	})
+	t.Run("NotRefreshable", func(t *testing.T) {
+		m := internal.New("database", credentials.NewAccessTokenCredentials("token"), &trace.Driver{})
+		ctx, err := m.Context(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, rejected, m.RecoverCredentials(ctx, rejected))
+	})
+}
@@ -0,0 +1,51 @@
+package meta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// UserMetadataPrefix is required prefix of keys of user metadata
+const UserMetadataPrefix = "x-"
+
+var errBadUserMetadataKey = xerrors.Wrap(errors.New("ydb: bad user metadata key"))
+
+// WithUserMetadata returns a copy of parent context with user metadata
+//
+// Keys of user metadata must have prefix UserMetadataPrefix, must not have reserved
+// prefix of ydb headers and must contain only lower-case latin letters, digits, '-', '_' and '.'
+func WithUserMetadata(ctx context.Context, md map[string]string) (context.Context, error) {
+	kv := make([]string, 0, len(md)*2) //nolint:gomnd
+	for key, value := range md {
+		if err := checkUserMetadataKey(key); err != nil {
+			return ctx, xerrors.WithStackTrace(err)
+		}
+		kv = append(kv, key, value)
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, kv...), nil
+}
+
+func checkUserMetadataKey(key string) error {
+	switch {
+	case len(key) <= len(UserMetadataPrefix) || !strings.HasPrefix(key, UserMetadataPrefix):
+		return fmt.Errorf("%w %q: key must have prefix %q", errBadUserMetadataKey, key, UserMetadataPrefix)
+	case strings.HasPrefix(key, "x-ydb-"):
+		return fmt.Errorf("%w %q: prefix %q reserved for ydb headers", errBadUserMetadataKey, key, "x-ydb-")
+	case strings.HasSuffix(key, "-bin"):
+		return fmt.Errorf("%w %q: binary headers not supported", errBadUserMetadataKey, key)
+	}
+	for _, r := range key {
+		if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return fmt.Errorf("%w %q: unexpected symbol %q", errBadUserMetadataKey, key, r)
+		}
+	}
+
+	return nil
+}
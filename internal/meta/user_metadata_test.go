@@ -0,0 +1,46 @@
+package meta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWithUserMetadata(t *testing.T) {
+	t.Run("OK", func(t *testing.T) {
+		ctx, err := WithUserMetadata(WithTraceID(context.Background(), "my-trace-id"), map[string]string{
+			"x-request-id":   "1",
+			"x-tenant.label": "tenant_1",
+		})
+		require.NoError(t, err)
+		md, has := metadata.FromOutgoingContext(ctx)
+		require.True(t, has)
+		require.Equal(t, []string{"1"}, md.Get("x-request-id"))
+		require.Equal(t, []string{"tenant_1"}, md.Get("x-tenant.label"))
+		require.Equal(t, []string{"my-trace-id"}, md.Get(HeaderTraceID))
+
+		ctx, err = (&Meta{database: "test"}).Context(ctx)
+		require.NoError(t, err)
+		md, has = metadata.FromOutgoingContext(ctx)
+		require.True(t, has)
+		require.Equal(t, []string{"1"}, md.Get("x-request-id"))
+		require.Equal(t, []string{"test"}, md.Get(HeaderDatabase))
+	})
+	for _, key := range []string{
+		"request-id",
+		"x-ydb-database",
+		"x-request-id-bin",
+		"X-Request-Id",
+		"x-request id",
+		"x-",
+	} {
+		t.Run(key, func(t *testing.T) {
+			ctx := context.Background()
+			userCtx, err := WithUserMetadata(ctx, map[string]string{"x-ok": "1", key: "1"})
+			require.ErrorIs(t, err, errBadUserMetadataKey)
+			require.Equal(t, ctx, userCtx)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package meta
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// MaxHeaderValueLength is a maximum length of application name and sdk build info suffix or extra part
+const MaxHeaderValueLength = 128
+
+var ErrInvalidHeaderValue = xerrors.Wrap(errors.New("invalid header value"))
+
+// ValidateHeaderValue checks that value can be sent as is in metadata of requests:
+// value must contains only printable ASCII characters and be no longer than MaxHeaderValueLength
+func ValidateHeaderValue(name, value string) error {
+	if len(value) > MaxHeaderValueLength {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s is longer than %d characters",
+			ErrInvalidHeaderValue, name, MaxHeaderValueLength,
+		))
+	}
+	for i := 0; i < len(value); i++ {
+		if value[i] < 0x20 || value[i] > 0x7E {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %s contains non-printable or non-ASCII character at %d",
+				ErrInvalidHeaderValue, name, i,
+			))
+		}
+	}
+
+	return nil
+}
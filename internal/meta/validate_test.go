@@ -0,0 +1,53 @@
+package meta
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateHeaderValue(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		value string
+		err   bool
+	}{
+		{
+			name:  "Empty",
+			value: "",
+		},
+		{
+			name:  "Printable",
+			value: "my-service/1.0 (build 42; env=prod)",
+		},
+		{
+			name:  "MaxLength",
+			value: strings.Repeat("a", MaxHeaderValueLength),
+		},
+		{
+			name:  "TooLong",
+			value: strings.Repeat("a", MaxHeaderValueLength+1),
+			err:   true,
+		},
+		{
+			name:  "NonASCII",
+			value: "сервис",
+			err:   true,
+		},
+		{
+			name:  "NewLine",
+			value: "service\n",
+			err:   true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHeaderValue("application name", tt.value)
+			if tt.err {
+				require.ErrorIs(t, err, ErrInvalidHeaderValue)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ type Conn struct {
 	NodeIDField   uint32
 	State         conn.State
 	LocalDCField  bool
+	InFlightField int
+	TrafficField  conn.Traffic
+	ServicesField []string
 }
 
 func (c *Conn) Invoke(
@@ -42,6 +45,7 @@ func (c *Conn) Endpoint() endpoint.Endpoint {
 		LocalDCField:  c.LocalDCField,
 		LocationField: c.LocationField,
 		NodeIDField:   c.NodeIDField,
+		ServicesField: c.ServicesField,
 	}
 }
 
@@ -49,6 +53,14 @@ func (c *Conn) LastUsage() time.Time {
 	panic("not implemented in mock")
 }
 
+func (c *Conn) InFlight() int {
+	return c.InFlightField
+}
+
+func (c *Conn) Traffic() conn.Traffic {
+	return c.TrafficField
+}
+
 func (c *Conn) Park(ctx context.Context) (err error) {
 	panic("not implemented in mock")
 }
@@ -82,6 +94,7 @@ type Endpoint struct {
 	LocationField string
 	NodeIDField   uint32
 	LocalDCField  bool
+	ServicesField []string
 }
 
 func (e *Endpoint) Choose(bool) {
@@ -115,6 +128,27 @@ func (e *Endpoint) LoadFactor() float32 {
 	panic("not implemented in mock")
 }
 
+func (e *Endpoint) Services() []string {
+	return e.ServicesField
+}
+
+func (e *Endpoint) HasService(name string) bool {
+	if len(e.ServicesField) == 0 {
+		return true
+	}
+	for _, service := range e.ServicesField {
+		if service == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Endpoint) SSL() bool {
+	panic("not implemented in mock")
+}
+
 func (e *Endpoint) String() string {
 	panic("not implemented in mock")
 }
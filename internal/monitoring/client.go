@@ -0,0 +1,212 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Monitoring_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Monitoring"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/monitoring/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/monitoring"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+//nolint:gofumpt
+//nolint:nolintlint
+var errNilClient = xerrors.Wrap(errors.New("monitoring client is not initialized"))
+
+var _ monitoring.Client = (*Client)(nil)
+
+type Client struct {
+	config  *config.Config
+	service Ydb_Monitoring_V1.MonitoringServiceClient
+}
+
+func New(ctx context.Context, cc grpc.ClientConnInterface, config *config.Config) *Client {
+	return &Client{
+		config:  config,
+		service: Ydb_Monitoring_V1.NewMonitoringServiceClient(cc),
+	}
+}
+
+func (c *Client) Close(_ context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	return nil
+}
+
+func (c *Client) SelfCheck(
+	ctx context.Context, opts ...monitoring.SelfCheckOption,
+) (result *monitoring.SelfCheckResult, _ error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+
+	var settings monitoring.SelfCheckSettings
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&settings)
+		}
+	}
+
+	err := c.do(ctx, func(ctx context.Context) (err error) {
+		result, err = c.selfCheck(ctx, settings)
+
+		return xerrors.WithStackTrace(err)
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return result, nil
+}
+
+func (c *Client) do(ctx context.Context, call func(ctx context.Context) error) error {
+	if !c.config.AutoRetry() {
+		return call(ctx)
+	}
+
+	return retry.Retry(ctx, call,
+		retry.WithStackTrace(),
+		retry.WithIdempotent(true),
+		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+	)
+}
+
+func (c *Client) selfCheck(
+	ctx context.Context, settings monitoring.SelfCheckSettings,
+) (*monitoring.SelfCheckResult, error) {
+	response, err := c.service.SelfCheck(ctx, &Ydb_Monitoring.SelfCheckRequest{
+		ReturnVerboseStatus: settings.Verbose,
+		MinimumStatus:       Ydb_Monitoring.StatusFlag_Status(settings.MinimumStatus),
+		MaximumLevel:        settings.MaximumLevel,
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	var result Ydb_Monitoring.SelfCheckResult
+	err = response.GetOperation().GetResult().UnmarshalTo(&result)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return &monitoring.SelfCheckResult{
+		Status: monitoring.SelfCheckStatus(result.GetSelfCheckResult()),
+		Issues: issueTree(result.GetIssueLog()),
+	}, nil
+}
+
+// issueTree links issues with their reasons and returns root issues in order of server response
+func issueTree(log []*Ydb_Monitoring.IssueLog) []*monitoring.Issue {
+	var (
+		issues     = make([]*monitoring.Issue, 0, len(log))
+		byID       = make(map[string]*monitoring.Issue, len(log))
+		referenced = make(map[string]struct{}, len(log))
+	)
+	for _, l := range log {
+		issue := &monitoring.Issue{
+			ID:        l.GetId(),
+			Status:    monitoring.Status(l.GetStatus()),
+			Message:   l.GetMessage(),
+			Type:      l.GetType(),
+			Level:     l.GetLevel(),
+			Count:     l.GetCount(),
+			Location:  location(l.GetLocation()),
+			ReasonIDs: l.GetReason(),
+		}
+		issues = append(issues, issue)
+		byID[issue.ID] = issue
+	}
+	for _, issue := range issues {
+		for _, id := range issue.ReasonIDs {
+			if reason, has := byID[id]; has && reason != issue {
+				issue.Reasons = append(issue.Reasons, reason)
+				referenced[id] = struct{}{}
+			}
+		}
+	}
+
+	roots := make([]*monitoring.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if _, has := referenced[issue.ID]; !has {
+			roots = append(roots, issue)
+		}
+	}
+
+	// issues in reason cycles are not reachable from roots, so they become roots too
+	reachable := monitoring.Flatten(roots...)
+	if len(reachable) < len(issues) {
+		visited := make(map[*monitoring.Issue]struct{}, len(reachable))
+		for _, issue := range reachable {
+			visited[issue] = struct{}{}
+		}
+		for _, issue := range issues {
+			if _, has := visited[issue]; !has {
+				roots = append(roots, issue)
+				for _, reason := range monitoring.Flatten(issue) {
+					visited[reason] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return roots
+}
+
+func location(l *Ydb_Monitoring.Location) *monitoring.Location {
+	if l == nil {
+		return nil
+	}
+
+	loc := &monitoring.Location{
+		Database: l.GetDatabase().GetName(),
+	}
+	if s := l.GetStorage(); s != nil {
+		loc.Storage = &monitoring.StorageLocation{
+			Node:     node(s.GetNode()),
+			Pool:     s.GetPool().GetName(),
+			GroupIDs: s.GetPool().GetGroup().GetId(),
+			VDiskIDs: s.GetPool().GetGroup().GetVdisk().GetId(),
+		}
+		for _, pdisk := range s.GetPool().GetGroup().GetVdisk().GetPdisk() {
+			loc.Storage.PDisks = append(loc.Storage.PDisks, monitoring.PDiskLocation{
+				ID:   pdisk.GetId(),
+				Path: pdisk.GetPath(),
+			})
+		}
+	}
+	if c := l.GetCompute(); c != nil {
+		loc.Compute = &monitoring.ComputeLocation{
+			Node: node(c.GetNode()),
+			Pool: c.GetPool().GetName(),
+		}
+		if t := c.GetTablet(); t != nil {
+			loc.Compute.Tablet = &monitoring.TabletLocation{
+				Type:  t.GetType(),
+				IDs:   t.GetId(),
+				Count: t.GetCount(),
+			}
+		}
+	}
+
+	return loc
+}
+
+func node(n *Ydb_Monitoring.LocationNode) *monitoring.NodeLocation {
+	if n == nil {
+		return nil
+	}
+
+	return &monitoring.NodeLocation{
+		ID:   n.GetId(),
+		Host: n.GetHost(),
+		Port: n.GetPort(),
+	}
+}
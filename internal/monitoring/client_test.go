@@ -0,0 +1,199 @@
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Monitoring_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Monitoring"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/monitoring/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/monitoring"
+)
+
+type serviceStub struct {
+	Ydb_Monitoring_V1.MonitoringServiceClient
+
+	selfCheck func(r *Ydb_Monitoring.SelfCheckRequest) (*Ydb_Monitoring.SelfCheckResponse, error)
+}
+
+func (s *serviceStub) SelfCheck(
+	ctx context.Context, in *Ydb_Monitoring.SelfCheckRequest, opts ...grpc.CallOption,
+) (*Ydb_Monitoring.SelfCheckResponse, error) {
+	return s.selfCheck(in)
+}
+
+func selfCheckResponse(t *testing.T, result *Ydb_Monitoring.SelfCheckResult) *Ydb_Monitoring.SelfCheckResponse {
+	a, err := anypb.New(result)
+	require.NoError(t, err)
+
+	return &Ydb_Monitoring.SelfCheckResponse{
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: a,
+		},
+	}
+}
+
+func TestClientSelfCheck(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("IssueTree", func(t *testing.T) {
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				selfCheck: func(r *Ydb_Monitoring.SelfCheckRequest) (*Ydb_Monitoring.SelfCheckResponse, error) {
+					require.True(t, r.GetReturnVerboseStatus())
+					require.Equal(t, Ydb_Monitoring.StatusFlag_YELLOW, r.GetMinimumStatus())
+					require.EqualValues(t, 3, r.GetMaximumLevel())
+
+					return selfCheckResponse(t, &Ydb_Monitoring.SelfCheckResult{
+						SelfCheckResult: Ydb_Monitoring.SelfCheck_DEGRADED,
+						IssueLog: []*Ydb_Monitoring.IssueLog{
+							{
+								Id:      "database",
+								Status:  Ydb_Monitoring.StatusFlag_ORANGE,
+								Message: "Database has storage issues",
+								Type:    "DATABASE",
+								Level:   1,
+								Reason:  []string{"group", "filtered"},
+								Location: &Ydb_Monitoring.Location{
+									Database: &Ydb_Monitoring.LocationDatabase{Name: "/local"},
+								},
+							},
+							{
+								Id:      "group",
+								Status:  Ydb_Monitoring.StatusFlag_RED,
+								Message: "Group failed",
+								Type:    "STORAGE_GROUP",
+								Level:   2,
+								Location: &Ydb_Monitoring.Location{
+									Storage: &Ydb_Monitoring.LocationStorage{
+										Node: &Ydb_Monitoring.LocationNode{Id: 1, Host: "host", Port: 19001},
+										Pool: &Ydb_Monitoring.LocationStoragePool{
+											Name: "pool",
+											Group: &Ydb_Monitoring.LocationStorageGroup{
+												Id: []string{"2181038080"},
+												Vdisk: &Ydb_Monitoring.LocationStorageVDisk{
+													Id:    []string{"vdisk"},
+													Pdisk: []*Ydb_Monitoring.LocationStoragePDisk{{Id: "pdisk", Path: "/dev/disk"}},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Id:     "tablets",
+								Status: Ydb_Monitoring.StatusFlag_YELLOW,
+								Type:   "COMPUTE_TABLET",
+								Level:  1,
+								Count:  2,
+								Location: &Ydb_Monitoring.Location{
+									Compute: &Ydb_Monitoring.LocationCompute{
+										Tablet: &Ydb_Monitoring.LocationComputeTablet{
+											Type:  "DataShard",
+											Id:    []string{"1", "2"},
+											Count: 2,
+										},
+									},
+								},
+							},
+						},
+					}), nil
+				},
+			},
+		}
+		result, err := c.SelfCheck(ctx,
+			monitoring.WithVerbose(),
+			monitoring.WithMinimumStatus(monitoring.StatusYellow),
+			monitoring.WithMaximumLevel(3),
+		)
+		require.NoError(t, err)
+		require.Equal(t, monitoring.SelfCheckStatusDegraded, result.Status)
+		require.Len(t, result.Issues, 2)
+
+		database := result.Issues[0]
+		require.Equal(t, "database", database.ID)
+		require.Equal(t, monitoring.StatusOrange, database.Status)
+		require.Equal(t, &monitoring.Location{Database: "/local"}, database.Location)
+		require.Equal(t, []string{"group", "filtered"}, database.ReasonIDs)
+		require.Len(t, database.Reasons, 1)
+
+		group := database.Reasons[0]
+		require.Equal(t, "group", group.ID)
+		require.Equal(t, &monitoring.Location{
+			Storage: &monitoring.StorageLocation{
+				Node:     &monitoring.NodeLocation{ID: 1, Host: "host", Port: 19001},
+				Pool:     "pool",
+				GroupIDs: []string{"2181038080"},
+				VDiskIDs: []string{"vdisk"},
+				PDisks:   []monitoring.PDiskLocation{{ID: "pdisk", Path: "/dev/disk"}},
+			},
+		}, group.Location)
+
+		tablets := result.Issues[1]
+		require.Equal(t, "tablets", tablets.ID)
+		require.EqualValues(t, 2, tablets.Count)
+		require.Equal(t, &monitoring.TabletLocation{
+			Type:  "DataShard",
+			IDs:   []string{"1", "2"},
+			Count: 2,
+		}, tablets.Location.Compute.Tablet)
+
+		flat := result.Flatten()
+		require.Equal(t, []*monitoring.Issue{group, database, tablets}, flat)
+	})
+	t.Run("ReasonCycle", func(t *testing.T) {
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				selfCheck: func(r *Ydb_Monitoring.SelfCheckRequest) (*Ydb_Monitoring.SelfCheckResponse, error) {
+					return selfCheckResponse(t, &Ydb_Monitoring.SelfCheckResult{
+						SelfCheckResult: Ydb_Monitoring.SelfCheck_EMERGENCY,
+						IssueLog: []*Ydb_Monitoring.IssueLog{
+							{Id: "a", Status: Ydb_Monitoring.StatusFlag_RED, Reason: []string{"b"}},
+							{Id: "b", Status: Ydb_Monitoring.StatusFlag_RED, Reason: []string{"a"}},
+						},
+					}), nil
+				},
+			},
+		}
+		result, err := c.SelfCheck(ctx)
+		require.NoError(t, err)
+		require.Len(t, result.Issues, 1)
+		require.Len(t, result.Flatten(), 2)
+	})
+	t.Run("RetryTransportError", func(t *testing.T) {
+		attempts := 0
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				selfCheck: func(r *Ydb_Monitoring.SelfCheckRequest) (*Ydb_Monitoring.SelfCheckResponse, error) {
+					attempts++
+					if attempts == 1 {
+						return nil, xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+					}
+
+					return selfCheckResponse(t, &Ydb_Monitoring.SelfCheckResult{
+						SelfCheckResult: Ydb_Monitoring.SelfCheck_GOOD,
+					}), nil
+				},
+			},
+		}
+		result, err := c.SelfCheck(ctx)
+		require.NoError(t, err)
+		require.Equal(t, monitoring.SelfCheckStatusGood, result.Status)
+		require.Empty(t, result.Issues)
+		require.Equal(t, 2, attempts)
+	})
+}
@@ -0,0 +1,348 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Import"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+//nolint:gofumpt
+//nolint:nolintlint
+var errNilClient = xerrors.Wrap(errors.New("operation client is not initialized"))
+
+var _ operation.Client = (*Client)(nil)
+
+type Client struct {
+	config  *config.Config
+	service Ydb_Operation_V1.OperationServiceClient
+}
+
+func New(ctx context.Context, cc grpc.ClientConnInterface, config *config.Config) *Client {
+	return &Client{
+		config:  config,
+		service: Ydb_Operation_V1.NewOperationServiceClient(cc),
+	}
+}
+
+func (c *Client) Close(_ context.Context) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, call func(ctx context.Context) error) error {
+	if !c.config.AutoRetry() {
+		return call(ctx)
+	}
+
+	return retry.Retry(ctx, call,
+		retry.WithStackTrace(),
+		retry.WithIdempotent(true),
+		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+	)
+}
+
+func (c *Client) Get(ctx context.Context, id string) (op *operation.Operation, _ error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+
+	err := c.do(ctx, func(ctx context.Context) (err error) {
+		op, err = c.get(ctx, id)
+
+		return xerrors.WithStackTrace(err)
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return op, nil
+}
+
+func (c *Client) get(ctx context.Context, id string) (*operation.Operation, error) {
+	// connection wrapping treats not ready operation as error, but not ready operation is normal result of Get
+	response, err := c.service.GetOperation(conn.WithoutWrapping(ctx), &Ydb_Operations.GetOperationRequest{
+		Id: id,
+	})
+	if err != nil {
+		if xerrors.IsContextError(err) {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return nil, xerrors.WithStackTrace(xerrors.Transport(err))
+	}
+
+	return fromOperation(response.GetOperation())
+}
+
+func (c *Client) List(
+	ctx context.Context, kind operation.Kind, pageSize uint64, pageToken string,
+) (result *operation.ListResult, _ error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+
+	err := c.do(ctx, func(ctx context.Context) (err error) {
+		result, err = c.list(ctx, kind, pageSize, pageToken)
+
+		return xerrors.WithStackTrace(err)
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return result, nil
+}
+
+func (c *Client) list(
+	ctx context.Context, kind operation.Kind, pageSize uint64, pageToken string,
+) (*operation.ListResult, error) {
+	response, err := c.service.ListOperations(ctx, &Ydb_Operations.ListOperationsRequest{
+		Kind:      string(kind),
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return nil, xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(response)))
+	}
+
+	result := &operation.ListResult{
+		Operations:    make([]*operation.Operation, 0, len(response.GetOperations())),
+		NextPageToken: response.GetNextPageToken(),
+	}
+	for _, o := range response.GetOperations() {
+		op, err := fromOperation(o)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		result.Operations = append(result.Operations, op)
+	}
+
+	return result, nil
+}
+
+func (c *Client) Cancel(ctx context.Context, id string) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	return c.do(ctx, func(ctx context.Context) error {
+		response, err := c.service.CancelOperation(ctx, &Ydb_Operations.CancelOperationRequest{
+			Id: id,
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(response)))
+		}
+
+		return nil
+	})
+}
+
+func (c *Client) Forget(ctx context.Context, id string) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	return c.do(ctx, func(ctx context.Context) error {
+		response, err := c.service.ForgetOperation(ctx, &Ydb_Operations.ForgetOperationRequest{
+			Id: id,
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(response)))
+		}
+
+		return nil
+	})
+}
+
+func (c *Client) Wait(ctx context.Context, id string, pollInterval time.Duration) (*operation.Operation, error) {
+	for {
+		op, err := c.Get(ctx, id)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if op.Ready {
+			if op.Err != nil {
+				return op, xerrors.WithStackTrace(op.Err)
+			}
+
+			return op, nil
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return op, xerrors.WithStackTrace(ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+func fromOperation(o *Ydb_Operations.Operation) (*operation.Operation, error) {
+	op := &operation.Operation{
+		ID:    o.GetId(),
+		Ready: o.GetReady(),
+	}
+	if op.Ready && o.GetStatus() != Ydb.StatusIds_SUCCESS {
+		op.Err = xerrors.Operation(xerrors.FromOperation(o))
+	}
+
+	metadata, err := fromMetadata(o.GetMetadata())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	op.Metadata = metadata
+
+	return op, nil
+}
+
+func fromMetadata(md *anypb.Any) (operation.Metadata, error) {
+	var (
+		indexBuild Ydb_Table.IndexBuildMetadata
+		export     Ydb_Export.ExportToS3Metadata
+		imp        Ydb_Import.ImportFromS3Metadata
+		script     Ydb_Query.ExecuteScriptMetadata
+	)
+	switch {
+	case md == nil:
+		return nil, nil //nolint:nilnil
+	case md.MessageIs(&indexBuild):
+		if err := md.UnmarshalTo(&indexBuild); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return &operation.IndexBuildMetadata{
+			Path:     indexBuild.GetDescription().GetPath(),
+			Index:    indexBuild.GetDescription().GetIndex().GetName(),
+			State:    operation.IndexBuildState(indexBuild.GetState()),
+			Progress: indexBuild.GetProgress(),
+		}, nil
+	case md.MessageIs(&export):
+		if err := md.UnmarshalTo(&export); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		metadata := &operation.ExportMetadata{
+			Progress: exportProgress(export.GetProgress()),
+			Items:    make([]operation.BackupItemProgress, 0, len(export.GetItemsProgress())),
+		}
+		for _, item := range export.GetItemsProgress() {
+			metadata.Items = append(metadata.Items, backupItemProgress(item))
+		}
+
+		return metadata, nil
+	case md.MessageIs(&imp):
+		if err := md.UnmarshalTo(&imp); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		metadata := &operation.ImportMetadata{
+			Progress: importProgress(imp.GetProgress()),
+			Items:    make([]operation.BackupItemProgress, 0, len(imp.GetItemsProgress())),
+		}
+		for _, item := range imp.GetItemsProgress() {
+			metadata.Items = append(metadata.Items, backupItemProgress(item))
+		}
+
+		return metadata, nil
+	case md.MessageIs(&script):
+		if err := md.UnmarshalTo(&script); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+
+		return &operation.ScriptExecutionMetadata{
+			ExecutionID:     script.GetExecutionId(),
+			Status:          operation.ScriptExecutionStatus(script.GetExecStatus()),
+			ResultSetsCount: len(script.GetResultSetsMeta()),
+		}, nil
+	default:
+		return nil, nil //nolint:nilnil
+	}
+}
+
+func exportProgress(p Ydb_Export.ExportProgress_Progress) operation.BackupProgress {
+	switch p {
+	case Ydb_Export.ExportProgress_PROGRESS_PREPARING:
+		return operation.BackupProgressPreparing
+	case Ydb_Export.ExportProgress_PROGRESS_TRANSFER_DATA:
+		return operation.BackupProgressTransferData
+	case Ydb_Export.ExportProgress_PROGRESS_DONE:
+		return operation.BackupProgressDone
+	case Ydb_Export.ExportProgress_PROGRESS_CANCELLATION:
+		return operation.BackupProgressCancellation
+	case Ydb_Export.ExportProgress_PROGRESS_CANCELLED:
+		return operation.BackupProgressCancelled
+	default:
+		return operation.BackupProgressUnspecified
+	}
+}
+
+func importProgress(p Ydb_Import.ImportProgress_Progress) operation.BackupProgress {
+	switch p {
+	case Ydb_Import.ImportProgress_PROGRESS_PREPARING:
+		return operation.BackupProgressPreparing
+	case Ydb_Import.ImportProgress_PROGRESS_TRANSFER_DATA:
+		return operation.BackupProgressTransferData
+	case Ydb_Import.ImportProgress_PROGRESS_BUILD_INDEXES:
+		return operation.BackupProgressBuildIndexes
+	case Ydb_Import.ImportProgress_PROGRESS_DONE:
+		return operation.BackupProgressDone
+	case Ydb_Import.ImportProgress_PROGRESS_CANCELLATION:
+		return operation.BackupProgressCancellation
+	case Ydb_Import.ImportProgress_PROGRESS_CANCELLED:
+		return operation.BackupProgressCancelled
+	default:
+		return operation.BackupProgressUnspecified
+	}
+}
+
+type itemProgress interface {
+	GetPartsTotal() uint32
+	GetPartsCompleted() uint32
+	GetStartTime() *timestamppb.Timestamp
+	GetEndTime() *timestamppb.Timestamp
+}
+
+func backupItemProgress(item itemProgress) operation.BackupItemProgress {
+	progress := operation.BackupItemProgress{
+		PartsTotal:     item.GetPartsTotal(),
+		PartsCompleted: item.GetPartsCompleted(),
+	}
+	if t := item.GetStartTime(); t != nil {
+		progress.StartTime = t.AsTime()
+	}
+	if t := item.GetEndTime(); t != nil {
+		progress.EndTime = t.AsTime()
+	}
+
+	return progress
+}
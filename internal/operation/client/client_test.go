@@ -0,0 +1,320 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Operation_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Export"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+)
+
+type serviceStub struct {
+	Ydb_Operation_V1.OperationServiceClient
+
+	get    func(id string) (*Ydb_Operations.GetOperationResponse, error)
+	list   func(r *Ydb_Operations.ListOperationsRequest) (*Ydb_Operations.ListOperationsResponse, error)
+	cancel func(id string) (*Ydb_Operations.CancelOperationResponse, error)
+	forget func(id string) (*Ydb_Operations.ForgetOperationResponse, error)
+}
+
+func (s *serviceStub) GetOperation(
+	ctx context.Context, in *Ydb_Operations.GetOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.GetOperationResponse, error) {
+	return s.get(in.GetId())
+}
+
+func (s *serviceStub) ListOperations(
+	ctx context.Context, in *Ydb_Operations.ListOperationsRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.ListOperationsResponse, error) {
+	return s.list(in)
+}
+
+func (s *serviceStub) CancelOperation(
+	ctx context.Context, in *Ydb_Operations.CancelOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.CancelOperationResponse, error) {
+	return s.cancel(in.GetId())
+}
+
+func (s *serviceStub) ForgetOperation(
+	ctx context.Context, in *Ydb_Operations.ForgetOperationRequest, opts ...grpc.CallOption,
+) (*Ydb_Operations.ForgetOperationResponse, error) {
+	return s.forget(in.GetId())
+}
+
+func indexBuildOperation(t *testing.T, ready bool, progress float32) *Ydb_Operations.Operation {
+	metadata, err := anypb.New(&Ydb_Table.IndexBuildMetadata{
+		Description: &Ydb_Table.IndexBuildDescription{
+			Path:  "/local/table",
+			Index: &Ydb_Table.TableIndex{Name: "idx"},
+		},
+		State:    Ydb_Table.IndexBuildState_STATE_TRANSFERING_DATA,
+		Progress: progress,
+	})
+	require.NoError(t, err)
+
+	return &Ydb_Operations.Operation{
+		Id:       "op",
+		Ready:    ready,
+		Status:   Ydb.StatusIds_SUCCESS,
+		Metadata: metadata,
+	}
+}
+
+func TestClientGet(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("IndexBuild", func(t *testing.T) {
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				get: func(id string) (*Ydb_Operations.GetOperationResponse, error) {
+					require.Equal(t, "op", id)
+
+					return &Ydb_Operations.GetOperationResponse{
+						Operation: indexBuildOperation(t, false, 42),
+					}, nil
+				},
+			},
+		}
+		op, err := c.Get(ctx, "op")
+		require.NoError(t, err)
+		require.Equal(t, &operation.Operation{
+			ID:    "op",
+			Ready: false,
+			Metadata: &operation.IndexBuildMetadata{
+				Path:     "/local/table",
+				Index:    "idx",
+				State:    operation.IndexBuildStateTransferringData,
+				Progress: 42,
+			},
+		}, op)
+	})
+	t.Run("Export", func(t *testing.T) {
+		metadata, err := anypb.New(&Ydb_Export.ExportToS3Metadata{
+			Progress: Ydb_Export.ExportProgress_PROGRESS_TRANSFER_DATA,
+			ItemsProgress: []*Ydb_Export.ExportItemProgress{{
+				PartsTotal:     10,
+				PartsCompleted: 3,
+				StartTime:      timestamppb.New(time.Unix(100, 0)),
+			}},
+		})
+		require.NoError(t, err)
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				get: func(id string) (*Ydb_Operations.GetOperationResponse, error) {
+					return &Ydb_Operations.GetOperationResponse{
+						Operation: &Ydb_Operations.Operation{
+							Id:       id,
+							Metadata: metadata,
+						},
+					}, nil
+				},
+			},
+		}
+		op, err := c.Get(ctx, "op")
+		require.NoError(t, err)
+		require.Equal(t, &operation.ExportMetadata{
+			Progress: operation.BackupProgressTransferData,
+			Items: []operation.BackupItemProgress{{
+				PartsTotal:     10,
+				PartsCompleted: 3,
+				StartTime:      time.Unix(100, 0).UTC(),
+			}},
+		}, op.Metadata)
+	})
+	t.Run("ScriptExecution", func(t *testing.T) {
+		metadata, err := anypb.New(&Ydb_Query.ExecuteScriptMetadata{
+			ExecutionId:    "execution",
+			ExecStatus:     Ydb_Query.ExecStatus_EXEC_STATUS_COMPLETED,
+			ResultSetsMeta: []*Ydb_Query.ResultSetMeta{{}, {}},
+		})
+		require.NoError(t, err)
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				get: func(id string) (*Ydb_Operations.GetOperationResponse, error) {
+					return &Ydb_Operations.GetOperationResponse{
+						Operation: &Ydb_Operations.Operation{
+							Id:       id,
+							Ready:    true,
+							Status:   Ydb.StatusIds_SUCCESS,
+							Metadata: metadata,
+						},
+					}, nil
+				},
+			},
+		}
+		op, err := c.Get(ctx, "op")
+		require.NoError(t, err)
+		require.Equal(t, &operation.ScriptExecutionMetadata{
+			ExecutionID:     "execution",
+			Status:          operation.ScriptExecutionStatusCompleted,
+			ResultSetsCount: 2,
+		}, op.Metadata)
+	})
+	t.Run("FailedOperation", func(t *testing.T) {
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				get: func(id string) (*Ydb_Operations.GetOperationResponse, error) {
+					return &Ydb_Operations.GetOperationResponse{
+						Operation: &Ydb_Operations.Operation{
+							Id:     id,
+							Ready:  true,
+							Status: Ydb.StatusIds_CANCELLED,
+						},
+					}, nil
+				},
+			},
+		}
+		op, err := c.Get(ctx, "op")
+		require.NoError(t, err)
+		require.True(t, op.Ready)
+		require.True(t, xerrors.IsOperationError(op.Err, Ydb.StatusIds_CANCELLED))
+		require.Nil(t, op.Metadata)
+	})
+	t.Run("RetryTransportError", func(t *testing.T) {
+		attempts := 0
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				get: func(id string) (*Ydb_Operations.GetOperationResponse, error) {
+					attempts++
+					if attempts == 1 {
+						return nil, grpcStatus.Error(grpcCodes.Unavailable, "")
+					}
+
+					return &Ydb_Operations.GetOperationResponse{
+						Operation: &Ydb_Operations.Operation{
+							Id:     id,
+							Ready:  true,
+							Status: Ydb.StatusIds_SUCCESS,
+						},
+					}, nil
+				},
+			},
+		}
+		op, err := c.Get(ctx, "op")
+		require.NoError(t, err)
+		require.True(t, op.Ready)
+		require.Equal(t, 2, attempts)
+	})
+}
+
+func TestClientList(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := &Client{
+		config: config.New(),
+		service: &serviceStub{
+			list: func(r *Ydb_Operations.ListOperationsRequest) (*Ydb_Operations.ListOperationsResponse, error) {
+				require.Equal(t, "buildindex", r.GetKind())
+				require.EqualValues(t, 10, r.GetPageSize())
+				require.Equal(t, "token", r.GetPageToken())
+
+				return &Ydb_Operations.ListOperationsResponse{
+					Status:        Ydb.StatusIds_SUCCESS,
+					Operations:    []*Ydb_Operations.Operation{indexBuildOperation(t, true, 100)},
+					NextPageToken: "next",
+				}, nil
+			},
+		},
+	}
+	result, err := c.List(ctx, operation.KindBuildIndex, 10, "token")
+	require.NoError(t, err)
+	require.Equal(t, "next", result.NextPageToken)
+	require.Len(t, result.Operations, 1)
+	require.Equal(t, "op", result.Operations[0].ID)
+	require.True(t, result.Operations[0].Ready)
+}
+
+func TestClientCancelAndForget(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := &Client{
+		config: config.New(),
+		service: &serviceStub{
+			cancel: func(id string) (*Ydb_Operations.CancelOperationResponse, error) {
+				return &Ydb_Operations.CancelOperationResponse{Status: Ydb.StatusIds_SUCCESS}, nil
+			},
+			forget: func(id string) (*Ydb_Operations.ForgetOperationResponse, error) {
+				return &Ydb_Operations.ForgetOperationResponse{Status: Ydb.StatusIds_NOT_FOUND}, nil
+			},
+		},
+	}
+	require.NoError(t, c.Cancel(ctx, "op"))
+	err := c.Forget(ctx, "op")
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND))
+}
+
+func TestClientWait(t *testing.T) {
+	ctx := xtest.Context(t)
+	t.Run("Ready", func(t *testing.T) {
+		polls := 0
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				get: func(id string) (*Ydb_Operations.GetOperationResponse, error) {
+					polls++
+
+					return &Ydb_Operations.GetOperationResponse{
+						Operation: indexBuildOperation(t, polls == 3, float32(polls)),
+					}, nil
+				},
+			},
+		}
+		op, err := c.Wait(ctx, "op", time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, op.Ready)
+		require.Equal(t, 3, polls)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				get: func(id string) (*Ydb_Operations.GetOperationResponse, error) {
+					return &Ydb_Operations.GetOperationResponse{
+						Operation: &Ydb_Operations.Operation{
+							Id:     id,
+							Ready:  true,
+							Status: Ydb.StatusIds_PRECONDITION_FAILED,
+						},
+					}, nil
+				},
+			},
+		}
+		op, err := c.Wait(ctx, "op", time.Millisecond)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_PRECONDITION_FAILED))
+		require.True(t, op.Ready)
+	})
+	t.Run("ContextDone", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		c := &Client{
+			config: config.New(),
+			service: &serviceStub{
+				get: func(id string) (*Ydb_Operations.GetOperationResponse, error) {
+					return &Ydb_Operations.GetOperationResponse{
+						Operation: indexBuildOperation(t, false, 0),
+					}, nil
+				},
+			},
+		}
+		_, err := c.Wait(ctx, "op", time.Millisecond)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
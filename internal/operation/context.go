@@ -21,6 +21,32 @@ func WithTimeout(ctx context.Context, operationTimeout time.Duration) context.Co
 	return context.WithValue(ctx, ctxOperationTimeoutKey{}, operationTimeout)
 }
 
+// WithGrownTimeout returns a copy of parent context in which YDB operation timeout
+// parameter is set to d regardless of parent context timeout.
+// It is used for timeout growing of next attempts in retry loop
+func WithGrownTimeout(ctx context.Context, operationTimeout time.Duration) context.Context {
+	return context.WithValue(ctx, ctxOperationTimeoutKey{}, operationTimeout)
+}
+
+// Timeout returns YDB operation timeout from context or defaultTimeout if context has no operation timeout
+func Timeout(ctx context.Context, defaultTimeout time.Duration) time.Duration {
+	if d, ok := ctxTimeout(ctx); ok {
+		return d
+	}
+
+	return defaultTimeout
+}
+
+// HasTimeout reports whether YDB operation timeout or cancel after parameter is set in context
+func HasTimeout(ctx context.Context) bool {
+	if _, ok := ctxTimeout(ctx); ok {
+		return true
+	}
+	_, ok := ctxCancelAfter(ctx)
+
+	return ok
+}
+
 // WithCancelAfter returns a copy of parent context in which YDB operation
 // cancel after parameter is set to d. If parent context cancellation timeout is smaller
 // than d, parent context is returned.
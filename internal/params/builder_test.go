@@ -354,9 +354,9 @@ func TestBuilder(t *testing.T) {
 				},
 				Value: &Ydb.Value{
 					Value: &Ydb.Value_Low_128{
-						Low_128: 651345242494996240,
+						Low_128: 506660481424032516,
 					},
-					High_128: 72623859790382856,
+					High_128: 1157159078456920585,
 				},
 			},
 		},
@@ -0,0 +1,612 @@
+package params
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var ErrParamsMismatch = xerrors.Wrap(errors.New("query parameters do not match declared parameters"))
+
+// FromMap makes parameters of declared types from JSON-decoded values.
+// Names of parameters may be with or without leading '$'.
+// Omitted parameters of optional types are NULL
+func FromMap(m map[string]any, declared map[string]types.Type) (*Parameters, error) {
+	provided := make(map[string]any, len(m))
+	for name, v := range m {
+		provided[paramName(name)] = v
+	}
+	declaredTypes := make(map[string]types.Type, len(declared))
+	for name, t := range declared {
+		declaredTypes[paramName(name)] = t
+	}
+
+	names := make([]string, 0, len(declaredTypes)+len(provided))
+	for name := range declaredTypes {
+		names = append(names, name)
+	}
+	for name := range provided {
+		if _, has := declaredTypes[name]; !has {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var (
+		parameters = make(Parameters, 0, len(declaredTypes))
+		mismatches []string
+	)
+	for _, name := range names {
+		t, isDeclared := declaredTypes[name]
+		v, isProvided := provided[name]
+		switch {
+		case !isDeclared:
+			mismatches = append(mismatches, fmt.Sprintf("%s: not declared", name))
+		case !isProvided:
+			if _, isOptional := t.(types.Optional); !isOptional {
+				mismatches = append(mismatches, fmt.Sprintf("%s: declared %s, not provided", name, t.Yql()))
+
+				continue
+			}
+			parameters = append(parameters, Named(name, value.ZeroValue(t)))
+		default:
+			vv, err := coercer{}.valueFromAny(t, v)
+			if err != nil {
+				mismatches = append(mismatches, withPath(err, name).Error())
+
+				continue
+			}
+			parameters = append(parameters, Named(name, vv))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrParamsMismatch, strings.Join(mismatches, "; ")))
+	}
+
+	return &parameters, nil
+}
+
+// ValueFromJSON makes value of type t from JSON representation of value.ToJSON
+func ValueFromJSON(data []byte, t types.Type) (value.Value, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %w", value.ErrInvalidJSON, err))
+	}
+	if decoder.More() {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: unexpected data after JSON value", value.ErrInvalidJSON))
+	}
+
+	vv, err := coercer{fromJSON: true}.valueFromAny(t, v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %w", value.ErrInvalidJSON, err))
+	}
+
+	return vv, nil
+}
+
+func paramName(name string) string {
+	if strings.HasPrefix(name, "$") {
+		return name
+	}
+
+	return "$" + name
+}
+
+// coerceError is an error of value coercion with path inside of parameter
+type coerceError struct {
+	path string
+	msg  string
+}
+
+func (e *coerceError) Error() string {
+	if e.path == "" {
+		return e.msg
+	}
+
+	return e.path + ": " + e.msg
+}
+
+func coerceErrorf(t types.Type, v any, format string, args ...any) *coerceError {
+	return &coerceError{
+		msg: fmt.Sprintf("cannot coerce %T (%v) to %s: %s", v, v, t.Yql(), fmt.Sprintf(format, args...)),
+	}
+}
+
+func withPath(err error, path string) error {
+	var e *coerceError
+	if errors.As(err, &e) {
+		e.path = path + e.path
+	}
+
+	return err
+}
+
+// coercer makes values of YDB types from JSON-decoded values
+type coercer struct {
+	// fromJSON enables decoding of representation of ToJSON:
+	// base64 strings of Bytes and Yson, arrays of [key, value] pairs of Dict and re-marshaling of Json
+	fromJSON bool
+}
+
+//nolint:funlen
+func (c coercer) valueFromAny(t types.Type, v any) (value.Value, error) {
+	switch t := t.(type) {
+	case types.Optional:
+		if v == nil {
+			return value.NullValue(t.InnerType()), nil
+		}
+		vv, err := c.valueFromAny(t.InnerType(), v)
+		if err != nil {
+			return nil, err
+		}
+
+		return value.OptionalValue(vv), nil
+	case types.Primitive:
+		return c.primitiveFromAny(t, v)
+	case *types.Decimal:
+		var s string
+		switch vv := v.(type) {
+		case string:
+			s = vv
+		case json.Number:
+			s = vv.String()
+		case float64:
+			s = strconv.FormatFloat(vv, 'f', -1, 64)
+		default:
+			return nil, coerceErrorf(t, v, "expected string or number")
+		}
+		d, err := decimal.Parse(s, t.Precision(), t.Scale())
+		if err != nil {
+			return nil, coerceErrorf(t, v, "%v", err)
+		}
+
+		return value.DecimalValueFromBigInt(d, t.Precision(), t.Scale()), nil
+	case *types.Void:
+		if v != nil {
+			return nil, coerceErrorf(t, v, "expected null")
+		}
+
+		return value.VoidValue(), nil
+	case *types.Tagged:
+		vv, err := c.valueFromAny(t.InnerType(), v)
+		if err != nil {
+			return nil, err
+		}
+
+		return value.TaggedValue(t.Tag(), vv), nil
+	case *types.List:
+		items, err := c.itemsFromAny(t, t.ItemType(), v)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return value.ZeroValue(t), nil
+		}
+
+		return value.ListValue(items...), nil
+	case *types.Set:
+		items, err := c.itemsFromAny(t, t.ItemType(), v)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return value.ZeroValue(t), nil
+		}
+
+		return value.SetValue(items...), nil
+	case *types.Tuple:
+		return c.tupleFromAny(t, v)
+	case *types.Struct:
+		return c.structFromAny(t, v)
+	case *types.Dict:
+		return c.dictFromAny(t, v)
+	default:
+		return nil, coerceErrorf(t, v, "unsupported type")
+	}
+}
+
+func (c coercer) itemsFromAny(t, itemType types.Type, v any) ([]value.Value, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, coerceErrorf(t, v, "expected array")
+	}
+	items := make([]value.Value, 0, len(list))
+	for i := range list {
+		item, err := c.valueFromAny(itemType, list[i])
+		if err != nil {
+			return nil, withPath(err, "["+strconv.Itoa(i)+"]")
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (c coercer) tupleFromAny(t *types.Tuple, v any) (value.Value, error) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, coerceErrorf(t, v, "expected array")
+	}
+	innerTypes := t.InnerTypes()
+	if len(list) != len(innerTypes) {
+		return nil, coerceErrorf(t, v, "expected %d items, got %d", len(innerTypes), len(list))
+	}
+	items := make([]value.Value, 0, len(list))
+	for i := range list {
+		item, err := c.valueFromAny(innerTypes[i], list[i])
+		if err != nil {
+			return nil, withPath(err, "["+strconv.Itoa(i)+"]")
+		}
+		items = append(items, item)
+	}
+
+	return value.TupleValue(items...), nil
+}
+
+func (c coercer) structFromAny(t *types.Struct, v any) (value.Value, error) {
+	object, ok := v.(map[string]any)
+	if !ok {
+		return nil, coerceErrorf(t, v, "expected object")
+	}
+	fields := t.Fields()
+	known := make(map[string]struct{}, len(fields))
+	values := make([]value.StructValueField, 0, len(fields))
+	for _, f := range fields {
+		known[f.Name] = struct{}{}
+		fv, has := object[f.Name]
+		if !has {
+			if _, isOptional := f.T.(types.Optional); !isOptional {
+				return nil, withPath(coerceErrorf(t, v, "field not provided"), "."+f.Name)
+			}
+		}
+		vv, err := c.valueFromAny(f.T, fv)
+		if err != nil {
+			return nil, withPath(err, "."+f.Name)
+		}
+		values = append(values, value.StructValueField{Name: f.Name, V: vv})
+	}
+	unknown := make([]string, 0)
+	for name := range object {
+		if _, has := known[name]; !has {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		return nil, coerceErrorf(t, v, "unknown fields %v", unknown)
+	}
+
+	return value.StructValue(values...), nil
+}
+
+func (c coercer) dictFromAny(t *types.Dict, v any) (value.Value, error) {
+	if pairs, isArray := v.([]any); isArray && c.fromJSON {
+		return c.dictFromPairs(t, pairs)
+	}
+	object, ok := v.(map[string]any)
+	if !ok {
+		return nil, coerceErrorf(t, v, "expected object")
+	}
+	if len(object) == 0 {
+		return value.ZeroValue(t), nil
+	}
+	keys := make([]string, 0, len(object))
+	for k := range object {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fields := make([]value.DictValueField, 0, len(object))
+	for _, k := range keys {
+		kv, err := c.valueFromAny(t.KeyType(), k)
+		if err != nil {
+			return nil, withPath(err, "["+strconv.Quote(k)+"]")
+		}
+		vv, err := c.valueFromAny(t.ValueType(), object[k])
+		if err != nil {
+			return nil, withPath(err, "["+strconv.Quote(k)+"]")
+		}
+		fields = append(fields, value.DictValueField{K: kv, V: vv})
+	}
+
+	return value.DictValue(fields...), nil
+}
+
+// dictFromPairs makes Dict from array of [key, value] pairs
+func (c coercer) dictFromPairs(t *types.Dict, pairs []any) (value.Value, error) {
+	if len(pairs) == 0 {
+		return value.ZeroValue(t), nil
+	}
+	fields := make([]value.DictValueField, 0, len(pairs))
+	for i := range pairs {
+		pair, ok := pairs[i].([]any)
+		if !ok || len(pair) != 2 { //nolint:gomnd
+			return nil, withPath(coerceErrorf(t, pairs[i], "expected [key, value] pair"), "["+strconv.Itoa(i)+"]")
+		}
+		kv, err := c.valueFromAny(t.KeyType(), pair[0])
+		if err != nil {
+			return nil, withPath(err, "["+strconv.Itoa(i)+"][0]")
+		}
+		vv, err := c.valueFromAny(t.ValueType(), pair[1])
+		if err != nil {
+			return nil, withPath(err, "["+strconv.Itoa(i)+"][1]")
+		}
+		fields = append(fields, value.DictValueField{K: kv, V: vv})
+	}
+
+	return value.DictValue(fields...), nil
+}
+
+//nolint:funlen,gocyclo
+func (c coercer) primitiveFromAny(t types.Primitive, v any) (value.Value, error) {
+	switch t {
+	case types.Bool:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, coerceErrorf(t, v, "expected boolean")
+		}
+
+		return value.BoolValue(b), nil
+	case types.Int8, types.Int16, types.Int32, types.Int64:
+		n, err := intFromAny(t, v)
+		if err != nil {
+			return nil, err
+		}
+		switch t {
+		case types.Int8:
+			return value.Int8Value(int8(n)), nil
+		case types.Int16:
+			return value.Int16Value(int16(n)), nil
+		case types.Int32:
+			return value.Int32Value(int32(n)), nil
+		default:
+			return value.Int64Value(n), nil
+		}
+	case types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		n, err := uintFromAny(t, v)
+		if err != nil {
+			return nil, err
+		}
+		switch t {
+		case types.Uint8:
+			return value.Uint8Value(uint8(n)), nil
+		case types.Uint16:
+			return value.Uint16Value(uint16(n)), nil
+		case types.Uint32:
+			return value.Uint32Value(uint32(n)), nil
+		default:
+			return value.Uint64Value(n), nil
+		}
+	case types.Float, types.Double:
+		f, err := floatFromAny(t, v)
+		if err != nil {
+			return nil, err
+		}
+		if t == types.Float {
+			if math.Abs(f) > math.MaxFloat32 && !math.IsInf(f, 0) {
+				return nil, coerceErrorf(t, v, "out of range")
+			}
+
+			return value.FloatValue(float32(f)), nil
+		}
+
+		return value.DoubleValue(f), nil
+	case types.Text, types.Bytes, types.YSON, types.DyNumber:
+		s, ok := v.(string)
+		if !ok {
+			return nil, coerceErrorf(t, v, "expected string")
+		}
+		switch t {
+		case types.Text:
+			return value.TextValue(s), nil
+		case types.Bytes, types.YSON:
+			b := []byte(s)
+			if c.fromJSON {
+				var err error
+				if b, err = base64.StdEncoding.DecodeString(s); err != nil {
+					return nil, coerceErrorf(t, v, "%v", err)
+				}
+			}
+			if t == types.Bytes {
+				return value.BytesValue(b), nil
+			}
+
+			return value.YSONValue(b), nil
+		default:
+			return value.DyNumberValue(s), nil
+		}
+	case types.JSON, types.JSONDocument:
+		s, ok := v.(string)
+		if !ok || c.fromJSON {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, coerceErrorf(t, v, "%v", err)
+			}
+			s = string(b)
+		}
+		if t == types.JSON {
+			return value.JSONValue(s), nil
+		}
+
+		return value.JSONDocumentValue(s), nil
+	case types.UUID:
+		s, ok := v.(string)
+		if !ok {
+			return nil, coerceErrorf(t, v, "expected string")
+		}
+		u, err := uuid.Parse(s)
+		if err != nil {
+			return nil, coerceErrorf(t, v, "%v", err)
+		}
+
+		return value.UUIDValue(u), nil
+	case types.Date, types.Datetime, types.Timestamp:
+		tt, err := timeFromAny(t, v)
+		if err != nil {
+			return nil, err
+		}
+		switch t {
+		case types.Date:
+			return value.DateValueFromTime(tt), nil
+		case types.Datetime:
+			return value.DatetimeValueFromTime(tt), nil
+		default:
+			return value.TimestampValueFromTime(tt), nil
+		}
+	case types.Interval:
+		s, ok := v.(string)
+		if !ok {
+			return nil, coerceErrorf(t, v, "expected duration string")
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, coerceErrorf(t, v, "%v", err)
+		}
+
+		return value.IntervalValueFromDuration(d), nil
+	default:
+		return nil, coerceErrorf(t, v, "unsupported type")
+	}
+}
+
+var intRanges = map[types.Primitive][2]int64{
+	types.Int8:  {math.MinInt8, math.MaxInt8},
+	types.Int16: {math.MinInt16, math.MaxInt16},
+	types.Int32: {math.MinInt32, math.MaxInt32},
+	types.Int64: {math.MinInt64, math.MaxInt64},
+}
+
+var uintRanges = map[types.Primitive]uint64{
+	types.Uint8:  math.MaxUint8,
+	types.Uint16: math.MaxUint16,
+	types.Uint32: math.MaxUint32,
+	types.Uint64: math.MaxUint64,
+}
+
+// intFromAny accepts integral numbers and strings, strings keep precision of big integers
+func intFromAny(t types.Primitive, v any) (int64, error) {
+	var n int64
+	switch vv := v.(type) {
+	case float64:
+		if vv != math.Trunc(vv) {
+			return 0, coerceErrorf(t, v, "not an integer")
+		}
+		if vv < math.MinInt64 || vv >= math.MaxInt64 {
+			return 0, coerceErrorf(t, v, "out of range")
+		}
+		n = int64(vv)
+	case json.Number:
+		i, err := strconv.ParseInt(vv.String(), 10, 64)
+		if err != nil {
+			return 0, coerceErrorf(t, v, "%v", err)
+		}
+		n = i
+	case string:
+		i, err := strconv.ParseInt(vv, 10, 64)
+		if err != nil {
+			return 0, coerceErrorf(t, v, "%v", err)
+		}
+		n = i
+	default:
+		return 0, coerceErrorf(t, v, "expected number")
+	}
+	if r := intRanges[t]; n < r[0] || n > r[1] {
+		return 0, coerceErrorf(t, v, "out of range [%d, %d]", r[0], r[1])
+	}
+
+	return n, nil
+}
+
+func uintFromAny(t types.Primitive, v any) (uint64, error) {
+	var n uint64
+	switch vv := v.(type) {
+	case float64:
+		if vv != math.Trunc(vv) {
+			return 0, coerceErrorf(t, v, "not an integer")
+		}
+		if vv < 0 || vv >= math.MaxUint64 {
+			return 0, coerceErrorf(t, v, "out of range")
+		}
+		n = uint64(vv)
+	case json.Number:
+		i, err := strconv.ParseUint(vv.String(), 10, 64)
+		if err != nil {
+			return 0, coerceErrorf(t, v, "%v", err)
+		}
+		n = i
+	case string:
+		i, err := strconv.ParseUint(vv, 10, 64)
+		if err != nil {
+			return 0, coerceErrorf(t, v, "%v", err)
+		}
+		n = i
+	default:
+		return 0, coerceErrorf(t, v, "expected number")
+	}
+	if upper := uintRanges[t]; n > upper {
+		return 0, coerceErrorf(t, v, "out of range [0, %d]", upper)
+	}
+
+	return n, nil
+}
+
+// floatFromAny accepts numbers and strings "NaN", "+Inf" and "-Inf"
+func floatFromAny(t types.Primitive, v any) (float64, error) {
+	switch vv := v.(type) {
+	case float64:
+		return vv, nil
+	case string:
+		f, err := strconv.ParseFloat(vv, 64)
+		if err != nil || !(math.IsNaN(f) || math.IsInf(f, 0)) {
+			return 0, coerceErrorf(t, v, "expected number")
+		}
+
+		return f, nil
+	case json.Number:
+		f, err := vv.Float64()
+		if err != nil {
+			return 0, coerceErrorf(t, v, "%v", err)
+		}
+
+		return f, nil
+	default:
+		return 0, coerceErrorf(t, v, "expected number")
+	}
+}
+
+// timeFromAny accepts RFC 3339 strings, date without time is allowed for Date
+func timeFromAny(t types.Primitive, v any) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, coerceErrorf(t, v, "expected RFC 3339 string")
+	}
+	if t == types.Date {
+		if tt, err := time.Parse(time.DateOnly, s); err == nil {
+			return tt, nil
+		}
+	}
+	tt, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, coerceErrorf(t, v, "%v", err)
+	}
+
+	return tt, nil
+}
@@ -0,0 +1,278 @@
+package params
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestFromMap(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		json     string
+		declared map[string]types.Type
+		expected map[string]value.Value
+	}{
+		{
+			name: "Primitives",
+			json: `{"b":true,"i8":-128,"u64":"18446744073709551615","d":1.5,"s":"test","ts":"2024-01-02T03:04:05Z"}`,
+			declared: map[string]types.Type{
+				"$b":   types.Bool,
+				"$i8":  types.Int8,
+				"$u64": types.Uint64,
+				"$d":   types.Double,
+				"$s":   types.Text,
+				"ts":   types.Timestamp,
+			},
+			expected: map[string]value.Value{
+				"$b":   value.BoolValue(true),
+				"$i8":  value.Int8Value(-128),
+				"$u64": value.Uint64Value(18446744073709551615),
+				"$d":   value.DoubleValue(1.5),
+				"$s":   value.TextValue("test"),
+				"$ts":  value.TimestampValue(1704164645000000),
+			},
+		},
+		{
+			name: "Optional",
+			json: `{"a":null,"b":1}`,
+			declared: map[string]types.Type{
+				"$a": types.NewOptional(types.Int32),
+				"$b": types.NewOptional(types.Int32),
+				"$c": types.NewOptional(types.Text),
+			},
+			expected: map[string]value.Value{
+				"$a": value.NullValue(types.Int32),
+				"$b": value.OptionalValue(value.Int32Value(1)),
+				"$c": value.NullValue(types.Text),
+			},
+		},
+		{
+			name: "Containers",
+			json: `{"list":[1,2],"empty":[],"s":{"id":1,"name":"a"},"dict":{"k":true},"tuple":[1,"a"]}`,
+			declared: map[string]types.Type{
+				"$list":  types.NewList(types.Uint32),
+				"$empty": types.NewList(types.Uint32),
+				"$s": types.NewStruct(
+					types.StructField{Name: "id", T: types.Uint64},
+					types.StructField{Name: "name", T: types.Text},
+					types.StructField{Name: "extra", T: types.NewOptional(types.Bool)},
+				),
+				"$dict":  types.NewDict(types.Text, types.Bool),
+				"$tuple": types.NewTuple(types.Int64, types.Bytes),
+			},
+			expected: map[string]value.Value{
+				"$list":  value.ListValue(value.Uint32Value(1), value.Uint32Value(2)),
+				"$empty": value.ZeroValue(types.NewList(types.Uint32)),
+				"$s": value.StructValue(
+					value.StructValueField{Name: "id", V: value.Uint64Value(1)},
+					value.StructValueField{Name: "name", V: value.TextValue("a")},
+					value.StructValueField{Name: "extra", V: value.NullValue(types.Bool)},
+				),
+				"$dict": value.DictValue(
+					value.DictValueField{K: value.TextValue("k"), V: value.BoolValue(true)},
+				),
+				"$tuple": value.TupleValue(value.Int64Value(1), value.BytesValue([]byte("a"))),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var m map[string]any
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &m))
+
+			p, err := FromMap(m, tt.declared)
+			require.NoError(t, err)
+
+			actual := make(map[string]value.Value, p.Count())
+			p.Each(func(name string, v value.Value) {
+				actual[name] = v
+			})
+			require.Len(t, actual, len(tt.expected))
+			for name, v := range tt.expected {
+				require.Contains(t, actual, name)
+				require.True(t, types.Equal(v.Type(), actual[name].Type()), name)
+				require.Equal(t, v.Yql(), actual[name].Yql(), name)
+			}
+		})
+	}
+}
+
+func TestFromMapErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		json     string
+		declared map[string]types.Type
+		err      string
+	}{
+		{
+			name:     "OutOfRange",
+			json:     `{"a":128}`,
+			declared: map[string]types.Type{"$a": types.Int8},
+			err:      "$a: cannot coerce float64 (128) to Int8: out of range [-128, 127]",
+		},
+		{
+			name:     "Negative",
+			json:     `{"a":-1}`,
+			declared: map[string]types.Type{"$a": types.Uint32},
+			err:      "$a: cannot coerce float64 (-1) to Uint32: out of range",
+		},
+		{
+			name:     "NotInteger",
+			json:     `{"a":1.5}`,
+			declared: map[string]types.Type{"$a": types.Int64},
+			err:      "$a: cannot coerce float64 (1.5) to Int64: not an integer",
+		},
+		{
+			name:     "NullOfRequired",
+			json:     `{"a":null}`,
+			declared: map[string]types.Type{"$a": types.Text},
+			err:      "$a: cannot coerce <nil> (<nil>) to Utf8: expected string",
+		},
+		{
+			name:     "NotProvided",
+			json:     `{}`,
+			declared: map[string]types.Type{"$a": types.Text},
+			err:      "$a: declared Utf8, not provided",
+		},
+		{
+			name:     "NotDeclared",
+			json:     `{"a":1}`,
+			declared: map[string]types.Type{},
+			err:      "$a: not declared",
+		},
+		{
+			name:     "NestedPath",
+			json:     `{"a":[{"id":1},{"id":"x"}]}`,
+			declared: map[string]types.Type{"$a": types.NewList(types.NewStruct(types.StructField{Name: "id", T: types.Uint64}))},
+			err:      `$a[1].id: cannot coerce string (x) to Uint64: strconv.ParseUint: parsing "x": invalid syntax`,
+		},
+		{
+			name:     "UnknownField",
+			json:     `{"a":{"id":1,"b":2}}`,
+			declared: map[string]types.Type{"$a": types.NewStruct(types.StructField{Name: "id", T: types.Uint64})},
+			err:      "unknown fields [b]",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var m map[string]any
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &m))
+
+			_, err := FromMap(m, tt.declared)
+			require.ErrorIs(t, err, ErrParamsMismatch)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestValueFromJSON(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		v    value.Value
+	}{
+		{
+			name: "Primitives",
+			v: value.TupleValue(
+				value.BoolValue(true),
+				value.Int64Value(math.MinInt64),
+				value.Uint64Value(math.MaxUint64),
+				value.DoubleValue(math.Inf(-1)),
+				value.FloatValue(0.5),
+				value.TextValue("text"),
+				value.BytesValue([]byte{0, 1, 2}),
+				value.YSONValue([]byte("[1]")),
+				value.DyNumberValue("1E1"),
+				value.UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+			),
+		},
+		{
+			name: "Times",
+			v: value.TupleValue(
+				value.DateValueFromTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				value.DatetimeValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+				value.TimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)),
+				value.IntervalValueFromDuration(-time.Hour-1500*time.Microsecond),
+			),
+		},
+		{
+			name: "Decimal",
+			v:    value.DecimalValueFromBigInt(big.NewInt(-150), 22, 2),
+		},
+		{
+			name: "JSON",
+			v:    value.TupleValue(value.JSONValue(`{"a":[1,2.5]}`), value.JSONDocumentValue(`"s"`)),
+		},
+		{
+			name: "Containers",
+			v: value.StructValue(
+				value.StructValueField{Name: "list", V: value.ListValue(value.OptionalValue(value.Int32Value(1)),
+					value.NullValue(types.Int32))},
+				value.StructValueField{Name: "set", V: value.SetValue(value.TextValue("a"))},
+				value.StructValueField{Name: "dict", V: value.DictValue(
+					value.DictValueField{K: value.TextValue("a"), V: value.Int32Value(1)},
+				)},
+				value.StructValueField{Name: "pairs", V: value.DictValue(
+					value.DictValueField{K: value.Uint64Value(1), V: value.BytesValue([]byte("a"))},
+					value.DictValueField{K: value.Uint64Value(2), V: value.BytesValue([]byte("b"))},
+				)},
+				value.StructValueField{Name: "empty", V: value.ZeroValue(types.NewDict(types.Int32, types.Int32))},
+			),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := value.ToJSON(tt.v)
+			require.NoError(t, err)
+
+			v, err := ValueFromJSON(b, tt.v.Type())
+			require.NoError(t, err, string(b))
+			require.True(t, types.Equal(tt.v.Type(), v.Type()))
+			require.Equal(t, tt.v.Yql(), v.Yql())
+		})
+	}
+}
+
+func TestValueFromJSONErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		json string
+		t    types.Type
+		err  string
+	}{
+		{
+			name: "InvalidBase64",
+			json: `"a"`,
+			t:    types.Bytes,
+			err:  "cannot coerce string (a) to String: illegal base64 data at input byte 0",
+		},
+		{
+			name: "NotPair",
+			json: `[[1]]`,
+			t:    types.NewDict(types.Int32, types.Int32),
+			err:  "[0]: cannot coerce []interface {} ([1]) to Dict<Int32,Int32>: expected [key, value] pair",
+		},
+		{
+			name: "Syntax",
+			json: `{"a":`,
+			t:    types.NewStruct(types.StructField{Name: "a", T: types.Int32}),
+			err:  "unexpected EOF",
+		},
+		{
+			name: "TrailingData",
+			json: `1 2`,
+			t:    types.Int32,
+			err:  "unexpected data after JSON value",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValueFromJSON([]byte(tt.json), tt.t)
+			require.ErrorIs(t, err, value.ErrInvalidJSON)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -740,9 +740,9 @@ func TestStruct(t *testing.T) {
 						Items: []*Ydb.Value{
 							{
 								Value: &Ydb.Value_Low_128{
-									Low_128: 651345242494996240,
+									Low_128: 506660481424032516,
 								},
-								High_128: 72623859790382856,
+								High_128: 1157159078456920585,
 							},
 						},
 					},
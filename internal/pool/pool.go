@@ -189,7 +189,7 @@ func New[PT Item[T], T any](
 					cancelCreate context.CancelFunc
 				)
 				if d := p.createTimeout; d > 0 {
-					createCtx, cancelCreate = xcontext.WithTimeout(createCtx, d)
+					createCtx, cancelCreate = xcontext.WithNamedTimeout(createCtx, xerrors.DeadlineSourceSessionCreateTimeout, d)
 				} else {
 					createCtx, cancelCreate = xcontext.WithCancel(createCtx)
 				}
@@ -367,7 +367,7 @@ func (p *Pool[PT, T]) closeItem(ctx context.Context, item PT) error {
 
 	var cancel context.CancelFunc
 	if d := p.closeTimeout; d > 0 {
-		ctx, cancel = xcontext.WithTimeout(ctx, d)
+		ctx, cancel = xcontext.WithNamedTimeout(ctx, xerrors.DeadlineSourceSessionDeleteTimeout, d)
 	} else {
 		ctx, cancel = xcontext.WithCancel(ctx)
 	}
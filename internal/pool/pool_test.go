@@ -316,3 +316,70 @@ func TestSafeStatsRace(t *testing.T) {
 		wg.Wait()
 	}, xtest.StopAfter(5*time.Second))
 }
+
+// closeCtxItem is an item which is never alive and waits for end of context of close
+type closeCtxItem struct {
+	closeErrs chan error
+}
+
+func (t *closeCtxItem) IsAlive() bool {
+	return false
+}
+
+func (t *closeCtxItem) ID() string {
+	return ""
+}
+
+func (t *closeCtxItem) Close(ctx context.Context) error {
+	<-ctx.Done()
+	t.closeErrs <- ctx.Err()
+
+	return ctx.Err()
+}
+
+func TestPoolDeadlineCause(t *testing.T) {
+	const timeout = 10 * time.Millisecond
+	rootCtx := xtest.Context(t)
+	t.Run("CreateItemTimeout", func(t *testing.T) {
+		var (
+			attempts  int64
+			createErr = make(chan error, 1)
+		)
+		p := New(rootCtx,
+			WithCreateItemTimeout[*testItem, testItem](timeout),
+			WithCreateFunc(func(ctx context.Context) (*testItem, error) {
+				if atomic.AddInt64(&attempts, 1) == 1 {
+					<-ctx.Done()
+					createErr <- ctx.Err()
+
+					return nil, xerrors.WithStackTrace(ctx.Err())
+				}
+
+				return &testItem{}, nil
+			}),
+		)
+		require.NoError(t, p.With(rootCtx, func(ctx context.Context, item *testItem) error {
+			return nil
+		}))
+		cause, has := xerrors.DeadlineCause(<-createErr)
+		require.True(t, has)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceSessionCreateTimeout, Timeout: timeout}, cause)
+	})
+	t.Run("CloseItemTimeout", func(t *testing.T) {
+		item := &closeCtxItem{closeErrs: make(chan error, 1)}
+		p := New(rootCtx,
+			WithLimit[*closeCtxItem, closeCtxItem](1),
+			WithCloseItemTimeout[*closeCtxItem, closeCtxItem](timeout),
+			WithCreateFunc(func(ctx context.Context) (*closeCtxItem, error) {
+				return item, nil
+			}),
+		)
+		// item which is not alive is closed on put back into pool
+		require.NoError(t, p.With(rootCtx, func(ctx context.Context, item *closeCtxItem) error {
+			return nil
+		}))
+		cause, has := xerrors.DeadlineCause(<-item.closeErrs)
+		require.True(t, has)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceSessionDeleteTimeout, Timeout: timeout}, cause)
+	})
+}
@@ -189,7 +189,7 @@ func New(ctx context.Context, balancer balancer, cfg *config.Config) *Client {
 				cancelCreate context.CancelFunc
 			)
 			if d := cfg.SessionCreateTimeout(); d > 0 {
-				createCtx, cancelCreate = xcontext.WithTimeout(ctx, d)
+				createCtx, cancelCreate = xcontext.WithNamedTimeout(ctx, xerrors.DeadlineSourceSessionCreateTimeout, d)
 			} else {
 				createCtx, cancelCreate = xcontext.WithCancel(ctx)
 			}
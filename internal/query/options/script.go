@@ -0,0 +1,101 @@
+package options
+
+type (
+	ExecuteScript struct {
+		commonExecuteSettings
+	}
+	ExecuteScriptOption interface {
+		applyExecuteScriptOption(s *ExecuteScript)
+	}
+	FetchScript struct {
+		resultSetIndex int64
+		rowsLimit      int64
+		fetchToken     string
+	}
+	FetchScriptOption func(s *FetchScript)
+)
+
+var (
+	_ ExecuteScriptOption = Syntax(0)
+	_ ExecuteScriptOption = ExecMode(0)
+	_ ExecuteScriptOption = StatsMode(0)
+	_ ExecuteScriptOption = ParametersOption{}
+	_ ExecuteScriptOption = CallOptions{}
+)
+
+func (syntax Syntax) applyExecuteScriptOption(s *ExecuteScript) {
+	s.syntax = syntax
+}
+
+func (mode ExecMode) applyExecuteScriptOption(s *ExecuteScript) {
+	s.execMode = mode
+}
+
+func (mode StatsMode) applyExecuteScriptOption(s *ExecuteScript) {
+	s.statsMode = mode
+}
+
+func (params ParametersOption) applyExecuteScriptOption(s *ExecuteScript) {
+	s.params = append(s.params, params...)
+}
+
+func (opts CallOptions) applyExecuteScriptOption(s *ExecuteScript) {
+	s.callOptions = append(s.callOptions, opts...)
+}
+
+func ExecuteScriptSettings(opts ...ExecuteScriptOption) *ExecuteScript {
+	settings := &ExecuteScript{
+		commonExecuteSettings: defaultCommonExecuteSettings(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.applyExecuteScriptOption(settings)
+		}
+	}
+
+	return settings
+}
+
+// WithResultSetIndex selects result set of script for fetch
+func WithResultSetIndex(index int64) FetchScriptOption {
+	return func(s *FetchScript) {
+		s.resultSetIndex = index
+	}
+}
+
+// WithRowsLimit limits number of rows of fetched page
+func WithRowsLimit(limit int64) FetchScriptOption {
+	return func(s *FetchScript) {
+		s.rowsLimit = limit
+	}
+}
+
+// WithFetchToken continues fetch from page of token (NextFetchToken of previous page)
+func WithFetchToken(token string) FetchScriptOption {
+	return func(s *FetchScript) {
+		s.fetchToken = token
+	}
+}
+
+func FetchScriptSettings(opts ...FetchScriptOption) *FetchScript {
+	settings := &FetchScript{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(settings)
+		}
+	}
+
+	return settings
+}
+
+func (s *FetchScript) ResultSetIndex() int64 {
+	return s.resultSetIndex
+}
+
+func (s *FetchScript) RowsLimit() int64 {
+	return s.rowsLimit
+}
+
+func (s *FetchScript) FetchToken() string {
+	return s.fetchToken
+}
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
@@ -19,9 +19,11 @@ var _ query.ResultSet = (*resultSet)(nil)
 type resultSet struct {
 	index       int64
 	recv        func() (*Ydb_Query.ExecuteQueryResponsePart, error)
-	columns     []*Ydb.Column
+	columns     *scanner.Columns
 	currentPart *Ydb_Query.ExecuteQueryResponsePart
+	rows        []row // rows of current part, allocated at once on first access
 	rowIndex    int
+	totalRows   int
 	trace       *trace.Query
 	done        chan struct{}
 }
@@ -40,7 +42,8 @@ func newResultSet(
 		recv:        recv,
 		currentPart: part,
 		rowIndex:    -1,
-		columns:     part.GetResultSet().GetColumns(),
+		totalRows:   len(part.GetResultSet().GetRows()),
+		columns:     scanner.NewColumns(part.GetResultSet().GetColumns()),
 		trace:       t,
 		done:        make(chan struct{}),
 	}
@@ -66,11 +69,15 @@ func (rs *resultSet) nextRow(ctx context.Context) (*row, error) {
 				}
 				rs.rowIndex = 0
 				rs.currentPart = part
+				rs.rows = nil
 				if part == nil {
 					close(rs.done)
 
 					return nil, xerrors.WithStackTrace(io.EOF)
 				}
+				if rs.index == part.GetResultSetIndex() {
+					rs.totalRows += len(part.GetResultSet().GetRows())
+				}
 			}
 			if rs.index != rs.currentPart.GetResultSetIndex() {
 				close(rs.done)
@@ -82,10 +89,61 @@ func (rs *resultSet) nextRow(ctx context.Context) (*row, error) {
 			}
 
 			if rs.rowIndex < len(rs.currentPart.GetResultSet().GetRows()) {
-				return newRow(ctx, rs.columns, rs.currentPart.GetResultSet().GetRows()[rs.rowIndex], rs.trace)
+				return rs.row(ctx), nil
+			}
+		}
+	}
+}
+
+// row returns current row of result set
+//
+// Rows of current part are allocated at once instead of allocation per row.
+// Rows are not reused because row may be used by caller after next call of NextRow
+func (rs *resultSet) row(ctx context.Context) *row {
+	if rs.rows == nil {
+		data := rs.columns.Rows(rs.currentPart.GetResultSet().GetRows())
+		rs.rows = make([]row, len(data))
+		for i := range data {
+			rs.rows[i] = row{
+				trace:          rs.trace,
+				indexedScanner: scanner.Indexed(&data[i]),
+				namedScanner:   scanner.Named(&data[i]),
+				structScanner:  scanner.Struct(&data[i]),
 			}
 		}
 	}
+	r := &rs.rows[rs.rowIndex]
+	r.ctx = ctx
+
+	return r
+}
+
+// Index returns index of result set in the result
+func (rs *resultSet) Index() int {
+	return int(rs.index)
+}
+
+// RowCount returns number of rows in the current part of result set
+func (rs *resultSet) RowCount() int {
+	if rs.currentPart.GetResultSetIndex() != rs.index {
+		return 0
+	}
+
+	return len(rs.currentPart.GetResultSet().GetRows())
+}
+
+// TotalRowsScanned returns number of rows of all parts of result set received up to current one
+func (rs *resultSet) TotalRowsScanned() int {
+	return rs.totalRows
+}
+
+// Truncated returns true if current part of result set has been truncated by server
+func (rs *resultSet) Truncated() bool {
+	if rs.currentPart.GetResultSetIndex() != rs.index {
+		return false
+	}
+
+	return rs.currentPart.GetResultSet().GetTruncated()
 }
 
 func (rs *resultSet) NextRow(ctx context.Context) (_ query.Row, err error) {
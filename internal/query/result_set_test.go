@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
@@ -918,3 +919,145 @@ func TestResultSetNext(t *testing.T) {
 		}
 	})
 }
+
+// BenchmarkResultSetScan scans 1M rows of 10 columns per operation
+func BenchmarkResultSetScan(b *testing.B) {
+	const (
+		rowsPerPart = 1000
+		partsCount  = 1000
+	)
+	columns := []*Ydb.Column{
+		{Name: "id", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}}},
+		{Name: "title", Type: &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+			Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+		}}}},
+		{Name: "created", Type: &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+			Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_TIMESTAMP}},
+		}}}},
+		{Name: "count", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}}},
+		{Name: "enabled", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_BOOL}}},
+		{Name: "score", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_DOUBLE}}},
+		{Name: "payload", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_STRING}}},
+		{Name: "name", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}}},
+		{Name: "parent_id", Type: &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+			Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT64}},
+		}}}},
+		{Name: "updated", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_DATETIME}}},
+	}
+	rows := make([]*Ydb.Value, rowsPerPart)
+	for i := range rows {
+		parentID := &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}
+		if i%2 == 0 {
+			parentID = &Ydb.Value{Value: &Ydb.Value_Int64Value{Int64Value: int64(i)}}
+		}
+		rows[i] = &Ydb.Value{Items: []*Ydb.Value{
+			{Value: &Ydb.Value_Uint64Value{Uint64Value: uint64(i)}},
+			{Value: &Ydb.Value_TextValue{TextValue: fmt.Sprintf("title-%d", i)}},
+			{Value: &Ydb.Value_Uint64Value{Uint64Value: uint64(i) * 1000000}},
+			{Value: &Ydb.Value_Int32Value{Int32Value: int32(i)}},
+			{Value: &Ydb.Value_BoolValue{BoolValue: i%3 == 0}},
+			{Value: &Ydb.Value_DoubleValue{DoubleValue: float64(i) / 3}},
+			{Value: &Ydb.Value_BytesValue{BytesValue: []byte("payload")}},
+			{Value: &Ydb.Value_TextValue{TextValue: "name"}},
+			parentID,
+			{Value: &Ydb.Value_Uint32Value{Uint32Value: uint32(i)}},
+		}}
+	}
+	part := &Ydb_Query.ExecuteQueryResponsePart{
+		ResultSet: &Ydb.ResultSet{
+			Columns: columns,
+			Rows:    rows,
+		},
+	}
+	var (
+		id       uint64
+		title    string
+		created  *time.Time
+		count    int32
+		enabled  bool
+		score    float64
+		payload  []byte
+		name     string
+		parentID *int64
+		updated  time.Time
+	)
+	ctx := context.Background()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parts := 1
+		rs := newResultSet(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+			if parts == partsCount {
+				return nil, io.EOF
+			}
+			parts++
+
+			return part, nil
+		}, part, nil)
+		for {
+			r, err := rs.nextRow(ctx)
+			if err != nil {
+				if xerrors.Is(err, io.EOF) {
+					break
+				}
+				b.Fatal(err)
+			}
+			if err = r.Scan(&id, &title, &created, &count, &enabled,
+				&score, &payload, &name, &parentID, &updated,
+			); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func TestResultSetRowsCounters(t *testing.T) {
+	ctx := xtest.Context(t)
+	part := func(resultSetIndex int64, values ...uint64) *Ydb_Query.ExecuteQueryResponsePart {
+		rows := make([]*Ydb.Value, 0, len(values))
+		for _, v := range values {
+			rows = append(rows, &Ydb.Value{
+				Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: v}}},
+			})
+		}
+
+		return &Ydb_Query.ExecuteQueryResponsePart{
+			Status:         Ydb.StatusIds_SUCCESS,
+			ResultSetIndex: resultSetIndex,
+			ResultSet: &Ydb.ResultSet{
+				Columns: []*Ydb.Column{{
+					Name: "a",
+					Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+				}},
+				Rows: rows,
+			},
+		}
+	}
+	parts := []*Ydb_Query.ExecuteQueryResponsePart{
+		part(1, 3, 4, 5),
+		part(2, 6),
+	}
+	rs := newResultSet(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+		if len(parts) == 0 {
+			return nil, io.EOF
+		}
+		p := parts[0]
+		parts = parts[1:]
+
+		return p, nil
+	}, part(1, 1, 2), nil)
+	require.Equal(t, 1, rs.Index())
+	require.Equal(t, 2, rs.RowCount())
+	require.Equal(t, 2, rs.TotalRowsScanned())
+	for i := 0; i < 5; i++ {
+		_, err := rs.nextRow(ctx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, rs.RowCount())
+	require.Equal(t, 5, rs.TotalRowsScanned())
+	require.False(t, rs.Truncated())
+	_, err := rs.nextRow(ctx)
+	require.ErrorIs(t, err, errWrongResultSetIndex)
+	require.Equal(t, 0, rs.RowCount())
+	require.Equal(t, 5, rs.TotalRowsScanned())
+}
@@ -3,8 +3,6 @@ package query
 import (
 	"context"
 
-	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
-
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/scanner"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
@@ -22,18 +20,6 @@ type row struct {
 	structScanner  scanner.StructScanner
 }
 
-func newRow(ctx context.Context, columns []*Ydb.Column, v *Ydb.Value, t *trace.Query) (*row, error) {
-	data := scanner.Data(columns, v.GetItems())
-
-	return &row{
-		ctx:            ctx,
-		trace:          t,
-		indexedScanner: scanner.Indexed(data),
-		namedScanner:   scanner.Named(data),
-		structScanner:  scanner.Struct(data),
-	}, nil
-}
-
 func (r row) Scan(dst ...interface{}) (err error) {
 	var (
 		ctx    = r.ctx
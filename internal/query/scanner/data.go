@@ -9,28 +9,74 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
-type data struct {
-	columns []*Ydb.Column
-	values  []*Ydb.Value
+type (
+	// Columns contains columns of result set with column decoders
+	//
+	// Columns must be created once per result set and shared between rows of result set
+	Columns struct {
+		columns  []*Ydb.Column
+		decoders []columnDecoder
+	}
+	data struct {
+		*Columns
+		values []*Ydb.Value
+	}
+)
+
+func NewColumns(columns []*Ydb.Column) *Columns {
+	decoders := make([]columnDecoder, len(columns))
+	for i := range columns {
+		decoders[i] = newColumnDecoder(columns[i].GetType())
+	}
+
+	return &Columns{
+		columns:  columns,
+		decoders: decoders,
+	}
 }
 
 func Data(columns []*Ydb.Column, values []*Ydb.Value) *data {
 	return &data{
-		columns: columns,
+		Columns: NewColumns(columns),
 		values:  values,
 	}
 }
 
-func (s data) seekByName(name string) (value.Value, error) {
+// Rows returns data of given rows allocated at once
+func (c *Columns) Rows(rows []*Ydb.Value) []data {
+	dd := make([]data, len(rows))
+	for i := range rows {
+		dd[i] = data{
+			Columns: c,
+			values:  rows[i].GetItems(),
+		}
+	}
+
+	return dd
+}
+
+func (s data) indexByName(name string) (int, error) {
 	for i := range s.columns {
 		if s.columns[i].GetName() == name {
-			return value.FromYDB(s.columns[i].GetType(), s.values[i]), nil
+			return i, nil
 		}
 	}
 
-	return nil, xerrors.WithStackTrace(fmt.Errorf("'%s': %w", name, errColumnsNotFoundInRow))
+	return -1, xerrors.WithStackTrace(fmt.Errorf("'%s': %w", name, errColumnsNotFoundInRow))
 }
 
-func (s data) seekByIndex(idx int) value.Value {
-	return value.FromYDB(s.columns[idx].GetType(), s.values[idx])
+// castTo decodes value of column with index idx into dst
+//
+// Primitive values are decoded directly from protobuf if destination is supported
+// by column decoder, other values are decoded with value.CastTo. Errors contain name of column
+func (s data) castTo(idx int, dst interface{}) error {
+	if decode := s.decoders[idx]; decode != nil && decode(s.values[idx], dst) {
+		return nil
+	}
+
+	if err := value.CastTo(value.FromYDB(s.columns[idx].GetType(), s.values[idx]), dst); err != nil {
+		return xerrors.WithStackTrace(fmt.Errorf("column '%s': %w", s.columns[idx].GetName(), err))
+	}
+
+	return nil
 }
@@ -0,0 +1,233 @@
+package scanner
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+// columnDecoder decodes column value from protobuf directly into destination
+// without building of intermediate value.Value
+//
+// columnDecoder returns false if destination is not supported by decoder.
+// In this case value must be decoded with value.CastTo
+type columnDecoder func(v *Ydb.Value, dst interface{}) bool
+
+// newColumnDecoder returns decoder for primitive and Optional<primitive> types
+// or nil for other types
+func newColumnDecoder(t *Ydb.Type) columnDecoder {
+	if optional, ok := t.GetType().(*Ydb.Type_OptionalType); ok {
+		decode := primitiveDecoder(optional.OptionalType.GetItem())
+		if decode == nil {
+			return nil
+		}
+
+		return optionalDecoder(decode)
+	}
+
+	return primitiveDecoder(t)
+}
+
+// optionalDecoder decodes Optional<T> value same as value.CastTo does:
+// NULL value sets nil into **T destination and keeps *T destination untouched
+func optionalDecoder(decode columnDecoder) columnDecoder {
+	return func(v *Ydb.Value, dst interface{}) bool {
+		if _, isNull := v.GetValue().(*Ydb.Value_NullFlagValue); !isNull {
+			return decode(v, dst) || decodeToPointer(decode, v, dst)
+		}
+		switch dst := dst.(type) {
+		case **bool:
+			*dst = nil
+		case **int8:
+			*dst = nil
+		case **int16:
+			*dst = nil
+		case **int32:
+			*dst = nil
+		case **int64:
+			*dst = nil
+		case **uint8:
+			*dst = nil
+		case **uint16:
+			*dst = nil
+		case **uint32:
+			*dst = nil
+		case **uint64:
+			*dst = nil
+		case **float32:
+			*dst = nil
+		case **float64:
+			*dst = nil
+		case **string:
+			*dst = nil
+		case **[]byte:
+			*dst = nil
+		case **time.Time:
+			*dst = nil
+		case **time.Duration:
+			*dst = nil
+		case *bool, *int8, *int16, *int32, *int64, *uint8, *uint16, *uint32, *uint64,
+			*float32, *float64, *string, *[]byte, *time.Time, *time.Duration:
+		default:
+			return false
+		}
+
+		return true
+	}
+}
+
+//nolint:gocyclo
+func decodeToPointer(decode columnDecoder, v *Ydb.Value, dst interface{}) bool {
+	switch dst := dst.(type) {
+	case **bool:
+		return decodeToNew(decode, v, dst)
+	case **int8:
+		return decodeToNew(decode, v, dst)
+	case **int16:
+		return decodeToNew(decode, v, dst)
+	case **int32:
+		return decodeToNew(decode, v, dst)
+	case **int64:
+		return decodeToNew(decode, v, dst)
+	case **uint8:
+		return decodeToNew(decode, v, dst)
+	case **uint16:
+		return decodeToNew(decode, v, dst)
+	case **uint32:
+		return decodeToNew(decode, v, dst)
+	case **uint64:
+		return decodeToNew(decode, v, dst)
+	case **float32:
+		return decodeToNew(decode, v, dst)
+	case **float64:
+		return decodeToNew(decode, v, dst)
+	case **string:
+		return decodeToNew(decode, v, dst)
+	case **[]byte:
+		return decodeToNew(decode, v, dst)
+	case **time.Time:
+		return decodeToNew(decode, v, dst)
+	case **time.Duration:
+		return decodeToNew(decode, v, dst)
+	default:
+		return false
+	}
+}
+
+func decodeToNew[T any](decode columnDecoder, v *Ydb.Value, dst **T) bool {
+	ptr := new(T)
+	if !decode(v, ptr) {
+		return false
+	}
+	*dst = ptr
+
+	return true
+}
+
+//nolint:funlen
+func primitiveDecoder(t *Ydb.Type) columnDecoder {
+	switch t.GetTypeId() {
+	case Ydb.Type_BOOL:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, v.GetBoolValue())
+		}
+	case Ydb.Type_INT8:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, int8(v.GetInt32Value()))
+		}
+	case Ydb.Type_INT16:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, int16(v.GetInt32Value()))
+		}
+	case Ydb.Type_INT32:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, v.GetInt32Value())
+		}
+	case Ydb.Type_INT64:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, v.GetInt64Value())
+		}
+	case Ydb.Type_UINT8:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, uint8(v.GetUint32Value()))
+		}
+	case Ydb.Type_UINT16:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, uint16(v.GetUint32Value()))
+		}
+	case Ydb.Type_UINT32:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, v.GetUint32Value())
+		}
+	case Ydb.Type_UINT64:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, v.GetUint64Value())
+		}
+	case Ydb.Type_FLOAT:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, v.GetFloatValue())
+		}
+	case Ydb.Type_DOUBLE:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, v.GetDoubleValue())
+		}
+	case Ydb.Type_DATE:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, value.DateToTime(v.GetUint32Value()))
+		}
+	case Ydb.Type_DATETIME:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, value.DatetimeToTime(v.GetUint32Value()))
+		}
+	case Ydb.Type_TIMESTAMP:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, value.TimestampToTime(v.GetUint64Value()))
+		}
+	case Ydb.Type_INTERVAL:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			return decodeTo(dst, value.IntervalToDuration(v.GetInt64Value()))
+		}
+	case Ydb.Type_UTF8:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			switch dst := dst.(type) {
+			case *string:
+				*dst = v.GetTextValue()
+			case *[]byte:
+				*dst = xstring.ToBytes(v.GetTextValue())
+			default:
+				return false
+			}
+
+			return true
+		}
+	case Ydb.Type_STRING:
+		return func(v *Ydb.Value, dst interface{}) bool {
+			switch dst := dst.(type) {
+			case *[]byte:
+				*dst = v.GetBytesValue()
+			case *string:
+				*dst = xstring.FromBytes(v.GetBytesValue())
+			default:
+				return false
+			}
+
+			return true
+		}
+	default:
+		return nil
+	}
+}
+
+// decodeTo sets src into destination of the same type
+func decodeTo[T any](dst interface{}, src T) bool {
+	ptr, ok := dst.(*T)
+	if !ok {
+		return false
+	}
+	*ptr = src
+
+	return true
+}
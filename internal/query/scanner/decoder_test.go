@@ -0,0 +1,204 @@
+package scanner
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+func TestColumnDecoder(t *testing.T) {
+	primitive := func(id Ydb.Type_PrimitiveTypeId) *Ydb.Type {
+		return &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: id}}
+	}
+	optional := func(t *Ydb.Type) *Ydb.Type {
+		return &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: t}}}
+	}
+	toYDB := func(v value.Value) *Ydb.Value {
+		return value.ToYDB(v, allocator.New()).GetValue()
+	}
+	null := &Ydb.Value{Value: &Ydb.Value_NullFlagValue{}}
+	destinations := func() []interface{} {
+		return []interface{}{
+			new(bool), new(int8), new(int16), new(int32), new(int64),
+			new(uint8), new(uint16), new(uint32), new(uint64), new(float32), new(float64),
+			new(string), new([]byte), new(time.Time), new(time.Duration), new(int), new(interface{}),
+			new(*bool), new(*int8), new(*int16), new(*int32), new(*int64),
+			new(*uint8), new(*uint16), new(*uint32), new(*uint64), new(*float32), new(*float64),
+			new(*string), new(*[]byte), new(*time.Time), new(*time.Duration), new(*int),
+		}
+	}
+	for _, tt := range []struct {
+		name    string
+		t       *Ydb.Type
+		v       *Ydb.Value
+		handled []interface{}
+	}{
+		{
+			name:    "Bool",
+			t:       primitive(Ydb.Type_BOOL),
+			v:       toYDB(value.BoolValue(true)),
+			handled: []interface{}{new(bool)},
+		},
+		{
+			name:    "Int8",
+			t:       primitive(Ydb.Type_INT8),
+			v:       toYDB(value.Int8Value(-8)),
+			handled: []interface{}{new(int8)},
+		},
+		{
+			name:    "Int16",
+			t:       primitive(Ydb.Type_INT16),
+			v:       toYDB(value.Int16Value(-16)),
+			handled: []interface{}{new(int16)},
+		},
+		{
+			name:    "Int32",
+			t:       primitive(Ydb.Type_INT32),
+			v:       toYDB(value.Int32Value(-32)),
+			handled: []interface{}{new(int32)},
+		},
+		{
+			name:    "Int64",
+			t:       primitive(Ydb.Type_INT64),
+			v:       toYDB(value.Int64Value(-64)),
+			handled: []interface{}{new(int64)},
+		},
+		{
+			name:    "Uint8",
+			t:       primitive(Ydb.Type_UINT8),
+			v:       toYDB(value.Uint8Value(8)),
+			handled: []interface{}{new(uint8)},
+		},
+		{
+			name:    "Uint16",
+			t:       primitive(Ydb.Type_UINT16),
+			v:       toYDB(value.Uint16Value(16)),
+			handled: []interface{}{new(uint16)},
+		},
+		{
+			name:    "Uint32",
+			t:       primitive(Ydb.Type_UINT32),
+			v:       toYDB(value.Uint32Value(32)),
+			handled: []interface{}{new(uint32)},
+		},
+		{
+			name:    "Uint64",
+			t:       primitive(Ydb.Type_UINT64),
+			v:       toYDB(value.Uint64Value(64)),
+			handled: []interface{}{new(uint64)},
+		},
+		{
+			name:    "Float",
+			t:       primitive(Ydb.Type_FLOAT),
+			v:       toYDB(value.FloatValue(1.5)),
+			handled: []interface{}{new(float32)},
+		},
+		{
+			name:    "Double",
+			t:       primitive(Ydb.Type_DOUBLE),
+			v:       toYDB(value.DoubleValue(2.5)),
+			handled: []interface{}{new(float64)},
+		},
+		{
+			name:    "Date",
+			t:       primitive(Ydb.Type_DATE),
+			v:       toYDB(value.DateValue(100)),
+			handled: []interface{}{new(time.Time)},
+		},
+		{
+			name:    "Datetime",
+			t:       primitive(Ydb.Type_DATETIME),
+			v:       toYDB(value.DatetimeValue(100)),
+			handled: []interface{}{new(time.Time)},
+		},
+		{
+			name:    "Timestamp",
+			t:       primitive(Ydb.Type_TIMESTAMP),
+			v:       toYDB(value.TimestampValue(100)),
+			handled: []interface{}{new(time.Time)},
+		},
+		{
+			name:    "Interval",
+			t:       primitive(Ydb.Type_INTERVAL),
+			v:       toYDB(value.IntervalValue(100)),
+			handled: []interface{}{new(time.Duration)},
+		},
+		{
+			name:    "Text",
+			t:       primitive(Ydb.Type_UTF8),
+			v:       toYDB(value.TextValue("test")),
+			handled: []interface{}{new(string), new([]byte)},
+		},
+		{
+			name:    "Bytes",
+			t:       primitive(Ydb.Type_STRING),
+			v:       toYDB(value.BytesValue([]byte("test"))),
+			handled: []interface{}{new(string), new([]byte)},
+		},
+		{
+			name:    "Optional<Int32>",
+			t:       optional(primitive(Ydb.Type_INT32)),
+			v:       toYDB(value.Int32Value(32)),
+			handled: []interface{}{new(int32), new(*int32)},
+		},
+		{
+			name:    "Optional<Text>",
+			t:       optional(primitive(Ydb.Type_UTF8)),
+			v:       toYDB(value.TextValue("test")),
+			handled: []interface{}{new(string), new([]byte), new(*string), new(*[]byte)},
+		},
+		{
+			name: "NULL of Optional<Timestamp>",
+			t:    optional(primitive(Ydb.Type_TIMESTAMP)),
+			v:    null,
+			handled: []interface{}{
+				new(bool), new(int8), new(int16), new(int32), new(int64),
+				new(uint8), new(uint16), new(uint32), new(uint64), new(float32), new(float64),
+				new(string), new([]byte), new(time.Time), new(time.Duration),
+				new(*bool), new(*int8), new(*int16), new(*int32), new(*int64),
+				new(*uint8), new(*uint16), new(*uint32), new(*uint64), new(*float32), new(*float64),
+				new(*string), new(*[]byte), new(*time.Time), new(*time.Duration),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			decode := newColumnDecoder(tt.t)
+			require.NotNil(t, decode)
+			var handled []interface{}
+			for _, dst := range destinations() {
+				if !decode(tt.v, dst) {
+					continue
+				}
+				handled = append(handled, reflect.New(reflect.TypeOf(dst).Elem()).Interface())
+				exp := reflect.New(reflect.TypeOf(dst).Elem()).Interface()
+				require.NoError(t, value.CastTo(value.FromYDB(tt.t, tt.v), exp))
+				require.Equal(t, exp, dst)
+			}
+			require.Equal(t, tt.handled, handled)
+		})
+	}
+	t.Run("Unsupported", func(t *testing.T) {
+		require.Nil(t, newColumnDecoder(primitive(Ydb.Type_UUID)))
+		require.Nil(t, newColumnDecoder(optional(optional(primitive(Ydb.Type_INT32)))))
+		require.Nil(t, newColumnDecoder(&Ydb.Type{Type: &Ydb.Type_ListType{
+			ListType: &Ydb.ListType{Item: primitive(Ydb.Type_INT32)},
+		}}))
+	})
+	t.Run("KeepsDestinationOfNull", func(t *testing.T) {
+		dst := 42
+		i32 := int32(42)
+		ptr := &i32
+		decode := newColumnDecoder(optional(primitive(Ydb.Type_INT32)))
+		require.False(t, decode(null, &dst))
+		require.True(t, decode(null, &i32))
+		require.EqualValues(t, 42, i32)
+		require.True(t, decode(null, &ptr))
+		require.Nil(t, ptr)
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
@@ -40,11 +39,11 @@ func Named(data *data) NamedScanner {
 
 func (s NamedScanner) ScanNamed(dst ...NamedDestination) (err error) {
 	for i := range dst {
-		v, err := s.data.seekByName(dst[i].name)
+		idx, err := s.data.indexByName(dst[i].name)
 		if err != nil {
 			return xerrors.WithStackTrace(err)
 		}
-		if err = value.CastTo(v, dst[i].ref); err != nil {
+		if err = s.data.castTo(idx, dst[i].ref); err != nil {
 			return xerrors.WithStackTrace(err)
 		}
 	}
@@ -5,7 +5,6 @@ import (
 	"reflect"
 	"strings"
 
-	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
@@ -56,11 +55,11 @@ func (s StructScanner) ScanStruct(dst interface{}, opts ...ScanStructOption) (er
 	existingFields := make(map[string]struct{}, tt.NumField())
 	for i := 0; i < tt.NumField(); i++ {
 		name := fieldName(tt.Field(i), settings.TagName)
-		v, err := s.data.seekByName(name)
+		idx, err := s.data.indexByName(name)
 		if err != nil {
 			missingColumns = append(missingColumns, name)
 		} else {
-			if err = value.CastTo(v, ptr.Elem().Field(i).Addr().Interface()); err != nil {
+			if err = s.data.castTo(idx, ptr.Elem().Field(i).Addr().Interface()); err != nil {
 				return xerrors.WithStackTrace(err)
 			}
 			existingFields[name] = struct{}{}
@@ -1,6 +1,8 @@
 package scanner
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
@@ -41,16 +43,14 @@ func TestFieldName(t *testing.T) {
 
 func TestStruct(t *testing.T) {
 	newScannerData := func(mapping map[*Ydb.Column]*Ydb.Value) *data {
-		data := &data{
-			columns: make([]*Ydb.Column, 0, len(mapping)),
-			values:  make([]*Ydb.Value, 0, len(mapping)),
-		}
+		columns := make([]*Ydb.Column, 0, len(mapping))
+		values := make([]*Ydb.Value, 0, len(mapping))
 		for c, v := range mapping {
-			data.columns = append(data.columns, c)
-			data.values = append(data.values, v)
+			columns = append(columns, c)
+			values = append(values, v)
 		}
 
-		return data
+		return Data(columns, values)
 	}
 
 	type scanData struct { //nolint:maligned
@@ -851,3 +851,66 @@ func TestScannerStructOrdering(t *testing.T) {
 	require.Equal(t, "B", row.B)
 	require.Equal(t, "C", row.C)
 }
+
+type testStatus string
+
+func (s testStatus) YDBValue() (value.Value, error) {
+	return value.Int32Value(1), nil
+}
+
+func (s *testStatus) ScanYDBValue(v value.Value) error {
+	inner := value.UnwrapOptional(v)
+	if inner == nil || inner.Yql() != "1" {
+		return fmt.Errorf("%w: %s", errUnknownStatus, v.Yql())
+	}
+	*s = "active"
+
+	return nil
+}
+
+var errUnknownStatus = errors.New("unknown status")
+
+func TestStructValueMapper(t *testing.T) {
+	columns := []*Ydb.Column{
+		{
+			Name: "status",
+			Type: &Ydb.Type{
+				Type: &Ydb.Type_OptionalType{
+					OptionalType: &Ydb.OptionalType{
+						Item: &Ydb.Type{
+							Type: &Ydb.Type_TypeId{
+								TypeId: Ydb.Type_INT32,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	var row struct {
+		Status testStatus `sql:"status"`
+	}
+	t.Run("Known", func(t *testing.T) {
+		err := Struct(Data(columns, []*Ydb.Value{
+			{
+				Value: &Ydb.Value_Int32Value{
+					Int32Value: 1,
+				},
+			},
+		})).ScanStruct(&row)
+		require.NoError(t, err)
+		require.Equal(t, testStatus("active"), row.Status)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		err := Struct(Data(columns, []*Ydb.Value{
+			{
+				Value: &Ydb.Value_Int32Value{
+					Int32Value: 42,
+				},
+			},
+		})).ScanStruct(&row)
+		require.ErrorIs(t, err, errUnknownStatus)
+		require.ErrorContains(t, err, "column 'status'")
+		require.ErrorContains(t, err, "Just(42)")
+	})
+}
@@ -0,0 +1,141 @@
+package query
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Query_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	internalOperation "github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+)
+
+func (c *Client) ExecuteScript(
+	ctx context.Context, q string, ttl time.Duration, opts ...options.ExecuteScriptOption,
+) (op *query.ScriptOperation, _ error) {
+	select {
+	case <-c.done:
+		return nil, xerrors.WithStackTrace(errClosedClient)
+	default:
+	}
+
+	settings := options.ExecuteScriptSettings(opts...)
+
+	// script execution is not idempotent: retry only errors which guarantee that script was not started
+	err := retry.Retry(ctx, func(ctx context.Context) (err error) {
+		op, err = executeScript(ctx, c.grpcClient, q, ttl, settings)
+
+		return xerrors.WithStackTrace(err)
+	}, retry.WithStackTrace())
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return op, nil
+}
+
+func executeScript(
+	ctx context.Context, client Ydb_Query_V1.QueryServiceClient, q string, ttl time.Duration,
+	settings *options.ExecuteScript,
+) (*query.ScriptOperation, error) {
+	a := allocator.New()
+	defer a.Free()
+
+	request := &Ydb_Query.ExecuteScriptRequest{
+		OperationParams: internalOperation.Params(ctx, 0, 0, internalOperation.ModeAsync),
+		ExecMode:        Ydb_Query.ExecMode(settings.ExecMode()),
+		ScriptContent: &Ydb_Query.QueryContent{
+			Syntax: Ydb_Query.Syntax(settings.Syntax()),
+			Text:   q,
+		},
+		Parameters: settings.Params().ToYDB(a),
+		StatsMode:  Ydb_Query.StatsMode(settings.StatsMode()),
+	}
+	if ttl > 0 {
+		request.ResultsTtl = durationpb.New(ttl)
+	}
+
+	op, err := client.ExecuteScript(ctx, request, settings.CallOptions()...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	// response of ExecuteScript is an operation itself, so connection does not check status of operation
+	if op.GetReady() && op.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return nil, xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(op)))
+	}
+
+	return &query.ScriptOperation{
+		ID:          op.GetId(),
+		ExecutionID: scriptExecutionID(op),
+	}, nil
+}
+
+func scriptExecutionID(op *Ydb_Operations.Operation) string {
+	var metadata Ydb_Query.ExecuteScriptMetadata
+	if err := op.GetMetadata().UnmarshalTo(&metadata); err != nil {
+		return ""
+	}
+
+	return metadata.GetExecutionId()
+}
+
+func (c *Client) FetchScriptResults(
+	ctx context.Context, operationID string, opts ...options.FetchScriptOption,
+) (r *query.ScriptResult, _ error) {
+	select {
+	case <-c.done:
+		return nil, xerrors.WithStackTrace(errClosedClient)
+	default:
+	}
+
+	settings := options.FetchScriptSettings(opts...)
+
+	err := retry.Retry(ctx, func(ctx context.Context) (err error) {
+		r, err = fetchScriptResults(ctx, c, operationID, settings)
+
+		return xerrors.WithStackTrace(err)
+	}, retry.WithStackTrace(), retry.WithIdempotent(true))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return r, nil
+}
+
+func fetchScriptResults(
+	ctx context.Context, c *Client, operationID string, settings *options.FetchScript,
+) (*query.ScriptResult, error) {
+	response, err := c.grpcClient.FetchScriptResults(ctx, &Ydb_Query.FetchScriptResultsRequest{
+		OperationId:    operationID,
+		ResultSetIndex: settings.ResultSetIndex(),
+		FetchToken:     settings.FetchToken(),
+		RowsLimit:      settings.RowsLimit(),
+	})
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	// response of FetchScriptResults has no operation, so connection does not check status of response
+	if response.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return nil, xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(response)))
+	}
+
+	return &query.ScriptResult{
+		ResultSetIndex: response.GetResultSetIndex(),
+		ResultSet: newResultSet(func() (*Ydb_Query.ExecuteQueryResponsePart, error) {
+			return nil, io.EOF
+		}, &Ydb_Query.ExecuteQueryResponsePart{
+			ResultSetIndex: response.GetResultSetIndex(),
+			ResultSet:      response.GetResultSet(),
+		}, c.config.Trace()),
+		NextFetchToken: response.GetNextFetchToken(),
+	}, nil
+}
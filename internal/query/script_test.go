@@ -0,0 +1,172 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Query"
+	"go.uber.org/mock/gomock"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+)
+
+func newTestClient(service *MockQueryServiceClient) *Client {
+	return &Client{
+		config:     config.New(),
+		grpcClient: service,
+		done:       make(chan struct{}),
+	}
+}
+
+func TestExecuteScript(t *testing.T) {
+	t.Run("HappyWay", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		metadata, err := anypb.New(&Ydb_Query.ExecuteScriptMetadata{
+			ExecutionId: "execution",
+			ExecStatus:  Ydb_Query.ExecStatus_EXEC_STATUS_STARTING,
+		})
+		require.NoError(t, err)
+		service := NewMockQueryServiceClient(ctrl)
+		service.EXPECT().ExecuteScript(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, request *Ydb_Query.ExecuteScriptRequest, _ ...interface{}) (
+				*Ydb_Operations.Operation, error,
+			) {
+				require.Equal(t, "SELECT 1", request.GetScriptContent().GetText())
+				require.Equal(t, Ydb_Query.Syntax_SYNTAX_YQL_V1, request.GetScriptContent().GetSyntax())
+				require.Equal(t, Ydb_Query.ExecMode_EXEC_MODE_EXECUTE, request.GetExecMode())
+				require.Equal(t, time.Hour, request.GetResultsTtl().AsDuration())
+				require.Equal(t, Ydb_Operations.OperationParams_ASYNC, request.GetOperationParams().GetOperationMode())
+
+				return &Ydb_Operations.Operation{
+					Id:       "operation",
+					Metadata: metadata,
+				}, nil
+			})
+		op, err := query.ExecuteScript(ctx, newTestClient(service), "SELECT 1", time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, &query.ScriptOperation{
+			ID:          "operation",
+			ExecutionID: "execution",
+		}, op)
+	})
+	t.Run("OperationError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		service.EXPECT().ExecuteScript(gomock.Any(), gomock.Any()).Return(&Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_GENERIC_ERROR,
+		}, nil)
+		_, err := newTestClient(service).ExecuteScript(ctx, "SELECT 1", 0)
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_GENERIC_ERROR))
+	})
+	t.Run("TransportError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		service.EXPECT().ExecuteScript(gomock.Any(), gomock.Any()).Return(
+			nil, xerrors.Transport(grpcStatus.Error(grpcCodes.PermissionDenied, "")),
+		)
+		_, err := newTestClient(service).ExecuteScript(ctx, "SELECT 1", 0)
+		require.Error(t, err)
+		require.True(t, xerrors.IsTransportError(err, grpcCodes.PermissionDenied))
+	})
+	t.Run("ClosedClient", func(t *testing.T) {
+		c := newTestClient(NewMockQueryServiceClient(gomock.NewController(t)))
+		close(c.done)
+		_, err := c.ExecuteScript(xtest.Context(t), "SELECT 1", 0)
+		require.ErrorIs(t, err, errClosedClient)
+	})
+}
+
+func TestFetchScriptResults(t *testing.T) {
+	resultSet := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{
+				Name: "id",
+				Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+			},
+		},
+		Rows: []*Ydb.Value{
+			{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 1}}}},
+			{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 2}}}},
+		},
+	}
+	t.Run("HappyWay", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		service.EXPECT().FetchScriptResults(gomock.Any(), &Ydb_Query.FetchScriptResultsRequest{
+			OperationId:    "operation",
+			ResultSetIndex: 1,
+			FetchToken:     "token",
+			RowsLimit:      2,
+		}).Return(&Ydb_Query.FetchScriptResultsResponse{
+			Status:         Ydb.StatusIds_SUCCESS,
+			ResultSetIndex: 1,
+			ResultSet:      resultSet,
+			NextFetchToken: "next",
+		}, nil)
+		r, err := newTestClient(service).FetchScriptResults(ctx, "operation",
+			options.WithResultSetIndex(1),
+			options.WithFetchToken("token"),
+			options.WithRowsLimit(2),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, r.ResultSetIndex)
+		require.Equal(t, "next", r.NextFetchToken)
+		var ids []uint64
+		for {
+			row, err := r.ResultSet.NextRow(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			var id uint64
+			require.NoError(t, row.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.Equal(t, []uint64{1, 2}, ids)
+	})
+	t.Run("RetryableError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		service.EXPECT().FetchScriptResults(gomock.Any(), gomock.Any()).Return(&Ydb_Query.FetchScriptResultsResponse{
+			Status: Ydb.StatusIds_UNAVAILABLE,
+		}, nil)
+		service.EXPECT().FetchScriptResults(gomock.Any(), gomock.Any()).Return(&Ydb_Query.FetchScriptResultsResponse{
+			Status:    Ydb.StatusIds_SUCCESS,
+			ResultSet: resultSet,
+		}, nil)
+		r, err := newTestClient(service).FetchScriptResults(ctx, "operation")
+		require.NoError(t, err)
+		require.Empty(t, r.NextFetchToken)
+	})
+	t.Run("OperationError", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		ctrl := gomock.NewController(t)
+		service := NewMockQueryServiceClient(ctrl)
+		service.EXPECT().FetchScriptResults(gomock.Any(), gomock.Any()).Return(&Ydb_Query.FetchScriptResultsResponse{
+			Status: Ydb.StatusIds_BAD_REQUEST,
+		}, nil)
+		_, err := newTestClient(service).FetchScriptResults(ctx, "operation")
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+	})
+}
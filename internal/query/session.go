@@ -130,7 +130,7 @@ func (s *Session) attach(ctx context.Context) (finalErr error) {
 
 		var cancel context.CancelFunc
 		if d := s.cfg.SessionDeleteTimeout(); d > 0 {
-			ctx, cancel = xcontext.WithTimeout(ctx, d)
+			ctx, cancel = xcontext.WithNamedTimeout(ctx, xerrors.DeadlineSourceSessionDeleteTimeout, d)
 		} else {
 			ctx, cancel = xcontext.WithCancel(ctx)
 		}
@@ -273,7 +273,7 @@ func (s *Session) Execute(
 	ctx context.Context, q string, opts ...options.ExecuteOption,
 ) (_ query.Transaction, _ query.Result, err error) {
 	onDone := trace.QueryOnSessionExecute(s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.(*Session).Execute"), s, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.(*Session).Execute"), s, s.cfg.RedactQueryText(q))
 	defer func() {
 		onDone(err)
 	}()
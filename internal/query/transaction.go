@@ -35,7 +35,7 @@ func (tx transaction) Execute(ctx context.Context, q string, opts ...options.TxE
 	r query.Result, finalErr error,
 ) {
 	onDone := trace.QueryOnTxExecute(tx.s.cfg.Trace(), &ctx,
-		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.transaction.Execute"), tx.s, tx, q)
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/query.transaction.Execute"), tx.s, tx, tx.s.cfg.RedactQueryText(q))
 	defer func() {
 		onDone(finalErr)
 	}()
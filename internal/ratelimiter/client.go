@@ -9,6 +9,7 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_RateLimiter"
 	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/config"
@@ -52,6 +53,9 @@ func (c *Client) CreateResource(
 	if c == nil {
 		return xerrors.WithStackTrace(errNilClient)
 	}
+	if err = resource.Validate(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
 	call := func(ctx context.Context) error {
 		return xerrors.WithStackTrace(c.createResource(ctx, coordinationNodePath, resource))
 	}
@@ -74,15 +78,7 @@ func (c *Client) createResource(
 ) (err error) {
 	_, err = c.service.CreateResource(ctx, &Ydb_RateLimiter.CreateResourceRequest{
 		CoordinationNodePath: coordinationNodePath,
-		Resource: &Ydb_RateLimiter.Resource{
-			ResourcePath: resource.ResourcePath,
-			Type: &Ydb_RateLimiter.Resource_HierarchicalDrr{HierarchicalDrr: &Ydb_RateLimiter.HierarchicalDrrSettings{
-				MaxUnitsPerSecond:       resource.HierarchicalDrr.MaxUnitsPerSecond,
-				MaxBurstSizeCoefficient: resource.HierarchicalDrr.MaxBurstSizeCoefficient,
-				PrefetchCoefficient:     resource.HierarchicalDrr.PrefetchCoefficient,
-				PrefetchWatermark:       resource.HierarchicalDrr.PrefetchWatermark,
-			}},
-		},
+		Resource:             resourceToProto(resource),
 		OperationParams: operation.Params(
 			ctx,
 			c.config.OperationTimeout(),
@@ -90,8 +86,33 @@ func (c *Client) createResource(
 			operation.ModeSync,
 		),
 	})
+	if err != nil {
+		return xerrors.WithStackTrace(c.checkParentResource(ctx, coordinationNodePath, resource, err))
+	}
 
-	return
+	return nil
+}
+
+// checkParentResource replaces error of resource creation with ErrParentResourceNotFound if parent
+// of nested resource does not exist, because server does not report missing parent explicitly
+func (c *Client) checkParentResource(
+	ctx context.Context,
+	coordinationNodePath string,
+	resource ratelimiter.Resource,
+	err error,
+) error {
+	parentPath := resource.ParentPath()
+	if parentPath == "" || !xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND, Ydb.StatusIds_BAD_REQUEST) {
+		return err
+	}
+	_, describeErr := c.describeResource(ctx, coordinationNodePath, parentPath)
+	if !ratelimiter.IsResourceNotFoundError(describeErr) {
+		return err
+	}
+
+	return xerrors.WithStackTrace(fmt.Errorf("%w: %q is parent of %q: %w",
+		ratelimiter.ErrParentResourceNotFound, parentPath, resource.ResourcePath, err,
+	))
 }
 
 func (c *Client) AlterResource(
@@ -102,6 +123,9 @@ func (c *Client) AlterResource(
 	if c == nil {
 		return xerrors.WithStackTrace(errNilClient)
 	}
+	if err = resource.Validate(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
 	call := func(ctx context.Context) error {
 		return xerrors.WithStackTrace(c.alterResource(ctx, coordinationNodePath, resource))
 	}
@@ -124,15 +148,7 @@ func (c *Client) alterResource(
 ) (err error) {
 	_, err = c.service.AlterResource(ctx, &Ydb_RateLimiter.AlterResourceRequest{
 		CoordinationNodePath: coordinationNodePath,
-		Resource: &Ydb_RateLimiter.Resource{
-			ResourcePath: resource.ResourcePath,
-			Type: &Ydb_RateLimiter.Resource_HierarchicalDrr{HierarchicalDrr: &Ydb_RateLimiter.HierarchicalDrrSettings{
-				MaxUnitsPerSecond:       resource.HierarchicalDrr.MaxUnitsPerSecond,
-				MaxBurstSizeCoefficient: resource.HierarchicalDrr.MaxBurstSizeCoefficient,
-				PrefetchCoefficient:     resource.HierarchicalDrr.PrefetchCoefficient,
-				PrefetchWatermark:       resource.HierarchicalDrr.PrefetchWatermark,
-			}},
-		},
+		Resource:             resourceToProto(resource),
 		OperationParams: operation.Params(
 			ctx,
 			c.config.OperationTimeout(),
@@ -247,6 +263,62 @@ func (c *Client) listResource(
 	return result.GetResourcePaths(), nil
 }
 
+func (c *Client) ListResourceSettings(
+	ctx context.Context,
+	coordinationNodePath string,
+	resourcePath string,
+	recursive bool,
+) (resources []ratelimiter.Resource, _ error) {
+	if c == nil {
+		return resources, xerrors.WithStackTrace(errNilClient)
+	}
+	call := func(ctx context.Context) (err error) {
+		resources, err = c.listResourceSettings(ctx, coordinationNodePath, resourcePath, recursive)
+
+		return xerrors.WithStackTrace(err)
+	}
+	if !c.config.AutoRetry() {
+		err := call(ctx)
+
+		return resources, err
+	}
+	err := retry.Retry(ctx, call,
+		retry.WithIdempotent(true),
+		retry.WithStackTrace(),
+		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+	)
+
+	return resources, err
+}
+
+func (c *Client) listResourceSettings(
+	ctx context.Context,
+	coordinationNodePath string,
+	resourcePath string,
+	recursive bool,
+) (_ []ratelimiter.Resource, err error) {
+	paths, err := c.listResource(ctx, coordinationNodePath, resourcePath, recursive)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	resources := make([]ratelimiter.Resource, 0, len(paths))
+	for _, path := range paths {
+		resource, err := c.describeResource(ctx, coordinationNodePath, path)
+		if err != nil {
+			if ratelimiter.IsResourceNotFoundError(err) {
+				// resource was dropped after listing
+				continue
+			}
+
+			return nil, xerrors.WithStackTrace(err)
+		}
+		resources = append(resources, *resource)
+	}
+
+	return resources, nil
+}
+
 func (c *Client) DescribeResource(
 	ctx context.Context,
 	coordinationNodePath string,
@@ -302,20 +374,36 @@ func (c *Client) describeResource(
 		return nil, xerrors.WithStackTrace(err)
 	}
 
+	return resourceFromProto(result.GetResource()), nil
+}
+
+func resourceToProto(resource ratelimiter.Resource) *Ydb_RateLimiter.Resource {
+	return &Ydb_RateLimiter.Resource{
+		ResourcePath: resource.ResourcePath,
+		Type: &Ydb_RateLimiter.Resource_HierarchicalDrr{HierarchicalDrr: &Ydb_RateLimiter.HierarchicalDrrSettings{
+			MaxUnitsPerSecond:       resource.HierarchicalDrr.MaxUnitsPerSecond,
+			MaxBurstSizeCoefficient: resource.HierarchicalDrr.MaxBurstSizeCoefficient,
+			PrefetchCoefficient:     resource.HierarchicalDrr.PrefetchCoefficient,
+			PrefetchWatermark:       resource.HierarchicalDrr.PrefetchWatermark,
+		}},
+	}
+}
+
+func resourceFromProto(r *Ydb_RateLimiter.Resource) *ratelimiter.Resource {
 	resource := &ratelimiter.Resource{
-		ResourcePath: result.GetResource().GetResourcePath(),
+		ResourcePath: r.GetResourcePath(),
 	}
 
-	if result.GetResource().GetHierarchicalDrr() != nil {
+	if drr := r.GetHierarchicalDrr(); drr != nil {
 		resource.HierarchicalDrr = ratelimiter.HierarchicalDrrSettings{
-			MaxUnitsPerSecond:       result.GetResource().GetHierarchicalDrr().GetMaxUnitsPerSecond(),
-			MaxBurstSizeCoefficient: result.GetResource().GetHierarchicalDrr().GetMaxBurstSizeCoefficient(),
-			PrefetchCoefficient:     result.GetResource().GetHierarchicalDrr().GetPrefetchCoefficient(),
-			PrefetchWatermark:       result.GetResource().GetHierarchicalDrr().GetPrefetchWatermark(),
+			MaxUnitsPerSecond:       drr.GetMaxUnitsPerSecond(),
+			MaxBurstSizeCoefficient: drr.GetMaxBurstSizeCoefficient(),
+			PrefetchCoefficient:     drr.GetPrefetchCoefficient(),
+			PrefetchWatermark:       drr.GetPrefetchWatermark(),
 		}
 	}
 
-	return resource, nil
+	return resource
 }
 
 func (c *Client) AcquireResource(
@@ -331,7 +419,7 @@ func (c *Client) AcquireResource(
 	call := func(ctx context.Context) error {
 		return xerrors.WithStackTrace(c.acquireResource(ctx, coordinationNodePath, resourcePath, amount, opts...))
 	}
-	if !c.config.AutoRetry() {
+	if !c.config.AutoRetry() && !options.NewAcquire(opts...).Retry() {
 		return call(ctx)
 	}
 
@@ -398,7 +486,13 @@ func (c *Client) acquireResource(
 		return xerrors.WithStackTrace(fmt.Errorf("%w: %d", errUnknownAcquireType, acquireOptions.Type()))
 	}
 
-	if xerrors.IsOperationError(err, Ydb.StatusIds_TIMEOUT, Ydb.StatusIds_CANCELLED) {
+	if xerrors.IsOperationError(err, Ydb.StatusIds_TIMEOUT, Ydb.StatusIds_CANCELLED) ||
+		xerrors.IsTransportError(err, grpcCodes.ResourceExhausted) {
+		if acquireOptions.Retry() {
+			// rejected acquire is likely to succeed later, when the resource accumulates units
+			return xerrors.WithStackTrace(ratelimiterErrors.NewRetryableAcquire(amount, err))
+		}
+
 		return xerrors.WithStackTrace(ratelimiterErrors.NewAcquire(amount, err))
 	}
 
@@ -0,0 +1,270 @@
+package ratelimiter
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_RateLimiter_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_RateLimiter"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
+)
+
+type serviceStub struct {
+	Ydb_RateLimiter_V1.RateLimiterServiceClient
+
+	resources map[string]*Ydb_RateLimiter.Resource
+	dropped   []string // paths which are listed but not described
+	acquire   []error  // results of acquire calls
+	acquires  int
+}
+
+func (s *serviceStub) CreateResource(
+	_ context.Context, in *Ydb_RateLimiter.CreateResourceRequest, _ ...grpc.CallOption,
+) (*Ydb_RateLimiter.CreateResourceResponse, error) {
+	path := in.GetResource().GetResourcePath()
+	if i := strings.LastIndex(path, "/"); i > 0 {
+		if _, has := s.resources[path[:i]]; !has {
+			return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_REQUEST))
+		}
+	}
+	s.resources[path] = in.GetResource()
+
+	return &Ydb_RateLimiter.CreateResourceResponse{}, nil
+}
+
+func (s *serviceStub) AlterResource(
+	_ context.Context, in *Ydb_RateLimiter.AlterResourceRequest, _ ...grpc.CallOption,
+) (*Ydb_RateLimiter.AlterResourceResponse, error) {
+	s.resources[in.GetResource().GetResourcePath()] = in.GetResource()
+
+	return &Ydb_RateLimiter.AlterResourceResponse{}, nil
+}
+
+func (s *serviceStub) ListResources(
+	_ context.Context, in *Ydb_RateLimiter.ListResourcesRequest, _ ...grpc.CallOption,
+) (*Ydb_RateLimiter.ListResourcesResponse, error) {
+	var paths []string
+	for path := range s.resources {
+		switch {
+		case path == in.GetResourcePath():
+		case in.GetResourcePath() != "" && !strings.HasPrefix(path, in.GetResourcePath()+"/"):
+			continue
+		case !in.GetRecursive() && strings.Count(path, "/") > strings.Count(in.GetResourcePath(), "/")+1:
+			continue
+		case !in.GetRecursive() && in.GetResourcePath() == "" && strings.Contains(path, "/"):
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	// listed resource is dropped concurrently
+	paths = append(paths, s.dropped...)
+	result, err := anypb.New(&Ydb_RateLimiter.ListResourcesResult{ResourcePaths: paths})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ydb_RateLimiter.ListResourcesResponse{
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: result,
+		},
+	}, nil
+}
+
+func (s *serviceStub) DescribeResource(
+	_ context.Context, in *Ydb_RateLimiter.DescribeResourceRequest, _ ...grpc.CallOption,
+) (*Ydb_RateLimiter.DescribeResourceResponse, error) {
+	resource, has := s.resources[in.GetResourcePath()]
+	if !has {
+		return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_NOT_FOUND))
+	}
+	result, err := anypb.New(&Ydb_RateLimiter.DescribeResourceResult{Resource: resource})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Ydb_RateLimiter.DescribeResourceResponse{
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: result,
+		},
+	}, nil
+}
+
+func (s *serviceStub) AcquireResource(
+	context.Context, *Ydb_RateLimiter.AcquireResourceRequest, ...grpc.CallOption,
+) (*Ydb_RateLimiter.AcquireResourceResponse, error) {
+	s.acquires++
+	if len(s.acquire) == 0 {
+		return &Ydb_RateLimiter.AcquireResourceResponse{}, nil
+	}
+	err := s.acquire[0]
+	s.acquire = s.acquire[1:]
+
+	return &Ydb_RateLimiter.AcquireResourceResponse{}, err
+}
+
+func TestDescribeResourceRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	c := &Client{
+		config:  config.New(),
+		service: &serviceStub{resources: make(map[string]*Ydb_RateLimiter.Resource)},
+	}
+
+	resource := ratelimiter.Resource{
+		ResourcePath: "test_resource",
+		HierarchicalDrr: ratelimiter.HierarchicalDrrSettings{
+			MaxUnitsPerSecond:       10,
+			MaxBurstSizeCoefficient: 2,
+			PrefetchCoefficient:     0.5,
+			PrefetchWatermark:       0.75,
+		},
+	}
+	require.NoError(t, c.CreateResource(ctx, "/local/node", resource))
+
+	described, err := c.DescribeResource(ctx, "/local/node", "test_resource")
+	require.NoError(t, err)
+	require.Equal(t, resource, *described)
+
+	_, err = c.DescribeResource(ctx, "/local/node", "unknown")
+	require.True(t, ratelimiter.IsResourceNotFoundError(err))
+	require.False(t, ratelimiter.IsAcquireError(err))
+}
+
+func TestAcquireResourceRejection(t *testing.T) {
+	ctx := context.Background()
+	rejected := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_TIMEOUT))
+
+	t.Run("NoRetry", func(t *testing.T) {
+		service := &serviceStub{acquire: []error{rejected}}
+		c := &Client{config: config.New(), service: service}
+
+		err := c.AcquireResource(ctx, "/local/node", "test_resource", 5)
+		require.True(t, ratelimiter.IsAcquireError(err))
+		require.True(t, ratelimiter.IsResourceExhaustedError(err))
+		require.False(t, ratelimiter.IsResourceNotFoundError(err))
+		require.EqualValues(t, 5, ratelimiter.ToAcquireError(err).Amount())
+		require.Equal(t, 1, service.acquires)
+	})
+	t.Run("WithAcquireRetry", func(t *testing.T) {
+		service := &serviceStub{acquire: []error{rejected}}
+		c := &Client{config: config.New(), service: service}
+
+		err := c.AcquireResource(ctx, "/local/node", "test_resource", 5, ratelimiter.WithAcquireRetry())
+		require.NoError(t, err)
+		require.Equal(t, 2, service.acquires)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		service := &serviceStub{acquire: []error{xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_NOT_FOUND))}}
+		c := &Client{config: config.New(), service: service}
+
+		err := c.AcquireResource(ctx, "/local/node", "test_resource", 5, ratelimiter.WithAcquireRetry())
+		require.True(t, ratelimiter.IsResourceNotFoundError(err))
+		require.False(t, ratelimiter.IsAcquireError(err))
+		require.Equal(t, 1, service.acquires)
+	})
+}
+
+func TestCreateResourceValidation(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		name     string
+		resource ratelimiter.Resource
+	}{
+		{
+			name:     "EmptyPath",
+			resource: ratelimiter.NewResource("").WithMaxUnitsPerSecond(1),
+		},
+		{
+			name:     "AbsolutePath",
+			resource: ratelimiter.NewResource("/root").WithMaxUnitsPerSecond(1),
+		},
+		{
+			name:     "NegativeMaxUnitsPerSecond",
+			resource: ratelimiter.NewResource("root").WithMaxUnitsPerSecond(-1),
+		},
+		{
+			name:     "NegativeMaxBurstSizeCoefficient",
+			resource: ratelimiter.NewResource("root").WithMaxUnitsPerSecond(1).WithMaxBurstSizeCoefficient(-2),
+		},
+		{
+			name:     "NaNPrefetchCoefficient",
+			resource: ratelimiter.NewResource("root").WithMaxUnitsPerSecond(1).WithPrefetchCoefficient(math.NaN()),
+		},
+		{
+			name:     "TooLargePrefetchWatermark",
+			resource: ratelimiter.NewResource("root").WithMaxUnitsPerSecond(1).WithPrefetchWatermark(1.5),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &serviceStub{resources: make(map[string]*Ydb_RateLimiter.Resource)}
+			c := &Client{config: config.New(), service: service}
+
+			require.ErrorIs(t, c.CreateResource(ctx, "/local/node", tt.resource), ratelimiter.ErrInvalidResource)
+			require.ErrorIs(t, c.AlterResource(ctx, "/local/node", tt.resource), ratelimiter.ErrInvalidResource)
+			require.Empty(t, service.resources)
+		})
+	}
+	t.Run("NegativePrefetchCoefficient", func(t *testing.T) {
+		// negative prefetch coefficient disables prefetching
+		c := &Client{config: config.New(), service: &serviceStub{resources: make(map[string]*Ydb_RateLimiter.Resource)}}
+
+		require.NoError(t, c.CreateResource(ctx, "/local/node",
+			ratelimiter.NewResource("root").WithMaxUnitsPerSecond(1).WithPrefetchCoefficient(-1),
+		))
+	})
+}
+
+func TestCreateResourceParentNotFound(t *testing.T) {
+	ctx := context.Background()
+	c := &Client{config: config.New(), service: &serviceStub{resources: make(map[string]*Ydb_RateLimiter.Resource)}}
+
+	err := c.CreateResource(ctx, "/local/node", ratelimiter.NewResource("root/child"))
+	require.ErrorIs(t, err, ratelimiter.ErrParentResourceNotFound)
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST))
+	require.Contains(t, err.Error(), `"root" is parent of "root/child"`)
+
+	require.NoError(t, c.CreateResource(ctx, "/local/node", ratelimiter.NewResource("root").WithMaxUnitsPerSecond(1)))
+	require.NoError(t, c.CreateResource(ctx, "/local/node", ratelimiter.NewResource("root/child")))
+}
+
+func TestListResourceSettings(t *testing.T) {
+	ctx := context.Background()
+	service := &serviceStub{resources: make(map[string]*Ydb_RateLimiter.Resource)}
+	c := &Client{config: config.New(), service: service}
+
+	root := ratelimiter.NewResource("root").WithMaxUnitsPerSecond(100).WithMaxBurstSizeCoefficient(2)
+	child := ratelimiter.NewResource("root/child").WithPrefetchCoefficient(0.5).WithPrefetchWatermark(0.75)
+	leaf := ratelimiter.NewResource("root/child/leaf").WithMaxUnitsPerSecond(10)
+	for _, resource := range []ratelimiter.Resource{root, child, leaf} {
+		require.NoError(t, c.CreateResource(ctx, "/local/node", resource))
+	}
+	service.dropped = []string{"root/dropped"}
+
+	resources, err := ratelimiter.ListResourceSettings(ctx, c, "/local/node", "root", true)
+	require.NoError(t, err)
+	require.Equal(t, []ratelimiter.Resource{root, child, leaf}, resources)
+
+	resources, err = c.ListResourceSettings(ctx, "/local/node", "root", false)
+	require.NoError(t, err)
+	require.Equal(t, []ratelimiter.Resource{root, child}, resources)
+
+	require.NoError(t, c.AlterResource(ctx, "/local/node", leaf.WithMaxUnitsPerSecond(20)))
+	resources, err = c.ListResourceSettings(ctx, "/local/node", "root/child/leaf", false)
+	require.NoError(t, err)
+	require.Equal(t, []ratelimiter.Resource{leaf.WithMaxUnitsPerSecond(20)}, resources)
+}
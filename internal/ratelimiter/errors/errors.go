@@ -3,13 +3,17 @@ package errors
 import (
 	"fmt"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/ratelimiter"
 )
 
+var _ xerrors.Error = (*acquireError)(nil)
+
 type acquireError struct {
-	err    error
-	amount uint64
+	err       error
+	amount    uint64
+	retryable bool
 }
 
 func (e *acquireError) Amount() uint64 {
@@ -24,6 +28,40 @@ func (e *acquireError) Unwrap() error {
 	return e.err
 }
 
+func (e *acquireError) Code() int32 {
+	var ee xerrors.Error
+	if e.err != nil && xerrors.As(e.err, &ee) {
+		return ee.Code()
+	}
+
+	return -1
+}
+
+func (e *acquireError) Name() string {
+	return "ratelimiter/acquire"
+}
+
+// Type overrides type of underlying error: rejected acquire is retried only if caller opted in
+func (e *acquireError) Type() xerrors.Type {
+	if e.retryable {
+		return xerrors.TypeRetryable
+	}
+
+	return xerrors.TypeNonRetryable
+}
+
+func (e *acquireError) BackoffType() backoff.Type {
+	if e.retryable {
+		return backoff.TypeSlow
+	}
+
+	return backoff.TypeNoBackoff
+}
+
+func (e *acquireError) IsRetryObjectValid() bool {
+	return false
+}
+
 func NewAcquire(amoount uint64, err error) ratelimiter.AcquireError {
 	return &acquireError{
 		err:    err,
@@ -31,6 +69,15 @@ func NewAcquire(amoount uint64, err error) ratelimiter.AcquireError {
 	}
 }
 
+// NewRetryableAcquire makes acquire error which is retried with slow backoff
+func NewRetryableAcquire(amount uint64, err error) ratelimiter.AcquireError {
+	return &acquireError{
+		err:       err,
+		amount:    amount,
+		retryable: true,
+	}
+}
+
 func IsAcquireError(err error) bool {
 	var ae *acquireError
 
@@ -24,12 +24,16 @@ type Acquire interface {
 
 	// OperationCancelAfter defines operation CancelAfter for acquire request
 	OperationCancelAfter() time.Duration
+
+	// Retry defines retrying of rejected acquire requests
+	Retry() bool
 }
 
 type acquireOptionsHolder struct {
 	acquireType          AcquireType
 	operationTimeout     time.Duration
 	operationCancelAfter time.Duration
+	retry                bool
 }
 
 func (h *acquireOptionsHolder) OperationTimeout() time.Duration {
@@ -37,7 +41,11 @@ func (h *acquireOptionsHolder) OperationTimeout() time.Duration {
 }
 
 func (h *acquireOptionsHolder) OperationCancelAfter() time.Duration {
-	return h.operationTimeout
+	return h.operationCancelAfter
+}
+
+func (h *acquireOptionsHolder) Retry() bool {
+	return h.retry
 }
 
 func (h *acquireOptionsHolder) Type() AcquireType {
@@ -70,6 +78,12 @@ func WithOperationCancelAfter(operationCancelAfter time.Duration) AcquireOption
 	}
 }
 
+func WithRetry() AcquireOption {
+	return func(h *acquireOptionsHolder) {
+		h.retry = true
+	}
+}
+
 func NewAcquire(opts ...AcquireOption) Acquire {
 	h := &acquireOptionsHolder{
 		acquireType: AcquireTypeDefault,
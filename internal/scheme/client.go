@@ -3,6 +3,8 @@ package scheme
 import (
 	"context"
 	"errors"
+	pathpkg "path"
+	"strings"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
@@ -277,20 +279,21 @@ func (c *Client) ModifyPermissions(
 }
 
 func (c *Client) modifyPermissions(ctx context.Context, path string, desc permissionsDesc) (err error) {
-	_, err = c.service.ModifyPermissions(
-		ctx,
-		&Ydb_Scheme.ModifyPermissionsRequest{
-			Path:             path,
-			Actions:          desc.actions,
-			ClearPermissions: desc.clear,
-			OperationParams: operation.Params(
-				ctx,
-				c.config.OperationTimeout(),
-				c.config.OperationCancelAfter(),
-				operation.ModeSync,
-			),
-		},
-	)
+	request := &Ydb_Scheme.ModifyPermissionsRequest{
+		Path:             path,
+		Actions:          desc.actions,
+		ClearPermissions: desc.clear,
+		OperationParams: operation.Params(
+			ctx,
+			c.config.OperationTimeout(),
+			c.config.OperationCancelAfter(),
+			operation.ModeSync,
+		),
+	}
+	if desc.inheritance != nil {
+		request.Inheritance = desc.inheritance
+	}
+	_, err = c.service.ModifyPermissions(ctx, request)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -298,6 +301,50 @@ func (c *Client) modifyPermissions(ctx context.Context, path string, desc permis
 	return nil
 }
 
+// EffectivePermissions returns access rights of scheme object at path including inherited access rights.
+// Effective permissions computed by server are used if server returns them, otherwise explicit access rights
+// of path and its parent directories up to database root are merged. Merging stops at parent directory with
+// effective permissions computed by server: such server would return inherited access rights of path too,
+// so their absence means interrupted inheritance
+func (c *Client) EffectivePermissions(ctx context.Context, path string) (scheme.EffectivePermissions, error) {
+	return effectivePermissions(ctx, c.Database(), path, c.DescribePath)
+}
+
+func effectivePermissions(
+	ctx context.Context,
+	database, path string,
+	describe func(ctx context.Context, path string) (scheme.Entry, error),
+) (p scheme.EffectivePermissions, _ error) {
+	for i := 0; ; i++ {
+		e, err := describe(ctx, path)
+		if err != nil {
+			return p, xerrors.WithStackTrace(err)
+		}
+		if i == 0 {
+			p.Owner = e.Owner
+		}
+		// server effective permissions already include access rights of parent directories
+		// with respect to interrupted inheritance
+		if len(e.EffectivePermissions) > 0 {
+			if i > 0 {
+				// server computes effective permissions, but object at initial path has no inherited
+				// access rights of this parent, so inheritance is interrupted below this parent
+				return p, nil
+			}
+			p.Merge(e.EffectivePermissions...)
+
+			return p, nil
+		}
+		p.Merge(e.Permissions...)
+
+		parent := pathpkg.Dir(path)
+		if path == database || parent == path || (parent != database && !strings.HasPrefix(parent, database+"/")) {
+			return p, nil
+		}
+		path = parent
+	}
+}
+
 func putEntry(dst []scheme.Entry, src []*Ydb_Scheme.Entry) {
 	for i, e := range src {
 		(dst[i]).From(e)
@@ -0,0 +1,197 @@
+package scheme
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+func TestEffectivePermissions(t *testing.T) {
+	errNotFound := errors.New("not found")
+	entries := map[string]scheme.Entry{
+		"/local": {
+			Owner: "root",
+			Permissions: []scheme.Permissions{
+				{Subject: "reader", PermissionNames: []string{scheme.PermissionRead}},
+				{Subject: "admin", PermissionNames: []string{scheme.PermissionFull}},
+			},
+		},
+		"/local/dir": {
+			Owner: "root",
+			Permissions: []scheme.Permissions{
+				{Subject: "writer", PermissionNames: []string{scheme.PermissionWrite}},
+				{Subject: "reader", PermissionNames: []string{scheme.PermissionList}},
+			},
+		},
+		"/local/dir/table": {
+			Owner: "user",
+			Permissions: []scheme.Permissions{
+				{Subject: "reader", PermissionNames: []string{scheme.PermissionRead}},
+			},
+		},
+		"/local/isolated": {
+			Owner: "root",
+			Permissions: []scheme.Permissions{
+				{Subject: "writer", PermissionNames: []string{scheme.PermissionWrite}},
+			},
+			// server returns effective permissions of directory with interrupted inheritance
+			// without access rights of parent directories
+			EffectivePermissions: []scheme.Permissions{
+				{Subject: "writer", PermissionNames: []string{scheme.PermissionWrite}},
+			},
+		},
+		"/local/secure": {
+			Owner: "root",
+			Permissions: []scheme.Permissions{
+				{Subject: "admin", PermissionNames: []string{scheme.PermissionFull}},
+			},
+			EffectivePermissions: []scheme.Permissions{
+				{Subject: "reader", PermissionNames: []string{scheme.PermissionRead}},
+				{Subject: "admin", PermissionNames: []string{scheme.PermissionFull}},
+			},
+		},
+		// table with interrupted inheritance and without own access rights, so server returns
+		// no effective permissions
+		"/local/secure/table": {
+			Owner: "user",
+		},
+		"/local/inherited": {
+			Owner: "root",
+			Permissions: []scheme.Permissions{
+				{Subject: "writer", PermissionNames: []string{scheme.PermissionWrite}},
+			},
+			EffectivePermissions: []scheme.Permissions{
+				{Subject: "reader", PermissionNames: []string{scheme.PermissionRead}},
+				{Subject: "admin", PermissionNames: []string{scheme.PermissionFull}},
+				{Subject: "writer", PermissionNames: []string{scheme.PermissionWrite}},
+			},
+		},
+	}
+	for _, tt := range []struct {
+		name        string
+		path        string
+		described   []string
+		permissions scheme.EffectivePermissions
+		allowed     map[string][]string
+		denied      map[string][]string
+		err         error
+	}{
+		{
+			name:      "NestedGrants",
+			path:      "/local/dir/table",
+			described: []string{"/local/dir/table", "/local/dir", "/local"},
+			permissions: scheme.EffectivePermissions{
+				Owner: "user",
+				Permissions: []scheme.Permissions{
+					{Subject: "reader", PermissionNames: []string{scheme.PermissionRead, scheme.PermissionList}},
+					{Subject: "writer", PermissionNames: []string{scheme.PermissionWrite}},
+					{Subject: "admin", PermissionNames: []string{scheme.PermissionFull}},
+				},
+			},
+			allowed: map[string][]string{
+				"user":   {scheme.PermissionFull},
+				"reader": {scheme.PermissionSelectRow, scheme.PermissionDescribeSchema},
+				"writer": {scheme.PermissionUpdateRow, scheme.PermissionEraseRow},
+				"admin":  {scheme.PermissionSelectRow, scheme.PermissionUpdateRow, scheme.PermissionRead},
+			},
+			denied: map[string][]string{
+				"reader":  {scheme.PermissionUpdateRow},
+				"writer":  {scheme.PermissionSelectRow},
+				"unknown": {scheme.PermissionDescribeSchema},
+			},
+		},
+		{
+			name:      "ServerEffectivePermissions",
+			path:      "/local/inherited",
+			described: []string{"/local/inherited"},
+			permissions: scheme.EffectivePermissions{
+				Owner: "root",
+				Permissions: []scheme.Permissions{
+					{Subject: "reader", PermissionNames: []string{scheme.PermissionRead}},
+					{Subject: "admin", PermissionNames: []string{scheme.PermissionFull}},
+					{Subject: "writer", PermissionNames: []string{scheme.PermissionWrite}},
+				},
+			},
+			allowed: map[string][]string{
+				"reader": {scheme.PermissionSelectRow},
+				"admin":  {scheme.PermissionAlterSchema},
+			},
+		},
+		{
+			name:      "InterruptedInheritance",
+			path:      "/local/isolated",
+			described: []string{"/local/isolated"},
+			permissions: scheme.EffectivePermissions{
+				Owner: "root",
+				Permissions: []scheme.Permissions{
+					{Subject: "writer", PermissionNames: []string{scheme.PermissionWrite}},
+				},
+			},
+			allowed: map[string][]string{
+				"writer": {scheme.PermissionUpdateRow},
+			},
+			denied: map[string][]string{
+				"reader": {scheme.PermissionSelectRow},
+				"admin":  {scheme.PermissionSelectRow},
+			},
+		},
+		{
+			name:      "InterruptedInheritanceWithoutPermissions",
+			path:      "/local/secure/table",
+			described: []string{"/local/secure/table", "/local/secure"},
+			permissions: scheme.EffectivePermissions{
+				Owner: "user",
+			},
+			allowed: map[string][]string{
+				"user": {scheme.PermissionSelectRow},
+			},
+			denied: map[string][]string{
+				"reader": {scheme.PermissionSelectRow},
+				"admin":  {scheme.PermissionSelectRow},
+			},
+		},
+		{
+			name:      "NotFound",
+			path:      "/local/dir/unknown",
+			described: []string{"/local/dir/unknown"},
+			err:       errNotFound,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var described []string
+			p, err := effectivePermissions(context.Background(), "/local", tt.path,
+				func(ctx context.Context, path string) (scheme.Entry, error) {
+					described = append(described, path)
+					e, has := entries[path]
+					if !has {
+						return e, errNotFound
+					}
+
+					return e, nil
+				},
+			)
+			require.Equal(t, tt.described, described)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.permissions, p)
+			for subject, permissions := range tt.allowed {
+				for _, permission := range permissions {
+					require.True(t, p.Allowed(subject, permission), subject+": "+permission)
+				}
+			}
+			for subject, permissions := range tt.denied {
+				for _, permission := range permissions {
+					require.False(t, p.Allowed(subject, permission), subject+": "+permission)
+				}
+			}
+		})
+	}
+}
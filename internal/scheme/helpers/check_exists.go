@@ -6,6 +6,8 @@ import (
 	"path"
 	"strings"
 
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 )
@@ -15,16 +17,39 @@ type schemeClient interface {
 	ListDirectory(ctx context.Context, path string) (d scheme.Directory, err error)
 }
 
+// isInside checks that cleaned absolute path p is a database root or a path inside database
+func isInside(database, p string) bool {
+	database = path.Clean(database)
+
+	return p == database || strings.HasPrefix(p, strings.TrimRight(database, "/")+"/")
+}
+
+// listDirectory lists directory. Directory which is not found is reported as exists == false without error,
+// other errors (access errors, transport errors) are returned as is
+func listDirectory(ctx context.Context, c schemeClient, directory string) (d scheme.Directory, exists bool, _ error) {
+	d, err := c.ListDirectory(ctx, directory)
+	if err != nil {
+		if xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR) {
+			return d, false, nil
+		}
+
+		return d, false, xerrors.WithStackTrace(err)
+	}
+
+	return d, true, nil
+}
+
 func IsDirectoryExists(ctx context.Context, c schemeClient, directory string) (
 	exists bool, _ error,
 ) {
-	if !strings.HasPrefix(directory, c.Database()) {
+	directory = path.Clean(directory)
+	if !isInside(c.Database(), directory) {
 		return false, xerrors.WithStackTrace(fmt.Errorf(
 			"path '%s' must be inside database '%s'",
 			directory, c.Database(),
 		))
 	}
-	if directory == c.Database() {
+	if directory == path.Clean(c.Database()) {
 		return true, nil
 	}
 	parentDirectory, childDirectory := path.Split(directory)
@@ -36,9 +61,11 @@ func IsDirectoryExists(ctx context.Context, c schemeClient, directory string) (
 		return false, nil
 	}
 
-	d, err := c.ListDirectory(ctx, parentDirectory)
+	d, exists, err := listDirectory(ctx, c, parentDirectory)
 	if err != nil {
 		return false, xerrors.WithStackTrace(err)
+	} else if !exists {
+		return false, nil
 	}
 	for i := range d.Children {
 		if d.Children[i].Name != childDirectory {
@@ -60,12 +87,13 @@ func IsDirectoryExists(ctx context.Context, c schemeClient, directory string) (
 func IsEntryExists(ctx context.Context, c schemeClient, absPath string, entryTypes ...scheme.EntryType) (
 	exists bool, _ error,
 ) {
-	if !strings.HasPrefix(absPath, c.Database()) {
+	absPath = path.Clean(absPath)
+	if !isInside(c.Database(), absPath) {
 		return false, xerrors.WithStackTrace(fmt.Errorf(
 			"entry path '%s' must be inside database '%s'",
 			absPath, c.Database(),
 		))
-	} else if absPath == c.Database() {
+	} else if absPath == path.Clean(c.Database()) {
 		return false, xerrors.WithStackTrace(fmt.Errorf(
 			"entry path '%s' cannot be equals database name '%s'",
 			absPath, c.Database(),
@@ -77,12 +105,14 @@ func IsEntryExists(ctx context.Context, c schemeClient, absPath string, entryTyp
 	} else if !exists {
 		return false, nil
 	}
-	d, err := c.ListDirectory(ctx, directory)
+	d, exists, err := listDirectory(ctx, c, directory)
 	if err != nil {
 		return false, xerrors.WithStackTrace(fmt.Errorf(
 			"list directory '%s' failed: %w",
 			directory, err,
 		))
+	} else if !exists {
+		return false, nil
 	}
 	for i := range d.Children {
 		if d.Children[i].Name != entryName {
@@ -7,7 +7,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 )
 
@@ -238,3 +242,124 @@ func TestEntryTypePrintf(t *testing.T) {
 		fmt.Sprintf("%v", []scheme.EntryType{scheme.EntryTable, scheme.EntryColumnTable}),
 	)
 }
+
+// schemeClientStub lists directories from map of directory path to children
+type schemeClientStub struct {
+	dbName      string
+	directories map[string][]scheme.Entry
+	err         error // error of listing of unknown directories
+}
+
+func (c schemeClientStub) Database() string {
+	return c.dbName
+}
+
+func (c schemeClientStub) ListDirectory(ctx context.Context, p string) (d scheme.Directory, err error) {
+	children, has := c.directories[strings.TrimRight(p, "/")]
+	if !has {
+		return d, c.err
+	}
+
+	return scheme.Directory{
+		Entry:    scheme.Entry{Name: p, Type: scheme.EntryDirectory},
+		Children: children,
+	}, nil
+}
+
+func TestIsEntryExistsErrors(t *testing.T) {
+	var (
+		notFound = xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR))
+		denied   = xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAUTHORIZED))
+		network  = xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+		client   = func(err error) schemeClientStub {
+			return schemeClientStub{
+				dbName: "/local",
+				directories: map[string][]scheme.Entry{
+					"/local": {
+						{Name: "dir", Type: scheme.EntryDirectory},
+						{Name: "table", Type: scheme.EntryTable},
+						{Name: "column_table", Type: scheme.EntryColumnTable},
+					},
+				},
+				err: err,
+			}
+		}
+	)
+	for _, tt := range []struct {
+		name       string
+		client     schemeClientStub
+		path       string
+		entryTypes []scheme.EntryType
+		exists     bool
+		err        error
+	}{
+		{
+			name:       "Table",
+			client:     client(notFound),
+			path:       "/local/table",
+			entryTypes: []scheme.EntryType{scheme.EntryTable},
+			exists:     true,
+		},
+		{
+			name:       "ColumnTable",
+			client:     client(notFound),
+			path:       "/local/column_table",
+			entryTypes: []scheme.EntryType{scheme.EntryColumnTable},
+			exists:     true,
+		},
+		{
+			name:       "TrailingSlash",
+			client:     client(notFound),
+			path:       "/local/table/",
+			entryTypes: []scheme.EntryType{scheme.EntryTable},
+			exists:     true,
+		},
+		{
+			name:       "NotFound",
+			client:     client(notFound),
+			path:       "/local/dir/table",
+			entryTypes: []scheme.EntryType{scheme.EntryTable},
+			exists:     false,
+		},
+		{
+			name:       "AccessDenied",
+			client:     client(denied),
+			path:       "/local/dir/table",
+			entryTypes: []scheme.EntryType{scheme.EntryTable},
+			err:        denied,
+		},
+		{
+			name:       "TransportError",
+			client:     client(network),
+			path:       "/local/dir/table",
+			entryTypes: []scheme.EntryType{scheme.EntryColumnTable},
+			err:        network,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			exists, err := IsEntryExists(context.Background(), tt.client, tt.path, tt.entryTypes...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.exists, exists)
+		})
+	}
+	t.Run("OutsideDatabase", func(t *testing.T) {
+		_, err := IsEntryExists(context.Background(), client(notFound), "/local2/table", scheme.EntryTable)
+		require.Error(t, err)
+		_, err = IsDirectoryExists(context.Background(), client(notFound), "/local2")
+		require.Error(t, err)
+	})
+	t.Run("Directory", func(t *testing.T) {
+		exists, err := IsDirectoryExists(context.Background(), client(notFound), "/local/dir/")
+		require.NoError(t, err)
+		require.True(t, exists)
+		exists, err = IsDirectoryExists(context.Background(), client(notFound), "/local/dir/subdir")
+		require.NoError(t, err)
+		require.False(t, exists)
+		_, err = IsDirectoryExists(context.Background(), client(denied), "/local/dir/subdir")
+		require.ErrorIs(t, err, denied)
+	})
+}
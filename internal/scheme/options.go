@@ -3,14 +3,21 @@ package scheme
 import "github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
 
 type permissionsDesc struct {
-	clear   bool
-	actions []*Ydb_Scheme.PermissionsAction
+	clear       bool
+	inheritance *Ydb_Scheme.ModifyPermissionsRequest_InterruptInheritance
+	actions     []*Ydb_Scheme.PermissionsAction
 }
 
 func (p *permissionsDesc) SetClear(clear bool) {
 	p.clear = clear
 }
 
+func (p *permissionsDesc) SetInterruptInheritance(interrupt bool) {
+	p.inheritance = &Ydb_Scheme.ModifyPermissionsRequest_InterruptInheritance{
+		InterruptInheritance: interrupt,
+	}
+}
+
 func (p *permissionsDesc) AppendAction(action *Ydb_Scheme.PermissionsAction) {
 	p.actions = append(p.actions, action)
 }
@@ -71,3 +71,18 @@ func TestSchemeOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestInterruptInheritanceOption(t *testing.T) {
+	var desc permissionsDesc
+	if desc.inheritance != nil {
+		t.Errorf("Inheritance is not as expected")
+	}
+	scheme.WithInterruptInheritance(true)(&desc)
+	if desc.inheritance == nil || !desc.inheritance.InterruptInheritance {
+		t.Errorf("Inheritance is not as expected")
+	}
+	scheme.WithInterruptInheritance(false)(&desc)
+	if desc.inheritance == nil || desc.inheritance.InterruptInheritance {
+		t.Errorf("Inheritance is not as expected")
+	}
+}
@@ -6,11 +6,13 @@ import (
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scripting_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scripting"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scripting/config"
@@ -74,7 +76,7 @@ func (c *Client) execute(
 	var (
 		onDone = trace.ScriptingOnExecute(c.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/scripting.(*Client).execute"),
-			query, parameters,
+			c.config.RedactQueryText(query), parameters,
 		)
 		a       = allocator.New()
 		request = &Ydb_Scripting.ExecuteYqlRequest{
@@ -154,7 +156,7 @@ func (c *Client) explain(
 	var (
 		onDone = trace.ScriptingOnExplain(c.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/scripting.(*Client).explain"),
-			query,
+			c.config.RedactQueryText(query),
 		)
 		request = &Ydb_Scripting.ExplainYqlRequest{
 			Script: query,
@@ -194,6 +196,68 @@ func (c *Client) explain(
 	return e, nil
 }
 
+func (c *Client) Validate(ctx context.Context, query string) (queryIssues []issues.Issue, err error) {
+	if c == nil {
+		return nil, xerrors.WithStackTrace(errNilClient)
+	}
+	call := func(ctx context.Context) error {
+		queryIssues, err = c.validate(ctx, query)
+
+		return xerrors.WithStackTrace(err)
+	}
+	if !c.config.AutoRetry() {
+		err = call(ctx)
+
+		return queryIssues, err
+	}
+	err = retry.Retry(ctx, call,
+		retry.WithStackTrace(),
+		retry.WithIdempotent(true),
+		retry.WithTrace(c.config.TraceRetry()),
+		retry.WithBudget(c.config.RetryBudget()),
+	)
+
+	return queryIssues, xerrors.WithStackTrace(err)
+}
+
+// validate explains query in validate mode. Issues of invalid query are taken from operation error
+func (c *Client) validate(ctx context.Context, query string) (_ []issues.Issue, err error) {
+	var (
+		onDone = trace.ScriptingOnValidate(c.config.Trace(), &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/scripting.(*Client).validate"),
+			c.config.RedactQueryText(query),
+		)
+		request = &Ydb_Scripting.ExplainYqlRequest{
+			Script: query,
+			Mode:   Ydb_Scripting.ExplainYqlRequest_VALIDATE,
+			OperationParams: operation.Params(
+				ctx,
+				c.config.OperationTimeout(),
+				c.config.OperationCancelAfter(),
+				operation.ModeSync,
+			),
+		}
+		response    *Ydb_Scripting.ExplainYqlResponse
+		queryIssues []*Ydb_Issue.IssueMessage
+	)
+	defer func() {
+		traceIssues := make([]trace.Issue, 0, len(queryIssues))
+		for _, issue := range queryIssues {
+			traceIssues = append(traceIssues, issue)
+		}
+		onDone(traceIssues, err)
+	}()
+	response, err = c.service.ExplainYql(ctx, request)
+	if err != nil {
+		queryIssues = xerrors.OperationIssues(err)
+
+		return issues.FromYDB(queryIssues), xerrors.WithStackTrace(err)
+	}
+	queryIssues = response.GetOperation().GetIssues()
+
+	return issues.FromYDB(queryIssues), nil
+}
+
 func (c *Client) StreamExecute(
 	ctx context.Context,
 	query string,
@@ -229,7 +293,7 @@ func (c *Client) streamExecute(
 	var (
 		onIntermediate = trace.ScriptingOnStreamExecute(c.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/scripting.(*Client).streamExecute"),
-			query, parameters,
+			c.config.RedactQueryText(query), parameters,
 		)
 		a       = allocator.New()
 		request = &Ydb_Scripting.ExecuteYqlRequest{
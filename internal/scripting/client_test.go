@@ -0,0 +1,124 @@
+package scripting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scripting_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scripting"
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scripting/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// explainStub is a scripting service which responds on explain requests with given response or error
+type explainStub struct {
+	Ydb_Scripting_V1.ScriptingServiceClient
+
+	requests []*Ydb_Scripting.ExplainYqlRequest
+	response *Ydb_Scripting.ExplainYqlResponse
+	err      error
+}
+
+func (s *explainStub) ExplainYql(
+	ctx context.Context, in *Ydb_Scripting.ExplainYqlRequest, opts ...grpc.CallOption,
+) (*Ydb_Scripting.ExplainYqlResponse, error) {
+	s.requests = append(s.requests, in)
+
+	return s.response, s.err
+}
+
+func TestClientValidate(t *testing.T) {
+	warning := &Ydb_Issue.IssueMessage{
+		Message:  "unused declare",
+		Severity: 2,
+		Position: &Ydb_Issue.IssueMessage_Position{Row: 1, Column: 5},
+	}
+	syntaxError := &Ydb_Issue.IssueMessage{
+		Message:  "syntax error",
+		Severity: 1,
+		Position: &Ydb_Issue.IssueMessage_Position{Row: 2, Column: 1},
+		Issues: []*Ydb_Issue.IssueMessage{{
+			Message:  "unexpected token",
+			Severity: 1,
+			Position: &Ydb_Issue.IssueMessage_Position{Row: 2, Column: 1},
+		}},
+	}
+	for _, tt := range []struct {
+		name   string
+		stub   *explainStub
+		issues []issues.Issue
+		err    bool
+	}{
+		{
+			name: "ValidWithWarnings",
+			stub: &explainStub{
+				response: &Ydb_Scripting.ExplainYqlResponse{
+					Operation: &Ydb_Operations.Operation{
+						Ready:  true,
+						Status: Ydb.StatusIds_SUCCESS,
+						Issues: []*Ydb_Issue.IssueMessage{warning},
+					},
+				},
+			},
+			issues: []issues.Issue{{
+				Message:  "unused declare",
+				Severity: 2,
+				Position: issues.Position{Row: 1, Column: 5},
+			}},
+		},
+		{
+			name: "Invalid",
+			stub: &explainStub{
+				err: xerrors.Operation(
+					xerrors.WithStatusCode(Ydb.StatusIds_GENERIC_ERROR),
+					xerrors.WithIssues([]*Ydb_Issue.IssueMessage{syntaxError}),
+				),
+			},
+			issues: []issues.Issue{{
+				Message:  "syntax error",
+				Severity: 1,
+				Position: issues.Position{Row: 2, Column: 1},
+				Issues: []issues.Issue{{
+					Message:  "unexpected token",
+					Severity: 1,
+					Position: issues.Position{Row: 2, Column: 1},
+				}},
+			}},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var traced []trace.Issue
+			c := &Client{
+				config: config.New(config.WithTrace(trace.Scripting{
+					OnValidate: func(trace.ScriptingValidateStartInfo) func(trace.ScriptingValidateDoneInfo) {
+						return func(info trace.ScriptingValidateDoneInfo) {
+							traced = info.Issues
+						}
+					},
+				})),
+				service: tt.stub,
+			}
+			queryIssues, err := c.Validate(xtest.Context(t), "SELECT 1")
+			if tt.err {
+				require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_GENERIC_ERROR))
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.issues, queryIssues)
+			require.Len(t, traced, 1)
+			require.Len(t, tt.stub.requests, 1)
+			require.Equal(t, "SELECT 1", tt.stub.requests[0].GetScript())
+			require.Equal(t, Ydb_Scripting.ExplainYqlRequest_VALIDATE, tt.stub.requests[0].GetMode())
+		})
+	}
+}
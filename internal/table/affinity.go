@@ -0,0 +1,82 @@
+package table
+
+import (
+	"container/list"
+	"context"
+)
+
+// sessionAffinityCapacity is a max number of affinity keys which are remembered by pool
+const sessionAffinityCapacity = 1024
+
+type ctxSessionAffinityKey struct{}
+
+// withSessionAffinityKey makes context for getting of session from pool preferably from session
+// which was got with the same key before
+func withSessionAffinityKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ctxSessionAffinityKey{}, key)
+}
+
+func sessionAffinityKey(ctx context.Context) string {
+	if key, ok := ctx.Value(ctxSessionAffinityKey{}).(string); ok {
+		return key
+	}
+
+	return ""
+}
+
+type affinityEntry struct {
+	key       string
+	sessionID string
+}
+
+// affinityCache is a LRU mapping from affinity keys to session ids.
+// affinityCache is not safe for concurrent use, it is guarded by mutex of pool
+type affinityCache struct {
+	capacity int
+	lru      *list.List // list<*affinityEntry>, the most recently used entry is first
+	entries  map[string]*list.Element
+
+	hits, misses uint64
+}
+
+func newAffinityCache(capacity int) *affinityCache {
+	return &affinityCache{
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// sessionID returns id of session which was used with key last time
+func (c *affinityCache) sessionID(key string) (string, bool) {
+	el, has := c.entries[key]
+	if !has {
+		return "", false
+	}
+
+	return el.Value.(*affinityEntry).sessionID, true
+}
+
+// put remembers session of key and evicts the least recently used key if capacity is exceeded
+func (c *affinityCache) put(key, sessionID string) {
+	if el, has := c.entries[key]; has {
+		el.Value.(*affinityEntry).sessionID = sessionID
+		c.lru.MoveToFront(el)
+
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&affinityEntry{key: key, sessionID: sessionID})
+	if c.lru.Len() > c.capacity {
+		el := c.lru.Back()
+		c.lru.Remove(el)
+		delete(c.entries, el.Value.(*affinityEntry).key)
+	}
+}
+
+func (c *affinityCache) len() int {
+	return c.lru.Len()
+}
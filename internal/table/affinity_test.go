@@ -0,0 +1,121 @@
+package table
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestAffinityCache(t *testing.T) {
+	c := newAffinityCache(2)
+	c.put("a", "1")
+	c.put("b", "2")
+	id, has := c.sessionID("a")
+	require.True(t, has)
+	require.Equal(t, "1", id)
+
+	// "a" is the most recently used
+	c.put("a", "3")
+	c.put("c", "4")
+	require.Equal(t, 2, c.len())
+	_, has = c.sessionID("b")
+	require.False(t, has)
+	id, has = c.sessionID("a")
+	require.True(t, has)
+	require.Equal(t, "3", id)
+}
+
+func TestClientSessionAffinity(t *testing.T) {
+	var (
+		infos     []trace.TablePoolSessionAffinityInfo
+		serviceID uint32
+	)
+	c := newClientWithStubBuilder(t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						serviceID++
+
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(testutil.WithServiceID(serviceID)),
+						}, nil
+					},
+				},
+			),
+		),
+		0,
+		config.WithSizeLimit(3),
+		config.WithTrace(&trace.Table{
+			OnPoolSessionAffinity: func(info trace.TablePoolSessionAffinityInfo) {
+				infos = append(infos, info)
+			},
+		}),
+	)
+	defer func() {
+		_ = c.Close(context.Background())
+	}()
+
+	ctx := context.Background()
+	doWithKey := func(key string, op func(s table.Session)) (id string) {
+		require.NoError(t, c.Do(ctx, func(ctx context.Context, s table.Session) error {
+			id = s.ID()
+			if op != nil {
+				op(s)
+			}
+
+			return nil
+		}, table.WithSessionAffinityKey(key)))
+
+		return id
+	}
+
+	// two sessions in pool, session of "b" is the first idle
+	var a, b string
+	a = doWithKey("a", func(table.Session) {
+		b = doWithKey("b", nil)
+	})
+	require.NotEqual(t, a, b)
+
+	t.Run("Hit", func(t *testing.T) {
+		infos = nil
+		require.Equal(t, a, doWithKey("a", nil))
+		require.Equal(t, b, doWithKey("b", nil))
+		require.Len(t, infos, 2)
+		require.True(t, infos[0].Hit)
+		require.True(t, infos[1].Hit)
+		require.Equal(t, "b", infos[1].Key)
+	})
+	t.Run("BusySession", func(t *testing.T) {
+		infos = nil
+		// session of "a" is busy, so another session is used without waiting
+		doWithKey("a", func(table.Session) {
+			require.NotEqual(t, a, doWithKey("a", nil))
+		})
+		require.Len(t, infos, 2)
+		require.True(t, infos[0].Hit)
+		require.False(t, infos[1].Hit)
+	})
+	t.Run("UnknownKey", func(t *testing.T) {
+		infos = nil
+		doWithKey("c", nil)
+		require.Len(t, infos, 1)
+		require.False(t, infos[0].Hit)
+		require.Equal(t, infos[0].Hits+infos[0].Misses, uint64(7))
+	})
+	t.Run("WithoutKey", func(t *testing.T) {
+		infos = nil
+		require.NoError(t, c.Do(ctx, func(ctx context.Context, s table.Session) error {
+			return nil
+		}))
+		require.Empty(t, infos)
+	})
+}
@@ -0,0 +1,63 @@
+package table
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+func newBulkUpsertClient(tb testing.TB, handler func(request *Ydb_Table.BulkUpsertRequest)) *Client {
+	tb.Helper()
+
+	return New(context.Background(), testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+					return &Ydb_Table.CreateSessionResult{}, nil
+				},
+				testutil.TableBulkUpsert: func(request interface{}) (proto.Message, error) {
+					handler(request.(*Ydb_Table.BulkUpsertRequest))
+
+					return &Ydb_Table.BulkUpsertResult{}, nil
+				},
+			},
+		),
+	), config.New())
+}
+
+func TestSessionBulkUpsertArrow(t *testing.T) {
+	var (
+		schema = []byte("schema")
+		data   = []byte("record batch")
+		actual *Ydb_Table.BulkUpsertRequest
+	)
+	client := newBulkUpsertClient(t, func(request *Ydb_Table.BulkUpsertRequest) {
+		// request is released to allocator after call
+		actual, _ = proto.Clone(request).(*Ydb_Table.BulkUpsertRequest)
+	})
+	ctx, cancel := xcontext.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err := client.Do(ctx, func(ctx context.Context, s table.Session) error {
+		return table.BulkUpsertArrow(ctx, s, "table", schema, data)
+	}, table.WithIdempotent())
+	require.NoError(t, err)
+	require.Equal(t, "table", actual.GetTable())
+	require.Equal(t, schema, actual.GetArrowBatchSettings().GetSchema())
+	require.Equal(t, data, actual.GetData())
+	require.Nil(t, actual.GetRows())
+}
+
+func TestBulkUpsertArrowNotSupported(t *testing.T) {
+	err := table.BulkUpsertArrow(context.Background(), struct{ table.Session }{}, "table", nil, nil)
+	require.Error(t, err)
+}
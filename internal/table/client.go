@@ -19,6 +19,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -42,8 +43,16 @@ func New(ctx context.Context, balancer balancer, config *config.Config) *Client
 		onDone(config.SizeLimit())
 	}()
 
+	paramsTypes := newParamsTypesCache(paramsTypesCacheCapacity)
+
 	return newClient(ctx, balancer, func(ctx context.Context) (s *session, err error) {
-		return newSession(ctx, balancer, config)
+		s, err = newSession(ctx, balancer, config)
+		if err != nil {
+			return nil, err
+		}
+		s.paramsTypes = paramsTypes
+
+		return s, nil
 	}, config)
 }
 
@@ -62,6 +71,7 @@ func newClient(
 		index:       make(map[*session]sessionInfo),
 		idle:        list.New(),
 		waitQ:       list.New(),
+		affinity:    newAffinityCache(sessionAffinityCapacity),
 		limit:       config.SizeLimit(),
 		waitChPool: sync.Pool{
 			New: func() interface{} {
@@ -70,7 +80,11 @@ func newClient(
 				return &ch
 			},
 		},
-		done: make(chan struct{}),
+		done:    make(chan struct{}),
+		changed: make(chan struct{}),
+	}
+	if n := config.WarmUp(); n > 0 {
+		c.internalPoolWarmUp(ctx, n)
 	}
 	if idleThreshold := config.IdleThreshold(); idleThreshold > 0 {
 		c.wg.Add(1)
@@ -94,13 +108,16 @@ type Client struct {
 	mu                xsync.Mutex
 	index             map[*session]sessionInfo
 	createInProgress  int        // KIKIMR-9163: in-create-process counter
+	warmUpInProgress  int        // sessions in-create-process by warm-up
 	limit             int        // Upper bound for Client size.
 	idle              *list.List // list<*session>
 	waitQ             *list.List // list<*chan *session>
+	affinity          *affinityCache
 	waitChPool        sync.Pool
 	testHookGetWaitCh func() // nil except some tests.
 	wg                sync.WaitGroup
 	done              chan struct{}
+	changed           chan struct{} // closed and replaced on append of session to pool
 }
 
 type createSessionOptions struct {
@@ -172,7 +189,9 @@ func (c *Client) createSession(ctx context.Context, opts ...createSessionOption)
 
 				if timeout := c.config.CreateSessionTimeout(); timeout > 0 {
 					var cancel context.CancelFunc
-					createSessionCtx, cancel = xcontext.WithTimeout(createSessionCtx, timeout)
+					createSessionCtx, cancel = xcontext.WithNamedTimeout(
+						createSessionCtx, xerrors.DeadlineSourceSessionCreateTimeout, timeout,
+					)
 					defer cancel()
 				}
 
@@ -185,7 +204,9 @@ func (c *Client) createSession(ctx context.Context, opts ...createSessionOption)
 
 					if timeout := c.config.DeleteTimeout(); timeout > 0 {
 						var cancel context.CancelFunc
-						createSessionCtx, cancel = xcontext.WithTimeout(closeSessionCtx, timeout)
+						closeSessionCtx, cancel = xcontext.WithNamedTimeout(
+							closeSessionCtx, xerrors.DeadlineSourceSessionDeleteTimeout, timeout,
+						)
 						defer cancel()
 					}
 
@@ -324,6 +345,9 @@ func (c *Client) internalPoolCreateSession(ctx context.Context) (s *session, err
 				}
 				trace.TableOnPoolSessionAdd(c.config.Trace(), s)
 				trace.TableOnPoolStateChange(c.config.Trace(), len(c.index), "append")
+
+				close(c.changed)
+				c.changed = make(chan struct{})
 			})
 		}), withCreateSessionOnClose(func(s *session) {
 			c.mu.WithLock(func() {
@@ -374,6 +398,8 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 		start = time.Now()
 		i     = 0
 		o     = getOptions{t: c.config.Trace()}
+		key   = sessionAffinityKey(ctx)
+		hit   bool
 	)
 	for _, opt := range opts {
 		if opt != nil {
@@ -385,6 +411,9 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*Client).internalPoolGet"),
 	)
 	defer func() {
+		if s != nil && err == nil && key != "" {
+			c.internalPoolOnSessionAffinity(key, s, hit)
+		}
 		onDone(s, i, err)
 	}()
 
@@ -393,7 +422,11 @@ func (c *Client) internalPoolGet(ctx context.Context, opts ...getOption) (s *ses
 		i++
 		// First, we try to internalPoolGet session from idle
 		c.mu.WithLock(func() {
-			s = c.internalPoolRemoveFirstIdle()
+			s = c.internalPoolRemoveAffinityIdle(key, avoidNodes(ctx), avoidSessions(ctx))
+			hit = s != nil
+			if s == nil {
+				s = c.internalPoolRemoveFirstIdle(avoidNodes(ctx), avoidSessions(ctx))
+			}
 		})
 
 		if s != nil {
@@ -467,6 +500,32 @@ func (c *Client) Get(ctx context.Context) (s *session, err error) {
 	return c.internalPoolGet(ctx)
 }
 
+// PingWithIdleSession executes lightweight query with first idle session of the Client.
+// PingWithIdleSession does not create new session and returns false if Client have no idle sessions.
+func (c *Client) PingWithIdleSession(ctx context.Context) (pinged bool, err error) {
+	if c.isClosed() {
+		return false, xerrors.WithStackTrace(errClosedClient)
+	}
+
+	var s *session
+	c.mu.WithLock(func() {
+		s = c.internalPoolRemoveFirstIdle(nil, nil)
+	})
+	if s == nil {
+		return false, nil
+	}
+	defer func() {
+		_ = c.Put(ctx, s)
+	}()
+
+	_, res, err := s.Execute(ctx, table.OnlineReadOnlyTxControl(), "SELECT 1", nil)
+	if err != nil {
+		return false, xerrors.WithStackTrace(err)
+	}
+
+	return true, res.Close()
+}
+
 func (c *Client) internalPoolWaitFromCh(ctx context.Context, t *trace.Table) (s *session, err error) {
 	var (
 		ch *chan *session
@@ -651,18 +710,31 @@ func (c *Client) Do(ctx context.Context, op table.Operation, opts ...table.Optio
 	}
 
 	config := c.retryOptions(opts...)
+	ctx = withSessionAffinityKey(ctx, config.SessionAffinityKey)
 
-	attempts, onDone := 0, trace.TableOnDo(config.Trace, &ctx,
+	attempts, tli, onDone := 0, 0, trace.TableOnDo(config.Trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*Client).Do"),
 		config.Label, config.Idempotent, xcontext.IsNestedCall(ctx),
 	)
 	defer func() {
-		onDone(attempts, finalErr)
+		onDone(attempts, tli, finalErr)
 	}()
 
-	err := do(ctx, c, c.config, op, func(err error) {
+	growth := newCompilationTimeoutGrowth(config, c.config.OperationTimeout())
+
+	onAttempt := func(err error) {
 		attempts++
-	}, config.RetryOptions...)
+		if err != nil && xerrors.IsOperationErrorTransactionLocksInvalidated(err) {
+			tli++
+		}
+	}
+
+	var err error
+	if config.Idempotent && config.HedgeDelay > 0 {
+		err = c.doHedged(ctx, growth.wrap(op), config, onAttempt)
+	} else {
+		err = do(ctx, c, c.config, growth.wrap(op), onAttempt, config.RetryOptions...)
+	}
 	if err != nil {
 		return xerrors.WithStackTrace(err)
 	}
@@ -670,6 +742,33 @@ func (c *Client) Do(ctx context.Context, op table.Operation, opts ...table.Optio
 	return nil
 }
 
+func (c *Client) Explain(
+	ctx context.Context,
+	query string,
+	opts ...table.Option,
+) (exp table.DataQueryExplanation, finalErr error) {
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		exp, err = s.Explain(ctx, query)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		stmt, err := s.Prepare(ctx, query)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		exp.ParameterTypes = parameterTypes(stmt)
+
+		return nil
+	}, append([]table.Option{table.WithIdempotent()}, opts...)...)
+	if err != nil {
+		return exp, xerrors.WithStackTrace(err)
+	}
+
+	return exp, nil
+}
+
 func (c *Client) DoTx(ctx context.Context, op table.TxOperation, opts ...table.Option) (finalErr error) {
 	if c == nil {
 		return xerrors.WithStackTrace(errNilClient)
@@ -680,19 +779,29 @@ func (c *Client) DoTx(ctx context.Context, op table.TxOperation, opts ...table.O
 	}
 
 	config := c.retryOptions(opts...)
+	ctx = withSessionAffinityKey(ctx, config.SessionAffinityKey)
 
-	attempts, onDone := 0, trace.TableOnDoTx(config.Trace, &ctx,
+	var commitQueryStats stats.QueryStats
+	attempts, tli, onDone := 0, 0, trace.TableOnDoTx(config.Trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*Client).DoTx"),
 		config.Label, config.Idempotent, xcontext.IsNestedCall(ctx),
 	)
 	defer func() {
-		onDone(attempts, finalErr)
+		onDone(attempts, tli, commitQueryStats, finalErr)
 	}()
 
+	growth := newCompilationTimeoutGrowth(config, c.config.OperationTimeout())
+
 	return retryBackoff(ctx, c,
-		func(ctx context.Context, s table.Session) (err error) {
+		growth.wrap(func(ctx context.Context, s table.Session) (err error) {
 			attempts++
 
+			defer func() {
+				if err != nil && xerrors.IsOperationErrorTransactionLocksInvalidated(err) {
+					tli++
+				}
+			}()
+
 			tx, err := s.BeginTransaction(ctx, config.TxSettings)
 			if err != nil {
 				return xerrors.WithStackTrace(err)
@@ -728,13 +837,15 @@ func (c *Client) DoTx(ctx context.Context, op table.TxOperation, opts ...table.O
 				return xerrors.WithStackTrace(err)
 			}
 
-			_, err = tx.CommitTx(ctx, config.TxCommitOptions...)
+			commitResult, err := tx.CommitTx(ctx, config.TxCommitOptions...)
 			if err != nil {
 				return xerrors.WithStackTrace(err)
 			}
 
+			commitQueryStats = commitResult.Stats()
+
 			return nil
-		},
+		}),
 		config.RetryOptions...,
 	)
 }
@@ -753,7 +864,8 @@ func (c *Client) internalPoolGCTick(ctx context.Context, idleThreshold time.Dura
 			if info.idle == nil {
 				panic("inconsistent session info")
 			}
-			if since := c.clock.Since(info.touched); since > idleThreshold {
+			since := c.clock.Since(info.touched)
+			if since > idleThreshold || (c.nodeChecker != nil && !c.nodeChecker.HasNode(s.NodeID())) {
 				s.SetStatus(table.SessionClosing)
 				c.wg.Add(1)
 				go func() {
@@ -830,9 +942,28 @@ func (c *Client) internalPoolPeekFirstIdle() (s *session, touched time.Time) {
 // removes first session from idle and resets the keepAliveCount
 // to prevent session from dying in the internalPoolGC after it was returned
 // to be used only in outgoing functions that make session busy.
+// First session not on avoided nodes is preferred if avoidNodeIDs is not empty.
 // c.mu must be held.
-func (c *Client) internalPoolRemoveFirstIdle() *session {
+func (c *Client) internalPoolRemoveFirstIdle(avoidNodeIDs []uint32, avoidSessionIDs []string) *session {
 	s, _ := c.internalPoolPeekFirstIdle()
+	if len(avoidNodeIDs) > 0 || len(avoidSessionIDs) > 0 {
+		var preferred *session
+		for el := c.idle.Front(); el != nil; el = el.Next() {
+			candidate := el.Value.(*session)
+			if !containsNode(avoidNodeIDs, candidate.NodeID()) && !containsSession(avoidSessionIDs, candidate.ID()) {
+				preferred = candidate
+
+				break
+			}
+		}
+		switch {
+		case preferred != nil:
+			s = preferred
+		case len(avoidSessionIDs) > 0 && c.createInProgress+len(c.index) < c.limit:
+			// busy sessions are not reused while new session can be created
+			s = nil
+		}
+	}
 	if s != nil {
 		info := c.internalPoolRemoveIdle(s)
 		c.index[s] = info
@@ -841,6 +972,48 @@ func (c *Client) internalPoolRemoveFirstIdle() *session {
 	return s
 }
 
+// internalPoolRemoveAffinityIdle removes from idle the session which was got with affinity key last time.
+// It returns nil if key is empty or session is not idle now, so caller never waits for such session.
+// c.mu must be held.
+func (c *Client) internalPoolRemoveAffinityIdle(key string, avoidNodeIDs []uint32, avoidSessionIDs []string) *session {
+	if key == "" {
+		return nil
+	}
+	id, has := c.affinity.sessionID(key)
+	if !has || containsSession(avoidSessionIDs, id) {
+		return nil
+	}
+	for el := c.idle.Front(); el != nil; el = el.Next() {
+		s := el.Value.(*session)
+		if s.ID() != id {
+			continue
+		}
+		if containsNode(avoidNodeIDs, s.NodeID()) {
+			return nil
+		}
+		c.index[s] = c.internalPoolRemoveIdle(s)
+
+		return s
+	}
+
+	return nil
+}
+
+// internalPoolOnSessionAffinity remembers session got with affinity key and counts hits and misses
+func (c *Client) internalPoolOnSessionAffinity(key string, s *session, hit bool) {
+	var hits, misses uint64
+	c.mu.WithLock(func() {
+		c.affinity.put(key, s.ID())
+		if hit {
+			c.affinity.hits++
+		} else {
+			c.affinity.misses++
+		}
+		hits, misses = c.affinity.hits, c.affinity.misses
+	})
+	trace.TableOnPoolSessionAffinity(c.config.Trace(), s, key, hit, hits, misses)
+}
+
 // c.mu must be held.
 func (c *Client) internalPoolNotify(s *session) (notified bool) {
 	for el := c.waitQ.Front(); el != nil; el = c.waitQ.Front() {
@@ -878,7 +1051,7 @@ func (c *Client) internalPoolNotify(s *session) (notified bool) {
 
 func (c *Client) internalPoolSyncCloseSession(ctx context.Context, s *session) {
 	var cancel context.CancelFunc
-	ctx, cancel = xcontext.WithTimeout(ctx, c.config.DeleteTimeout())
+	ctx, cancel = xcontext.WithNamedTimeout(ctx, xerrors.DeadlineSourceSessionDeleteTimeout, c.config.DeleteTimeout())
 	defer cancel()
 
 	_ = s.Close(ctx)
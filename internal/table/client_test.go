@@ -14,7 +14,10 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -26,7 +29,10 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
@@ -674,6 +680,54 @@ func TestSessionPoolGetPut(t *testing.T) {
 	assertCreated(2)
 }
 
+func TestPingWithIdleSession(t *testing.T) {
+	var (
+		created  int
+		executed int
+	)
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						created++
+
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableExecuteDataQuery: func(interface{}) (proto.Message, error) {
+						executed++
+
+						return &Ydb_Table.ExecuteQueryResult{}, nil
+					},
+					testutil.TableDeleteSession: okHandler,
+				},
+			),
+		),
+		0,
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	pinged, err := p.PingWithIdleSession(context.Background())
+	require.NoError(t, err)
+	require.False(t, pinged)
+	require.Equal(t, 0, created)
+	require.Equal(t, 0, executed)
+
+	mustPutSession(t, p, mustGetSession(t, p))
+
+	pinged, err = p.PingWithIdleSession(context.Background())
+	require.NoError(t, err)
+	require.True(t, pinged)
+	require.Equal(t, 1, created)
+	require.Equal(t, 1, executed)
+	require.Equal(t, 1, p.idle.Len())
+}
+
 func TestSessionPoolCloseIdleSessions(t *testing.T) {
 	xtest.TestManyTimes(t, func(t testing.TB) {
 		var (
@@ -1036,3 +1090,385 @@ func TestDeadlockOnInternalPoolGCTick(t *testing.T) {
 		c.internalPoolGCTick(ctx, 0)
 	}, xtest.StopAfter(12*time.Second))
 }
+
+func TestClientDoTxTransactionLocksInvalidated(t *testing.T) {
+	var commits int
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableBeginTransaction: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.BeginTransactionResult{
+							TxMeta: &Ydb_Table.TransactionMeta{
+								Id: "tx",
+							},
+						}, nil
+					},
+					testutil.TableCommitTransaction: func(interface{}) (proto.Message, error) {
+						commits++
+						if commits < 3 {
+							return nil, xerrors.Operation(
+								xerrors.WithStatusCode(Ydb.StatusIds_ABORTED),
+								xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+									Message:   "Transaction locks invalidated",
+									IssueCode: 2001,
+								}}),
+							)
+						}
+
+						return &Ydb_Table.CommitTransactionResult{}, nil
+					},
+					testutil.TableRollbackTransaction: okHandler,
+					testutil.TableDeleteSession:       okHandler,
+				},
+			),
+		),
+		0,
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	var done trace.TableDoTxDoneInfo
+	err := p.DoTx(xtest.Context(t), func(ctx context.Context, tx table.TransactionActor) error {
+		return nil
+	}, table.WithTrace(trace.Table{
+		OnDoTx: func(info trace.TableDoTxStartInfo) func(trace.TableDoTxDoneInfo) {
+			require.False(t, info.Idempotent)
+
+			return func(info trace.TableDoTxDoneInfo) {
+				done = info
+			}
+		},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 3, commits)
+	require.Equal(t, 3, done.Attempts)
+	require.Equal(t, 2, done.TransactionLocksInvalidated)
+}
+
+func TestClientExplain(t *testing.T) {
+	const query = "DECLARE $id AS Uint64; SELECT * FROM series WHERE id = $id"
+	var explains, prepares int
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableExplainDataQuery: func(request interface{}) (proto.Message, error) {
+						explains++
+						require.Equal(t, query, request.(*Ydb_Table.ExplainDataQueryRequest).GetYqlText())
+						if explains < 2 {
+							return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+						}
+
+						return &Ydb_Table.ExplainQueryResult{
+							QueryAst:  "ast",
+							QueryPlan: `{"Plan":{}}`,
+						}, nil
+					},
+					testutil.TablePrepareDataQuery: func(request interface{}) (proto.Message, error) {
+						prepares++
+						require.Equal(t, query, request.(*Ydb_Table.PrepareDataQueryRequest).GetYqlText())
+
+						return &Ydb_Table.PrepareQueryResult{
+							QueryId: "id",
+							ParametersTypes: map[string]*Ydb.Type{
+								"$id": {Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+							},
+						}, nil
+					},
+					testutil.TableDeleteSession: okHandler,
+				},
+			),
+		),
+		0,
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	exp, err := table.Explain(xtest.Context(t), p, query)
+	require.NoError(t, err)
+	require.Equal(t, 2, explains)
+	require.Equal(t, 1, prepares)
+	require.Equal(t, "ast", exp.AST)
+	require.Equal(t, `{"Plan":{}}`, exp.Plan)
+	require.Len(t, exp.ParameterTypes, 1)
+	require.Equal(t, "Uint64", exp.ParameterTypes["$id"].Yql())
+}
+
+func TestClientDoTxCommitQueryStats(t *testing.T) {
+	var doTxStats stats.QueryStats
+	p := newClientWithStubBuilder(
+		t,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableBeginTransaction: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.BeginTransactionResult{
+							TxMeta: &Ydb_Table.TransactionMeta{
+								Id: "tx",
+							},
+						}, nil
+					},
+					testutil.TableCommitTransaction: func(request interface{}) (proto.Message, error) {
+						r, ok := request.(*Ydb_Table.CommitTransactionRequest)
+						require.True(t, ok)
+						require.Equal(t, Ydb_Table.QueryStatsCollection_STATS_COLLECTION_BASIC, r.GetCollectStats())
+
+						return &Ydb_Table.CommitTransactionResult{
+							QueryStats: &Ydb_TableStats.QueryStats{
+								TotalDurationUs:  300,
+								TotalCpuTimeUs:   200,
+								ProcessCpuTimeUs: 100,
+								QueryPhases: []*Ydb_TableStats.QueryPhaseStats{{
+									DurationUs:     50,
+									CpuTimeUs:      40,
+									AffectedShards: 2,
+								}},
+							},
+						}, nil
+					},
+					testutil.TableRollbackTransaction: okHandler,
+					testutil.TableDeleteSession:       okHandler,
+				},
+			),
+		),
+		0,
+	)
+	defer func() {
+		_ = p.Close(context.Background())
+	}()
+
+	err := p.DoTx(xtest.Context(t), func(ctx context.Context, tx table.TransactionActor) error {
+		return nil
+	},
+		table.WithTxCommitOptions(options.WithCommitCollectStats(options.CollectStatsModeBasic)),
+		table.WithTrace(trace.Table{
+			OnDoTx: func(info trace.TableDoTxStartInfo) func(trace.TableDoTxDoneInfo) {
+				return func(info trace.TableDoTxDoneInfo) {
+					doTxStats = info.CommitQueryStats
+				}
+			},
+		}),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, doTxStats)
+	require.Equal(t, 300*time.Microsecond, doTxStats.TotalDuration())
+	require.Equal(t, 200*time.Microsecond, doTxStats.TotalCPUTime())
+	require.Equal(t, 100*time.Microsecond, doTxStats.ProcessCPUTime())
+	phase, ok := doTxStats.NextPhase()
+	require.True(t, ok)
+	require.Equal(t, 50*time.Microsecond, phase.Duration())
+	require.Equal(t, 40*time.Microsecond, phase.CPUTime())
+	require.EqualValues(t, 2, phase.AffectedShards())
+}
+
+type nodeCheckerFunc func(id uint32) bool
+
+func (f nodeCheckerFunc) HasNode(id uint32) bool {
+	return f(id)
+}
+
+func TestClientGCTickClosesSessionsOfDrainingNodes(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := newClientWithStubBuilder(t,
+		testutil.NewBalancer(testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+			testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+				return &Ydb_Table.CreateSessionResult{
+					SessionId: testutil.SessionID(testutil.WithNodeID(1)),
+				}, nil
+			},
+			testutil.TableDeleteSession: okHandler,
+		})),
+		0,
+	)
+	defer func() {
+		_ = c.Close(ctx)
+	}()
+	var draining atomic.Bool
+	c.nodeChecker = nodeCheckerFunc(func(id uint32) bool {
+		return !draining.Load()
+	})
+
+	s, err := c.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, c.Put(ctx, s))
+
+	c.internalPoolGCTick(ctx, time.Hour)
+	require.Equal(t, table.SessionReady, s.Status())
+
+	draining.Store(true)
+	c.internalPoolGCTick(ctx, time.Hour)
+	require.Eventually(t, func() bool {
+		return s.Status() == table.SessionClosed
+	}, time.Second, time.Millisecond)
+}
+
+func TestClientWarmUp(t *testing.T) {
+	ctx := xtest.Context(t)
+	var created atomic.Int32
+	c := newClientWithStubBuilder(t,
+		testutil.NewBalancer(testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+			testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+				if created.Add(1) == 1 {
+					return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+				}
+
+				return &Ydb_Table.CreateSessionResult{
+					SessionId: testutil.SessionID(),
+				}, nil
+			},
+			testutil.TableDeleteSession: okHandler,
+		})),
+		0,
+		config.WithSizeLimit(3),
+		config.WithWarmUp(5),
+	)
+
+	require.NoError(t, table.WaitUntilWarm(ctx, c, 3))
+	require.ErrorIs(t, c.WaitUntilWarm(ctx, 4), errSessionPoolOverflow)
+	require.EqualValues(t, 4, created.Load())
+	require.Eventually(t, func() bool {
+		var idle int
+		c.mu.WithLock(func() {
+			idle = c.idle.Len()
+		})
+
+		return idle == 3
+	}, time.Second, time.Millisecond)
+
+	require.NoError(t, c.Close(ctx))
+	require.ErrorIs(t, c.WaitUntilWarm(ctx, 1), errClosedClient)
+}
+
+func TestClientWarmUpStopsOnClose(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := newClientWithStubBuilder(t,
+		testutil.NewBalancer(testutil.WithInvokeHandlers(testutil.InvokeHandlers{
+			testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+				return nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+			},
+		})),
+		0,
+		config.WithWarmUp(10),
+		config.WithClock(clockwork.NewFakeClock()),
+	)
+
+	waitErr := make(chan error, 1)
+	go func() {
+		waitErr <- c.WaitUntilWarm(ctx, 1)
+	}()
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		_ = c.Close(ctx)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("warm-up blocks close of client")
+	}
+	require.ErrorIs(t, <-waitErr, errClosedClient)
+}
+
+func TestClientQuarantinePoisonedSession(t *testing.T) {
+	for _, tt := range []struct {
+		name                 string
+		maxConsecutiveErrors int
+		failures             []int
+		sessions             int
+		quarantined          int
+	}{
+		{
+			name:                 "Disabled",
+			maxConsecutiveErrors: 0,
+			failures:             []int{6},
+			sessions:             1,
+			quarantined:          0,
+		},
+		{
+			name:                 "AlwaysErrors",
+			maxConsecutiveErrors: 3,
+			failures:             []int{7},
+			sessions:             3,
+			quarantined:          2,
+		},
+		{
+			name:                 "ResetOnSuccess",
+			maxConsecutiveErrors: 3,
+			failures:             []int{2, 2, 2},
+			sessions:             1,
+			quarantined:          0,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var quarantined []trace.TablePoolSessionQuarantineInfo
+			p := New(context.Background(), testutil.NewBalancer(
+				testutil.WithInvokeHandlers(
+					testutil.InvokeHandlers{
+						testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+							return &Ydb_Table.CreateSessionResult{
+								SessionId: testutil.SessionID(),
+							}, nil
+						},
+						testutil.TableDeleteSession: okHandler,
+					},
+				),
+			), config.New(
+				config.WithMaxConsecutiveErrors(tt.maxConsecutiveErrors),
+				config.WithTrace(&trace.Table{
+					OnPoolSessionQuarantine: func(info trace.TablePoolSessionQuarantineInfo) {
+						quarantined = append(quarantined, info)
+					},
+				}),
+			),
+			)
+			defer func() {
+				_ = p.Close(context.Background())
+			}()
+
+			sessions := make(map[table.Session]struct{})
+			for _, failures := range tt.failures {
+				attempts := 0
+				err := p.Do(xtest.Context(t), func(ctx context.Context, s table.Session) error {
+					sessions[s] = struct{}{}
+					attempts++
+					if attempts <= failures {
+						return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+					}
+
+					return nil
+				}, table.WithIdempotent(), table.WithRetryOptions([]retry.Option{
+					retry.WithSlowBackoff(retry.Backoff(time.Microsecond, 0, 0)),
+				}))
+				require.NoError(t, err)
+			}
+			require.Len(t, sessions, tt.sessions)
+			require.Len(t, quarantined, tt.quarantined)
+			for _, info := range quarantined {
+				require.Equal(t, tt.maxConsecutiveErrors, info.ConsecutiveErrors)
+				require.True(t, xerrors.IsOperationError(info.Error, Ydb.StatusIds_OVERLOADED))
+			}
+		})
+	}
+}
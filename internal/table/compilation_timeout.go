@@ -0,0 +1,80 @@
+package table
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+// compilationTimeoutGrowth grows operation timeout of next attempts after attempt failed with
+// query compilation timeout (see table.WithCompilationTimeoutGrowth)
+type compilationTimeoutGrowth struct {
+	factor         float64
+	max            time.Duration
+	defaultTimeout time.Duration
+
+	// mu guards timeout because attempts of hedged calls (see table.WithHedgeDelay) run concurrently
+	mu      xsync.Mutex
+	timeout time.Duration // operation timeout of next attempt, zero until first compilation timeout
+}
+
+func newCompilationTimeoutGrowth(opts *table.Options, defaultTimeout time.Duration) *compilationTimeoutGrowth {
+	if opts.CompilationTimeoutGrowthFactor <= 1 || opts.CompilationTimeoutMax <= 0 {
+		return nil
+	}
+
+	return &compilationTimeoutGrowth{
+		factor:         opts.CompilationTimeoutGrowthFactor,
+		max:            opts.CompilationTimeoutMax,
+		defaultTimeout: defaultTimeout,
+	}
+}
+
+// wrap applies grown operation timeout to attempts of op and makes compilation timeouts retryable
+func (g *compilationTimeoutGrowth) wrap(op table.Operation) table.Operation {
+	if g == nil {
+		return op
+	}
+
+	return func(ctx context.Context, s table.Session) error {
+		var timeout time.Duration
+		g.mu.WithLock(func() {
+			timeout = g.timeout
+		})
+		if timeout > 0 {
+			ctx = operation.WithGrownTimeout(ctx, timeout)
+		}
+
+		return g.check(ctx, op(ctx, s))
+	}
+}
+
+func (g *compilationTimeoutGrowth) check(ctx context.Context, err error) error {
+	if err == nil || !xerrors.IsOperationErrorCompilationTimeout(err) {
+		return err
+	}
+
+	timeout := operation.Timeout(ctx, g.defaultTimeout)
+	if timeout <= 0 || timeout >= g.max {
+		return err
+	}
+
+	g.mu.WithLock(func() {
+		g.timeout = time.Duration(float64(timeout) * g.factor)
+		if g.timeout > g.max {
+			g.timeout = g.max
+		}
+	})
+
+	// timeout may also be reported after start of execution, so attempt is retryable for idempotent operations only
+	return xerrors.Retryable(err,
+		xerrors.Conditionally(),
+		xerrors.WithBackoff(backoff.TypeFast),
+		xerrors.WithName("CompilationTimeout"),
+	)
+}
@@ -0,0 +1,50 @@
+package table
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+func TestCompilationTimeoutGrowth(t *testing.T) {
+	compilationTimeout := xerrors.Operation(
+		xerrors.WithStatusCode(Ydb.StatusIds_TIMEOUT),
+		xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{Message: "Query compilation timed out.", IssueCode: 2016}}),
+	)
+	opts := &table.Options{}
+	table.WithCompilationTimeoutGrowth(2, 5*time.Second).ApplyTableOption(opts)
+	g := newCompilationTimeoutGrowth(opts, 2*time.Second)
+	require.NotNil(t, g)
+
+	var timeouts []time.Duration
+	op := g.wrap(func(ctx context.Context, s table.Session) error {
+		timeouts = append(timeouts, operation.Timeout(ctx, 2*time.Second))
+
+		return compilationTimeout
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		err := op(ctx, nil)
+		m := retry.Check(err)
+		if i < 2 {
+			// compilation timeout is retried for idempotent operations only
+			require.True(t, m.MustRetry(true), i)
+			require.False(t, m.MustRetry(false), i)
+		} else {
+			require.False(t, m.MustRetry(true), i)
+		}
+	}
+	require.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second}, timeouts)
+
+	require.Nil(t, newCompilationTimeoutGrowth(&table.Options{}, time.Second))
+}
@@ -1,11 +1,13 @@
 package config
 
 import (
+	"context"
 	"time"
 
 	"github.com/jonboulle/clockwork"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -14,6 +16,8 @@ const (
 	DefaultSessionPoolCreateSessionTimeout = 5 * time.Second
 	DefaultSessionPoolSizeLimit            = 50
 	DefaultSessionPoolIdleThreshold        = 5 * time.Minute
+	DefaultConsecutiveErrorsWindow         = time.Minute
+	DefaultMaxQueryTextSize                = 10 << 20
 
 	// Deprecated: table client do not supports background session keep-aliving now.
 	// Will be removed after Oct 2024.
@@ -148,6 +152,88 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithStrictNamedScan enables errors on columns of result set row which were not scanned by ScanNamed
+func WithStrictNamedScan() Option {
+	return func(c *Config) {
+		c.strictNamedScan = true
+	}
+}
+
+// WithZeroStructForNull enables scanning of NULL structs into zero-valued go structs with ScanWithDefaults
+func WithZeroStructForNull() Option {
+	return func(c *Config) {
+		c.zeroStructForNull = true
+	}
+}
+
+// WithKeepInCache defines keep-in-cache flag of query cache policy for data queries without explicit
+// options.WithKeepInCache
+func WithKeepInCache(keepInCache bool) Option {
+	return func(c *Config) {
+		c.keepInCache = &keepInCache
+	}
+}
+
+// WithWarmUp defines number of sessions which table client creates in background after start.
+// Number of warmed up sessions is limited by SizeLimit. Zero value disables warm-up
+func WithWarmUp(n int) Option {
+	return func(c *Config) {
+		if n > 0 {
+			c.warmUp = n
+		} else {
+			c.warmUp = 0
+		}
+	}
+}
+
+// WithMaxConsecutiveErrors defines number of consecutive retryable errors of session
+// within ConsecutiveErrorsWindow after which session is deleted instead of returning to pool.
+// Zero value disables quarantine of sessions
+func WithMaxConsecutiveErrors(n int) Option {
+	return func(c *Config) {
+		if n > 0 {
+			c.maxConsecutiveErrors = n
+		} else {
+			c.maxConsecutiveErrors = 0
+		}
+	}
+}
+
+// WithConsecutiveErrorsWindow defines window for counting of consecutive errors of session
+// If window is less than or equal to zero then the DefaultConsecutiveErrorsWindow is used.
+func WithConsecutiveErrorsWindow(window time.Duration) Option {
+	return func(c *Config) {
+		if window > 0 {
+			c.consecutiveErrorsWindow = window
+		}
+	}
+}
+
+// WithMaxQueryTextSize defines limit of size of query text in bytes for Execute, Prepare and scan queries.
+// Query with larger text is rejected on client side without sending to server.
+// Zero or negative value disables the check
+func WithMaxQueryTextSize(n int) Option {
+	return func(c *Config) {
+		c.maxQueryTextSize = n
+	}
+}
+
+// WithWarningHandler defines handler of non-fatal issues of successful responses of Execute, CommitTx
+// and ExecuteSchemeQuery
+func WithWarningHandler(handler func(ctx context.Context, issues []issues.Issue)) Option {
+	return func(c *Config) {
+		c.warningHandler = handler
+	}
+}
+
+// WithStreamInactivityTimeout overrides stream inactivity timeout of driver for streams of table client
+// (read table and scan query streams). Zero timeout disables checking of inactivity of table streams
+func WithStreamInactivityTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		config.SetStreamInactivityTimeout(&c.Common, timeout)
+	}
+}
+
 // WithClock replaces default clock
 func WithClock(clock clockwork.Clock) Option {
 	return func(c *Config) {
@@ -160,12 +246,23 @@ type Config struct {
 	config.Common
 
 	sizeLimit int
+	warmUp    int
 
 	createSessionTimeout time.Duration
 	deleteTimeout        time.Duration
 	idleThreshold        time.Duration
 
-	ignoreTruncated bool
+	maxConsecutiveErrors    int
+	consecutiveErrorsWindow time.Duration
+
+	maxQueryTextSize int
+
+	warningHandler func(ctx context.Context, issues []issues.Issue)
+
+	ignoreTruncated   bool
+	strictNamedScan   bool
+	zeroStructForNull bool
+	keepInCache       *bool
 
 	trace *trace.Table
 
@@ -189,6 +286,16 @@ func (c *Config) SizeLimit() int {
 	return c.sizeLimit
 }
 
+// WarmUp is a number of sessions which table client creates in background after start.
+// WarmUp is limited by SizeLimit
+func (c *Config) WarmUp() int {
+	if c.warmUp > c.sizeLimit {
+		return c.sizeLimit
+	}
+
+	return c.warmUp
+}
+
 // KeepAliveMinSize is a lower bound for sessions in the pool. If there are more sessions open, then
 // the excess idle ones will be closed and removed after IdleKeepAliveThreshold is reached for each of them.
 // If KeepAliveMinSize is less than zero, then no sessions will be preserved
@@ -206,6 +313,26 @@ func (c *Config) IgnoreTruncated() bool {
 	return c.ignoreTruncated
 }
 
+// StrictNamedScan specifies behavior on columns of result set row which were not scanned by ScanNamed
+func (c *Config) StrictNamedScan() bool {
+	return c.strictNamedScan
+}
+
+// ZeroStructForNull specifies behavior of ScanWithDefaults on NULL structs
+func (c *Config) ZeroStructForNull() bool {
+	return c.zeroStructForNull
+}
+
+// KeepInCache returns keep-in-cache flag of query cache policy for data query without explicit
+// options.WithKeepInCache. By default, queries with parameters are kept in server query cache
+func (c *Config) KeepInCache(hasParams bool) bool {
+	if c.keepInCache != nil {
+		return *c.keepInCache
+	}
+
+	return hasParams
+}
+
 // IdleKeepAliveThreshold is a number of keepAlive messages to call before the
 // session is removed if it is an excess session (see KeepAliveMinSize)
 // This means that session will be deleted after the expiration of lifetime = IdleThreshold * IdleKeepAliveThreshold
@@ -251,13 +378,36 @@ func (c *Config) DeleteTimeout() time.Duration {
 	return c.deleteTimeout
 }
 
+// MaxConsecutiveErrors is a number of consecutive retryable errors of session after which
+// session is deleted instead of returning to pool. Zero value means quarantine is disabled
+func (c *Config) MaxConsecutiveErrors() int {
+	return c.maxConsecutiveErrors
+}
+
+// ConsecutiveErrorsWindow is a window for counting of consecutive errors of session
+func (c *Config) ConsecutiveErrorsWindow() time.Duration {
+	return c.consecutiveErrorsWindow
+}
+
+// MaxQueryTextSize is a limit of size of query text in bytes, zero or negative value means no limit
+func (c *Config) MaxQueryTextSize() int {
+	return c.maxQueryTextSize
+}
+
+// WarningHandler returns handler of non-fatal issues of successful responses or nil if handler is not defined
+func (c *Config) WarningHandler() func(ctx context.Context, issues []issues.Issue) {
+	return c.warningHandler
+}
+
 func defaults() *Config {
 	return &Config{
-		sizeLimit:            DefaultSessionPoolSizeLimit,
-		createSessionTimeout: DefaultSessionPoolCreateSessionTimeout,
-		deleteTimeout:        DefaultSessionPoolDeleteTimeout,
-		idleThreshold:        DefaultSessionPoolIdleThreshold,
-		clock:                clockwork.NewRealClock(),
-		trace:                &trace.Table{},
+		sizeLimit:               DefaultSessionPoolSizeLimit,
+		createSessionTimeout:    DefaultSessionPoolCreateSessionTimeout,
+		deleteTimeout:           DefaultSessionPoolDeleteTimeout,
+		idleThreshold:           DefaultSessionPoolIdleThreshold,
+		consecutiveErrorsWindow: DefaultConsecutiveErrorsWindow,
+		maxQueryTextSize:        DefaultMaxQueryTextSize,
+		clock:                   clockwork.NewRealClock(),
+		trace:                   &trace.Table{},
 	}
 }
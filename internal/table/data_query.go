@@ -19,6 +19,11 @@ type (
 		id    string
 		query string
 	}
+	// tracedDataQuery is representation of query in traces with redacted query text
+	tracedDataQuery struct {
+		id    string
+		query string
+	}
 )
 
 func (q textDataQuery) String() string {
@@ -69,3 +74,22 @@ func queryPrepared(id, query string) query {
 		query: query,
 	}
 }
+
+func (q tracedDataQuery) String() string {
+	return q.query
+}
+
+func (q tracedDataQuery) ID() string {
+	return q.id
+}
+
+func (q tracedDataQuery) YQL() string {
+	return q.query
+}
+
+func queryForTrace(redact func(query string) string, q query) tracedDataQuery {
+	return tracedDataQuery{
+		id:    q.ID(),
+		query: redact(q.YQL()),
+	}
+}
@@ -37,6 +37,14 @@ var (
 
 	// errParamsRequired returned by a Client instance to indicate that required params is not defined
 	errParamsRequired = xerrors.Wrap(errors.New("params required"))
+
+	// errNoTimeToLiveSettings returned by a session to indicate that run interval of TTL cannot be changed
+	// because table has no TTL settings
+	errNoTimeToLiveSettings = xerrors.Wrap(errors.New("table has no TTL settings"))
+
+	// errInvalidPartitions returned by a session to indicate that initial partitions of CreateTable request
+	// are invalid
+	errInvalidPartitions = xerrors.Wrap(errors.New("invalid partitions"))
 )
 
 func isCreateSessionErrorRetriable(err error) bool {
@@ -0,0 +1,171 @@
+package table
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type ctxAvoidNodesKey struct{}
+
+// withAvoidNodes makes context for getting of session from pool preferably not on given nodes
+func withAvoidNodes(ctx context.Context, nodeIDs []uint32) context.Context {
+	if len(nodeIDs) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ctxAvoidNodesKey{}, nodeIDs)
+}
+
+func avoidNodes(ctx context.Context) []uint32 {
+	if nodeIDs, ok := ctx.Value(ctxAvoidNodesKey{}).([]uint32); ok {
+		return nodeIDs
+	}
+
+	return nil
+}
+
+func containsNode(nodeIDs []uint32, nodeID uint32) bool {
+	for _, id := range nodeIDs {
+		if id == nodeID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hedgedCalls tracks nodes of sessions of in-flight calls of hedged operation
+type hedgedCalls struct {
+	mu    sync.Mutex
+	nodes map[uint32]int
+}
+
+func (h *hedgedCalls) wrap(op table.Operation) table.Operation {
+	return func(ctx context.Context, s table.Session) error {
+		nodeID := s.NodeID()
+		h.mu.Lock()
+		h.nodes[nodeID]++
+		h.mu.Unlock()
+
+		defer func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			if h.nodes[nodeID]--; h.nodes[nodeID] == 0 {
+				delete(h.nodes, nodeID)
+			}
+		}()
+
+		return op(ctx, s)
+	}
+}
+
+func (h *hedgedCalls) inflightNodes() []uint32 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	nodeIDs := make([]uint32, 0, len(h.nodes))
+	for nodeID := range h.nodes {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+
+	return nodeIDs
+}
+
+type hedgedResult struct {
+	index int
+	err   error
+}
+
+// doHedged calls op with retries (as do) and starts additional calls of op on another sessions after every
+// config.HedgeDelay while op is not completed and number of hedged calls is less than config.MaxHedges.
+// doHedged returns on first success or after fail of all calls
+func (c *Client) doHedged(
+	ctx context.Context,
+	op table.Operation,
+	config *table.Options,
+	onAttempt func(err error),
+) (finalErr error) {
+	maxHedges := config.MaxHedges
+	if maxHedges <= 0 {
+		maxHedges = 1
+	}
+
+	var (
+		hedges int
+		winner = -1
+		onDone = trace.TableOnDoHedged(config.Trace, &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*Client).doHedged"),
+			config.Label, config.HedgeDelay, maxHedges,
+		)
+	)
+	defer func() {
+		onDone(hedges, winner, finalErr)
+	}()
+
+	ctx, cancel := xcontext.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		calls = &hedgedCalls{
+			nodes: make(map[uint32]int),
+		}
+		attemptsMtx sync.Mutex
+		results     = make(chan hedgedResult, maxHedges+1)
+		running     int
+	)
+	start := func(index int) {
+		callCtx := withAvoidNodes(ctx, calls.inflightNodes())
+		running++
+		go func() {
+			err := do(callCtx, c, c.config, calls.wrap(op), func(err error) {
+				attemptsMtx.Lock()
+				defer attemptsMtx.Unlock()
+
+				if onAttempt != nil {
+					onAttempt(err)
+				}
+			}, config.RetryOptions...)
+			results <- hedgedResult{index: index, err: err}
+		}()
+	}
+	wait := func() {
+		for ; running > 0; running-- {
+			<-results
+		}
+	}
+
+	start(0)
+
+	timer := c.clock.NewTimer(config.HedgeDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.Chan():
+			if hedges < maxHedges {
+				hedges++
+				start(hedges)
+			}
+			if hedges < maxHedges {
+				timer.Reset(config.HedgeDelay)
+			}
+		case r := <-results:
+			running--
+			if r.err == nil {
+				winner = r.index
+				cancel()
+				wait()
+
+				return nil
+			}
+			if running == 0 {
+				return r.err
+			}
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func newHedgeTestClient(t *testing.T, opts ...config.Option) *Client {
+	var nodeID atomic.Uint32
+	c := New(context.Background(), testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+					return &Ydb_Table.CreateSessionResult{
+						SessionId: testutil.SessionID(testutil.WithNodeID(nodeID.Add(1))),
+					}, nil
+				},
+				testutil.TableDeleteSession: okHandler,
+			},
+		),
+	), config.New(append([]config.Option{config.WithIdleThreshold(-1)}, opts...)...))
+	t.Cleanup(func() {
+		_ = c.Close(context.Background())
+	})
+
+	return c
+}
+
+func TestClientDoHedged(t *testing.T) {
+	const delay = time.Second
+	errTest := errors.New("test")
+	for _, tt := range []struct {
+		name string
+		opts []table.Option
+		// results of calls of operation in order of start, nil means call blocks until cancel
+		results  []*error
+		hedged   bool
+		hedges   int
+		winner   int
+		err      error
+		canceled int
+	}{
+		{
+			name:    "PrimaryWins",
+			opts:    []table.Option{table.WithIdempotent(), table.WithHedgeDelay(delay)},
+			results: []*error{new(error)},
+			hedged:  true,
+			hedges:  0,
+			winner:  0,
+		},
+		{
+			name:     "HedgeWins",
+			opts:     []table.Option{table.WithIdempotent(), table.WithHedgeDelay(delay)},
+			results:  []*error{nil, new(error)},
+			hedged:   true,
+			hedges:   1,
+			winner:   1,
+			canceled: 1,
+		},
+		{
+			name: "MaxHedges",
+			opts: []table.Option{
+				table.WithIdempotent(),
+				table.WithHedgeDelay(delay),
+				table.WithMaxHedges(2),
+			},
+			results:  []*error{nil, nil, new(error)},
+			hedged:   true,
+			hedges:   2,
+			winner:   2,
+			canceled: 2,
+		},
+		{
+			name:    "PrimaryFails",
+			opts:    []table.Option{table.WithIdempotent(), table.WithHedgeDelay(delay)},
+			results: []*error{&errTest},
+			hedged:  true,
+			hedges:  0,
+			winner:  -1,
+			err:     errTest,
+		},
+		{
+			name:    "NonIdempotent",
+			opts:    []table.Option{table.WithHedgeDelay(delay)},
+			results: []*error{new(error)},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				clock    = clockwork.NewFakeClock()
+				hedged   []trace.TableDoHedgedDoneInfo
+				calls    atomic.Int32
+				canceled atomic.Int32
+				started  = make(chan table.Session, len(tt.results))
+			)
+			c := newHedgeTestClient(t,
+				config.WithClock(clock),
+				config.WithTrace(&trace.Table{
+					OnDoHedged: func(trace.TableDoHedgedStartInfo) func(trace.TableDoHedgedDoneInfo) {
+						return func(info trace.TableDoHedgedDoneInfo) {
+							hedged = append(hedged, info)
+						}
+					},
+				}),
+			)
+			done := make(chan error, 1)
+			go func() {
+				done <- table.DoHedged(xtest.Context(t), c, func(ctx context.Context, s table.Session) error {
+					started <- s
+					result := tt.results[calls.Add(1)-1]
+					if result == nil {
+						<-ctx.Done()
+						canceled.Add(1)
+
+						return ctx.Err()
+					}
+
+					return *result
+				}, tt.opts...)
+			}()
+			nodes := make(map[uint32]struct{})
+			for i := 0; i < len(tt.results); i++ {
+				s := <-started
+				nodes[s.NodeID()] = struct{}{}
+				if i < len(tt.results)-1 {
+					clock.BlockUntil(1)
+					clock.Advance(delay)
+				}
+			}
+			err := <-done
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.EqualValues(t, len(tt.results), calls.Load())
+			require.EqualValues(t, tt.canceled, canceled.Load())
+			require.Len(t, nodes, len(tt.results))
+			if !tt.hedged {
+				require.Empty(t, hedged)
+
+				return
+			}
+			require.Len(t, hedged, 1)
+			require.Equal(t, tt.hedges, hedged[0].Hedges)
+			require.Equal(t, tt.winner, hedged[0].Winner)
+		})
+	}
+}
+
+func TestClientDoHedgedCompilationTimeoutGrowth(t *testing.T) {
+	compilationTimeout := xerrors.Operation(
+		xerrors.WithStatusCode(Ydb.StatusIds_TIMEOUT),
+		xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{Message: "Query compilation timed out.", IssueCode: 2016}}),
+	)
+	c := newHedgeTestClient(t)
+	var calls atomic.Int32
+	err := c.Do(operation.WithTimeout(xtest.Context(t), time.Second), func(ctx context.Context, s table.Session) error {
+		// attempts of primary and hedged calls fail concurrently and grow operation timeout
+		time.Sleep(time.Millisecond)
+		if calls.Add(1) <= 8 {
+			return compilationTimeout
+		}
+
+		return nil
+	},
+		table.WithIdempotent(),
+		table.WithHedgeDelay(time.Millisecond),
+		table.WithMaxHedges(3),
+		table.WithCompilationTimeoutGrowth(2, time.Hour),
+	)
+	require.NoError(t, err)
+	require.Greater(t, calls.Load(), int32(8))
+}
+
+func TestClientGetAvoidNodes(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := newHedgeTestClient(t)
+	var sessions []*session
+	for i := 0; i < 3; i++ {
+		s, err := c.Get(ctx)
+		require.NoError(t, err)
+		sessions = append(sessions, s)
+	}
+	for _, s := range sessions {
+		require.NoError(t, c.Put(ctx, s))
+	}
+
+	s, err := c.Get(withAvoidNodes(ctx, []uint32{sessions[0].NodeID(), sessions[1].NodeID()}))
+	require.NoError(t, err)
+	require.Equal(t, sessions[2], s)
+	require.NoError(t, c.Put(ctx, s))
+
+	// first idle session is taken if all sessions are on avoided nodes
+	s, err = c.Get(withAvoidNodes(ctx, []uint32{
+		sessions[0].NodeID(), sessions[1].NodeID(), sessions[2].NodeID(),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, sessions[0], s)
+}
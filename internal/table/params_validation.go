@@ -0,0 +1,142 @@
+package table
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+// paramsTypesCacheCapacity is a max number of queries which declared types of parameters are remembered by client
+const paramsTypesCacheCapacity = 1024
+
+type paramsTypesEntry struct {
+	query    string
+	declared map[string]*Ydb.Type
+}
+
+// paramsTypesCache is a LRU mapping from query text to declared types of query parameters
+type paramsTypesCache struct {
+	mu       sync.Mutex
+	capacity int
+	lru      *list.List // list<*paramsTypesEntry>, the most recently used entry is first
+	entries  map[string]*list.Element
+}
+
+func newParamsTypesCache(capacity int) *paramsTypesCache {
+	return &paramsTypesCache{
+		capacity: capacity,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+func (c *paramsTypesCache) get(query string) (map[string]*Ydb.Type, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, has := c.entries[query]
+	if !has {
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+
+	return el.Value.(*paramsTypesEntry).declared, true //nolint:forcetypeassert
+}
+
+// set remembers declared types of query parameters and evicts the least recently used query
+// if capacity is exceeded
+func (c *paramsTypesCache) set(query string, declared map[string]*Ydb.Type) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, has := c.entries[query]; has {
+		el.Value.(*paramsTypesEntry).declared = declared //nolint:forcetypeassert
+		c.lru.MoveToFront(el)
+
+		return
+	}
+	c.entries[query] = c.lru.PushFront(&paramsTypesEntry{query: query, declared: declared})
+	if c.lru.Len() > c.capacity {
+		el := c.lru.Back()
+		c.lru.Remove(el)
+		delete(c.entries, el.Value.(*paramsTypesEntry).query) //nolint:forcetypeassert
+	}
+}
+
+// declaredParams returns declared types of query parameters from cache or from prepared query
+func (s *session) declaredParams(ctx context.Context, query string) (map[string]*Ydb.Type, error) {
+	if declared, has := s.paramsTypes.get(query); has {
+		return declared, nil
+	}
+
+	stmt, err := s.Prepare(ctx, query)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	declared := stmt.(*statement).params //nolint:forcetypeassert
+
+	s.paramsTypes.set(query, declared)
+
+	return declared, nil
+}
+
+// checkParams compares provided parameters with declared types of query parameters.
+// Omitted parameters of optional types are allowed because server treats them as NULL
+func checkParams(declared map[string]*Ydb.Type, parameters *params.Parameters) error {
+	provided := make(map[string]types.Type, parameters.Count())
+	parameters.Each(func(name string, v value.Value) {
+		provided[name] = v.Type()
+	})
+
+	names := make([]string, 0, len(declared)+len(provided))
+	for name := range declared {
+		names = append(names, name)
+	}
+	for name := range provided {
+		if _, has := declared[name]; !has {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var mismatches []string
+	for _, name := range names {
+		d, isDeclared := declared[name]
+		p, isProvided := provided[name]
+		switch {
+		case !isDeclared:
+			mismatches = append(mismatches, fmt.Sprintf("%s: not declared, provided %s", name, p.Yql()))
+		case !isProvided:
+			t := types.TypeFromYDB(d)
+			if _, isOptional := t.(types.Optional); !isOptional {
+				mismatches = append(mismatches, fmt.Sprintf("%s: declared %s, not provided", name, t.Yql()))
+			}
+		default:
+			if t := types.TypeFromYDB(d); !types.Equal(t, p) {
+				mismatches = append(mismatches, fmt.Sprintf("%s: declared %s, provided %s", name, t.Yql(), p.Yql()))
+			}
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %s", table.ErrParamsMismatch, strings.Join(mismatches, "; ")))
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestCheckParams(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	declared := map[string]*Ydb.Type{
+		"$id":    types.TypeUint64.ToYDB(a),
+		"$title": types.Optional(types.TypeText).ToYDB(a),
+	}
+	for _, tt := range []struct {
+		name   string
+		params *table.QueryParameters
+		err    string
+	}{
+		{
+			name: "Match",
+			params: table.NewQueryParameters(
+				table.ValueParam("$id", types.Uint64Value(1)),
+				table.ValueParam("$title", types.OptionalValue(types.TextValue("title"))),
+			),
+		},
+		{
+			name: "OptionalOmitted",
+			params: table.NewQueryParameters(
+				table.ValueParam("$id", types.Uint64Value(1)),
+			),
+		},
+		{
+			name: "TypeMismatch",
+			params: table.NewQueryParameters(
+				table.ValueParam("$id", types.Int64Value(1)),
+				table.ValueParam("$title", types.TextValue("title")),
+			),
+			err: "$id: declared Uint64, provided Int64; $title: declared Optional<Utf8>, provided Utf8",
+		},
+		{
+			name: "NotProvided",
+			params: table.NewQueryParameters(
+				table.ValueParam("$title", types.NullValue(types.TypeText)),
+			),
+			err: "$id: declared Uint64, not provided",
+		},
+		{
+			name: "NotDeclared",
+			params: table.NewQueryParameters(
+				table.ValueParam("$id", types.Uint64Value(1)),
+				table.ValueParam("$name", types.TextValue("name")),
+			),
+			err: "$name: not declared, provided Utf8",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkParams(declared, tt.params)
+			if tt.err == "" {
+				require.NoError(t, err)
+
+				return
+			}
+			require.ErrorIs(t, err, table.ErrParamsMismatch)
+			require.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestParamsTypesCache(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	declared := map[string]*Ydb.Type{
+		"$id": types.TypeUint64.ToYDB(a),
+	}
+	c := newParamsTypesCache(2)
+	c.set("q1", declared)
+	c.set("q2", declared)
+	_, has := c.get("q1")
+	require.True(t, has)
+	// q2 is the least recently used query
+	c.set("q3", declared)
+	_, has = c.get("q2")
+	require.False(t, has)
+	for _, query := range []string{"q1", "q3"} {
+		cached, has := c.get(query)
+		require.True(t, has)
+		require.Equal(t, declared, cached)
+	}
+	require.Equal(t, 2, c.lru.Len())
+}
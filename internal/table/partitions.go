@@ -0,0 +1,96 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// partitionsTracker tracks kinds of initial partitions defined by options of CreateTable request
+// because request keeps only last defined kind
+type partitionsTracker struct {
+	uniform  bool
+	explicit bool
+}
+
+func (p *partitionsTracker) track(request *Ydb_Table.CreateTableRequest) {
+	switch request.GetPartitions().(type) {
+	case *Ydb_Table.CreateTableRequest_UniformPartitions:
+		p.uniform = true
+	case *Ydb_Table.CreateTableRequest_PartitionAtKeys:
+		p.explicit = true
+	}
+	switch request.GetProfile().GetPartitioningPolicy().GetPartitions().(type) {
+	case *Ydb_Table.PartitioningPolicy_UniformPartitions:
+		p.uniform = true
+	case *Ydb_Table.PartitioningPolicy_ExplicitPartitions:
+		p.explicit = true
+	}
+}
+
+// validate checks that uniform and explicit partitions are not combined and
+// types of split points are matched to types of primary key prefix
+func (p *partitionsTracker) validate(request *Ydb_Table.CreateTableRequest) error {
+	if p.uniform && p.explicit {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: uniform and explicit partitions cannot be combined",
+			errInvalidPartitions,
+		))
+	}
+
+	splitPoints := request.GetPartitionAtKeys().GetSplitPoints()
+	if len(splitPoints) == 0 {
+		splitPoints = request.GetProfile().GetPartitioningPolicy().GetExplicitPartitions().GetSplitPoints()
+	}
+	if len(splitPoints) == 0 {
+		return nil
+	}
+
+	columnTypes := make(map[string]*Ydb.Type, len(request.GetColumns()))
+	for _, c := range request.GetColumns() {
+		columnTypes[c.GetName()] = c.GetType()
+	}
+	primaryKey := request.GetPrimaryKey()
+
+	for i, splitPoint := range splitPoints {
+		items := []*Ydb.Type{splitPoint.GetType()}
+		if tuple := splitPoint.GetType().GetTupleType(); tuple != nil {
+			items = tuple.GetElements()
+		}
+		if len(items) > len(primaryKey) {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w: split point %d has %d items, but primary key has %d columns",
+				errInvalidPartitions, i, len(items), len(primaryKey),
+			))
+		}
+		for j, item := range items {
+			columnType, has := columnTypes[primaryKey[j]]
+			if !has {
+				return xerrors.WithStackTrace(fmt.Errorf("%w: unknown primary key column %q",
+					errInvalidPartitions, primaryKey[j],
+				))
+			}
+			if !proto.Equal(unwrapOptionalType(item), unwrapOptionalType(columnType)) {
+				return xerrors.WithStackTrace(fmt.Errorf(
+					"%w: type %s of item %d of split point %d is not matched to type %s of primary key column %q",
+					errInvalidPartitions, types.TypeFromYDB(item).Yql(), j, i,
+					types.TypeFromYDB(columnType).Yql(), primaryKey[j],
+				))
+			}
+		}
+	}
+
+	return nil
+}
+
+func unwrapOptionalType(t *Ydb.Type) *Ydb.Type {
+	for t.GetOptionalType() != nil {
+		t = t.GetOptionalType().GetItem()
+	}
+
+	return t
+}
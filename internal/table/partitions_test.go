@@ -0,0 +1,194 @@
+package table
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+func TestCreateTablePartitionsValidation(t *testing.T) {
+	columns := []options.CreateTableOption{
+		options.WithColumn("a", types.NewOptional(types.Uint64)),
+		options.WithColumn("b", types.NewOptional(types.Text)),
+		options.WithColumn("c", types.NewOptional(types.Int32)),
+		options.WithPrimaryKeyColumn("a", "b"),
+	}
+	for _, tt := range []struct {
+		name string
+		opts []options.CreateTableOption
+		err  string
+	}{
+		{
+			name: "Uniform",
+			opts: []options.CreateTableOption{
+				options.WithPartitions(options.WithUniformPartitions(4)),
+			},
+		},
+		{
+			name: "Explicit",
+			opts: []options.CreateTableOption{
+				options.WithPartitions(options.WithExplicitPartitions(
+					value.TupleValue(value.OptionalValue(value.Uint64Value(10))),
+					value.TupleValue(value.OptionalValue(value.Uint64Value(20)), value.TextValue("x")),
+				)),
+			},
+		},
+		{
+			name: "ExplicitSingleValue",
+			opts: []options.CreateTableOption{
+				options.WithPartitions(options.WithExplicitPartitions(value.Uint64Value(10))),
+			},
+		},
+		{
+			name: "UniformAndExplicit",
+			opts: []options.CreateTableOption{
+				options.WithPartitions(options.WithUniformPartitions(4)),
+				options.WithPartitions(options.WithExplicitPartitions(value.TupleValue(value.Uint64Value(10)))),
+			},
+			err: "uniform and explicit partitions cannot be combined",
+		},
+		{
+			name: "UniformAndExplicitProfile",
+			opts: []options.CreateTableOption{
+				options.WithPartitions(options.WithUniformPartitions(4)),
+				options.WithProfile(options.WithPartitioningPolicy(
+					options.WithPartitioningPolicyExplicitPartitions(value.TupleValue(value.Uint64Value(10))),
+				)),
+			},
+			err: "uniform and explicit partitions cannot be combined",
+		},
+		{
+			name: "TypeMismatch",
+			opts: []options.CreateTableOption{
+				options.WithPartitions(options.WithExplicitPartitions(
+					value.TupleValue(value.Uint64Value(10), value.Int32Value(1)),
+				)),
+			},
+			err: "type Int32 of item 1 of split point 0 is not matched to type Optional<Utf8> of primary key column \"b\"",
+		},
+		{
+			name: "TooManyItems",
+			opts: []options.CreateTableOption{
+				options.WithPartitions(options.WithExplicitPartitions(
+					value.TupleValue(value.Uint64Value(10), value.TextValue("x"), value.Int32Value(1)),
+				)),
+			},
+			err: "split point 0 has 3 items, but primary key has 2 columns",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var created bool
+			client := New(context.Background(), testutil.NewBalancer(
+				testutil.WithInvokeHandlers(
+					testutil.InvokeHandlers{
+						testutil.TableCreateSession: func(request interface{}) (proto.Message, error) {
+							return &Ydb_Table.CreateSessionResult{}, nil
+						},
+						testutil.TableCreateTable: func(request interface{}) (proto.Message, error) {
+							created = true
+
+							return &Ydb_Table.CreateTableResponse{}, nil
+						},
+					},
+				),
+			), config.New())
+			ctx, cancel := xcontext.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			err := client.Do(ctx, func(ctx context.Context, s table.Session) error {
+				return s.CreateTable(ctx, "table", append(append([]options.CreateTableOption{}, columns...), tt.opts...)...)
+			}, table.WithIdempotent())
+			if tt.err != "" {
+				require.ErrorIs(t, err, errInvalidPartitions)
+				require.ErrorContains(t, err, tt.err)
+				require.False(t, created)
+			} else {
+				require.NoError(t, err)
+				require.True(t, created)
+			}
+		})
+	}
+}
+
+func TestPartitionsRoundTrip(t *testing.T) {
+	var request *Ydb_Table.CreateTableRequest
+	client := New(context.Background(), testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+					return &Ydb_Table.CreateSessionResult{}, nil
+				},
+				testutil.TableCreateTable: func(r interface{}) (proto.Message, error) {
+					// request is released to allocator after call
+					request, _ = proto.Clone(r.(*Ydb_Table.CreateTableRequest)).(*Ydb_Table.CreateTableRequest)
+
+					return &Ydb_Table.CreateTableResponse{}, nil
+				},
+				testutil.TableDescribeTable: func(interface{}) (proto.Message, error) {
+					result := &Ydb_Table.DescribeTableResult{
+						PrimaryKey:           request.GetPrimaryKey(),
+						Columns:              request.GetColumns(),
+						PartitioningSettings: request.GetPartitioningSettings(),
+					}
+					result.ShardKeyBounds = append(result.ShardKeyBounds, request.GetPartitionAtKeys().GetSplitPoints()...)
+
+					return result, nil
+				},
+			},
+		),
+	), config.New())
+	ctx, cancel := xcontext.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	settings := options.PartitioningSettings{
+		PartitioningBySize: options.FeatureEnabled,
+		PartitionSizeMb:    512,
+		PartitioningByLoad: options.FeatureEnabled,
+		MinPartitionsCount: 2,
+		MaxPartitionsCount: 16,
+	}
+	splitPoints := []value.Value{
+		value.TupleValue(value.OptionalValue(value.Uint64Value(10))),
+		value.TupleValue(value.OptionalValue(value.Uint64Value(20))),
+	}
+
+	var desc options.Description
+	err := client.Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		err = s.CreateTable(ctx, "table",
+			options.WithColumn("id", types.NewOptional(types.Uint64)),
+			options.WithPrimaryKeyColumn("id"),
+			options.WithPartitioningSettings(
+				options.WithPartitioningBySize(options.FeatureEnabled),
+				options.WithPartitionSizeMb(512),
+				options.WithPartitioningByLoad(options.FeatureEnabled),
+				options.WithMinPartitionsCount(2),
+				options.WithMaxPartitionsCount(16),
+			),
+			options.WithPartitions(options.WithExplicitPartitions(splitPoints...)),
+		)
+		if err != nil {
+			return err
+		}
+		desc, err = s.DescribeTable(ctx, "table", options.WithShardKeyBounds())
+
+		return err
+	}, table.WithIdempotent())
+	require.NoError(t, err)
+	require.Equal(t, settings, desc.PartitioningSettings)
+	require.Len(t, desc.SplitPoints(), len(splitPoints))
+	for i := range splitPoints {
+		require.Equal(t, splitPoints[i].Yql(), desc.SplitPoints()[i].Yql())
+	}
+}
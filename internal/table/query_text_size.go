@@ -0,0 +1,26 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+)
+
+// checkQueryTextSize returns error if size of query text exceeds limit. Limit of call overrides limit of config
+// (zero value of call limit means limit of config), non-positive limit disables the check
+func checkQueryTextSize(query string, configLimit, callLimit int) error {
+	limit := configLimit
+	if callLimit != 0 {
+		limit = callLimit
+	}
+	if limit <= 0 || len(query) <= limit {
+		return nil
+	}
+
+	return xerrors.WithStackTrace(fmt.Errorf(
+		"%w: size of query text is %d bytes, limit is %d bytes "+
+			"(pass values as query parameters declared with DECLARE instead of inlining them into query text)",
+		table.ErrQueryTextTooLarge, len(query), limit,
+	))
+}
@@ -11,6 +11,35 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+type ctxAvoidSessionsKey struct{}
+
+// withAvoidSessions makes context for getting of session from pool preferably not from given sessions
+func withAvoidSessions(ctx context.Context, sessionIDs []string) context.Context {
+	if len(sessionIDs) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ctxAvoidSessionsKey{}, sessionIDs)
+}
+
+func avoidSessions(ctx context.Context) []string {
+	if sessionIDs, ok := ctx.Value(ctxAvoidSessionsKey{}).([]string); ok {
+		return sessionIDs
+	}
+
+	return nil
+}
+
+func containsSession(sessionIDs []string, sessionID string) bool {
+	for _, id := range sessionIDs {
+		if id == sessionID {
+			return true
+		}
+	}
+
+	return false
+}
+
 // SessionProvider is the interface that holds session lifecycle logic.
 type SessionProvider interface {
 	// Get returns alive idle session or creates new one.
@@ -66,11 +95,15 @@ func retryBackoff(
 	op table.Operation,
 	opts ...retry.Option,
 ) error {
+	// busySessions are sessions which failed with SESSION_BUSY status, such sessions are returned to pool,
+	// but next attempts use another sessions
+	var busySessions []string
+
 	return retry.Retry(ctx,
 		func(ctx context.Context) (err error) {
 			var s *session
 
-			s, err = p.Get(ctx)
+			s, err = p.Get(withAvoidSessions(ctx, busySessions))
 			if err != nil {
 				return xerrors.WithStackTrace(err)
 			}
@@ -79,9 +112,12 @@ func retryBackoff(
 				_ = p.Put(ctx, s)
 			}()
 
-			if err = op(ctx, s); err != nil {
-				s.checkError(err)
-
+			err = op(ctx, s)
+			s.checkError(err)
+			if retry.Check(err).IsRetryObjectBusy() {
+				busySessions = append(busySessions, s.ID())
+			}
+			if err != nil {
 				return xerrors.WithStackTrace(err)
 			}
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
@@ -15,6 +16,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
@@ -133,6 +135,125 @@ func TestRetryerBadSession(t *testing.T) {
 	}
 }
 
+func TestRetryerSessionBusy(t *testing.T) {
+	var (
+		sessions = []*session{simpleSession(t), simpleSession(t)}
+		closed   = make(map[string]bool)
+		put      []string
+	)
+	for i, s := range sessions {
+		s.id = testutil.SessionID(testutil.WithNodeID(uint32(i + 1)))
+		s.onClose = append(s.onClose, func(s *session) {
+			closed[s.ID()] = true
+		})
+	}
+	p := SessionProviderFunc{
+		OnGet: func(ctx context.Context) (*session, error) {
+			for _, s := range sessions {
+				if !containsSession(avoidSessions(ctx), s.ID()) {
+					return s, nil
+				}
+			}
+
+			return nil, xerrors.WithStackTrace(errNoSession)
+		},
+		OnPut: func(ctx context.Context, s *session) error {
+			put = append(put, s.ID())
+			if s.isClosing() {
+				return s.Close(ctx)
+			}
+
+			return nil
+		},
+	}
+	var used []string
+	err := do(context.Background(), p, config.New(),
+		func(ctx context.Context, s table.Session) error {
+			used = append(used, s.ID())
+			if len(used) == 1 {
+				return xerrors.Operation(
+					xerrors.WithStatusCode(Ydb.StatusIds_SESSION_BUSY),
+				)
+			}
+
+			return nil
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{sessions[0].ID(), sessions[1].ID()}, used)
+	require.NotEqual(t, used[0], used[1])
+	// busy session is returned to pool and is not deleted
+	require.Equal(t, used, put)
+	require.Empty(t, closed)
+	require.Equal(t, table.SessionReady, sessions[0].Status())
+}
+
+func TestClientDoSessionBusy(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := newHedgeTestClient(t)
+	var used []table.Session
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) error {
+		used = append(used, s)
+		if len(used) == 1 {
+			return xerrors.Operation(
+				xerrors.WithStatusCode(Ydb.StatusIds_SESSION_BUSY),
+			)
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, used, 2)
+	require.NotEqual(t, used[0].ID(), used[1].ID())
+	c.mu.WithLock(func() {
+		require.Equal(t, 2, c.idle.Len())
+	})
+
+	// busy session is reused if pool is full
+	c = newHedgeTestClient(t, config.WithSizeLimit(1))
+	used = used[:0]
+	err = c.Do(ctx, func(ctx context.Context, s table.Session) error {
+		used = append(used, s)
+		if len(used) == 1 {
+			return xerrors.Operation(
+				xerrors.WithStatusCode(Ydb.StatusIds_SESSION_BUSY),
+			)
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, used, 2)
+	require.Equal(t, used[0].ID(), used[1].ID())
+}
+
+func TestClientDoBeforeRetry(t *testing.T) {
+	ctx := xtest.Context(t)
+	c := newHedgeTestClient(t)
+	var (
+		attempts int
+		before   []int
+	)
+	err := c.Do(ctx, func(ctx context.Context, s table.Session) error {
+		attempts++
+
+		return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+	}, table.WithRetryOptions([]retry.Option{
+		retry.WithBeforeRetry(func(ctx context.Context, attempt int, err error) error {
+			before = append(before, attempt)
+			if attempt == 3 {
+				return context.Canceled
+			}
+
+			return nil
+		}),
+	}))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 2, attempts)
+	require.Equal(t, []int{2, 3}, before)
+}
+
 func TestRetryerSessionClosing(t *testing.T) {
 	closed := make(map[table.Session]bool)
 	p := SessionProviderFunc{
@@ -0,0 +1,25 @@
+package scanner
+
+import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/arrow"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// WriteArrowRecordBatch writes all rows of current result set (or current part of stream result)
+// into Arrow IPC stream as record batch. Encoded rows are converted directly from protobuf wire format
+// without decoding into values of scanner, position of scanner in result set is not changed
+func (r *baseResult) WriteArrowRecordBatch(w *arrow.Writer) error {
+	if r.set == nil {
+		return xerrors.WithStackTrace(errNoResultSet)
+	}
+	if r.lazy != nil {
+		return xerrors.WithStackTrace(w.WriteEncoded(r.set.GetColumns(), r.lazy.rows))
+	}
+	rows := r.set.GetRows()
+
+	return xerrors.WithStackTrace(w.Write(r.set.GetColumns(), len(rows), func(i int) (*Ydb.Value, error) {
+		return rows[i], nil
+	}))
+}
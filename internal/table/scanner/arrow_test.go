@@ -0,0 +1,212 @@
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+)
+
+// arrowMessages returns number of messages and sizes of bodies of messages of Arrow IPC stream
+func arrowMessages(t *testing.T, stream []byte) (bodies []int) {
+	t.Helper()
+
+	for {
+		require.GreaterOrEqual(t, len(stream), 8)
+		size := int(binary.LittleEndian.Uint32(stream[4:8]))
+		if size == 0 {
+			require.Len(t, stream, 8)
+
+			return bodies
+		}
+		meta := stream[8 : 8+size]
+		message := int(binary.LittleEndian.Uint32(meta))
+		vtable := message - int(int32(binary.LittleEndian.Uint32(meta[message:])))
+		bodyLength := int(binary.LittleEndian.Uint64(meta[message+int(binary.LittleEndian.Uint16(meta[vtable+10:])):]))
+		bodies = append(bodies, bodyLength)
+		stream = stream[8+size+bodyLength:]
+	}
+}
+
+// arrowStreams collects Arrow IPC streams of result sets which are written by result.ToArrow
+type arrowStreams struct {
+	streams []*bytes.Buffer
+	// writes is a number of writes into streams
+	writes int
+}
+
+func (s *arrowStreams) newStream(resultSetIndex int) (io.Writer, error) {
+	if resultSetIndex != len(s.streams) {
+		return nil, fmt.Errorf("unexpected index of result set %d", resultSetIndex)
+	}
+	s.streams = append(s.streams, &bytes.Buffer{})
+
+	return writerFunc(func(p []byte) (int, error) {
+		s.writes++
+
+		return s.streams[resultSetIndex].Write(p)
+	}), nil
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+func TestToArrow(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Unary", func(t *testing.T) {
+		res := NewUnary([]*Ydb.ResultSet{
+			{
+				Columns: []*Ydb.Column{{Name: "id", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}}}},
+				Rows: []*Ydb.Value{
+					{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 1}}}},
+					{Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: 2}}}},
+				},
+			},
+			{
+				Columns: []*Ydb.Column{{Name: "name", Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}}}},
+			},
+		}, nil)
+		var s arrowStreams
+		require.NoError(t, result.ToArrow(ctx, res, s.newStream))
+		require.Len(t, s.streams, 2)
+		// schema and record batch with two uint64 values
+		require.Equal(t, []int{0, 16}, arrowMessages(t, s.streams[0].Bytes()))
+		// schema and empty record batch with offsets of strings
+		require.Equal(t, []int{0, 8}, arrowMessages(t, s.streams[1].Bytes()))
+	})
+	t.Run("Stream", func(t *testing.T) {
+		var s arrowStreams
+		res := lazyStream(t, NewLazyCodec(), [][]byte{
+			wideResponse(t, 4, 3, 0, false),
+			wideResponse(t, 4, 2, 3, false),
+		})
+		defer func() {
+			_ = res.Close()
+		}()
+		require.NoError(t, result.ToArrow(ctx, res, s.newStream))
+		require.Len(t, s.streams, 1)
+		// schema and record batch per part of stream
+		require.Len(t, arrowMessages(t, s.streams[0].Bytes()), 3)
+		require.Equal(t, 5, res.TotalRowsScanned())
+	})
+	t.Run("PartsAreWrittenOnReceive", func(t *testing.T) {
+		var s arrowStreams
+		codec := NewLazyCodec()
+		first := wideResponse(t, 4, 3, 0, false)
+		parts := make(chan []byte)
+		// writes into stream before receiving of next part
+		var writes []int
+		res, err := NewStream(ctx,
+			func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+				part := first
+				if part == nil {
+					writes = append(writes, s.writes)
+					var ok bool
+					if part, ok = <-parts; !ok {
+						return nil, nil, io.EOF
+					}
+				}
+				first = nil
+				var response Ydb_Table.ExecuteScanQueryPartialResponse
+				if err := codec.Unmarshal(part, &response); err != nil {
+					return nil, nil, err
+				}
+
+				return response.GetResult().GetResultSet(), response.GetResult().GetQueryStats(), nil
+			},
+			func(err error) error {
+				return err
+			},
+			WithLazyRows(codec),
+		)
+		require.NoError(t, err)
+		defer func() {
+			_ = res.Close()
+		}()
+		done := make(chan error, 1)
+		go func() {
+			done <- result.ToArrow(ctx, res, s.newStream)
+		}()
+		parts <- wideResponse(t, 4, 2, 3, false)
+		close(parts)
+		require.NoError(t, <-done)
+		// schema and record batch of first part are written before next part is received
+		require.Len(t, writes, 2)
+		require.NotZero(t, writes[0])
+		require.Greater(t, writes[1], writes[0])
+		require.Len(t, arrowMessages(t, s.streams[0].Bytes()), 3)
+	})
+	t.Run("UnsupportedType", func(t *testing.T) {
+		res := NewUnary([]*Ydb.ResultSet{{
+			Columns: []*Ydb.Column{{Name: "ids", Type: &Ydb.Type{Type: &Ydb.Type_ListType{ListType: &Ydb.ListType{
+				Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+			}}}}},
+		}}, nil)
+		err := result.ToArrow(ctx, res, (&arrowStreams{}).newStream)
+		require.ErrorIs(t, err, result.ErrArrowUnsupportedType)
+	})
+}
+
+func BenchmarkToArrow(b *testing.B) {
+	const (
+		columns = 10
+		rows    = 1000000
+		part    = 10000
+	)
+	// 10M cells in parts of scan query
+	parts := make([][]byte, 0, rows/part)
+	for from := 0; from < rows; from += part {
+		parts = append(parts, wideResponse(b, columns, part, from, false))
+	}
+	b.ResetTimer()
+	b.Run("ScanNamed", func(b *testing.B) {
+		b.ReportAllocs()
+		var (
+			c0, c2, c4, c6, c8 uint64
+			c1, c3, c5, c7, c9 *string
+		)
+		for i := 0; i < b.N; i++ {
+			res := lazyStream(b, NewLazyCodec(), parts)
+			for res.NextResultSet(context.Background()) {
+				for res.NextRow() {
+					if err := res.ScanNamed(
+						named.Required("c0", &c0), named.Optional("c1", &c1),
+						named.Required("c2", &c2), named.Optional("c3", &c3),
+						named.Required("c4", &c4), named.Optional("c5", &c5),
+						named.Required("c6", &c6), named.Optional("c7", &c7),
+						named.Required("c8", &c8), named.Optional("c9", &c9),
+					); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+			if err := res.Err(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("ToArrow", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			res := lazyStream(b, NewLazyCodec(), parts)
+			if err := result.ToArrow(context.Background(), res, func(int) (io.Writer, error) {
+				return io.Discard, nil
+			}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
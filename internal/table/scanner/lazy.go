@@ -0,0 +1,266 @@
+package scanner
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	resultSetName  = (&Ydb.ResultSet{}).ProtoReflect().Descriptor().FullName()
+	rowsFieldNum   = (&Ydb.ResultSet{}).ProtoReflect().Descriptor().Fields().ByName("rows").Number()
+	itemsFieldNum  = (&Ydb.Value{}).ProtoReflect().Descriptor().Fields().ByName("items").Number()
+	hasResultSetMu sync.Mutex
+	hasResultSet   = map[protoreflect.FullName]bool{}
+)
+
+// LazyCodec is a grpc codec which unmarshals result sets without decoding of rows.
+// Encoded rows are kept by codec until result set is taken by result with WithLazyRows option,
+// so items of rows are decoded on demand and items of columns which are not scanned are only skipped
+//
+// Codec must be created for every stream
+type LazyCodec struct {
+	mu   sync.Mutex
+	rows map[*Ydb.ResultSet][][]byte
+}
+
+func NewLazyCodec() *LazyCodec {
+	return &LazyCodec{
+		rows: make(map[*Ydb.ResultSet][][]byte),
+	}
+}
+
+func (c *LazyCodec) Name() string {
+	return "proto"
+}
+
+func (c *LazyCodec) Marshal(v interface{}) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("failed to marshal, message is %T, want proto.Message", v))
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (c *LazyCodec) Unmarshal(data []byte, v interface{}) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return xerrors.WithStackTrace(fmt.Errorf("failed to unmarshal, message is %T, want proto.Message", v))
+	}
+	m := msg.ProtoReflect()
+	if !containsResultSet(m.Descriptor()) {
+		return proto.Unmarshal(data, msg)
+	}
+	proto.Reset(msg)
+
+	// encoded rows refer to data, so data is copied for independence from buffers of grpc
+	return c.unmarshal(append([]byte(nil), data...), m)
+}
+
+// take returns encoded rows of result set unmarshalled by codec
+func (c *LazyCodec) take(set *Ydb.ResultSet) (rows [][]byte, has bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rows, has = c.rows[set]
+	delete(c.rows, set)
+
+	return rows, has
+}
+
+func (c *LazyCodec) unmarshal(b []byte, m protoreflect.Message) error {
+	var rest []byte
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return xerrors.WithStackTrace(protowire.ParseError(n))
+		}
+		l := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if l < 0 {
+			return xerrors.WithStackTrace(protowire.ParseError(l))
+		}
+		fd := m.Descriptor().Fields().ByNumber(num)
+		if fd == nil || typ != protowire.BytesType || fd.Kind() != protoreflect.MessageKind ||
+			fd.IsList() || fd.IsMap() || !containsResultSet(fd.Message()) {
+			rest = append(rest, b[:n+l]...)
+			b = b[n+l:]
+
+			continue
+		}
+		v, _ := protowire.ConsumeBytes(b[n:])
+		b = b[n+l:]
+		if fd.Message().FullName() == resultSetName {
+			set, rows, err := unmarshalResultSet(v)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			m.Set(fd, protoreflect.ValueOfMessage(set.ProtoReflect()))
+			c.mu.Lock()
+			c.rows[set] = rows
+			c.mu.Unlock()
+
+			continue
+		}
+		if err := c.unmarshal(v, m.Mutable(fd).Message()); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return proto.UnmarshalOptions{Merge: true}.Unmarshal(rest, m.Interface())
+}
+
+// unmarshalResultSet unmarshals result set without rows and returns encoded rows.
+// Every row of result set is an empty placeholder which is filled by items on demand
+func unmarshalResultSet(b []byte) (set *Ydb.ResultSet, rows [][]byte, _ error) {
+	var rest []byte
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, nil, xerrors.WithStackTrace(protowire.ParseError(n))
+		}
+		l := protowire.ConsumeFieldValue(num, typ, b[n:])
+		if l < 0 {
+			return nil, nil, xerrors.WithStackTrace(protowire.ParseError(l))
+		}
+		if num == rowsFieldNum && typ == protowire.BytesType {
+			v, _ := protowire.ConsumeBytes(b[n:])
+			rows = append(rows, v)
+		} else {
+			rest = append(rest, b[:n+l]...)
+		}
+		b = b[n+l:]
+	}
+
+	set = &Ydb.ResultSet{}
+	if err := proto.Unmarshal(rest, set); err != nil {
+		return nil, nil, xerrors.WithStackTrace(err)
+	}
+	placeholders := make([]Ydb.Value, len(rows))
+	set.Rows = make([]*Ydb.Value, len(rows))
+	for i := range placeholders {
+		set.Rows[i] = &placeholders[i]
+	}
+
+	return set, rows, nil
+}
+
+// splitItems returns encoded items of encoded row without decoding of items
+func splitItems(row []byte) (items [][]byte, _ error) {
+	for len(row) > 0 {
+		num, typ, n := protowire.ConsumeTag(row)
+		if n < 0 {
+			return nil, xerrors.WithStackTrace(protowire.ParseError(n))
+		}
+		l := protowire.ConsumeFieldValue(num, typ, row[n:])
+		if l < 0 {
+			return nil, xerrors.WithStackTrace(protowire.ParseError(l))
+		}
+		if num == itemsFieldNum && typ == protowire.BytesType {
+			v, _ := protowire.ConsumeBytes(row[n:])
+			items = append(items, v)
+		}
+		row = row[n+l:]
+	}
+
+	return items, nil
+}
+
+// containsResultSet reports that message is result set or contains result set in singular message fields
+func containsResultSet(md protoreflect.MessageDescriptor) bool {
+	hasResultSetMu.Lock()
+	defer hasResultSetMu.Unlock()
+
+	return containsResultSetLocked(md)
+}
+
+func containsResultSetLocked(md protoreflect.MessageDescriptor) bool {
+	if md.FullName() == resultSetName {
+		return true
+	}
+	if has, ok := hasResultSet[md.FullName()]; ok {
+		return has
+	}
+	// protects from recursion of message types
+	hasResultSet[md.FullName()] = false
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() && containsResultSetLocked(fd.Message()) {
+			hasResultSet[md.FullName()] = true
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithLazyRows makes result to take encoded rows of result sets from codec
+func WithLazyRows(codec *LazyCodec) option {
+	return func(r *baseResult) {
+		r.valueScanner.lazyCodec = codec
+	}
+}
+
+// lazyRow decodes items of current row on demand
+type lazyRow struct {
+	rows  [][]byte   // encoded rows of current result set
+	index int        // index of current row
+	items [][]byte   // encoded items of current row, nil if current row is not split yet
+	row   *Ydb.Value // placeholder of current row which is filled by decoded items
+}
+
+func (r *lazyRow) reset(row *Ydb.Value, index int) {
+	r.row = row
+	r.index = index
+	r.items = nil
+}
+
+func (r *lazyRow) split() error {
+	if r.items != nil {
+		return nil
+	}
+	items, err := splitItems(r.rows[r.index])
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	r.items = append(make([][]byte, 0, len(items)), items...)
+	r.row.Items = make([]*Ydb.Value, len(items))
+
+	return nil
+}
+
+func (r *lazyRow) itemCount() (int, error) {
+	if err := r.split(); err != nil {
+		return 0, xerrors.WithStackTrace(err)
+	}
+
+	return len(r.items), nil
+}
+
+// item returns decoded item of current row with index idx
+func (r *lazyRow) item(idx int) (*Ydb.Value, error) {
+	if err := r.split(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	if idx < 0 || idx >= len(r.items) {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("item %d not found in row of %d items", idx, len(r.items)))
+	}
+	if r.row.GetItems()[idx] == nil {
+		v := &Ydb.Value{}
+		if err := proto.Unmarshal(r.items[idx], v); err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		r.row.Items[idx] = v
+	}
+
+	return r.row.GetItems()[idx], nil
+}
@@ -0,0 +1,266 @@
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+)
+
+// wideResponse returns encoded part of scan query with columns c0...c<columns-1> of Uint64 and Optional<Utf8> types
+func wideResponse(tb testing.TB, columns, rows, from int, truncated bool) []byte {
+	tb.Helper()
+
+	set := &Ydb.ResultSet{Truncated: truncated}
+	for i := 0; i < columns; i++ {
+		t := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}}
+		if i%2 == 1 {
+			t = &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+				Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+			}}}
+		}
+		set.Columns = append(set.Columns, &Ydb.Column{Name: fmt.Sprintf("c%d", i), Type: t})
+	}
+	for r := from; r < from+rows; r++ {
+		row := &Ydb.Value{}
+		for i := 0; i < columns; i++ {
+			if i%2 == 1 {
+				row.Items = append(row.Items, &Ydb.Value{Value: &Ydb.Value_TextValue{
+					TextValue: fmt.Sprintf("row %d column %d", r, i),
+				}})
+			} else {
+				row.Items = append(row.Items, &Ydb.Value{Value: &Ydb.Value_Uint64Value{
+					Uint64Value: uint64(r*columns + i),
+				}})
+			}
+		}
+		set.Rows = append(set.Rows, row)
+	}
+	data, err := proto.Marshal(&Ydb_Table.ExecuteScanQueryPartialResponse{
+		Status: Ydb.StatusIds_SUCCESS,
+		Result: &Ydb_Table.ExecuteScanQueryPartialResult{
+			ResultSet:  set,
+			QueryStats: &Ydb_TableStats.QueryStats{TotalDurationUs: 42},
+		},
+	})
+	require.NoError(tb, err)
+
+	return data
+}
+
+// lazyStream returns stream result over encoded parts which are unmarshalled with codec
+func lazyStream(tb testing.TB, codec *LazyCodec, parts [][]byte, opts ...option) StreamResult {
+	tb.Helper()
+
+	res, err := NewStream(context.Background(),
+		func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+			if len(parts) == 0 {
+				return nil, nil, io.EOF
+			}
+			var response Ydb_Table.ExecuteScanQueryPartialResponse
+			if err := codec.Unmarshal(parts[0], &response); err != nil {
+				return nil, nil, err
+			}
+			parts = parts[1:]
+
+			return response.GetResult().GetResultSet(), response.GetResult().GetQueryStats(), nil
+		},
+		func(err error) error {
+			return err
+		},
+		append(opts, WithLazyRows(codec))...,
+	)
+	require.NoError(tb, err)
+
+	return res
+}
+
+func TestLazyCodec(t *testing.T) {
+	t.Run("Unmarshal", func(t *testing.T) {
+		codec := NewLazyCodec()
+		var response Ydb_Table.ExecuteScanQueryPartialResponse
+		require.NoError(t, codec.Unmarshal(wideResponse(t, 40, 3, 0, true), &response))
+		require.Equal(t, Ydb.StatusIds_SUCCESS, response.GetStatus())
+		require.EqualValues(t, 42, response.GetResult().GetQueryStats().GetTotalDurationUs())
+
+		set := response.GetResult().GetResultSet()
+		require.Len(t, set.GetColumns(), 40)
+		require.Len(t, set.GetRows(), 3)
+		require.True(t, set.GetTruncated())
+		for _, row := range set.GetRows() {
+			require.Empty(t, row.GetItems())
+		}
+
+		rows, has := codec.take(set)
+		require.True(t, has)
+		require.Len(t, rows, 3)
+		_, has = codec.take(set)
+		require.False(t, has)
+	})
+	t.Run("OtherMessages", func(t *testing.T) {
+		codec := NewLazyCodec()
+		request := &Ydb_Table.ExecuteScanQueryRequest{
+			Query: &Ydb_Table.Query{Query: &Ydb_Table.Query_YqlText{YqlText: "SELECT 1"}},
+		}
+		data, err := codec.Marshal(request)
+		require.NoError(t, err)
+		var actual Ydb_Table.ExecuteScanQueryRequest
+		require.NoError(t, codec.Unmarshal(data, &actual))
+		require.True(t, proto.Equal(request, &actual))
+	})
+}
+
+func TestLazyRows(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ScanNamed", func(t *testing.T) {
+		res := lazyStream(t, NewLazyCodec(), [][]byte{
+			wideResponse(t, 40, 2, 0, false),
+			wideResponse(t, 40, 3, 2, false),
+		})
+		defer func() {
+			_ = res.Close()
+		}()
+
+		var (
+			rowIndex int
+			c0       uint64
+			c1       *string
+		)
+		for res.NextResultSet(ctx) {
+			require.Equal(t, 40, res.CurrentResultSet().ColumnCount())
+			for res.NextRow() {
+				require.NoError(t, res.ScanNamed(
+					named.Required("c0", &c0),
+					named.Optional("c1", &c1),
+				))
+				require.EqualValues(t, rowIndex*40, c0)
+				require.NotNil(t, c1)
+				require.Equal(t, fmt.Sprintf("row %d column 1", rowIndex), *c1)
+
+				// not scanned items are not decoded
+				row := res.(*streamResult).row
+				require.Len(t, row.GetItems(), 40)
+				for i, item := range row.GetItems() {
+					require.Equal(t, i < 2, item != nil, i)
+				}
+				rowIndex++
+			}
+		}
+		require.NoError(t, res.Err())
+		require.Equal(t, 5, rowIndex)
+		require.Equal(t, 5, res.TotalRowsScanned())
+	})
+	t.Run("SetColumnsOfInterest", func(t *testing.T) {
+		res := lazyStream(t, NewLazyCodec(), [][]byte{
+			wideResponse(t, 40, 2, 0, false),
+			wideResponse(t, 40, 2, 2, false),
+		})
+		defer func() {
+			_ = res.Close()
+		}()
+		res.SetColumnsOfInterest("c4", "c3")
+
+		var (
+			rowIndex int
+			c4       uint64
+			c3       string
+		)
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				require.NoError(t, res.ScanWithDefaults(&c4, &c3))
+				require.EqualValues(t, rowIndex*40+4, c4)
+				require.Equal(t, fmt.Sprintf("row %d column 3", rowIndex), c3)
+				rowIndex++
+			}
+		}
+		require.NoError(t, res.Err())
+		require.Equal(t, 4, rowIndex)
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		res := lazyStream(t, NewLazyCodec(), [][]byte{
+			wideResponse(t, 40, 3, 0, true),
+		})
+		defer func() {
+			_ = res.Close()
+		}()
+
+		require.ErrorIs(t, res.NextResultSetErr(ctx), result.ErrTruncated)
+		require.Equal(t, 3, res.CurrentResultSet().RowCount())
+		require.True(t, res.CurrentResultSet().Truncated())
+		require.Equal(t, 3, res.TotalRowsScanned())
+	})
+	t.Run("IgnoreTruncated", func(t *testing.T) {
+		res := lazyStream(t, NewLazyCodec(), [][]byte{
+			wideResponse(t, 40, 3, 0, true),
+		}, WithIgnoreTruncated(true))
+		defer func() {
+			_ = res.Close()
+		}()
+
+		require.NoError(t, res.NextResultSetErr(ctx))
+		var count int
+		for res.NextRow() {
+			count++
+		}
+		require.NoError(t, res.Err())
+		require.Equal(t, 3, count)
+	})
+}
+
+func BenchmarkLazyRows(b *testing.B) {
+	const (
+		columns = 40
+		rows    = 1000
+	)
+	data := wideResponse(b, columns, rows, 0, false)
+	scan := func(b *testing.B, res result.BaseResult) {
+		var (
+			c0, c2, c4 uint64
+			c1, c3     *string
+		)
+		for res.NextRow() {
+			if err := res.ScanNamed(
+				named.Required("c0", &c0),
+				named.Optional("c1", &c1),
+				named.Required("c2", &c2),
+				named.Optional("c3", &c3),
+				named.Required("c4", &c4),
+			); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+	b.Run("Eager", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var response Ydb_Table.ExecuteScanQueryPartialResponse
+			if err := proto.Unmarshal(data, &response); err != nil {
+				b.Fatal(err)
+			}
+			res := NewUnary([]*Ydb.ResultSet{response.GetResult().GetResultSet()}, nil)
+			if err := res.NextResultSetErr(context.Background()); err != nil {
+				b.Fatal(err)
+			}
+			scan(b, res)
+		}
+	})
+	b.Run("Lazy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			res := lazyStream(b, NewLazyCodec(), [][]byte{data})
+			if err := res.NextResultSetErr(context.Background()); err != nil {
+				b.Fatal(err)
+			}
+			scan(b, res)
+		}
+	})
+}
@@ -9,13 +9,18 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 )
 
-var errAlreadyClosed = xerrors.Wrap(errors.New("result closed early"))
+var (
+	errAlreadyClosed = xerrors.Wrap(errors.New("result closed early"))
+	errNoResultSet   = xerrors.Wrap(errors.New("no result set selected"))
+)
 
 type baseResult struct {
 	valueScanner
@@ -24,7 +29,19 @@ type baseResult struct {
 	statsMtx             xsync.RWMutex
 	stats                *Ydb_TableStats.QueryStats
 
+	resultSetIndex   int
+	totalRowsScanned int
+
 	closed atomic.Bool
+
+	keyColumns []string
+
+	// columnsOfInterest are columns for positional scan of result sets without explicit columns
+	columnsOfInterest []string
+
+	statsHandler func(stats.QueryStats)
+
+	issues []issues.Issue
 }
 
 type streamResult struct {
@@ -32,6 +49,61 @@ type streamResult struct {
 
 	recv  func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error)
 	close func(error) error
+
+	lastKeySet *Ydb.ResultSet
+	lastKeyRow *Ydb.Value
+}
+
+// NextRow selects next row in the current result set and remembers it for LastKey
+func (r *streamResult) NextRow() bool {
+	if !r.valueScanner.NextRow() {
+		return false
+	}
+	if len(r.keyColumns) > 0 {
+		r.lastKeySet, r.lastKeyRow = r.set, r.row
+		if r.lazy != nil {
+			// items of key columns must be decoded before next row for LastKey
+			for _, name := range r.keyColumns {
+				for i, c := range r.set.GetColumns() {
+					if c.GetName() == name {
+						_, _ = r.item(i)
+					}
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// LastKey returns tuple of values of key columns from the last fetched row.
+// LastKey returns nil if key columns are not defined, no rows were fetched or
+// some key column is not in result set
+func (r *streamResult) LastKey() value.Value {
+	if r.lastKeyRow == nil {
+		return nil
+	}
+	var (
+		columns = r.lastKeySet.GetColumns()
+		items   = r.lastKeyRow.GetItems()
+		key     = make([]value.Value, 0, len(r.keyColumns))
+	)
+	for _, name := range r.keyColumns {
+		idx := -1
+		for i := range columns {
+			if columns[i].GetName() == name {
+				idx = i
+
+				break
+			}
+		}
+		if idx < 0 || idx >= len(items) || items[idx] == nil {
+			return nil
+		}
+		key = append(key, value.FromYDB(columns[idx].GetType(), items[idx]))
+	}
+
+	return value.TupleValue(key...)
 }
 
 // Err returns error caused Scanner to be broken.
@@ -74,6 +146,11 @@ func (r *unaryResult) ResultSetCount() int {
 	return len(r.sets)
 }
 
+// Issues returns non-fatal issues of successful response
+func (r *unaryResult) Issues() []issues.Issue {
+	return r.issues
+}
+
 func (r *baseResult) isClosed() bool {
 	return r.closed.Load()
 }
@@ -106,6 +183,41 @@ func WithMarkTruncatedAsRetryable() option {
 	}
 }
 
+// WithStrictNamedScan enables errors on columns of row which were not scanned by ScanNamed
+func WithStrictNamedScan(strictNamedScan bool) option {
+	return func(r *baseResult) {
+		r.valueScanner.strictNamedScan = strictNamedScan
+	}
+}
+
+// WithZeroStructForNull enables scanning of NULL structs into zero-valued go structs with ScanWithDefaults
+func WithZeroStructForNull(zeroStructForNull bool) option {
+	return func(r *baseResult) {
+		r.valueScanner.zeroStructForNull = zeroStructForNull
+	}
+}
+
+// WithKeyColumns defines key columns for LastKey of stream result
+func WithKeyColumns(keyColumns ...string) option {
+	return func(r *baseResult) {
+		r.keyColumns = keyColumns
+	}
+}
+
+// WithStatsHandler defines handler of query stats from parts of stream result
+func WithStatsHandler(handler func(stats.QueryStats)) option {
+	return func(r *baseResult) {
+		r.statsHandler = handler
+	}
+}
+
+// WithIssues defines non-fatal issues of successful response of unary result
+func WithIssues(issues []issues.Issue) option {
+	return func(r *baseResult) {
+		r.issues = issues
+	}
+}
+
 func NewStream(
 	ctx context.Context,
 	recv func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error),
@@ -113,6 +225,9 @@ func NewStream(
 	opts ...option,
 ) (StreamResult, error) {
 	r := &streamResult{
+		baseResult: baseResult{
+			resultSetIndex: -1,
+		},
 		recv:  recv,
 		close: onClose,
 	}
@@ -131,7 +246,8 @@ func NewStream(
 func NewUnary(sets []*Ydb.ResultSet, stats *Ydb_TableStats.QueryStats, opts ...option) UnaryResult {
 	r := &unaryResult{
 		baseResult: baseResult{
-			stats: stats,
+			stats:          stats,
+			resultSetIndex: -1,
 		},
 		sets: sets,
 	}
@@ -147,8 +263,25 @@ func NewUnary(sets []*Ydb.ResultSet, stats *Ydb_TableStats.QueryStats, opts ...o
 func (r *baseResult) Reset(set *Ydb.ResultSet, columnNames ...string) {
 	r.reset(set)
 	if set != nil {
-		r.setColumnIndexes(columnNames)
+		r.setColumnIndexes(r.columnsForScan(columnNames))
+	}
+}
+
+// SetColumnsOfInterest defines columns for positional scan of current and next result sets
+// which are selected without explicit columns
+func (r *baseResult) SetColumnsOfInterest(names ...string) {
+	r.columnsOfInterest = append([]string(nil), names...)
+	if r.set != nil {
+		r.setColumnIndexes(r.columnsForScan(nil))
+	}
+}
+
+func (r *baseResult) columnsForScan(columnNames []string) []string {
+	if len(columnNames) == 0 && len(r.columnsOfInterest) > 0 {
+		return r.columnsOfInterest
 	}
+
+	return columnNames
 }
 
 func (r *unaryResult) NextResultSetErr(ctx context.Context, columns ...string) (err error) {
@@ -159,6 +292,8 @@ func (r *unaryResult) NextResultSetErr(ctx context.Context, columns ...string) (
 		return io.EOF
 	}
 	r.Reset(r.sets[r.nextSet], columns...)
+	r.resultSetIndex = r.nextSet
+	r.totalRowsScanned += r.RowCount()
 	r.nextSet++
 
 	return ctx.Err()
@@ -171,7 +306,7 @@ func (r *unaryResult) NextResultSet(ctx context.Context, columns ...string) bool
 func (r *streamResult) nextResultSetErr(ctx context.Context, columns ...string) (err error) {
 	// skipping second recv because first call of recv is from New Stream(), second call is from user
 	if r.nextResultSetCounter.Add(1) == 2 { //nolint:gomnd
-		r.setColumnIndexes(columns)
+		r.setColumnIndexes(r.columnsForScan(columns))
 
 		return ctx.Err()
 	}
@@ -185,10 +320,16 @@ func (r *streamResult) nextResultSetErr(ctx context.Context, columns ...string)
 		return r.errorf(1, "streamResult.NextResultSetErr(): %w", err)
 	}
 	r.Reset(s, columns...)
+	// all parts of stream belong to the single result set of scan query or read table
+	r.resultSetIndex = 0
+	r.totalRowsScanned += r.RowCount()
 	if stats != nil {
 		r.statsMtx.WithLock(func() {
 			r.stats = stats
 		})
+		if r.statsHandler != nil {
+			r.statsHandler(&queryStats{stats: stats})
+		}
 	}
 
 	return ctx.Err()
@@ -221,6 +362,16 @@ func (r *baseResult) CurrentResultSet() result.Set {
 	return r
 }
 
+// ResultSetIndex returns index of current result set or -1 if result set not selected yet
+func (r *baseResult) ResultSetIndex() int {
+	return r.resultSetIndex
+}
+
+// TotalRowsScanned returns number of rows of all result sets and stream parts received up to current one
+func (r *baseResult) TotalRowsScanned() int {
+	return r.totalRowsScanned
+}
+
 // Stats returns query execution queryStats.
 func (r *baseResult) Stats() stats.QueryStats {
 	var s queryStats
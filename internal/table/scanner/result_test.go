@@ -2,6 +2,7 @@ package scanner
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
@@ -13,9 +14,11 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 )
 
 func TestResultAny(t *testing.T) {
@@ -79,6 +82,88 @@ func TestResultAny(t *testing.T) {
 	}
 }
 
+func TestScanWithDefaultsNullContainers(t *testing.T) {
+	type point struct {
+		X int64
+	}
+	a := allocator.New()
+	defer a.Free()
+	newResult := func(zeroStructForNull bool) UnaryResult {
+		return NewUnary(
+			[]*Ydb.ResultSet{
+				NewResultSet(a,
+					WithColumns(
+						options.Column{Name: "list", Type: types.NewOptional(types.NewList(types.Int64))},
+						options.Column{Name: "set", Type: types.NewOptional(types.NewSet(types.Text))},
+						options.Column{Name: "dict", Type: types.NewOptional(types.NewDict(types.Text, types.Int64))},
+						options.Column{Name: "decimal", Type: types.NewOptional(types.NewDecimal(22, 9))},
+						options.Column{Name: "json", Type: types.NewOptional(types.JSON)},
+						options.Column{Name: "pointer", Type: types.NewOptional(types.NewList(types.Text))},
+						options.Column{Name: "struct", Type: types.NewOptional(types.NewStruct(
+							types.StructField{Name: "X", T: types.Int64},
+						))},
+					),
+					WithValues(
+						value.NullValue(types.NewList(types.Int64)),
+						value.NullValue(types.NewSet(types.Text)),
+						value.NullValue(types.NewDict(types.Text, types.Int64)),
+						value.NullValue(types.NewDecimal(22, 9)),
+						value.NullValue(types.JSON),
+						value.NullValue(types.NewList(types.Text)),
+						value.NullValue(types.NewStruct(types.StructField{Name: "X", T: types.Int64})),
+					),
+				),
+			},
+			nil,
+			WithZeroStructForNull(zeroStructForNull),
+		)
+	}
+	t.Run("ZeroStructForNull", func(t *testing.T) {
+		var (
+			list    = []int64{1}
+			set     []string
+			dict    = map[string]int64{"a": 1}
+			d       decimal.Decimal
+			raw     json.RawMessage
+			pointer = &[]string{"a"}
+			s       = point{X: 1}
+		)
+		res := newResult(true)
+		require.True(t, res.NextResultSet(context.Background()))
+		require.True(t, res.NextRow())
+		require.NoError(t, res.ScanWithDefaults(&list, &set, &dict, &d, &raw, &pointer, &s))
+		require.NotNil(t, list)
+		require.Empty(t, list)
+		require.NotNil(t, set)
+		require.Empty(t, set)
+		require.NotNil(t, dict)
+		require.Empty(t, dict)
+		require.Equal(t, decimal.Decimal{Precision: 22, Scale: 9}, d)
+		require.NotNil(t, raw)
+		require.Empty(t, raw)
+		require.Nil(t, pointer)
+		require.Equal(t, point{}, s)
+	})
+	t.Run("ErrorOnNullStruct", func(t *testing.T) {
+		var (
+			list    []int64
+			set     []string
+			dict    map[string]int64
+			d       decimal.Decimal
+			raw     json.RawMessage
+			pointer *[]string
+			s       point
+		)
+		res := newResult(false)
+		require.True(t, res.NextResultSet(context.Background()))
+		require.True(t, res.NextRow())
+		require.ErrorContains(t,
+			res.ScanWithDefaults(&list, &set, &dict, &d, &raw, &pointer, &s),
+			"NULL struct",
+		)
+	})
+}
+
 func TestResultOUint32(t *testing.T) {
 	for _, test := range []struct {
 		name    string
@@ -280,3 +365,152 @@ func TestNewStreamWithRecvFirstResultSet(t *testing.T) {
 		})
 	}
 }
+
+func TestResultRowsCounters(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	sets := []*Ydb.ResultSet{
+		NewResultSet(a,
+			WithColumns(options.Column{Name: "a", Type: types.Uint64}),
+			WithValues(value.Uint64Value(1), value.Uint64Value(2)),
+		),
+		NewResultSet(a,
+			WithColumns(options.Column{Name: "a", Type: types.Uint64}),
+			WithValues(value.Uint64Value(3), value.Uint64Value(4), value.Uint64Value(5)),
+		),
+	}
+	type counters struct {
+		index, rowCount, totalRowsScanned int
+	}
+	t.Run("Unary", func(t *testing.T) {
+		res := NewUnary(sets, nil)
+		require.Equal(t, -1, res.ResultSetIndex())
+		var act []counters
+		for res.NextResultSet(context.Background()) {
+			act = append(act, counters{res.ResultSetIndex(), res.CurrentResultSet().RowCount(), res.TotalRowsScanned()})
+		}
+		require.NoError(t, res.Err())
+		require.Equal(t, []counters{{0, 2, 2}, {1, 3, 5}}, act)
+	})
+	t.Run("Stream", func(t *testing.T) {
+		parts := sets
+		res, err := NewStream(context.Background(),
+			func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+				if len(parts) == 0 {
+					return nil, nil, io.EOF
+				}
+				part := parts[0]
+				parts = parts[1:]
+
+				return part, nil, nil
+			},
+			func(err error) error {
+				return err
+			},
+		)
+		require.NoError(t, err)
+		var act []counters
+		for res.NextResultSet(context.Background()) {
+			require.False(t, res.CurrentResultSet().Truncated())
+			act = append(act, counters{res.ResultSetIndex(), res.CurrentResultSet().RowCount(), res.TotalRowsScanned()})
+		}
+		require.NoError(t, res.Err())
+		require.Equal(t, []counters{{0, 2, 2}, {0, 3, 5}}, act)
+	})
+}
+
+func TestStreamResultLastKey(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	newStream := func(t *testing.T, opts ...option) StreamResult {
+		parts := []*Ydb.ResultSet{
+			NewResultSet(a,
+				WithColumns(
+					options.Column{Name: "a", Type: types.Uint64},
+					options.Column{Name: "b", Type: types.Text},
+					options.Column{Name: "c", Type: types.NewOptional(types.Int32)},
+				),
+				WithValues(
+					value.Uint64Value(1), value.TextValue("x"), value.OptionalValue(value.Int32Value(10)),
+					value.Uint64Value(2), value.TextValue("y"), value.NullValue(types.Int32),
+				),
+			),
+		}
+		res, err := NewStream(context.Background(),
+			func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+				if len(parts) == 0 {
+					return nil, nil, io.EOF
+				}
+				part := parts[0]
+				parts = parts[1:]
+
+				return part, nil, nil
+			},
+			func(err error) error {
+				return err
+			},
+			opts...,
+		)
+		require.NoError(t, err)
+		require.True(t, res.NextResultSet(context.Background()))
+
+		return res
+	}
+	t.Run("WithKeyColumns", func(t *testing.T) {
+		res := newStream(t, WithKeyColumns("a", "c"))
+		require.Nil(t, res.LastKey())
+		require.True(t, res.NextRow())
+		require.Equal(t, "(1ul,Just(10))", res.LastKey().Yql())
+		require.True(t, res.NextRow())
+		require.Equal(t, "(2ul,Nothing(Optional<Int32>))", res.LastKey().Yql())
+		require.False(t, res.NextRow())
+		require.Equal(t, "(2ul,Nothing(Optional<Int32>))", res.LastKey().Yql())
+	})
+	t.Run("WithoutKeyColumns", func(t *testing.T) {
+		res := newStream(t)
+		require.True(t, res.NextRow())
+		require.Nil(t, res.LastKey())
+	})
+	t.Run("UnknownKeyColumn", func(t *testing.T) {
+		res := newStream(t, WithKeyColumns("a", "d"))
+		require.True(t, res.NextRow())
+		require.Nil(t, res.LastKey())
+	})
+}
+
+func TestStreamResultStatsHandler(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	parts := []*Ydb_TableStats.QueryStats{
+		{ProcessCpuTimeUs: 1},
+		nil,
+		{ProcessCpuTimeUs: 3},
+	}
+	var cpuTimes []time.Duration
+	res, err := NewStream(context.Background(),
+		func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+			if len(parts) == 0 {
+				return nil, nil, io.EOF
+			}
+			part := parts[0]
+			parts = parts[1:]
+
+			return NewResultSet(a, WithColumns(options.Column{Name: "a", Type: types.Uint64})), part, nil
+		},
+		func(err error) error {
+			return err
+		},
+		WithStatsHandler(func(s stats.QueryStats) {
+			cpuTimes = append(cpuTimes, s.ProcessCPUTime())
+		}),
+	)
+	require.NoError(t, err)
+	sets := 0
+	for res.NextResultSet(context.Background()) {
+		sets++
+	}
+	require.NoError(t, res.Err())
+	require.Equal(t, 3, sets)
+	require.Equal(t, []time.Duration{time.Microsecond, 3 * time.Microsecond}, cpuTimes)
+	require.Equal(t, 3*time.Microsecond, res.Stats().ProcessCPUTime())
+}
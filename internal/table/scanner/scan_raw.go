@@ -32,7 +32,7 @@ func (s *rawConverter) HasItems() bool {
 }
 
 func (s *rawConverter) HasNextItem() bool {
-	return s.hasItems() && s.nextItem < len(s.row.GetItems())
+	return s.hasItems() && s.nextItem < s.ItemCount()
 }
 
 func (s *rawConverter) Path() string {
@@ -267,7 +267,7 @@ func (s *rawConverter) UUID() (v [16]byte) {
 	}
 	s.unwrap()
 
-	return s.uint128()
+	return s.uuid()
 }
 
 func (s *rawConverter) DyNumber() (v string) {
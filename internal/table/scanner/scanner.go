@@ -2,6 +2,7 @@ package scanner
 
 import (
 	"database/sql"
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
@@ -36,6 +38,15 @@ type valueScanner struct {
 
 	columnIndexes []int
 
+	strictNamedScan bool
+	scannedColumns  []bool // reused between rows with strict named scan
+
+	zeroStructForNull bool
+
+	// lazyCodec is a source of encoded rows, lazy is not nil if rows of current result set are encoded
+	lazyCodec *LazyCodec
+	lazy      *lazyRow
+
 	errMtx xsync.RWMutex
 	err    error
 }
@@ -76,6 +87,14 @@ func (s *valueScanner) ItemCount() int {
 	if s.row == nil {
 		return 0
 	}
+	if s.lazy != nil {
+		n, err := s.lazy.itemCount()
+		if err != nil {
+			_ = s.errorf(0, "decode row failed: %w", err)
+		}
+
+		return n
+	}
 
 	return len(s.row.GetItems())
 }
@@ -94,6 +113,9 @@ func (s *valueScanner) NextRow() bool {
 		return false
 	}
 	s.row = s.set.GetRows()[s.nextRow]
+	if s.lazy != nil {
+		s.lazy.reset(s.row, s.nextRow)
+	}
 	s.nextRow++
 	s.nextItem = 0
 	s.stack.reset()
@@ -178,36 +200,100 @@ func (s *valueScanner) Scan(values ...indexed.RequiredOrOptional) (err error) {
 	return s.Err()
 }
 
+// ScanNamed scans row values into destinations by column names
+//
+// Same column may be scanned into multiple destinations. If scanner is in strict named scan mode,
+// ScanNamed returns error if row contains columns which were not scanned into any destination.
 func (s *valueScanner) ScanNamed(namedValues ...named.Value) error {
 	if err := s.Err(); err != nil {
 		return err
 	}
-	if s.ColumnCount() < len(namedValues) {
-		panic(fmt.Sprintf("scan row failed: count of columns less then values (%d < %d)", s.ColumnCount(), len(namedValues)))
-	}
 	if s.nextItem != 0 {
 		panic("scan row failed: double scan per row")
 	}
+	if s.strictNamedScan {
+		s.scannedColumns = append(s.scannedColumns[:0], make([]bool, s.ColumnCount())...)
+	}
 	for i := range namedValues {
-		if err := s.seekItemByName(namedValues[i].Name); err != nil {
+		idx, err := s.seekItemByName(namedValues[i].Name)
+		if err != nil {
 			return err
 		}
-		switch t := namedValues[i].Type; t {
-		case named.TypeRequired:
-			s.scanRequired(namedValues[i].Value)
-		case named.TypeOptional:
-			s.scanOptional(namedValues[i].Value, false)
-		case named.TypeOptionalWithUseDefault:
-			s.scanOptional(namedValues[i].Value, true)
-		default:
-			panic(fmt.Sprintf("unknown type of named.valueType: %d", t))
+		if s.strictNamedScan {
+			s.scannedColumns[idx] = true
+		}
+		s.scanNamed(namedValues[i])
+		if s.err != nil {
+			return s.namedValueError(namedValues[i])
 		}
 	}
 	s.nextItem += len(namedValues)
+	if s.strictNamedScan {
+		if err := s.notScannedColumnsError(); err != nil {
+			return err
+		}
+	}
 
 	return s.Err()
 }
 
+func (s *valueScanner) scanNamed(v named.Value) {
+	switch t := v.Type; t {
+	case named.TypeRequired:
+		if s.isCurrentTypeOptional() && !isNullableDestination(v.Value) {
+			if s.isNull() {
+				_ = s.errorf(0, "scan row failed: NULL value of required column, "+
+					"use named.Optional or named.OptionalWithDefault for nullable columns",
+				)
+
+				return
+			}
+			s.unwrap()
+		}
+		s.scanRequired(v.Value)
+	case named.TypeOptional:
+		s.scanOptional(v.Value, false)
+	case named.TypeOptionalWithUseDefault:
+		s.scanOptional(v.Value, true)
+	default:
+		panic(fmt.Sprintf("unknown type of named.valueType: %d", t))
+	}
+}
+
+// namedValueError replaces error of scanning of named value with error
+// which contains column name, column type and destination type
+func (s *valueScanner) namedValueError(v named.Value) error {
+	s.errMtx.Lock()
+	defer s.errMtx.Unlock()
+	t := "<unknown>"
+	for _, c := range s.set.GetColumns() {
+		if c.GetName() == v.Name {
+			t = internalTypes.TypeFromYDB(c.GetType()).Yql()
+
+			break
+		}
+	}
+	s.err = xerrors.WithStackTrace(fmt.Errorf("scan column %q of type %s into %T failed: %w",
+		v.Name, t, v.Value, s.err,
+	), xerrors.WithSkipDepth(1))
+
+	return s.err
+}
+
+func (s *valueScanner) notScannedColumnsError() error {
+	var names []string
+	for i, scanned := range s.scannedColumns {
+		if !scanned {
+			names = append(names, s.set.GetColumns()[i].GetName())
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	return s.errorf(1, "scan row failed: columns %q were not scanned in strict named scan mode", names)
+}
+
 // Truncated returns true if current result set has been truncated by server
 func (s *valueScanner) Truncated() bool {
 	if s.set == nil {
@@ -256,6 +342,12 @@ func (s *valueScanner) reset(set *Ydb.ResultSet, columnNames ...string) {
 	s.nextRow = 0
 	s.nextItem = 0
 	s.columnIndexes = nil
+	s.lazy = nil
+	if s.lazyCodec != nil && set != nil {
+		if rows, has := s.lazyCodec.take(set); has {
+			s.lazy = &lazyRow{rows: rows}
+		}
+	}
 	s.setColumnIndexes(columnNames)
 	s.stack.reset()
 	s.converter = &rawConverter{
@@ -301,29 +393,50 @@ func (s *valueScanner) seekItemByID(id int) error {
 		return s.notFoundColumnByIndex(id)
 	}
 	col := s.set.GetColumns()[id]
+	v, err := s.item(id)
+	if err != nil {
+		return err
+	}
 	s.stack.scanItem.name = col.GetName()
 	s.stack.scanItem.t = col.GetType()
-	s.stack.scanItem.v = s.row.GetItems()[id]
+	s.stack.scanItem.v = v
 
 	return nil
 }
 
-func (s *valueScanner) seekItemByName(name string) error {
+func (s *valueScanner) seekItemByName(name string) (int, error) {
 	if !s.hasItems() {
-		return s.notFoundColumnName(name)
+		return -1, s.notFoundColumnName(name)
 	}
 	for i, c := range s.set.GetColumns() {
 		if name != c.GetName() {
 			continue
 		}
+		v, err := s.item(i)
+		if err != nil {
+			return -1, err
+		}
 		s.stack.scanItem.name = c.GetName()
 		s.stack.scanItem.t = c.GetType()
-		s.stack.scanItem.v = s.row.GetItems()[i]
+		s.stack.scanItem.v = v
 
-		return s.Err()
+		return i, s.Err()
 	}
 
-	return s.notFoundColumnName(name)
+	return -1, s.notFoundColumnName(name)
+}
+
+// item returns item of current row with index idx. Items of encoded rows are decoded on first access
+func (s *valueScanner) item(idx int) (*Ydb.Value, error) {
+	if s.lazy == nil {
+		return s.row.GetItems()[idx], nil
+	}
+	v, err := s.lazy.item(idx)
+	if err != nil {
+		return nil, s.errorf(1, "decode item of column %d failed: %w", idx, err)
+	}
+
+	return v, nil
 }
 
 func (s *valueScanner) setColumnIndexes(columns []string) {
@@ -385,6 +498,14 @@ func (s *valueScanner) any() interface{} {
 		x = s.stack.current()
 	}
 
+	if _, isTagged := x.t.GetType().(*Ydb.Type_TaggedType); isTagged {
+		s.unwrapVariantAndTagged()
+		x = s.stack.current()
+		if s.isCurrentTypeOptional() {
+			return s.any()
+		}
+	}
+
 	t := internalTypes.TypeFromYDB(x.t)
 	p, primitive := t.(internalTypes.Primitive)
 	if !primitive {
@@ -411,7 +532,7 @@ func (s *valueScanner) any() interface{} {
 	case internalTypes.Bytes:
 		return s.bytes()
 	case internalTypes.UUID:
-		return s.uint128()
+		return s.uuid()
 	case internalTypes.Uint32:
 		return s.uint32()
 	case internalTypes.Date:
@@ -498,6 +619,46 @@ func (s *valueScanner) unwrap() {
 	s.stack.scanItem.t = t.OptionalType.GetItem()
 }
 
+// unwrapVariantAndTagged replaces current item under scan of Variant type with active alternative
+// and current item of Tagged type with tagged item
+// ignores if type is not variant or tagged
+func (s *valueScanner) unwrapVariantAndTagged() {
+	for s.Err() == nil {
+		c := s.stack.current()
+		switch t := c.t.GetType().(type) {
+		case *Ydb.Type_TaggedType:
+			s.stack.scanItem = item{name: c.name, i: c.i, t: t.TaggedType.GetType(), v: c.v}
+		case *Ydb.Type_VariantType:
+			nested, _ := c.v.GetValue().(*Ydb.Value_NestedValue)
+			if nested == nil {
+				s.valueTypeError(c.v.GetValue(), nested)
+
+				return
+			}
+			idx := int(c.v.GetVariantIndex())
+			var alternative *Ydb.Type
+			switch items := t.VariantType.GetType().(type) {
+			case *Ydb.VariantType_TupleItems:
+				if elements := items.TupleItems.GetElements(); idx < len(elements) {
+					alternative = elements[idx]
+				}
+			case *Ydb.VariantType_StructItems:
+				if members := items.StructItems.GetMembers(); idx < len(members) {
+					alternative = members[idx].GetType()
+				}
+			}
+			if alternative == nil {
+				_ = s.errorf(0, "scan row failed: variant index %d out of range at %q", idx, s.path())
+
+				return
+			}
+			s.stack.scanItem = item{name: c.name, i: c.i, t: alternative, v: nested.NestedValue}
+		default:
+			return
+		}
+	}
+}
+
 func (s *valueScanner) unwrapValue() (v *Ydb.Value) {
 	x, _ := s.stack.currentValue().(*Ydb.Value_NestedValue)
 	if x == nil {
@@ -702,6 +863,61 @@ func (s *valueScanner) uint128() (v [16]byte) {
 	return value.BigEndianUint128(hi, lo)
 }
 
+func (s *valueScanner) uuid() (v [16]byte) {
+	c := s.stack.current()
+	if c.isEmpty() {
+		_ = s.errorf(0, "not implemented convert to [16]byte")
+
+		return
+	}
+	lo := s.low128()
+	hi := c.v.GetHigh_128()
+
+	return value.UUIDFromHiLoPair(hi, lo)
+}
+
+// trySetUUID scans UUID item into destinations which must be checked before sql.Scanner
+// (such as github.com/google/uuid.UUID which implements both sql.Scanner and encoding.TextUnmarshaler)
+func (s *valueScanner) trySetUUID(v interface{}) bool {
+	if s.stack.current().t.GetTypeId() != Ydb.Type_UUID {
+		return false
+	}
+	switch dst := v.(type) {
+	case *value.LegacyUUIDBytes:
+		if err := value.CastTo(value.UUIDValue(s.uuid()), dst); err != nil {
+			_ = s.errorf(0, "scan UUID failed: %w", err)
+		}
+
+		return true
+	case encoding.TextUnmarshaler:
+		if err := value.CastTo(value.UUIDValue(s.uuid()), dst); err != nil {
+			_ = s.errorf(0, "scan UUID failed: %w", err)
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+// trySetJSON unmarshals Json or JsonDocument item into pointer destination with json.Unmarshal
+// Null value is unmarshaled as JSON null
+func (s *valueScanner) trySetJSON(v interface{}) bool {
+	t := s.stack.current().t.GetTypeId()
+	if t != Ydb.Type_JSON && t != Ydb.Type_JSON_DOCUMENT || reflect.ValueOf(v).Kind() != reflect.Ptr {
+		return false
+	}
+	data := []byte("null")
+	if !s.isNull() {
+		data = xstring.ToBytes(s.text())
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		_ = s.errorf(0, "scan row failed: json.Unmarshal of %q into %T failed: %w", s.path(), v, err)
+	}
+
+	return true
+}
+
 func (s *valueScanner) null() {
 	x, _ := s.stack.currentValue().(*Ydb.Value_NullFlagValue)
 	if x == nil {
@@ -743,8 +959,7 @@ func (s *valueScanner) setTime(dst *time.Time) {
 func (s *valueScanner) setString(dst *string) {
 	switch t := s.stack.current().t.GetTypeId(); t {
 	case Ydb.Type_UUID:
-		src := s.uint128()
-		*dst = xstring.FromBytes(src[:])
+		*dst = uuid.UUID(s.uuid()).String()
 	case Ydb.Type_UTF8, Ydb.Type_DYNUMBER, Ydb.Type_YSON, Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT:
 		*dst = s.text()
 	case Ydb.Type_STRING:
@@ -757,7 +972,7 @@ func (s *valueScanner) setString(dst *string) {
 func (s *valueScanner) setByte(dst *[]byte) {
 	switch t := s.stack.current().t.GetTypeId(); t {
 	case Ydb.Type_UUID:
-		src := s.uint128()
+		src := s.uuid()
 		*dst = src[:]
 	case Ydb.Type_UTF8, Ydb.Type_DYNUMBER, Ydb.Type_YSON, Ydb.Type_JSON, Ydb.Type_JSON_DOCUMENT:
 		*dst = xstring.ToBytes(s.text())
@@ -811,6 +1026,12 @@ func (s *valueScanner) trySetByteArray(v interface{}, optional, def bool) bool {
 
 //nolint:gocyclo
 func (s *valueScanner) scanRequired(v interface{}) {
+	if !isValueDestination(v) {
+		s.unwrapVariantAndTagged()
+	}
+	if s.trySetUUID(v) {
+		return
+	}
 	switch v := v.(type) {
 	case *bool:
 		*v = s.bool()
@@ -847,13 +1068,21 @@ func (s *valueScanner) scanRequired(v interface{}) {
 	case *[]byte:
 		s.setByte(v)
 	case *[16]byte:
-		*v = s.uint128()
+		if s.stack.current().t.GetTypeId() == Ydb.Type_UUID {
+			*v = s.uuid()
+		} else {
+			*v = s.uint128()
+		}
 	case *interface{}:
 		*v = s.any()
 	case *value.Value:
 		*v = s.value()
 	case *decimal.Decimal:
 		*v = s.unwrapDecimal()
+	case value.Decoder:
+		if err := v.ScanYDBValue(s.value()); err != nil {
+			_ = s.errorf(0, "ydb.ValueMapper error at %q: %w", s.path(), err)
+		}
 	case scanner.Scanner:
 		err := v.UnmarshalYDB(s.converter)
 		if err != nil {
@@ -877,7 +1106,14 @@ func (s *valueScanner) scanRequired(v interface{}) {
 		if err != nil {
 			_ = s.errorf(0, "json.Unmarshaler error: %w", err)
 		}
+	case encoding.TextUnmarshaler, encoding.BinaryUnmarshaler:
+		if err := value.CastTo(s.value(), v); err != nil {
+			_ = s.errorf(0, "scan row failed: %w", err)
+		}
 	default:
+		if s.trySetJSON(v) {
+			return
+		}
 		ok := s.trySetByteArray(v, false, false)
 		if !ok {
 			_ = s.errorf(0, "scan row failed: type %T is unknown", v)
@@ -887,7 +1123,7 @@ func (s *valueScanner) scanRequired(v interface{}) {
 
 //nolint:gocyclo
 func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool) {
-	if defaultValueForOptional {
+	if defaultValueForOptional && !isPointerToPointer(v) {
 		if s.isNull() {
 			s.setDefaultValue(v)
 		} else {
@@ -897,6 +1133,18 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 
 		return
 	}
+	if !s.isNull() && isVariantOrTagged(s.stack.current().t) && !isValueDestination(v) {
+		s.unwrap()
+		s.unwrapVariantAndTagged()
+	}
+	if !s.isNull() && isUUID(s.stack.current().t) {
+		if _, ok := v.(encoding.TextUnmarshaler); ok {
+			s.unwrap()
+			s.trySetUUID(v)
+
+			return
+		}
+	}
 	switch v := v.(type) {
 	case **bool:
 		if s.isNull() {
@@ -1027,7 +1275,18 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 		if s.isNull() {
 			*v = nil
 		} else {
-			src := s.uint128()
+			s.unwrap()
+			var src [16]byte
+			s.scanRequired(&src)
+			*v = &src
+		}
+	case **value.LegacyUUIDBytes:
+		if s.isNull() {
+			*v = nil
+		} else {
+			s.unwrap()
+			var src value.LegacyUUIDBytes
+			s.scanRequired(&src)
 			*v = &src
 		}
 	case **interface{}:
@@ -1046,6 +1305,10 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 			src := s.unwrapDecimal()
 			*v = &src
 		}
+	case value.Decoder:
+		if err := v.ScanYDBValue(s.value()); err != nil {
+			_ = s.errorf(0, "ydb.ValueMapper error at %q: %w", s.path(), err)
+		}
 	case scanner.Scanner:
 		err := v.UnmarshalYDB(s.converter)
 		if err != nil {
@@ -1078,8 +1341,28 @@ func (s *valueScanner) scanOptional(v interface{}, defaultValueForOptional bool)
 		if err != nil {
 			_ = s.errorf(0, "json.Unmarshaler error: %w", err)
 		}
+	case encoding.TextUnmarshaler, encoding.BinaryUnmarshaler:
+		if s.isNull() {
+			_ = s.errorf(0, "scan row failed: type %T is not optional! use double pointer or sql.Scanner.", v)
+
+			return
+		}
+		s.unwrap()
+		if err := value.CastTo(s.value(), v); err != nil {
+			_ = s.errorf(0, "scan row failed: %w", err)
+		}
 	default:
+		if isPointerToUnmarshaler(v) {
+			if err := value.CastTo(s.value(), v); err != nil {
+				_ = s.errorf(0, "scan row failed: %w", err)
+			}
+
+			return
+		}
 		s.unwrap()
+		if s.trySetJSON(v) {
+			return
+		}
 		ok := s.trySetByteArray(v, true, false)
 		if !ok {
 			rv := reflect.TypeOf(v)
@@ -1100,6 +1383,8 @@ func (s *valueScanner) setDefaultValue(dst interface{}) {
 		*v = 0
 	case *int16:
 		*v = 0
+	case *int:
+		*v = 0
 	case *int32:
 		*v = 0
 	case *int64:
@@ -1108,6 +1393,8 @@ func (s *valueScanner) setDefaultValue(dst interface{}) {
 		*v = 0
 	case *uint16:
 		*v = 0
+	case *uint:
+		*v = 0
 	case *uint32:
 		*v = 0
 	case *uint64:
@@ -1132,6 +1419,16 @@ func (s *valueScanner) setDefaultValue(dst interface{}) {
 		*v = s.value()
 	case *decimal.Decimal:
 		*v = decimal.Decimal{}
+		if d, ok := s.nullItemType().GetType().(*Ydb.Type_DecimalType); ok {
+			v.Precision = d.DecimalType.GetPrecision()
+			v.Scale = d.DecimalType.GetScale()
+		}
+	case *json.RawMessage:
+		*v = json.RawMessage{}
+	case value.Decoder:
+		if err := v.ScanYDBValue(s.value()); err != nil {
+			_ = s.errorf(0, "ydb.ValueMapper error at %q: %w", s.path(), err)
+		}
 	case sql.Scanner:
 		err := v.Scan(nil)
 		if err != nil {
@@ -1147,7 +1444,14 @@ func (s *valueScanner) setDefaultValue(dst interface{}) {
 		if err != nil {
 			_ = s.errorf(0, "json.Unmarshaler error: %w", err)
 		}
+	case encoding.TextUnmarshaler, encoding.BinaryUnmarshaler:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && !rv.IsNil() {
+			rv.Elem().SetZero()
+		}
 	default:
+		if s.trySetDefaultContainer(v) {
+			return
+		}
 		ok := s.trySetByteArray(v, false, true)
 		if !ok {
 			_ = s.errorf(0, "scan row failed: type %T is unknown", v)
@@ -1155,6 +1459,45 @@ func (s *valueScanner) setDefaultValue(dst interface{}) {
 	}
 }
 
+// nullItemType returns type of item of current NULL optional value
+func (s *valueScanner) nullItemType() *Ydb.Type {
+	t := s.stack.current().t
+	for isOptional(t) {
+		t = t.GetOptionalType().GetItem()
+	}
+
+	return t
+}
+
+// trySetDefaultContainer sets empty slice or map into destination of NULL list, set or dict value
+// and zero value into destination of NULL struct value if zeroStructForNull is enabled
+func (s *valueScanner) trySetDefaultContainer(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return false
+	}
+	rv = rv.Elem()
+	t := s.nullItemType()
+	switch {
+	case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() != reflect.Uint8 &&
+		(t.GetListType() != nil || t.GetDictType() != nil):
+		rv.Set(reflect.MakeSlice(rv.Type(), 0, 0))
+	case rv.Kind() == reflect.Map && t.GetDictType() != nil:
+		rv.Set(reflect.MakeMap(rv.Type()))
+	case rv.Kind() == reflect.Struct && t.GetStructType() != nil:
+		if !s.zeroStructForNull {
+			_ = s.errorf(0, "scan row failed: NULL struct at %q into %T requires zero struct for NULL option", s.path(), v)
+
+			return true
+		}
+		rv.SetZero()
+	default:
+		return false
+	}
+
+	return true
+}
+
 func (r *baseResult) SetErr(err error) {
 	r.errMtx.WithLock(func() {
 		r.err = err
@@ -1323,6 +1666,48 @@ func (s *scanStack) currentType() interface{} {
 	return nil
 }
 
+func isUUID(typ *Ydb.Type) bool {
+	for isOptional(typ) {
+		typ = typ.GetOptionalType().GetItem()
+	}
+
+	return typ.GetTypeId() == Ydb.Type_UUID
+}
+
+// isVariantOrTagged checks that typ (or item of optional typ) is variant or tagged type
+func isVariantOrTagged(typ *Ydb.Type) bool {
+	for isOptional(typ) {
+		typ = typ.GetOptionalType().GetItem()
+	}
+	switch typ.GetType().(type) {
+	case *Ydb.Type_VariantType, *Ydb.Type_TaggedType:
+		return true
+	default:
+		return false
+	}
+}
+
+// isValueDestination checks that v is a destination which receives value as is
+// (without unwrapping of variant and tagged values)
+func isValueDestination(v interface{}) bool {
+	switch v.(type) {
+	case *value.Value, *interface{}, scanner.Scanner:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNullableDestination checks that v is a destination which receives optional value as is
+// (including NULL values)
+func isNullableDestination(v interface{}) bool {
+	if _, ok := v.(sql.Scanner); ok {
+		return true
+	}
+
+	return isValueDestination(v)
+}
+
 func isOptional(typ *Ydb.Type) bool {
 	if typ == nil {
 		return false
@@ -1331,3 +1716,22 @@ func isOptional(typ *Ydb.Type) bool {
 
 	return yes
 }
+
+// isPointerToPointer checks that v is a destination of optional value such as **int64 or **[]string
+func isPointerToPointer(v interface{}) bool {
+	t := reflect.TypeOf(v)
+
+	return t != nil && t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Ptr
+}
+
+// isPointerToUnmarshaler checks that v is a double pointer to type which implements
+// encoding.TextUnmarshaler or encoding.BinaryUnmarshaler
+func isPointerToUnmarshaler(v interface{}) bool {
+	t := reflect.TypeOf(v)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Ptr {
+		return false
+	}
+
+	return t.Elem().Implements(reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()) ||
+		t.Elem().Implements(reflect.TypeOf((*encoding.BinaryUnmarshaler)(nil)).Elem())
+}
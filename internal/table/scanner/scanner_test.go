@@ -3,11 +3,14 @@ package scanner
 import (
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"math"
+	"reflect"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 
@@ -465,10 +468,11 @@ func valueFromPrimitiveTypeID(c *column, r xrand.Rand) (*Ydb.Value, interface{})
 		v := [16]byte{}
 		binary.BigEndian.PutUint64(v[0:8], uint64(rv))
 		binary.BigEndian.PutUint64(v[8:16], uint64(rv))
+		high, low := value.UUIDToHiLoPair(v)
 		ydbval := &Ydb.Value{
-			High_128: binary.BigEndian.Uint64(v[0:8]),
+			High_128: high,
 			Value: &Ydb.Value_Low_128{
-				Low_128: binary.BigEndian.Uint64(v[8:16]),
+				Low_128: low,
 			},
 		}
 		if c.optional && !c.testDefault {
@@ -777,3 +781,436 @@ func TestScanToJsonUnmarshaller(t *testing.T) {
 		}
 	}
 }
+
+func TestScanUUID(t *testing.T) {
+	id := uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff")
+	high, low := value.UUIDToHiLoPair(id)
+	set := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{{
+			Name: "id",
+			Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UUID}},
+		}, {
+			Name: "optional_id",
+			Type: &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+				Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UUID}},
+			}}},
+		}},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{
+				{High_128: high, Value: &Ydb.Value_Low_128{Low_128: low}},
+				{High_128: high, Value: &Ydb.Value_Low_128{Low_128: low}},
+			},
+		}},
+	}
+	for _, tt := range []struct {
+		name     string
+		required interface{}
+		optional interface{}
+		exp      interface{}
+	}{
+		{
+			name:     "[16]byte",
+			required: new([16]byte),
+			optional: new(*[16]byte),
+			exp:      [16]byte(id),
+		},
+		{
+			name:     "string",
+			required: new(string),
+			optional: new(*string),
+			exp:      id.String(),
+		},
+		{
+			name:     "uuid.UUID",
+			required: new(uuid.UUID),
+			optional: new(uuid.UUID),
+			exp:      id,
+		},
+		{
+			name:     "types.LegacyUUIDBytes",
+			required: new(types.LegacyUUIDBytes),
+			optional: new(*types.LegacyUUIDBytes),
+			exp:      types.LegacyUUIDBytes(value.BigEndianUint128(high, low)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := initScanner()
+			s.reset(set)
+			require.True(t, s.NextRow())
+			require.NoError(t, s.ScanNamed(
+				named.Required("id", tt.required),
+				named.Optional("optional_id", tt.optional),
+			))
+			deref := func(v interface{}) interface{} {
+				rv := reflect.ValueOf(v)
+				for rv.Kind() == reflect.Ptr {
+					rv = rv.Elem()
+				}
+
+				return rv.Interface()
+			}
+			require.Equal(t, tt.exp, deref(tt.required))
+			require.Equal(t, tt.exp, deref(tt.optional))
+		})
+	}
+}
+
+type textUnmarshaler struct {
+	text string
+}
+
+func (u *textUnmarshaler) UnmarshalText(text []byte) error {
+	u.text = string(text)
+
+	return nil
+}
+
+type binaryUnmarshaler struct {
+	data []byte
+}
+
+func (u *binaryUnmarshaler) UnmarshalBinary(data []byte) error {
+	u.data = append([]byte{}, data...)
+
+	return nil
+}
+
+func TestScanUnmarshalers(t *testing.T) {
+	textType := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}}
+	bytesType := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_STRING}}
+	optional := func(t *Ydb.Type) *Ydb.Type {
+		return &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: t}}}
+	}
+	set := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "text", Type: textType},
+			{Name: "bytes", Type: bytesType},
+			{Name: "optional_text", Type: optional(textType)},
+			{Name: "null_text", Type: optional(textType)},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{
+				{Value: &Ydb.Value_TextValue{TextValue: "text"}},
+				{Value: &Ydb.Value_BytesValue{BytesValue: []byte("bytes")}},
+				{Value: &Ydb.Value_TextValue{TextValue: "optional"}},
+				{Value: &Ydb.Value_NullFlagValue{}},
+			},
+		}},
+	}
+	s := initScanner()
+	s.reset(set)
+	require.True(t, s.NextRow())
+	var (
+		text         textUnmarshaler
+		bytes        binaryUnmarshaler
+		optionalText *textUnmarshaler
+		nullText     = &textUnmarshaler{text: "not null"}
+	)
+	require.NoError(t, s.ScanNamed(
+		named.Required("text", &text),
+		named.Required("bytes", &bytes),
+		named.Optional("optional_text", &optionalText),
+		named.Optional("null_text", &nullText),
+	))
+	require.Equal(t, "text", text.text)
+	require.Equal(t, []byte("bytes"), bytes.data)
+	require.NotNil(t, optionalText)
+	require.Equal(t, "optional", optionalText.text)
+	require.Nil(t, nullText)
+}
+
+func TestScanVariantAndTagged(t *testing.T) {
+	int32Type := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}}
+	textType := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}}
+	variantType := &Ydb.Type{Type: &Ydb.Type_VariantType{VariantType: &Ydb.VariantType{
+		Type: &Ydb.VariantType_TupleItems{TupleItems: &Ydb.TupleType{
+			Elements: []*Ydb.Type{int32Type, int32Type},
+		}},
+	}}}
+	taggedType := &Ydb.Type{Type: &Ydb.Type_TaggedType{TaggedType: &Ydb.TaggedType{
+		Tag:  "name",
+		Type: textType,
+	}}}
+	variantValue := &Ydb.Value{
+		Value:        &Ydb.Value_NestedValue{NestedValue: &Ydb.Value{Value: &Ydb.Value_Int32Value{Int32Value: 42}}},
+		VariantIndex: 1,
+	}
+	set := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "variant", Type: variantType},
+			{Name: "optional_variant", Type: &Ydb.Type{
+				Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: variantType}},
+			}},
+			{Name: "tagged", Type: taggedType},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{
+				variantValue,
+				variantValue,
+				{Value: &Ydb.Value_TextValue{TextValue: "test"}},
+			},
+		}},
+	}
+	t.Run("Direct", func(t *testing.T) {
+		s := initScanner()
+		s.reset(set)
+		require.True(t, s.NextRow())
+		var (
+			variant         int32
+			optionalVariant *int32
+			tagged          string
+		)
+		require.NoError(t, s.ScanNamed(
+			named.Required("variant", &variant),
+			named.Optional("optional_variant", &optionalVariant),
+			named.Required("tagged", &tagged),
+		))
+		require.EqualValues(t, 42, variant)
+		require.NotNil(t, optionalVariant)
+		require.EqualValues(t, 42, *optionalVariant)
+		require.Equal(t, "test", tagged)
+	})
+	t.Run("Value", func(t *testing.T) {
+		s := initScanner()
+		s.reset(set)
+		require.True(t, s.NextRow())
+		var (
+			variant types.Value
+			tagged  types.Value
+		)
+		require.NoError(t, s.ScanNamed(
+			named.Required("variant", &variant),
+			named.Required("tagged", &tagged),
+		))
+		_, idx, inner, err := types.VariantValue(variant)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, idx)
+		require.Equal(t, "42", inner.Yql())
+		tag, inner, err := types.TaggedItem(tagged)
+		require.NoError(t, err)
+		require.Equal(t, "name", tag)
+		require.Equal(t, `"test"u`, inner.Yql())
+	})
+	t.Run("Any", func(t *testing.T) {
+		s := initScanner()
+		s.reset(set)
+		require.True(t, s.NextRow())
+		var tagged interface{}
+		require.NoError(t, s.ScanNamed(
+			named.Required("tagged", &tagged),
+		))
+		require.Equal(t, "test", tagged)
+	})
+}
+
+func TestScanJSON(t *testing.T) {
+	type object struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	jsonType := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_JSON}}
+	documentType := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_JSON_DOCUMENT}}
+	optional := func(t *Ydb.Type) *Ydb.Type {
+		return &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: t}}}
+	}
+	set := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "json", Type: jsonType},
+			{Name: "document", Type: documentType},
+			{Name: "optional", Type: optional(jsonType)},
+			{Name: "null", Type: optional(jsonType)},
+			{Name: "invalid", Type: jsonType},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{
+				{Value: &Ydb.Value_TextValue{TextValue: `{"id":1,"name":"json"}`}},
+				{Value: &Ydb.Value_TextValue{TextValue: `{"id":2}`}},
+				{Value: &Ydb.Value_TextValue{TextValue: `{"id":3}`}},
+				{Value: &Ydb.Value_NullFlagValue{}},
+				{Value: &Ydb.Value_TextValue{TextValue: `{"id":`}},
+			},
+		}},
+	}
+	t.Run("Objects", func(t *testing.T) {
+		s := initScanner()
+		s.reset(set)
+		require.True(t, s.NextRow())
+		var (
+			jsonObject object
+			document   map[string]int
+			optional   *object
+			null       = &object{}
+		)
+		require.NoError(t, s.ScanNamed(
+			named.Required("json", &jsonObject),
+			named.Required("document", &document),
+			named.Optional("optional", &optional),
+			named.Optional("null", &null),
+		))
+		require.Equal(t, object{ID: 1, Name: "json"}, jsonObject)
+		require.Equal(t, map[string]int{"id": 2}, document)
+		require.Equal(t, &object{ID: 3}, optional)
+		require.Nil(t, null)
+	})
+	t.Run("RawMessage", func(t *testing.T) {
+		s := initScanner()
+		s.reset(set)
+		require.True(t, s.NextRow())
+		var raw json.RawMessage
+		require.NoError(t, s.ScanNamed(
+			named.Required("invalid", &raw),
+		))
+		require.Equal(t, json.RawMessage(`{"id":`), raw)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		s := initScanner()
+		s.reset(set)
+		require.True(t, s.NextRow())
+		var dst object
+		err := s.ScanNamed(
+			named.Required("invalid", &dst),
+		)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `"invalid"`)
+	})
+}
+
+func TestScanNamedColumns(t *testing.T) {
+	primitive := func(id Ydb.Type_PrimitiveTypeId) *Ydb.Type {
+		return &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: id}}
+	}
+	optional := func(t *Ydb.Type) *Ydb.Type {
+		return &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: t}}}
+	}
+	set := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "id", Type: primitive(Ydb.Type_UINT64)},
+			{Name: "created", Type: optional(primitive(Ydb.Type_TIMESTAMP))},
+			{Name: "title", Type: optional(primitive(Ydb.Type_UTF8))},
+		},
+		Rows: []*Ydb.Value{{
+			Items: []*Ydb.Value{
+				{Value: &Ydb.Value_Uint64Value{Uint64Value: 42}},
+				{Value: &Ydb.Value_Uint64Value{Uint64Value: 1000000}},
+				{Value: &Ydb.Value_NullFlagValue{}},
+			},
+		}},
+	}
+	newScanner := func(t *testing.T, strict bool) *valueScanner {
+		s := initScanner()
+		s.reset(set)
+		s.strictNamedScan = strict
+		require.True(t, s.NextRow())
+
+		return s
+	}
+	t.Run("MultipleDestinations", func(t *testing.T) {
+		var (
+			id      uint64
+			idCopy  uint64
+			created time.Time
+			ptr     *time.Time
+		)
+		require.NoError(t, newScanner(t, false).ScanNamed(
+			named.Required("id", &id),
+			named.Required("id", &idCopy),
+			named.Required("created", &created),
+			named.Optional("created", &ptr),
+		))
+		require.EqualValues(t, 42, id)
+		require.EqualValues(t, 42, idCopy)
+		require.Equal(t, value.TimestampToTime(1000000), created)
+		require.Equal(t, &created, ptr)
+	})
+	t.Run("RequiredNull", func(t *testing.T) {
+		var title string
+		err := newScanner(t, false).ScanNamed(
+			named.Required("title", &title),
+		)
+		require.ErrorContains(t, err, `scan column "title" of type Optional<Utf8> into *string failed`)
+		require.ErrorContains(t, err, "use named.Optional or named.OptionalWithDefault")
+	})
+	t.Run("TypeMismatch", func(t *testing.T) {
+		var created int32
+		err := newScanner(t, false).ScanNamed(
+			named.OptionalWithDefault("created", &created),
+		)
+		require.ErrorContains(t, err, `scan column "created" of type Optional<Timestamp> into *int32 failed`)
+	})
+	t.Run("Strict", func(t *testing.T) {
+		var (
+			id    uint64
+			title *string
+		)
+		err := newScanner(t, true).ScanNamed(
+			named.Required("id", &id),
+			named.Optional("title", &title),
+		)
+		require.ErrorContains(t, err, `columns ["created"] were not scanned`)
+		var created time.Time
+		require.NoError(t, newScanner(t, true).ScanNamed(
+			named.Required("id", &id),
+			named.OptionalWithDefault("created", &created),
+			named.Optional("title", &title),
+		))
+		require.NoError(t, newScanner(t, false).ScanNamed(
+			named.Required("id", &id),
+		))
+	})
+	t.Run("NotPointer", func(t *testing.T) {
+		var id uint64
+		require.Panics(t, func() {
+			_ = named.Required("id", id)
+		})
+	})
+}
+
+type valueMapperStub struct {
+	v value.Value
+}
+
+func (m *valueMapperStub) ScanYDBValue(v value.Value) error {
+	if value.UnwrapOptional(v) == nil {
+		return errors.New("unexpected NULL")
+	}
+	m.v = v
+
+	return nil
+}
+
+func TestScanValueMapper(t *testing.T) {
+	int32Type := &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_INT32}}
+	set := &Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{Name: "enum", Type: int32Type},
+			{Name: "optional_enum", Type: &Ydb.Type{
+				Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{Item: int32Type}},
+			}},
+		},
+		Rows: []*Ydb.Value{
+			{
+				Items: []*Ydb.Value{
+					{Value: &Ydb.Value_Int32Value{Int32Value: 1}},
+					{Value: &Ydb.Value_Int32Value{Int32Value: 2}},
+				},
+			},
+			{
+				Items: []*Ydb.Value{
+					{Value: &Ydb.Value_Int32Value{Int32Value: 1}},
+					{Value: &Ydb.Value_NullFlagValue{}},
+				},
+			},
+		},
+	}
+	s := initScanner()
+	s.reset(set)
+	require.True(t, s.NextRow())
+	var enum, optionalEnum valueMapperStub
+	require.NoError(t, s.Scan(&enum, &optionalEnum))
+	require.Equal(t, value.Int32Value(1), enum.v)
+	require.Equal(t, value.Int32Value(2), value.UnwrapOptional(optionalEnum.v))
+	require.True(t, s.NextRow())
+	err := s.Scan(&enum, &optionalEnum)
+	require.ErrorContains(t, err, "unexpected NULL")
+	require.ErrorContains(t, err, "optional_enum")
+}
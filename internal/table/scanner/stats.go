@@ -10,13 +10,12 @@ import (
 
 // queryStats holds query execution statistics.
 type queryStats struct {
-	stats          *Ydb_TableStats.QueryStats
-	processCPUTime time.Duration
-	pos            int
+	stats *Ydb_TableStats.QueryStats
+	pos   int
 }
 
 func (s *queryStats) ProcessCPUTime() time.Duration {
-	return s.processCPUTime
+	return time.Microsecond * time.Duration(s.stats.GetProcessCpuTimeUs())
 }
 
 func (s *queryStats) Compilation() (c *stats.CompilationStats) {
@@ -90,12 +89,22 @@ func (q *queryPhase) NextTableAccess() (t *stats.TableAccess, ok bool) {
 	x := q.tables[q.pos]
 	q.pos++
 
-	return &stats.TableAccess{
-		Name:    x.GetName(),
-		Reads:   initOperationStats(x.GetReads()),
-		Updates: initOperationStats(x.GetUpdates()),
-		Deletes: initOperationStats(x.GetDeletes()),
-	}, true
+	tableAccess := initTableAccess(x)
+
+	return &tableAccess, true
+}
+
+// TableAccess returns all accessed tables within query execution phase.
+func (q *queryPhase) TableAccess() []stats.TableAccess {
+	if len(q.tables) == 0 {
+		return nil
+	}
+	tables := make([]stats.TableAccess, 0, len(q.tables))
+	for _, x := range q.tables {
+		tables = append(tables, initTableAccess(x))
+	}
+
+	return tables
 }
 
 func (q *queryPhase) Duration() time.Duration {
@@ -114,6 +123,16 @@ func (q *queryPhase) IsLiteralPhase() bool {
 	return q.literalPhase
 }
 
+func initTableAccess(x *Ydb_TableStats.TableAccessStats) stats.TableAccess {
+	return stats.TableAccess{
+		Name:            x.GetName(),
+		Reads:           initOperationStats(x.GetReads()),
+		Updates:         initOperationStats(x.GetUpdates()),
+		Deletes:         initOperationStats(x.GetDeletes()),
+		PartitionsCount: x.GetPartitionsCount(),
+	}
+}
+
 func initOperationStats(x *Ydb_TableStats.OperationStats) stats.OperationStats {
 	if x == nil {
 		return stats.OperationStats{}
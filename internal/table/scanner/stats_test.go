@@ -0,0 +1,87 @@
+package scanner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
+)
+
+func TestQueryStatsPhases(t *testing.T) {
+	s := &queryStats{stats: &Ydb_TableStats.QueryStats{
+		QueryPlan: `{"Plan":{}}`,
+		QueryAst:  "(return)",
+		QueryPhases: []*Ydb_TableStats.QueryPhaseStats{
+			{
+				DurationUs:   10,
+				CpuTimeUs:    5,
+				LiteralPhase: true,
+			},
+			{
+				DurationUs:     100,
+				CpuTimeUs:      50,
+				AffectedShards: 3,
+				TableAccess: []*Ydb_TableStats.TableAccessStats{
+					{
+						Name:            "/local/series",
+						Reads:           &Ydb_TableStats.OperationStats{Rows: 10, Bytes: 1000},
+						PartitionsCount: 2,
+					},
+					{
+						Name:    "/local/episodes",
+						Updates: &Ydb_TableStats.OperationStats{Rows: 1, Bytes: 100},
+						Deletes: &Ydb_TableStats.OperationStats{Rows: 2, Bytes: 200},
+					},
+				},
+			},
+		},
+	}}
+	require.Equal(t, `{"Plan":{}}`, s.QueryPlan())
+	require.Equal(t, "(return)", s.QueryAST())
+
+	literal, ok := s.NextPhase()
+	require.True(t, ok)
+	require.True(t, literal.IsLiteralPhase())
+	require.Equal(t, 5*time.Microsecond, literal.CPUTime())
+	tables, err := stats.AllTableAccess(literal)
+	require.NoError(t, err)
+	require.Empty(t, tables)
+	_, ok = literal.NextTableAccess()
+	require.False(t, ok)
+
+	phase, ok := s.NextPhase()
+	require.True(t, ok)
+	require.False(t, phase.IsLiteralPhase())
+	require.Equal(t, 100*time.Microsecond, phase.Duration())
+	require.Equal(t, 50*time.Microsecond, phase.CPUTime())
+	require.EqualValues(t, 3, phase.AffectedShards())
+	expected := []stats.TableAccess{
+		{
+			Name:            "/local/series",
+			Reads:           stats.OperationStats{Rows: 10, Bytes: 1000},
+			PartitionsCount: 2,
+		},
+		{
+			Name:    "/local/episodes",
+			Updates: stats.OperationStats{Rows: 1, Bytes: 100},
+			Deletes: stats.OperationStats{Rows: 2, Bytes: 200},
+		},
+	}
+	tables, err = stats.AllTableAccess(phase)
+	require.NoError(t, err)
+	require.Equal(t, expected, tables)
+
+	// iteration with NextTableAccess does not affect AllTableAccess
+	table, ok := phase.NextTableAccess()
+	require.True(t, ok)
+	require.Equal(t, expected[0], *table)
+	tables, err = stats.AllTableAccess(phase)
+	require.NoError(t, err)
+	require.Equal(t, expected, tables)
+
+	_, ok = s.NextPhase()
+	require.False(t, ok)
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Formats"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"google.golang.org/grpc"
@@ -20,6 +22,8 @@ import (
 	balancerContext "github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/feature"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
@@ -54,6 +58,11 @@ type session struct {
 	statusMtx    sync.RWMutex
 	closeOnce    sync.Once
 	nodeID       atomic.Uint32
+	paramsTypes  *paramsTypesCache
+
+	errorsMtx         sync.Mutex
+	consecutiveErrors int
+	firstErrorAt      time.Time
 }
 
 func (s *session) LastUsage() time.Time {
@@ -288,14 +297,19 @@ func (s *session) CreateTable(
 				operation.ModeSync,
 			),
 		}
-		a = allocator.New()
+		a          = allocator.New()
+		partitions partitionsTracker
 	)
 	defer a.Free()
 	for _, opt := range opts {
 		if opt != nil {
 			opt.ApplyCreateTableOption((*options.CreateTableDesc)(&request), a)
+			partitions.track(&request)
 		}
 	}
+	if err = partitions.validate(&request); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
 	_, err = s.tableService.CreateTable(ctx, &request)
 	if err != nil {
 		return xerrors.WithStackTrace(err)
@@ -485,11 +499,64 @@ func (s *session) DropTable(
 
 func (s *session) checkError(err error) {
 	if err == nil {
+		s.resetErrors()
+
+		return
+	}
+	m := retry.Check(err)
+	if m.IsRetryObjectBusy() {
+		// busy session is returned to pool for reuse by next calls and SESSION_BUSY is not
+		// a sign of poisoned session
+		return
+	}
+	if m.IsRetryObjectValid() {
+		s.SetStatus(table.SessionClosing)
+
+		return
+	}
+	if !m.MustRetry(true) {
+		s.resetErrors()
+
 		return
 	}
-	if m := retry.Check(err); m.IsRetryObjectValid() {
+	if n := s.countError(); n > 0 {
+		// session is poisoned: it fails every request, but server does not report
+		// about invalid session, so session must be deleted instead of reuse
 		s.SetStatus(table.SessionClosing)
+		trace.TableOnPoolSessionQuarantine(s.config.Trace(), s, n, err)
+	}
+}
+
+func (s *session) resetErrors() {
+	s.errorsMtx.Lock()
+	defer s.errorsMtx.Unlock()
+
+	s.consecutiveErrors = 0
+}
+
+// countError counts consecutive retryable error of session and returns number of
+// consecutive errors if session must be quarantined or zero otherwise
+func (s *session) countError() int {
+	maxErrors := s.config.MaxConsecutiveErrors()
+	if maxErrors <= 0 {
+		return 0
+	}
+
+	s.errorsMtx.Lock()
+	defer s.errorsMtx.Unlock()
+
+	now := s.config.Clock().Now()
+	if s.consecutiveErrors == 0 || now.Sub(s.firstErrorAt) > s.config.ConsecutiveErrorsWindow() {
+		s.consecutiveErrors = 0
+		s.firstErrorAt = now
+	}
+	s.consecutiveErrors++
+
+	if s.consecutiveErrors < maxErrors {
+		return 0
 	}
+
+	return s.consecutiveErrors
 }
 
 // AlterTable modifies schema of table at given path with given options.
@@ -512,14 +579,76 @@ func (s *session) AlterTable(
 		a = allocator.New()
 	)
 	defer a.Free()
+	var (
+		operationID *string
+		runInterval *time.Duration
+	)
 	for _, opt := range opts {
 		if opt != nil {
 			opt.ApplyAlterTableOption((*options.AlterTableDesc)(&request), a)
+			if async, ok := opt.(interface{ OperationID() *string }); ok && async.OperationID() != nil {
+				operationID = async.OperationID()
+			}
+			if ttl, ok := opt.(interface{ TimeToLiveRunInterval() time.Duration }); ok {
+				interval := ttl.TimeToLiveRunInterval()
+				runInterval = &interval
+			}
 		}
 	}
-	_, err = s.tableService.AlterTable(ctx, &request)
+	if runInterval != nil {
+		if err = s.setTimeToLiveRunInterval(ctx, &request, *runInterval); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	if operationID == nil {
+		_, err = s.tableService.AlterTable(ctx, &request)
 
-	return xerrors.WithStackTrace(err)
+		return xerrors.WithStackTrace(err)
+	}
+
+	// connection wrapping treats not ready operation as error, but not ready operation is normal in async mode
+	response, err := s.tableService.AlterTable(conn.WithoutWrapping(ctx), &request)
+	if err != nil {
+		if xerrors.IsContextError(err) {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return xerrors.WithStackTrace(xerrors.Transport(err))
+	}
+	if op := response.GetOperation(); op.GetReady() && op.GetStatus() != Ydb.StatusIds_SUCCESS {
+		return xerrors.WithStackTrace(xerrors.Operation(xerrors.FromOperation(op)))
+	}
+	*operationID = response.GetOperation().GetId()
+
+	return nil
+}
+
+// setTimeToLiveRunInterval sets run interval into TTL settings of request.
+// If request has no TTL settings then current TTL settings of table are used.
+func (s *session) setTimeToLiveRunInterval(
+	ctx context.Context,
+	request *Ydb_Table.AlterTableRequest,
+	runInterval time.Duration,
+) error {
+	settings := request.GetSetTtlSettings()
+	if settings == nil && request.GetDropTtlSettings() == nil {
+		desc, err := s.DescribeTable(ctx, request.GetPath())
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		settings = desc.TimeToLiveSettings.ToYDB()
+		if settings != nil {
+			request.TtlAction = &Ydb_Table.AlterTableRequest_SetTtlSettings{
+				SetTtlSettings: settings,
+			}
+		}
+	}
+	if settings == nil {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %q", errNoTimeToLiveSettings, request.GetPath()))
+	}
+	settings.RunIntervalSeconds = uint32(runInterval.Seconds())
+
+	return nil
 }
 
 // CopyTable creates copy of table at given path.
@@ -666,7 +795,8 @@ func (s *session) Explain(
 		onDone   = trace.TableOnSessionQueryExplain(
 			s.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*session).Explain"),
-			s, query,
+			s, s.config.RedactQueryText(query),
+			fingerprint.QueryIf(s.config.Trace().OnSessionQueryExplain != nil, query),
 		)
 	)
 	defer func() {
@@ -706,6 +836,22 @@ func (s *session) Explain(
 	}, nil
 }
 
+// parameterTypes returns types of parameters declared in prepared query. Explain response does not contain
+// parameters, so types are taken from prepared statement
+func parameterTypes(stmt table.Statement) map[string]types.Type {
+	s, ok := stmt.(*statement)
+	if !ok {
+		return nil
+	}
+
+	parameterTypes := make(map[string]types.Type, len(s.params))
+	for name, t := range s.params {
+		parameterTypes[name] = types.TypeFromYDB(t)
+	}
+
+	return parameterTypes
+}
+
 // Prepare prepares data query within session s.
 func (s *session) Prepare(ctx context.Context, queryText string) (_ table.Statement, err error) {
 	var (
@@ -715,17 +861,22 @@ func (s *session) Prepare(ctx context.Context, queryText string) (_ table.Statem
 		onDone   = trace.TableOnSessionQueryPrepare(
 			s.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*session).Prepare"),
-			s, queryText,
+			s, s.config.RedactQueryText(queryText),
+			fingerprint.QueryIf(s.config.Trace().OnSessionQueryPrepare != nil, queryText),
 		)
 	)
 	defer func() {
 		if err != nil {
 			onDone(nil, err)
 		} else {
-			onDone(stmt.query, nil)
+			onDone(queryForTrace(s.config.RedactQueryText, stmt.query), nil)
 		}
 	}()
 
+	if err = checkQueryTextSize(queryText, s.config.MaxQueryTextSize(), 0); err != nil {
+		return nil, err
+	}
+
 	response, err = s.tableService.PrepareDataQuery(ctx,
 		&Ydb_Table.PrepareDataQueryRequest{
 			SessionId: s.id,
@@ -782,7 +933,7 @@ func (s *session) Execute(
 	request.Parameters = parameters.ToYDB(a)
 	request.Query = q.toYDB(a)
 	request.QueryCachePolicy = a.TableQueryCachePolicy()
-	request.QueryCachePolicy.KeepInCache = len(request.Parameters) > 0
+	request.QueryCachePolicy.KeepInCache = s.config.KeepInCache(len(request.Parameters) > 0)
 	request.OperationParams = operation.Params(ctx,
 		s.config.OperationTimeout(),
 		s.config.OperationCancelAfter(),
@@ -798,25 +949,60 @@ func (s *session) Execute(
 	onDone := trace.TableOnSessionQueryExecute(
 		s.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*session).Execute"),
-		s, q, parameters,
+		s, queryForTrace(s.config.RedactQueryText, q), parameters,
 		request.QueryCachePolicy.GetKeepInCache(),
+		request.GetOperationParams().GetOperationTimeout().AsDuration(),
+		fingerprint.QueryIf(s.config.Trace().OnSessionQueryExecute != nil, q.YQL()),
 	)
 	defer func() {
-		onDone(txr, false, r, err)
+		onDone(txr, false, r, compilationFromCache(r), err)
 	}()
 
-	result, err := s.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
+	if err = checkQueryTextSize(query, s.config.MaxQueryTextSize(), request.MaxQueryTextSize); err != nil {
+		return nil, nil, err
+	}
+
+	if request.ValidateParams {
+		declared, err := s.declaredParams(ctx, query)
+		if err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+		if err = checkParams(declared, parameters); err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+	}
+
+	result, warnings, err := s.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
 	if err != nil {
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
+	s.onWarnings(ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*session).Execute"),
+		q.YQL(), warnings,
+	)
+
+	return s.executeQueryResult(result, warnings, request.TxControl, request.IgnoreTruncated)
+}
+
+// compilationFromCache reports that compiled query was found in server query cache
+func compilationFromCache(r result.Result) bool {
+	if r == nil {
+		return false
+	}
+	s := r.Stats()
+	if s == nil {
+		return false
+	}
+	c := s.Compilation()
 
-	return s.executeQueryResult(result, request.TxControl, request.IgnoreTruncated)
+	return c != nil && c.FromCache
 }
 
 // executeQueryResult returns Transaction and result built from received
 // result.
 func (s *session) executeQueryResult(
 	res *Ydb_Table.ExecuteQueryResult,
+	warnings []*Ydb_Issue.IssueMessage,
 	txControl *Ydb_Table.TransactionControl,
 	ignoreTruncated bool,
 ) (
@@ -837,15 +1023,37 @@ func (s *session) executeQueryResult(
 		res.GetResultSets(),
 		res.GetQueryStats(),
 		scanner.WithIgnoreTruncated(ignoreTruncated),
+		scanner.WithStrictNamedScan(s.config.StrictNamedScan()),
+		scanner.WithZeroStructForNull(s.config.ZeroStructForNull()),
+		scanner.WithIssues(issues.FromYDB(warnings)),
 	), nil
 }
 
+// onWarnings reports non-fatal issues of successful response to trace and to warning handler
+func (s *session) onWarnings(
+	ctx context.Context, call stack.Caller, query string, warnings []*Ydb_Issue.IssueMessage,
+) {
+	if len(warnings) == 0 {
+		return
+	}
+	queryFingerprint := fingerprint.QueryIf(query != "" && s.config.Trace().OnSessionQueryWarnings != nil, query)
+	traceIssues := make([]trace.Issue, 0, len(warnings))
+	for _, issue := range warnings {
+		traceIssues = append(traceIssues, issue)
+	}
+	trace.TableOnSessionQueryWarnings(s.config.Trace(), &ctx, call, s, queryFingerprint, traceIssues)
+	if handler := s.config.WarningHandler(); handler != nil {
+		handler(ctx, issues.FromYDB(warnings))
+	}
+}
+
 // executeDataQuery executes data query.
 func (s *session) executeDataQuery(
 	ctx context.Context, a *allocator.Allocator, request *Ydb_Table.ExecuteDataQueryRequest,
 	callOptions ...grpc.CallOption,
 ) (
 	_ *Ydb_Table.ExecuteQueryResult,
+	warnings []*Ydb_Issue.IssueMessage,
 	err error,
 ) {
 	var (
@@ -855,15 +1063,15 @@ func (s *session) executeDataQuery(
 
 	response, err = s.tableService.ExecuteDataQuery(ctx, request, callOptions...)
 	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
 	err = response.GetOperation().GetResult().UnmarshalTo(result)
 	if err != nil {
-		return nil, xerrors.WithStackTrace(err)
+		return nil, nil, xerrors.WithStackTrace(err)
 	}
 
-	return result, nil
+	return result, response.GetOperation().GetIssues(), nil
 }
 
 // ExecuteSchemeQuery executes scheme query.
@@ -887,9 +1095,16 @@ func (s *session) ExecuteSchemeQuery(
 			opt((*options.ExecuteSchemeQueryDesc)(&request))
 		}
 	}
-	_, err = s.tableService.ExecuteSchemeQuery(ctx, &request)
+	response, err := s.tableService.ExecuteSchemeQuery(ctx, &request)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	s.onWarnings(ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*session).ExecuteSchemeQuery"),
+		query, response.GetOperation().GetIssues(),
+	)
 
-	return xerrors.WithStackTrace(err)
+	return nil
 }
 
 // DescribeTableOptions describes supported table options.
@@ -1025,6 +1240,15 @@ func (s *session) DescribeTableOptions(ctx context.Context) (
 	return desc, nil
 }
 
+// streamContext opts ctx of stream in checking of inactivity with timeout of table client (if defined) or driver
+func (s *session) streamContext(ctx context.Context) context.Context {
+	if timeout, ok := s.config.StreamInactivityTimeout(); ok {
+		return conn.WithStreamInactivityTimeout(ctx, timeout)
+	}
+
+	return conn.WithStreamInactivityCheck(ctx)
+}
+
 // StreamReadTable reads table at given path with given options.
 //
 // Note that given ctx controls the lifetime of the whole read, not only this
@@ -1052,13 +1276,14 @@ func (s *session) StreamReadTable(
 		onDone(xerrors.HideEOF(err))
 	}()
 
+	desc := options.ReadTableDesc{ReadTableRequest: &request}
 	for _, opt := range opts {
 		if opt != nil {
-			opt.ApplyReadTableOption((*options.ReadTableDesc)(&request), a)
+			opt.ApplyReadTableOption(&desc, a)
 		}
 	}
 
-	ctx, cancel := xcontext.WithCancel(ctx)
+	ctx, cancel := xcontext.WithCancel(s.streamContext(ctx))
 
 	stream, err = s.tableService.StreamReadTable(ctx, &request)
 	if err != nil {
@@ -1094,6 +1319,9 @@ func (s *session) StreamReadTable(
 			return err
 		},
 		scanner.WithIgnoreTruncated(true), // stream read table always returns truncated flag on last result set
+		scanner.WithStrictNamedScan(s.config.StrictNamedScan()),
+		scanner.WithZeroStructForNull(s.config.ZeroStructForNull()),
+		scanner.WithKeyColumns(desc.KeyColumns...),
 	)
 }
 
@@ -1137,6 +1365,8 @@ func (s *session) ReadRows(
 		[]*Ydb.ResultSet{response.GetResultSet()},
 		nil,
 		scanner.WithIgnoreTruncated(s.config.IgnoreTruncated()),
+		scanner.WithStrictNamedScan(s.config.StrictNamedScan()),
+		scanner.WithZeroStructForNull(s.config.ZeroStructForNull()),
 	), nil
 }
 
@@ -1157,7 +1387,8 @@ func (s *session) StreamExecuteScanQuery(
 		onDone = trace.TableOnSessionQueryStreamExecute(
 			s.config.Trace(), &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*session).StreamExecuteScanQuery"),
-			s, q, parameters,
+			s, queryForTrace(s.config.RedactQueryText, q), parameters,
+			fingerprint.QueryIf(s.config.Trace().OnSessionQueryStreamExecute != nil, query),
 		)
 		request = Ydb_Table.ExecuteScanQueryRequest{
 			Query:      q.toYDB(a),
@@ -1172,13 +1403,26 @@ func (s *session) StreamExecuteScanQuery(
 		onDone(xerrors.HideEOF(err))
 	}()
 
+	desc := options.ExecuteScanQueryDesc{ExecuteScanQueryRequest: &request}
 	for _, opt := range opts {
 		if opt != nil {
-			callOptions = append(callOptions, opt.ApplyExecuteScanQueryOption((*options.ExecuteScanQueryDesc)(&request))...)
+			callOptions = append(callOptions, opt.ApplyExecuteScanQueryOption(&desc)...)
 		}
 	}
+	if desc.IntermediateStatsHandler != nil &&
+		request.GetCollectStats() == Ydb_Table.QueryStatsCollection_STATS_COLLECTION_UNSPECIFIED {
+		request.CollectStats = Ydb_Table.QueryStatsCollection_STATS_COLLECTION_FULL
+	}
+
+	if err = checkQueryTextSize(query, s.config.MaxQueryTextSize(), desc.MaxQueryTextSize); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := xcontext.WithCancel(s.streamContext(ctx))
 
-	ctx, cancel := xcontext.WithCancel(ctx)
+	// rows of parts are decoded lazily with scan of result, so items of not scanned columns are not decoded
+	codec := scanner.NewLazyCodec()
+	callOptions = append(callOptions, grpc.ForceCodec(codec))
 
 	stream, err = s.tableService.StreamExecuteScanQuery(ctx, &request, callOptions...)
 	if err != nil {
@@ -1215,6 +1459,10 @@ func (s *session) StreamExecuteScanQuery(
 		},
 		scanner.WithIgnoreTruncated(s.config.IgnoreTruncated()),
 		scanner.WithMarkTruncatedAsRetryable(),
+		scanner.WithStrictNamedScan(s.config.StrictNamedScan()),
+		scanner.WithZeroStructForNull(s.config.ZeroStructForNull()),
+		scanner.WithStatsHandler(desc.IntermediateStatsHandler),
+		scanner.WithLazyRows(codec),
 	)
 }
 
@@ -1262,6 +1510,53 @@ func (s *session) BulkUpsert(ctx context.Context, table string, rows value.Value
 	return nil
 }
 
+// BulkUpsertArrow uploads given Apache Arrow record batch to the table.
+func (s *session) BulkUpsertArrow(ctx context.Context, table string, schema, data []byte,
+	opts ...options.BulkUpsertOption,
+) (err error) {
+	var (
+		callOptions []grpc.CallOption
+		onDone      = trace.TableOnSessionBulkUpsert(
+			s.config.Trace(), &ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*session).BulkUpsertArrow"),
+			s,
+		)
+	)
+	defer func() {
+		onDone(err)
+	}()
+
+	for _, opt := range opts {
+		if opt != nil {
+			callOptions = append(callOptions, opt.ApplyBulkUpsertOption()...)
+		}
+	}
+
+	_, err = s.tableService.BulkUpsert(ctx,
+		&Ydb_Table.BulkUpsertRequest{
+			Table: table,
+			DataFormat: &Ydb_Table.BulkUpsertRequest_ArrowBatchSettings{
+				ArrowBatchSettings: &Ydb_Formats.ArrowBatchSettings{
+					Schema: schema,
+				},
+			},
+			Data: data,
+			OperationParams: operation.Params(
+				ctx,
+				s.config.OperationTimeout(),
+				s.config.OperationCancelAfter(),
+				operation.ModeSync,
+			),
+		},
+		callOptions...,
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
 // BeginTransaction begins new transaction within given session with given
 // settings.
 func (s *session) BeginTransaction(
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,23 +13,32 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	commonConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestSessionKeepAlive(t *testing.T) {
@@ -892,3 +902,502 @@ func TestRenameTables(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionQueryTextRedaction(t *testing.T) {
+	const (
+		queryText = "SELECT secret FROM users"
+		redacted  = "<redacted>"
+	)
+	var common commonConfig.Common
+	commonConfig.SetQueryTextRedactor(&common, func(query string) string {
+		return redacted
+	})
+	var traced, fingerprints []string
+	cfg := config.New(
+		config.With(common),
+		config.WithTrace(&trace.Table{
+			OnSessionQueryPrepare: func(info trace.TablePrepareDataQueryStartInfo) func(trace.TablePrepareDataQueryDoneInfo) {
+				traced = append(traced, info.Query)
+				fingerprints = append(fingerprints, info.QueryFingerprint)
+
+				return func(info trace.TablePrepareDataQueryDoneInfo) {
+					traced = append(traced, info.Result.String())
+				}
+			},
+			OnSessionQueryExecute: func(info trace.TableExecuteDataQueryStartInfo) func(trace.TableExecuteDataQueryDoneInfo) {
+				traced = append(traced, info.Query.String())
+				fingerprints = append(fingerprints, info.QueryFingerprint)
+
+				return nil
+			},
+			OnSessionQueryExplain: func(info trace.TableExplainQueryStartInfo) func(trace.TableExplainQueryDoneInfo) {
+				traced = append(traced, info.Query)
+				fingerprints = append(fingerprints, info.QueryFingerprint)
+
+				return nil
+			},
+		}),
+	)
+	client := New(context.Background(), testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableExecuteDataQuery: func(interface{}) (proto.Message, error) {
+					return &Ydb_Table.ExecuteQueryResult{}, nil
+				},
+				testutil.TableExplainDataQuery: func(request interface{}) (result proto.Message, err error) {
+					return &Ydb_Table.ExplainQueryResult{}, nil
+				},
+				testutil.TablePrepareDataQuery: func(request interface{}) (result proto.Message, err error) {
+					return &Ydb_Table.PrepareQueryResult{QueryId: "id"}, nil
+				},
+			},
+		),
+	), config.New())
+	s := &session{
+		tableService: Ydb_Table_V1.NewTableServiceClient(client.cc),
+		config:       cfg,
+	}
+	ctx := xtest.Context(t)
+	_, err := s.Explain(ctx, queryText)
+	require.NoError(t, err)
+	_, _, err = s.Execute(ctx, table.TxControl(), queryText, table.NewQueryParameters())
+	require.NoError(t, err)
+	stmt, err := s.Prepare(ctx, queryText)
+	require.NoError(t, err)
+	require.Equal(t, queryText, stmt.Text())
+	_, _, err = stmt.Execute(ctx, table.TxControl(), table.NewQueryParameters())
+	require.NoError(t, err)
+	require.Equal(t, []string{redacted, redacted, redacted, redacted, redacted}, traced)
+	// fingerprint is computed from original query text
+	f := fingerprint.Query(queryText)
+	require.Equal(t, []string{f, f, f, f}, fingerprints)
+}
+
+func TestSessionExplainWithoutPrepare(t *testing.T) {
+	client := New(context.Background(), testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableExplainDataQuery: func(request interface{}) (result proto.Message, err error) {
+					return &Ydb_Table.ExplainQueryResult{QueryAst: "ast"}, nil
+				},
+				testutil.TablePrepareDataQuery: func(request interface{}) (result proto.Message, err error) {
+					t.Fatal("unexpected prepare of explained query")
+
+					return nil, nil
+				},
+			},
+		),
+	), config.New())
+	s := &session{
+		tableService: Ydb_Table_V1.NewTableServiceClient(client.cc),
+		config:       config.New(),
+	}
+	exp, err := s.Explain(xtest.Context(t), "SELECT 1")
+	require.NoError(t, err)
+	require.Equal(t, "ast", exp.AST)
+	require.Nil(t, exp.ParameterTypes)
+}
+
+func TestSessionAlterTableTimeToLiveRunInterval(t *testing.T) {
+	var (
+		ttl       *Ydb_Table.TtlSettings
+		described int
+		altered   *Ydb_Table.AlterTableRequest
+	)
+	client := New(context.Background(), testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableDescribeTable: func(interface{}) (proto.Message, error) {
+					described++
+
+					return &Ydb_Table.DescribeTableResult{
+						Self:        &Ydb_Scheme.Entry{Name: "table"},
+						TtlSettings: ttl,
+					}, nil
+				},
+				testutil.TableAlterTable: func(request interface{}) (proto.Message, error) {
+					altered = request.(*Ydb_Table.AlterTableRequest)
+
+					return &Ydb_Table.AlterTableResponse{}, nil
+				},
+			},
+		),
+	), config.New())
+	s := &session{
+		tableService: Ydb_Table_V1.NewTableServiceClient(client.cc),
+		config:       config.New(),
+	}
+	ctx := xtest.Context(t)
+	t.Run("CurrentSettings", func(t *testing.T) {
+		described, altered = 0, nil
+		settings := options.NewTTLSettings().ColumnSeconds("expire_at").ExpireAfter(time.Hour)
+		ttl = settings.ToYDB()
+		err := s.AlterTable(ctx, "table", options.WithSetTimeToLiveRunInterval(time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, 1, described)
+		require.Equal(t, "expire_at", altered.GetSetTtlSettings().GetValueSinceUnixEpoch().GetColumnName())
+		require.EqualValues(t, 3600, altered.GetSetTtlSettings().GetValueSinceUnixEpoch().GetExpireAfterSeconds())
+		require.EqualValues(t, 60, altered.GetSetTtlSettings().GetRunIntervalSeconds())
+	})
+	t.Run("NewSettings", func(t *testing.T) {
+		described, altered = 0, nil
+		err := s.AlterTable(ctx, "table",
+			options.WithSetTimeToLiveRunInterval(time.Minute),
+			options.WithSetTimeToLiveSettings(options.NewTTLSettings().ColumnDateType("created_at")),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 0, described)
+		require.Equal(t, "created_at", altered.GetSetTtlSettings().GetDateTypeColumn().GetColumnName())
+		require.EqualValues(t, 60, altered.GetSetTtlSettings().GetRunIntervalSeconds())
+	})
+	t.Run("NoSettings", func(t *testing.T) {
+		described, altered = 0, nil
+		ttl = nil
+		err := s.AlterTable(ctx, "table", options.WithSetTimeToLiveRunInterval(time.Minute))
+		require.ErrorIs(t, err, errNoTimeToLiveSettings)
+		require.Nil(t, altered)
+	})
+}
+
+func TestSessionExecuteParamsValidation(t *testing.T) {
+	var prepared, executed int
+	client := New(context.Background(), testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableExecuteDataQuery: func(interface{}) (proto.Message, error) {
+					executed++
+
+					return &Ydb_Table.ExecuteQueryResult{}, nil
+				},
+				testutil.TablePrepareDataQuery: func(request interface{}) (result proto.Message, err error) {
+					prepared++
+
+					return &Ydb_Table.PrepareQueryResult{
+						QueryId: "id",
+						ParametersTypes: map[string]*Ydb.Type{
+							"$id": {Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+						},
+					}, nil
+				},
+			},
+		),
+	), config.New())
+	s := &session{
+		tableService: Ydb_Table_V1.NewTableServiceClient(client.cc),
+		config:       config.New(),
+		paramsTypes:  newParamsTypesCache(paramsTypesCacheCapacity),
+	}
+	ctx := xtest.Context(t)
+	const query = "DECLARE $id AS Uint64; SELECT $id"
+	_, _, err := s.Execute(ctx, table.TxControl(), query,
+		table.NewQueryParameters(table.ValueParam("$id", value.Int32Value(1))),
+		options.WithParamsValidation(),
+	)
+	require.ErrorIs(t, err, table.ErrParamsMismatch)
+	require.Contains(t, err.Error(), "$id: declared Uint64, provided Int32")
+	require.Equal(t, 0, executed)
+	_, _, err = s.Execute(ctx, table.TxControl(), query,
+		table.NewQueryParameters(table.ValueParam("$id", value.Uint64Value(1))),
+		options.WithParamsValidation(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, executed)
+	require.Equal(t, 1, prepared)
+}
+
+func TestSessionExecuteQueryCachePolicy(t *testing.T) {
+	withParams := table.NewQueryParameters(table.ValueParam("$a", value.Uint64Value(1)))
+	for _, tt := range []struct {
+		name        string
+		cfg         []config.Option
+		params      *params.Parameters
+		opts        []options.ExecuteDataQueryOption
+		keepInCache bool
+	}{
+		{
+			name:        "NoParams",
+			params:      table.NewQueryParameters(),
+			keepInCache: false,
+		},
+		{
+			name:        "WithParams",
+			params:      withParams,
+			keepInCache: true,
+		},
+		{
+			name:        "DefaultKeepInCache",
+			cfg:         []config.Option{config.WithKeepInCache(true)},
+			params:      table.NewQueryParameters(),
+			keepInCache: true,
+		},
+		{
+			name:        "DefaultNotKeepInCache",
+			cfg:         []config.Option{config.WithKeepInCache(false)},
+			params:      withParams,
+			keepInCache: false,
+		},
+		{
+			name:        "ExplicitOverDefault",
+			cfg:         []config.Option{config.WithKeepInCache(false)},
+			params:      withParams,
+			opts:        []options.ExecuteDataQueryOption{options.WithKeepInCache(true)},
+			keepInCache: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				keepInCache  bool
+				tracedKeep   bool
+				tracedCached bool
+			)
+			cfg := config.New(append(tt.cfg, config.WithTrace(&trace.Table{
+				OnSessionQueryExecute: func(
+					info trace.TableExecuteDataQueryStartInfo,
+				) func(trace.TableExecuteDataQueryDoneInfo) {
+					tracedKeep = info.KeepInCache
+
+					return func(info trace.TableExecuteDataQueryDoneInfo) {
+						tracedCached = info.CompilationFromCache
+					}
+				},
+			}))...)
+			client := New(context.Background(), testutil.NewBalancer(
+				testutil.WithInvokeHandlers(
+					testutil.InvokeHandlers{
+						testutil.TableExecuteDataQuery: func(request interface{}) (proto.Message, error) {
+							r, ok := request.(*Ydb_Table.ExecuteDataQueryRequest)
+							if !ok {
+								return nil, fmt.Errorf("unexpected request type %T", request)
+							}
+							keepInCache = r.GetQueryCachePolicy().GetKeepInCache()
+
+							return &Ydb_Table.ExecuteQueryResult{
+								QueryStats: &Ydb_TableStats.QueryStats{
+									Compilation: &Ydb_TableStats.CompilationStats{FromCache: keepInCache},
+								},
+							}, nil
+						},
+					},
+				),
+			), config.New())
+			s := &session{
+				tableService: Ydb_Table_V1.NewTableServiceClient(client.cc),
+				config:       cfg,
+			}
+			_, res, err := s.Execute(xtest.Context(t), table.TxControl(), "SELECT 1", tt.params, tt.opts...)
+			require.NoError(t, err)
+			fromCache := res.Stats().Compilation().FromCache
+			require.Equal(t, tt.keepInCache, keepInCache)
+			require.Equal(t, tt.keepInCache, tracedKeep)
+			require.Equal(t, fromCache, tracedCached)
+			require.Equal(t, tt.keepInCache, fromCache)
+		})
+	}
+}
+
+func TestSessionMaxQueryTextSize(t *testing.T) {
+	query := "SELECT 1; -- " + strings.Repeat("x", 100)
+	for _, tt := range []struct {
+		name     string
+		cfg      []config.Option
+		opts     []options.ExecuteDataQueryOption
+		rejected bool
+	}{
+		{
+			name:     "Default",
+			rejected: false,
+		},
+		{
+			name:     "ConfigLimit",
+			cfg:      []config.Option{config.WithMaxQueryTextSize(100)},
+			rejected: true,
+		},
+		{
+			name:     "ConfigNoLimit",
+			cfg:      []config.Option{config.WithMaxQueryTextSize(0)},
+			rejected: false,
+		},
+		{
+			name:     "CallLimit",
+			opts:     []options.ExecuteDataQueryOption{options.WithMaxQueryTextSize(100)},
+			rejected: true,
+		},
+		{
+			name:     "CallOverridesConfig",
+			cfg:      []config.Option{config.WithMaxQueryTextSize(100)},
+			opts:     []options.ExecuteDataQueryOption{options.WithMaxQueryTextSize(1000)},
+			rejected: false,
+		},
+		{
+			name:     "CallNoLimit",
+			cfg:      []config.Option{config.WithMaxQueryTextSize(100)},
+			opts:     []options.ExecuteDataQueryOption{options.WithMaxQueryTextSize(0)},
+			rejected: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var executed, prepared int
+			client := New(context.Background(), testutil.NewBalancer(
+				testutil.WithInvokeHandlers(
+					testutil.InvokeHandlers{
+						testutil.TableExecuteDataQuery: func(interface{}) (proto.Message, error) {
+							executed++
+
+							return &Ydb_Table.ExecuteQueryResult{}, nil
+						},
+						testutil.TablePrepareDataQuery: func(interface{}) (proto.Message, error) {
+							prepared++
+
+							return &Ydb_Table.PrepareQueryResult{}, nil
+						},
+					},
+				),
+			), config.New())
+			s := &session{
+				tableService: Ydb_Table_V1.NewTableServiceClient(client.cc),
+				config:       config.New(tt.cfg...),
+			}
+			ctx := xtest.Context(t)
+			_, _, err := s.Execute(ctx, table.TxControl(), query, table.NewQueryParameters(), tt.opts...)
+			if !tt.rejected {
+				require.NoError(t, err)
+				require.Equal(t, 1, executed)
+
+				return
+			}
+			require.ErrorIs(t, err, table.ErrQueryTextTooLarge)
+			require.Contains(t, err.Error(), fmt.Sprintf("size of query text is %d bytes, limit is 100 bytes", len(query)))
+			require.Nil(t, xerrors.RetryableError(err))
+			require.Equal(t, 0, executed)
+
+			if len(tt.opts) == 0 {
+				_, err = s.Prepare(ctx, query)
+				require.ErrorIs(t, err, table.ErrQueryTextTooLarge)
+				require.Equal(t, 0, prepared)
+
+				_, err = s.StreamExecuteScanQuery(ctx, query, table.NewQueryParameters())
+				require.ErrorIs(t, err, table.ErrQueryTextTooLarge)
+			}
+		})
+	}
+}
+
+// warningsServiceStub responds successfully with non-fatal issues
+type warningsServiceStub struct {
+	Ydb_Table_V1.TableServiceClient
+
+	issues []*Ydb_Issue.IssueMessage
+}
+
+func (s *warningsServiceStub) operation(t proto.Message) *Ydb_Operations.Operation {
+	result, err := anypb.New(t)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Ydb_Operations.Operation{
+		Ready:  true,
+		Status: Ydb.StatusIds_SUCCESS,
+		Issues: s.issues,
+		Result: result,
+	}
+}
+
+func (s *warningsServiceStub) ExecuteDataQuery(
+	context.Context, *Ydb_Table.ExecuteDataQueryRequest, ...grpc.CallOption,
+) (*Ydb_Table.ExecuteDataQueryResponse, error) {
+	return &Ydb_Table.ExecuteDataQueryResponse{
+		Operation: s.operation(&Ydb_Table.ExecuteQueryResult{TxMeta: &Ydb_Table.TransactionMeta{Id: "tx"}}),
+	}, nil
+}
+
+func (s *warningsServiceStub) CommitTransaction(
+	context.Context, *Ydb_Table.CommitTransactionRequest, ...grpc.CallOption,
+) (*Ydb_Table.CommitTransactionResponse, error) {
+	return &Ydb_Table.CommitTransactionResponse{
+		Operation: s.operation(&Ydb_Table.CommitTransactionResult{}),
+	}, nil
+}
+
+func (s *warningsServiceStub) ExecuteSchemeQuery(
+	context.Context, *Ydb_Table.ExecuteSchemeQueryRequest, ...grpc.CallOption,
+) (*Ydb_Table.ExecuteSchemeQueryResponse, error) {
+	return &Ydb_Table.ExecuteSchemeQueryResponse{
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Issues: s.issues,
+		},
+	}, nil
+}
+
+func TestSessionWarnings(t *testing.T) {
+	const (
+		dataQuery   = "SELECT CAST(1 AS Uint8)"
+		schemeQuery = "CREATE TABLE t (id Uint64, PRIMARY KEY (id))"
+	)
+	warnings := []issues.Issue{{
+		Code:     1107,
+		Message:  "type coercion",
+		Severity: 2,
+		Position: issues.Position{Row: 1, Column: 8},
+		Issues:   []issues.Issue{{Message: "implicit cast"}},
+	}}
+	ydbIssues := []*Ydb_Issue.IssueMessage{{
+		IssueCode: 1107,
+		Message:   "type coercion",
+		Severity:  2,
+		Position:  &Ydb_Issue.IssueMessage_Position{Row: 1, Column: 8},
+		Issues:    []*Ydb_Issue.IssueMessage{{Message: "implicit cast"}},
+	}}
+	t.Run("WithIssues", func(t *testing.T) {
+		var (
+			handled      [][]issues.Issue
+			fingerprints []string
+		)
+		s := &session{
+			id:           "session",
+			tableService: &warningsServiceStub{issues: ydbIssues},
+			config: config.New(
+				config.WithWarningHandler(func(ctx context.Context, issues []issues.Issue) {
+					handled = append(handled, issues)
+				}),
+				config.WithTrace(&trace.Table{
+					OnSessionQueryWarnings: func(info trace.TableSessionQueryWarningsInfo) {
+						require.Equal(t, "session", info.Session.ID())
+						require.Len(t, info.Issues, 1)
+						require.Equal(t, "type coercion", info.Issues[0].GetMessage())
+						fingerprints = append(fingerprints, info.QueryFingerprint)
+					},
+				}),
+			),
+		}
+		ctx := xtest.Context(t)
+		tx, res, err := s.Execute(ctx, table.TxControl(table.BeginTx()), dataQuery, table.NewQueryParameters())
+		require.NoError(t, err)
+		require.Equal(t, warnings, res.Issues())
+		res, err = tx.CommitTx(ctx)
+		require.NoError(t, err)
+		require.Equal(t, warnings, res.Issues())
+		require.NoError(t, s.ExecuteSchemeQuery(ctx, schemeQuery))
+
+		require.Equal(t, [][]issues.Issue{warnings, warnings, warnings}, handled)
+		require.Equal(t, []string{fingerprint.Query(dataQuery), "", fingerprint.Query(schemeQuery)}, fingerprints)
+	})
+	t.Run("WithoutIssues", func(t *testing.T) {
+		s := &session{
+			id:           "session",
+			tableService: &warningsServiceStub{},
+			config: config.New(
+				config.WithWarningHandler(func(ctx context.Context, issues []issues.Issue) {
+					t.Fatalf("unexpected warnings: %v", issues)
+				}),
+			),
+		}
+		ctx := xtest.Context(t)
+		_, res, err := s.Execute(ctx, table.TxControl(), dataQuery, table.NewQueryParameters())
+		require.NoError(t, err)
+		require.Nil(t, res.Issues())
+		require.NoError(t, s.ExecuteSchemeQuery(ctx, schemeQuery))
+	})
+}
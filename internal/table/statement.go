@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -47,7 +48,7 @@ func (s *statement) Execute(
 	request.Parameters = parameters.ToYDB(a)
 	request.Query = s.query.toYDB(a)
 	request.QueryCachePolicy = a.TableQueryCachePolicy()
-	request.QueryCachePolicy.KeepInCache = len(request.Parameters) > 0
+	request.QueryCachePolicy.KeepInCache = s.session.config.KeepInCache(len(request.Parameters) > 0)
 	request.OperationParams = operation.Params(ctx,
 		s.session.config.OperationTimeout(),
 		s.session.config.OperationCancelAfter(),
@@ -63,13 +64,21 @@ func (s *statement) Execute(
 	onDone := trace.TableOnSessionQueryExecute(
 		s.session.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*statement).Execute"),
-		s.session, s.query, parameters,
+		s.session, queryForTrace(s.session.config.RedactQueryText, s.query), parameters,
 		request.QueryCachePolicy.GetKeepInCache(),
+		request.GetOperationParams().GetOperationTimeout().AsDuration(),
+		fingerprint.QueryIf(s.session.config.Trace().OnSessionQueryExecute != nil, s.query.YQL()),
 	)
 	defer func() {
-		onDone(txr, true, r, err)
+		onDone(txr, true, r, compilationFromCache(r), err)
 	}()
 
+	if request.ValidateParams {
+		if err = checkParams(s.params, parameters); err != nil {
+			return nil, nil, xerrors.WithStackTrace(err)
+		}
+	}
+
 	return s.execute(ctx, a, &request, request.TxControl, callOptions...)
 }
 
@@ -81,12 +90,16 @@ func (s *statement) execute(
 ) (
 	txr table.Transaction, r result.Result, err error,
 ) {
-	res, err := s.session.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
+	res, warnings, err := s.session.executeDataQuery(ctx, a, request.ExecuteDataQueryRequest, callOptions...)
 	if err != nil {
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
+	s.session.onWarnings(ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*statement).Execute"),
+		s.query.YQL(), warnings,
+	)
 
-	return s.session.executeQueryResult(res, txControl, request.IgnoreTruncated)
+	return s.session.executeQueryResult(res, warnings, txControl, request.IgnoreTruncated)
 }
 
 func (s *statement) NumInput() int {
@@ -8,6 +8,8 @@ import (
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -16,6 +18,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
@@ -55,6 +58,29 @@ func (tx *transaction) ID() string {
 	return tx.id
 }
 
+// commitTx reports whether execution with opts commits transaction
+func (tx *transaction) commitTx(opts []options.ExecuteDataQueryOption) bool {
+	if tx.control.Desc().GetCommitTx() {
+		return true
+	}
+
+	a := allocator.New()
+	defer a.Free()
+
+	desc := options.ExecuteDataQueryDesc{
+		ExecuteDataQueryRequest: &Ydb_Table.ExecuteDataQueryRequest{
+			TxControl: &Ydb_Table.TransactionControl{},
+		},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyExecuteDataQueryOption(&desc, a)
+		}
+	}
+
+	return desc.GetTxControl().GetCommitTx()
+}
+
 // Execute executes query represented by text within transaction tx.
 func (tx *transaction) Execute(
 	ctx context.Context,
@@ -64,7 +90,8 @@ func (tx *transaction) Execute(
 	onDone := trace.TableOnTxExecute(
 		tx.s.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*transaction).Execute"),
-		tx.s, tx, queryFromText(query), parameters,
+		tx.s, tx, queryForTrace(tx.s.config.RedactQueryText, queryFromText(query)), parameters,
+		tx.commitTx(opts), fingerprint.QueryIf(tx.s.config.Trace().OnTxExecute != nil, query),
 	)
 	defer func() {
 		onDone(r, err)
@@ -101,7 +128,9 @@ func (tx *transaction) ExecuteStatement(
 	onDone := trace.TableOnTxExecuteStatement(
 		tx.s.config.Trace(), &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*transaction).ExecuteStatement"),
-		tx.s, tx, stmt.(*statement).query, parameters,
+		tx.s, tx, queryForTrace(tx.s.config.RedactQueryText, stmt.(*statement).query), parameters,
+		tx.commitTx(opts),
+		fingerprint.QueryIf(tx.s.config.Trace().OnTxExecuteStatement != nil, stmt.(*statement).query.YQL()),
 	)
 	defer func() {
 		onDone(r, err)
@@ -137,7 +166,11 @@ func (tx *transaction) CommitTx(
 		tx.s, tx,
 	)
 	defer func() {
-		onDone(err)
+		var queryStats stats.QueryStats
+		if r != nil {
+			queryStats = r.Stats()
+		}
+		onDone(queryStats, err)
 	}()
 
 	switch tx.state.Load() {
@@ -179,10 +212,19 @@ func (tx *transaction) CommitTx(
 
 		tx.state.Store(txStateCommitted)
 
+		warnings := response.GetOperation().GetIssues()
+		tx.s.onWarnings(ctx,
+			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/table.(*transaction).CommitTx"),
+			"", warnings,
+		)
+
 		return scanner.NewUnary(
 			nil,
 			result.GetQueryStats(),
 			scanner.WithIgnoreTruncated(tx.s.config.IgnoreTruncated()),
+			scanner.WithStrictNamedScan(tx.s.config.StrictNamedScan()),
+			scanner.WithZeroStructForNull(tx.s.config.ZeroStructForNull()),
+			scanner.WithIssues(issues.FromYDB(warnings)),
 		), nil
 	}
 }
@@ -3,15 +3,23 @@ package table
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestTxSkipRollbackForCommitted(t *testing.T) {
@@ -125,3 +133,145 @@ func TestTxSkipRollbackForCommitted(t *testing.T) {
 		}
 	}
 }
+
+func TestTxCommitQueryStats(t *testing.T) {
+	ctx := xtest.Context(t)
+	var traceStats stats.QueryStats
+	s, err := newSession(ctx,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableBeginTransaction: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.BeginTransactionResult{
+							TxMeta: &Ydb_Table.TransactionMeta{
+								Id: "tx",
+							},
+						}, nil
+					},
+					testutil.TableCommitTransaction: func(request interface{}) (proto.Message, error) {
+						r, ok := request.(*Ydb_Table.CommitTransactionRequest)
+						require.True(t, ok)
+						if r.GetCollectStats() == Ydb_Table.QueryStatsCollection_STATS_COLLECTION_UNSPECIFIED {
+							return &Ydb_Table.CommitTransactionResult{}, nil
+						}
+
+						return &Ydb_Table.CommitTransactionResult{
+							QueryStats: &Ydb_TableStats.QueryStats{
+								TotalDurationUs:  300,
+								TotalCpuTimeUs:   200,
+								ProcessCpuTimeUs: 100,
+							},
+						}, nil
+					},
+				},
+			),
+		),
+		config.New(config.WithTrace(&trace.Table{
+			OnTxCommit: func(info trace.TableTxCommitStartInfo) func(trace.TableTxCommitDoneInfo) {
+				return func(info trace.TableTxCommitDoneInfo) {
+					traceStats = info.QueryStats
+				}
+			},
+		})),
+	)
+	require.NoError(t, err)
+	t.Run("WithoutStats", func(t *testing.T) {
+		tx, err := s.BeginTransaction(ctx, table.TxSettings())
+		require.NoError(t, err)
+		r, err := tx.CommitTx(ctx)
+		require.NoError(t, err)
+		require.Nil(t, r.Stats())
+		require.Nil(t, traceStats)
+	})
+	t.Run("WithStats", func(t *testing.T) {
+		tx, err := s.BeginTransaction(ctx, table.TxSettings())
+		require.NoError(t, err)
+		r, err := tx.CommitTx(ctx, options.WithCommitCollectStats(options.CollectStatsModeBasic))
+		require.NoError(t, err)
+		for _, queryStats := range []stats.QueryStats{r.Stats(), traceStats} {
+			require.NotNil(t, queryStats)
+			require.Equal(t, 300*time.Microsecond, queryStats.TotalDuration())
+			require.Equal(t, 200*time.Microsecond, queryStats.TotalCPUTime())
+			require.Equal(t, 100*time.Microsecond, queryStats.ProcessCPUTime())
+		}
+	})
+}
+
+func TestTxExecuteTraceCommitTx(t *testing.T) {
+	ctx := xtest.Context(t)
+	var (
+		requestCommitTx bool
+		tracedCommitTx  bool
+		tracedTxID      string
+	)
+	s, err := newSession(ctx,
+		testutil.NewBalancer(
+			testutil.WithInvokeHandlers(
+				testutil.InvokeHandlers{
+					testutil.TableCreateSession: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.CreateSessionResult{
+							SessionId: testutil.SessionID(),
+						}, nil
+					},
+					testutil.TableBeginTransaction: func(interface{}) (proto.Message, error) {
+						return &Ydb_Table.BeginTransactionResult{
+							TxMeta: &Ydb_Table.TransactionMeta{
+								Id: "tx",
+							},
+						}, nil
+					},
+					testutil.TableExecuteDataQuery: func(request interface{}) (proto.Message, error) {
+						r, ok := request.(*Ydb_Table.ExecuteDataQueryRequest)
+						require.True(t, ok)
+						requestCommitTx = r.GetTxControl().GetCommitTx()
+
+						return &Ydb_Table.ExecuteQueryResult{
+							TxMeta: &Ydb_Table.TransactionMeta{
+								Id: "tx",
+							},
+						}, nil
+					},
+				},
+			),
+		),
+		config.New(config.WithTrace(&trace.Table{
+			OnTxExecute: func(info trace.TableTransactionExecuteStartInfo) func(trace.TableTransactionExecuteDoneInfo) {
+				tracedCommitTx = info.CommitTx
+				tracedTxID = info.Tx.ID()
+
+				return nil
+			},
+		})),
+	)
+	require.NoError(t, err)
+	for _, tt := range []struct {
+		name     string
+		opts     []options.ExecuteDataQueryOption
+		commitTx bool
+	}{
+		{
+			name:     "WithoutCommit",
+			commitTx: false,
+		},
+		{
+			name:     "WithCommit",
+			opts:     []options.ExecuteDataQueryOption{options.WithCommit()},
+			commitTx: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := s.BeginTransaction(ctx, table.TxSettings())
+			require.NoError(t, err)
+			_, err = tx.Execute(ctx, "SELECT 1", table.NewQueryParameters(), tt.opts...)
+			require.NoError(t, err)
+			require.Equal(t, tt.commitTx, requestCommitTx)
+			require.Equal(t, tt.commitTx, tracedCommitTx)
+			require.Equal(t, "tx", tracedTxID)
+		})
+	}
+}
@@ -16,6 +16,7 @@ func NewTimeToLiveSettings(settings *Ydb_Table.TtlSettings) *options.TimeToLiveS
 			ColumnName:         mode.DateTypeColumn.GetColumnName(),
 			ExpireAfterSeconds: mode.DateTypeColumn.GetExpireAfterSeconds(),
 			Mode:               options.TimeToLiveModeDateType,
+			RunIntervalSeconds: settings.GetRunIntervalSeconds(),
 		}
 
 	case *Ydb_Table.TtlSettings_ValueSinceUnixEpoch:
@@ -24,6 +25,7 @@ func NewTimeToLiveSettings(settings *Ydb_Table.TtlSettings) *options.TimeToLiveS
 			ColumnUnit:         timeToLiveUnit(mode.ValueSinceUnixEpoch.GetColumnUnit()),
 			ExpireAfterSeconds: mode.ValueSinceUnixEpoch.GetExpireAfterSeconds(),
 			Mode:               options.TimeToLiveModeValueSinceUnixEpoch,
+			RunIntervalSeconds: settings.GetRunIntervalSeconds(),
 		}
 	}
 
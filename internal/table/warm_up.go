@@ -0,0 +1,115 @@
+package table
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// warmUpConcurrency limits number of concurrent create session requests of warm-up
+const warmUpConcurrency = 4
+
+// internalPoolWarmUp starts background creation of sessions until pool contains n sessions.
+// Failed create session requests are retried with backoff, warm-up stops on close of Client
+func (c *Client) internalPoolWarmUp(ctx context.Context, n int) {
+	workers := warmUpConcurrency
+	if n < workers {
+		workers = n
+	}
+
+	c.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer c.wg.Done()
+			c.internalPoolWarmUpWorker(ctx, n)
+		}()
+	}
+}
+
+func (c *Client) internalPoolWarmUpWorker(ctx context.Context, n int) {
+	for attempt := 0; ; {
+		var needMore bool
+		c.mu.WithLock(func() {
+			needMore = !c.isClosed() && len(c.index)+c.warmUpInProgress < n
+			if needMore {
+				c.warmUpInProgress++
+			}
+		})
+		if !needMore {
+			return
+		}
+
+		s, err := c.internalPoolCreateSession(ctx)
+
+		c.mu.WithLock(func() {
+			c.warmUpInProgress--
+		})
+
+		if err == nil {
+			attempt = 0
+			_ = c.Put(ctx, s)
+
+			continue
+		}
+
+		attempt++
+		delay := backoff.Slow
+		if isCreateSessionErrorRetriable(err) {
+			delay = backoff.Fast
+		}
+
+		timer := c.clock.NewTimer(delay.Delay(attempt))
+		select {
+		case <-c.done:
+			timer.Stop()
+
+			return
+		case <-ctx.Done():
+			timer.Stop()
+
+			return
+		case <-timer.Chan():
+		}
+	}
+}
+
+// WaitUntilWarm waits until pool contains at least n sessions
+func (c *Client) WaitUntilWarm(ctx context.Context, n int) error {
+	if c == nil {
+		return xerrors.WithStackTrace(errNilClient)
+	}
+
+	for {
+		var (
+			size    int
+			limit   int
+			changed chan struct{}
+		)
+		c.mu.WithLock(func() {
+			size = len(c.index)
+			limit = c.limit
+			changed = c.changed
+		})
+
+		switch {
+		case c.isClosed():
+			return xerrors.WithStackTrace(errClosedClient)
+		case size >= n:
+			return nil
+		case n > limit:
+			return xerrors.WithStackTrace(fmt.Errorf("%w: cannot wait for %d sessions with size limit %d",
+				errSessionPoolOverflow, n, limit,
+			))
+		}
+
+		select {
+		case <-c.done:
+			return xerrors.WithStackTrace(errClosedClient)
+		case <-ctx.Done():
+			return xerrors.WithStackTrace(ctx.Err())
+		case <-changed:
+		}
+	}
+}
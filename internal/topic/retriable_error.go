@@ -18,7 +18,8 @@ const (
 )
 
 type RetrySettings struct {
-	StartTimeout time.Duration // Full retry timeout
+	StartTimeout time.Duration   // Full retry timeout
+	Backoff      backoff.Backoff // Backoff for retriable errors, if nil - backoff selected by error type
 	CheckError   PublicCheckErrorRetryFunction
 }
 
@@ -93,6 +94,10 @@ func CheckRetryMode(err error, settings RetrySettings, retriesDuration time.Dura
 		return nil, false
 	}
 
+	if settings.Backoff != nil {
+		return settings.Backoff, true
+	}
+
 	switch mode.BackoffType() {
 	case backoff.TypeFast:
 		return backoff.Fast, true
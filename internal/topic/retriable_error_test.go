@@ -215,6 +215,26 @@ func TestCheckRetryMode(t *testing.T) {
 			resBackoff:   nil,
 			resRetriable: false,
 		},
+		{
+			name: "RetryRetriableErrorWithCustomBackoff",
+			err:  slowError,
+			settings: RetrySettings{
+				Backoff: backoff.Fast,
+			},
+			duration:     0,
+			resBackoff:   backoff.Fast,
+			resRetriable: true,
+		},
+		{
+			name: "UnretriableErrorWithCustomBackoff",
+			err:  unretriable,
+			settings: RetrySettings{
+				Backoff: backoff.Fast,
+			},
+			duration:     0,
+			resBackoff:   nil,
+			resRetriable: false,
+		},
 		{
 			name:         "EOF", // Issue https://github.com/ydb-platform/ydb-go-sdk/issues/754
 			err:          fmt.Errorf("test wrap: %w", io.EOF),
@@ -2,16 +2,22 @@ package topicclientinternal
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
 	"google.golang.org/grpc"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawydb"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsync"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
@@ -20,11 +26,25 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+var (
+	errClientClosed      = xerrors.Wrap(errors.New("ydb: topic client closed"))
+	ErrPartitionNotFound = xerrors.Wrap(errors.New("ydb: topic partition not found"))
+
+	ErrMinActivePartitionsExceedsLimit = xerrors.Wrap(errors.New(
+		"ydb: min active partitions of topic exceeds partition count limit",
+	))
+)
+
 type Client struct {
 	cfg                    topic.Config
 	cred                   credentials.Credentials
 	defaultOperationParams rawydb.OperationParams
 	rawClient              rawtopic.Client
+
+	mu       xsync.Mutex
+	closed   bool
+	lastID   uint64
+	children map[uint64]func(ctx context.Context) error // close functions of started readers and writers
 }
 
 func New(
@@ -45,6 +65,7 @@ func New(
 		cred:                   cred,
 		defaultOperationParams: defaultOperationParams,
 		rawClient:              rawClient,
+		children:               make(map[uint64]func(ctx context.Context) error),
 	}
 }
 
@@ -62,10 +83,56 @@ func newTopicConfig(opts ...topicoptions.TopicOption) topic.Config {
 }
 
 // Close the client
-func (c *Client) Close(_ context.Context) error {
+//
+// Close stops start of new readers and writers and closes all readers and writers
+// started by client and not closed yet
+func (c *Client) Close(ctx context.Context) error {
+	var closes []func(ctx context.Context) error
+	c.mu.WithLock(func() {
+		c.closed = true
+		for _, f := range c.children {
+			closes = append(closes, f)
+		}
+		c.children = nil
+	})
+
+	var issues []error
+	for _, f := range closes {
+		if err := f(ctx); err != nil {
+			issues = append(issues, err)
+		}
+	}
+
+	if len(issues) > 0 {
+		return xerrors.WithStackTrace(xerrors.NewWithIssues("topic client close failed", issues...))
+	}
+
 	return nil
 }
 
+// addChild registers close function of started reader or writer.
+// Returns id for unregister child on close or error if client already closed
+func (c *Client) addChild(closeFunc func(ctx context.Context) error) (id uint64, err error) {
+	c.mu.WithLock(func() {
+		if c.closed {
+			err = xerrors.WithStackTrace(errClientClosed)
+
+			return
+		}
+		c.lastID++
+		id = c.lastID
+		c.children[id] = closeFunc
+	})
+
+	return id, err
+}
+
+func (c *Client) removeChild(id uint64) {
+	c.mu.WithLock(func() {
+		delete(c.children, id)
+	})
+}
+
 // Alter topic options
 func (c *Client) Alter(ctx context.Context, path string, opts ...topicoptions.AlterOption) error {
 	req := &rawtopic.AlterTopicRequest{}
@@ -76,6 +143,11 @@ func (c *Client) Alter(ctx context.Context, path string, opts ...topicoptions.Al
 			opt.ApplyAlterOption(req)
 		}
 	}
+	if s := req.AlterPartitionSettings; s.SetMinActivePartitions.HasValue && s.SetPartitionCountLimit.HasValue {
+		if err := checkPartitioning(s.SetMinActivePartitions.Value, s.SetPartitionCountLimit.Value); err != nil {
+			return err
+		}
+	}
 
 	call := func(ctx context.Context) error {
 		_, alterErr := c.rawClient.AlterTopic(ctx, req)
@@ -109,6 +181,11 @@ func (c *Client) Create(
 			opt.ApplyCreateOption(req)
 		}
 	}
+	if err := checkPartitioning(
+		req.PartitionSettings.MinActivePartitions, req.PartitionSettings.PartitionCountLimit,
+	); err != nil {
+		return err
+	}
 
 	call := func(ctx context.Context) error {
 		_, createErr := c.rawClient.CreateTopic(ctx, req)
@@ -127,6 +204,18 @@ func (c *Client) Create(
 	return call(ctx)
 }
 
+// checkPartitioning rejects min active partitions greater than partition count limit on client side.
+// Zero limit is not checked because it means default limit of server
+func checkPartitioning(minActivePartitions, partitionCountLimit int64) error {
+	if partitionCountLimit == 0 || minActivePartitions <= partitionCountLimit {
+		return nil
+	}
+
+	return xerrors.WithStackTrace(fmt.Errorf("%w: %d > %d",
+		ErrMinActivePartitionsExceedsLimit, minActivePartitions, partitionCountLimit,
+	))
+}
+
 // Describe topic
 func (c *Client) Describe(
 	ctx context.Context,
@@ -173,6 +262,100 @@ func (c *Client) Describe(
 	return res, nil
 }
 
+// DescribePartition describe partition of topic with location and offsets of messages
+func (c *Client) DescribePartition(
+	ctx context.Context,
+	path string,
+	partitionID int64,
+) (res topictypes.PartitionDescription, _ error) {
+	req := rawtopic.DescribeTopicRequest{
+		OperationParams: c.defaultOperationParams,
+		Path:            path,
+		IncludeStats:    true,
+	}
+
+	var rawRes rawtopic.DescribeTopicResult
+
+	call := func(ctx context.Context) (describeErr error) {
+		rawRes, describeErr = c.rawClient.DescribeTopic(ctx, req)
+
+		return describeErr
+	}
+
+	var err error
+
+	if c.cfg.AutoRetry() {
+		err = retry.Retry(ctx, call,
+			retry.WithIdempotent(true),
+			retry.WithTrace(c.cfg.TraceRetry()),
+			retry.WithBudget(c.cfg.RetryBudget()),
+		)
+	} else {
+		err = call(ctx)
+	}
+
+	if err != nil {
+		return res, err
+	}
+
+	partition, err := findPartition(&rawRes, path, partitionID)
+	if err != nil {
+		return res, err
+	}
+
+	res.FromRaw(partition)
+
+	return res, nil
+}
+
+// DescribeConsumer describe consumer of topic
+func (c *Client) DescribeConsumer(
+	ctx context.Context,
+	path string,
+	consumer string,
+	opts ...topicoptions.DescribeConsumerOption,
+) (res topictypes.TopicConsumerDescription, _ error) {
+	req := rawtopic.DescribeConsumerRequest{
+		OperationParams: c.defaultOperationParams,
+		Path:            path,
+		Consumer:        consumer,
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&req)
+		}
+	}
+
+	var rawRes rawtopic.DescribeConsumerResult
+
+	call := func(ctx context.Context) (describeErr error) {
+		rawRes, describeErr = c.rawClient.DescribeConsumer(ctx, req)
+
+		return describeErr
+	}
+
+	var err error
+
+	if c.cfg.AutoRetry() {
+		err = retry.Retry(ctx, call,
+			retry.WithIdempotent(true),
+			retry.WithTrace(c.cfg.TraceRetry()),
+			retry.WithBudget(c.cfg.RetryBudget()),
+		)
+	} else {
+		err = call(ctx)
+	}
+
+	if err != nil {
+		return res, err
+	}
+
+	res.FromRaw(&rawRes)
+
+	return res, nil
+}
+
 // Drop topic
 func (c *Client) Drop(ctx context.Context, path string, opts ...topicoptions.DropOption) error {
 	req := rawtopic.DropTopicRequest{}
@@ -202,6 +385,16 @@ func (c *Client) Drop(ctx context.Context, path string, opts ...topicoptions.Dro
 	return call(ctx)
 }
 
+// streamContext opts ctx of reader or writer stream in checking of inactivity with timeout of topic client
+// (if defined) or driver
+func (c *Client) streamContext(ctx context.Context) context.Context {
+	if timeout, ok := c.cfg.StreamInactivityTimeout(); ok {
+		return conn.WithStreamInactivityTimeout(ctx, timeout)
+	}
+
+	return conn.WithStreamInactivityCheck(ctx)
+}
+
 // StartReader create new topic reader and start pull messages from server
 // it is fast non block call, connection will start in background
 func (c *Client) StartReader(
@@ -212,7 +405,15 @@ func (c *Client) StartReader(
 	var connector topicreaderinternal.TopicSteamReaderConnect = func(ctx context.Context) (
 		topicreaderinternal.RawTopicReaderStream, error,
 	) {
-		return c.rawClient.StreamRead(ctx)
+		nodeID, err := c.selectedPartitionsNode(ctx, readSelectors)
+		if err != nil {
+			return nil, err
+		}
+		if nodeID != 0 {
+			ctx = balancer.WithEndpoint(ctx, nodeEndpoint(nodeID))
+		}
+
+		return c.rawClient.StreamRead(c.streamContext(ctx))
 	}
 
 	defaultOpts := []topicoptions.ReaderOption{
@@ -223,7 +424,23 @@ func (c *Client) StartReader(
 	}
 	opts = append(defaultOpts, opts...)
 
+	c.mu.Lock()
+	closed := c.closed
+	c.mu.Unlock()
+	if closed {
+		return nil, xerrors.WithStackTrace(errClientClosed)
+	}
+
 	internalReader := topicreaderinternal.NewReader(connector, consumer, readSelectors, opts...)
+	id, err := c.addChild(internalReader.Close)
+	if err != nil {
+		_ = internalReader.Close(context.Background())
+
+		return nil, err
+	}
+	internalReader.OnClose(func() {
+		c.removeChild(id)
+	})
 	trace.TopicOnReaderStart(internalReader.Tracer(), internalReader.ID(), consumer)
 
 	return topicreader.NewReader(internalReader), nil
@@ -235,7 +452,7 @@ func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption
 		topicwriterinternal.RawTopicWriterStream,
 		error,
 	) {
-		return c.rawClient.StreamWrite(ctx)
+		return c.rawClient.StreamWrite(c.streamContext(ctx))
 	}
 
 	options := []topicoptions.WriterOption{
@@ -247,10 +464,32 @@ func (c *Client) StartWriter(topicPath string, opts ...topicoptions.WriterOption
 
 	options = append(options, opts...)
 
+	c.mu.Lock()
+	closed := c.closed
+	c.mu.Unlock()
+	if closed {
+		return nil, xerrors.WithStackTrace(errClientClosed)
+	}
+
 	writer, err := topicwriterinternal.NewWriter(c.cred, options)
 	if err != nil {
 		return nil, err
 	}
+	id, err := c.addChild(func(ctx context.Context) error {
+		if err := writer.Close(ctx); err != nil && !topicwriterinternal.IsClosedError(err) {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		_ = writer.Close(context.Background())
+
+		return nil, err
+	}
+	writer.OnClose(func() {
+		c.removeChild(id)
+	})
 
 	return topicwriter.NewWriter(writer), nil
 }
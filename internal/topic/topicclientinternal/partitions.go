@@ -0,0 +1,79 @@
+package topicclientinternal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+)
+
+// nodeEndpoint is a preferred endpoint of balancer by node id
+type nodeEndpoint uint32
+
+func (id nodeEndpoint) NodeID() uint32 {
+	return uint32(id)
+}
+
+func findPartition(
+	description *rawtopic.DescribeTopicResult,
+	path string,
+	partitionID int64,
+) (*rawtopic.PartitionInfo, error) {
+	for i := range description.Partitions {
+		if description.Partitions[i].PartitionID == partitionID {
+			return &description.Partitions[i], nil
+		}
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("%w: partition %d of topic %q",
+		ErrPartitionNotFound, partitionID, path,
+	))
+}
+
+// selectedPartitionsNode checks that partitions of read selectors exist and returns id of node
+// which hosts all selected partitions. Zero node id is returned if selectors have no partitions,
+// if partitions are hosted by different nodes or if location of partitions is unknown
+func (c *Client) selectedPartitionsNode(ctx context.Context, selectors topicoptions.ReadSelectors) (uint32, error) {
+	var (
+		nodeID   uint32
+		located  bool // nodeID is a node of selected partitions
+		diffNode bool // selected partitions are hosted by different nodes
+	)
+	for _, selector := range selectors {
+		if len(selector.Partitions) == 0 {
+			// all partitions of topic are selected
+			diffNode = true
+
+			continue
+		}
+
+		description, err := c.rawClient.DescribeTopic(ctx, rawtopic.DescribeTopicRequest{
+			OperationParams: c.defaultOperationParams,
+			Path:            selector.Path,
+			IncludeStats:    true,
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		for _, id := range selector.Partitions {
+			partition, err := findPartition(&description, selector.Path, id)
+			if err != nil {
+				return 0, err
+			}
+			switch partitionNodeID := uint32(partition.PartitionStats.PartitionNodeID); {
+			case !located:
+				nodeID, located = partitionNodeID, true
+			case partitionNodeID != nodeID:
+				diffNode = true
+			}
+		}
+	}
+	if diffNode {
+		return 0, nil
+	}
+
+	return nodeID, nil
+}
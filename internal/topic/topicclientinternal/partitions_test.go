@@ -0,0 +1,195 @@
+package topicclientinternal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+)
+
+var errStreamRead = errors.New("stream read is not supported by test connection")
+
+// topicConn is a connection to topic service which describes topics with partitions hosted by given nodes
+type topicConn struct {
+	t *testing.T
+	// partitions contains node ids of partitions of topics
+	partitions map[string][]int32
+	// endpoints receives preferred endpoints of balancer for read streams
+	endpoints chan balancer.Endpoint
+}
+
+func (c *topicConn) Invoke(_ context.Context, _ string, args, reply interface{}, _ ...grpc.CallOption) error {
+	req := args.(*Ydb_Topic.DescribeTopicRequest) //nolint:forcetypeassert
+	nodes, has := c.partitions[req.GetPath()]
+	if !has {
+		return errors.New("topic not found")
+	}
+	require.True(c.t, req.GetIncludeStats())
+
+	result := &Ydb_Topic.DescribeTopicResult{
+		Self:                 &Ydb_Scheme.Entry{Name: req.GetPath()},
+		PartitioningSettings: &Ydb_Topic.PartitioningSettings{},
+	}
+	for i, nodeID := range nodes {
+		result.Partitions = append(result.Partitions, &Ydb_Topic.DescribeTopicResult_PartitionInfo{
+			PartitionId: int64(i),
+			Active:      true,
+			PartitionStats: &Ydb_Topic.PartitionStats{
+				PartitionOffsets: &Ydb_Topic.OffsetsRange{Start: 10, End: 20},
+				PartitionNodeId:  nodeID,
+			},
+		})
+	}
+	anyResult, err := anypb.New(result)
+	require.NoError(c.t, err)
+	proto.Merge(reply.(proto.Message), &Ydb_Topic.DescribeTopicResponse{ //nolint:forcetypeassert
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+			Result: anyResult,
+		},
+	})
+
+	return nil
+}
+
+func (c *topicConn) NewStream(
+	ctx context.Context, _ *grpc.StreamDesc, _ string, _ ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	endpoint, _ := balancer.ContextEndpoint(ctx)
+	select {
+	case c.endpoints <- endpoint:
+	default:
+	}
+
+	return nil, errStreamRead
+}
+
+func newTestClient(t *testing.T, partitions map[string][]int32) (*Client, *topicConn) {
+	conn := &topicConn{t: t, partitions: partitions, endpoints: make(chan balancer.Endpoint, 1)}
+
+	return New(context.Background(), conn, nil), conn
+}
+
+func TestDescribePartition(t *testing.T) {
+	c, _ := newTestClient(t, map[string][]int32{"topic": {1, 2}})
+	ctx := xtest.Context(t)
+
+	description, err := c.DescribePartition(ctx, "topic", 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, description.PartitionID)
+	require.True(t, description.Active)
+	require.EqualValues(t, 2, description.NodeID)
+	require.EqualValues(t, 10, description.StartOffset)
+	require.EqualValues(t, 20, description.EndOffset)
+
+	_, err = c.DescribePartition(ctx, "topic", 2)
+	require.ErrorIs(t, err, ErrPartitionNotFound)
+}
+
+func TestSelectedPartitionsNode(t *testing.T) {
+	c, conn := newTestClient(t, map[string][]int32{"a": {1, 1, 2}, "b": {1}})
+	for _, tt := range []struct {
+		name      string
+		selectors topicoptions.ReadSelectors
+		nodeID    uint32
+		err       error
+	}{
+		{
+			name:      "AllPartitions",
+			selectors: topicoptions.ReadTopic("a"),
+		},
+		{
+			name:      "OneNode",
+			selectors: topicoptions.ReadTopic("a").WithPartitions(0, 1),
+			nodeID:    1,
+		},
+		{
+			name:      "OneNodeOfTopics",
+			selectors: append(topicoptions.ReadTopic("a"), topicoptions.ReadTopic("b")...).WithPartitions(0),
+			nodeID:    1,
+		},
+		{
+			name:      "DifferentNodes",
+			selectors: topicoptions.ReadTopic("a").WithPartitions(1, 2),
+		},
+		{
+			name:      "PartitionsOfTopicAndAllPartitions",
+			selectors: append(topicoptions.ReadTopic("a").WithPartitions(0), topicoptions.ReadTopic("b")...),
+		},
+		{
+			name:      "PartitionNotFound",
+			selectors: topicoptions.ReadTopic("b").WithPartitions(0, 1),
+			err:       ErrPartitionNotFound,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			nodeID, err := c.selectedPartitionsNode(xtest.Context(t), tt.selectors)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.nodeID, nodeID)
+		})
+	}
+	t.Run("ReadStream", func(t *testing.T) {
+		reader, err := c.StartReader("consumer", topicoptions.ReadTopic("a").WithPartitions(2))
+		require.NoError(t, err)
+		defer func() {
+			_ = reader.Close(context.Background())
+		}()
+		// read stream is routed to node of partition
+		endpoint := <-conn.endpoints
+		require.NotNil(t, endpoint)
+		require.EqualValues(t, 2, endpoint.NodeID())
+	})
+}
+
+func TestCreateAlterPartitioningValidation(t *testing.T) {
+	c, _ := newTestClient(t, nil)
+	ctx := xtest.Context(t)
+
+	for _, tt := range []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "Create",
+			call: func() error {
+				return c.Create(ctx, "topic",
+					topicoptions.CreateWithMinActivePartitions(3),
+					topicoptions.CreateWithPartitionCountLimit(2),
+				)
+			},
+		},
+		{
+			name: "Alter",
+			call: func() error {
+				return c.Alter(ctx, "topic",
+					topicoptions.AlterWithMinActivePartitions(3),
+					topicoptions.AlterWithPartitionCountLimit(2),
+				)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.call(), ErrMinActivePartitionsExceedsLimit)
+		})
+	}
+}
+
+func TestCheckPartitioning(t *testing.T) {
+	require.NoError(t, checkPartitioning(2, 2))
+	require.NoError(t, checkPartitioning(1, 2))
+	require.NoError(t, checkPartitioning(3, 0))
+	require.ErrorIs(t, checkPartitioning(3, 2), ErrMinActivePartitionsExceedsLimit)
+}
@@ -97,6 +97,7 @@ func newBatchFromStream(
 	}
 
 	session.setLastReceivedMessageOffset(prevOffset)
+	session.setEndOffset(prevOffset + 1)
 
 	return newBatch(session, messages)
 }
@@ -117,6 +118,15 @@ func (m *PublicBatch) PartitionID() int64 {
 	return m.partitionSession().PartitionID
 }
 
+// PartitionEndOffset is offset of the next message, which will be written to the partition, as known by reader.
+// It allows to calculate lag of the batch without describe of the topic:
+// PartitionEndOffset() - Messages[len(Messages)-1].Offset - 1
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (m *PublicBatch) PartitionEndOffset() int64 {
+	return m.partitionSession().endOffset().ToInt64()
+}
+
 func (m *PublicBatch) partitionSession() *partitionSession {
 	return m.commitRange.partitionSession
 }
@@ -1,10 +1,13 @@
 package topicreaderinternal
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicreader"
 )
 
 func TestBatch_New(t *testing.T) {
@@ -59,6 +62,28 @@ func TestBatch_Cut(t *testing.T) {
 	})
 }
 
+func TestBatch_PartitionEndOffset(t *testing.T) {
+	session := newPartitionSession(context.Background(), "topic", 1, 0, "", 0, 10)
+	session.setEndOffset(20)
+
+	batch, err := newBatchFromStream(newDecoderMap(), session, rawtopicreader.Batch{
+		MessageData: []rawtopicreader.MessageData{{Offset: 10}, {Offset: 11}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(20), batch.PartitionEndOffset())
+
+	// end offset is moved forward by received messages
+	batch, err = newBatchFromStream(newDecoderMap(), session, rawtopicreader.Batch{
+		MessageData: []rawtopicreader.MessageData{{Offset: 25}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(26), batch.PartitionEndOffset())
+
+	// outdated end offset is ignored
+	session.setEndOffset(15)
+	require.Equal(t, int64(26), batch.PartitionEndOffset())
+}
+
 func TestBatch_Extend(t *testing.T) {
 	t.Run("Ok", func(t *testing.T) {
 		session := &partitionSession{}
@@ -10,5 +10,7 @@ type batchedStreamReader interface {
 	WaitInit(ctx context.Context) error
 	ReadMessageBatch(ctx context.Context, opts ReadMessageBatchOptions) (*PublicBatch, error)
 	Commit(ctx context.Context, commitRange commitRange) error
+	CommitWithAck(ctx context.Context, commitRange commitRange) error
 	CloseWithError(ctx context.Context, err error) error
+	Stats() PublicReaderStats
 }
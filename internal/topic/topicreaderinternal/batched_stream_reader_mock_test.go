@@ -64,6 +64,20 @@ func (mr *MockbatchedStreamReaderMockRecorder) Commit(ctx, commitRange any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockbatchedStreamReader)(nil).Commit), ctx, commitRange)
 }
 
+// CommitWithAck mocks base method.
+func (m *MockbatchedStreamReader) CommitWithAck(ctx context.Context, commitRange commitRange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitWithAck", ctx, commitRange)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitWithAck indicates an expected call of CommitWithAck.
+func (mr *MockbatchedStreamReaderMockRecorder) CommitWithAck(ctx, commitRange any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitWithAck", reflect.TypeOf((*MockbatchedStreamReader)(nil).CommitWithAck), ctx, commitRange)
+}
+
 // ReadMessageBatch mocks base method.
 func (m *MockbatchedStreamReader) ReadMessageBatch(ctx context.Context, opts ReadMessageBatchOptions) (*PublicBatch, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +93,20 @@ func (mr *MockbatchedStreamReaderMockRecorder) ReadMessageBatch(ctx, opts any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMessageBatch", reflect.TypeOf((*MockbatchedStreamReader)(nil).ReadMessageBatch), ctx, opts)
 }
 
+// Stats mocks base method.
+func (m *MockbatchedStreamReader) Stats() PublicReaderStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(PublicReaderStats)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockbatchedStreamReaderMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockbatchedStreamReader)(nil).Stats))
+}
+
 // WaitInit mocks base method.
 func (m *MockbatchedStreamReader) WaitInit(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -79,6 +79,15 @@ func (c *committer) Close(ctx context.Context, err error) error {
 }
 
 func (c *committer) Commit(ctx context.Context, commitRange commitRange) error {
+	return c.commit(ctx, commitRange, c.mode == CommitModeSync)
+}
+
+// CommitWithAck waits ack of commit from server independent of commit mode
+func (c *committer) CommitWithAck(ctx context.Context, commitRange commitRange) error {
+	return c.commit(ctx, commitRange, true)
+}
+
+func (c *committer) commit(ctx context.Context, commitRange commitRange, waitAck bool) error {
 	if !c.mode.commitsEnabled() {
 		return ErrCommitDisabled
 	}
@@ -87,15 +96,19 @@ func (c *committer) Commit(ctx context.Context, commitRange commitRange) error {
 		return ctx.Err()
 	}
 
-	waiter, err := c.pushCommit(commitRange)
+	waiter, err := c.pushCommit(commitRange, waitAck)
 	if err != nil {
 		return err
 	}
 
+	if !waitAck {
+		return nil
+	}
+
 	return c.waitCommitAck(ctx, waiter)
 }
 
-func (c *committer) pushCommit(commitRange commitRange) (commitWaiter, error) {
+func (c *committer) pushCommit(commitRange commitRange, waitAck bool) (commitWaiter, error) {
 	var resErr error
 	waiter := newCommitWaiter(commitRange.partitionSession, commitRange.commitOffsetEnd)
 	c.m.WithLock(func() {
@@ -106,7 +119,7 @@ func (c *committer) pushCommit(commitRange commitRange) (commitWaiter, error) {
 		}
 
 		c.commits.Append(&commitRange)
-		if c.mode == CommitModeSync {
+		if waitAck {
 			c.addWaiterNeedLock(waiter)
 		}
 	})
@@ -200,10 +213,6 @@ func (c *committer) waitSendTrigger(ctx context.Context) {
 }
 
 func (c *committer) waitCommitAck(ctx context.Context, waiter commitWaiter) error {
-	if c.mode != CommitModeSync {
-		return nil
-	}
-
 	defer c.m.WithLock(func() {
 		c.removeWaiterByIDNeedLock(waiter.ID)
 	})
@@ -215,7 +224,14 @@ func (c *committer) waitCommitAck(ctx context.Context, waiter commitWaiter) erro
 	case <-ctx.Done():
 		return ctx.Err()
 	case <-waiter.Session.Context().Done():
-		return PublicErrCommitSessionToExpiredSession
+		// committed offset of stopped partition session may be updated by stop request from server
+		if waiter.checkCondition(waiter.Session, waiter.Session.committedOffset()) {
+			return nil
+		}
+
+		return PublicErrPartitionSessionClosed
+	case <-c.backgroundWorker.Done():
+		return xerrors.WithStackTrace(PublicErrCommitSessionToExpiredSession)
 	case <-waiter.Committed:
 		return nil
 	}
@@ -195,6 +195,81 @@ func TestCommitterCommitSync(t *testing.T) {
 	})
 }
 
+func TestCommitterCommitWithAck(t *testing.T) {
+	xtest.TestManyTimesWithName(t, "AsyncMode", func(t testing.TB) {
+		ctx := xtest.Context(t)
+		session := &partitionSession{
+			ctx:                context.Background(),
+			partitionSessionID: 1,
+		}
+		session.committedOffsetVal.Store(1)
+
+		cRange := commitRange{
+			commitOffsetStart: 1,
+			commitOffsetEnd:   2,
+			partitionSession:  session,
+		}
+
+		c := newTestCommitter(ctx, t)
+		c.mode = CommitModeAsync
+		c.send = func(msg rawtopicreader.ClientMessage) error {
+			go c.OnCommitNotify(session, cRange.commitOffsetEnd)
+
+			return nil
+		}
+		require.NoError(t, c.CommitWithAck(ctx, cRange))
+	})
+
+	xtest.TestManyTimesWithName(t, "SessionClosedAfterCommit", func(t testing.TB) {
+		ctx := xtest.Context(t)
+
+		sessionCtx, sessionCancel := xcontext.WithCancel(ctx)
+		session := &partitionSession{
+			ctx:                sessionCtx,
+			partitionSessionID: 1,
+		}
+		session.committedOffsetVal.Store(1)
+		cRange := commitRange{
+			commitOffsetStart: 1,
+			commitOffsetEnd:   2,
+			partitionSession:  session,
+		}
+
+		c := newTestCommitter(ctx, t)
+		c.mode = CommitModeAsync
+		c.send = func(msg rawtopicreader.ClientMessage) error {
+			// server reports committed offset in stop partition request
+			session.setCommittedOffset(cRange.commitOffsetEnd)
+			sessionCancel()
+
+			return nil
+		}
+
+		require.NoError(t, c.CommitWithAck(ctx, cRange))
+	})
+
+	xtest.TestManyTimesWithName(t, "CommitterClosed", func(t testing.TB) {
+		ctx := xtest.Context(t)
+		session := &partitionSession{
+			ctx:                ctx,
+			partitionSessionID: 1,
+		}
+		session.committedOffsetVal.Store(1)
+		cRange := commitRange{
+			commitOffsetStart: 1,
+			commitOffsetEnd:   2,
+			partitionSession:  session,
+		}
+
+		c := newTestCommitter(ctx, t)
+		c.send = func(msg rawtopicreader.ClientMessage) error {
+			return errors.New("test")
+		}
+
+		require.ErrorIs(t, c.CommitWithAck(ctx, cRange), PublicErrCommitSessionToExpiredSession)
+	})
+}
+
 func TestCommitterBuffer(t *testing.T) {
 	t.Run("SendZeroLag", func(t *testing.T) {
 		ctx := xtest.Context(t)
@@ -209,7 +284,7 @@ func TestCommitterBuffer(t *testing.T) {
 			return nil
 		}
 
-		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 2}})
+		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 2}}, false)
 		require.NoError(t, err)
 		<-sendCalled
 	})
@@ -238,9 +313,9 @@ func TestCommitterBuffer(t *testing.T) {
 			return nil
 		}
 
-		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 1}})
+		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 1}}, false)
 		require.NoError(t, err)
-		_, err = c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 2}})
+		_, err = c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 2}}, false)
 		require.NoError(t, err)
 		require.False(t, isSended())
 
@@ -276,7 +351,7 @@ func TestCommitterBuffer(t *testing.T) {
 			{partitionSession: &partitionSession{partitionSessionID: 3}},
 		})
 
-		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 4}})
+		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 4}}, false)
 		require.NoError(t, err)
 		<-sendCalled
 	})
@@ -313,6 +388,7 @@ func TestCommitterBuffer(t *testing.T) {
 						partitionSessionID: rawtopicreader.PartitionSessionID(i),
 					},
 				},
+				false,
 			)
 			require.NoError(t, err)
 		}
@@ -323,7 +399,7 @@ func TestCommitterBuffer(t *testing.T) {
 		})
 		require.False(t, isSended())
 
-		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 3}})
+		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{partitionSessionID: 3}}, false)
 		require.NoError(t, err)
 		<-sendCalled
 	})
@@ -341,7 +417,7 @@ func TestCommitterBuffer(t *testing.T) {
 
 			return nil
 		}
-		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{}})
+		_, err := c.pushCommit(commitRange{partitionSession: &partitionSession{}}, false)
 		require.NoError(t, err)
 
 		clock.BlockUntil(1)
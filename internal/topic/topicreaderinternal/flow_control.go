@@ -0,0 +1,97 @@
+package topicreaderinternal
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// PublicReaderStats is a state of local buffer of reader
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PublicReaderStats struct {
+	// BufferSizeBytes is a size of local buffer of reader (WithReaderBufferSizeBytes)
+	BufferSizeBytes int
+
+	// BufferedBytes is a size of messages which are received from server and are not read yet
+	BufferedBytes int
+
+	// BufferedMessages is a count of messages which are received from server and are not read yet
+	BufferedMessages int
+
+	// Paused reports that reader does not request more data from server because of full buffer
+	Paused bool
+}
+
+// flowControl tracks messages in local buffer of stream reader and pauses requests of data from server
+// above high watermark (bytes or messages) until buffer is freed below low watermark
+type flowControl struct {
+	lowWatermarkBytes  int
+	highWatermarkBytes int
+	maxMessages        int
+
+	bufferedBytes    atomic.Int64
+	bufferedMessages atomic.Int64
+	paused           atomic.Bool
+
+	pausedAt time.Time // used only by data request loop
+}
+
+func (f *flowControl) init(cfg *topicStreamReaderConfig) {
+	f.lowWatermarkBytes = cfg.BufferLowWatermarkBytes
+	f.highWatermarkBytes = cfg.BufferHighWatermarkBytes
+	if f.lowWatermarkBytes > f.highWatermarkBytes {
+		f.lowWatermarkBytes = f.highWatermarkBytes
+	}
+	f.maxMessages = cfg.MaxMessagesInFlight
+}
+
+func (f *flowControl) onReceived(bytes, messages int) {
+	f.bufferedBytes.Add(int64(bytes))
+	f.bufferedMessages.Add(int64(messages))
+}
+
+func (f *flowControl) onRead(bytes, messages int) {
+	f.bufferedBytes.Add(-int64(bytes))
+	f.bufferedMessages.Add(-int64(messages))
+}
+
+func (f *flowControl) buffered() (bytes, messages int) {
+	return int(f.bufferedBytes.Load()), int(f.bufferedMessages.Load())
+}
+
+// mustPause returns reason of pause if reader must not request more data with current state of buffer
+func (f *flowControl) mustPause() (trace.TopicReaderFlowControlPauseReason, bool) {
+	bytes, messages := f.buffered()
+	if f.maxMessages > 0 && messages >= f.maxMessages {
+		return trace.TopicReaderFlowControlPauseReasonMaxMessages, true
+	}
+	if f.highWatermarkBytes <= 0 {
+		return "", false
+	}
+	if f.paused.Load() {
+		if bytes > f.lowWatermarkBytes {
+			return trace.TopicReaderFlowControlPauseReasonHighWatermark, true
+		}
+
+		return "", false
+	}
+	if bytes >= f.highWatermarkBytes {
+		return trace.TopicReaderFlowControlPauseReasonHighWatermark, true
+	}
+
+	return "", false
+}
+
+func (f *flowControl) pause(now time.Time) {
+	f.pausedAt = now
+	f.paused.Store(true)
+}
+
+// resume returns duration of pause
+func (f *flowControl) resume(now time.Time) time.Duration {
+	f.paused.Store(false)
+
+	return now.Sub(f.pausedAt)
+}
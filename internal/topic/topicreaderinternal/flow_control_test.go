@@ -0,0 +1,93 @@
+package topicreaderinternal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestFlowControlMustPause(t *testing.T) {
+	type step struct {
+		received, read int // bytes, one message per read or received call
+		pause          bool
+		reason         trace.TopicReaderFlowControlPauseReason
+	}
+	for _, tt := range []struct {
+		name  string
+		cfg   topicStreamReaderConfig
+		steps []step
+	}{
+		{
+			name: "Disabled",
+			steps: []step{
+				{received: 100},
+				{received: 1000000},
+				{read: 1000000},
+			},
+		},
+		{
+			name: "Watermarks",
+			cfg: topicStreamReaderConfig{
+				BufferLowWatermarkBytes:  20,
+				BufferHighWatermarkBytes: 80,
+			},
+			steps: []step{
+				{received: 50},
+				{received: 40, pause: true, reason: trace.TopicReaderFlowControlPauseReasonHighWatermark},
+				{read: 30, pause: true, reason: trace.TopicReaderFlowControlPauseReasonHighWatermark},
+				{read: 30, pause: true, reason: trace.TopicReaderFlowControlPauseReasonHighWatermark},
+				{read: 10},
+				{received: 50},
+			},
+		},
+		{
+			name: "MaxMessages",
+			cfg: topicStreamReaderConfig{
+				MaxMessagesInFlight: 2,
+			},
+			steps: []step{
+				{received: 10},
+				{received: 10, pause: true, reason: trace.TopicReaderFlowControlPauseReasonMaxMessages},
+				{read: 10},
+				{received: 1000, pause: true, reason: trace.TopicReaderFlowControlPauseReasonMaxMessages},
+				{read: 1000},
+			},
+		},
+		{
+			name: "LowWatermarkGreaterThanHigh",
+			cfg: topicStreamReaderConfig{
+				BufferLowWatermarkBytes:  100,
+				BufferHighWatermarkBytes: 50,
+			},
+			steps: []step{
+				{received: 60, pause: true, reason: trace.TopicReaderFlowControlPauseReasonHighWatermark},
+				{read: 10},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var f flowControl
+			f.init(&tt.cfg)
+			for i, s := range tt.steps {
+				if s.received > 0 {
+					f.onReceived(s.received, 1)
+				}
+				if s.read > 0 {
+					f.onRead(s.read, 1)
+				}
+				reason, pause := f.mustPause()
+				require.Equal(t, s.pause, pause, i)
+				require.Equal(t, s.reason, reason, i)
+				switch {
+				case pause && !f.paused.Load():
+					f.pause(time.Now())
+				case !pause && f.paused.Load():
+					require.GreaterOrEqual(t, f.resume(time.Now()), time.Duration(0))
+				}
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ type partitionSession struct {
 
 	lastReceivedOffsetEndVal atomic.Int64
 	committedOffsetVal       atomic.Int64
+	endOffsetVal             atomic.Int64
 }
 
 func newPartitionSession(
@@ -54,6 +55,7 @@ func newPartitionSession(
 	}
 	res.committedOffsetVal.Store(committedOffset.ToInt64())
 	res.lastReceivedOffsetEndVal.Store(committedOffset.ToInt64() - 1)
+	res.endOffsetVal.Store(committedOffset.ToInt64())
 
 	return res
 }
@@ -92,6 +94,27 @@ func (s *partitionSession) setLastReceivedMessageOffset(v rawtopicreader.Offset)
 	s.lastReceivedOffsetEndVal.Store(v.ToInt64())
 }
 
+// endOffset returns offset of the next message, which will be written to the partition,
+// as known by reader
+func (s *partitionSession) endOffset() rawtopicreader.Offset {
+	v := s.endOffsetVal.Load()
+
+	var res rawtopicreader.Offset
+	res.FromInt64(v)
+
+	return res
+}
+
+// setEndOffset moves known end offset of the partition forward, lesser values are ignored
+func (s *partitionSession) setEndOffset(v rawtopicreader.Offset) {
+	for {
+		old := s.endOffsetVal.Load()
+		if v.ToInt64() <= old || s.endOffsetVal.CompareAndSwap(old, v.ToInt64()) {
+			return
+		}
+	}
+}
+
 type partitionSessionStorage struct {
 	m sync.RWMutex
 
@@ -48,6 +48,7 @@ type Reader struct {
 	defaultBatchConfig ReadMessageBatchOptions
 	tracer             *trace.Topic
 	readerID           int64
+	onClose            []func()
 }
 
 type ReadMessageBatchOptions struct {
@@ -101,6 +102,7 @@ func NewReader(
 			readerConnector,
 			cfg.OperationTimeout(),
 			cfg.RetrySettings,
+			cfg.ReconnectTimeout,
 			cfg.Trace,
 		),
 		defaultBatchConfig: cfg.DefaultBatchConfig,
@@ -124,9 +126,25 @@ func (r *Reader) Tracer() *trace.Topic {
 }
 
 func (r *Reader) Close(ctx context.Context) error {
+	defer func() {
+		for _, f := range r.onClose {
+			f()
+		}
+	}()
+
 	return r.reader.CloseWithError(ctx, xerrors.WithStackTrace(errReaderClosed))
 }
 
+// Stats returns state of local buffer of current stream of reader
+func (r *Reader) Stats() PublicReaderStats {
+	return r.reader.Stats()
+}
+
+// OnClose adds callback which will be called after each close of reader
+func (r *Reader) OnClose(f func()) {
+	r.onClose = append(r.onClose, f)
+}
+
 // ReadMessage read exactly one message
 func (r *Reader) ReadMessage(ctx context.Context) (*PublicMessage, error) {
 	res, err := r.ReadMessageBatch(ctx, readExplicitMessagesCount(1))
@@ -167,15 +185,34 @@ func (r *Reader) ReadMessageBatch(ctx context.Context, opts ...PublicReadBatchOp
 }
 
 func (r *Reader) Commit(ctx context.Context, offsets PublicCommitRangeGetter) (err error) {
+	cr, err := r.commitRange(offsets)
+	if err != nil {
+		return err
+	}
+
+	return r.reader.Commit(ctx, cr)
+}
+
+// CommitWithAck commits offsets and waits ack of commit from server independent of commit mode
+func (r *Reader) CommitWithAck(ctx context.Context, offsets PublicCommitRangeGetter) (err error) {
+	cr, err := r.commitRange(offsets)
+	if err != nil {
+		return err
+	}
+
+	return r.reader.CommitWithAck(ctx, cr)
+}
+
+func (r *Reader) commitRange(offsets PublicCommitRangeGetter) (commitRange, error) {
 	cr := offsets.getCommitRange().priv
 	if cr.partitionSession.readerID != r.readerID {
-		return xerrors.WithStackTrace(xerrors.Wrap(fmt.Errorf(
+		return cr, xerrors.WithStackTrace(xerrors.Wrap(fmt.Errorf(
 			"ydb: messages session reader id (%v) != current reader id (%v): %w",
 			cr.partitionSession.readerID, r.readerID, errCommitSessionFromOtherReader,
 		)))
 	}
 
-	return r.reader.Commit(ctx, cr)
+	return cr, nil
 }
 
 func (r *Reader) CommitRanges(ctx context.Context, ranges []PublicCommitRange) error {
@@ -222,6 +259,7 @@ type ReaderConfig struct {
 	config.Common
 
 	RetrySettings      topic.RetrySettings
+	ReconnectTimeout   time.Duration
 	DefaultBatchConfig ReadMessageBatchOptions
 	topicStreamReaderConfig
 }
@@ -267,7 +305,8 @@ func convertNewParamsToStreamConfig(
 }
 
 type PublicReadSelector struct {
-	Path       string
+	Path string
+	// Partitions restricts read session to partitions with given ids, empty for all partitions of topic
 	Partitions []int64
 	ReadFrom   time.Time     // zero value mean skip read from filter
 	MaxTimeLag time.Duration // 0 mean skip time lag filter
@@ -27,6 +27,12 @@ const defaultBufferSize = 1024 * 1024
 var (
 	PublicErrCommitSessionToExpiredSession = xerrors.Wrap(errors.New("ydb: commit to expired session"))
 
+	// PublicErrPartitionSessionClosed is a kind of PublicErrCommitSessionToExpiredSession
+	// for commits of messages of closed partition session (for example, session of broken stream before reconnect)
+	PublicErrPartitionSessionClosed = xerrors.Wrap(
+		fmt.Errorf("ydb: partition session closed: %w", PublicErrCommitSessionToExpiredSession),
+	)
+
 	errCommitWithNilPartitionSession = xerrors.Wrap(errors.New("ydb: commit with nil partition session"))
 )
 
@@ -39,6 +45,7 @@ type topicStreamReaderImpl struct {
 
 	freeBytes           chan int
 	restBufferSizeBytes atomic.Int64
+	flowControl         flowControl
 	sessionController   partitionSessionStorage
 	backgroundWorkers   background.Worker
 
@@ -62,6 +69,9 @@ type topicStreamReaderConfig struct {
 	CommitterBatchCounterTrigger    int
 	BaseContext                     context.Context //nolint:containedctx
 	BufferSizeProtoBytes            int
+	BufferLowWatermarkBytes         int
+	BufferHighWatermarkBytes        int
+	MaxMessagesInFlight             int
 	Cred                            credentials.Credentials
 	CredUpdateInterval              time.Duration
 	Consumer                        string
@@ -120,6 +130,13 @@ func newTopicStreamReader(
 	}()
 
 	reader := newTopicStreamReaderStopped(readerID, stream, cfg)
+	defer func() {
+		if err != nil {
+			// stopped reader already started committer loop
+			reader.cancel()
+			_ = reader.committer.Close(context.Background(), err)
+		}
+	}()
 	if err = reader.initSession(); err != nil {
 		return nil, err
 	}
@@ -160,6 +177,7 @@ func newTopicStreamReaderStopped(
 	res.committer.BufferTimeLagTrigger = cfg.CommitterBatchTimeLag
 	res.committer.BufferCountTrigger = cfg.CommitterBatchCounterTrigger
 	res.sessionController.init()
+	res.flowControl.init(&cfg)
 	res.freeBytes <- cfg.BufferSizeProtoBytes
 
 	return res
@@ -313,6 +331,7 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequestFromBuffer(
 	}()
 
 	if msg.Graceful {
+		r.onCommittedOffsetFromStopRequest(session, msg.CommittedOffset)
 		session.Close()
 		resp := &rawtopicreader.StopPartitionSessionResponse{
 			PartitionSessionID: session.partitionSessionID,
@@ -347,8 +366,18 @@ func (r *topicStreamReaderImpl) onUpdateTokenResponse(m *rawtopicreader.UpdateTo
 }
 
 func (r *topicStreamReaderImpl) Commit(ctx context.Context, commitRange commitRange) (err error) {
+	return r.commit(ctx, commitRange, r.cfg.CommitMode == CommitModeSync)
+}
+
+// CommitWithAck commits range and waits ack of commit from server independent of commit mode
+func (r *topicStreamReaderImpl) CommitWithAck(ctx context.Context, commitRange commitRange) (err error) {
+	return r.commit(ctx, commitRange, true)
+}
+
+func (r *topicStreamReaderImpl) commit(ctx context.Context, commitRange commitRange, waitAck bool) (err error) {
 	defer func() {
-		if errors.Is(err, PublicErrCommitSessionToExpiredSession) && r.cfg.CommitMode == CommitModeAsync {
+		if errors.Is(err, PublicErrCommitSessionToExpiredSession) && !errors.Is(err, PublicErrPartitionSessionClosed) &&
+			!waitAck {
 			err = nil
 		}
 	}()
@@ -375,6 +404,10 @@ func (r *topicStreamReaderImpl) Commit(ctx context.Context, commitRange commitRa
 		return err
 	}
 
+	if waitAck {
+		return r.committer.CommitWithAck(ctx, commitRange)
+	}
+
 	return r.committer.Commit(ctx, commitRange)
 }
 
@@ -389,7 +422,7 @@ func (r *topicStreamReaderImpl) checkCommitRange(commitRange commitRange) error
 	}
 
 	if session.Context().Err() != nil {
-		return xerrors.WithStackTrace(PublicErrCommitSessionToExpiredSession)
+		return xerrors.WithStackTrace(PublicErrPartitionSessionClosed)
 	}
 
 	ownSession, err := r.sessionController.Get(session.partitionSessionID)
@@ -558,6 +591,8 @@ func (r *topicStreamReaderImpl) dataRequestLoop(ctx context.Context) {
 
 	doneChan := ctx.Done()
 
+	// pending is a size of freed buffer which is not requested from server because of flow control pause
+	pending := 0
 	for {
 		select {
 		case <-doneChan:
@@ -566,7 +601,7 @@ func (r *topicStreamReaderImpl) dataRequestLoop(ctx context.Context) {
 			return
 
 		case free := <-r.freeBytes:
-			sum := free
+			sum := pending + free
 
 			// consume all messages from order and compress it to one data request
 		forConsumeRequests:
@@ -579,6 +614,13 @@ func (r *topicStreamReaderImpl) dataRequestLoop(ctx context.Context) {
 				}
 			}
 
+			if r.pauseDataRequests(sum) {
+				pending = sum
+
+				continue
+			}
+			pending = 0
+
 			resCapacity := r.addRestBufferBytes(sum)
 			trace.TopicOnReaderSentDataRequest(r.cfg.Trace, r.readConnectionID, sum, resCapacity)
 			if err := r.sendDataRequest(sum); err != nil {
@@ -588,6 +630,40 @@ func (r *topicStreamReaderImpl) dataRequestLoop(ctx context.Context) {
 	}
 }
 
+// pauseDataRequests checks flow control before request of data with size requestBytes and reports that
+// request must be postponed. It is called only from data request loop
+func (r *topicStreamReaderImpl) pauseDataRequests(requestBytes int) bool {
+	reason, pause := r.flowControl.mustPause()
+	paused := r.flowControl.paused.Load()
+	switch {
+	case pause && !paused:
+		r.flowControl.pause(time.Now())
+		// messages of buffer are not enough for min count of batch while buffer is not freed
+		r.batcher.IgnoreMinRestrictionsOnNextPop()
+		bytes, messages := r.flowControl.buffered()
+		trace.TopicOnReaderFlowControlPause(r.cfg.Trace, r.readConnectionID, reason, bytes, messages)
+	case !pause && paused:
+		pauseDuration := r.flowControl.resume(time.Now())
+		bytes, messages := r.flowControl.buffered()
+		trace.TopicOnReaderFlowControlResume(r.cfg.Trace, r.readConnectionID,
+			bytes, messages, requestBytes, pauseDuration,
+		)
+	}
+
+	return pause
+}
+
+func (r *topicStreamReaderImpl) Stats() PublicReaderStats {
+	bytes, messages := r.flowControl.buffered()
+
+	return PublicReaderStats{
+		BufferSizeBytes:  r.cfg.BufferSizeProtoBytes,
+		BufferedBytes:    bytes,
+		BufferedMessages: messages,
+		Paused:           r.flowControl.paused.Load(),
+	}
+}
+
 func (r *topicStreamReaderImpl) sendDataRequest(size int) error {
 	return r.send(&rawtopicreader.ReadRequest{BytesSize: size})
 }
@@ -597,6 +673,7 @@ func (r *topicStreamReaderImpl) freeBufferFromMessages(batch *PublicBatch) {
 	for messageIndex := range batch.Messages {
 		size += batch.Messages[messageIndex].bufferBytesAccount
 	}
+	r.flowControl.onRead(size, len(batch.Messages))
 	select {
 	case r.freeBytes <- size:
 	case <-r.ctx.Done():
@@ -657,6 +734,12 @@ func (r *topicStreamReaderImpl) onReadResponse(msg *rawtopicreader.ReadResponse)
 		return err
 	}
 
+	messagesCount := 0
+	for i := range batches {
+		messagesCount += len(batches[i].Messages)
+	}
+	r.flowControl.onReceived(msg.BytesSize, messagesCount)
+
 	for i := range batches {
 		if err := r.batcher.PushBatches(batches[i]); err != nil {
 			return err
@@ -756,6 +839,7 @@ func (r *topicStreamReaderImpl) onStartPartitionSessionRequest(m *rawtopicreader
 		m.PartitionSession.PartitionSessionID,
 		m.CommittedOffset,
 	)
+	session.setEndOffset(m.PartitionOffsets.End)
 	if err := r.sessionController.Add(session); err != nil {
 		return err
 	}
@@ -825,8 +909,22 @@ func (r *topicStreamReaderImpl) onStopPartitionSessionRequest(m *rawtopicreader.
 	}
 
 	if !m.Graceful {
+		r.onCommittedOffsetFromStopRequest(session, m.CommittedOffset)
 		session.Close()
 	}
 
 	return r.batcher.PushRawMessage(session, m)
 }
+
+// onCommittedOffsetFromStopRequest notifies commit waiters about committed offset of stopped partition session
+// for waiters do not fail with PublicErrPartitionSessionClosed if commit was acked by server before stop
+func (r *topicStreamReaderImpl) onCommittedOffsetFromStopRequest(
+	session *partitionSession,
+	committedOffset rawtopicreader.Offset,
+) {
+	if committedOffset <= session.committedOffset() {
+		return
+	}
+	session.setCommittedOffset(committedOffset)
+	r.committer.OnCommitNotify(session, committedOffset)
+}
@@ -324,6 +324,99 @@ func TestTopicStreamReaderImpl_CommitStolen(t *testing.T) {
 	})
 }
 
+func TestTopicStreamReaderImpl_SyncCommitOnGracefulStopPartition(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		ackedByEnd bool
+	}{
+		{
+			name:       "CommittedBeforeStop",
+			ackedByEnd: true,
+		},
+		{
+			name:       "NotCommittedBeforeStop",
+			ackedByEnd: false,
+		},
+	} {
+		xtest.TestManyTimesWithName(t, test.name, func(t testing.TB) {
+			e := newTopicReaderTestEnv(t)
+			e.reader.cfg.CommitMode = CommitModeSync
+
+			committed := e.partitionSession.committedOffset()
+			commitReceived := make(empty.Chan)
+			e.stream.EXPECT().Send(gomock.AssignableToTypeOf(&rawtopicreader.CommitOffsetRequest{})).
+				Do(func(_ interface{}) {
+					close(commitReceived)
+				}).Return(nil)
+
+			stopPartitionResponseSent := make(empty.Chan)
+			e.stream.EXPECT().Send(&rawtopicreader.StopPartitionSessionResponse{PartitionSessionID: e.partitionSessionID}).
+				Do(func(_ interface{}) {
+					close(stopPartitionResponseSent)
+				}).Return(nil)
+
+			e.Start()
+
+			e.SendFromServer(&rawtopicreader.ReadResponse{
+				PartitionData: []rawtopicreader.PartitionData{
+					{
+						PartitionSessionID: e.partitionSessionID,
+						Batches: []rawtopicreader.Batch{
+							{
+								Codec: rawtopiccommon.CodecRaw,
+								MessageData: []rawtopicreader.MessageData{
+									{
+										Offset: committed,
+										SeqNo:  1,
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+
+			batch, err := e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+			require.NoError(t, err)
+
+			commitErr := make(chan error, 1)
+			go func() {
+				commitErr <- e.reader.Commit(e.ctx, batch.commitRange)
+			}()
+			xtest.WaitChannelClosed(t, commitReceived)
+
+			stopCommittedOffset := committed
+			if test.ackedByEnd {
+				stopCommittedOffset = batch.commitRange.commitOffsetEnd
+			}
+			e.SendFromServer(&rawtopicreader.StopPartitionSessionRequest{
+				PartitionSessionID: e.partitionSessionID,
+				Graceful:           true,
+				CommittedOffset:    stopCommittedOffset,
+			})
+
+			readCtx, readCtxCancel := xcontext.WithCancel(e.ctx)
+			go func() {
+				<-stopPartitionResponseSent
+				readCtxCancel()
+			}()
+			_, err = e.reader.ReadMessageBatch(readCtx, newReadMessageBatchOptions())
+			require.ErrorIs(t, err, context.Canceled)
+
+			select {
+			case err = <-commitErr:
+			case <-time.After(time.Second):
+				t.Fatal("sync commit hangs after stop of partition session")
+			}
+			if test.ackedByEnd {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, PublicErrCommitSessionToExpiredSession)
+			}
+		})
+	}
+}
+
 func TestTopicStreamReaderImpl_Create(t *testing.T) {
 	xtest.TestManyTimesWithName(t, "BadSessionInitialization", func(t testing.TB) {
 		mc := gomock.NewController(t)
@@ -569,6 +662,66 @@ func TestTopicStreamReaderImpl_ReadMessages(t *testing.T) {
 		})
 	})
 
+	xtest.TestManyTimesWithName(t, "FlowControl", func(t testing.TB) {
+		e := newTopicReaderTestEnv(t)
+		e.reader.flowControl.maxMessages = 2
+
+		paused := make(empty.Chan)
+		resumed := make(empty.Chan)
+		e.reader.cfg.Trace = &trace.Topic{
+			OnReaderFlowControlPause: func(info trace.TopicReaderFlowControlPauseInfo) {
+				require.Equal(t, trace.TopicReaderFlowControlPauseReasonMaxMessages, info.Reason)
+				close(paused)
+			},
+			OnReaderFlowControlResume: func(info trace.TopicReaderFlowControlResumeInfo) {
+				require.Equal(t, 0, info.BufferedMessages)
+				close(resumed)
+			},
+		}
+
+		const dataSize = 1000
+		dataRequested := make(empty.Chan)
+		// freed bytes of all messages are requested at once after resume
+		e.stream.EXPECT().Send(&rawtopicreader.ReadRequest{BytesSize: dataSize}).Do(func(_ interface{}) {
+			close(dataRequested)
+		})
+
+		e.Start()
+		e.SendFromServer(&rawtopicreader.ReadResponse{BytesSize: dataSize, PartitionData: []rawtopicreader.PartitionData{
+			{
+				PartitionSessionID: e.partitionSessionID,
+				Batches: []rawtopicreader.Batch{
+					{
+						MessageData: []rawtopicreader.MessageData{
+							{Offset: 1, SeqNo: 1, Data: []byte{1}},
+							{Offset: 2, SeqNo: 2, Data: []byte{2}},
+							{Offset: 3, SeqNo: 3, Data: []byte{3}},
+						},
+					},
+				},
+			},
+		}})
+		xtest.SpinWaitCondition(t, nil, func() bool {
+			return e.reader.Stats().BufferedMessages == 3
+		})
+		require.Equal(t, dataSize, e.reader.Stats().BufferedBytes)
+
+		oneOption := newReadMessageBatchOptions()
+		oneOption.MaxCount = 1
+		_, err := e.reader.ReadMessageBatch(e.ctx, oneOption)
+		require.NoError(t, err)
+		xtest.WaitChannelClosed(t, paused)
+		stats := e.reader.Stats()
+		require.True(t, stats.Paused)
+		require.Equal(t, 2, stats.BufferedMessages)
+
+		_, err = e.reader.ReadMessageBatch(e.ctx, newReadMessageBatchOptions())
+		require.NoError(t, err)
+		xtest.WaitChannelClosed(t, resumed)
+		xtest.WaitChannelClosed(t, dataRequested)
+		require.Equal(t, PublicReaderStats{BufferSizeBytes: int(e.initialBufferSizeBytes)}, e.reader.Stats())
+	})
+
 	xtest.TestManyTimesWithName(t, "ReadBatch", func(t testing.TB) {
 		e := newTopicReaderTestEnv(t)
 		e.Start()
@@ -953,6 +1106,29 @@ func TestTopicStreamReadImpl_CommitWithBadSession(t *testing.T) {
 	})
 }
 
+func TestTopicStreamReadImpl_CommitWithClosedSession(t *testing.T) {
+	for name, mode := range map[string]PublicCommitMode{
+		"CommitModeAsync": CommitModeAsync,
+		"CommitModeSync":  CommitModeSync,
+	} {
+		t.Run(name, func(t *testing.T) {
+			e := newTopicReaderTestEnv(t)
+			e.reader.cfg.CommitMode = mode
+			e.Start()
+
+			e.partitionSession.Close()
+			err := e.reader.Commit(e.ctx, commitRange{
+				commitOffsetStart: e.partitionSession.committedOffset(),
+				commitOffsetEnd:   e.partitionSession.committedOffset() + 1,
+				partitionSession:  e.partitionSession,
+			})
+			require.ErrorIs(t, err, PublicErrPartitionSessionClosed)
+			require.ErrorIs(t, err, PublicErrCommitSessionToExpiredSession)
+			require.False(t, e.reader.closed)
+		})
+	}
+}
+
 type streamEnv struct {
 	ctx                    context.Context //nolint:containedctx
 	t                      testing.TB
@@ -24,6 +24,8 @@ import (
 var (
 	errReconnectRequestOutdated = xerrors.Wrap(errors.New("ydb: reconnect request outdated"))
 	errReconnect                = xerrors.Wrap(errors.New("ydb: reconnect to topic grpc stream"))
+
+	PublicErrReconnectTimeout = xerrors.Wrap(errors.New("ydb: topic reader reconnect timeout exceeded"))
 )
 
 type readerConnectFunc func(ctx context.Context) (batchedStreamReader, error)
@@ -40,6 +42,7 @@ type readerReconnector struct {
 	readerConnect              readerConnectFunc
 	reconnectFromBadStream     chan reconnectRequest
 	connectTimeout             time.Duration
+	reconnectTimeout           time.Duration // zero means reconnect without timeout
 	readerID                   int64
 	streamConnectionInProgress empty.Chan // opened if connection in progress, closed if connection established
 	initDoneCh                 empty.Chan
@@ -53,16 +56,18 @@ func newReaderReconnector(
 	connector readerConnectFunc,
 	connectTimeout time.Duration,
 	retrySettings topic.RetrySettings,
+	reconnectTimeout time.Duration,
 	tracer *trace.Topic,
 ) *readerReconnector {
 	res := &readerReconnector{
-		readerID:       readerID,
-		clock:          clockwork.NewRealClock(),
-		readerConnect:  connector,
-		streamErr:      errUnconnected,
-		connectTimeout: connectTimeout,
-		tracer:         tracer,
-		retrySettings:  retrySettings,
+		readerID:         readerID,
+		clock:            clockwork.NewRealClock(),
+		readerConnect:    connector,
+		streamErr:        errUnconnected,
+		connectTimeout:   connectTimeout,
+		reconnectTimeout: reconnectTimeout,
+		tracer:           tracer,
+		retrySettings:    retrySettings,
 	}
 
 	if res.connectTimeout == 0 {
@@ -137,6 +142,18 @@ func (r *readerReconnector) Commit(ctx context.Context, commitRange commitRange)
 	return err
 }
 
+func (r *readerReconnector) CommitWithAck(ctx context.Context, commitRange commitRange) error {
+	stream, err := r.stream(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = stream.CommitWithAck(ctx, commitRange)
+	r.fireReconnectOnRetryableError(stream, err)
+
+	return err
+}
+
 func (r *readerReconnector) CloseWithError(ctx context.Context, err error) error {
 	var closeErr error
 	r.closeOnce.Do(func() {
@@ -164,6 +181,18 @@ func (r *readerReconnector) CloseWithError(ctx context.Context, err error) error
 	return closeErr
 }
 
+func (r *readerReconnector) Stats() PublicReaderStats {
+	var stream batchedStreamReader
+	r.m.WithRLock(func() {
+		stream = r.streamVal
+	})
+	if stream == nil {
+		return PublicReaderStats{}
+	}
+
+	return stream.Stats()
+}
+
 func (r *readerReconnector) start() {
 	r.background.Start("reconnector-loop", r.reconnectionLoop)
 
@@ -184,7 +213,10 @@ func (r *readerReconnector) initChannelsAndClock() {
 func (r *readerReconnector) reconnectionLoop(ctx context.Context) {
 	defer r.handlePanic()
 
-	var retriesStarted time.Time
+	var (
+		retriesStarted time.Time
+		brokenSince    time.Time // time of first failed reconnect request since last successful connection
+	)
 	lastTime := time.Time{}
 	attempt := 0
 	for {
@@ -207,6 +239,7 @@ func (r *readerReconnector) reconnectionLoop(ctx context.Context) {
 				retriesStarted = time.Now()
 			}
 		}
+		requestTime := r.clock.Now()
 
 		if request.reason != nil {
 			if retryBackoff, isRetriableErr := r.checkErrRetryMode(
@@ -229,10 +262,43 @@ func (r *readerReconnector) reconnectionLoop(ctx context.Context) {
 			}
 		}
 
-		_ = r.reconnect(ctx, request.reason, request.oldReader)
+		err := r.reconnect(ctx, request.reason, request.oldReader)
+		switch {
+		case err == nil:
+			brokenSince = time.Time{}
+		case xerrors.Is(err, errReconnectRequestOutdated):
+			// pass
+		default:
+			if brokenSince.IsZero() {
+				brokenSince = requestTime
+			}
+			if r.reconnectTimeout > 0 && r.clock.Since(brokenSince) >= r.reconnectTimeout {
+				r.stopReconnect(err)
+			}
+		}
 	}
 }
 
+// stopReconnect breaks reader with terminal error after reconnect timeout exceeded
+func (r *readerReconnector) stopReconnect(reason error) {
+	// reason not wrapped with %w because reason may be retriable, but terminal error must be not
+	err := xerrors.WithStackTrace(fmt.Errorf("%w (%v): last error: %v",
+		PublicErrReconnectTimeout, r.reconnectTimeout, reason,
+	))
+	r.m.WithLock(func() {
+		if r.closedErr != nil {
+			return
+		}
+		r.closedErr = err
+		r.streamErr = err
+		if !r.initDone {
+			r.initDone = true
+			r.initErr = err
+			close(r.initDoneCh)
+		}
+	})
+}
+
 func (r *readerReconnector) reconnect(ctx context.Context, reason error, oldReader batchedStreamReader) (err error) {
 	onDone := trace.TopicOnReaderReconnect(r.tracer, reason)
 	defer func() {
@@ -10,7 +10,9 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/background"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/empty"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -118,6 +120,31 @@ func TestTopicReaderReconnectorReadMessageBatch(t *testing.T) {
 		require.Equal(t, batch, res)
 	})
 
+	t.Run("ReconnectTimeout", func(t *testing.T) {
+		ctx := xtest.Context(t)
+		connectErr := xerrors.Retryable(errors.New("test reconnect error"))
+		reconnector := &readerReconnector{
+			readerConnect: func(ctx context.Context) (batchedStreamReader, error) {
+				return nil, connectErr
+			},
+			connectTimeout:   value.InfiniteDuration,
+			reconnectTimeout: 50 * time.Millisecond,
+			retrySettings: topic.RetrySettings{
+				StartTimeout: value.InfiniteDuration,
+				Backoff:      backoff.New(backoff.WithSlotDuration(time.Millisecond), backoff.WithCeiling(1)),
+			},
+			background: *background.NewWorker(ctx),
+			tracer:     &trace.Topic{},
+		}
+		reconnector.initChannelsAndClock()
+		reconnector.start()
+
+		require.ErrorIs(t, reconnector.WaitInit(ctx), PublicErrReconnectTimeout)
+		_, err := reconnector.ReadMessageBatch(ctx, ReadMessageBatchOptions{})
+		require.ErrorIs(t, err, PublicErrReconnectTimeout)
+		require.NoError(t, reconnector.CloseWithError(ctx, errReaderClosed))
+	})
+
 	t.Run("StartWithCancelledContext", func(t *testing.T) {
 		cancelledCtx, cancelledCtxCancel := xcontext.WithCancel(context.Background())
 		cancelledCtxCancel()
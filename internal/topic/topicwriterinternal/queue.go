@@ -46,12 +46,21 @@ type messageQueue struct {
 
 	messagesByOrder map[int]messageWithDataContent
 	seqNoToOrderID  map[int64]int
+
+	// ackResults contains acks of messages which added with ack waiter, acks removed by WaitAcks
+	ackResults map[int]ackResult
+}
+
+type ackResult struct {
+	ack      PublicWriteAck
+	received bool
 }
 
 func newMessageQueue() messageQueue {
 	return messageQueue{
 		messagesByOrder: make(map[int]messageWithDataContent),
 		seqNoToOrderID:  make(map[int64]int),
+		ackResults:      make(map[int]ackResult),
 		hasNewMessages:  make(empty.Chan, 1),
 		closedChan:      make(empty.Chan),
 		lastSeqNo:       -1,
@@ -59,7 +68,7 @@ func newMessageQueue() messageQueue {
 }
 
 func (q *messageQueue) AddMessages(messages []messageWithDataContent) error {
-	_, err := q.addMessages(messages, false)
+	_, err := q.addMessages(messages, false, false)
 
 	return err
 }
@@ -68,10 +77,18 @@ func (q *messageQueue) AddMessagesWithWaiter(messages []messageWithDataContent)
 	waiter MessageQueueAckWaiter,
 	err error,
 ) {
-	return q.addMessages(messages, true)
+	return q.addMessages(messages, true, false)
+}
+
+// AddMessagesWithAckWaiter adds messages and saves acks of the messages for WaitAcks
+func (q *messageQueue) AddMessagesWithAckWaiter(messages []messageWithDataContent) (
+	waiter MessageQueueAckWaiter,
+	err error,
+) {
+	return q.addMessages(messages, true, true)
 }
 
-func (q *messageQueue) addMessages(messages []messageWithDataContent, needWaiter bool) (
+func (q *messageQueue) addMessages(messages []messageWithDataContent, needWaiter, needAcks bool) (
 	waiter MessageQueueAckWaiter,
 	err error,
 ) {
@@ -94,6 +111,9 @@ func (q *messageQueue) addMessages(messages []messageWithDataContent, needWaiter
 		if needWaiter {
 			waiter.AddWaitIndex(messageIndex)
 		}
+		if needAcks {
+			q.ackResults[messageIndex] = ackResult{}
+		}
 	}
 
 	q.notifyNewMessages()
@@ -146,7 +166,7 @@ func (q *messageQueue) addMessageNeedLock(
 	return messageIndex
 }
 
-func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
+func (q *messageQueue) AcksReceived(partitionID int64, acks []rawtopicwriter.WriteAck) error {
 	ackReceivedCounter := 0
 	q.m.Lock()
 	defer func() {
@@ -161,7 +181,7 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 	}
 
 	for i := range acks {
-		if err := q.ackReceivedNeedLock(acks[i].SeqNo); err != nil {
+		if err := q.ackReceivedNeedLock(partitionID, &acks[i]); err != nil {
 			return err
 		}
 		ackReceivedCounter++
@@ -172,15 +192,27 @@ func (q *messageQueue) AcksReceived(acks []rawtopicwriter.WriteAck) error {
 	return nil
 }
 
-func (q *messageQueue) ackReceivedNeedLock(seqNo int64) error {
-	orderID, ok := q.seqNoToOrderID[seqNo]
+func (q *messageQueue) ackReceivedNeedLock(partitionID int64, ack *rawtopicwriter.WriteAck) error {
+	orderID, ok := q.seqNoToOrderID[ack.SeqNo]
 	if !ok {
 		return xerrors.WithStackTrace(errAckUnexpectedMessage)
 	}
 
-	delete(q.seqNoToOrderID, seqNo)
+	delete(q.seqNoToOrderID, ack.SeqNo)
 	delete(q.messagesByOrder, orderID)
 
+	if _, ok = q.ackResults[orderID]; ok {
+		q.ackResults[orderID] = ackResult{
+			ack: PublicWriteAck{
+				SeqNo:        ack.SeqNo,
+				Offset:       ack.MessageWriteStatus.WrittenOffset,
+				PartitionID:  partitionID,
+				Deduplicated: ack.MessageWriteStatus.Type == rawtopicwriter.WriteStatusTypeSkipped,
+			},
+			received: true,
+		}
+	}
+
 	return nil
 }
 
@@ -338,6 +370,31 @@ func (q *messageQueue) Wait(ctx context.Context, waiter MessageQueueAckWaiter) e
 	}
 }
 
+// WaitAcks waits acks for all messages of waiter, which added by AddMessagesWithAckWaiter
+// and returns acks in order of messages
+func (q *messageQueue) WaitAcks(ctx context.Context, waiter MessageQueueAckWaiter) ([]PublicWriteAck, error) {
+	waitErr := q.Wait(ctx, waiter)
+
+	q.m.Lock()
+	defer q.m.Unlock()
+
+	acks := make([]PublicWriteAck, 0, len(waiter.sequenseNumbers))
+	for _, index := range waiter.sequenseNumbers {
+		res, ok := q.ackResults[index]
+		delete(q.ackResults, index)
+		if waitErr == nil && (!ok || !res.received) {
+			waitErr = xerrors.WithStackTrace(errAckUnexpectedMessage)
+		}
+		acks = append(acks, res.ack)
+	}
+
+	if waitErr != nil {
+		return nil, waitErr
+	}
+
+	return acks, nil
+}
+
 // WaitLastWritten waits for last written message gets ack.
 func (q *messageQueue) WaitLastWritten(ctx context.Context) error {
 	var lastIndex int
@@ -418,7 +418,7 @@ func TestRegressionIssue1038_ReceiveAckAfterCloseQueue(t *testing.T) {
 	counter++
 
 	require.NoError(t, q.Close(errors.New("test err")))
-	require.ErrorIs(t, q.AcksReceived([]rawtopicwriter.WriteAck{
+	require.ErrorIs(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{
 		{
 			SeqNo:              1,
 			MessageWriteStatus: rawtopicwriter.MessageWriteStatus{},
@@ -432,7 +432,7 @@ func TestQueue_Ack(t *testing.T) {
 		q := newMessageQueue()
 		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1, 2, 5)))
 
-		require.NoError(t, q.AcksReceived([]rawtopicwriter.WriteAck{
+		require.NoError(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 2,
 			},
@@ -448,7 +448,7 @@ func TestQueue_Ack(t *testing.T) {
 		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1)))
 
 		// remove first with the seqno
-		require.Error(t, q.AcksReceived([]rawtopicwriter.WriteAck{
+		require.Error(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 5,
 			},
@@ -472,7 +472,7 @@ func TestQueue_Ack(t *testing.T) {
 		err := q.AddMessages(newTestMessagesWithContent(1, 2, 3))
 		require.NoError(t, err)
 
-		err = q.AcksReceived([]rawtopicwriter.WriteAck{
+		err = q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 1,
 			},
@@ -485,7 +485,7 @@ func TestQueue_Ack(t *testing.T) {
 		require.Equal(t, 2, receivedCount)
 
 		// Double ack
-		err = q.AcksReceived([]rawtopicwriter.WriteAck{
+		err = q.AcksReceived(0, []rawtopicwriter.WriteAck{
 			{
 				SeqNo: 1,
 			},
@@ -514,3 +514,71 @@ func getSeqNumbers(messages []messageWithDataContent) []int64 {
 
 	return res
 }
+
+func TestQueue_WaitAcks(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		ctx := context.Background()
+		q := newMessageQueue()
+		require.NoError(t, q.AddMessages(newTestMessagesWithContent(1)))
+		waiter, err := q.AddMessagesWithAckWaiter(newTestMessagesWithContent(2, 3))
+		require.NoError(t, err)
+
+		require.NoError(t, q.AcksReceived(5, []rawtopicwriter.WriteAck{
+			{
+				SeqNo: 1,
+				MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+					Type:          rawtopicwriter.WriteStatusTypeWritten,
+					WrittenOffset: 10,
+				},
+			},
+			{
+				SeqNo: 2,
+				MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+					Type:          rawtopicwriter.WriteStatusTypeWritten,
+					WrittenOffset: 11,
+				},
+			},
+			{
+				SeqNo: 3,
+				MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+					Type: rawtopicwriter.WriteStatusTypeSkipped,
+				},
+			},
+		}))
+
+		acks, err := q.WaitAcks(ctx, waiter)
+		require.NoError(t, err)
+		require.Equal(t, []PublicWriteAck{
+			{SeqNo: 2, Offset: 11, PartitionID: 5},
+			{SeqNo: 3, PartitionID: 5, Deduplicated: true},
+		}, acks)
+		require.Empty(t, q.ackResults)
+	})
+	t.Run("ContextCancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		q := newMessageQueue()
+		waiter, err := q.AddMessagesWithAckWaiter(newTestMessagesWithContent(1, 2))
+		require.NoError(t, err)
+		require.NoError(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{{SeqNo: 1}}))
+
+		cancel()
+		_, err = q.WaitAcks(ctx, waiter)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Empty(t, q.ackResults)
+
+		// late ack of message without waiter
+		require.NoError(t, q.AcksReceived(0, []rawtopicwriter.WriteAck{{SeqNo: 2}}))
+		require.Empty(t, q.ackResults)
+	})
+	t.Run("Closed", func(t *testing.T) {
+		ctx := context.Background()
+		q := newMessageQueue()
+		waiter, err := q.AddMessagesWithAckWaiter(newTestMessagesWithContent(1))
+		require.NoError(t, err)
+
+		testErr := errors.New("test")
+		require.NoError(t, q.Close(testErr))
+		_, err = q.WaitAcks(ctx, waiter)
+		require.ErrorIs(t, err, testErr)
+	})
+}
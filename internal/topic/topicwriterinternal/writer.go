@@ -7,6 +7,7 @@ import (
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopicwriter"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
 //go:generate mockgen -destination raw_topic_writer_stream_mock_test.go -package topicwriterinternal -write_package_comment=false github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicwriterinternal RawTopicWriterStream
@@ -20,6 +21,7 @@ type RawTopicWriterStream interface {
 type Writer struct {
 	streamWriter StreamWriter
 	clock        clockwork.Clock
+	onClose      []func()
 }
 
 func NewWriter(cred credentials.Credentials, options []PublicWriterOption) (*Writer, error) {
@@ -48,14 +50,46 @@ func (w *Writer) Write(ctx context.Context, messages ...PublicMessage) error {
 	return w.streamWriter.Write(ctx, messages)
 }
 
+func (w *Writer) WriteWithAck(ctx context.Context, messages ...PublicMessage) ([]PublicWriteAck, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return w.streamWriter.WriteWithAck(ctx, messages)
+}
+
+func (w *Writer) WriteWithAckAsync(ctx context.Context, messages ...PublicMessage) (<-chan PublicWriteAckResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return w.streamWriter.WriteWithAckAsync(ctx, messages)
+}
+
 func (w *Writer) WaitInit(ctx context.Context) (info InitialInfo, err error) {
 	return w.streamWriter.WaitInit(ctx)
 }
 
 func (w *Writer) Close(ctx context.Context) error {
+	defer func() {
+		for _, f := range w.onClose {
+			f()
+		}
+	}()
+
 	return w.streamWriter.Close(ctx)
 }
 
+// IsClosedError checks that err is returned from Close of writer which already stopped
+func IsClosedError(err error) bool {
+	return xerrors.Is(err, errCloseClosedMessageQueue)
+}
+
+// OnClose adds callback which will be called after each close of writer
+func (w *Writer) OnClose(f func()) {
+	w.onClose = append(w.onClose, f)
+}
+
 func (w *Writer) Flush(ctx context.Context) error {
 	return w.streamWriter.Flush(ctx)
 }
@@ -27,6 +27,12 @@ func WithAutoSetSeqNo(val bool) PublicWriterOption {
 	}
 }
 
+func WithGetLastSeqNo(val bool) PublicWriterOption {
+	return func(cfg *WriterReconnectorConfig) {
+		cfg.GetLastSeqNo = val
+	}
+}
+
 func WithAutoCodec() PublicWriterOption {
 	return func(cfg *WriterReconnectorConfig) {
 		cfg.forceCodec = rawtopiccommon.CodecUNSPECIFIED
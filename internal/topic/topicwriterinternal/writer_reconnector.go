@@ -33,8 +33,8 @@ import (
 var (
 	errConnTimeout           = xerrors.Wrap(errors.New("ydb: connection timeout"))
 	errStopWriterReconnector = xerrors.Wrap(errors.New("ydb: stop writer reconnector"))
-	errNonZeroSeqNo          = xerrors.Wrap(errors.New("ydb: non zero seqno for auto set seqno mode"))
-	errNonZeroCreatedAt      = xerrors.Wrap(errors.New("ydb: non zero Message.CreatedAt and set auto fill created at option")) //nolint:lll
+	errNonZeroSeqNo          = xerrors.Wrap(errors.New("ydb: non zero Message.SeqNo for auto set seqno mode, disable auto set seqno for explicit SeqNo")) //nolint:lll
+	errNonZeroCreatedAt      = xerrors.Wrap(errors.New("ydb: non zero Message.CreatedAt and set auto fill created at option"))                            //nolint:lll
 	errNoAllowedCodecs       = xerrors.Wrap(errors.New("ydb: no allowed codecs for write to topic"))
 	errLargeMessage          = xerrors.Wrap(errors.New("ydb: message uncompressed size more, then limit"))
 	PublicErrQueueIsFull     = xerrors.Wrap(errors.New("ydb: queue is full"))
@@ -45,6 +45,10 @@ var (
 	// It is fast check for return error at writer create context instead of stream initialization
 	// The error will remove in the future, when skip message group id will be allowed by server.
 	errProducerIDNotEqualMessageGroupID = xerrors.Wrap(errors.New("ydb: producer id not equal to message group id, use option WithMessageGroupID(producerID) for create writer")) //nolint:lll
+
+	// errAutoSeqNoWithoutLastSeqNo returns on writer create because auto set seqno continues from last seqno
+	// of producer and can't start from zero without duplicates skipped by server
+	errAutoSeqNoWithoutLastSeqNo = xerrors.Wrap(errors.New("ydb: auto set seqno mode requires get last seqno on writer init, use option WithWriterGetLastSeqNo(true)")) //nolint:lll
 )
 
 type WriterReconnectorConfig struct {
@@ -57,6 +61,7 @@ type WriterReconnectorConfig struct {
 	Connect                      ConnectFunc
 	WaitServerAck                bool
 	AutoSetSeqNo                 bool
+	GetLastSeqNo                 bool
 	AutoSetCreatedTime           bool
 	OnWriterInitResponseCallback PublicOnWriterInitResponseCallback
 	RetrySettings                topic.RetrySettings
@@ -69,6 +74,9 @@ func (cfg *WriterReconnectorConfig) validate() error {
 		cfg.producerID != cfg.defaultPartitioning.MessageGroupID {
 		return xerrors.WithStackTrace(errProducerIDNotEqualMessageGroupID)
 	}
+	if cfg.AutoSetSeqNo && !cfg.GetLastSeqNo {
+		return xerrors.WithStackTrace(errAutoSeqNoWithoutLastSeqNo)
+	}
 
 	return nil
 }
@@ -83,6 +91,7 @@ func newWriterReconnectorConfig(options ...PublicWriterOption) WriterReconnector
 			tracer:             &trace.Topic{},
 		},
 		AutoSetSeqNo:       true,
+		GetLastSeqNo:       true,
 		AutoSetCreatedTime: true,
 		MaxMessageSize:     50 * 1024 * 1024, //nolint:gomnd
 		MaxQueueLen:        1000,             //nolint:gomnd
@@ -207,19 +216,67 @@ func (w *WriterReconnector) start() {
 }
 
 func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage) error {
+	waiter, err := w.addMessages(ctx, messages, w.cfg.WaitServerAck, false)
+	if err != nil {
+		return err
+	}
+
+	if !w.cfg.WaitServerAck {
+		return nil
+	}
+
+	return w.queue.Wait(ctx, waiter)
+}
+
+// WriteWithAck writes messages and waits acks of all of them independent of sync write mode
+func (w *WriterReconnector) WriteWithAck(ctx context.Context, messages []PublicMessage) ([]PublicWriteAck, error) {
+	waiter, err := w.addMessages(ctx, messages, true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.queue.WaitAcks(ctx, waiter)
+}
+
+// WriteWithAckAsync adds messages to the queue same as Write in async mode and waits acks in background
+func (w *WriterReconnector) WriteWithAckAsync(
+	ctx context.Context,
+	messages []PublicMessage,
+) (<-chan PublicWriteAckResult, error) {
+	waiter, err := w.addMessages(ctx, messages, true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(chan PublicWriteAckResult, 1)
+	go func() {
+		defer close(res)
+
+		acks, err := w.queue.WaitAcks(ctx, waiter)
+		res <- PublicWriteAckResult{Acks: acks, Err: err}
+	}()
+
+	return res, nil
+}
+
+func (w *WriterReconnector) addMessages(
+	ctx context.Context,
+	messages []PublicMessage,
+	needWaiter, needAcks bool,
+) (waiter MessageQueueAckWaiter, err error) {
 	if err := w.background.CloseReason(); err != nil {
-		return xerrors.WithStackTrace(fmt.Errorf("ydb: writer is closed: %w", err))
+		return waiter, xerrors.WithStackTrace(fmt.Errorf("ydb: writer is closed: %w", err))
 	}
 	if ctx.Err() != nil {
-		return ctx.Err()
+		return waiter, ctx.Err()
 	}
 	if len(messages) == 0 {
-		return nil
+		return waiter, nil
 	}
 
 	semaphoreWeight := int64(len(messages))
 	if semaphoreWeight > int64(w.cfg.MaxQueueLen) {
-		return xerrors.WithStackTrace(fmt.Errorf(
+		return waiter, xerrors.WithStackTrace(fmt.Errorf(
 			"ydb: add more messages, then max queue limit. max queue: %v, try to add: %v: %w",
 			w.cfg.MaxQueueLen,
 			semaphoreWeight,
@@ -227,7 +284,7 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 		))
 	}
 	if err := w.semaphore.Acquire(ctx, semaphoreWeight); err != nil {
-		return xerrors.WithStackTrace(
+		return waiter, xerrors.WithStackTrace(
 			fmt.Errorf("ydb: add new messages exceed max queue size limit. Add count: %v, max size: %v: %w",
 				semaphoreWeight,
 				w.cfg.MaxQueueLen,
@@ -240,18 +297,17 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 
 	messagesSlice, err := w.createMessagesWithContent(messages)
 	if err != nil {
-		return err
+		return waiter, err
 	}
 
 	if err = w.checkMessages(messagesSlice); err != nil {
-		return err
+		return waiter, err
 	}
 
 	if err = w.waitFirstInitResponse(ctx); err != nil {
-		return err
+		return waiter, err
 	}
 
-	var waiter MessageQueueAckWaiter
 	w.m.WithLock(func() {
 		// need set numbers and add to queue atomically
 		err = w.fillFields(messagesSlice)
@@ -259,9 +315,12 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 			return
 		}
 
-		if w.cfg.WaitServerAck {
+		switch {
+		case needAcks:
+			waiter, err = w.queue.AddMessagesWithAckWaiter(messagesSlice)
+		case needWaiter:
 			waiter, err = w.queue.AddMessagesWithWaiter(messagesSlice)
-		} else {
+		default:
 			err = w.queue.AddMessages(messagesSlice)
 		}
 		if err == nil {
@@ -269,15 +328,8 @@ func (w *WriterReconnector) Write(ctx context.Context, messages []PublicMessage)
 			semaphoreWeight = 0
 		}
 	})
-	if err != nil {
-		return err
-	}
 
-	if !w.cfg.WaitServerAck {
-		return nil
-	}
-
-	return w.queue.Wait(ctx, waiter)
+	return waiter, err
 }
 
 func (w *WriterReconnector) checkMessages(messages []messageWithDataContent) error {
@@ -455,7 +507,7 @@ func (w *WriterReconnector) startWriteStream(ctx, streamCtx context.Context, att
 }
 
 func (w *WriterReconnector) needReceiveLastSeqNo() bool {
-	res := !w.firstConnectionHandled.Load()
+	res := w.cfg.GetLastSeqNo && !w.firstConnectionHandled.Load()
 
 	return res
 }
@@ -529,7 +581,10 @@ func (w *WriterReconnector) onWriterChange(writerStream *SingleStreamWriter) {
 	if isFirstInit {
 		w.m.WithLock(func() {
 			w.initDone = true
-			w.initInfo = InitialInfo{LastSeqNum: w.lastSeqNo}
+			w.initInfo = InitialInfo{
+				LastSeqNum:  w.lastSeqNo,
+				PartitionID: writerStream.PartitionID,
+			}
 			close(w.initDoneCh)
 		})
 		w.onWriterInitCallbackHandler(writerStream)
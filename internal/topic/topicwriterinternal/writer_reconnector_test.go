@@ -176,6 +176,84 @@ func TestWriterImpl_Write(t *testing.T) {
 			xtest.WaitChannelClosed(t, writeCompleted)
 		})
 	})
+	t.Run("WriteWithAck", func(t *testing.T) {
+		xtest.TestManyTimes(t, func(t testing.TB) {
+			e := newTestEnv(t, nil)
+
+			e.stream.EXPECT().Send(gomock.Any()).Return(nil).AnyTimes()
+
+			type writeResult struct {
+				acks []PublicWriteAck
+				err  error
+			}
+			writeCompleted := make(chan writeResult, 1)
+			go func() {
+				acks, err := e.writer.WriteWithAck(e.ctx, newTestMessages(1, 2))
+				writeCompleted <- writeResult{acks: acks, err: err}
+			}()
+
+			xtest.SpinWaitCondition(t, &e.writer.queue.m, func() bool {
+				return len(e.writer.queue.ackResults) == 2
+			})
+
+			e.sendFromServer(&rawtopicwriter.WriteResult{
+				Acks: []rawtopicwriter.WriteAck{
+					{
+						SeqNo: 2,
+						MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+							Type:          rawtopicwriter.WriteStatusTypeSkipped,
+							SkippedReason: rawtopicwriter.WriteStatusSkipReasonAlreadyWritten,
+						},
+					},
+					{
+						SeqNo: 1,
+						MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+							Type:          rawtopicwriter.WriteStatusTypeWritten,
+							WrittenOffset: 10,
+						},
+					},
+				},
+				PartitionID: e.partitionID,
+			})
+
+			res := <-writeCompleted
+			require.NoError(t, res.err)
+			require.Equal(t, []PublicWriteAck{
+				{SeqNo: 1, Offset: 10, PartitionID: e.partitionID},
+				{SeqNo: 2, PartitionID: e.partitionID, Deduplicated: true},
+			}, res.acks)
+			require.Empty(t, e.writer.queue.ackResults)
+		})
+	})
+	t.Run("WriteWithAckAsync", func(t *testing.T) {
+		e := newTestEnv(t, nil)
+
+		e.stream.EXPECT().Send(gomock.Any()).Return(nil).AnyTimes()
+
+		ackResult, err := e.writer.WriteWithAckAsync(e.ctx, newTestMessages(1))
+		require.NoError(t, err)
+		require.NoError(t, e.writer.Write(e.ctx, newTestMessages(2)))
+
+		e.sendFromServer(&rawtopicwriter.WriteResult{
+			Acks: []rawtopicwriter.WriteAck{
+				{
+					SeqNo: 1,
+					MessageWriteStatus: rawtopicwriter.MessageWriteStatus{
+						Type:          rawtopicwriter.WriteStatusTypeWritten,
+						WrittenOffset: 3,
+					},
+				},
+			},
+			PartitionID: e.partitionID,
+		})
+
+		res := <-ackResult
+		require.NoError(t, res.Err)
+		require.Equal(t, []PublicWriteAck{{SeqNo: 1, Offset: 3, PartitionID: e.partitionID}}, res.Acks)
+
+		_, ok := <-ackResult
+		require.False(t, ok)
+	})
 }
 
 func TestWriterImpl_WriteCodecs(t *testing.T) {
@@ -303,7 +381,7 @@ func TestWriterReconnector_Write_QueueLimit(t *testing.T) {
 
 		go func() {
 			waitStartQueueWait(1)
-			ackErr := w.queue.AcksReceived([]rawtopicwriter.WriteAck{
+			ackErr := w.queue.AcksReceived(0, []rawtopicwriter.WriteAck{
 				{
 					SeqNo: 1,
 				},
@@ -339,15 +417,50 @@ func TestWriterImpl_InitSession(t *testing.T) {
 	require.True(t, isClosed(w.firstInitResponseProcessedChan))
 }
 
+func TestWriterImpl_SeqNoModes(t *testing.T) {
+	t.Run("AutoSeqNoRejectsExplicitSeqNo", func(t *testing.T) {
+		w := newTestWriterStopped(WithAutoSetSeqNo(true))
+		w.onWriterChange(&SingleStreamWriter{})
+		err := w.Write(context.Background(), []PublicMessage{{SeqNo: 1}})
+		require.ErrorIs(t, err, errNonZeroSeqNo)
+	})
+	t.Run("AutoSeqNoRequiresLastSeqNo", func(t *testing.T) {
+		cfg := newWriterReconnectorConfig(
+			WithProducerID("test-producer-id"),
+			WithAutoSetSeqNo(true),
+			WithGetLastSeqNo(false),
+		)
+		require.ErrorIs(t, cfg.validate(), errAutoSeqNoWithoutLastSeqNo)
+	})
+	t.Run("ManualSeqNoWithoutLastSeqNo", func(t *testing.T) {
+		cfg := newWriterReconnectorConfig(
+			WithProducerID("test-producer-id"),
+			WithAutoSetSeqNo(false),
+			WithGetLastSeqNo(false),
+		)
+		require.NoError(t, cfg.validate())
+		w := newTestWriterStopped(WithAutoSetSeqNo(false), WithGetLastSeqNo(false))
+		require.False(t, w.needReceiveLastSeqNo())
+	})
+	t.Run("ManualSeqNoWithLastSeqNo", func(t *testing.T) {
+		w := newTestWriterStopped(WithAutoSetSeqNo(false))
+		require.True(t, w.needReceiveLastSeqNo())
+		w.onWriterChange(&SingleStreamWriter{})
+		require.False(t, w.needReceiveLastSeqNo())
+	})
+}
+
 func TestWriterImpl_WaitInit(t *testing.T) {
 	t.Run("OK", func(t *testing.T) {
 		w := newTestWriterStopped(WithAutoSetSeqNo(true))
 		expectedInitData := InitialInfo{
-			LastSeqNum: int64(123),
+			LastSeqNum:  int64(123),
+			PartitionID: 5,
 		}
 		w.onWriterChange(&SingleStreamWriter{
 			ReceivedLastSeqNum:  expectedInitData.LastSeqNum,
 			LastSeqNumRequested: true,
+			PartitionID:         expectedInitData.PartitionID,
 		})
 
 		initData, err := w.WaitInit(context.Background())
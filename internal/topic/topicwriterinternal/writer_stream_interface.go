@@ -7,11 +7,37 @@ import (
 //go:generate mockgen -source writer_stream_interface.go -destination writer_stream_interface_mock_test.go -package topicwriterinternal -write_package_comment=false
 type StreamWriter interface {
 	Write(ctx context.Context, messages []PublicMessage) error
+	WriteWithAck(ctx context.Context, messages []PublicMessage) ([]PublicWriteAck, error)
+	WriteWithAckAsync(ctx context.Context, messages []PublicMessage) (<-chan PublicWriteAckResult, error)
 	WaitInit(ctx context.Context) (info InitialInfo, err error)
 	Close(ctx context.Context) error
 	Flush(ctx context.Context) error
 }
 
 type InitialInfo struct {
-	LastSeqNum int64
+	LastSeqNum  int64
+	PartitionID int64
+}
+
+type PublicWriteAck struct {
+	// SeqNo is a sequence number of message
+	SeqNo int64
+
+	// Offset is an offset of message in the partition, it is zero for deduplicated messages
+	Offset int64
+
+	// PartitionID is an id of partition of the message
+	PartitionID int64
+
+	// Deduplicated is true if server skipped the message because message with the SeqNo
+	// was written before (for example by previous writer with same producer id)
+	Deduplicated bool
+}
+
+type PublicWriteAckResult struct {
+	// Acks contains acks of messages in order of messages in write call
+	Acks []PublicWriteAck
+
+	// Err is an error of waiting acks, Acks is empty if Err is not nil
+	Err error
 }
@@ -92,3 +92,33 @@ func (mr *MockStreamWriterMockRecorder) Write(ctx, messages any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockStreamWriter)(nil).Write), ctx, messages)
 }
+
+// WriteWithAck mocks base method.
+func (m *MockStreamWriter) WriteWithAck(ctx context.Context, messages []PublicMessage) ([]PublicWriteAck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteWithAck", ctx, messages)
+	ret0, _ := ret[0].([]PublicWriteAck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteWithAck indicates an expected call of WriteWithAck.
+func (mr *MockStreamWriterMockRecorder) WriteWithAck(ctx, messages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteWithAck", reflect.TypeOf((*MockStreamWriter)(nil).WriteWithAck), ctx, messages)
+}
+
+// WriteWithAckAsync mocks base method.
+func (m *MockStreamWriter) WriteWithAckAsync(ctx context.Context, messages []PublicMessage) (<-chan PublicWriteAckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteWithAckAsync", ctx, messages)
+	ret0, _ := ret[0].(<-chan PublicWriteAckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteWithAckAsync indicates an expected call of WriteWithAckAsync.
+func (mr *MockStreamWriterMockRecorder) WriteWithAckAsync(ctx, messages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteWithAckAsync", reflect.TypeOf((*MockStreamWriter)(nil).WriteWithAckAsync), ctx, messages)
+}
@@ -1,5 +1,163 @@
 package value
 
+import (
+	"database/sql"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// CastTo try cast value to destination
+//
+// Destinations are checked in the following order:
+//  0. Decoder, which receives value as is
+//  1. destinations natively supported by value type (such as *string for Text values
+//     or encoding.TextUnmarshaler for UUID values)
+//  2. sql.Scanner, which receives Go representation of value (nil for Null values)
+//  3. encoding.TextUnmarshaler, which receives text representation of value
+//  4. encoding.BinaryUnmarshaler, which receives bytes representation of value
+//  5. any other pointer for Json and JsonDocument values, which is filled with json.Unmarshal
 func CastTo(v Value, dst interface{}) error {
-	return v.castTo(dst)
+	if decoder, ok := dst.(Decoder); ok {
+		return decoder.ScanYDBValue(v)
+	}
+
+	err := v.castTo(dst)
+	if err == nil || !xerrors.Is(err, ErrCannotCast) {
+		return err
+	}
+
+	if ok, castErr := castToUnmarshaler(v, dst); ok {
+		return castErr
+	}
+
+	return err
+}
+
+func castToUnmarshaler(v Value, dst interface{}) (ok bool, _ error) {
+	if scanner, ok := dst.(sql.Scanner); ok {
+		src, err := toGo(v)
+		if err != nil {
+			return true, xerrors.WithStackTrace(err)
+		}
+		if err = scanner.Scan(src); err != nil {
+			return true, xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%s' (type '%s') to sql.Scanner '%T': %w",
+				ErrCannotCast, v.Yql(), v.Type().Yql(), dst, err,
+			))
+		}
+
+		return true, nil
+	}
+
+	if unmarshaler, ok := dst.(encoding.TextUnmarshaler); ok {
+		var text string
+		if err := v.castTo(&text); err != nil {
+			return true, xerrors.WithStackTrace(err)
+		}
+		if err := unmarshaler.UnmarshalText([]byte(text)); err != nil {
+			return true, xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%s' (type '%s') to encoding.TextUnmarshaler '%T': %w",
+				ErrCannotCast, v.Yql(), v.Type().Yql(), dst, err,
+			))
+		}
+
+		return true, nil
+	}
+
+	if unmarshaler, ok := dst.(encoding.BinaryUnmarshaler); ok {
+		var data []byte
+		if err := v.castTo(&data); err != nil {
+			return true, xerrors.WithStackTrace(err)
+		}
+		if err := unmarshaler.UnmarshalBinary(data); err != nil {
+			return true, xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%s' (type '%s') to encoding.BinaryUnmarshaler '%T': %w",
+				ErrCannotCast, v.Yql(), v.Type().Yql(), dst, err,
+			))
+		}
+
+		return true, nil
+	}
+
+	if t := v.Type(); t == types.JSON || t == types.JSONDocument {
+		if reflect.ValueOf(dst).Kind() != reflect.Ptr {
+			return false, nil
+		}
+		var data []byte
+		if err := v.castTo(&data); err != nil {
+			return true, xerrors.WithStackTrace(err)
+		}
+		if err := json.Unmarshal(data, dst); err != nil {
+			return true, xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%s' (type '%s') to '%T' with json.Unmarshal: %w",
+				ErrCannotCast, v.Yql(), v.Type().Yql(), dst, err,
+			))
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// toGo returns Go representation of value such as database/sql driver returns
+// (UUID values are represented as canonical text, containers are represented as Value)
+//
+//nolint:gocyclo
+func toGo(v Value) (interface{}, error) {
+	if optional, ok := v.(*optionalValue); ok {
+		if optional.value == nil {
+			return nil, nil //nolint:nilnil
+		}
+
+		return toGo(optional.value)
+	}
+
+	var dst interface{}
+	switch v.Type() {
+	case types.Bool:
+		dst = new(bool)
+	case types.Int8:
+		dst = new(int8)
+	case types.Int16:
+		dst = new(int16)
+	case types.Int32:
+		dst = new(int32)
+	case types.Int64:
+		dst = new(int64)
+	case types.Uint8:
+		dst = new(uint8)
+	case types.Uint16:
+		dst = new(uint16)
+	case types.Uint32:
+		dst = new(uint32)
+	case types.Uint64:
+		dst = new(uint64)
+	case types.Float:
+		dst = new(float32)
+	case types.Double:
+		dst = new(float64)
+	case types.Date, types.Datetime, types.Timestamp, types.TzDate, types.TzDatetime, types.TzTimestamp:
+		dst = new(time.Time)
+	case types.Interval:
+		dst = new(time.Duration)
+	case types.Text, types.DyNumber, types.UUID:
+		dst = new(string)
+	case types.Bytes, types.YSON, types.JSON, types.JSONDocument:
+		dst = new([]byte)
+	default:
+		return v, nil
+	}
+
+	if err := v.castTo(dst); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return reflect.ValueOf(dst).Elem().Interface(), nil
 }
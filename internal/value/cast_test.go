@@ -0,0 +1,175 @@
+package value
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testScanner struct {
+	src interface{}
+}
+
+func (s *testScanner) Scan(src interface{}) error {
+	s.src = src
+
+	return nil
+}
+
+type testTextUnmarshaler struct {
+	text string
+}
+
+func (u *testTextUnmarshaler) UnmarshalText(text []byte) error {
+	u.text = string(text)
+
+	return nil
+}
+
+type testBinaryUnmarshaler struct {
+	data []byte
+}
+
+func (u *testBinaryUnmarshaler) UnmarshalBinary(data []byte) error {
+	u.data = append([]byte{}, data...)
+
+	return nil
+}
+
+type testTextAndBinaryUnmarshaler struct {
+	from string
+}
+
+func (u *testTextAndBinaryUnmarshaler) UnmarshalText(text []byte) error {
+	u.from = "text:" + string(text)
+
+	return nil
+}
+
+func (u *testTextAndBinaryUnmarshaler) UnmarshalBinary(data []byte) error {
+	u.from = "binary:" + string(data)
+
+	return nil
+}
+
+type testScannerAndTextUnmarshaler struct {
+	from string
+}
+
+func (u *testScannerAndTextUnmarshaler) Scan(src interface{}) error {
+	u.from = fmt.Sprintf("scan:%v", src)
+
+	return nil
+}
+
+func (u *testScannerAndTextUnmarshaler) UnmarshalText(text []byte) error {
+	u.from = "text:" + string(text)
+
+	return nil
+}
+
+type testFailedUnmarshaler struct{}
+
+func (testFailedUnmarshaler) UnmarshalText([]byte) error {
+	return errors.New("test")
+}
+
+func TestCastToUnmarshalers(t *testing.T) {
+	t.Run("sql.Scanner", func(t *testing.T) {
+		for _, tt := range []struct {
+			v   Value
+			exp interface{}
+		}{
+			{v: Int32Value(42), exp: int32(42)},
+			{v: TextValue("test"), exp: "test"},
+			{v: BytesValue([]byte("test")), exp: []byte("test")},
+			{v: DatetimeValue(1), exp: DatetimeToTime(1)},
+			{v: OptionalValue(Uint64Value(42)), exp: uint64(42)},
+			{v: NullValue(Uint64Value(42).Type()), exp: nil},
+			{v: UUIDValue([16]byte{1, 2, 3}), exp: "01020300-0000-0000-0000-000000000000"},
+			{v: ListValue(Int32Value(1)), exp: ListValue(Int32Value(1))},
+		} {
+			t.Run(tt.v.Yql(), func(t *testing.T) {
+				dst := &testScanner{src: "not scanned"}
+				require.NoError(t, CastTo(tt.v, dst))
+				require.Equal(t, tt.exp, dst.src)
+			})
+		}
+	})
+	t.Run("encoding.TextUnmarshaler", func(t *testing.T) {
+		var dst testTextUnmarshaler
+		require.NoError(t, CastTo(TextValue("test"), &dst))
+		require.Equal(t, "test", dst.text)
+		require.NoError(t, CastTo(JSONValue(`{"a":1}`), &dst))
+		require.Equal(t, `{"a":1}`, dst.text)
+		require.NoError(t, CastTo(OptionalValue(BytesValue([]byte("bytes"))), &dst))
+		require.Equal(t, "bytes", dst.text)
+	})
+	t.Run("encoding.BinaryUnmarshaler", func(t *testing.T) {
+		var dst testBinaryUnmarshaler
+		require.NoError(t, CastTo(BytesValue([]byte{1, 2, 3}), &dst))
+		require.Equal(t, []byte{1, 2, 3}, dst.data)
+		require.NoError(t, CastTo(YSONValue([]byte("[1]")), &dst))
+		require.Equal(t, []byte("[1]"), dst.data)
+	})
+	t.Run("DoublePointer", func(t *testing.T) {
+		var dst *testTextUnmarshaler
+		require.NoError(t, CastTo(OptionalValue(TextValue("test")), &dst))
+		require.NotNil(t, dst)
+		require.Equal(t, "test", dst.text)
+		require.NoError(t, CastTo(NullValue(TextValue("").Type()), &dst))
+		require.Nil(t, dst)
+	})
+	t.Run("Precedence", func(t *testing.T) {
+		t.Run("TextBeforeBinary", func(t *testing.T) {
+			var dst testTextAndBinaryUnmarshaler
+			require.NoError(t, CastTo(BytesValue([]byte("test")), &dst))
+			require.Equal(t, "text:test", dst.from)
+		})
+		t.Run("ScannerBeforeText", func(t *testing.T) {
+			var dst testScannerAndTextUnmarshaler
+			require.NoError(t, CastTo(TextValue("test"), &dst))
+			require.Equal(t, "scan:test", dst.from)
+		})
+		t.Run("NativeBeforeUnmarshalers", func(t *testing.T) {
+			var dst testScannerAndTextUnmarshaler
+			require.NoError(t, CastTo(UUIDValue([16]byte{1}), &dst))
+			require.Equal(t, "text:01000000-0000-0000-0000-000000000000", dst.from)
+		})
+	})
+	t.Run("Errors", func(t *testing.T) {
+		require.ErrorIs(t, CastTo(TextValue("test"), &testFailedUnmarshaler{}), ErrCannotCast)
+		require.ErrorIs(t, CastTo(ListValue(Int32Value(1)), &testBinaryUnmarshaler{}), ErrCannotCast)
+	})
+}
+
+type testValuer struct {
+	v driver.Value
+}
+
+func (v testValuer) Value() (driver.Value, error) {
+	return v.v, nil
+}
+
+func TestFromGoValuer(t *testing.T) {
+	v, err := FromGo(testValuer{v: "test"}, nil, "")
+	require.NoError(t, err)
+	require.True(t, Equal(TextValue("test"), v))
+
+	v, err = FromGo(struct {
+		A testValuer  `ydb:"a"`
+		B *testValuer `ydb:"b"`
+	}{A: testValuer{v: int64(42)}}, nil, "")
+	require.NoError(t, err)
+	require.True(t, Equal(StructValue(
+		StructValueField{Name: "a", V: Int64Value(42)},
+		StructValueField{Name: "b", V: NullValue(StructValue().Type())},
+	), v), v.Yql())
+
+	v, err = FromGo(testValuer{v: "test"}, BytesValue(nil).Type(), "")
+	require.NoError(t, err)
+	require.True(t, Equal(BytesValue([]byte("test")), v))
+}
@@ -0,0 +1,35 @@
+package value
+
+import (
+	"hash/fnv"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+// Equal checks values for equality of types and contents
+//
+// Dict and Set values are equal independently of order of items.
+// Float and Double NaN values are equal to each other.
+func Equal(a, b Value) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if !types.Equal(a.Type(), b.Type()) {
+		return false
+	}
+
+	return a.Yql() == b.Yql()
+}
+
+// Hash returns hash of value which is consistent with Equal
+func Hash(v Value) uint64 {
+	h := fnv.New64a()
+	if v == nil {
+		return h.Sum64()
+	}
+	_, _ = h.Write([]byte(v.Type().Yql()))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(v.Yql()))
+
+	return h.Sum64()
+}
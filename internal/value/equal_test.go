@@ -0,0 +1,156 @@
+package value
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestEqual(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		a     Value
+		b     Value
+		equal bool
+	}{
+		{
+			name:  "Int32",
+			a:     Int32Value(1),
+			b:     Int32Value(1),
+			equal: true,
+		},
+		{
+			name:  "Int32 vs Int64",
+			a:     Int32Value(1),
+			b:     Int64Value(1),
+			equal: false,
+		},
+		{
+			name:  "Text vs Bytes",
+			a:     TextValue("a"),
+			b:     BytesValue([]byte("a")),
+			equal: false,
+		},
+		{
+			name: "Dict with different order",
+			a: DictValue(
+				DictValueField{K: TextValue("a"), V: Int32Value(1)},
+				DictValueField{K: TextValue("b"), V: Int32Value(2)},
+			),
+			b: DictValue(
+				DictValueField{K: TextValue("b"), V: Int32Value(2)},
+				DictValueField{K: TextValue("a"), V: Int32Value(1)},
+			),
+			equal: true,
+		},
+		{
+			name:  "Set with different order",
+			a:     SetValue(Int32Value(1), Int32Value(2)),
+			b:     SetValue(Int32Value(2), Int32Value(1)),
+			equal: true,
+		},
+		{
+			name:  "List with different order",
+			a:     ListValue(Int32Value(1), Int32Value(2)),
+			b:     ListValue(Int32Value(2), Int32Value(1)),
+			equal: false,
+		},
+		{
+			name: "Struct",
+			a: StructValue(
+				StructValueField{Name: "a", V: Int32Value(1)},
+				StructValueField{Name: "b", V: TextValue("b")},
+			),
+			b: StructValue(
+				StructValueField{Name: "b", V: TextValue("b")},
+				StructValueField{Name: "a", V: Int32Value(1)},
+			),
+			equal: true,
+		},
+		{
+			name:  "Tuple",
+			a:     TupleValue(Int32Value(1), TextValue("b")),
+			b:     TupleValue(Int32Value(1), TextValue("c")),
+			equal: false,
+		},
+		{
+			name:  "Optional vs required",
+			a:     OptionalValue(Int32Value(1)),
+			b:     Int32Value(1),
+			equal: false,
+		},
+		{
+			name:  "Optional<Optional<T>> with Null inside vs Null of Optional<T>",
+			a:     OptionalValue(NullValue(types.Int32)),
+			b:     NullValue(types.NewOptional(types.Int32)),
+			equal: false,
+		},
+		{
+			name:  "Optional<Optional<T>> with Null inside",
+			a:     OptionalValue(NullValue(types.Int32)),
+			b:     OptionalValue(NullValue(types.Int32)),
+			equal: true,
+		},
+		{
+			name:  "Optional<Optional<T>> with value",
+			a:     OptionalValue(OptionalValue(Int32Value(1))),
+			b:     OptionalValue(OptionalValue(Int32Value(1))),
+			equal: true,
+		},
+		{
+			name:  "Decimal with different precision",
+			a:     DecimalValueFromBigInt(big.NewInt(123), 22, 9),
+			b:     DecimalValueFromBigInt(big.NewInt(123), 21, 9),
+			equal: false,
+		},
+		{
+			name:  "nil",
+			a:     nil,
+			b:     nil,
+			equal: true,
+		},
+		{
+			name:  "nil vs value",
+			a:     nil,
+			b:     VoidValue(),
+			equal: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.equal, Equal(tt.a, tt.b))
+			require.Equal(t, tt.equal, Equal(tt.b, tt.a))
+			if tt.equal {
+				require.Equal(t, Hash(tt.a), Hash(tt.b))
+			}
+		})
+	}
+}
+
+func TestYqlLiterals(t *testing.T) {
+	for _, tt := range []struct {
+		v   Value
+		exp string
+	}{
+		{v: Int8Value(-1), exp: "-1t"},
+		{v: Int16Value(-1), exp: "-1s"},
+		{v: Int64Value(-1), exp: "-1l"},
+		{v: DecimalValueFromBigInt(big.NewInt(-1234), 22, 9), exp: `Decimal("-0.000001234",22,9)`},
+		{v: DecimalValueFromBigInt(big.NewInt(0), 22, 9), exp: `Decimal("0.000000000",22,9)`},
+		{v: DecimalValueFromBigInt(big.NewInt(1234567), 10, 2), exp: `Decimal("12345.67",10,2)`},
+		{v: DecimalValueFromBigInt(big.NewInt(12), 5, 0), exp: `Decimal("12",5,0)`},
+		{v: ZeroValue(types.NewList(types.Int32)), exp: "ListCreate(Int32)"},
+		{v: ZeroValue(types.NewSet(types.Int32)), exp: "SetCreate(Int32)"},
+		{v: ZeroValue(types.NewDict(types.Text, types.Int32)), exp: "DictCreate(Utf8,Int32)"},
+		{v: TupleValue(Int32Value(1)), exp: "(1,)"},
+		{v: TupleValue(), exp: "AsTuple()"},
+		{v: OptionalValue(NullValue(types.Int32)), exp: "Just(Nothing(Optional<Int32>))"},
+		{v: NullValue(types.NewOptional(types.Int32)), exp: "Nothing(Optional<Optional<Int32>>)"},
+	} {
+		t.Run(tt.exp, func(t *testing.T) {
+			require.Equal(t, tt.exp, tt.v.Yql())
+		})
+	}
+}
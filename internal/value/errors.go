@@ -4,5 +4,6 @@ import "errors"
 
 var (
 	ErrCannotCast                   = errors.New("cannot cast")
+	ErrOutOfRange                   = errors.New("out of range")
 	errDestinationTypeIsNotAPointer = errors.New("destination type is not a pointer")
 )
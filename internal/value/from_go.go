@@ -0,0 +1,827 @@
+package value
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// DefaultFromGoTagName is a name of struct tag which maps Go struct fields to YDB struct fields
+const DefaultFromGoTagName = "ydb"
+
+var (
+	ErrUnsupportedGoType = errors.New("unsupported go type")
+
+	errNilValue            = errors.New("nil value")
+	errTypeMismatch        = errors.New("type mismatch")
+	errUnknownStructField  = errors.New("unknown struct field")
+	errMissingStructField  = errors.New("missing struct field")
+	errTupleLengthMismatch = errors.New("tuple length mismatch")
+	errRecursiveType       = errors.New("recursive type")
+
+	typeOfValue     = reflect.TypeOf((*Value)(nil)).Elem()
+	typeOfValuer    = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	typeOfTime      = reflect.TypeOf(time.Time{})
+	typeOfDuration  = reflect.TypeOf(time.Duration(0))
+	typeOfBigInt    = reflect.TypeOf(big.Int{})
+	typeOfDecimal   = reflect.TypeOf(decimal.Decimal{})
+	typeOfUUIDBytes = reflect.TypeOf([16]byte{})
+)
+
+// FromGoError describes failure of building Value from Go value
+//
+// Path points to failed item from the root of Go value, such as "$.users[2].name".
+type FromGoError struct {
+	Path string
+	Err  error
+}
+
+func (e *FromGoError) Error() string {
+	return fmt.Sprintf("cannot build ydb value at '%s': %v", e.Path, e.Err)
+}
+
+func (e *FromGoError) Unwrap() error {
+	return e.Err
+}
+
+// FromGo builds Value from Go value using reflection
+//
+// If t is nil, YDB type is inferred from Go type:
+//   - bool, intX, uintX, float32 and float64 are mapped to corresponding primitive types
+//     (int and uint are mapped to Int64 and Uint64)
+//   - string is mapped to Text and []byte is mapped to Bytes
+//   - time.Time is mapped to Timestamp and time.Duration is mapped to Interval
+//   - [16]byte (and named types such as uuid.UUID) is mapped to UUID
+//   - driver.Valuer is mapped by result of Value() call
+//   - pointers are mapped to Optional (nil pointer is mapped to Null)
+//   - slices and arrays are mapped to List
+//   - maps are mapped to Dict
+//   - structs are mapped to Struct with field names from tagName tag (or Go field name if tag is empty),
+//     fields with tag "-" and unexported fields are skipped
+//
+// YDB type of recursive Go types (such as linked list nodes) cannot be inferred.
+//
+// If t is not nil, Go value is converted to given YDB type, which allows to
+// disambiguate mapping (such as string to Bytes or time.Time to Date)
+func FromGo(v interface{}, t types.Type, tagName string) (Value, error) {
+	if tagName == "" {
+		tagName = DefaultFromGoTagName
+	}
+
+	b := newFromGoBuilder(tagName)
+
+	return b.value(reflect.ValueOf(v), t, "$")
+}
+
+// TypeFromGo infers YDB type from Go type same as FromGo with nil type
+func TypeFromGo(rt reflect.Type, tagName string) (types.Type, error) {
+	if tagName == "" {
+		tagName = DefaultFromGoTagName
+	}
+
+	b := newFromGoBuilder(tagName)
+
+	return b.typeOf(rt, "$")
+}
+
+type fromGoBuilder struct {
+	tagName string
+
+	// inferring keeps composite Go types which YDB types are being inferred now,
+	// so recursive Go types are reported as error instead of endless recursion
+	inferring map[reflect.Type]struct{}
+}
+
+func newFromGoBuilder(tagName string) fromGoBuilder {
+	return fromGoBuilder{
+		tagName:   tagName,
+		inferring: make(map[reflect.Type]struct{}),
+	}
+}
+
+func fromGoErr(path string, err error) error {
+	return xerrors.WithStackTrace(&FromGoError{Path: path, Err: err})
+}
+
+func (b fromGoBuilder) value(rv reflect.Value, t types.Type, path string) (Value, error) {
+	if rv.IsValid() && rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			rv = reflect.Value{}
+		} else {
+			rv = rv.Elem()
+		}
+	}
+
+	if !rv.IsValid() {
+		if isOptional, inner := optionalInnerType(t); isOptional {
+			return NullValue(inner), nil
+		}
+
+		return nil, fromGoErr(path, errNilValue)
+	}
+
+	if rv.Type().Implements(typeOfValue) {
+		if rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return nil, fromGoErr(path, errNilValue)
+		}
+		v, _ := rv.Interface().(Value)
+		if t != nil && !types.Equal(v.Type(), t) {
+			return nil, fromGoErr(path, fmt.Errorf("%w: value of type '%s' instead of '%s'",
+				errTypeMismatch, v.Type().Yql(), t.Yql(),
+			))
+		}
+
+		return v, nil
+	}
+
+	if rv.Type().Implements(typeOfValuer) && !isUUIDArray(rv.Type()) &&
+		!(rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		valuer, _ := rv.Interface().(driver.Valuer)
+		src, err := valuer.Value()
+		if err != nil {
+			return nil, fromGoErr(path, fmt.Errorf("driver.Valuer error: %w", err))
+		}
+
+		return b.value(reflect.ValueOf(src), t, path)
+	}
+
+	if t == nil {
+		return b.infer(rv, path)
+	}
+
+	return b.convert(rv, t, path)
+}
+
+func isUUIDArray(rt reflect.Type) bool {
+	return rt.Kind() == reflect.Array && rt.ConvertibleTo(typeOfUUIDBytes)
+}
+
+func optionalInnerType(t types.Type) (bool, types.Type) {
+	if optional, ok := t.(types.Optional); ok {
+		return true, optional.InnerType()
+	}
+
+	return false, nil
+}
+
+//nolint:gocyclo,funlen
+func (b fromGoBuilder) infer(rv reflect.Value, path string) (Value, error) {
+	rt := rv.Type()
+	switch {
+	case rt == typeOfTime:
+		v, err := TimestampValueFromTimeChecked(rv.Interface().(time.Time)) //nolint:forcetypeassert
+		if err != nil {
+			return nil, fromGoErr(path, err)
+		}
+
+		return v, nil
+	case rt == typeOfDuration:
+		v, err := IntervalValueFromDurationChecked(time.Duration(rv.Int()))
+		if err != nil {
+			return nil, fromGoErr(path, err)
+		}
+
+		return v, nil
+	case rt == typeOfDecimal:
+		d := rv.Interface().(decimal.Decimal) //nolint:forcetypeassert
+
+		return DecimalValue(d.Bytes, d.Precision, d.Scale), nil
+	case isUUIDArray(rt):
+		return UUIDValue(rv.Convert(typeOfUUIDBytes).Interface().([16]byte)), nil //nolint:forcetypeassert
+	}
+
+	switch rt.Kind() {
+	case reflect.Bool:
+		return BoolValue(rv.Bool()), nil
+	case reflect.Int8:
+		return Int8Value(int8(rv.Int())), nil
+	case reflect.Int16:
+		return Int16Value(int16(rv.Int())), nil
+	case reflect.Int32:
+		return Int32Value(int32(rv.Int())), nil
+	case reflect.Int, reflect.Int64:
+		return Int64Value(rv.Int()), nil
+	case reflect.Uint8:
+		return Uint8Value(uint8(rv.Uint())), nil
+	case reflect.Uint16:
+		return Uint16Value(uint16(rv.Uint())), nil
+	case reflect.Uint32:
+		return Uint32Value(uint32(rv.Uint())), nil
+	case reflect.Uint, reflect.Uint64:
+		return Uint64Value(rv.Uint()), nil
+	case reflect.Float32:
+		return FloatValue(float32(rv.Float())), nil
+	case reflect.Float64:
+		return DoubleValue(rv.Float()), nil
+	case reflect.String:
+		return TextValue(rv.String()), nil
+	case reflect.Ptr:
+		if rv.IsNil() {
+			t, err := b.typeOf(rt.Elem(), path)
+			if err != nil {
+				return nil, err
+			}
+
+			return NullValue(t), nil
+		}
+		v, err := b.value(rv.Elem(), nil, path)
+		if err != nil {
+			return nil, err
+		}
+
+		return OptionalValue(v), nil
+	case reflect.Slice, reflect.Array:
+		if rt.Elem().Kind() == reflect.Uint8 && rt.Kind() == reflect.Slice {
+			return BytesValue(rv.Bytes()), nil
+		}
+		// items of interface type are inferred one by one
+		if rt.Elem().Kind() == reflect.Interface && rv.Len() > 0 {
+			return b.list(rv, nil, path)
+		}
+		itemType, err := b.typeOf(rt.Elem(), path+"[]")
+		if err != nil {
+			return nil, err
+		}
+
+		return b.list(rv, itemType, path)
+	case reflect.Map:
+		if rv.Len() > 0 {
+			return b.dict(rv, nil, nil, path)
+		}
+		keyType, err := b.typeOf(rt.Key(), path+"[]")
+		if err != nil {
+			return nil, err
+		}
+		valueType, err := b.typeOf(rt.Elem(), path+"[]")
+		if err != nil {
+			return nil, err
+		}
+
+		return b.dict(rv, keyType, valueType, path)
+	case reflect.Struct:
+		fields := b.structFields(rt)
+		values := make([]StructValueField, 0, len(fields))
+		for _, f := range fields {
+			v, err := b.value(rv.FieldByIndex(f.index), nil, path+"."+f.name)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, StructValueField{Name: f.name, V: v})
+		}
+
+		return StructValue(values...), nil
+	default:
+		return nil, fromGoErr(path, fmt.Errorf("%w '%s'", ErrUnsupportedGoType, rt))
+	}
+}
+
+// typeOf infers YDB type from Go type, which is needed for nil pointers and empty containers
+//
+//nolint:gocyclo,funlen
+func (b fromGoBuilder) typeOf(rt reflect.Type, path string) (types.Type, error) {
+	switch {
+	case rt == typeOfTime:
+		return types.Timestamp, nil
+	case rt == typeOfDuration:
+		return types.Interval, nil
+	case rt == typeOfDecimal:
+		return types.NewDecimal(decimalPrecision, decimalScale), nil
+	case isUUIDArray(rt):
+		return types.UUID, nil
+	}
+
+	switch rt.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+		if _, has := b.inferring[rt]; has {
+			return nil, fromGoErr(path, fmt.Errorf("%w '%s': %w", ErrUnsupportedGoType, rt, errRecursiveType))
+		}
+		b.inferring[rt] = struct{}{}
+		defer delete(b.inferring, rt)
+	}
+
+	switch rt.Kind() {
+	case reflect.Bool:
+		return types.Bool, nil
+	case reflect.Int8:
+		return types.Int8, nil
+	case reflect.Int16:
+		return types.Int16, nil
+	case reflect.Int32:
+		return types.Int32, nil
+	case reflect.Int, reflect.Int64:
+		return types.Int64, nil
+	case reflect.Uint8:
+		return types.Uint8, nil
+	case reflect.Uint16:
+		return types.Uint16, nil
+	case reflect.Uint32:
+		return types.Uint32, nil
+	case reflect.Uint, reflect.Uint64:
+		return types.Uint64, nil
+	case reflect.Float32:
+		return types.Float, nil
+	case reflect.Float64:
+		return types.Double, nil
+	case reflect.String:
+		return types.Text, nil
+	case reflect.Ptr:
+		t, err := b.typeOf(rt.Elem(), path)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewOptional(t), nil
+	case reflect.Slice, reflect.Array:
+		if rt.Elem().Kind() == reflect.Uint8 && rt.Kind() == reflect.Slice {
+			return types.Bytes, nil
+		}
+		t, err := b.typeOf(rt.Elem(), path+"[]")
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewList(t), nil
+	case reflect.Map:
+		keyType, err := b.typeOf(rt.Key(), path+"[]")
+		if err != nil {
+			return nil, err
+		}
+		valueType, err := b.typeOf(rt.Elem(), path+"[]")
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewDict(keyType, valueType), nil
+	case reflect.Struct:
+		fields := b.structFields(rt)
+		structFields := make([]types.StructField, 0, len(fields))
+		for _, f := range fields {
+			t, err := b.typeOf(rt.FieldByIndex(f.index).Type, path+"."+f.name)
+			if err != nil {
+				return nil, err
+			}
+			structFields = append(structFields, types.StructField{Name: f.name, T: t})
+		}
+		// fields sorted same as in StructValue
+		sort.Slice(structFields, func(i, j int) bool {
+			return structFields[i].Name < structFields[j].Name
+		})
+
+		return types.NewStruct(structFields...), nil
+	default:
+		return nil, fromGoErr(path, fmt.Errorf("%w '%s': cannot infer ydb type", ErrUnsupportedGoType, rt))
+	}
+}
+
+//nolint:gocyclo,funlen
+func (b fromGoBuilder) convert(rv reflect.Value, t types.Type, path string) (Value, error) {
+	if isOptional, inner := optionalInnerType(t); isOptional {
+		if rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				return NullValue(inner), nil
+			}
+			rv = rv.Elem()
+		}
+		v, err := b.value(rv, inner, path)
+		if err != nil {
+			return nil, err
+		}
+
+		return OptionalValue(v), nil
+	}
+
+	if rv.Kind() == reflect.Ptr && rv.Type().Elem() != typeOfBigInt {
+		if rv.IsNil() {
+			return nil, fromGoErr(path, fmt.Errorf("%w for non-optional type '%s'", errNilValue, t.Yql()))
+		}
+
+		return b.value(rv.Elem(), t, path)
+	}
+
+	switch tt := t.(type) {
+	case types.Primitive:
+		return b.primitive(rv, tt, path)
+	case *types.Decimal:
+		return b.decimal(rv, tt, path)
+	case *types.List:
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return nil, b.mismatch(rv, t, path)
+		}
+
+		return b.list(rv, tt.ItemType(), path)
+	case *types.Set:
+		var items []Value
+		switch rv.Kind() {
+		case reflect.Slice, reflect.Array:
+			items = make([]Value, 0, rv.Len())
+			for i := 0; i < rv.Len(); i++ {
+				v, err := b.value(rv.Index(i), tt.ItemType(), fmt.Sprintf("%s[%d]", path, i))
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, v)
+			}
+		case reflect.Map:
+			items = make([]Value, 0, rv.Len())
+			iter := rv.MapRange()
+			for iter.Next() {
+				v, err := b.value(iter.Key(), tt.ItemType(), fmt.Sprintf("%s[%v]", path, iter.Key()))
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, v)
+			}
+		default:
+			return nil, b.mismatch(rv, t, path)
+		}
+		if len(items) == 0 {
+			return ZeroValue(t), nil
+		}
+
+		return SetValue(items...), nil
+	case *types.Dict:
+		if rv.Kind() != reflect.Map {
+			return nil, b.mismatch(rv, t, path)
+		}
+
+		return b.dict(rv, tt.KeyType(), tt.ValueType(), path)
+	case *types.Tuple:
+		innerTypes := tt.InnerTypes()
+		items := make([]Value, 0, len(innerTypes))
+		switch rv.Kind() {
+		case reflect.Slice, reflect.Array:
+			if rv.Len() != len(innerTypes) {
+				return nil, fromGoErr(path, fmt.Errorf("%w: %d items instead of %d",
+					errTupleLengthMismatch, rv.Len(), len(innerTypes),
+				))
+			}
+			for i := range innerTypes {
+				v, err := b.value(rv.Index(i), innerTypes[i], fmt.Sprintf("%s[%d]", path, i))
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, v)
+			}
+		case reflect.Struct:
+			fields := b.structFields(rv.Type())
+			if len(fields) != len(innerTypes) {
+				return nil, fromGoErr(path, fmt.Errorf("%w: %d fields instead of %d",
+					errTupleLengthMismatch, len(fields), len(innerTypes),
+				))
+			}
+			for i := range innerTypes {
+				v, err := b.value(rv.FieldByIndex(fields[i].index), innerTypes[i], path+"."+fields[i].name)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, v)
+			}
+		default:
+			return nil, b.mismatch(rv, t, path)
+		}
+
+		return TupleValue(items...), nil
+	case *types.Struct:
+		return b.structValue(rv, tt, path)
+	default:
+		return nil, fromGoErr(path, fmt.Errorf("%w: type '%s' is not supported as target type",
+			ErrUnsupportedGoType, t.Yql(),
+		))
+	}
+}
+
+func (b fromGoBuilder) mismatch(rv reflect.Value, t types.Type, path string) error {
+	return fromGoErr(path, fmt.Errorf("%w: cannot convert '%s' to '%s'", errTypeMismatch, rv.Type(), t.Yql()))
+}
+
+func (b fromGoBuilder) list(rv reflect.Value, itemType types.Type, path string) (Value, error) {
+	if rv.Len() == 0 {
+		return ZeroValue(types.NewList(itemType)), nil
+	}
+	items := make([]Value, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		v, err := b.value(rv.Index(i), itemType, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+	}
+
+	return ListValue(items...), nil
+}
+
+func (b fromGoBuilder) dict(rv reflect.Value, keyType, valueType types.Type, path string) (Value, error) {
+	if rv.Len() == 0 {
+		return ZeroValue(types.NewDict(keyType, valueType)), nil
+	}
+	fields := make([]DictValueField, 0, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		itemPath := fmt.Sprintf("%s[%v]", path, iter.Key())
+		k, err := b.value(iter.Key(), keyType, itemPath)
+		if err != nil {
+			return nil, err
+		}
+		v, err := b.value(iter.Value(), valueType, itemPath)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, DictValueField{K: k, V: v})
+	}
+
+	return DictValue(fields...), nil
+}
+
+func (b fromGoBuilder) structValue(rv reflect.Value, t *types.Struct, path string) (Value, error) {
+	targetFields := t.Fields()
+	values := make([]StructValueField, 0, len(targetFields))
+	switch rv.Kind() {
+	case reflect.Struct:
+		fields := b.structFields(rv.Type())
+		index := make(map[string][]int, len(fields))
+		for _, f := range fields {
+			index[f.name] = f.index
+		}
+		for _, f := range targetFields {
+			fieldIndex, has := index[f.Name]
+			if !has {
+				return nil, fromGoErr(path+"."+f.Name, errMissingStructField)
+			}
+			delete(index, f.Name)
+			v, err := b.value(rv.FieldByIndex(fieldIndex), f.T, path+"."+f.Name)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, StructValueField{Name: f.Name, V: v})
+		}
+		for _, f := range fields {
+			if _, has := index[f.name]; has {
+				return nil, fromGoErr(path+"."+f.name, errUnknownStructField)
+			}
+		}
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, b.mismatch(rv, t, path)
+		}
+		for _, f := range targetFields {
+			item := rv.MapIndex(reflect.ValueOf(f.Name).Convert(rv.Type().Key()))
+			if !item.IsValid() {
+				return nil, fromGoErr(path+"."+f.Name, errMissingStructField)
+			}
+			v, err := b.value(item, f.T, path+"."+f.Name)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, StructValueField{Name: f.Name, V: v})
+		}
+		if rv.Len() > len(targetFields) {
+			names := make([]string, 0, rv.Len())
+			for _, k := range rv.MapKeys() {
+				names = append(names, k.String())
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				if !hasStructField(targetFields, name) {
+					return nil, fromGoErr(path+"."+name, errUnknownStructField)
+				}
+			}
+		}
+	default:
+		return nil, b.mismatch(rv, t, path)
+	}
+
+	return StructValue(values...), nil
+}
+
+func hasStructField(fields []types.StructField, name string) bool {
+	for i := range fields {
+		if fields[i].Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+//nolint:gocyclo,funlen
+func (b fromGoBuilder) primitive(rv reflect.Value, t types.Primitive, path string) (Value, error) {
+	rt := rv.Type()
+	switch t {
+	case types.Bool:
+		if rt.Kind() == reflect.Bool {
+			return BoolValue(rv.Bool()), nil
+		}
+	case types.Int8, types.Int16, types.Int32, types.Int64,
+		types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		if rt == typeOfDuration {
+			break
+		}
+
+		return b.integer(rv, t, path)
+	case types.Float:
+		switch rt.Kind() { //nolint:exhaustive
+		case reflect.Float32, reflect.Float64:
+			return FloatValue(float32(rv.Float())), nil
+		}
+	case types.Double:
+		switch rt.Kind() { //nolint:exhaustive
+		case reflect.Float32, reflect.Float64:
+			return DoubleValue(rv.Float()), nil
+		}
+	case types.Date, types.Datetime, types.Timestamp, types.TzDate, types.TzDatetime, types.TzTimestamp:
+		if rt == typeOfTime {
+			return b.temporal(rv.Interface().(time.Time), t, path) //nolint:forcetypeassert
+		}
+	case types.Interval:
+		if rt == typeOfDuration {
+			v, err := IntervalValueFromDurationChecked(time.Duration(rv.Int()))
+			if err != nil {
+				return nil, fromGoErr(path, err)
+			}
+
+			return v, nil
+		}
+	case types.Text, types.JSON, types.JSONDocument, types.DyNumber, types.Bytes, types.YSON:
+		var s string
+		switch {
+		case rt.Kind() == reflect.String:
+			s = rv.String()
+		case rt.Kind() == reflect.Slice && rt.Elem().Kind() == reflect.Uint8:
+			s = string(rv.Bytes())
+		default:
+			return nil, b.mismatch(rv, t, path)
+		}
+		switch t { //nolint:exhaustive
+		case types.Text:
+			return TextValue(s), nil
+		case types.JSON:
+			return JSONValue(s), nil
+		case types.JSONDocument:
+			return JSONDocumentValue(s), nil
+		case types.DyNumber:
+			return DyNumberValue(s), nil
+		case types.Bytes:
+			return BytesValue([]byte(s)), nil
+		default:
+			return YSONValue([]byte(s)), nil
+		}
+	case types.UUID:
+		switch {
+		case isUUIDArray(rt):
+			return UUIDValue(rv.Convert(typeOfUUIDBytes).Interface().([16]byte)), nil //nolint:forcetypeassert
+		case rt.Kind() == reflect.String:
+			v, err := UUIDFromString(rv.String())
+			if err != nil {
+				return nil, fromGoErr(path, err)
+			}
+
+			return UUIDValue(v), nil
+		}
+	}
+
+	return nil, b.mismatch(rv, t, path)
+}
+
+func (b fromGoBuilder) integer(rv reflect.Value, t types.Primitive, path string) (Value, error) {
+	var (
+		n        *big.Int
+		min, max int64
+		umax     uint64
+	)
+	switch rv.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = big.NewInt(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n = new(big.Int).SetUint64(rv.Uint())
+	default:
+		return nil, b.mismatch(rv, t, path)
+	}
+	switch t { //nolint:exhaustive
+	case types.Int8:
+		min, max = math.MinInt8, math.MaxInt8
+	case types.Int16:
+		min, max = math.MinInt16, math.MaxInt16
+	case types.Int32:
+		min, max = math.MinInt32, math.MaxInt32
+	case types.Int64:
+		min, max = math.MinInt64, math.MaxInt64
+	case types.Uint8:
+		umax = math.MaxUint8
+	case types.Uint16:
+		umax = math.MaxUint16
+	case types.Uint32:
+		umax = math.MaxUint32
+	default:
+		umax = math.MaxUint64
+	}
+	if umax > 0 {
+		if n.Sign() < 0 || n.Cmp(new(big.Int).SetUint64(umax)) > 0 {
+			return nil, fromGoErr(path, &OutOfRangeError{Type: t, Value: rv.Interface()})
+		}
+	} else if n.Cmp(big.NewInt(min)) < 0 || n.Cmp(big.NewInt(max)) > 0 {
+		return nil, fromGoErr(path, &OutOfRangeError{Type: t, Value: rv.Interface()})
+	}
+
+	switch t { //nolint:exhaustive
+	case types.Int8:
+		return Int8Value(int8(n.Int64())), nil
+	case types.Int16:
+		return Int16Value(int16(n.Int64())), nil
+	case types.Int32:
+		return Int32Value(int32(n.Int64())), nil
+	case types.Int64:
+		return Int64Value(n.Int64()), nil
+	case types.Uint8:
+		return Uint8Value(uint8(n.Uint64())), nil
+	case types.Uint16:
+		return Uint16Value(uint16(n.Uint64())), nil
+	case types.Uint32:
+		return Uint32Value(uint32(n.Uint64())), nil
+	default:
+		return Uint64Value(n.Uint64()), nil
+	}
+}
+
+func (b fromGoBuilder) temporal(tm time.Time, t types.Primitive, path string) (v Value, err error) {
+	switch t { //nolint:exhaustive
+	case types.Date:
+		v, err = DateValueFromTimeChecked(tm)
+	case types.Datetime:
+		v, err = DatetimeValueFromTimeChecked(tm)
+	case types.Timestamp:
+		v, err = TimestampValueFromTimeChecked(tm)
+	case types.TzDate:
+		v = TzDateValueFromTime(tm)
+	case types.TzDatetime:
+		v = TzDatetimeValueFromTime(tm)
+	default:
+		v = TzTimestampValueFromTime(tm)
+	}
+	if err != nil {
+		return nil, fromGoErr(path, err)
+	}
+
+	return v, nil
+}
+
+func (b fromGoBuilder) decimal(rv reflect.Value, t *types.Decimal, path string) (Value, error) {
+	switch {
+	case rv.Type() == typeOfDecimal:
+		d := rv.Interface().(decimal.Decimal) //nolint:forcetypeassert
+		if d.Precision != t.Precision() || d.Scale != t.Scale() {
+			return nil, b.mismatch(rv, t, path)
+		}
+
+		return DecimalValue(d.Bytes, d.Precision, d.Scale), nil
+	case rv.Kind() == reflect.Ptr && rv.Type().Elem() == typeOfBigInt:
+		if rv.IsNil() {
+			return nil, fromGoErr(path, fmt.Errorf("%w for non-optional type '%s'", errNilValue, t.Yql()))
+		}
+
+		return DecimalValueFromBigInt(rv.Interface().(*big.Int), t.Precision(), t.Scale()), nil //nolint:forcetypeassert
+	case rv.Kind() == reflect.String:
+		n, err := decimal.Parse(rv.String(), t.Precision(), t.Scale())
+		if err != nil {
+			return nil, fromGoErr(path, err)
+		}
+
+		return DecimalValueFromBigInt(n, t.Precision(), t.Scale()), nil
+	default:
+		return nil, b.mismatch(rv, t, path)
+	}
+}
+
+type fromGoField struct {
+	name  string
+	index []int
+}
+
+func (b fromGoBuilder) structFields(rt reflect.Type) []fromGoField {
+	fields := make([]fromGoField, 0, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get(b.tagName), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, fromGoField{name: name, index: f.Index})
+	}
+
+	return fields
+}
@@ -0,0 +1,301 @@
+package value
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestFromGo(t *testing.T) {
+	type item struct {
+		ID      uint64  `ydb:"id"`
+		Title   *string `ydb:"title"`
+		Skipped int     `ydb:"-"`
+		Tags    []string
+		private int
+	}
+	title := "title"
+	ts := time.Unix(123, 456000).UTC()
+	for _, tt := range []struct {
+		name string
+		src  interface{}
+		t    types.Type
+		exp  Value
+	}{
+		{
+			name: "int",
+			src:  42,
+			exp:  Int64Value(42),
+		},
+		{
+			name: "uint8",
+			src:  uint8(42),
+			exp:  Uint8Value(42),
+		},
+		{
+			name: "string",
+			src:  "test",
+			exp:  TextValue("test"),
+		},
+		{
+			name: "bytes",
+			src:  []byte("test"),
+			exp:  BytesValue([]byte("test")),
+		},
+		{
+			name: "time",
+			src:  ts,
+			exp:  TimestampValueFromTime(ts),
+		},
+		{
+			name: "duration",
+			src:  time.Second,
+			exp:  IntervalValueFromDuration(time.Second),
+		},
+		{
+			name: "uuid",
+			src:  uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff"),
+			exp:  UUIDValue(uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff")),
+		},
+		{
+			name: "pointer",
+			src:  &title,
+			exp:  OptionalValue(TextValue(title)),
+		},
+		{
+			name: "nil pointer",
+			src:  (*time.Time)(nil),
+			exp:  NullValue(types.Timestamp),
+		},
+		{
+			name: "value",
+			src:  []interface{}{Int32Value(1), Int32Value(2)},
+			exp:  ListValue(Int32Value(1), Int32Value(2)),
+		},
+		{
+			name: "empty list",
+			src:  []int32{},
+			exp:  ZeroValue(types.NewList(types.Int32)),
+		},
+		{
+			name: "dict",
+			src:  map[string]int32{"b": 2, "a": 1},
+			exp: DictValue(
+				DictValueField{K: TextValue("a"), V: Int32Value(1)},
+				DictValueField{K: TextValue("b"), V: Int32Value(2)},
+			),
+		},
+		{
+			name: "empty dict",
+			src:  map[string]int32{},
+			exp:  ZeroValue(types.NewDict(types.Text, types.Int32)),
+		},
+		{
+			name: "structs",
+			src:  []item{{ID: 1, Title: &title, Tags: []string{"a"}}, {ID: 2}},
+			exp: ListValue(
+				StructValue(
+					StructValueField{Name: "id", V: Uint64Value(1)},
+					StructValueField{Name: "title", V: OptionalValue(TextValue(title))},
+					StructValueField{Name: "Tags", V: ListValue(TextValue("a"))},
+				),
+				StructValue(
+					StructValueField{Name: "id", V: Uint64Value(2)},
+					StructValueField{Name: "title", V: NullValue(types.Text)},
+					StructValueField{Name: "Tags", V: ZeroValue(types.NewList(types.Text))},
+				),
+			),
+		},
+		{
+			name: "string to bytes",
+			src:  "test",
+			t:    types.Bytes,
+			exp:  BytesValue([]byte("test")),
+		},
+		{
+			name: "string to uuid",
+			src:  "00112233-4455-6677-8899-aabbccddeeff",
+			t:    types.UUID,
+			exp:  UUIDValue(uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff")),
+		},
+		{
+			name: "time to date",
+			src:  ts,
+			t:    types.Date,
+			exp:  DateValueFromTime(ts),
+		},
+		{
+			name: "int to optional uint32",
+			src:  42,
+			t:    types.NewOptional(types.Uint32),
+			exp:  OptionalValue(Uint32Value(42)),
+		},
+		{
+			name: "nil to optional",
+			src:  nil,
+			t:    types.NewOptional(types.Uint32),
+			exp:  NullValue(types.Uint32),
+		},
+		{
+			name: "string to decimal",
+			src:  "12.5",
+			t:    types.NewDecimal(22, 9),
+			exp:  DecimalValueFromBigInt(big.NewInt(12500000000), 22, 9),
+		},
+		{
+			name: "slice to set",
+			src:  []string{"b", "a"},
+			t:    types.NewSet(types.Bytes),
+			exp:  SetValue(BytesValue([]byte("a")), BytesValue([]byte("b"))),
+		},
+		{
+			name: "slice to tuple",
+			src:  []interface{}{1, "a"},
+			t:    types.NewTuple(types.Int32, types.Text),
+			exp:  TupleValue(Int32Value(1), TextValue("a")),
+		},
+		{
+			name: "map to struct",
+			src:  map[string]interface{}{"id": 1, "title": nil},
+			t: types.NewStruct(
+				types.StructField{Name: "id", T: types.Uint64},
+				types.StructField{Name: "title", T: types.NewOptional(types.Bytes)},
+			),
+			exp: StructValue(
+				StructValueField{Name: "id", V: Uint64Value(1)},
+				StructValueField{Name: "title", V: NullValue(types.Bytes)},
+			),
+		},
+		{
+			name: "struct to struct",
+			src:  []item{{ID: 1, Title: &title}},
+			t: types.NewList(types.NewStruct(
+				types.StructField{Name: "id", T: types.Uint32},
+				types.StructField{Name: "title", T: types.NewOptional(types.Bytes)},
+				types.StructField{Name: "Tags", T: types.NewList(types.Bytes)},
+			)),
+			exp: ListValue(StructValue(
+				StructValueField{Name: "id", V: Uint32Value(1)},
+				StructValueField{Name: "title", V: OptionalValue(BytesValue([]byte(title)))},
+				StructValueField{Name: "Tags", V: ZeroValue(types.NewList(types.Bytes))},
+			)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := FromGo(tt.src, tt.t, "")
+			require.NoError(t, err)
+			require.True(t, Equal(tt.exp, v), "%s != %s", tt.exp.Yql(), v.Yql())
+		})
+	}
+}
+
+func TestFromGoTagName(t *testing.T) {
+	v, err := FromGo(struct {
+		ID uint64 `json:"id" ydb:"-"`
+	}{ID: 1}, nil, "json")
+	require.NoError(t, err)
+	require.True(t, Equal(StructValue(StructValueField{Name: "id", V: Uint64Value(1)}), v))
+}
+
+// node is a self-referential type which YDB type cannot be inferred
+type node struct {
+	Value int   `ydb:"value"`
+	Next  *node `ydb:"next"`
+}
+
+type recursiveSlice []recursiveSlice
+
+func TestFromGoErrors(t *testing.T) {
+	type item struct {
+		Name  string `ydb:"name"`
+		Count int    `ydb:"count"`
+	}
+	type root struct {
+		Items []item `ydb:"items"`
+	}
+	for _, tt := range []struct {
+		name string
+		src  interface{}
+		t    types.Type
+		path string
+		err  error
+	}{
+		{
+			name: "type mismatch",
+			src:  root{Items: []item{{}}},
+			t: types.NewStruct(types.StructField{Name: "items", T: types.NewList(types.NewStruct(
+				types.StructField{Name: "name", T: types.Int32},
+				types.StructField{Name: "count", T: types.Int32},
+			))}),
+			path: "$.items[0].name",
+			err:  errTypeMismatch,
+		},
+		{
+			name: "out of range",
+			src:  root{Items: []item{{}, {Count: 1000}}},
+			t: types.NewStruct(types.StructField{Name: "items", T: types.NewList(types.NewStruct(
+				types.StructField{Name: "name", T: types.Text},
+				types.StructField{Name: "count", T: types.Int8},
+			))}),
+			path: "$.items[1].count",
+			err:  ErrOutOfRange,
+		},
+		{
+			name: "missing field",
+			src:  item{},
+			t: types.NewStruct(
+				types.StructField{Name: "name", T: types.Text},
+				types.StructField{Name: "count", T: types.Int8},
+				types.StructField{Name: "extra", T: types.Int8},
+			),
+			path: "$.extra",
+			err:  errMissingStructField,
+		},
+		{
+			name: "unknown field",
+			src:  item{},
+			t:    types.NewStruct(types.StructField{Name: "name", T: types.Text}),
+			path: "$.count",
+			err:  errUnknownStructField,
+		},
+		{
+			name: "nil for non-optional",
+			src:  []*int{nil},
+			t:    types.NewList(types.Int32),
+			path: "$[0]",
+			err:  errNilValue,
+		},
+		{
+			name: "recursive struct",
+			src:  []*node{nil},
+			path: "$[].next",
+			err:  errRecursiveType,
+		},
+		{
+			name: "recursive slice",
+			src:  recursiveSlice{},
+			path: "$[][]",
+			err:  errRecursiveType,
+		},
+		{
+			name: "channel",
+			src:  map[string]interface{}{"ch": make(chan int)},
+			path: "$[ch]",
+			err:  ErrUnsupportedGoType,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FromGo(tt.src, tt.t, "")
+			require.ErrorIs(t, err, tt.err)
+			var fromGoErr *FromGoError
+			require.True(t, errors.As(err, &fromGoErr))
+			require.Equal(t, tt.path, fromGoErr.Path)
+		})
+	}
+}
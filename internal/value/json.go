@@ -0,0 +1,68 @@
+package value
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
+)
+
+var ErrInvalidJSON = errors.New("invalid json")
+
+// marshalJSON returns JSON representation of v
+//
+// Strings, bytes and json.RawMessage are treated as already encoded JSON and only validated.
+func marshalJSON(v interface{}) (string, error) {
+	var data []byte
+	switch vv := v.(type) {
+	case string:
+		data = xstring.ToBytes(vv)
+	case []byte:
+		data = vv
+	case json.RawMessage:
+		data = vv
+	default:
+		var err error
+		data, err = json.Marshal(v)
+		if err != nil {
+			return "", xerrors.WithStackTrace(fmt.Errorf("%w: %w", ErrInvalidJSON, err))
+		}
+
+		return xstring.FromBytes(data), nil
+	}
+	if !utf8.Valid(data) {
+		return "", xerrors.WithStackTrace(fmt.Errorf("%w: invalid UTF-8", ErrInvalidJSON))
+	}
+	if !json.Valid(data) {
+		return "", xerrors.WithStackTrace(fmt.Errorf("%w: %q", ErrInvalidJSON, data))
+	}
+
+	return string(data), nil
+}
+
+// JSONValueFromObject makes Json value from Go value marshaled with json.Marshal
+//
+// Strings, bytes and json.RawMessage are treated as already encoded JSON and only validated.
+func JSONValueFromObject(v interface{}) (jsonValue, error) {
+	s, err := marshalJSON(v)
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	return JSONValue(s), nil
+}
+
+// JSONDocumentValueFromObject makes JsonDocument value from Go value marshaled with json.Marshal
+//
+// Strings, bytes and json.RawMessage are treated as already encoded JSON and only validated.
+func JSONDocumentValueFromObject(v interface{}) (jsonDocumentValue, error) {
+	s, err := marshalJSON(v)
+	if err != nil {
+		return "", xerrors.WithStackTrace(err)
+	}
+
+	return JSONDocumentValue(s), nil
+}
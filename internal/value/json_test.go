@@ -0,0 +1,108 @@
+package value
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONValueFromObject(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		src  interface{}
+		exp  string
+		err  error
+	}{
+		{
+			name: "struct",
+			src: struct {
+				ID   int    `json:"id"`
+				Name string `json:"name"`
+			}{ID: 1, Name: "test"},
+			exp: `{"id":1,"name":"test"}`,
+		},
+		{
+			name: "map",
+			src:  map[string]int{"a": 1},
+			exp:  `{"a":1}`,
+		},
+		{
+			name: "string",
+			src:  `{"a":1}`,
+			exp:  `{"a":1}`,
+		},
+		{
+			name: "bytes",
+			src:  []byte(`[1,2]`),
+			exp:  `[1,2]`,
+		},
+		{
+			name: "json.RawMessage",
+			src:  json.RawMessage(`null`),
+			exp:  `null`,
+		},
+		{
+			name: "invalid json",
+			src:  `{"a":`,
+			err:  ErrInvalidJSON,
+		},
+		{
+			name: "invalid utf-8",
+			src:  []byte{'"', 0xff, '"'},
+			err:  ErrInvalidJSON,
+		},
+		{
+			name: "unsupported",
+			src:  make(chan int),
+			err:  ErrInvalidJSON,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := JSONValueFromObject(tt.src)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, JSONValue(tt.exp), v)
+			d, err := JSONDocumentValueFromObject(tt.src)
+			require.NoError(t, err)
+			require.Equal(t, JSONDocumentValue(tt.exp), d)
+		})
+	}
+}
+
+func TestJSONCastTo(t *testing.T) {
+	type object struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	t.Run("Struct", func(t *testing.T) {
+		var dst object
+		require.NoError(t, CastTo(JSONValue(`{"id":1,"name":"test"}`), &dst))
+		require.Equal(t, object{ID: 1, Name: "test"}, dst)
+	})
+	t.Run("Map", func(t *testing.T) {
+		var dst map[string]interface{}
+		require.NoError(t, CastTo(JSONDocumentValue(`{"a":1}`), &dst))
+		require.Equal(t, map[string]interface{}{"a": float64(1)}, dst)
+	})
+	t.Run("RawMessage", func(t *testing.T) {
+		var dst json.RawMessage
+		require.NoError(t, CastTo(JSONValue(`{"a": 1}`), &dst))
+		require.Equal(t, json.RawMessage(`{"a": 1}`), dst)
+	})
+	t.Run("Optional", func(t *testing.T) {
+		var dst *object
+		require.NoError(t, CastTo(OptionalValue(JSONValue(`{"id":1}`)), &dst))
+		require.Equal(t, &object{ID: 1}, dst)
+		require.NoError(t, CastTo(NullValue(JSONValue("").Type()), &dst))
+		require.Nil(t, dst)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		var dst object
+		require.ErrorIs(t, CastTo(JSONValue(`{"id":"1"}`), &dst), ErrCannotCast)
+	})
+}
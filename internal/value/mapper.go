@@ -0,0 +1,32 @@
+package value
+
+type (
+	// Encoder makes Value of Go value of custom type, for example of enum constant
+	Encoder interface {
+		YDBValue() (Value, error)
+	}
+	// Decoder fills Go value of custom type from Value, for example from value of enum column.
+	// Decoder receives value as is, including Optional and NULL values
+	Decoder interface {
+		ScanYDBValue(v Value) error
+	}
+	// Mapper controls both encoding and decoding of Go values of custom type
+	Mapper interface {
+		Encoder
+		Decoder
+	}
+)
+
+// UnwrapOptional returns inner value of Optional value or v if v is not Optional. It returns nil for NULL value
+func UnwrapOptional(v Value) Value {
+	for {
+		optional, ok := v.(*optionalValue)
+		if !ok {
+			return v
+		}
+		if optional.value == nil {
+			return nil
+		}
+		v = optional.value
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
@@ -30,6 +31,135 @@ const (
 
 var epoch = time.Unix(0, 0)
 
+// Upper bounds (exclusive) of YDB temporal types. All of Date, Datetime and Timestamp
+// are limited by range [1970-01-01T00:00:00Z, 2106-01-01T00:00:00Z)
+const (
+	maxDate      uint32 = 49673
+	maxDatetime  uint32 = 4291747200
+	maxTimestamp uint64 = 4291747200000000
+	maxInterval  int64  = 4291747200000000
+)
+
+// OutOfRangeError is the error for time values which cannot be represented by YDB temporal type
+type OutOfRangeError struct {
+	Type  types.Type
+	Value interface{}
+}
+
+func (e *OutOfRangeError) Error() string {
+	return fmt.Sprintf("value '%v' is out of range of YDB type %s", e.Value, e.Type.Yql())
+}
+
+func (e *OutOfRangeError) Is(target error) bool {
+	return target == ErrOutOfRange
+}
+
+func outOfRange(t types.Type, v interface{}) error {
+	return xerrors.WithStackTrace(&OutOfRangeError{Type: t, Value: v}, xerrors.WithSkipDepth(1))
+}
+
+// DateValueFromTimeChecked makes Date value from time.Time or returns *OutOfRangeError
+// if date is before 1970-01-01 or after 2105-12-31
+//
+// Time of day is truncated. Date is calculated in UTC.
+func DateValueFromTimeChecked(t time.Time) (dateValue, error) {
+	if t.Before(epoch) || !t.Before(DateToTime(maxDate)) {
+		return 0, outOfRange(types.Date, t)
+	}
+
+	return DateValueFromTime(t), nil
+}
+
+// DatetimeValueFromTimeChecked makes Datetime value from time.Time or returns *OutOfRangeError
+// if time is before 1970-01-01T00:00:00Z or after 2105-12-31T23:59:59Z
+//
+// Fractional seconds are truncated.
+func DatetimeValueFromTimeChecked(t time.Time) (datetimeValue, error) {
+	if t.Before(epoch) || !t.Before(DatetimeToTime(maxDatetime)) {
+		return 0, outOfRange(types.Datetime, t)
+	}
+
+	return DatetimeValueFromTime(t), nil
+}
+
+// TimestampValueFromTimeChecked makes Timestamp value from time.Time or returns *OutOfRangeError
+// if time is before 1970-01-01T00:00:00Z or after 2105-12-31T23:59:59.999999Z
+//
+// Nanoseconds are truncated to microseconds.
+func TimestampValueFromTimeChecked(t time.Time) (timestampValue, error) {
+	if t.Before(epoch) || !t.Before(TimestampToTime(maxTimestamp)) {
+		return 0, outOfRange(types.Timestamp, t)
+	}
+
+	return TimestampValueFromTime(t), nil
+}
+
+// IntervalValueFromDurationChecked makes Interval value from time.Duration or returns *OutOfRangeError
+// if absolute value of duration is greater than difference between bounds of Timestamp
+//
+// Nanoseconds are truncated to microseconds toward zero, sign is saved.
+func IntervalValueFromDurationChecked(d time.Duration) (intervalValue, error) {
+	if us := durationToMicroseconds(d); us <= -maxInterval || us >= maxInterval {
+		return 0, outOfRange(types.Interval, d)
+	}
+
+	return IntervalValueFromDuration(d), nil
+}
+
+// IntervalValueFromParts makes Interval value from days, hours, minutes, seconds and microseconds or
+// returns *OutOfRangeError if sum of parts overflows range of Interval type
+//
+// Parts may have different signs, for example (1, -1, 0, 0, 0) is 23 hours.
+func IntervalValueFromParts(days, hours, minutes, seconds, microseconds int64) (intervalValue, error) {
+	var (
+		total int64
+		parts = [...]struct {
+			value int64
+			unit  int64
+		}{
+			{days, int64(secondsPerDay * microsecondsPerSecond)},
+			{hours, int64(secondsPerHour * microsecondsPerSecond)},
+			{minutes, int64(secondsPerMinute * microsecondsPerSecond)},
+			{seconds, microsecondsPerSecond},
+			{microseconds, 1},
+		}
+	)
+	for _, part := range parts {
+		// every part is bounded before multiplication, so sum of parts cannot overflow int64
+		if part.value < -maxInterval/part.unit || part.value > maxInterval/part.unit {
+			return 0, outOfRange(types.Interval, intervalParts(days, hours, minutes, seconds, microseconds))
+		}
+		total += part.value * part.unit
+	}
+	if total <= -maxInterval || total >= maxInterval {
+		return 0, outOfRange(types.Interval, intervalParts(days, hours, minutes, seconds, microseconds))
+	}
+
+	return intervalValue(total), nil
+}
+
+func intervalParts(days, hours, minutes, seconds, microseconds int64) string {
+	return fmt.Sprintf("%dd%dh%dm%ds%dus", days, hours, minutes, seconds, microseconds)
+}
+
+// formatTz formats time to text form of YDB timezone types
+//
+// Time in UTC is formatted with utcLayout (as it was before support of locations).
+// Time in other locations is formatted as "<time in location>,<IANA location name>".
+// time.Local location cannot be interpreted by YDB, so time in local location is converted to UTC.
+// Wall time in location is ambiguous inside of daylight saving time overlap, so such times may be
+// parsed back with other offset.
+func formatTz(t time.Time, utcLayout, tzLayout string) string {
+	if t.Location() == time.Local {
+		t = t.UTC()
+	}
+	if t.Location() == time.UTC {
+		return t.Format(utcLayout)
+	}
+
+	return t.Format(tzLayout) + "," + t.Location().String()
+}
+
 // IntervalToDuration returns time.Duration from given microseconds
 func IntervalToDuration(n int64) time.Duration {
 	return time.Duration(n) * time.Microsecond
@@ -60,8 +190,24 @@ func TimestampToTime(n uint64) time.Time {
 	return time.Unix(int64(sec), int64(nsec))
 }
 
+// parseUTC parses text form of timezone types without location (such as "2020-05-29T11:22:54Z")
+func parseUTC(layout, s string) (t time.Time, err error) {
+	if layout == LayoutTimestamp && strings.IndexByte(s, '.') < 0 {
+		layout = LayoutDatetime
+	}
+	t, err = time.ParseInLocation(layout, s, time.UTC)
+	if err != nil {
+		return t, xerrors.WithStackTrace(fmt.Errorf("parse '%s' failed: %w", s, err))
+	}
+
+	return t, nil
+}
+
 func TzDateToTime(s string) (t time.Time, err error) {
 	ss := strings.Split(s, ",")
+	if len(ss) == 1 {
+		return parseUTC(LayoutDate, s)
+	}
 	if len(ss) != 2 { //nolint:gomnd
 		return t, xerrors.WithStackTrace(fmt.Errorf("not found timezone location in '%s'", s))
 	}
@@ -79,6 +225,9 @@ func TzDateToTime(s string) (t time.Time, err error) {
 
 func TzDatetimeToTime(s string) (t time.Time, err error) {
 	ss := strings.Split(s, ",")
+	if len(ss) == 1 {
+		return parseUTC(LayoutDatetime, s)
+	}
 	if len(ss) != 2 { //nolint:gomnd
 		return t, xerrors.WithStackTrace(fmt.Errorf("not found timezone location in '%s'", s))
 	}
@@ -96,6 +245,9 @@ func TzDatetimeToTime(s string) (t time.Time, err error) {
 
 func TzTimestampToTime(s string) (t time.Time, err error) {
 	ss := strings.Split(s, ",")
+	if len(ss) == 1 {
+		return parseUTC(LayoutTimestamp, s)
+	}
 	if len(ss) != 2 { //nolint:gomnd
 		return t, xerrors.WithStackTrace(fmt.Errorf("not found timezone location in '%s'", s))
 	}
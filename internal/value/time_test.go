@@ -1,10 +1,14 @@
 package value
 
 import (
+	"math"
+	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 )
 
 func TestTzSomeToTime(t *testing.T) {
@@ -70,3 +74,223 @@ func TestTzSomeToTime(t *testing.T) {
 		})
 	}
 }
+
+func TestTemporalRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(0)) //nolint:gosec
+	a := allocator.New()
+	defer a.Free()
+	roundTrip := func(v Value) Value {
+		return FromYDB(v.Type().ToYDB(a), v.toYDB(a))
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		ts := TimestampToTime(uint64(r.Int63n(int64(maxTimestamp))))
+		// wall time in daylight saving time overlap is ambiguous
+		ambiguous := func(tt time.Time) bool {
+			y, m, d := tt.Date()
+			h, mm, ss := tt.Clock()
+
+			return !time.Date(y, m, d, h, mm, ss, tt.Nanosecond(), berlin).Equal(tt)
+		}(ts.In(berlin))
+		t.Run("Date", func(t *testing.T) {
+			v, err := DateValueFromTimeChecked(ts)
+			require.NoError(t, err)
+			var dst time.Time
+			require.NoError(t, CastTo(roundTrip(v), &dst))
+			y, m, d := ts.UTC().Date()
+			require.Equal(t, time.Date(y, m, d, 0, 0, 0, 0, time.UTC), dst.UTC())
+		})
+		t.Run("Datetime", func(t *testing.T) {
+			v, err := DatetimeValueFromTimeChecked(ts)
+			require.NoError(t, err)
+			var dst time.Time
+			require.NoError(t, CastTo(roundTrip(v), &dst))
+			require.Equal(t, ts.Truncate(time.Second).UTC(), dst.UTC())
+		})
+		t.Run("Timestamp", func(t *testing.T) {
+			v, err := TimestampValueFromTimeChecked(ts)
+			require.NoError(t, err)
+			var dst time.Time
+			require.NoError(t, CastTo(roundTrip(v), &dst))
+			require.Equal(t, ts.UTC(), dst.UTC())
+		})
+		t.Run("TzTimestamp", func(t *testing.T) {
+			if ambiguous {
+				t.Skip("ambiguous wall time")
+			}
+			var dst time.Time
+			require.NoError(t, CastTo(roundTrip(TzTimestampValueFromTime(ts.In(berlin))), &dst))
+			require.Equal(t, ts.In(berlin), dst)
+		})
+		t.Run("TzDatetime", func(t *testing.T) {
+			if ambiguous {
+				t.Skip("ambiguous wall time")
+			}
+			var dst time.Time
+			require.NoError(t, CastTo(roundTrip(TzDatetimeValueFromTime(ts.In(berlin))), &dst))
+			require.Equal(t, ts.Truncate(time.Second).In(berlin), dst)
+		})
+		t.Run("Interval", func(t *testing.T) {
+			d := time.Duration(r.Int63n(2*maxInterval-1)-maxInterval+1) * time.Microsecond
+			v, err := IntervalValueFromDurationChecked(d)
+			require.NoError(t, err)
+			var dst time.Duration
+			require.NoError(t, CastTo(roundTrip(v), &dst))
+			require.Equal(t, d, dst)
+		})
+	}
+}
+
+func TestTemporalOutOfRange(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		f    func() error
+	}{
+		{
+			name: "Date before 1970",
+			f: func() error {
+				_, err := DateValueFromTimeChecked(time.Date(1969, time.December, 31, 0, 0, 0, 0, time.UTC))
+
+				return err
+			},
+		},
+		{
+			name: "Date after 2105",
+			f: func() error {
+				_, err := DateValueFromTimeChecked(time.Date(2106, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+				return err
+			},
+		},
+		{
+			name: "Datetime after 2105",
+			f: func() error {
+				_, err := DatetimeValueFromTimeChecked(time.Date(2106, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+				return err
+			},
+		},
+		{
+			name: "Timestamp before 1970",
+			f: func() error {
+				_, err := TimestampValueFromTimeChecked(time.Unix(-1, 0))
+
+				return err
+			},
+		},
+		{
+			name: "Interval",
+			f: func() error {
+				_, err := IntervalValueFromDurationChecked(-time.Duration(maxInterval) * time.Microsecond)
+
+				return err
+			},
+		},
+		{
+			name: "IntervalFromParts",
+			f: func() error {
+				_, err := IntervalValueFromParts(49673, 0, 0, 0, 0)
+
+				return err
+			},
+		},
+		{
+			name: "IntervalFromPartsOverflow",
+			f: func() error {
+				_, err := IntervalValueFromParts(0, 0, 0, 0, math.MinInt64)
+
+				return err
+			},
+		},
+		{
+			name: "IntervalFromPartsMultiplicationOverflow",
+			f: func() error {
+				_, err := IntervalValueFromParts(math.MaxInt64/int64(secondsPerDay), 0, 0, 0, 0)
+
+				return err
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.f()
+			require.ErrorIs(t, err, ErrOutOfRange)
+			var outOfRangeErr *OutOfRangeError
+			require.ErrorAs(t, err, &outOfRangeErr)
+		})
+	}
+	_, err := DateValueFromTimeChecked(time.Date(2105, time.December, 31, 23, 59, 59, 0, time.UTC))
+	require.NoError(t, err)
+	_, err = TimestampValueFromTimeChecked(time.Unix(0, 0))
+	require.NoError(t, err)
+}
+
+func TestTzValueFromTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	ts := time.Date(2020, time.May, 29, 11, 22, 54, 123456789, berlin)
+	require.Equal(t, TzDateValue("2020-05-29,Europe/Berlin"), TzDateValueFromTime(ts))
+	require.Equal(t, TzDatetimeValue("2020-05-29T11:22:54,Europe/Berlin"), TzDatetimeValueFromTime(ts))
+	require.Equal(t, TzTimestampValue("2020-05-29T11:22:54.123456,Europe/Berlin"), TzTimestampValueFromTime(ts))
+	require.Equal(t, TzTimestampValue("2020-05-29T09:22:54.123456Z"), TzTimestampValueFromTime(ts.In(time.Local)))
+	require.Equal(t, TzDatetimeValue("2020-05-29T09:22:54Z"), TzDatetimeValueFromTime(ts.UTC()))
+	for _, v := range []Value{
+		TzDateValueFromTime(ts.UTC()),
+		TzDatetimeValueFromTime(ts.UTC()),
+		TzTimestampValueFromTime(ts.UTC()),
+	} {
+		var dst time.Time
+		require.NoError(t, CastTo(v, &dst))
+		require.Equal(t, time.UTC, dst.Location())
+	}
+}
+
+func TestIntervalValueFromParts(t *testing.T) {
+	for _, tt := range []struct {
+		days, hours, minutes, seconds, microseconds int64
+
+		exp     time.Duration
+		literal string
+	}{
+		{
+			exp:     0,
+			literal: `Interval("PT0S")`,
+		},
+		{
+			days:         1,
+			hours:        2,
+			minutes:      3,
+			seconds:      4,
+			microseconds: 5,
+			exp:          26*time.Hour + 3*time.Minute + 4*time.Second + 5*time.Microsecond,
+			literal:      `Interval("P1DT2H3M4.000005S")`,
+		},
+		{
+			days:    1,
+			hours:   -1,
+			exp:     23 * time.Hour,
+			literal: `Interval("PT23H")`,
+		},
+		{
+			minutes: -90,
+			exp:     -90 * time.Minute,
+			literal: `Interval("-PT1H30M")`,
+		},
+		{
+			days:         49672,
+			hours:        23,
+			minutes:      59,
+			seconds:      59,
+			microseconds: 999999,
+			exp:          time.Duration(maxInterval-1) * time.Microsecond,
+			literal:      `Interval("P49672DT23H59M59.999999S")`,
+		},
+	} {
+		t.Run(tt.literal, func(t *testing.T) {
+			v, err := IntervalValueFromParts(tt.days, tt.hours, tt.minutes, tt.seconds, tt.microseconds)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, IntervalToDuration(int64(v)))
+			require.Equal(t, tt.literal, v.Yql())
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package value
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ToJSON returns JSON representation of v for debugging and HTTP APIs:
+//   - NULL of Optional and Void are null
+//   - integers are numbers, NaN and infinities of Float and Double are strings "NaN", "+Inf" and "-Inf"
+//   - Date is "2006-01-02", Datetime and Timestamp are RFC 3339 strings in UTC
+//   - Interval is a string of time.Duration, for example "1h2m3.5s"
+//   - Bytes and Yson are base64 strings, Decimal, DyNumber, UUID, Pg and Tz-types are strings
+//   - Json and JsonDocument are embedded as is
+//   - List, Set and Tuple are arrays, Struct is an object
+//   - Dict with Text keys is an object, other Dict is an array of [key, value] pairs
+//   - Variant is an object with single field named as variant name (or index for tuple variant)
+//   - Tagged is its inner value
+//
+// The representation is lossy: Just(NULL) of nested Optional is null as NULL, invalid UTF-8 of Text
+// is replaced by U+FFFD, tags of Tagged and time zones of Tz-types are not parsed back and integers
+// above 2^53 lose precision in JSON decoders which decode numbers into float64
+func ToJSON(v Value) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, v); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+//nolint:funlen,gocyclo
+func writeJSON(buf *bytes.Buffer, v Value) error {
+	switch vv := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case *optionalValue:
+		if vv.value == nil {
+			buf.WriteString("null")
+
+			return nil
+		}
+
+		return writeJSON(buf, vv.value)
+	case voidValue:
+		buf.WriteString("null")
+	case boolValue:
+		buf.WriteString(strconv.FormatBool(bool(vv)))
+	case int8Value:
+		buf.WriteString(strconv.FormatInt(int64(vv), 10))
+	case int16Value:
+		buf.WriteString(strconv.FormatInt(int64(vv), 10))
+	case int32Value:
+		buf.WriteString(strconv.FormatInt(int64(vv), 10))
+	case int64Value:
+		buf.WriteString(strconv.FormatInt(int64(vv), 10))
+	case uint8Value:
+		buf.WriteString(strconv.FormatUint(uint64(vv), 10))
+	case uint16Value:
+		buf.WriteString(strconv.FormatUint(uint64(vv), 10))
+	case uint32Value:
+		buf.WriteString(strconv.FormatUint(uint64(vv), 10))
+	case uint64Value:
+		buf.WriteString(strconv.FormatUint(uint64(vv), 10))
+	case *floatValue:
+		writeJSONFloat(buf, float64(vv.value), 32)
+	case *doubleValue:
+		writeJSONFloat(buf, vv.value, 64)
+	case dateValue:
+		writeJSONString(buf, DateToTime(uint32(vv)).UTC().Format(time.DateOnly))
+	case datetimeValue:
+		writeJSONString(buf, DatetimeToTime(uint32(vv)).UTC().Format(time.RFC3339))
+	case timestampValue:
+		writeJSONString(buf, TimestampToTime(uint64(vv)).UTC().Format(time.RFC3339Nano))
+	case intervalValue:
+		writeJSONString(buf, IntervalToDuration(int64(vv)).String())
+	case tzDateValue:
+		writeJSONString(buf, string(vv))
+	case tzDatetimeValue:
+		writeJSONString(buf, string(vv))
+	case tzTimestampValue:
+		writeJSONString(buf, string(vv))
+	case textValue:
+		writeJSONString(buf, string(vv))
+	case bytesValue:
+		writeJSONString(buf, base64.StdEncoding.EncodeToString(vv))
+	case ysonValue:
+		writeJSONString(buf, base64.StdEncoding.EncodeToString(vv))
+	case dyNumberValue:
+		writeJSONString(buf, string(vv))
+	case jsonValue:
+		return writeJSONRaw(buf, string(vv))
+	case jsonDocumentValue:
+		return writeJSONRaw(buf, string(vv))
+	case *uuidValue:
+		writeJSONString(buf, uuid.UUID(vv.value).String())
+	case *decimalValue:
+		writeJSONString(buf, formatDecimal(
+			decimal.FromBytes(vv.value[:], vv.innerType.Precision(), vv.innerType.Scale()),
+			vv.innerType.Scale(),
+		))
+	case pgValue:
+		writeJSONString(buf, vv.val)
+	case *taggedValue:
+		return writeJSON(buf, vv.value)
+	case *listValue:
+		return writeJSONArray(buf, vv.items)
+	case *setValue:
+		return writeJSONArray(buf, vv.items)
+	case *tupleValue:
+		return writeJSONArray(buf, vv.items)
+	case *structValue:
+		buf.WriteByte('{')
+		for i := range vv.fields {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeJSONString(buf, vv.fields[i].Name)
+			buf.WriteByte(':')
+			if err := writeJSON(buf, vv.fields[i].V); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case *dictValue:
+		return writeJSONDict(buf, vv)
+	case *variantValue:
+		name, idx := vv.Variant()
+		if _, isTuple := vv.innerType.(*types.VariantTuple); isTuple {
+			name = strconv.FormatUint(uint64(idx), 10)
+		}
+		buf.WriteByte('{')
+		writeJSONString(buf, name)
+		buf.WriteByte(':')
+		if err := writeJSON(buf, vv.value); err != nil {
+			return err
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("%w: cannot marshal %T to JSON", ErrInvalidJSON, v)
+	}
+
+	return nil
+}
+
+func writeJSONFloat(buf *bytes.Buffer, f float64, bitSize int) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		writeJSONString(buf, strconv.FormatFloat(f, 'g', -1, bitSize))
+
+		return
+	}
+	buf.WriteString(strconv.FormatFloat(f, 'g', -1, bitSize))
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) {
+	b, _ := json.Marshal(s) //nolint:errchkjson
+	buf.Write(b)
+}
+
+func writeJSONRaw(buf *bytes.Buffer, s string) error {
+	if !json.Valid([]byte(s)) {
+		return fmt.Errorf("%w: %q", ErrInvalidJSON, s)
+	}
+
+	return json.Compact(buf, []byte(s))
+}
+
+func writeJSONArray(buf *bytes.Buffer, items []Value) error {
+	buf.WriteByte('[')
+	for i := range items {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJSON(buf, items[i]); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+
+	return nil
+}
+
+func writeJSONDict(buf *bytes.Buffer, v *dictValue) error {
+	var stringKeys bool
+	if t, ok := v.t.(*types.Dict); ok {
+		stringKeys = types.Equal(t.KeyType(), types.Text)
+	}
+	if stringKeys {
+		buf.WriteByte('{')
+	} else {
+		buf.WriteByte('[')
+	}
+	for i := range v.values {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if stringKeys {
+			writeJSONString(buf, string(v.values[i].K.(textValue))) //nolint:forcetypeassert
+			buf.WriteByte(':')
+			if err := writeJSON(buf, v.values[i].V); err != nil {
+				return err
+			}
+
+			continue
+		}
+		buf.WriteByte('[')
+		if err := writeJSON(buf, v.values[i].K); err != nil {
+			return err
+		}
+		buf.WriteByte(',')
+		if err := writeJSON(buf, v.values[i].V); err != nil {
+			return err
+		}
+		buf.WriteByte(']')
+	}
+	if stringKeys {
+		buf.WriteByte('}')
+	} else {
+		buf.WriteByte(']')
+	}
+
+	return nil
+}
+
+func (v boolValue) MarshalJSON() ([]byte, error)         { return ToJSON(v) }
+func (v dateValue) MarshalJSON() ([]byte, error)         { return ToJSON(v) }
+func (v datetimeValue) MarshalJSON() ([]byte, error)     { return ToJSON(v) }
+func (v *decimalValue) MarshalJSON() ([]byte, error)     { return ToJSON(v) }
+func (v *dictValue) MarshalJSON() ([]byte, error)        { return ToJSON(v) }
+func (v *doubleValue) MarshalJSON() ([]byte, error)      { return ToJSON(v) }
+func (v dyNumberValue) MarshalJSON() ([]byte, error)     { return ToJSON(v) }
+func (v *floatValue) MarshalJSON() ([]byte, error)       { return ToJSON(v) }
+func (v int8Value) MarshalJSON() ([]byte, error)         { return ToJSON(v) }
+func (v int16Value) MarshalJSON() ([]byte, error)        { return ToJSON(v) }
+func (v int32Value) MarshalJSON() ([]byte, error)        { return ToJSON(v) }
+func (v int64Value) MarshalJSON() ([]byte, error)        { return ToJSON(v) }
+func (v intervalValue) MarshalJSON() ([]byte, error)     { return ToJSON(v) }
+func (v jsonValue) MarshalJSON() ([]byte, error)         { return ToJSON(v) }
+func (v jsonDocumentValue) MarshalJSON() ([]byte, error) { return ToJSON(v) }
+func (v *listValue) MarshalJSON() ([]byte, error)        { return ToJSON(v) }
+func (v pgValue) MarshalJSON() ([]byte, error)           { return ToJSON(v) }
+func (v *setValue) MarshalJSON() ([]byte, error)         { return ToJSON(v) }
+func (v *optionalValue) MarshalJSON() ([]byte, error)    { return ToJSON(v) }
+func (v *structValue) MarshalJSON() ([]byte, error)      { return ToJSON(v) }
+func (v timestampValue) MarshalJSON() ([]byte, error)    { return ToJSON(v) }
+func (v *tupleValue) MarshalJSON() ([]byte, error)       { return ToJSON(v) }
+func (v tzDateValue) MarshalJSON() ([]byte, error)       { return ToJSON(v) }
+func (v tzDatetimeValue) MarshalJSON() ([]byte, error)   { return ToJSON(v) }
+func (v tzTimestampValue) MarshalJSON() ([]byte, error)  { return ToJSON(v) }
+func (v uint8Value) MarshalJSON() ([]byte, error)        { return ToJSON(v) }
+func (v uint16Value) MarshalJSON() ([]byte, error)       { return ToJSON(v) }
+func (v uint32Value) MarshalJSON() ([]byte, error)       { return ToJSON(v) }
+func (v uint64Value) MarshalJSON() ([]byte, error)       { return ToJSON(v) }
+func (v textValue) MarshalJSON() ([]byte, error)         { return ToJSON(v) }
+func (v *uuidValue) MarshalJSON() ([]byte, error)        { return ToJSON(v) }
+func (v *taggedValue) MarshalJSON() ([]byte, error)      { return ToJSON(v) }
+func (v *variantValue) MarshalJSON() ([]byte, error)     { return ToJSON(v) }
+func (v voidValue) MarshalJSON() ([]byte, error)         { return ToJSON(v) }
+func (v ysonValue) MarshalJSON() ([]byte, error)         { return ToJSON(v) }
+func (v bytesValue) MarshalJSON() ([]byte, error)        { return ToJSON(v) }
@@ -0,0 +1,136 @@
+package value
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+)
+
+func TestToJSON(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		v    Value
+		exp  string
+	}{
+		{
+			name: "Null",
+			v:    NullValue(types.Int32),
+			exp:  `null`,
+		},
+		{
+			name: "Optional",
+			v:    OptionalValue(Int32Value(1)),
+			exp:  `1`,
+		},
+		{
+			name: "Void",
+			v:    VoidValue(),
+			exp:  `null`,
+		},
+		{
+			name: "Integers",
+			v: TupleValue(
+				Int8Value(-1), Int64Value(math.MinInt64), Uint64Value(math.MaxUint64), BoolValue(true),
+			),
+			exp: `[-1,-9223372036854775808,18446744073709551615,true]`,
+		},
+		{
+			name: "Floats",
+			v: ListValue(
+				DoubleValue(1.5), DoubleValue(math.NaN()), DoubleValue(math.Inf(1)), DoubleValue(math.Inf(-1)),
+			),
+			exp: `[1.5,"NaN","+Inf","-Inf"]`,
+		},
+		{
+			name: "Float",
+			v:    FloatValue(0.1),
+			exp:  `0.1`,
+		},
+		{
+			name: "Times",
+			v: TupleValue(
+				DateValueFromTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)),
+				DatetimeValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+				TimestampValueFromTime(time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)),
+				IntervalValueFromDuration(time.Hour+1500*time.Millisecond),
+			),
+			exp: `["2024-01-02","2024-01-02T03:04:05Z","2024-01-02T03:04:05.000006Z","1h0m1.5s"]`,
+		},
+		{
+			name: "Strings",
+			v: TupleValue(
+				TextValue("<a>\n"), BytesValue([]byte{0, 1, 2}), YSONValue([]byte("[1]")),
+				UUIDValue([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}),
+				DecimalValueFromBigInt(big.NewInt(-150), 22, 2),
+			),
+			exp: `["\u003ca\u003e\n","AAEC","WzFd","01020304-0506-0708-090a-0b0c0d0e0f10","-1.50"]`,
+		},
+		{
+			name: "JSON",
+			v:    TupleValue(JSONValue(`{"a": [1, 2]}`), JSONDocumentValue(`"s"`)),
+			exp:  `[{"a":[1,2]},"s"]`,
+		},
+		{
+			name: "Struct",
+			v: StructValue(
+				StructValueField{Name: "id", V: Uint64Value(1)},
+				StructValueField{Name: "title", V: NullValue(types.Text)},
+			),
+			exp: `{"id":1,"title":null}`,
+		},
+		{
+			name: "DictWithTextKeys",
+			v: DictValue(
+				DictValueField{K: TextValue("b"), V: Int32Value(2)},
+				DictValueField{K: TextValue("a"), V: Int32Value(1)},
+			),
+			exp: `{"a":1,"b":2}`,
+		},
+		{
+			name: "DictWithNonTextKeys",
+			v: DictValue(
+				DictValueField{K: Int32Value(1), V: TextValue("a")},
+			),
+			exp: `[[1,"a"]]`,
+		},
+		{
+			name: "EmptyList",
+			v:    ZeroValue(types.NewList(types.Int32)),
+			exp:  `[]`,
+		},
+		{
+			name: "Variant",
+			v: VariantValueStruct(Int32Value(1), "a", types.NewVariantStruct(
+				types.StructField{Name: "a", T: types.Int32},
+				types.StructField{Name: "b", T: types.Text},
+			)),
+			exp: `{"a":1}`,
+		},
+		{
+			name: "Tagged",
+			v:    TaggedValue("tag", TextValue("a")),
+			exp:  `"a"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := ToJSON(tt.v)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, string(b))
+
+			b, err = json.Marshal(tt.v)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, string(b))
+		})
+	}
+}
+
+func TestToJSONInvalidJSON(t *testing.T) {
+	_, err := ToJSON(ListValue(JSONValue(`{`)))
+	require.ErrorIs(t, err, ErrInvalidJSON)
+}
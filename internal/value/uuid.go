@@ -0,0 +1,64 @@
+package value
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// LegacyUUIDBytes is a UUID bytes representation with byte order of ydb-go-sdk before v3.67.0
+//
+// Old versions of ydb-go-sdk encode [16]byte as big-endian uint128 without reordering
+// of bytes to the storage representation. Values written with old ordering may be read
+// with LegacyUUIDBytes destination for getting the same bytes as were written.
+type LegacyUUIDBytes [16]byte
+
+// uuidReorder swaps bytes between RFC 4122 (direct) order and mixed-endian storage order.
+// Transformation is symmetric, so the same function converts both directions.
+func uuidReorder(src [16]byte) (dst [16]byte) {
+	dst[0], dst[1], dst[2], dst[3] = src[3], src[2], src[1], src[0]
+	dst[4], dst[5] = src[5], src[4]
+	dst[6], dst[7] = src[7], src[6]
+	copy(dst[8:], src[8:])
+
+	return dst
+}
+
+// UUIDToHiLoPair converts UUID bytes in RFC 4122 order to YDB wire representation
+func UUIDToHiLoPair(v [16]byte) (high, low uint64) {
+	le := uuidReorder(v)
+
+	return binary.LittleEndian.Uint64(le[8:16]), binary.LittleEndian.Uint64(le[0:8])
+}
+
+// UUIDFromHiLoPair converts YDB wire representation to UUID bytes in RFC 4122 order
+func UUIDFromHiLoPair(high, low uint64) (v [16]byte) {
+	var le [16]byte
+	binary.LittleEndian.PutUint64(le[0:8], low)
+	binary.LittleEndian.PutUint64(le[8:16], high)
+
+	return uuidReorder(le)
+}
+
+// uuidToLegacyBytes returns bytes as they were decoded by old versions of ydb-go-sdk
+func uuidToLegacyBytes(v [16]byte) LegacyUUIDBytes {
+	return BigEndianUint128(UUIDToHiLoPair(v))
+}
+
+// uuidFromLegacyBytes returns UUID bytes in RFC 4122 order from bytes written by old versions of ydb-go-sdk
+func uuidFromLegacyBytes(v [16]byte) [16]byte {
+	return UUIDFromHiLoPair(binary.BigEndian.Uint64(v[0:8]), binary.BigEndian.Uint64(v[8:16]))
+}
+
+// UUIDFromString parses UUID from canonical text form (such as "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+func UUIDFromString(s string) ([16]byte, error) {
+	v, err := uuid.Parse(s)
+	if err != nil {
+		return [16]byte{}, xerrors.WithStackTrace(fmt.Errorf("parse UUID from string %q failed: %w", s, err))
+	}
+
+	return v, nil
+}
@@ -0,0 +1,76 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+)
+
+func TestUUIDToYDB(t *testing.T) {
+	id := uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff")
+	v := UUIDValue(id).toYDB(allocator.New())
+	require.Equal(t, uint64(0x6677445500112233), v.GetLow_128())
+	require.Equal(t, uint64(0xffeeddccbbaa9988), v.GetHigh_128())
+	require.Equal(t, `Uuid("00112233-4455-6677-8899-aabbccddeeff")`, UUIDValue(id).Yql())
+}
+
+func TestUUIDHiLoPairRoundTrip(t *testing.T) {
+	for _, id := range []uuid.UUID{
+		uuid.Nil,
+		uuid.MustParse("00112233-4455-6677-8899-aabbccddeeff"),
+		uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		uuid.New(),
+	} {
+		t.Run(id.String(), func(t *testing.T) {
+			high, low := UUIDToHiLoPair(id)
+			require.Equal(t, [16]byte(id), UUIDFromHiLoPair(high, low))
+
+			v := FromYDB(UUIDValue(id).Type().ToYDB(allocator.New()), UUIDValue(id).toYDB(allocator.New()))
+			var dst [16]byte
+			require.NoError(t, CastTo(v, &dst))
+			require.Equal(t, [16]byte(id), dst)
+		})
+	}
+}
+
+func TestUUIDCastTo(t *testing.T) {
+	id := uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	v := UUIDValue(id)
+	t.Run("string", func(t *testing.T) {
+		var dst string
+		require.NoError(t, CastTo(v, &dst))
+		require.Equal(t, id.String(), dst)
+	})
+	t.Run("[]byte", func(t *testing.T) {
+		var dst []byte
+		require.NoError(t, CastTo(v, &dst))
+		require.Equal(t, id[:], dst)
+	})
+	t.Run("encoding.TextUnmarshaler", func(t *testing.T) {
+		var dst uuid.UUID
+		require.NoError(t, CastTo(v, &dst))
+		require.Equal(t, id, dst)
+	})
+	t.Run("LegacyUUIDBytes", func(t *testing.T) {
+		legacy := [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+		a := allocator.New()
+		defer a.Free()
+		// old versions of ydb-go-sdk writes bytes as big-endian uint128
+		require.Equal(t, uint64(0x0102030405060708), UUIDWithLegacyByteOrderValue(legacy).toYDB(a).GetHigh_128())
+		require.Equal(t, uint64(0x090a0b0c0d0e0f10), UUIDWithLegacyByteOrderValue(legacy).toYDB(a).GetLow_128())
+		var dst LegacyUUIDBytes
+		require.NoError(t, CastTo(UUIDWithLegacyByteOrderValue(legacy), &dst))
+		require.Equal(t, LegacyUUIDBytes(legacy), dst)
+	})
+}
+
+func TestUUIDFromString(t *testing.T) {
+	v, err := UUIDFromString("00112233-4455-6677-8899-aabbccddeeff")
+	require.NoError(t, err)
+	require.Equal(t, [16]byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}, v)
+	_, err = UUIDFromString("not-a-uuid")
+	require.Error(t, err)
+}
@@ -1,12 +1,16 @@
 package value
 
 import (
+	"database/sql"
+	"encoding"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -160,7 +164,7 @@ func primitiveValueFromYDB(t types.Primitive, v *Ydb.Value) (Value, error) {
 		return BytesValue(v.GetBytesValue()), nil
 
 	case types.UUID:
-		return UUIDValue(BigEndianUint128(v.GetHigh_128(), v.GetLow_128())), nil
+		return UUIDValue(UUIDFromHiLoPair(v.GetHigh_128(), v.GetLow_128())), nil
 
 	default:
 		return nil, xerrors.WithStackTrace(fmt.Errorf("uncovered primitive type: %T", t))
@@ -189,8 +193,11 @@ func fromYDB(t *Ydb.Type, v *Ydb.Value) (Value, error) {
 
 	case types.Optional:
 		t = t.GetType().(*Ydb.Type_OptionalType).OptionalType.GetItem()
-		if nestedValue, ok := v.GetValue().(*Ydb.Value_NestedValue); ok {
-			return OptionalValue(FromYDB(t, nestedValue.NestedValue)), nil
+		// nested value wraps only optional items, other nested values (such as variant) belong to item
+		if _, isOptional := ttt.InnerType().(types.Optional); isOptional {
+			if nestedValue, ok := v.GetValue().(*Ydb.Value_NestedValue); ok {
+				return OptionalValue(FromYDB(t, nestedValue.NestedValue)), nil
+			}
 		}
 
 		return OptionalValue(FromYDB(t, v)), nil
@@ -287,6 +294,12 @@ func fromYDB(t *Ydb.Type, v *Ydb.Value) (Value, error) {
 			ttt.Tuple,
 		), nil
 
+	case *types.Tagged:
+		a := allocator.New()
+		defer a.Free()
+
+		return TaggedValue(ttt.Tag(), FromYDB(ttt.InnerType().ToYDB(a), v)), nil
+
 	case *types.PgType:
 		return &pgValue{
 			t: types.PgType{
@@ -492,8 +505,7 @@ func (v *decimalValue) Yql() string {
 	buffer.WriteString(v.innerType.Name())
 	buffer.WriteByte('(')
 	buffer.WriteByte('"')
-	s := decimal.FromBytes(v.value[:], v.innerType.Precision(), v.innerType.Scale()).String()
-	buffer.WriteString(s[:len(s)-int(v.innerType.Scale())] + "." + s[len(s)-int(v.innerType.Scale()):])
+	buffer.WriteString(formatDecimal(decimal.FromBytes(v.value[:], v.innerType.Precision(), v.innerType.Scale()), v.innerType.Scale()))
 	buffer.WriteByte('"')
 	buffer.WriteByte(',')
 	buffer.WriteString(strconv.FormatUint(uint64(v.innerType.Precision()), 10))
@@ -504,6 +516,25 @@ func (v *decimalValue) Yql() string {
 	return buffer.String()
 }
 
+// formatDecimal formats unscaled decimal value with exactly scale digits after point
+func formatDecimal(x *big.Int, scale uint32) string {
+	if decimal.IsInf(x) || decimal.IsNaN(x) {
+		return decimal.Format(x, 0, 0)
+	}
+	s := new(big.Int).Abs(x).String()
+	if len(s) <= int(scale) {
+		s = strings.Repeat("0", int(scale)-len(s)+1) + s
+	}
+	if scale > 0 {
+		s = s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	}
+	if x.Sign() < 0 {
+		return "-" + s
+	}
+
+	return s
+}
+
 func (v *decimalValue) Type() types.Type {
 	return v.innerType
 }
@@ -567,6 +598,9 @@ func (v *dictValue) castTo(dst interface{}) error {
 }
 
 func (v *dictValue) Yql() string {
+	if t, ok := v.t.(*types.Dict); ok && len(v.values) == 0 {
+		return "DictCreate(" + t.KeyType().Yql() + "," + t.ValueType().Yql() + ")"
+	}
 	buffer := xstring.Buffer()
 	defer buffer.Free()
 	buffer.WriteByte('{')
@@ -815,7 +849,7 @@ func (v int8Value) castTo(dst interface{}) error {
 }
 
 func (v int8Value) Yql() string {
-	return strconv.FormatUint(uint64(v), 10) + "t"
+	return strconv.FormatInt(int64(v), 10) + "t"
 }
 
 func (int8Value) Type() types.Type {
@@ -877,7 +911,7 @@ func (v int16Value) castTo(dst interface{}) error {
 }
 
 func (v int16Value) Yql() string {
-	return strconv.FormatUint(uint64(v), 10) + "s"
+	return strconv.FormatInt(int64(v), 10) + "s"
 }
 
 func (int16Value) Type() types.Type {
@@ -989,7 +1023,7 @@ func (v int64Value) castTo(dst interface{}) error {
 }
 
 func (v int64Value) Yql() string {
-	return strconv.FormatUint(uint64(v), 10) + "l"
+	return strconv.FormatInt(int64(v), 10) + "l"
 }
 
 func (int64Value) Type() types.Type {
@@ -1042,6 +1076,9 @@ func (v intervalValue) Yql() string {
 		d = -d
 	}
 	buffer.WriteByte('P')
+	if d == 0 {
+		buffer.WriteString("T0S")
+	}
 	//nolint:gomnd
 	if days := d / time.Hour / 24; days > 0 {
 		d -= days * time.Hour * 24 //nolint:durationcheck
@@ -1106,6 +1143,15 @@ func (v jsonValue) castTo(dst interface{}) error {
 	case *[]byte:
 		*vv = xstring.ToBytes(string(v))
 
+		return nil
+	case json.Unmarshaler:
+		if err := vv.UnmarshalJSON(xstring.ToBytes(string(v))); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%+v' (type '%s') to '%T' destination: %w",
+				ErrCannotCast, v, v.Type().Yql(), vv, err,
+			))
+		}
+
 		return nil
 	default:
 		return xerrors.WithStackTrace(fmt.Errorf(
@@ -1148,6 +1194,15 @@ func (v jsonDocumentValue) castTo(dst interface{}) error {
 	case *[]byte:
 		*vv = xstring.ToBytes(string(v))
 
+		return nil
+	case json.Unmarshaler:
+		if err := vv.UnmarshalJSON(xstring.ToBytes(string(v))); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%+v' (type '%s') to '%T' destination: %w",
+				ErrCannotCast, v, v.Type().Yql(), vv, err,
+			))
+		}
+
 		return nil
 	default:
 		return xerrors.WithStackTrace(fmt.Errorf(
@@ -1196,6 +1251,9 @@ func (v *listValue) castTo(dst interface{}) error {
 }
 
 func (v *listValue) Yql() string {
+	if t, ok := v.t.(*types.List); ok && len(v.items) == 0 {
+		return "ListCreate(" + t.ItemType().Yql() + ")"
+	}
 	buffer := xstring.Buffer()
 	defer buffer.Free()
 	buffer.WriteByte('[')
@@ -1289,6 +1347,9 @@ func (v *setValue) castTo(dst interface{}) error {
 }
 
 func (v *setValue) Yql() string {
+	if t, ok := v.t.(*types.Set); ok && len(v.items) == 0 {
+		return "SetCreate(" + t.ItemType().Yql() + ")"
+	}
 	buffer := xstring.Buffer()
 	defer buffer.Free()
 	buffer.WriteByte('{')
@@ -1372,6 +1433,13 @@ func (v *optionalValue) castTo(dst interface{}) error {
 
 	if inner.Kind() != reflect.Pointer {
 		if v.value == nil {
+			if scanner, ok := dst.(sql.Scanner); ok {
+				if err := scanner.Scan(nil); err != nil {
+					return xerrors.WithStackTrace(fmt.Errorf("%w NULL to sql.Scanner '%T': %w", ErrCannotCast, dst, err))
+				}
+
+				return nil
+			}
 			if ptr.CanAddr() {
 				ptr.SetZero()
 			}
@@ -1379,7 +1447,7 @@ func (v *optionalValue) castTo(dst interface{}) error {
 			return nil
 		}
 
-		if err := v.value.castTo(ptr.Interface()); err != nil {
+		if err := CastTo(v.value, ptr.Interface()); err != nil {
 			return xerrors.WithStackTrace(err)
 		}
 
@@ -1394,7 +1462,7 @@ func (v *optionalValue) castTo(dst interface{}) error {
 
 	inner.Set(reflect.New(inner.Type().Elem()))
 
-	if err := v.value.castTo(inner.Interface()); err != nil {
+	if err := CastTo(v.value, inner.Interface()); err != nil {
 		return xerrors.WithStackTrace(err)
 	}
 
@@ -1580,6 +1648,9 @@ func (v *tupleValue) castTo(dst interface{}) error {
 }
 
 func (v *tupleValue) Yql() string {
+	if len(v.items) == 0 {
+		return "AsTuple()"
+	}
 	buffer := xstring.Buffer()
 	defer buffer.Free()
 	buffer.WriteByte('(')
@@ -1589,6 +1660,10 @@ func (v *tupleValue) Yql() string {
 		}
 		buffer.WriteString(item.Yql())
 	}
+	if len(v.items) == 1 {
+		// single item tuple must be marked with trailing comma
+		buffer.WriteByte(',')
+	}
 	buffer.WriteByte(')')
 
 	return buffer.String()
@@ -1628,6 +1703,17 @@ type tzDateValue string
 
 func (v tzDateValue) castTo(dst interface{}) error {
 	switch vv := dst.(type) {
+	case *time.Time:
+		t, err := TzDateToTime(string(v))
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%+v' (type '%s') to '%T' destination: %w",
+				ErrCannotCast, v, v.Type().Yql(), vv, err,
+			))
+		}
+		*vv = t
+
+		return nil
 	case *string:
 		*vv = string(v)
 
@@ -1666,14 +1752,26 @@ func TzDateValue(v string) tzDateValue {
 	return tzDateValue(v)
 }
 
+// TzDateValueFromTime makes TzDate value from time.Time with saving of time location
 func TzDateValueFromTime(t time.Time) tzDateValue {
-	return tzDateValue(t.Format(LayoutDate))
+	return tzDateValue(formatTz(t, LayoutDate, LayoutDate))
 }
 
 type tzDatetimeValue string
 
 func (v tzDatetimeValue) castTo(dst interface{}) error {
 	switch vv := dst.(type) {
+	case *time.Time:
+		t, err := TzDatetimeToTime(string(v))
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%+v' (type '%s') to '%T' destination: %w",
+				ErrCannotCast, v, v.Type().Yql(), vv, err,
+			))
+		}
+		*vv = t
+
+		return nil
 	case *string:
 		*vv = string(v)
 
@@ -1712,14 +1810,26 @@ func TzDatetimeValue(v string) tzDatetimeValue {
 	return tzDatetimeValue(v)
 }
 
+// TzDatetimeValueFromTime makes TzDatetime value from time.Time with saving of time location
 func TzDatetimeValueFromTime(t time.Time) tzDatetimeValue {
-	return tzDatetimeValue(t.Format(LayoutDatetime))
+	return tzDatetimeValue(formatTz(t, LayoutDatetime, LayoutTzDatetime))
 }
 
 type tzTimestampValue string
 
 func (v tzTimestampValue) castTo(dst interface{}) error {
 	switch vv := dst.(type) {
+	case *time.Time:
+		t, err := TzTimestampToTime(string(v))
+		if err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%+v' (type '%s') to '%T' destination: %w",
+				ErrCannotCast, v, v.Type().Yql(), vv, err,
+			))
+		}
+		*vv = t
+
+		return nil
 	case *string:
 		*vv = string(v)
 
@@ -1758,8 +1868,9 @@ func TzTimestampValue(v string) tzTimestampValue {
 	return tzTimestampValue(v)
 }
 
+// TzTimestampValueFromTime makes TzTimestamp value from time.Time with saving of time location
 func TzTimestampValueFromTime(t time.Time) tzTimestampValue {
-	return tzTimestampValue(t.Format(LayoutTimestamp))
+	return tzTimestampValue(formatTz(t, LayoutTimestamp, LayoutTzTimestamp))
 }
 
 type uint8Value uint8
@@ -2063,7 +2174,7 @@ type uuidValue struct {
 func (v *uuidValue) castTo(dst interface{}) error {
 	switch vv := dst.(type) {
 	case *string:
-		*vv = string(v.value[:])
+		*vv = uuid.UUID(v.value).String()
 
 		return nil
 	case *[]byte:
@@ -2073,6 +2184,19 @@ func (v *uuidValue) castTo(dst interface{}) error {
 	case *[16]byte:
 		*vv = v.value
 
+		return nil
+	case *LegacyUUIDBytes:
+		*vv = uuidToLegacyBytes(v.value)
+
+		return nil
+	case encoding.TextUnmarshaler:
+		if err := vv.UnmarshalText(xstring.ToBytes(uuid.UUID(v.value).String())); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf(
+				"%w '%+v' (type '%s') to '%T' destination: %w",
+				ErrCannotCast, v, v.Type().Yql(), vv, err,
+			))
+		}
+
 		return nil
 	default:
 		return xerrors.WithStackTrace(fmt.Errorf(
@@ -2100,24 +2224,68 @@ func (*uuidValue) Type() types.Type {
 }
 
 func (v *uuidValue) toYDB(a *allocator.Allocator) *Ydb.Value {
-	var bytes [16]byte
+	var high, low uint64
 	if v != nil {
-		bytes = v.value
+		high, low = UUIDToHiLoPair(v.value)
 	}
 	vv := a.Low128()
-	vv.Low_128 = binary.BigEndian.Uint64(bytes[8:16])
+	vv.Low_128 = low
 
 	vvv := a.Value()
-	vvv.High_128 = binary.BigEndian.Uint64(bytes[0:8])
+	vvv.High_128 = high
 	vvv.Value = vv
 
 	return vvv
 }
 
+// UUIDValue makes UUID value from bytes in RFC 4122 order
 func UUIDValue(v [16]byte) *uuidValue {
 	return &uuidValue{value: v}
 }
 
+// UUIDWithLegacyByteOrderValue makes UUID value which encodes bytes as old versions of ydb-go-sdk did
+//
+// Use it only for compatibility with values which were written by old versions of ydb-go-sdk.
+func UUIDWithLegacyByteOrderValue(v [16]byte) *uuidValue {
+	return &uuidValue{value: uuidFromLegacyBytes(v)}
+}
+
+type taggedValue struct {
+	t     *types.Tagged
+	value Value
+}
+
+func (v *taggedValue) Tag() string {
+	return v.t.Tag()
+}
+
+func (v *taggedValue) Value() Value {
+	return v.value
+}
+
+func (v *taggedValue) castTo(dst interface{}) error {
+	return CastTo(v.value, dst)
+}
+
+func (v *taggedValue) Yql() string {
+	return fmt.Sprintf("AsTagged(%s,%q)", v.value.Yql(), v.t.Tag())
+}
+
+func (v *taggedValue) Type() types.Type {
+	return v.t
+}
+
+func (v *taggedValue) toYDB(a *allocator.Allocator) *Ydb.Value {
+	return v.value.toYDB(a)
+}
+
+func TaggedValue(tag string, v Value) *taggedValue {
+	return &taggedValue{
+		t:     types.NewTagged(tag, v.Type()),
+		value: v,
+	}
+}
+
 type variantValue struct {
 	innerType types.Type
 	value     Value
@@ -2138,7 +2306,7 @@ func (v *variantValue) Value() Value {
 }
 
 func (v *variantValue) castTo(dst interface{}) error {
-	return v.value.castTo(dst)
+	return CastTo(v.value, dst)
 }
 
 func (v *variantValue) Yql() string {
@@ -2396,7 +2564,7 @@ func ZeroValue(t types.Type) Value {
 		}
 	case *types.Dict:
 		return &dictValue{
-			t: t.ValueType(),
+			t: t,
 		}
 	case *types.EmptyDict:
 		return &dictValue{
@@ -2428,6 +2596,9 @@ func ZeroValue(t types.Type) Value {
 	case *types.Decimal:
 		return DecimalValue([16]byte{}, decimalPrecision, decimalScale)
 
+	case *types.Tagged:
+		return TaggedValue(t.Tag(), ZeroValue(t.InnerType()))
+
 	default:
 		panic(fmt.Sprintf("type '%T' have not a zero value", t))
 	}
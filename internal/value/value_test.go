@@ -178,6 +178,11 @@ func TestToYDBFromYDB(t *testing.T) {
 		ZeroValue(types.NewStruct()),
 		ZeroValue(types.NewTuple()),
 		PgValue(pg.OIDInt4, "123"),
+		TaggedValue("id", Uint64Value(42)),
+		OptionalValue(TaggedValue("tag", VariantValueTuple(Int32Value(42), 1, types.NewTuple(
+			types.Bytes,
+			types.Int32,
+		)))),
 	} {
 		t.Run(strconv.Itoa(i)+"."+v.Yql(), func(t *testing.T) {
 			a := allocator.New()
@@ -429,6 +434,14 @@ func TestValueYql(t *testing.T) {
 			)),
 			literal: `Variant(42,"bar",Variant<'bar':Int32,'foo':String>)`,
 		},
+		{
+			value:   TaggedValue("id", Uint64Value(42)),
+			literal: `AsTagged(42ul,"id")`,
+		},
+		{
+			value:   OptionalValue(TaggedValue("name", TextValue("foo"))),
+			literal: `Just(AsTagged("foo"u,"name"))`,
+		},
 		{
 			value: StructValue(
 				StructValueField{"series_id", Uint64Value(1)},
@@ -40,7 +40,13 @@ func (ctx *cancelCtx) Err() error {
 		return ctx.err
 	}
 
-	ctx.err = ctx.ctx.Err()
+	if err := ctx.ctx.Err(); err != nil {
+		// error of parent context keeps cause of expired deadline of parent if any
+		if parentErr := ctx.parentCtx.Err(); parentErr != nil {
+			err = parentErr
+		}
+		ctx.err = err
+	}
 
 	return ctx.err
 }
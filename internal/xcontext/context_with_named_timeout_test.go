@@ -0,0 +1,68 @@
+package xcontext
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestContextWithNamedTimeout(t *testing.T) {
+	t.Run("Expired", func(t *testing.T) {
+		ctx, cancel := WithNamedTimeout(context.Background(), xerrors.DeadlineSourceDialTimeout, time.Millisecond)
+		defer cancel()
+		<-ctx.Done()
+		require.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+		deadline, ok := xerrors.DeadlineCause(ctx.Err())
+		require.True(t, ok)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceDialTimeout, Timeout: time.Millisecond}, deadline)
+	})
+	t.Run("ParentExpired", func(t *testing.T) {
+		parentCtx, parentCancel := WithNamedTimeout(context.Background(),
+			xerrors.DeadlineSourceAttemptTimeout, time.Millisecond,
+		)
+		defer parentCancel()
+		ctx, cancel := WithNamedTimeout(parentCtx, xerrors.DeadlineSourceDefaultOperationTimeout, time.Hour)
+		defer cancel()
+		<-ctx.Done()
+		// deadline of parent is reported
+		deadline, ok := xerrors.DeadlineCause(ctx.Err())
+		require.True(t, ok)
+		require.Equal(t, xerrors.DeadlineSourceAttemptTimeout, deadline.Source)
+	})
+	t.Run("ParentOfCancelCtxExpired", func(t *testing.T) {
+		parentCtx, parentCancel := WithNamedTimeout(context.Background(),
+			xerrors.DeadlineSourceDefaultScanQueryTimeout, time.Millisecond,
+		)
+		defer parentCancel()
+		ctx, cancel := WithCancel(parentCtx)
+		defer cancel()
+		<-ctx.Done()
+		// deadline of parent is reported before child is canceled
+		deadline, ok := xerrors.DeadlineCause(ctx.Err())
+		require.True(t, ok)
+		require.Equal(t, xerrors.DeadlineSourceDefaultScanQueryTimeout, deadline.Source)
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := WithNamedTimeout(context.Background(), xerrors.DeadlineSourceDialTimeout, time.Hour)
+		cancel()
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+		_, ok := xerrors.DeadlineCause(ctx.Err())
+		require.False(t, ok)
+	})
+	t.Run("Deadline", func(t *testing.T) {
+		d := time.Now().Add(time.Millisecond)
+		ctx, cancel := WithNamedDeadline(context.Background(), xerrors.DeadlineSourceAttemptTimeout, time.Second, d)
+		defer cancel()
+		deadline, has := ctx.Deadline()
+		require.True(t, has)
+		require.Equal(t, d, deadline)
+		<-ctx.Done()
+		cause, ok := xerrors.DeadlineCause(ctx.Err())
+		require.True(t, ok)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceAttemptTimeout, Timeout: time.Second}, cause)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/deadline"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 )
 
@@ -19,12 +20,45 @@ func WithTimeout(ctx context.Context, t time.Duration) (context.Context, context
 	return childCtx, childCtx.cancel
 }
 
+// WithNamedTimeout is like WithTimeout, but error of expired deadline of returned context is tagged
+// with source and timeout of deadline (see deadline.Cause)
+func WithNamedTimeout(
+	ctx context.Context, source deadline.Source, t time.Duration,
+) (context.Context, context.CancelFunc) {
+	childCtx := &timeoutCtx{
+		parentCtx: ctx,
+		from:      stack.Record(1),
+		deadline:  &deadline.Deadline{Source: source, Timeout: t},
+	}
+	childCtx.ctx, childCtx.ctxCancel = context.WithTimeout(ctx, t)
+
+	return childCtx, childCtx.cancel
+}
+
+// WithNamedDeadline is like WithNamedTimeout, but deadline of returned context is d instead of now plus t.
+// It is used with deadlines computed with custom clock
+func WithNamedDeadline(
+	ctx context.Context, source deadline.Source, t time.Duration, d time.Time,
+) (context.Context, context.CancelFunc) {
+	childCtx := &timeoutCtx{
+		parentCtx: ctx,
+		from:      stack.Record(1),
+		deadline:  &deadline.Deadline{Source: source, Timeout: t},
+	}
+	childCtx.ctx, childCtx.ctxCancel = context.WithDeadline(ctx, d)
+
+	return childCtx, childCtx.cancel
+}
+
 type timeoutCtx struct {
 	parentCtx context.Context //nolint:containedctx
 	ctx       context.Context //nolint:containedctx
 	ctxCancel context.CancelFunc
 	from      string
 
+	// deadline is a tag of error of expired deadline, nil for untagged context
+	deadline *deadline.Deadline
+
 	m   sync.Mutex
 	err error
 }
@@ -47,6 +81,9 @@ func (ctx *timeoutCtx) Err() error {
 
 	if errors.Is(ctx.ctx.Err(), context.DeadlineExceeded) && ctx.parentCtx.Err() == nil {
 		ctx.err = errFrom(context.DeadlineExceeded, ctx.from)
+		if ctx.deadline != nil {
+			ctx.err = deadline.WithCause(ctx.err, *ctx.deadline)
+		}
 
 		return ctx.err
 	}
@@ -0,0 +1,29 @@
+package xcontext
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type ctxCredentialsRecoveryKey struct{}
+
+// WithCredentialsRecovery returns a copy of parent context which scopes recovery of rejected
+// credentials to a single retry loop. Nested calls reuse the scope of the outermost loop
+func WithCredentialsRecovery(ctx context.Context) context.Context {
+	if _, has := ctx.Value(ctxCredentialsRecoveryKey{}).(*atomic.Bool); has {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ctxCredentialsRecoveryKey{}, &atomic.Bool{})
+}
+
+// MarkCredentialsRecovered marks the recovery scope of ctx as used and reports whether it is
+// the first recovery in the scope. Returns false if ctx has no recovery scope
+func MarkCredentialsRecovered(ctx context.Context) bool {
+	recovered, has := ctx.Value(ctxCredentialsRecoveryKey{}).(*atomic.Bool)
+	if !has {
+		return false
+	}
+
+	return recovered.CompareAndSwap(false, true)
+}
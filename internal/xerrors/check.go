@@ -1,6 +1,10 @@
 package xerrors
 
 import (
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 )
 
@@ -17,8 +21,7 @@ func Check(err error) (
 			backoff.TypeNoBackoff,
 			false
 	}
-	var e Error
-	if As(err, &e) {
+	if e := errorOf(err); e != nil {
 		return int64(e.Code()), e.Type(), e.BackoffType(), e.IsRetryObjectValid()
 	}
 
@@ -28,15 +31,39 @@ func Check(err error) (
 		false
 }
 
+// IsRetryObjectBusy reports whether retry object (such as session) of err is busy now
+func IsRetryObjectBusy(err error) bool {
+	return IsOperationError(err, Ydb.StatusIds_SESSION_BUSY)
+}
+
 func IsRetryObjectValid(err error) bool {
 	if err == nil {
 		return true
 	}
 
-	var e Error
-	if As(err, &e) {
+	if e := errorOf(err); e != nil {
 		return !e.IsRetryObjectValid()
 	}
 
 	return true
 }
+
+// errorOf returns ydb error from err. Raw grpc status errors and stream terminations
+// (returned from streams without wrapping of errors) are treated as transport errors
+func errorOf(err error) Error {
+	var e Error
+	if As(err, &e) {
+		return e
+	}
+	if e = TransportError(err); e != nil {
+		return e
+	}
+	if isStreamTermination(err) {
+		return &transportError{
+			status: grpcStatus.New(grpcCodes.Unknown, err.Error()),
+			err:    err,
+		}
+	}
+
+	return nil
+}
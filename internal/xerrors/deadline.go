@@ -0,0 +1,63 @@
+package xerrors
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/deadline"
+)
+
+// DeadlineSource is a source of deadline which expired
+type DeadlineSource = deadline.Source
+
+const (
+	DeadlineSourceCaller                  = deadline.SourceCaller
+	DeadlineSourceDefaultOperationTimeout = deadline.SourceDefaultOperationTimeout
+	DeadlineSourceDefaultSchemeTimeout    = deadline.SourceDefaultSchemeTimeout
+	DeadlineSourceDefaultScanQueryTimeout = deadline.SourceDefaultScanQueryTimeout
+	DeadlineSourceAttemptTimeout          = deadline.SourceAttemptTimeout
+	DeadlineSourceOperationTimeout        = deadline.SourceOperationTimeout
+	DeadlineSourceOperationCancelAfter    = deadline.SourceOperationCancelAfter
+	DeadlineSourceDialTimeout             = deadline.SourceDialTimeout
+	DeadlineSourceSessionCreateTimeout    = deadline.SourceSessionCreateTimeout
+	DeadlineSourceSessionDeleteTimeout    = deadline.SourceSessionDeleteTimeout
+)
+
+// Deadline describes expired deadline: its source and configured timeout
+type Deadline = deadline.Deadline
+
+// WithDeadlineCause tags err with deadline which caused err.
+// Already tagged err is returned as is, because the innermost tag is the most precise
+func WithDeadlineCause(err error, d Deadline) error {
+	return deadline.WithCause(err, d)
+}
+
+// DeadlineCause returns deadline which caused err. It returns false if err is not caused by expired deadline.
+// Deadline errors without tag of SDK (see WithDeadlineCause) are reported as DeadlineSourceCaller
+func DeadlineCause(err error) (Deadline, bool) {
+	if d, ok := deadline.Cause(err); ok {
+		return d, true
+	}
+	if errors.Is(err, context.DeadlineExceeded) || IsTransportError(err, grpcCodes.DeadlineExceeded) {
+		return Deadline{Source: DeadlineSourceCaller}, true
+	}
+
+	return Deadline{}, false
+}
+
+// WithOperationDeadlineCause tags operation error of expired server-side timeout with corresponding timeout.
+// Zero timeouts are not set, so such errors are not tagged
+func WithOperationDeadlineCause(err error, operationTimeout, cancelAfter time.Duration) error {
+	switch {
+	case operationTimeout > 0 && IsOperationError(err, Ydb.StatusIds_TIMEOUT):
+		return WithDeadlineCause(err, Deadline{Source: DeadlineSourceOperationTimeout, Timeout: operationTimeout})
+	case cancelAfter > 0 && IsOperationError(err, Ydb.StatusIds_CANCELLED):
+		return WithDeadlineCause(err, Deadline{Source: DeadlineSourceOperationCancelAfter, Timeout: cancelAfter})
+	default:
+		return err
+	}
+}
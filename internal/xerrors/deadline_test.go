@@ -0,0 +1,133 @@
+package xerrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestDeadlineCause(t *testing.T) {
+	attempt := Deadline{Source: DeadlineSourceAttemptTimeout, Timeout: time.Second}
+	for _, tt := range []struct {
+		name     string
+		err      error
+		deadline Deadline
+		ok       bool
+	}{
+		{
+			name: "Nil",
+			err:  nil,
+		},
+		{
+			name: "NotDeadline",
+			err:  errors.New("test"),
+		},
+		{
+			name: "Canceled",
+			err:  context.Canceled,
+		},
+		{
+			name:     "UntaggedContext",
+			err:      fmt.Errorf("wrapped: %w", context.DeadlineExceeded),
+			deadline: Deadline{Source: DeadlineSourceCaller},
+			ok:       true,
+		},
+		{
+			name:     "UntaggedTransport",
+			err:      Transport(grpcStatus.Error(grpcCodes.DeadlineExceeded, "")),
+			deadline: Deadline{Source: DeadlineSourceCaller},
+			ok:       true,
+		},
+		{
+			name:     "Tagged",
+			err:      WithStackTrace(WithDeadlineCause(context.DeadlineExceeded, attempt)),
+			deadline: attempt,
+			ok:       true,
+		},
+		{
+			name: "InnermostTag",
+			err: WithDeadlineCause(
+				WithDeadlineCause(context.DeadlineExceeded, attempt),
+				Deadline{Source: DeadlineSourceCaller},
+			),
+			deadline: attempt,
+			ok:       true,
+		},
+		{
+			name:     "Joined",
+			err:      Join(errors.New("test"), WithDeadlineCause(context.DeadlineExceeded, attempt)),
+			deadline: attempt,
+			ok:       true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			deadline, ok := DeadlineCause(tt.err)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.deadline, deadline)
+		})
+	}
+}
+
+func TestWithDeadlineCause(t *testing.T) {
+	require.NoError(t, WithDeadlineCause(nil, Deadline{}))
+	err := WithDeadlineCause(context.DeadlineExceeded, Deadline{
+		Source:  DeadlineSourceDefaultOperationTimeout,
+		Timeout: 5 * time.Second,
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, "context deadline exceeded (expired deadline: default operation timeout 5s)", err.Error())
+	require.Equal(t, "caller", Deadline{Source: DeadlineSourceCaller}.String())
+}
+
+func TestWithOperationDeadlineCause(t *testing.T) {
+	timeout := Operation(WithStatusCode(Ydb.StatusIds_TIMEOUT))
+	cancelled := Operation(WithStatusCode(Ydb.StatusIds_CANCELLED))
+	for _, tt := range []struct {
+		name        string
+		err         error
+		timeout     time.Duration
+		cancelAfter time.Duration
+		deadline    Deadline
+		ok          bool
+	}{
+		{
+			name:     "OperationTimeout",
+			err:      timeout,
+			timeout:  time.Second,
+			deadline: Deadline{Source: DeadlineSourceOperationTimeout, Timeout: time.Second},
+			ok:       true,
+		},
+		{
+			name:        "CancelAfter",
+			err:         cancelled,
+			cancelAfter: time.Minute,
+			deadline:    Deadline{Source: DeadlineSourceOperationCancelAfter, Timeout: time.Minute},
+			ok:          true,
+		},
+		{
+			name:        "TimeoutWithoutParam",
+			err:         timeout,
+			cancelAfter: time.Minute,
+		},
+		{
+			name:    "OtherStatus",
+			err:     Operation(WithStatusCode(Ydb.StatusIds_OVERLOADED)),
+			timeout: time.Second,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := WithOperationDeadlineCause(tt.err, tt.timeout, tt.cancelAfter)
+			require.ErrorIs(t, err, tt.err)
+			deadline, ok := DeadlineCause(err)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.deadline, deadline)
+		})
+	}
+}
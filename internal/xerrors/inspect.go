@@ -0,0 +1,85 @@
+package xerrors
+
+import (
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// OperationStatus returns status code of operation error from err
+func OperationStatus(err error) (Ydb.StatusIds_StatusCode, bool) {
+	var op *operationError
+	if err == nil || !errors.As(err, &op) {
+		return Ydb.StatusIds_STATUS_CODE_UNSPECIFIED, false
+	}
+
+	return op.code, true
+}
+
+// OperationIssues returns issues of operation error from err
+func OperationIssues(err error) []*Ydb_Issue.IssueMessage {
+	var op *operationError
+	if err == nil || !errors.As(err, &op) {
+		return nil
+	}
+
+	return op.issues
+}
+
+// TransportStatus returns grpc code of transport error or grpc status error from err
+func TransportStatus(err error) (grpcCodes.Code, bool) {
+	if err == nil {
+		return grpcCodes.OK, false
+	}
+	if t := (*transportError)(nil); errors.As(err, &t) {
+		return t.status.Code(), true
+	}
+	if s, has := grpcStatus.FromError(err); has {
+		return s.Code(), true
+	}
+
+	return grpcCodes.OK, false
+}
+
+// NodeAddress returns address of node from operation or transport error
+func NodeAddress(err error) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+	if op := (*operationError)(nil); errors.As(err, &op) && op.address != "" {
+		return op.address, true
+	}
+	if t := (*transportError)(nil); errors.As(err, &t) && t.address != "" {
+		return t.address, true
+	}
+
+	return "", false
+}
+
+// lastAttemptError is an error of retry loop which keeps error of the last failed attempt
+// out of chain of wrapped errors
+type lastAttemptError interface {
+	LastAttemptError() error
+}
+
+// NodeID returns id of node from operation or transport error or from error of the last failed attempt
+// of retry loop
+func NodeID(err error) (uint32, bool) {
+	if err == nil {
+		return 0, false
+	}
+	if op := (*operationError)(nil); errors.As(err, &op) && op.nodeID != 0 {
+		return op.nodeID, true
+	}
+	if t := (*transportError)(nil); errors.As(err, &t) && t.nodeID != 0 {
+		return t.nodeID, true
+	}
+	if e := lastAttemptError(nil); errors.As(err, &e) {
+		return NodeID(e.LastAttemptError())
+	}
+
+	return 0, false
+}
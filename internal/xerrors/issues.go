@@ -55,6 +55,25 @@ func (ii issues) String() string {
 	return b.String()
 }
 
+// ShiftIssuesRows shifts rows of positions of issues of operation error from err by delta in place.
+// Positions which would be out of query text (row less than 1) are kept as is
+func ShiftIssuesRows(err error, delta int) {
+	var e *operationError
+	if delta == 0 || !errors.As(err, &e) {
+		return
+	}
+	e.issues.shiftRows(delta)
+}
+
+func (ii issues) shiftRows(delta int) {
+	for _, m := range ii {
+		if p := m.GetPosition(); p != nil && int(p.GetRow())+delta > 0 {
+			p.Row = uint32(int(p.GetRow()) + delta)
+		}
+		issues(m.GetIssues()).shiftRows(delta)
+	}
+}
+
 // NewWithIssues returns error which contains child issues
 func NewWithIssues(text string, issues ...error) error {
 	err := &withIssuesError{
@@ -14,10 +14,12 @@ import (
 
 // operationError reports about operation fail.
 type operationError struct {
-	code    Ydb.StatusIds_StatusCode
-	issues  issues
-	address string
-	traceID string
+	code     Ydb.StatusIds_StatusCode
+	issues   issues
+	address  string
+	nodeID   uint32
+	location string
+	traceID  string
 }
 
 func (e *operationError) isYdbError() {}
@@ -119,6 +121,13 @@ func (e *operationError) Error() string {
 		b.WriteString(", address = ")
 		b.WriteString(e.address)
 	}
+	if e.nodeID > 0 {
+		fmt.Fprintf(b, ", nodeID = %d", e.nodeID)
+	}
+	if len(e.location) > 0 {
+		b.WriteString(", location = ")
+		b.WriteString(e.location)
+	}
 	if len(e.issues) > 0 {
 		b.WriteString(", issues = ")
 		b.WriteString(e.issues.String())
@@ -146,16 +155,57 @@ func IsOperationError(err error, codes ...Ydb.StatusIds_StatusCode) bool {
 	return false
 }
 
-const issueCodeTransactionLocksInvalidated = 2001
+const (
+	issueCodeTransactionLocksInvalidated = 2001
+	issueCodeTimeout                     = 2016
+)
 
+// IsOperationErrorTransactionLocksInvalidated reports whether err contains operation error with code ABORTED
+// and TLI issue. Unlike errors.As all operation errors of joined errors are checked, not the first one only
 func IsOperationErrorTransactionLocksInvalidated(err error) (isTLI bool) {
-	if IsOperationError(err, Ydb.StatusIds_ABORTED) {
-		IterateByIssues(err, func(_ string, code Ydb.StatusIds_StatusCode, severity uint32) {
+	var op *operationError
+	if err == nil || !errors.As(err, &op) {
+		return false
+	}
+	if op.code == Ydb.StatusIds_ABORTED {
+		iterate(op.Issues(), func(_ string, code Ydb.StatusIds_StatusCode, severity uint32) {
 			isTLI = isTLI || (code == issueCodeTransactionLocksInvalidated)
 		})
+		if isTLI {
+			return true
+		}
+	}
+
+	var errs []error
+	switch e := err.(type) { //nolint:errorlint
+	case *withIssuesError:
+		errs = e.issues
+	case interface{ Unwrap() []error }:
+		errs = e.Unwrap()
+	case interface{ Unwrap() error }:
+		errs = []error{e.Unwrap()}
+	}
+	for _, err := range errs {
+		if IsOperationErrorTransactionLocksInvalidated(err) {
+			return true
+		}
 	}
 
-	return isTLI
+	return false
+}
+
+// IsOperationErrorCompilationTimeout reports whether err contains operation error with code TIMEOUT
+// and timeout issue, which server reports if query compilation did not fit into operation timeout
+func IsOperationErrorCompilationTimeout(err error) (has bool) {
+	var op *operationError
+	if err == nil || !errors.As(err, &op) || op.code != Ydb.StatusIds_TIMEOUT {
+		return false
+	}
+	iterate(op.Issues(), func(_ string, code Ydb.StatusIds_StatusCode, _ uint32) {
+		has = has || (code == issueCodeTimeout)
+	})
+
+	return has
 }
 
 func (e *operationError) Type() Type {
@@ -192,12 +242,13 @@ func (e *operationError) BackoffType() backoff.Type {
 	}
 }
 
+// IsRetryObjectValid reports whether session of operation must be deleted. Busy session (SESSION_BUSY)
+// is valid, so it is kept for reuse and next attempt uses another session
 func (e *operationError) IsRetryObjectValid() bool {
 	switch e.code {
 	case
 		Ydb.StatusIds_BAD_SESSION,
-		Ydb.StatusIds_SESSION_EXPIRED,
-		Ydb.StatusIds_SESSION_BUSY:
+		Ydb.StatusIds_SESSION_EXPIRED:
 		return true
 	default:
 		return false
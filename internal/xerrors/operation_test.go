@@ -119,6 +119,50 @@ func TestIsOperationErrorTransactionLocksInvalidated(t *testing.T) {
 			),
 			isTLI: true,
 		},
+		{
+			err: WithStackTrace(fmt.Errorf("wrapped: %w", WithStackTrace(Operation(
+				WithStatusCode(Ydb.StatusIds_ABORTED),
+				WithIssues([]*Ydb_Issue.IssueMessage{{
+					IssueCode: issueCodeTransactionLocksInvalidated,
+				}}),
+			)))),
+			isTLI: true,
+		},
+		{
+			err: NewWithIssues("",
+				WithStackTrace(Operation(WithStatusCode(Ydb.StatusIds_NOT_FOUND))),
+				WithStackTrace(Operation(
+					WithStatusCode(Ydb.StatusIds_ABORTED),
+					WithIssues([]*Ydb_Issue.IssueMessage{{
+						IssueCode: issueCodeTransactionLocksInvalidated,
+					}}),
+				)),
+			),
+			isTLI: true,
+		},
+		{
+			err: Join(
+				fmt.Errorf("attempt No.1: %w", Operation(WithStatusCode(Ydb.StatusIds_ABORTED))),
+				Retryable(Operation(
+					WithStatusCode(Ydb.StatusIds_ABORTED),
+					WithIssues([]*Ydb_Issue.IssueMessage{{
+						IssueCode: issueCodeTransactionLocksInvalidated,
+					}}),
+				)),
+			),
+			isTLI: true,
+		},
+		{
+			err: Join(
+				fmt.Errorf("not ydb error"),
+				Operation(WithStatusCode(Ydb.StatusIds_ABORTED)),
+			),
+			isTLI: false,
+		},
+		{
+			err:   fmt.Errorf("not ydb error"),
+			isTLI: false,
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			require.Equal(t, tt.isTLI, IsOperationErrorTransactionLocksInvalidated(tt.err))
@@ -126,6 +170,62 @@ func TestIsOperationErrorTransactionLocksInvalidated(t *testing.T) {
 	}
 }
 
+func TestIsOperationErrorCompilationTimeout(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		err  error
+		exp  bool
+	}{
+		{
+			name: "CompilationTimeout",
+			err: Operation(
+				WithStatusCode(Ydb.StatusIds_TIMEOUT),
+				WithIssues([]*Ydb_Issue.IssueMessage{{
+					Message:   "Query compilation timed out.",
+					IssueCode: issueCodeTimeout,
+				}}),
+			),
+			exp: true,
+		},
+		{
+			name: "NestedIssue",
+			err: WithStackTrace(Operation(
+				WithStatusCode(Ydb.StatusIds_TIMEOUT),
+				WithIssues([]*Ydb_Issue.IssueMessage{{
+					Message: "Execution",
+					Issues:  []*Ydb_Issue.IssueMessage{{IssueCode: issueCodeTimeout}},
+				}}),
+			)),
+			exp: true,
+		},
+		{
+			name: "MessageWithoutIssueCode",
+			err: Operation(
+				WithStatusCode(Ydb.StatusIds_TIMEOUT),
+				WithIssues([]*Ydb_Issue.IssueMessage{{Message: "Query compilation timed out."}}),
+			),
+			exp: false,
+		},
+		{
+			name: "OtherStatus",
+			err: Operation(
+				WithStatusCode(Ydb.StatusIds_GENERIC_ERROR),
+				WithIssues([]*Ydb_Issue.IssueMessage{{IssueCode: issueCodeTimeout}}),
+			),
+			exp: false,
+		},
+		{
+			name: "NotOperationError",
+			err:  fmt.Errorf("compilation timeout"),
+			exp:  false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.exp, IsOperationErrorCompilationTimeout(tt.err))
+		})
+	}
+}
+
 func Test_operationError_Error(t *testing.T) {
 	for _, tt := range []struct {
 		err  error
@@ -182,3 +282,39 @@ func Test_operationError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestShiftIssuesRows(t *testing.T) {
+	err := WithStackTrace(Operation(WithStatusCode(Ydb.StatusIds_GENERIC_ERROR), WithIssues([]*Ydb_Issue.IssueMessage{
+		{
+			Message: "issue one",
+			Position: &Ydb_Issue.IssueMessage_Position{
+				Row:    5,
+				Column: 3,
+			},
+			Issues: []*Ydb_Issue.IssueMessage{
+				{
+					Message: "issue two",
+					Position: &Ydb_Issue.IssueMessage_Position{
+						Row:    6,
+						Column: 4,
+					},
+				},
+				{
+					Message: "issue three",
+				},
+			},
+		},
+		{
+			Message: "issue in prefix",
+			Position: &Ydb_Issue.IssueMessage_Position{
+				Row:    2,
+				Column: 1,
+			},
+		},
+	})))
+	ShiftIssuesRows(err, -3)
+	require.Contains(t, err.Error(),
+		"issues = [{2:3 => 'issue one' [{3:4 => 'issue two'},{'issue three'}]},{2:1 => 'issue in prefix'}]",
+	)
+	ShiftIssuesRows(fmt.Errorf("not operation error"), -3)
+}
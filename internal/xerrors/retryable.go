@@ -12,6 +12,7 @@ type retryableError struct {
 	backoffType        backoff.Type
 	isRetryObjectValid bool
 	code               int32
+	conditionally      bool
 }
 
 func (e *retryableError) Code() int32 {
@@ -23,6 +24,10 @@ func (e *retryableError) Name() string {
 }
 
 func (e *retryableError) Type() Type {
+	if e.conditionally {
+		return TypeConditionallyRetryable
+	}
+
 	return TypeRetryable
 }
 
@@ -56,6 +61,13 @@ func WithName(name string) RetryableErrorOption {
 	}
 }
 
+// Conditionally makes error retryable for idempotent operations only
+func Conditionally() RetryableErrorOption {
+	return func(e *retryableError) {
+		e.conditionally = true
+	}
+}
+
 func InvalidObject() RetryableErrorOption {
 	return func(e *retryableError) {
 		e.isRetryObjectValid = true
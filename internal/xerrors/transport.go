@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"net"
+	"syscall"
 
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
@@ -13,10 +16,12 @@ import (
 )
 
 type transportError struct {
-	status  *grpcStatus.Status
-	err     error
-	address string
-	traceID string
+	status   *grpcStatus.Status
+	err      error
+	address  string
+	nodeID   uint32
+	location string
+	traceID  string
 }
 
 func (e *transportError) GRPCStatus() *grpcStatus.Status {
@@ -47,6 +52,36 @@ func WithAddress(address string) addressOption {
 	return addressOption(address)
 }
 
+type nodeIDOption uint32
+
+func (nodeID nodeIDOption) applyToTransportError(te *transportError) {
+	te.nodeID = uint32(nodeID)
+}
+
+func (nodeID nodeIDOption) applyToOperationError(oe *operationError) {
+	oe.nodeID = uint32(nodeID)
+}
+
+// WithNodeID is an option for construct transport or operation error with id of node
+func WithNodeID(nodeID uint32) nodeIDOption {
+	return nodeIDOption(nodeID)
+}
+
+type locationOption string
+
+func (location locationOption) applyToTransportError(te *transportError) {
+	te.location = string(location)
+}
+
+func (location locationOption) applyToOperationError(oe *operationError) {
+	oe.location = string(location)
+}
+
+// WithLocation is an option for construct transport or operation error with location of node
+func WithLocation(location string) locationOption {
+	return locationOption(location)
+}
+
 func (e *transportError) Error() string {
 	var b bytes.Buffer
 	b.WriteString(e.Name())
@@ -54,6 +89,12 @@ func (e *transportError) Error() string {
 	if len(e.address) > 0 {
 		b.WriteString(fmt.Sprintf(", address: %q", e.address))
 	}
+	if e.nodeID > 0 {
+		b.WriteString(fmt.Sprintf(", nodeID: %d", e.nodeID))
+	}
+	if len(e.location) > 0 {
+		b.WriteString(fmt.Sprintf(", location: %q", e.location))
+	}
 	if len(e.traceID) > 0 {
 		b.WriteString(fmt.Sprintf(", traceID: %q", e.traceID))
 	}
@@ -78,6 +119,12 @@ func (e *transportError) Type() Type {
 		grpcCodes.DeadlineExceeded,
 		grpcCodes.Unavailable:
 		return TypeConditionallyRetryable
+	case grpcCodes.Unknown:
+		if isStreamTermination(e.err) {
+			return TypeConditionallyRetryable
+		}
+
+		return TypeUndefined
 	default:
 		return TypeUndefined
 	}
@@ -93,6 +140,12 @@ func (e *transportError) BackoffType() backoff.Type {
 		return backoff.TypeFast
 	case grpcCodes.ResourceExhausted:
 		return backoff.TypeSlow
+	case grpcCodes.Unknown:
+		if isStreamTermination(e.err) {
+			return backoff.TypeFast
+		}
+
+		return backoff.TypeNoBackoff
 	default:
 		return backoff.TypeNoBackoff
 	}
@@ -109,6 +162,21 @@ func (e *transportError) IsRetryObjectValid() bool {
 	}
 }
 
+// isStreamTermination reports whether err is a premature termination of stream or connection
+// (unexpected EOF, connection reset, broken pipe) which is not wrapped into grpc status by grpc-go.
+// Regular io.EOF is the end of stream and is not a termination
+func isStreamTermination(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, net.ErrClosed)
+}
+
 // IsTransportError reports whether err is transportError with given grpc codes
 func IsTransportError(err error, codes ...grpcCodes.Code) bool {
 	if err == nil {
@@ -11,6 +11,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/helpers"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
@@ -156,7 +157,7 @@ func (c *conn) PrepareContext(ctx context.Context, query string) (_ driver.Stmt,
 	}
 	onDone := trace.DatabaseSQLOnConnPrepare(c.trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*conn).PrepareContext"),
-		query,
+		c.connector.redactQueryText(query), fingerprint.QueryIf(c.trace.OnConnPrepare != nil, query),
 	)
 	defer func() {
 		onDone(finalErr)
@@ -190,22 +191,24 @@ func (c *conn) execContext(ctx context.Context, query string, args []driver.Name
 		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
 	}
 
-	if c.currentTx != nil {
-		return c.currentTx.ExecContext(ctx, query, args)
-	}
-
 	var (
 		m      = queryModeFromContext(ctx, c.defaultQueryMode)
 		onDone = trace.DatabaseSQLOnConnExec(
 			c.trace, &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*conn).execContext"),
-			query, m.String(), xcontext.IsIdempotent(ctx), c.sinceLastUsage(),
+			c.connector.redactQueryText(query), m.String(), xcontext.IsIdempotent(ctx), c.sinceLastUsage(),
+			fingerprint.QueryIf(c.trace.OnConnExec != nil, query),
 		)
 	)
 	defer func() {
 		onDone(finalErr)
 	}()
 
+	args, outs, err := splitOutArgs(args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
 	switch m {
 	case DataQueryMode:
 		normalizedQuery, parameters, err := c.normalize(query, args...)
@@ -222,6 +225,9 @@ func (c *conn) execContext(ctx context.Context, query string, args []driver.Name
 		defer func() {
 			_ = res.Close()
 		}()
+		if err = scanOutArgs(ctx, res, outs); err != nil {
+			return nil, badconn.Map(xerrors.WithStackTrace(err))
+		}
 		if err = res.NextResultSetErr(ctx); !xerrors.Is(err, nil, io.EOF) {
 			return nil, badconn.Map(xerrors.WithStackTrace(err))
 		}
@@ -237,7 +243,7 @@ func (c *conn) execContext(ctx context.Context, query string, args []driver.Name
 		}
 		err = c.session.ExecuteSchemeQuery(ctx, normalizedQuery)
 		if err != nil {
-			return nil, badconn.Map(xerrors.WithStackTrace(err))
+			return nil, badconn.Map(xerrors.WithStackTrace(originIssuesPositions(err, query, normalizedQuery)))
 		}
 
 		return resultNoRows{}, nil
@@ -249,16 +255,19 @@ func (c *conn) execContext(ctx context.Context, query string, args []driver.Name
 		}
 		res, err = c.connector.parent.Scripting().StreamExecute(ctx, normalizedQuery, &parameters)
 		if err != nil {
-			return nil, badconn.Map(xerrors.WithStackTrace(err))
+			return nil, badconn.Map(xerrors.WithStackTrace(originIssuesPositions(err, query, normalizedQuery)))
 		}
 		defer func() {
 			_ = res.Close()
 		}()
+		if err = scanOutArgs(ctx, res, outs); err != nil {
+			return nil, badconn.Map(xerrors.WithStackTrace(originIssuesPositions(err, query, normalizedQuery)))
+		}
 		if err = res.NextResultSetErr(ctx); !xerrors.Is(err, nil, io.EOF) {
-			return nil, badconn.Map(xerrors.WithStackTrace(err))
+			return nil, badconn.Map(xerrors.WithStackTrace(originIssuesPositions(err, query, normalizedQuery)))
 		}
 		if err = res.Err(); err != nil {
-			return nil, badconn.Map(xerrors.WithStackTrace(err))
+			return nil, badconn.Map(xerrors.WithStackTrace(originIssuesPositions(err, query, normalizedQuery)))
 		}
 
 		return resultNoRows{}, nil
@@ -267,6 +276,14 @@ func (c *conn) execContext(ctx context.Context, query string, args []driver.Name
 	}
 }
 
+// originIssuesPositions maps rows of issues positions of err from normalized query to origin query.
+// Query bindings (such as TablePathPrefix) only prepend lines to origin query
+func originIssuesPositions(err error, query, normalizedQuery string) error {
+	xerrors.ShiftIssuesRows(err, strings.Count(query, "\n")-strings.Count(normalizedQuery, "\n"))
+
+	return err
+}
+
 func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (_ driver.Result, _ error) {
 	if !c.isReady() {
 		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
@@ -300,16 +317,13 @@ func (c *conn) queryContext(ctx context.Context, query string, args []driver.Nam
 		return nil, badconn.Map(xerrors.WithStackTrace(errNotReadyConn))
 	}
 
-	if c.currentTx != nil {
-		return c.currentTx.QueryContext(ctx, query, args)
-	}
-
 	var (
 		m      = queryModeFromContext(ctx, c.defaultQueryMode)
 		onDone = trace.DatabaseSQLOnConnQuery(
 			c.trace, &ctx,
 			stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*conn).queryContext"),
-			query, m.String(), xcontext.IsIdempotent(ctx), c.sinceLastUsage(),
+			c.connector.redactQueryText(query), m.String(), xcontext.IsIdempotent(ctx), c.sinceLastUsage(),
+			fingerprint.QueryIf(c.trace.OnConnQuery != nil, query),
 		)
 	)
 	defer func() {
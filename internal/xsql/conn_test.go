@@ -2,6 +2,18 @@ package xsql
 
 import (
 	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/bind"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 var (
@@ -49,3 +61,62 @@ var (
 		GetIndexColumns(ctx context.Context, tableName string, indexName string) (columns []string, err error)
 	} = (*conn)(nil)
 )
+
+type schemeQuerySessionMock struct {
+	table.ClosableSession
+
+	queries []string
+	err     error
+}
+
+func (s *schemeQuerySessionMock) Status() table.SessionStatus {
+	return table.SessionReady
+}
+
+func (s *schemeQuerySessionMock) ExecuteSchemeQuery(
+	ctx context.Context, query string, opts ...options.ExecuteSchemeQueryOption,
+) error {
+	s.queries = append(s.queries, query)
+
+	return s.err
+}
+
+func TestConnExecSchemeQuery(t *testing.T) {
+	session := &schemeQuerySessionMock{
+		err: xerrors.WithStackTrace(xerrors.Operation(
+			xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR),
+			xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+				Message: "Unknown column type",
+				Position: &Ydb_Issue.IssueMessage_Position{
+					Row:    5,
+					Column: 7,
+				},
+			}}),
+		)),
+	}
+	c := newConn(context.Background(), &Connector{
+		Bindings: bind.Bindings{bind.TablePathPrefix("/local/path")},
+		conns:    make(map[*conn]struct{}),
+	}, session, withTrace(&trace.DatabaseSQL{}))
+	ctx := WithQueryMode(context.Background(), SchemeQueryMode)
+
+	query := "CREATE TABLE test (\n\tid Uint64,\n\tval Unknown,\n\tPRIMARY KEY (id)\n)"
+	_, err := c.ExecContext(ctx, query, nil)
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR))
+	require.NotErrorIs(t, err, driver.ErrBadConn)
+	// issue position is mapped from query with bindings to origin query
+	require.Contains(t, err.Error(), "{2:7 => 'Unknown column type'}")
+	require.Len(t, session.queries, 1)
+	require.Contains(t, session.queries[0], "PRAGMA TablePathPrefix(\"/local/path\");")
+
+	c.currentTx = &tx{conn: c, ctx: ctx}
+	for _, mode := range []QueryMode{SchemeQueryMode, ScriptingQueryMode} {
+		_, err = c.ExecContext(WithQueryMode(context.Background(), mode), query, nil)
+		require.ErrorIs(t, err, ErrQueryModeInTx)
+		require.NotErrorIs(t, err, driver.ErrBadConn)
+		require.Nil(t, xerrors.RetryableError(err))
+		_, err = c.QueryContext(WithQueryMode(context.Background(), mode), query, nil)
+		require.ErrorIs(t, err, ErrQueryModeInTx)
+	}
+	require.Len(t, session.queries, 1)
+}
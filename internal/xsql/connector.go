@@ -198,6 +198,19 @@ func (m fakeTxConnectorOption) Apply(c *Connector) error {
 	return nil
 }
 
+type queryTextRedactorConnectorOption func(query string) string
+
+func (redactor queryTextRedactorConnectorOption) Apply(c *Connector) error {
+	c.queryTextRedactor = redactor
+
+	return nil
+}
+
+// WithQueryTextRedactor sets redactor of query text in database/sql traces
+func WithQueryTextRedactor(redactor func(query string) string) ConnectorOption {
+	return queryTextRedactorConnectorOption(redactor)
+}
+
 // WithFakeTx returns a copy of context with given QueryMode
 func WithFakeTx(m QueryMode) ConnectorOption {
 	return fakeTxConnectorOption(m)
@@ -266,6 +279,8 @@ type Connector struct {
 	trace       *trace.DatabaseSQL
 	traceRetry  *trace.Retry
 	retryBudget budget.Budget
+
+	queryTextRedactor func(query string) string
 }
 
 var (
@@ -273,6 +288,15 @@ var (
 	_ io.Closer        = &Connector{}
 )
 
+// redactQueryText returns query text for traces
+func (c *Connector) redactQueryText(query string) string {
+	if c.queryTextRedactor == nil {
+		return query
+	}
+
+	return c.queryTextRedactor(query)
+}
+
 func (c *Connector) idleCloser() (idleStopper func()) {
 	var ctx context.Context
 	ctx, idleStopper = xcontext.WithCancel(context.Background())
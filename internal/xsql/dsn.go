@@ -14,7 +14,21 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 )
 
-const tablePathPrefixTransformer = "table_path_prefix"
+// names of query params of connection string which are recognized by database/sql driver
+const (
+	DSNParamDatabase       = dsn.DatabaseParam
+	DSNParamToken          = "token"
+	DSNParamBalancer       = "go_balancer"
+	DSNParamBalancerAlias  = "balancer"
+	DSNParamQueryMode      = "go_query_mode"
+	DSNParamQueryModeAlias = "query_mode"
+	DSNParamFakeTx         = "go_fake_tx"
+	DSNParamQueryBind      = "go_query_bind"
+)
+
+// TablePathPrefixBinder is a name of query binder of DSNParamQueryBind param with table path prefix
+// in parentheses, such as table_path_prefix(/local/path)
+const TablePathPrefixBinder = "table_path_prefix"
 
 func Parse(dataSourceName string) (opts []config.Option, connectorOpts []ConnectorOption, _ error) {
 	info, err := dsn.Parse(dataSourceName)
@@ -22,28 +36,28 @@ func Parse(dataSourceName string) (opts []config.Option, connectorOpts []Connect
 		return nil, nil, xerrors.WithStackTrace(err)
 	}
 	opts = append(opts, info.Options...)
-	if token := info.Params.Get("token"); token != "" {
+	if token := info.Params.Get(DSNParamToken); token != "" {
 		opts = append(opts, config.WithCredentials(credentials.NewAccessTokenCredentials(token)))
 	}
-	if balancer := info.Params.Get("go_balancer"); balancer != "" {
+	if balancer := info.Params.Get(DSNParamBalancer); balancer != "" {
 		opts = append(opts, config.WithBalancer(balancers.FromConfig(balancer)))
-	} else if balancer := info.Params.Get("balancer"); balancer != "" {
+	} else if balancer := info.Params.Get(DSNParamBalancerAlias); balancer != "" {
 		opts = append(opts, config.WithBalancer(balancers.FromConfig(balancer)))
 	}
-	if queryMode := info.Params.Get("go_query_mode"); queryMode != "" {
+	if queryMode := info.Params.Get(DSNParamQueryMode); queryMode != "" {
 		mode := QueryModeFromString(queryMode)
 		if mode == UnknownQueryMode {
 			return nil, nil, xerrors.WithStackTrace(fmt.Errorf("unknown query mode: %s", queryMode))
 		}
 		connectorOpts = append(connectorOpts, WithDefaultQueryMode(mode))
-	} else if queryMode := info.Params.Get("query_mode"); queryMode != "" {
+	} else if queryMode := info.Params.Get(DSNParamQueryModeAlias); queryMode != "" {
 		mode := QueryModeFromString(queryMode)
 		if mode == UnknownQueryMode {
 			return nil, nil, xerrors.WithStackTrace(fmt.Errorf("unknown query mode: %s", queryMode))
 		}
 		connectorOpts = append(connectorOpts, WithDefaultQueryMode(mode))
 	}
-	if fakeTx := info.Params.Get("go_fake_tx"); fakeTx != "" {
+	if fakeTx := info.Params.Get(DSNParamFakeTx); fakeTx != "" {
 		for _, queryMode := range strings.Split(fakeTx, ",") {
 			mode := QueryModeFromString(queryMode)
 			if mode == UnknownQueryMode {
@@ -52,9 +66,9 @@ func Parse(dataSourceName string) (opts []config.Option, connectorOpts []Connect
 			connectorOpts = append(connectorOpts, WithFakeTx(mode))
 		}
 	}
-	if info.Params.Has("go_query_bind") {
+	if info.Params.Has(DSNParamQueryBind) {
 		var binders []ConnectorOption
-		queryTransformers := strings.Split(info.Params.Get("go_query_bind"), ",")
+		queryTransformers := strings.Split(info.Params.Get(DSNParamQueryBind), ",")
 		for _, transformer := range queryTransformers {
 			switch transformer {
 			case "declare":
@@ -64,7 +78,7 @@ func Parse(dataSourceName string) (opts []config.Option, connectorOpts []Connect
 			case "numeric":
 				binders = append(binders, WithQueryBind(bind.NumericArgs{}))
 			default:
-				if strings.HasPrefix(transformer, tablePathPrefixTransformer) {
+				if strings.HasPrefix(transformer, TablePathPrefixBinder) {
 					prefix, err := extractTablePathPrefixFromBinderName(transformer)
 					if err != nil {
 						return nil, nil, xerrors.WithStackTrace(err)
@@ -84,8 +98,8 @@ func Parse(dataSourceName string) (opts []config.Option, connectorOpts []Connect
 }
 
 var (
-	tablePathPrefixRe       = regexp.MustCompile(tablePathPrefixTransformer + "\\((.*)\\)")
-	errWrongTablePathPrefix = errors.New("wrong '" + tablePathPrefixTransformer + "' query transformer")
+	tablePathPrefixRe       = regexp.MustCompile(TablePathPrefixBinder + "\\((.*)\\)")
+	errWrongTablePathPrefix = errors.New("wrong '" + TablePathPrefixBinder + "' query transformer")
 )
 
 func extractTablePathPrefixFromBinderName(binderName string) (string, error) {
@@ -12,6 +12,14 @@ var (
 	errDeprecated      = driver.ErrSkip
 	errConnClosedEarly = xerrors.Retryable(errors.New("conn closed early"), xerrors.InvalidObject())
 	errNotReadyConn    = xerrors.Retryable(errors.New("conn not ready"), xerrors.InvalidObject())
+
+	ErrQueryModeInTx = xerrors.Wrap(errors.New("query mode is not supported inside transaction"))
+
+	ErrRowsUsedAfterTxFinished = xerrors.Wrap(errors.New("rows used after transaction finished"))
+
+	errNoRowsForOutArgs    = xerrors.Wrap(errors.New("query returned no rows for sql.Out arguments"))
+	errUnknownOutArgColumn = xerrors.Wrap(errors.New("query result has no column for sql.Out argument"))
+	errInvalidOutArgDest   = xerrors.Wrap(errors.New("destination of sql.Out argument is not a non-nil pointer"))
 )
 
 type ConnAlreadyHaveTxError struct {
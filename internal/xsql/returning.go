@@ -0,0 +1,107 @@
+package xsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
+)
+
+// splitOutArgs separates sql.Out arguments from query arguments.
+// Value of sql.Out with In flag is passed to query as regular argument
+func splitOutArgs(args []driver.NamedValue) (in, outs []driver.NamedValue, _ error) {
+	for i := range args {
+		out, ok := args[i].Value.(sql.Out)
+		if !ok {
+			in = append(in, args[i])
+
+			continue
+		}
+		if rv := reflect.ValueOf(out.Dest); rv.Kind() != reflect.Ptr || rv.IsNil() {
+			return nil, nil, xerrors.WithStackTrace(
+				fmt.Errorf("%w: argument No.%d has destination %T", errInvalidOutArgDest, args[i].Ordinal, out.Dest),
+			)
+		}
+		outs = append(outs, args[i])
+		if out.In {
+			arg := args[i]
+			arg.Value = reflect.Indirect(reflect.ValueOf(out.Dest)).Interface()
+			in = append(in, arg)
+		}
+	}
+
+	return in, outs, nil
+}
+
+// scanOutArgs fills destinations of sql.Out arguments with values of first row of first result set
+// (such as result of INSERT ... RETURNING). Named arguments receive values of columns with same name,
+// ordinal arguments receive values of columns in order of arguments
+func scanOutArgs(ctx context.Context, res result.BaseResult, outs []driver.NamedValue) error {
+	if len(outs) == 0 {
+		return nil
+	}
+	if err := res.NextResultSetErr(ctx); err != nil {
+		if xerrors.Is(err, io.EOF) {
+			return xerrors.WithStackTrace(errNoRowsForOutArgs)
+		}
+
+		return xerrors.WithStackTrace(err)
+	}
+	if !res.NextRow() {
+		if err := res.Err(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		return xerrors.WithStackTrace(errNoRowsForOutArgs)
+	}
+
+	var (
+		columns []string
+		values  []value.Value
+	)
+	res.CurrentResultSet().Columns(func(c options.Column) {
+		columns = append(columns, c.Name)
+	})
+	values = make([]value.Value, len(columns))
+	dst := make([]indexed.RequiredOrOptional, len(columns))
+	for i := range values {
+		dst[i] = &values[i]
+	}
+	if err := res.Scan(dst...); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	for i := range outs {
+		idx := i
+		if name := strings.TrimPrefix(outs[i].Name, "$"); name != "" {
+			idx = indexOfColumn(columns, name)
+		}
+		if idx < 0 || idx >= len(values) {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: '%s'", errUnknownOutArgColumn, outs[i].Name))
+		}
+		if err := value.CastTo(values[idx], outs[i].Value.(sql.Out).Dest); err != nil {
+			return xerrors.WithStackTrace(fmt.Errorf("scan column '%s' into sql.Out failed: %w", columns[idx], err))
+		}
+	}
+
+	return nil
+}
+
+func indexOfColumn(columns []string, name string) int {
+	for i := range columns {
+		if columns[i] == name {
+			return i
+		}
+	}
+
+	return -1
+}
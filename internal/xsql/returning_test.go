@@ -0,0 +1,161 @@
+package xsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type returningSessionMock struct {
+	table.ClosableSession
+
+	sets   []*Ydb.ResultSet
+	params []string
+}
+
+func (s *returningSessionMock) Status() table.SessionStatus {
+	return table.SessionReady
+}
+
+func (s *returningSessionMock) Execute(
+	ctx context.Context, tx *table.TransactionControl, query string, parameters *params.Parameters,
+	opts ...options.ExecuteDataQueryOption,
+) (table.Transaction, result.Result, error) {
+	s.params = s.params[:0]
+	parameters.Each(func(name string, _ value.Value) {
+		s.params = append(s.params, name)
+	})
+
+	return nil, scanner.NewUnary(s.sets, nil), nil
+}
+
+func returningResultSet(rows ...*Ydb.Value) *Ydb.ResultSet {
+	return &Ydb.ResultSet{
+		Columns: []*Ydb.Column{
+			{
+				Name: "id",
+				Type: &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+					Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+				}}},
+			},
+			{
+				Name: "title",
+				Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+			},
+		},
+		Rows: rows,
+	}
+}
+
+func returningRow(id uint64, title string) *Ydb.Value {
+	return &Ydb.Value{Items: []*Ydb.Value{
+		{Value: &Ydb.Value_Uint64Value{Uint64Value: id}},
+		{Value: &Ydb.Value_TextValue{TextValue: title}},
+	}}
+}
+
+func TestConnExecOutArgs(t *testing.T) {
+	const query = "INSERT INTO series (title) VALUES ($title) RETURNING id, title"
+	newTestConn := func(sets ...*Ydb.ResultSet) (*conn, *returningSessionMock) {
+		session := &returningSessionMock{sets: sets}
+
+		return newConn(context.Background(), &Connector{
+			conns: make(map[*conn]struct{}),
+		}, session, withTrace(&trace.DatabaseSQL{}), withDefaultQueryMode(DataQueryMode)), session
+	}
+
+	t.Run("Named", func(t *testing.T) {
+		c, session := newTestConn(returningResultSet(returningRow(42, "test")))
+		var (
+			id    uint64
+			title string
+		)
+		_, err := c.ExecContext(context.Background(), query, []driver.NamedValue{
+			{Name: "title", Ordinal: 1, Value: "test"},
+			{Name: "$id", Ordinal: 2, Value: sql.Out{Dest: &id}},
+			{Name: "title", Ordinal: 3, Value: sql.Out{Dest: &title}},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 42, id)
+		require.Equal(t, "test", title)
+		require.Equal(t, []string{"$title"}, session.params)
+	})
+	t.Run("Ordinal", func(t *testing.T) {
+		c, _ := newTestConn(returningResultSet(returningRow(42, "test"), returningRow(43, "other")))
+		var (
+			id    *uint64
+			title sql.NullString
+		)
+		_, err := c.ExecContext(context.Background(), query, []driver.NamedValue{
+			{Name: "title", Ordinal: 1, Value: "test"},
+			{Ordinal: 2, Value: sql.Out{Dest: &id}},
+			{Ordinal: 3, Value: sql.Out{Dest: &title}},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, id)
+		require.EqualValues(t, 42, *id)
+		require.Equal(t, sql.NullString{String: "test", Valid: true}, title)
+	})
+	t.Run("InOut", func(t *testing.T) {
+		c, session := newTestConn(returningResultSet(returningRow(42, "renamed")))
+		title := "test"
+		_, err := c.ExecContext(context.Background(), query, []driver.NamedValue{
+			{Name: "title", Ordinal: 1, Value: sql.Out{Dest: &title, In: true}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "renamed", title)
+		require.Equal(t, []string{"$title"}, session.params)
+	})
+	t.Run("NoRows", func(t *testing.T) {
+		c, _ := newTestConn(returningResultSet())
+		var id uint64
+		_, err := c.ExecContext(context.Background(), query, []driver.NamedValue{
+			{Name: "id", Ordinal: 1, Value: sql.Out{Dest: &id}},
+		})
+		require.ErrorIs(t, err, errNoRowsForOutArgs)
+		require.NotErrorIs(t, err, driver.ErrBadConn)
+	})
+	t.Run("UnknownColumn", func(t *testing.T) {
+		c, _ := newTestConn(returningResultSet(returningRow(42, "test")))
+		var id uint64
+		_, err := c.ExecContext(context.Background(), query, []driver.NamedValue{
+			{Name: "series_id", Ordinal: 1, Value: sql.Out{Dest: &id}},
+		})
+		require.ErrorIs(t, err, errUnknownOutArgColumn)
+	})
+	t.Run("InvalidDest", func(t *testing.T) {
+		var id *uint64
+		for _, out := range []sql.Out{
+			{Dest: nil, In: true},
+			{Dest: nil},
+			{Dest: id, In: true},
+			{Dest: uint64(42)},
+		} {
+			c, _ := newTestConn(returningResultSet(returningRow(42, "test")))
+			_, err := c.ExecContext(context.Background(), query, []driver.NamedValue{
+				{Name: "id", Ordinal: 1, Value: out},
+			})
+			require.ErrorIs(t, err, errInvalidOutArgDest)
+			require.NotErrorIs(t, err, driver.ErrBadConn)
+		}
+	})
+	t.Run("WithoutOutArgs", func(t *testing.T) {
+		c, _ := newTestConn(returningResultSet(returningRow(42, "test")))
+		_, err := c.ExecContext(context.Background(), query, []driver.NamedValue{
+			{Name: "title", Ordinal: 1, Value: "test"},
+		})
+		require.NoError(t, err)
+	})
+}
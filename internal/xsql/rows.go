@@ -7,6 +7,7 @@ import (
 	"io"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scanner"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -35,6 +36,11 @@ type rows struct {
 	// nextSet once need for get first result set as default.
 	// Iterate over many result sets must be with rows.NextResultSet()
 	nextSet sync.Once
+
+	// txRows is not nil for rows of query inside transaction
+	txRows *txRows
+	// txFinished is true if rows are closed by commit or rollback of transaction
+	txFinished atomic.Bool
 }
 
 func (r *rows) LastInsertId() (int64, error) { return 0, ErrUnsupported }
@@ -93,6 +99,9 @@ func (r *rows) ColumnTypeNullable(index int) (nullable, ok bool) {
 }
 
 func (r *rows) NextResultSet() (finalErr error) {
+	if r.txFinished.Load() {
+		return errRowsUsedAfterTxFinished()
+	}
 	r.nextSet.Do(func() {})
 	err := r.result.NextResultSetErr(context.Background())
 	if err != nil {
@@ -103,10 +112,17 @@ func (r *rows) NextResultSet() (finalErr error) {
 }
 
 func (r *rows) HasNextResultSet() bool {
+	if r.txFinished.Load() {
+		return false
+	}
+
 	return r.result.HasNextResultSet()
 }
 
 func (r *rows) Next(dst []driver.Value) error {
+	if r.txFinished.Load() {
+		return errRowsUsedAfterTxFinished()
+	}
 	var err error
 	r.nextSet.Do(func() {
 		err = r.result.NextResultSetErr(context.Background())
@@ -138,9 +154,55 @@ func (r *rows) Next(dst []driver.Value) error {
 }
 
 func (r *rows) Close() error {
+	if r.txRows != nil {
+		r.txRows.remove(r)
+	}
+	if r.txFinished.Load() {
+		return nil
+	}
+
 	return r.result.Close()
 }
 
+// errRowsUsedAfterTxFinished returns ErrRowsUsedAfterTxFinished joined with context.Canceled.
+// database/sql cancels rows of transaction at commit and rollback and closes them asynchronously,
+// so Rows.Err() of such rows reports context.Canceled regardless of which close happens first
+func errRowsUsedAfterTxFinished() error {
+	return xerrors.WithStackTrace(xerrors.Join(ErrRowsUsedAfterTxFinished, context.Canceled))
+}
+
+// txRows is a set of not closed rows of queries inside transaction.
+// Rows of transaction are closed at commit or rollback because result is not related to transaction
+// (and maybe to session) anymore. Access to txRows is serialized by database/sql as access to conn
+type txRows struct {
+	rows map[*rows]struct{}
+}
+
+func (s *txRows) add(r driver.Rows) {
+	rr, ok := r.(*rows)
+	if !ok {
+		return
+	}
+	if s.rows == nil {
+		s.rows = make(map[*rows]struct{})
+	}
+	rr.txRows = s
+	s.rows[rr] = struct{}{}
+}
+
+func (s *txRows) remove(r *rows) {
+	delete(s.rows, r)
+}
+
+// finish closes all rows of transaction. Next and NextResultSet of closed rows return ErrRowsUsedAfterTxFinished
+func (s *txRows) finish() {
+	for r := range s.rows {
+		r.txFinished.Store(true)
+		_ = r.result.Close()
+	}
+	s.rows = nil
+}
+
 type single struct {
 	values  []sql.NamedArg
 	readAll bool
@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
@@ -32,7 +33,8 @@ var (
 func (stmt *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (_ driver.Rows, finalErr error) {
 	onDone := trace.DatabaseSQLOnStmtQuery(stmt.trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*stmt).QueryContext"),
-		stmt.ctx, stmt.query,
+		stmt.ctx, stmt.conn.connector.redactQueryText(stmt.query),
+		fingerprint.QueryIf(stmt.trace.OnStmtQuery != nil, stmt.query),
 	)
 	defer func() {
 		onDone(finalErr)
@@ -51,7 +53,8 @@ func (stmt *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (_
 func (stmt *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (_ driver.Result, finalErr error) {
 	onDone := trace.DatabaseSQLOnStmtExec(stmt.trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*stmt).ExecContext"),
-		stmt.ctx, stmt.query,
+		stmt.ctx, stmt.conn.connector.redactQueryText(stmt.query),
+		fingerprint.QueryIf(stmt.trace.OnStmtExec != nil, stmt.query),
 	)
 	defer func() {
 		onDone(finalErr)
@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
@@ -17,6 +18,7 @@ type tx struct {
 	conn *conn
 	ctx  context.Context //nolint:containedctx
 	tx   table.Transaction
+	rows txRows
 }
 
 var (
@@ -53,6 +55,12 @@ func (c *conn) beginTx(ctx context.Context, txOptions driver.TxOptions) (current
 	return c.currentTx, nil
 }
 
+// queryModeInTxError returns error about query mode which can not be used inside transaction
+// because scheme queries and scripts are executed by separate services outside of session transaction
+func queryModeInTxError(m QueryMode) error {
+	return fmt.Errorf("%w: %s, execute query outside of transaction", ErrQueryModeInTx, m.String())
+}
+
 func (tx *tx) ID() string {
 	return tx.tx.ID()
 }
@@ -87,6 +95,7 @@ func (tx *tx) Commit() (finalErr error) {
 		return badconn.Map(xerrors.WithStackTrace(err))
 	}
 	defer func() {
+		tx.rows.finish()
 		tx.conn.currentTx = nil
 	}()
 	_, err := tx.tx.CommitTx(tx.ctx)
@@ -112,6 +121,7 @@ func (tx *tx) Rollback() (finalErr error) {
 		return badconn.Map(xerrors.WithStackTrace(err))
 	}
 	defer func() {
+		tx.rows.finish()
 		tx.conn.currentTx = nil
 	}()
 	err := tx.tx.Rollback(tx.ctx)
@@ -127,12 +137,16 @@ func (tx *tx) QueryContext(ctx context.Context, query string, args []driver.Name
 ) {
 	onDone := trace.DatabaseSQLOnTxQuery(tx.conn.trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*tx).QueryContext"),
-		tx.ctx, tx, query,
+		tx.ctx, tx, tx.conn.connector.redactQueryText(query),
+		fingerprint.QueryIf(tx.conn.trace.OnTxQuery != nil, query),
 	)
 	defer func() {
 		onDone(finalErr)
 	}()
 	m := queryModeFromContext(ctx, tx.conn.defaultQueryMode)
+	if m == SchemeQueryMode || m == ScriptingQueryMode {
+		return nil, xerrors.WithStackTrace(queryModeInTxError(m))
+	}
 	if m != DataQueryMode {
 		return nil, badconn.Map(
 			xerrors.WithStackTrace(
@@ -158,10 +172,13 @@ func (tx *tx) QueryContext(ctx context.Context, query string, args []driver.Name
 		return nil, badconn.Map(xerrors.WithStackTrace(err))
 	}
 
-	return &rows{
+	r := &rows{
 		conn:   tx.conn,
 		result: res,
-	}, nil
+	}
+	tx.rows.add(r)
+
+	return r, nil
 }
 
 func (tx *tx) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (
@@ -169,12 +186,16 @@ func (tx *tx) ExecContext(ctx context.Context, query string, args []driver.Named
 ) {
 	onDone := trace.DatabaseSQLOnTxExec(tx.conn.trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*tx).ExecContext"),
-		tx.ctx, tx, query,
+		tx.ctx, tx, tx.conn.connector.redactQueryText(query),
+		fingerprint.QueryIf(tx.conn.trace.OnTxExec != nil, query),
 	)
 	defer func() {
 		onDone(finalErr)
 	}()
 	m := queryModeFromContext(ctx, tx.conn.defaultQueryMode)
+	if m == SchemeQueryMode || m == ScriptingQueryMode {
+		return nil, xerrors.WithStackTrace(queryModeInTxError(m))
+	}
 	if m != DataQueryMode {
 		return nil, badconn.Map(
 			xerrors.WithStackTrace(
@@ -186,16 +207,26 @@ func (tx *tx) ExecContext(ctx context.Context, query string, args []driver.Named
 			),
 		)
 	}
+	args, outs, err := splitOutArgs(args)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
 	query, parameters, err := tx.conn.normalize(query, args...)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
-	_, err = tx.tx.Execute(ctx,
+	res, err := tx.tx.Execute(ctx,
 		query, &parameters, tx.conn.dataQueryOptions(ctx)...,
 	)
 	if err != nil {
 		return nil, badconn.Map(xerrors.WithStackTrace(err))
 	}
+	defer func() {
+		_ = res.Close()
+	}()
+	if err = scanOutArgs(ctx, res, outs); err != nil {
+		return nil, badconn.Map(xerrors.WithStackTrace(err))
+	}
 
 	return resultNoRows{}, nil
 }
@@ -203,7 +234,8 @@ func (tx *tx) ExecContext(ctx context.Context, query string, args []driver.Named
 func (tx *tx) PrepareContext(ctx context.Context, query string) (_ driver.Stmt, finalErr error) {
 	onDone := trace.DatabaseSQLOnTxPrepare(tx.conn.trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*tx).PrepareContext"),
-		tx.ctx, tx, query,
+		tx.ctx, tx, tx.conn.connector.redactQueryText(query),
+		fingerprint.QueryIf(tx.conn.trace.OnTxPrepare != nil, query),
 	)
 	defer func() {
 		onDone(finalErr)
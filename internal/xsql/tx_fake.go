@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql/driver"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/fingerprint"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
@@ -15,12 +16,14 @@ type txFake struct {
 	beginCtx context.Context //nolint:containedctx
 	conn     *conn
 	ctx      context.Context //nolint:containedctx
+	rows     txRows
 }
 
 func (tx *txFake) PrepareContext(ctx context.Context, query string) (_ driver.Stmt, finalErr error) {
 	onDone := trace.DatabaseSQLOnTxPrepare(tx.conn.trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*txFake).PrepareContext"),
-		tx.beginCtx, tx, query,
+		tx.beginCtx, tx, tx.conn.connector.redactQueryText(query),
+		fingerprint.QueryIf(tx.conn.trace.OnTxPrepare != nil, query),
 	)
 	defer func() {
 		onDone(finalErr)
@@ -46,10 +49,12 @@ var (
 )
 
 func (c *conn) beginTxFake(ctx context.Context, txOptions driver.TxOptions) (currentTx, error) {
-	return &txFake{
+	c.currentTx = &txFake{
 		conn: c,
 		ctx:  ctx,
-	}, nil
+	}
+
+	return c.currentTx, nil
 }
 
 func (tx *txFake) ID() string {
@@ -68,6 +73,7 @@ func (tx *txFake) Commit() (err error) {
 		onDone(err)
 	}()
 	defer func() {
+		tx.rows.finish()
 		tx.conn.currentTx = nil
 	}()
 	if !tx.conn.isReady() {
@@ -89,6 +95,7 @@ func (tx *txFake) Rollback() (err error) {
 		onDone(err)
 	}()
 	defer func() {
+		tx.rows.finish()
 		tx.conn.currentTx = nil
 	}()
 	if !tx.conn.isReady() {
@@ -104,15 +111,17 @@ func (tx *txFake) QueryContext(ctx context.Context, query string, args []driver.
 	onDone := trace.DatabaseSQLOnTxQuery(
 		tx.conn.trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*txFake).QueryContext"),
-		tx.ctx, tx, query,
+		tx.ctx, tx, tx.conn.connector.redactQueryText(query),
+		fingerprint.QueryIf(tx.conn.trace.OnTxQuery != nil, query),
 	)
 	defer func() {
 		onDone(err)
 	}()
-	rows, err = tx.conn.QueryContext(ctx, query, args)
+	rows, err = tx.conn.queryContext(ctx, query, args)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
+	tx.rows.add(rows)
 
 	return rows, nil
 }
@@ -123,12 +132,13 @@ func (tx *txFake) ExecContext(ctx context.Context, query string, args []driver.N
 	onDone := trace.DatabaseSQLOnTxExec(
 		tx.conn.trace, &ctx,
 		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/internal/xsql.(*txFake).ExecContext"),
-		tx.ctx, tx, query,
+		tx.ctx, tx, tx.conn.connector.redactQueryText(query),
+		fingerprint.QueryIf(tx.conn.trace.OnTxExec != nil, query),
 	)
 	defer func() {
 		onDone(err)
 	}()
-	result, err = tx.conn.ExecContext(ctx, query, args)
+	result, err = tx.conn.execContext(ctx, query, args)
 	if err != nil {
 		return nil, xerrors.WithStackTrace(err)
 	}
@@ -0,0 +1,248 @@
+package xsql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type txRowsTransactionMock struct {
+	table.Transaction
+
+	sets []*Ydb.ResultSet
+}
+
+func (tx *txRowsTransactionMock) ID() string {
+	return "test-tx"
+}
+
+func (tx *txRowsTransactionMock) Execute(
+	ctx context.Context, query string, parameters *params.Parameters, opts ...options.ExecuteDataQueryOption,
+) (result.Result, error) {
+	return scanner.NewUnary(tx.sets, nil), nil
+}
+
+func (tx *txRowsTransactionMock) CommitTx(
+	ctx context.Context, opts ...options.CommitTransactionOption,
+) (result.Result, error) {
+	return scanner.NewUnary(nil, nil), nil
+}
+
+func (tx *txRowsTransactionMock) Rollback(ctx context.Context) error {
+	return nil
+}
+
+type txRowsSessionMock struct {
+	table.ClosableSession
+
+	sets         []*Ydb.ResultSet
+	streamCloses int
+}
+
+func (s *txRowsSessionMock) Close(ctx context.Context) error {
+	return nil
+}
+
+func (s *txRowsSessionMock) Status() table.SessionStatus {
+	return table.SessionReady
+}
+
+func (s *txRowsSessionMock) Execute(
+	ctx context.Context, tx *table.TransactionControl, query string, parameters *params.Parameters,
+	opts ...options.ExecuteDataQueryOption,
+) (table.Transaction, result.Result, error) {
+	return nil, scanner.NewUnary(s.sets, nil), nil
+}
+
+func (s *txRowsSessionMock) BeginTransaction(
+	ctx context.Context, settings *table.TransactionSettings,
+) (table.Transaction, error) {
+	return &txRowsTransactionMock{sets: s.sets}, nil
+}
+
+func (s *txRowsSessionMock) StreamExecuteScanQuery(
+	ctx context.Context, query string, parameters *params.Parameters, opts ...options.ExecuteScanQueryOption,
+) (result.StreamResult, error) {
+	sets := s.sets
+
+	return scanner.NewStream(ctx,
+		func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+			if len(sets) == 0 {
+				return nil, nil, io.EOF
+			}
+			set := sets[0]
+			sets = sets[1:]
+
+			return set, nil, nil
+		},
+		func(err error) error {
+			s.streamCloses++
+
+			return err
+		},
+	)
+}
+
+func TestTxRowsAfterTxFinished(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		mode   QueryMode
+		finish func(tx driver.Tx) error
+	}{
+		{
+			name:   "BufferedCommit",
+			mode:   DataQueryMode,
+			finish: driver.Tx.Commit,
+		},
+		{
+			name:   "BufferedRollback",
+			mode:   DataQueryMode,
+			finish: driver.Tx.Rollback,
+		},
+		{
+			name:   "StreamingCommit",
+			mode:   ScanQueryMode,
+			finish: driver.Tx.Commit,
+		},
+		{
+			name:   "StreamingRollback",
+			mode:   ScanQueryMode,
+			finish: driver.Tx.Rollback,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &txRowsSessionMock{
+				sets: []*Ydb.ResultSet{
+					returningResultSet(returningRow(1, "a"), returningRow(2, "b")),
+					returningResultSet(returningRow(3, "c")),
+				},
+			}
+			c := newConn(context.Background(), &Connector{
+				conns: make(map[*conn]struct{}),
+			}, session, withTrace(&trace.DatabaseSQL{}), withFakeTxModes(ScanQueryMode))
+			ctx := WithQueryMode(context.Background(), tt.mode)
+
+			tx, err := c.BeginTx(ctx, driver.TxOptions{})
+			require.NoError(t, err)
+			opened, err := c.QueryContext(ctx, "SELECT id, title FROM series", nil)
+			require.NoError(t, err)
+			closed, err := c.QueryContext(ctx, "SELECT id, title FROM series", nil)
+			require.NoError(t, err)
+			require.NoError(t, closed.Close())
+
+			dst := make([]driver.Value, 2)
+			require.NoError(t, opened.Next(dst))
+			require.EqualValues(t, 1, dst[0])
+
+			require.NoError(t, tt.finish(tx))
+			require.ErrorIs(t, opened.Next(dst), ErrRowsUsedAfterTxFinished)
+			require.ErrorIs(t, opened.(driver.RowsNextResultSet).NextResultSet(), ErrRowsUsedAfterTxFinished)
+			require.False(t, opened.(driver.RowsNextResultSet).HasNextResultSet())
+			require.NoError(t, opened.Close())
+			if tt.mode == ScanQueryMode {
+				// stream of opened rows is closed by transaction
+				require.Equal(t, 2, session.streamCloses)
+			}
+
+			// rows outside of transaction are not affected by finished transaction
+			rows, err := c.QueryContext(ctx, "SELECT id, title FROM series", nil)
+			require.NoError(t, err)
+			require.NoError(t, rows.Next(dst))
+			require.NoError(t, rows.Close())
+		})
+	}
+}
+
+// connectorStub connects database/sql to single conn of driver
+type connectorStub struct {
+	conn *conn
+}
+
+func (c connectorStub) Connect(context.Context) (driver.Conn, error) {
+	return c.conn, nil
+}
+
+func (c connectorStub) Driver() driver.Driver {
+	return c.conn.connector.Driver()
+}
+
+func TestTxRowsAfterTxFinishedWithDatabaseSQL(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		mode   QueryMode
+		finish func(tx *sql.Tx) error
+	}{
+		{
+			name:   "BufferedCommit",
+			mode:   DataQueryMode,
+			finish: (*sql.Tx).Commit,
+		},
+		{
+			name:   "BufferedRollback",
+			mode:   DataQueryMode,
+			finish: (*sql.Tx).Rollback,
+		},
+		{
+			name:   "StreamingCommit",
+			mode:   ScanQueryMode,
+			finish: (*sql.Tx).Commit,
+		},
+		{
+			name:   "StreamingRollback",
+			mode:   ScanQueryMode,
+			finish: (*sql.Tx).Rollback,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &txRowsSessionMock{
+				sets: []*Ydb.ResultSet{
+					returningResultSet(returningRow(1, "a"), returningRow(2, "b")),
+				},
+			}
+			connector := &Connector{
+				conns: make(map[*conn]struct{}),
+			}
+			db := sql.OpenDB(connectorStub{
+				conn: newConn(context.Background(), connector, session,
+					withTrace(&trace.DatabaseSQL{}), withFakeTxModes(ScanQueryMode),
+				),
+			})
+			defer db.Close()
+			ctx := WithQueryMode(context.Background(), tt.mode)
+
+			tx, err := db.BeginTx(ctx, nil)
+			require.NoError(t, err)
+			rows, err := tx.QueryContext(ctx, "SELECT id, title FROM series")
+			require.NoError(t, err)
+			require.True(t, rows.Next())
+			var (
+				id    int64
+				title string
+			)
+			require.NoError(t, rows.Scan(&id, &title))
+			require.EqualValues(t, 1, id)
+
+			require.NoError(t, tt.finish(tx))
+			require.False(t, rows.Next())
+			require.ErrorIs(t, rows.Err(), context.Canceled)
+			require.NoError(t, rows.Close())
+
+			// conn is usable after finished transaction
+			require.NoError(t, db.QueryRowContext(ctx, "SELECT id, title FROM series").Scan(&id, &title))
+			require.EqualValues(t, 1, id)
+		})
+	}
+}
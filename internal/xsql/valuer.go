@@ -1,6 +1,8 @@
 package xsql
 
 import (
+	"github.com/google/uuid"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/scanner"
 )
 
@@ -11,6 +13,12 @@ type valuer struct {
 func (v *valuer) UnmarshalYDB(raw scanner.RawValue) error {
 	v.v = raw.Any()
 
+	// database/sql cannot convert [16]byte into string or sql.Scanner destinations
+	// so UUID values passes to database/sql in canonical text form
+	if id, ok := v.v.([16]byte); ok {
+		v.v = uuid.UUID(id).String()
+	}
+
 	return nil
 }
 
@@ -0,0 +1,71 @@
+package xtest
+
+import (
+	"bytes"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+const goroutinesLeakTimeout = 5 * time.Second
+
+// CheckGoroutinesLeak makes snapshot of current goroutines and returns function which fails test
+// if goroutines started after snapshot still run (in uber-go/goleak style).
+// Returned function waits for goroutines finish with timeout before fail
+func CheckGoroutinesLeak(tb testing.TB) (check func()) {
+	tb.Helper()
+
+	before := goroutines()
+
+	return func() {
+		tb.Helper()
+
+		var leaked []string
+		deadline := time.Now().Add(goroutinesLeakTimeout)
+		for {
+			leaked = leaked[:0]
+			for id, stack := range goroutines() {
+				if _, has := before[id]; !has {
+					leaked = append(leaked, stack)
+				}
+			}
+			if len(leaked) == 0 {
+				return
+			}
+			if time.Now().After(deadline) {
+				break
+			}
+			time.Sleep(10 * time.Millisecond) //nolint:gomnd
+		}
+		tb.Fatalf("found %d leaked goroutines:\n\n%s", len(leaked), strings.Join(leaked, "\n\n"))
+	}
+}
+
+// goroutines returns stacks of all goroutines except current by goroutine header ("goroutine 1")
+func goroutines() map[string]string {
+	buf := make([]byte, 1<<20) //nolint:gomnd
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+
+			break
+		}
+		buf = make([]byte, 2*len(buf)) //nolint:gomnd
+	}
+
+	stacks := bytes.Split(buf, []byte("\n\n"))
+	m := make(map[string]string, len(stacks))
+	for i, stack := range stacks {
+		if i == 0 {
+			// first stack is current goroutine
+			continue
+		}
+		s := string(stack)
+		header, _, _ := strings.Cut(s, " [")
+		m[header] = s
+	}
+
+	return m
+}
@@ -263,6 +263,26 @@ func internalCoordination(
 				Stringer("failure", info.Failure),
 			)
 		},
+		OnSessionRestored: func(info trace.CoordinationSessionRestoredInfo) {
+			if d.Details()&trace.CoordinationEvents == 0 {
+				return
+			}
+			ctx := with(context.Background(), DEBUG, "ydb", "coordination", "session", "restored")
+			l.Log(ctx, "",
+				String("sessionID", strconv.FormatUint(info.SessionID, 10)),
+				Int("reconnects", info.Reconnects),
+			)
+		},
+		OnSessionExpired: func(info trace.CoordinationSessionExpiredInfo) {
+			if d.Details()&trace.CoordinationEvents == 0 {
+				return
+			}
+			ctx := with(context.Background(), WARN, "ydb", "coordination", "session", "expired")
+			l.Log(ctx, "",
+				String("sessionID", strconv.FormatUint(info.SessionID, 10)),
+				Error(info.Reason),
+			)
+		},
 		OnSessionReceive: func(
 			info trace.CoordinationSessionReceiveStartInfo,
 		) func(
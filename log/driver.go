@@ -54,11 +54,13 @@ func internalDriver(l Logger, d trace.Detailer) trace.Driver { //nolint:gocyclo
 			endpoint := info.Endpoint
 			database := info.Database
 			secure := info.Secure
+			applicationName := info.ApplicationName
 			ctx := with(*info.Context, DEBUG, "ydb", "driver", "resolver", "init")
 			l.Log(ctx, "start",
 				String("endpoint", endpoint),
 				String("database", database),
 				Bool("secure", secure),
+				String("applicationName", applicationName),
 			)
 			start := time.Now()
 
@@ -197,6 +199,8 @@ func internalDriver(l Logger, d trace.Detailer) trace.Driver { //nolint:gocyclo
 						Stringer("endpoint", endpoint),
 						String("method", method),
 						latencyField(start),
+						Int("request_size", info.RequestSize),
+						Int("response_size", info.ResponseSize),
 						Stringer("metadata", metadata(info.Metadata)),
 					)
 				} else {
@@ -270,6 +274,18 @@ func internalDriver(l Logger, d trace.Detailer) trace.Driver { //nolint:gocyclo
 				}
 			}
 		},
+		OnConnStreamInactivityTimeout: func(info trace.DriverConnStreamInactivityTimeoutInfo) {
+			if d.Details()&trace.DriverConnStreamEvents == 0 {
+				return
+			}
+			ctx := with(info.Context, WARN, "ydb", "driver", "conn", "stream", "InactivityTimeout")
+			l.Log(ctx, "stream canceled",
+				Stringer("endpoint", info.Endpoint),
+				String("method", string(info.Method)),
+				Stringer("last_activity", info.LastActivity),
+				Duration("timeout", info.Timeout),
+			)
+		},
 		OnConnStreamSendMsg: func(info trace.DriverConnStreamSendMsgStartInfo) func(trace.DriverConnStreamSendMsgDoneInfo) {
 			if d.Details()&trace.DriverConnStreamEvents == 0 {
 				return nil
@@ -33,9 +33,10 @@ func internalRetry(l Logger, d trace.Detailer) (t trace.Retry) {
 					String("label", label),
 					latencyField(start),
 					Int("attempts", info.Attempts),
+					Int("tli", info.TransactionLocksInvalidated),
 				)
 			} else {
-				lvl := ERROR
+				lvl := WARN
 				if !xerrors.IsYdb(info.Error) {
 					lvl = DEBUG
 				}
@@ -45,14 +46,31 @@ func internalRetry(l Logger, d trace.Detailer) (t trace.Retry) {
 					String("label", label),
 					latencyField(start),
 					Int("attempts", info.Attempts),
+					Int("tli", info.TransactionLocksInvalidated),
+					Stringer("reason", info.Reason),
 					Bool("retryable", m.MustRetry(idempotent)),
-					Int64("code", m.StatusCode()),
-					Bool("deleteSession", m.IsRetryObjectValid()),
+					Int64("code", info.Code),
+					String("backoff", info.BackoffType),
+					Bool("deleteSession", info.DeleteSession),
 					versionField(),
 				)
 			}
 		}
 	}
+	t.OnSleepShortened = func(info trace.RetrySleepShortenedInfo) {
+		if d.Details()&trace.RetryEvents == 0 {
+			return
+		}
+		ctx := with(info.Context, DEBUG, "ydb", "retry", "sleep")
+		l.Log(ctx, "shortened",
+			String("label", info.Label),
+			Int("attempt", info.Attempt),
+			Duration("delay", info.Delay),
+			Duration("slept", info.Slept),
+			Duration("jitter", info.Jitter),
+			Stringer("reason", info.Reason),
+		)
+	}
 
 	return t
 }
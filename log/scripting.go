@@ -59,6 +59,29 @@ func internalScripting(l *wrapper, d trace.Detailer) (t trace.Scripting) {
 			}
 		}
 	}
+	t.OnValidate = func(info trace.ScriptingValidateStartInfo) func(trace.ScriptingValidateDoneInfo) {
+		if d.Details()&trace.ScriptingEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "scripting", "validate")
+		l.Log(ctx, "start")
+		start := time.Now()
+
+		return func(info trace.ScriptingValidateDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					Int("issues", len(info.Issues)),
+				)
+			} else {
+				l.Log(WithLevel(ctx, WARN), "failed",
+					Error(info.Error),
+					latencyField(start),
+					versionField(),
+				)
+			}
+		}
+	}
 	t.OnStreamExecute = func(
 		info trace.ScriptingStreamExecuteStartInfo,
 	) func(
@@ -40,6 +40,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 					Bool("idempotent", idempotent),
 					String("label", label),
 					Int("attempts", info.Attempts),
+					Int("tli", info.TransactionLocksInvalidated),
 				)
 			} else {
 				lvl := ERROR
@@ -52,6 +53,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 					Bool("idempotent", idempotent),
 					String("label", label),
 					Int("attempts", info.Attempts),
+					Int("tli", info.TransactionLocksInvalidated),
 					Error(info.Error),
 					Bool("retryable", m.MustRetry(idempotent)),
 					Int64("code", m.StatusCode()),
@@ -85,6 +87,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 					Bool("idempotent", idempotent),
 					String("label", label),
 					Int("attempts", info.Attempts),
+					Int("tli", info.TransactionLocksInvalidated),
 				)
 			} else {
 				lvl := WARN
@@ -97,6 +100,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 					Bool("idempotent", idempotent),
 					String("label", label),
 					Int("attempts", info.Attempts),
+					Int("tli", info.TransactionLocksInvalidated),
 					Error(info.Error),
 					Bool("retryable", m.MustRetry(idempotent)),
 					Int64("code", m.StatusCode()),
@@ -106,6 +110,42 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			}
 		}
 	}
+	t.OnDoHedged = func(
+		info trace.TableDoHedgedStartInfo,
+	) func(
+		trace.TableDoHedgedDoneInfo,
+	) {
+		if d.Details()&trace.TablePoolAPIEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "table", "do", "hedged")
+		label := info.Label
+		l.Log(ctx, "start",
+			String("label", label),
+			Duration("hedgeDelay", info.HedgeDelay),
+			Int("maxHedges", info.MaxHedges),
+		)
+		start := time.Now()
+
+		return func(info trace.TableDoHedgedDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					latencyField(start),
+					String("label", label),
+					Int("hedges", info.Hedges),
+					Int("winner", info.Winner),
+				)
+			} else {
+				l.Log(WithLevel(ctx, DEBUG), "done",
+					latencyField(start),
+					String("label", label),
+					Int("hedges", info.Hedges),
+					Error(info.Error),
+					versionField(),
+				)
+			}
+		}
+	}
 	t.OnCreateSession = func(
 		info trace.TableCreateSessionStartInfo,
 	) func(
@@ -289,6 +329,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 				Stringer("query", info.Query),
 				String("id", session.ID()),
 				String("status", session.Status()),
+				Duration("operationTimeout", info.OperationTimeout),
 			)...,
 		)
 		start := time.Now()
@@ -303,6 +344,7 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 						String("tx", tx.ID()),
 						String("status", session.Status()),
 						Bool("prepared", info.Prepared),
+						Bool("compilationFromCache", info.CompilationFromCache),
 						NamedError("result_err", info.Result.Err()),
 						latencyField(start),
 					)...,
@@ -322,6 +364,21 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			}
 		}
 	}
+	t.OnSessionQueryWarnings = func(info trace.TableSessionQueryWarningsInfo) {
+		if d.Details()&trace.TableSessionQueryEvents == 0 {
+			return
+		}
+		ctx := with(*info.Context, WARN, "ydb", "table", "session", "query", "warnings")
+		messages := make([]string, 0, len(info.Issues))
+		for _, issue := range info.Issues {
+			messages = append(messages, issue.GetMessage())
+		}
+		l.Log(ctx, "successful response with issues",
+			String("id", info.Session.ID()),
+			String("fingerprint", info.QueryFingerprint),
+			Strings("issues", messages),
+		)
+	}
 	t.OnSessionQueryStreamExecute = func(
 		info trace.TableSessionQueryStreamExecuteStartInfo,
 	) func(
@@ -436,6 +493,112 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			}
 		}
 	}
+	t.OnTxExecute = func(
+		info trace.TableTransactionExecuteStartInfo,
+	) func(
+		trace.TableTransactionExecuteDoneInfo,
+	) {
+		if d.Details()&trace.TableSessionTransactionEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "table", "session", "tx", "execute")
+		session := info.Session
+		tx := info.Tx
+		query := info.Query
+		commitTx := info.CommitTx
+		l.Log(ctx, "start",
+			appendFieldByCondition(l.logQuery,
+				Stringer("query", query),
+				String("id", session.ID()),
+				String("status", session.Status()),
+				String("tx", tx.ID()),
+				Bool("commitTx", commitTx),
+			)...,
+		)
+		start := time.Now()
+
+		return func(info trace.TableTransactionExecuteDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					appendFieldByCondition(l.logQuery,
+						Stringer("query", query),
+						String("id", session.ID()),
+						String("status", session.Status()),
+						String("tx", tx.ID()),
+						Bool("commitTx", commitTx),
+						NamedError("result_err", info.Result.Err()),
+						latencyField(start),
+					)...,
+				)
+			} else {
+				l.Log(WithLevel(ctx, ERROR), "failed",
+					appendFieldByCondition(l.logQuery,
+						Stringer("query", query),
+						Error(info.Error),
+						String("id", session.ID()),
+						String("status", session.Status()),
+						String("tx", tx.ID()),
+						Bool("commitTx", commitTx),
+						latencyField(start),
+						versionField(),
+					)...,
+				)
+			}
+		}
+	}
+	t.OnTxExecuteStatement = func(
+		info trace.TableTransactionExecuteStatementStartInfo,
+	) func(
+		trace.TableTransactionExecuteStatementDoneInfo,
+	) {
+		if d.Details()&trace.TableSessionTransactionEvents == 0 {
+			return nil
+		}
+		ctx := with(*info.Context, TRACE, "ydb", "table", "session", "tx", "execute", "statement")
+		session := info.Session
+		tx := info.Tx
+		query := info.StatementQuery
+		commitTx := info.CommitTx
+		l.Log(ctx, "start",
+			appendFieldByCondition(l.logQuery,
+				Stringer("query", query),
+				String("id", session.ID()),
+				String("status", session.Status()),
+				String("tx", tx.ID()),
+				Bool("commitTx", commitTx),
+			)...,
+		)
+		start := time.Now()
+
+		return func(info trace.TableTransactionExecuteStatementDoneInfo) {
+			if info.Error == nil {
+				l.Log(ctx, "done",
+					appendFieldByCondition(l.logQuery,
+						Stringer("query", query),
+						String("id", session.ID()),
+						String("status", session.Status()),
+						String("tx", tx.ID()),
+						Bool("commitTx", commitTx),
+						NamedError("result_err", info.Result.Err()),
+						latencyField(start),
+					)...,
+				)
+			} else {
+				l.Log(WithLevel(ctx, ERROR), "failed",
+					appendFieldByCondition(l.logQuery,
+						Stringer("query", query),
+						Error(info.Error),
+						String("id", session.ID()),
+						String("status", session.Status()),
+						String("tx", tx.ID()),
+						Bool("commitTx", commitTx),
+						latencyField(start),
+						versionField(),
+					)...,
+				)
+			}
+		}
+	}
 	t.OnTxCommit = func(info trace.TableTxCommitStartInfo) func(trace.TableTxCommitDoneInfo) {
 		if d.Details()&trace.TableSessionTransactionEvents == 0 {
 			return nil
@@ -575,6 +738,30 @@ func internalTable(l *wrapper, d trace.Detailer) (t trace.Table) {
 			String("status", info.Session.Status()),
 		)
 	}
+	t.OnPoolSessionQuarantine = func(info trace.TablePoolSessionQuarantineInfo) {
+		if d.Details()&trace.TablePoolLifeCycleEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), WARN, "ydb", "table", "pool", "session", "quarantine")
+		l.Log(ctx, "session deleted after consecutive errors",
+			String("id", info.Session.ID()),
+			Int("consecutive_errors", info.ConsecutiveErrors),
+			Error(info.Error),
+		)
+	}
+	t.OnPoolSessionAffinity = func(info trace.TablePoolSessionAffinityInfo) {
+		if d.Details()&trace.TablePoolAPIEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), TRACE, "ydb", "table", "pool", "session", "affinity")
+		l.Log(ctx, "",
+			String("id", info.Session.ID()),
+			String("key", info.Key),
+			Bool("hit", info.Hit),
+			Any("hits", info.Hits),
+			Any("misses", info.Misses),
+		)
+	}
 	t.OnPoolPut = func(info trace.TablePoolPutStartInfo) func(trace.TablePoolPutDoneInfo) {
 		if d.Details()&trace.TablePoolAPIEvents == 0 {
 			return nil
@@ -426,6 +426,31 @@ func internalTopic(l Logger, d trace.Detailer) (t trace.Topic) { //nolint:gocycl
 			}
 		}
 	}
+	t.OnReaderFlowControlPause = func(info trace.TopicReaderFlowControlPauseInfo) {
+		if d.Details()&trace.TopicReaderMessageEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), DEBUG, "ydb", "topic", "reader", "flow", "control", "pause")
+		l.Log(ctx, "pause data requests",
+			String("reader_connection_id", info.ReaderConnectionID),
+			Stringer("reason", info.Reason),
+			Int("buffered_bytes", info.BufferedBytes),
+			Int("buffered_messages", info.BufferedMessages),
+		)
+	}
+	t.OnReaderFlowControlResume = func(info trace.TopicReaderFlowControlResumeInfo) {
+		if d.Details()&trace.TopicReaderMessageEvents == 0 {
+			return
+		}
+		ctx := with(context.Background(), DEBUG, "ydb", "topic", "reader", "flow", "control", "resume")
+		l.Log(ctx, "resume data requests",
+			String("reader_connection_id", info.ReaderConnectionID),
+			Int("buffered_bytes", info.BufferedBytes),
+			Int("buffered_messages", info.BufferedMessages),
+			Int("request_bytes", info.RequestBytes),
+			Duration("pause", info.PauseDuration),
+		)
+	}
 	t.OnReaderUnknownGrpcMessage = func(info trace.OnReadUnknownGrpcMessageInfo) {
 		if d.Details()&trace.TopicReaderMessageEvents == 0 {
 			return
@@ -44,3 +44,16 @@ func WithTrailerCallback(
 ) context.Context {
 	return meta.WithTrailerCallback(ctx, callback)
 }
+
+// WithUserMetadata returns a copy of parent context with user metadata, which will be sent
+// with every request (including streaming calls and retry attempts) made with the context.
+// User metadata available in trace.Driver.OnConnInvoke and trace.Driver.OnConnNewStream callbacks
+// with metadata.FromOutgoingContext(*info.Context)
+//
+// Keys of user metadata must have prefix "x-", must not have reserved prefix "x-ydb-"
+// and must contain only lower-case latin letters, digits, '-', '_' and '.'
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithUserMetadata(ctx context.Context, md map[string]string) (context.Context, error) {
+	return meta.WithUserMetadata(ctx, md)
+}
@@ -17,6 +17,7 @@ func driver(config Config) (t trace.Driver) {
 	conns := config.GaugeVec("conns", "endpoint", "node_id")
 	banned := config.WithSystem("conn").GaugeVec("banned", "endpoint", "node_id", "cause")
 	requests := config.WithSystem("conn").CounterVec("requests", "status", "method", "endpoint", "node_id")
+	inFlight := config.WithSystem("conn").GaugeVec("in_flight", "endpoint", "node_id")
 	tli := config.CounterVec("transaction_locks_invalidated")
 
 	type endpointKey struct {
@@ -135,6 +136,14 @@ func driver(config Config) (t trace.Driver) {
 			}
 		}
 	}
+	t.OnConnInFlightChange = func(info trace.DriverConnInFlightChangeInfo) {
+		if config.Details()&trace.DriverConnEvents != 0 {
+			inFlight.With(map[string]string{
+				"endpoint": info.Endpoint.Address(),
+				"node_id":  idToString(info.Endpoint.NodeID()),
+			}).Set(float64(info.InFlight))
+		}
+	}
 	t.OnConnClose = func(info trace.DriverConnCloseStartInfo) func(trace.DriverConnCloseDoneInfo) {
 		if config.Details()&trace.DriverConnEvents != 0 {
 			conns.With(map[string]string{
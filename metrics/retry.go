@@ -10,6 +10,7 @@ func retry(config Config) (t trace.Retry) {
 	config = config.WithSystem("retry")
 	errs := config.CounterVec("errors", "status", "retry_label", "final")
 	attempts := config.HistogramVec("attempts", []float64{0, 1, 2, 3, 4, 5, 7, 10}, "retry_label")
+	tli := config.HistogramVec("tli", []float64{0, 1, 2, 3, 4, 5, 7, 10}, "retry_label")
 	latency := config.TimerVec("latency", "retry_label")
 	t.OnRetry = func(info trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
 		label := info.Label
@@ -23,6 +24,9 @@ func retry(config Config) (t trace.Retry) {
 				attempts.With(map[string]string{
 					"retry_label": label,
 				}).Record(float64(info.Attempts))
+				tli.With(map[string]string{
+					"retry_label": label,
+				}).Record(float64(info.TransactionLocksInvalidated))
 				errs.With(map[string]string{
 					"status":      errorBrief(info.Error),
 					"retry_label": label,
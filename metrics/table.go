@@ -18,6 +18,8 @@ func table(config Config) (t trace.Table) {
 	inflightLatency := config.WithSystem("inflight").TimerVec("latency")
 	wait := config.GaugeVec("wait")
 	waitLatency := config.WithSystem("wait").TimerVec("latency")
+	hedges := config.WithSystem("hedge").CounterVec("fired")
+	hedgeWinners := config.WithSystem("hedge").CounterVec("winners", "winner")
 	t.OnInit = func(info trace.TableInitStartInfo) func(trace.TableInitDoneInfo) {
 		return func(info trace.TableInitDoneInfo) {
 			limit.With(nil).Set(float64(info.Limit))
@@ -51,6 +53,27 @@ func table(config Config) (t trace.Table) {
 			size.With(nil).Add(-1)
 		}
 	}
+	t.OnDoHedged = func(info trace.TableDoHedgedStartInfo) func(trace.TableDoHedgedDoneInfo) {
+		if config.Details()&trace.TablePoolAPIEvents == 0 {
+			return nil
+		}
+
+		return func(info trace.TableDoHedgedDoneInfo) {
+			for i := 0; i < info.Hedges; i++ {
+				hedges.With(nil).Inc()
+			}
+			winner := "none"
+			switch {
+			case info.Winner == 0:
+				winner = "primary"
+			case info.Winner > 0:
+				winner = "hedge"
+			}
+			hedgeWinners.With(map[string]string{
+				"winner": winner,
+			}).Inc()
+		}
+	}
 	var inflightStarts sync.Map
 	t.OnPoolGet = func(info trace.TablePoolGetStartInfo) func(trace.TablePoolGetDoneInfo) {
 		wait.With(nil).Add(1)
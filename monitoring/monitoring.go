@@ -0,0 +1,239 @@
+// Package monitoring contains client of database self-check (health check) service
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+type (
+	// Client is a client of monitoring service
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Client interface {
+		// SelfCheck returns result of database self-check
+		// SelfCheck calls monitoring service directly and does not use sessions of table or query services
+		SelfCheck(ctx context.Context, opts ...SelfCheckOption) (*SelfCheckResult, error)
+	}
+
+	// SelfCheckStatus is an overall status of database
+	SelfCheckStatus int
+
+	// Status is a status of single issue
+	Status int
+
+	// SelfCheckResult is a result of database self-check
+	SelfCheckResult struct {
+		Status SelfCheckStatus
+
+		// Issues contains root issues of issue tree. Root issue is an issue which is not a reason of other issue
+		Issues []*Issue
+	}
+
+	// Issue is a node of issue tree
+	Issue struct {
+		ID      string
+		Status  Status
+		Message string
+
+		// Type is a type of checked component, such as DATABASE, STORAGE_GROUP or COMPUTE_NODE
+		Type string
+
+		// Level is a depth of issue in issue tree of server. Root issues have level 1
+		Level uint32
+
+		// Count is a number of similar issues merged into this issue
+		Count uint32
+
+		// Location is a location of issue or nil if server not returned location
+		Location *Location
+
+		// Reasons contains issues which caused this issue
+		Reasons []*Issue
+
+		// ReasonIDs contains ids of all reasons of issue, including reasons filtered out by server
+		ReasonIDs []string
+	}
+
+	// Location is a location of issue
+	Location struct {
+		Database string
+
+		// Storage is a storage location of issue or nil
+		Storage *StorageLocation
+
+		// Compute is a compute location of issue or nil
+		Compute *ComputeLocation
+	}
+
+	// NodeLocation is a cluster node
+	NodeLocation struct {
+		ID   uint32
+		Host string
+		Port uint32
+	}
+
+	// StorageLocation is a location of issue in storage layer
+	StorageLocation struct {
+		Node     *NodeLocation
+		Pool     string
+		GroupIDs []string
+		VDiskIDs []string
+		PDisks   []PDiskLocation
+	}
+
+	// PDiskLocation is a physical disk
+	PDiskLocation struct {
+		ID   string
+		Path string
+	}
+
+	// ComputeLocation is a location of issue in compute layer
+	ComputeLocation struct {
+		Node   *NodeLocation
+		Pool   string
+		Tablet *TabletLocation
+	}
+
+	// TabletLocation is a group of tablets with the same type
+	TabletLocation struct {
+		Type  string
+		IDs   []string
+		Count uint32
+	}
+
+	// SelfCheckSettings is a settings of self-check request
+	SelfCheckSettings struct {
+		// Verbose requests detailed info about all checked components instead of issues only
+		Verbose bool
+
+		// MinimumStatus is a minimum status of issues to return. Zero value means all issues
+		MinimumStatus Status
+
+		// MaximumLevel is a maximum level of issues to return. Zero value means all levels
+		MaximumLevel uint32
+	}
+
+	// SelfCheckOption is an option of SelfCheck
+	SelfCheckOption func(s *SelfCheckSettings)
+)
+
+const (
+	SelfCheckStatusUnspecified = SelfCheckStatus(iota)
+	SelfCheckStatusGood
+	SelfCheckStatusDegraded
+	SelfCheckStatusMaintenanceRequired
+	SelfCheckStatusEmergency
+)
+
+func (s SelfCheckStatus) String() string {
+	switch s {
+	case SelfCheckStatusUnspecified:
+		return "unspecified"
+	case SelfCheckStatusGood:
+		return "good"
+	case SelfCheckStatusDegraded:
+		return "degraded"
+	case SelfCheckStatusMaintenanceRequired:
+		return "maintenance_required"
+	case SelfCheckStatusEmergency:
+		return "emergency"
+	default:
+		return fmt.Sprintf("SelfCheckStatus(%d)", int(s))
+	}
+}
+
+// Statuses are ordered by severity: StatusRed is the most severe status
+const (
+	StatusUnspecified = Status(iota)
+	StatusGrey        // component state is unknown
+	StatusGreen
+	StatusBlue
+	StatusYellow
+	StatusOrange
+	StatusRed
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusUnspecified:
+		return "unspecified"
+	case StatusGrey:
+		return "grey"
+	case StatusGreen:
+		return "green"
+	case StatusBlue:
+		return "blue"
+	case StatusYellow:
+		return "yellow"
+	case StatusOrange:
+		return "orange"
+	case StatusRed:
+		return "red"
+	default:
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+}
+
+// WithVerbose requests detailed info about all checked components instead of issues only
+func WithVerbose() SelfCheckOption {
+	return func(s *SelfCheckSettings) {
+		s.Verbose = true
+	}
+}
+
+// WithMinimumStatus requests issues with status not less than given status only
+func WithMinimumStatus(status Status) SelfCheckOption {
+	return func(s *SelfCheckSettings) {
+		s.MinimumStatus = status
+	}
+}
+
+// WithMaximumLevel requests issues with level not greater than given level only
+func WithMaximumLevel(level uint32) SelfCheckOption {
+	return func(s *SelfCheckSettings) {
+		s.MaximumLevel = level
+	}
+}
+
+// Flatten returns all issues of issue tree
+func (r *SelfCheckResult) Flatten() []*Issue {
+	return Flatten(r.Issues...)
+}
+
+// Flatten returns given issues and all their reasons without duplicates.
+// Issues are sorted by severity: most severe issues first, issues with equal status are sorted by level and id
+func Flatten(issues ...*Issue) []*Issue {
+	var (
+		flat    []*Issue
+		visited = make(map[*Issue]struct{})
+		walk    func(issues []*Issue)
+	)
+	walk = func(issues []*Issue) {
+		for _, issue := range issues {
+			if _, has := visited[issue]; has {
+				continue
+			}
+			visited[issue] = struct{}{}
+			flat = append(flat, issue)
+			walk(issue.Reasons)
+		}
+	}
+	walk(issues)
+
+	sort.SliceStable(flat, func(i, j int) bool {
+		if flat[i].Status != flat[j].Status {
+			return flat[i].Status > flat[j].Status
+		}
+		if flat[i].Level != flat[j].Level {
+			return flat[i].Level < flat[j].Level
+		}
+
+		return flat[i].ID < flat[j].ID
+	})
+
+	return flat
+}
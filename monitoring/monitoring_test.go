@@ -0,0 +1,40 @@
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlatten(t *testing.T) {
+	var (
+		disk = &Issue{ID: "disk", Status: StatusOrange, Level: 3}
+		node = &Issue{ID: "node", Status: StatusYellow, Level: 2, Reasons: []*Issue{disk}}
+		pool = &Issue{ID: "pool", Status: StatusOrange, Level: 2, Reasons: []*Issue{disk}}
+		db   = &Issue{ID: "db", Status: StatusRed, Level: 1, Reasons: []*Issue{node, pool}}
+		grey = &Issue{ID: "grey", Status: StatusGrey, Level: 1}
+	)
+	for _, tt := range []struct {
+		name   string
+		issues []*Issue
+		exp    []*Issue
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name:   "Tree",
+			issues: []*Issue{grey, db},
+			exp:    []*Issue{db, pool, disk, node, grey},
+		},
+		{
+			name:   "Subtree",
+			issues: []*Issue{node},
+			exp:    []*Issue{disk, node},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.exp, Flatten(tt.issues...))
+		})
+	}
+}
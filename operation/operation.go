@@ -0,0 +1,233 @@
+// Package operation contains client of long-running operations (index builds, imports, exports, script executions)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package operation
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type (
+	// Client is a client of long-running operations service
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Client interface {
+		// Get returns state of operation with given id
+		Get(ctx context.Context, id string) (*Operation, error)
+
+		// List returns page of operations with given kind
+		// Empty pageToken means first page, empty NextPageToken of result means last page
+		List(ctx context.Context, kind Kind, pageSize uint64, pageToken string) (*ListResult, error)
+
+		// Cancel starts cancellation of operation with given id
+		Cancel(ctx context.Context, id string) error
+
+		// Forget forgets operation with given id. Forgotten operation cannot be read with Get or List
+		Forget(ctx context.Context, id string) error
+
+		// Wait polls operation state with pollInterval until operation is ready or context is done
+		// Wait returns error of operation if operation finished unsuccessfully
+		Wait(ctx context.Context, id string, pollInterval time.Duration) (*Operation, error)
+	}
+
+	// Kind is a kind of long-running operations for List
+	Kind string
+
+	// Operation is a state of long-running operation
+	Operation struct {
+		ID string
+
+		// Ready is true if operation finished (successfully or not)
+		Ready bool
+
+		// Err is an error of finished operation. Err is nil for not ready or successfully finished operation.
+		// Err can be checked with ydb.IsOperationError
+		Err error
+
+		// Metadata is a typed metadata of operation or nil for unknown kinds of operations
+		Metadata Metadata
+	}
+
+	// Metadata is a typed metadata of operation: *IndexBuildMetadata, *ExportMetadata, *ImportMetadata
+	// or *ScriptExecutionMetadata
+	Metadata interface {
+		isMetadata()
+	}
+
+	// IndexBuildMetadata is a metadata of index build operation
+	IndexBuildMetadata struct {
+		// Path is a path of indexed table
+		Path string
+
+		// Index is a name of building index
+		Index string
+
+		State IndexBuildState
+
+		// Progress is a percent of build progress in range [0, 100]
+		Progress float32
+	}
+
+	// IndexBuildState is a state of index build operation
+	IndexBuildState int
+
+	// ExportMetadata is a metadata of export operation
+	ExportMetadata struct {
+		Progress BackupProgress
+
+		// Items contains progress of export items in order of items in export settings
+		Items []BackupItemProgress
+	}
+
+	// ImportMetadata is a metadata of import operation
+	ImportMetadata struct {
+		Progress BackupProgress
+
+		// Items contains progress of import items in order of items in import settings
+		Items []BackupItemProgress
+	}
+
+	// ScriptExecutionMetadata is a metadata of script execution operation (see query.Client.ExecuteScript)
+	ScriptExecutionMetadata struct {
+		ExecutionID string
+
+		Status ScriptExecutionStatus
+
+		// ResultSetsCount is a number of result sets of script for query.Client.FetchScriptResults
+		ResultSetsCount int
+	}
+
+	// ScriptExecutionStatus is a status of script execution
+	ScriptExecutionStatus int
+
+	// BackupProgress is a stage of export or import operation
+	BackupProgress int
+
+	// BackupItemProgress is a progress of single item of export or import operation
+	BackupItemProgress struct {
+		PartsTotal     uint32
+		PartsCompleted uint32
+		StartTime      time.Time
+		EndTime        time.Time
+	}
+
+	// ListResult is a page of operations
+	ListResult struct {
+		Operations    []*Operation
+		NextPageToken string
+	}
+)
+
+const (
+	KindBuildIndex   = Kind("buildindex")
+	KindExportToS3   = Kind("export/s3")
+	KindExportToYt   = Kind("export/yt")
+	KindImportFromS3 = Kind("import/s3")
+	KindScriptExec   = Kind("scriptexec")
+)
+
+const (
+	IndexBuildStateUnspecified = IndexBuildState(iota)
+	IndexBuildStatePreparing
+	IndexBuildStateTransferringData
+	IndexBuildStateApplying
+	IndexBuildStateDone
+	IndexBuildStateCancellation
+	IndexBuildStateCancelled
+	IndexBuildStateRejection
+	IndexBuildStateRejected
+)
+
+func (s IndexBuildState) String() string {
+	switch s {
+	case IndexBuildStateUnspecified:
+		return "unspecified"
+	case IndexBuildStatePreparing:
+		return "preparing"
+	case IndexBuildStateTransferringData:
+		return "transferring_data"
+	case IndexBuildStateApplying:
+		return "applying"
+	case IndexBuildStateDone:
+		return "done"
+	case IndexBuildStateCancellation:
+		return "cancellation"
+	case IndexBuildStateCancelled:
+		return "cancelled"
+	case IndexBuildStateRejection:
+		return "rejection"
+	case IndexBuildStateRejected:
+		return "rejected"
+	default:
+		return fmt.Sprintf("IndexBuildState(%d)", int(s))
+	}
+}
+
+const (
+	BackupProgressUnspecified = BackupProgress(iota)
+	BackupProgressPreparing
+	BackupProgressTransferData
+	BackupProgressBuildIndexes // import only
+	BackupProgressDone
+	BackupProgressCancellation
+	BackupProgressCancelled
+)
+
+func (p BackupProgress) String() string {
+	switch p {
+	case BackupProgressUnspecified:
+		return "unspecified"
+	case BackupProgressPreparing:
+		return "preparing"
+	case BackupProgressTransferData:
+		return "transfer_data"
+	case BackupProgressBuildIndexes:
+		return "build_indexes"
+	case BackupProgressDone:
+		return "done"
+	case BackupProgressCancellation:
+		return "cancellation"
+	case BackupProgressCancelled:
+		return "cancelled"
+	default:
+		return fmt.Sprintf("BackupProgress(%d)", int(p))
+	}
+}
+
+const (
+	ScriptExecutionStatusUnspecified = ScriptExecutionStatus(0)
+	ScriptExecutionStatusStarting    = ScriptExecutionStatus(10)
+	ScriptExecutionStatusAborted     = ScriptExecutionStatus(20)
+	ScriptExecutionStatusCancelled   = ScriptExecutionStatus(30)
+	ScriptExecutionStatusCompleted   = ScriptExecutionStatus(40)
+	ScriptExecutionStatusFailed      = ScriptExecutionStatus(50)
+)
+
+func (s ScriptExecutionStatus) String() string {
+	switch s {
+	case ScriptExecutionStatusUnspecified:
+		return "unspecified"
+	case ScriptExecutionStatusStarting:
+		return "starting"
+	case ScriptExecutionStatusAborted:
+		return "aborted"
+	case ScriptExecutionStatusCancelled:
+		return "cancelled"
+	case ScriptExecutionStatusCompleted:
+		return "completed"
+	case ScriptExecutionStatusFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("ScriptExecutionStatus(%d)", int(s))
+	}
+}
+
+func (*IndexBuildMetadata) isMetadata() {}
+
+func (*ExportMetadata) isMetadata() {}
+
+func (*ImportMetadata) isMetadata() {}
+
+func (*ScriptExecutionMetadata) isMetadata() {}
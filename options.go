@@ -2,8 +2,10 @@ package ydb
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -17,6 +19,7 @@ import (
 	coordinationConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/coordination/config"
 	discoveryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/discovery/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/dsn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
 	queryConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/query/config"
 	ratelimiterConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/ratelimiter/config"
 	schemeConfig "github.com/ydb-platform/ydb-go-sdk/v3/internal/scheme/config"
@@ -39,6 +42,8 @@ func WithStaticCredentials(user, password string) Option {
 			User:     user,
 			Password: password,
 		}
+		// credentials of last option are used
+		c.oauth2Options = nil
 
 		return nil
 	}
@@ -58,25 +63,84 @@ func WithAccessTokenCredentials(accessToken string) Option {
 // WithOauth2TokenExchangeCredentials adds credentials that exchange token using
 // OAuth 2.0 token exchange protocol:
 // https://www.rfc-editor.org/rfc/rfc8693
+//
+// Credentials are created on connect. User-Agent of token exchange requests
+// contains application name and sdk build info of driver if credentials.WithUserAgent is not provided.
+// Next credentials options (such as WithCredentials) override OAuth 2.0 token exchange credentials
 func WithOauth2TokenExchangeCredentials(
 	opts ...credentials.Oauth2TokenExchangeCredentialsOption,
 ) Option {
 	opts = append(opts, credentials.WithSourceInfo("ydb.WithOauth2TokenExchangeCredentials(opts)"))
 
-	return WithCreateCredentialsFunc(func(context.Context) (credentials.Credentials, error) {
-		return credentials.NewOauth2TokenExchangeCredentials(opts...)
-	})
+	return func(ctx context.Context, c *Driver) error {
+		c.oauth2Options = opts
+
+		return nil
+	}
 }
 
 // WithApplicationName add provided application name to all api requests
+//
+// Application name must contains only printable ASCII characters and be no longer than 128 characters
 func WithApplicationName(applicationName string) Option {
 	return func(ctx context.Context, c *Driver) error {
+		if err := meta.ValidateHeaderValue("application name", applicationName); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
 		c.options = append(c.options, config.WithApplicationName(applicationName))
 
 		return nil
 	}
 }
 
+// WithSDKBuildInfoSuffix appends provided suffix to sdk build info (x-ydb-sdk-build-info header)
+// which sends with all api requests
+//
+// Suffix must contains only printable ASCII characters and be no longer than 128 characters.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSDKBuildInfoSuffix(suffix string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		if err := meta.ValidateHeaderValue("sdk build info suffix", suffix); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		c.options = append(c.options, config.WithSDKBuildInfoSuffix(suffix))
+
+		return nil
+	}
+}
+
+// WithSDKBuildInfoExtra appends provided extra part (such as internal build id) to sdk build info
+// (x-ydb-sdk-build-info header) which sends with all api requests. Extra parts are separated with ';'
+// and follow suffix of WithSDKBuildInfoSuffix
+//
+// Extra part must contains only printable ASCII characters and be no longer than 128 characters.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSDKBuildInfoExtra(extra string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		if err := meta.ValidateHeaderValue("sdk build info extra", extra); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		c.options = append(c.options, config.WithSDKBuildInfoExtra(extra))
+
+		return nil
+	}
+}
+
+// WithDisableSDKBuildInfoHeader disables sending of sdk build info (x-ydb-sdk-build-info header) with
+// unary and streaming api requests over all connections. Header which is set explicitly in outgoing
+// metadata of context is sent as is. User agent of http-based flows (such as credentials) is not changed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDisableSDKBuildInfoHeader() Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithDisableSDKBuildInfoHeader())
+
+		return nil
+	}
+}
+
 // WithUserAgent add provided user agent value to all api requests
 //
 // Deprecated: use WithApplicationName instead.
@@ -228,6 +292,8 @@ func WithCreateCredentialsFunc(createCredentials func(ctx context.Context) (cred
 			return xerrors.WithStackTrace(err)
 		}
 		c.options = append(c.options, config.WithCredentials(creds))
+		// credentials of last option are used
+		c.oauth2Options = nil
 
 		return nil
 	}
@@ -249,7 +315,126 @@ func WithBalancer(balancer *balancerConfig.Config) Option {
 	}
 }
 
-// WithDialTimeout sets timeout for establishing new Driver to cluster
+// WithMaxInFlightPerEndpoint limits number of in-flight unary calls and opened streams per endpoint.
+// Stream counts as one in-flight call until it is closed.
+//
+// Balancer prefers endpoints below limit. If all endpoints reached limit, call waits until some
+// in-flight call of any endpoint is done or until context is done. Calls bound to endpoint
+// (such as calls of table session) are not redirected to other endpoints and are not limited.
+// Limit is soft: concurrent calls can slightly exceed it.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxInFlightPerEndpoint(n int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithMaxInFlightPerEndpoint(n))
+
+		return nil
+	}
+}
+
+// WithMaxInFlightFailFast makes calls fail with retryable error instead of waiting
+// when all endpoints reached limit of WithMaxInFlightPerEndpoint
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxInFlightFailFast() Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithMaxInFlightFailFast())
+
+		return nil
+	}
+}
+
+// WithStreamMessageSizeSampling reports size of every n-th message of streams to
+// trace.Driver.OnConnStreamMessageSize. Sampling avoids overhead of trace calls for every message
+// of long streams. Zero n disables reporting of sizes of stream messages.
+//
+// Default sampling is config.DefaultStreamMessageSizeSampling.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStreamMessageSizeSampling(n int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithStreamMessageSizeSampling(n))
+
+		return nil
+	}
+}
+
+// WithStreamInactivityTimeout cancels read table, scan query and topic streams which received
+// no messages during timeout while stream read is waiting. Other streams (such as attach session
+// stream of query service and coordination session streams) are idle by design and are not checked.
+// Canceled stream returns transport error ErrStreamInactivityTimeout which is retryable for idempotent
+// operations, topic readers and writers reconnect after such error. Cancellation of stream is reported to
+// trace.Driver.OnConnStreamInactivityTimeout with time of the last activity of stream.
+// Timeout of table and topic streams may be overridden with WithTableStreamInactivityTimeout and
+// WithTopicStreamInactivityTimeout.
+//
+// By default inactivity of streams is not checked.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStreamInactivityTimeout(timeout time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithStreamInactivityTimeout(timeout))
+
+		return nil
+	}
+}
+
+// WithTableStreamInactivityTimeout overrides WithStreamInactivityTimeout for read table and scan query
+// streams of table.Client. Zero timeout disables checking of inactivity of table streams
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTableStreamInactivityTimeout(timeout time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithStreamInactivityTimeout(timeout))
+
+		return nil
+	}
+}
+
+// WithTopicStreamInactivityTimeout overrides WithStreamInactivityTimeout for streams of topic readers
+// and writers (see topicoptions.WithStreamInactivityTimeout)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTopicStreamInactivityTimeout(timeout time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.topicOptions = append(c.topicOptions, topicoptions.WithStreamInactivityTimeout(timeout))
+
+		return nil
+	}
+}
+
+// WithGrpcMaxRecvMsgSize limits size of grpc messages received by every connection of driver
+// (including connections which are re-created by balancer). Limit of received message must be greater
+// than size of largest result part (such as part of scan query result or read rows response).
+// Non-positive n resets limit to default.
+//
+// Default limit is config.DefaultGRPCMsgSize.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithGrpcMaxRecvMsgSize(n int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithGrpcMaxRecvMsgSize(n))
+
+		return nil
+	}
+}
+
+// WithGrpcMaxSendMsgSize limits size of grpc messages sent by every connection of driver
+// (including connections which are re-created by balancer). Non-positive n resets limit to default.
+//
+// Default limit is config.DefaultGRPCMsgSize.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithGrpcMaxSendMsgSize(n int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithGrpcMaxSendMsgSize(n))
+
+		return nil
+	}
+}
+
+// WithDialTimeout sets timeout of every attempt of connecting to endpoint (discovery endpoint
+// and discovered endpoints). Timeout bounds each attempt, not the whole establishing of Driver
 //
 // Default dial timeout is config.DefaultDialTimeout
 func WithDialTimeout(timeout time.Duration) Option {
@@ -260,6 +445,52 @@ func WithDialTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithDefaultOperationTimeout sets default client-side timeout of calls (data queries,
+// transactions and other unary requests, queries of query service)
+//
+// Timeout of call is chosen with precedence:
+//   - per-call operation timeout (ydb.WithOperationTimeout or ydb.WithOperationCancelAfter) disables
+//     default timeouts
+//   - deadline of context of call
+//   - default timeout of request type (ydb.WithDefaultScanQueryTimeout, ydb.WithDefaultSchemeTimeout)
+//   - default operation timeout
+//
+// Effective deadline is available in trace.Driver.OnConnInvoke and trace.Driver.OnConnNewStream events.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDefaultOperationTimeout(timeout time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithDefaultOperationTimeout(timeout))
+
+		return nil
+	}
+}
+
+// WithDefaultScanQueryTimeout sets default client-side timeout of scan query streams.
+// See ydb.WithDefaultOperationTimeout for precedence of timeouts
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDefaultScanQueryTimeout(timeout time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithDefaultScanQueryTimeout(timeout))
+
+		return nil
+	}
+}
+
+// WithDefaultSchemeTimeout sets default client-side timeout of scheme calls (create, alter, drop
+// and describe of tables and topics, calls of scheme service).
+// See ydb.WithDefaultOperationTimeout for precedence of timeouts
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDefaultSchemeTimeout(timeout time.Duration) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithDefaultSchemeTimeout(timeout))
+
+		return nil
+	}
+}
+
 // With collects additional configuration options.
 //
 // This option does not replace collected option, instead it will append provided options.
@@ -306,6 +537,29 @@ func WithRetryBudget(b budget.Budget) Option {
 	}
 }
 
+// WithQueryTextRedactor sets redactor of query text, which applies to query text before it put into traces
+// (and logs based on traces) of table, query, scripting and database/sql clients.
+// Redactor must be fast and deterministic. By default query text is not redacted.
+// RedactAll can be used as redactor for replace query text with hash of text
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryTextRedactor(redactor func(query string) string) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.options = append(c.options, config.WithQueryTextRedactor(redactor))
+
+		return nil
+	}
+}
+
+// RedactAll replaces query text with hash of text. Hash allows to match same queries in traces and logs
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func RedactAll(query string) string {
+	hash := sha256.Sum256([]byte(query))
+
+	return "<redacted:" + hex.EncodeToString(hash[:8]) + ">" //nolint:gomnd
+}
+
 // WithTraceDriver appends trace.Driver into driver traces
 func WithTraceDriver(t trace.Driver, opts ...trace.DriverComposeOption) Option { //nolint:gocritic
 	return func(ctx context.Context, c *Driver) error {
@@ -459,6 +713,63 @@ func WithSessionPoolDeleteTimeout(deleteTimeout time.Duration) Option {
 	}
 }
 
+// WithSessionPoolWarmUp defines number of sessions which table.Client creates in background after driver open.
+// Creation of sessions has bounded concurrency and is retried with backoff until success or close of driver.
+// Number of warmed up sessions is limited by WithSessionPoolSizeLimit.
+// Use table.WaitUntilWarm(ctx, Table(), n) for waiting of warmed up sessions
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionPoolWarmUp(n int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithWarmUp(n))
+
+		return nil
+	}
+}
+
+// WithMaxQueryTextSize defines limit of size of query text in bytes for Execute, Prepare and scan queries
+// of table.Client and database/sql driver (after generation of parameters declarations).
+// Query with larger text is rejected with table.ErrQueryTextTooLarge without sending to server.
+// By default limit is 10 MiB. Zero or negative value disables the check.
+// Limit can be overridden for single call with options.WithMaxQueryTextSize
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxQueryTextSize(n int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithMaxQueryTextSize(n))
+
+		return nil
+	}
+}
+
+// WithWarningHandler defines handler of non-fatal issues (such as warnings about type coercion) which server
+// attaches to successful responses of Execute, CommitTx and ExecuteSchemeQuery of table.Client.
+// Handler is called only if response has issues. Issues are also available with result.Result.Issues and
+// are reported with trace.Table.OnSessionQueryWarnings with id of session and fingerprint of query
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWarningHandler(handler func(ctx context.Context, issues []Issue)) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithWarningHandler(handler))
+
+		return nil
+	}
+}
+
+// WithSessionPoolMaxConsecutiveErrors defines number of consecutive retryable errors of session
+// after which session is deleted instead of returning to pool of table.Client.
+// Such "poisoned" session fails every request, but server does not report about invalid session.
+// Zero value (by default) disables quarantine of sessions
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionPoolMaxConsecutiveErrors(n int) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithMaxConsecutiveErrors(n))
+
+		return nil
+	}
+}
+
 // WithSessionPoolKeepAliveMinSize set minimum sessions should be keeped alive in table.Client
 //
 // Deprecated: use WithApplicationName instead.
@@ -486,6 +797,40 @@ func WithIgnoreTruncated() Option {
 	}
 }
 
+// WithDefaultQueryCachePolicy defines keep-in-cache flag of server query cache policy for data queries of table
+// client without explicit options.WithKeepInCache. By default, only queries with parameters are kept in cache.
+// Query service client caches compiled queries on server side without query cache policy
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDefaultQueryCachePolicy(keepInCache bool) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithKeepInCache(keepInCache))
+
+		return nil
+	}
+}
+
+// WithStrictNamedScan enables errors on columns of table result set row which were not scanned by ScanNamed
+func WithStrictNamedScan() Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithStrictNamedScan())
+
+		return nil
+	}
+}
+
+// WithZeroStructForNull enables scanning of NULL struct values of table result sets into zero-valued
+// go structs with ScanWithDefaults. By default ScanWithDefaults returns error on NULL struct
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithZeroStructForNull() Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.tableOptions = append(c.tableOptions, tableConfig.WithZeroStructForNull())
+
+		return nil
+	}
+}
+
 // WithPanicCallback specified behavior on panic
 // Warning: WithPanicCallback must be defined on start of all options
 // (before `WithTrace{Driver,Table,Scheme,Scripting,Coordination,Ratelimiter}` and other options)
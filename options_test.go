@@ -0,0 +1,61 @@
+package ydb //nolint:testpackage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+)
+
+func TestOauth2TokenExchangeCredentialsOptionsOrder(t *testing.T) {
+	oauth2 := WithOauth2TokenExchangeCredentials(
+		credentials.WithTokenEndpoint("http://localhost:8080/token"),
+		credentials.WithSubjectToken(credentials.NewFixedTokenSource("test", "urn:ietf:params:oauth:token-type:test")),
+	)
+	accessToken := credentials.NewAccessTokenCredentials("token")
+	for _, tt := range []struct {
+		name   string
+		opts   []Option
+		oauth2 bool
+	}{
+		{
+			name:   "Oauth2Only",
+			opts:   []Option{oauth2},
+			oauth2: true,
+		},
+		{
+			name:   "CredentialsAfterOauth2",
+			opts:   []Option{oauth2, WithCredentials(accessToken)},
+			oauth2: false,
+		},
+		{
+			name:   "Oauth2AfterCredentials",
+			opts:   []Option{WithCredentials(accessToken), oauth2},
+			oauth2: true,
+		},
+		{
+			name:   "StaticCredentialsAfterOauth2",
+			opts:   []Option{oauth2, WithStaticCredentials("user", "password")},
+			oauth2: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Driver{}
+			for _, opt := range tt.opts {
+				require.NoError(t, opt(context.Background(), d))
+			}
+			if tt.oauth2 {
+				// OAuth 2.0 token exchange credentials are created on connect over credentials of other options
+				require.NotEmpty(t, d.oauth2Options)
+			} else {
+				require.Empty(t, d.oauth2Options)
+			}
+			if !tt.oauth2 && d.userInfo == nil {
+				require.Equal(t, accessToken, config.New(d.options...).Credentials())
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package ydb
 
-import "github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
 
 // ParamsBuilder used for create query arguments instead of tons options.
 //
@@ -8,3 +12,22 @@ import "github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 func ParamsBuilder() params.Builder {
 	return params.Builder{}
 }
+
+// ParamsFromMap makes query parameters of declared types from JSON-decoded values
+// (float64, json.Number, string, bool, nil, []any, map[string]any).
+// Names of parameters in m and declared may be with or without leading '$'.
+// nil value or omitted parameter of Optional type is NULL. Integers are range-checked and may be
+// provided as strings to keep precision, Date/Datetime/Timestamp are RFC 3339 strings, Interval is
+// duration string, Struct and Dict are objects, List, Set and Tuple are arrays.
+// Returned error wraps table.ErrParamsMismatch and describes each mismatched parameter
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParamsFromMap(m map[string]any, declared map[string]types.Type) (*table.QueryParameters, error) {
+	return params.FromMap(m, declared)
+}
+
+// ValueMapper is an interface of custom Go types (such as enums) which control their encoding into query
+// parameters and decoding with Scan, ScanNamed and ScanStruct (see types.ValueMapper and types.NewEnumMapper)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ValueMapper = types.ValueMapper
@@ -0,0 +1,98 @@
+package ydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var (
+	// ErrPingAccessDenied returns from Driver.Ping on authentication or authorization errors
+	// client side must check error with errors.Is
+	ErrPingAccessDenied = xerrors.Wrap(errors.New("ydb: ping access denied"))
+
+	// ErrPingTransport returns from Driver.Ping on transport (grpc) errors
+	// client side must check error with errors.Is
+	ErrPingTransport = xerrors.Wrap(errors.New("ydb: ping transport error"))
+)
+
+type (
+	pingOptions struct {
+		withIdleSession bool
+	}
+
+	// PingOption is an option for Driver.Ping
+	PingOption func(o *pingOptions)
+)
+
+// WithPingIdleSession makes Driver.Ping to execute `SELECT 1` query with idle session of table client pool.
+// New session is not created: if pool have no idle sessions Driver.Ping makes discovery WhoAmI call
+func WithPingIdleSession() PingOption {
+	return func(o *pingOptions) {
+		o.withIdleSession = true
+	}
+}
+
+// Ping makes single lightweight call to database (discovery WhoAmI by default) without retries.
+// Ping may be used for readiness probes of applications.
+//
+// Errors of authentication and authorization are marked by ErrPingAccessDenied,
+// transport errors are marked by ErrPingTransport
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Ping(ctx context.Context, opts ...PingOption) (finalErr error) {
+	var options pingOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	defer func() {
+		finalErr = pingError(finalErr)
+	}()
+
+	if options.withIdleSession {
+		pinged, err := d.table.Get().PingWithIdleSession(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if pinged {
+			return nil
+		}
+	}
+
+	_, err := d.discovery.Get().WhoAmI(ctx)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func pingError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case credentials.IsAccessError(err):
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %w", ErrPingAccessDenied, err))
+	case xerrors.IsTransportError(err):
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %w", ErrPingTransport, err))
+	default:
+		return err
+	}
+}
+
+// Ready reports whether driver have at least one available connection to database.
+// Ready reflects state of balancer and does not make any I/O
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) Ready() bool {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+
+	return d.balancer != nil && d.balancer.Ready()
+}
@@ -0,0 +1,53 @@
+package ydb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+func TestPingError(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		err  error
+		mark error
+	}{
+		{
+			name: "Unauthenticated",
+			err:  xerrors.Transport(grpcStatus.Error(grpcCodes.Unauthenticated, "")),
+			mark: ErrPingAccessDenied,
+		},
+		{
+			name: "Unauthorized",
+			err:  xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAUTHORIZED)),
+			mark: ErrPingAccessDenied,
+		},
+		{
+			name: "Unavailable",
+			err:  xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")),
+			mark: ErrPingTransport,
+		},
+		{
+			name: "Other",
+			err:  errors.New("test"),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pingError(tt.err)
+			require.ErrorIs(t, err, tt.err)
+			if tt.mark != nil {
+				require.ErrorIs(t, err, tt.mark)
+			} else {
+				require.NotErrorIs(t, err, ErrPingAccessDenied)
+				require.NotErrorIs(t, err, ErrPingTransport)
+			}
+		})
+	}
+	require.NoError(t, pingError(nil))
+}
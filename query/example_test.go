@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
 	"github.com/ydb-platform/ydb-go-sdk/v3/query"
 )
 
@@ -196,3 +198,76 @@ func Example_txSelect() {
 	}
 	fmt.Printf("id=%v, myStr='%s'\n", id, myStr)
 }
+
+func Example_executeScript() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		fmt.Printf("failed connect: %v", err)
+
+		return
+	}
+	defer db.Close(ctx) // cleanup resources
+
+	// first process starts script and exits without waiting of script completion
+	op, err := query.ExecuteScript(ctx, db.Query(),
+		`SELECT 42 as id, "my string" as myStr`,
+		time.Hour, // results of script are persisted on server during ttl
+	)
+	if err != nil {
+		fmt.Printf("failed start script: %v", err)
+
+		return
+	}
+	// operation id must be passed to another process (such as over message queue or database row)
+	operationID := op.ID
+
+	// second process awaits script completion and fetches results by operation id
+	finished, err := db.Operation().Wait(ctx, operationID, time.Second)
+	if err != nil {
+		fmt.Printf("script failed: %v", err)
+
+		return
+	}
+	metadata, _ := finished.Metadata.(*operation.ScriptExecutionMetadata)
+	for i := 0; metadata != nil && i < metadata.ResultSetsCount; i++ {
+		var fetchToken string
+		for { // iterate over pages of result set
+			r, err := query.FetchScriptResults(ctx, db.Query(), operationID,
+				query.WithResultSetIndex(int64(i)),
+				query.WithRowsLimit(1000),
+				query.WithFetchToken(fetchToken),
+			)
+			if err != nil {
+				fmt.Printf("failed fetch results: %v", err)
+
+				return
+			}
+			for { // iterate over rows of page
+				row, err := r.ResultSet.NextRow(ctx)
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					fmt.Printf("failed read row: %v", err)
+
+					return
+				}
+				var (
+					id    int32
+					myStr string
+				)
+				if err = row.Scan(&id, &myStr); err != nil {
+					fmt.Printf("failed scan row: %v", err)
+
+					return
+				}
+				fmt.Printf("id=%v, myStr='%s'\n", id, myStr)
+			}
+			if r.NextFetchToken == "" {
+				break
+			}
+			fetchToken = r.NextFetchToken
+		}
+	}
+}
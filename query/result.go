@@ -16,6 +16,27 @@ type (
 	}
 	ResultSet interface {
 		NextRow(ctx context.Context) (Row, error)
+
+		// Index returns index of result set in the result
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		Index() int
+
+		// RowCount returns number of rows in the current part of result set.
+		// Use TotalRowsScanned() for running counter of rows of all received parts
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		RowCount() int
+
+		// TotalRowsScanned returns number of rows of all parts of result set received up to and including current one
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		TotalRowsScanned() int
+
+		// Truncated returns true if current part of result set has been truncated by server
+		//
+		// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+		Truncated() bool
 	}
 	Row interface {
 		Scan(dst ...interface{}) error
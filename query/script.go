@@ -0,0 +1,94 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/query/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+type (
+	// ScriptOperation is a started long-running execution of script
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptOperation struct {
+		// ID is an identifier of operation for operation client and FetchScriptResults
+		ID string
+
+		// ExecutionID is an identifier of script execution on server
+		ExecutionID string
+	}
+
+	// ScriptResult is a page of persisted result set of script execution
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ScriptResult struct {
+		ResultSetIndex int64
+		ResultSet      ResultSet
+
+		// NextFetchToken is a token for fetch of next page with WithFetchToken, empty for last page
+		NextFetchToken string
+	}
+)
+
+// WithResultSetIndex selects result set of script for FetchScriptResults
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithResultSetIndex(index int64) options.FetchScriptOption {
+	return options.WithResultSetIndex(index)
+}
+
+// WithRowsLimit limits number of rows of page for FetchScriptResults
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithRowsLimit(limit int64) options.FetchScriptOption {
+	return options.WithRowsLimit(limit)
+}
+
+// WithFetchToken continues FetchScriptResults from page of token (NextFetchToken of previous page)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFetchToken(token string) options.FetchScriptOption {
+	return options.WithFetchToken(token)
+}
+
+// ExecuteScript starts long-running execution of script with client c and returns operation of execution
+// without waiting of script completion. Results of script are persisted on server for ttl (zero ttl means
+// server default) and can be fetched with FetchScriptResults by operation id from any process.
+// Operation of script can be awaited with operation client (such as db.Operation().Wait).
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ExecuteScript(
+	ctx context.Context, c Client, query string, ttl time.Duration, opts ...options.ExecuteScriptOption,
+) (*ScriptOperation, error) {
+	if cc, has := c.(interface {
+		ExecuteScript(
+			ctx context.Context, query string, ttl time.Duration, opts ...options.ExecuteScriptOption,
+		) (*ScriptOperation, error)
+	}); has {
+		return cc.ExecuteScript(ctx, query, ttl, opts...)
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("client %T not supported execution of scripts", c))
+}
+
+// FetchScriptResults fetches page of persisted results of script execution with operation id.
+// Options WithResultSetIndex, WithRowsLimit and WithFetchToken select result set, size of page and page
+// of result set. Empty NextFetchToken of result means last page of result set.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func FetchScriptResults(
+	ctx context.Context, c Client, operationID string, opts ...options.FetchScriptOption,
+) (*ScriptResult, error) {
+	if cc, has := c.(interface {
+		FetchScriptResults(
+			ctx context.Context, operationID string, opts ...options.FetchScriptOption,
+		) (*ScriptResult, error)
+	}); has {
+		return cc.FetchScriptResults(ctx, operationID, opts...)
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("client %T not supported fetching of script results", c))
+}
@@ -1,8 +1,69 @@
 package ratelimiter
 
+import (
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// AcquireError is an error of rejected acquire request. Acquire request is rejected if the requested amount of units
+// is not available before the operation timeout or if server responds with gRPC code RESOURCE_EXHAUSTED
 type AcquireError interface {
 	error
 
+	// Amount returns requested amount of units. Server does not report available amount of units on rejection, use
+	// Client.DescribeResource for getting limits of resource
 	Amount() uint64
 	Unwrap() error
 }
+
+// IsAcquireError checks whether given err is a rejection of acquire request
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func IsAcquireError(err error) bool {
+	return ToAcquireError(err) != nil
+}
+
+// ToAcquireError casts given err to AcquireError. If given err is not an acquire error - returns nil
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ToAcquireError(err error) AcquireError {
+	var ae AcquireError
+	if err != nil && xerrors.As(err, &ae) {
+		return ae
+	}
+
+	return nil
+}
+
+// IsResourceNotFoundError checks whether given err reports that the coordination node or the resource does not exist
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func IsResourceNotFoundError(err error) bool {
+	return xerrors.IsOperationError(err, Ydb.StatusIds_NOT_FOUND)
+}
+
+// IsResourceExhaustedError checks whether given err reports that the quota of resource is exhausted:
+// acquire request is rejected (see AcquireError) or server responds with gRPC code RESOURCE_EXHAUSTED
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func IsResourceExhaustedError(err error) bool {
+	return IsAcquireError(err) || xerrors.IsTransportError(err, grpcCodes.ResourceExhausted)
+}
+
+var (
+	// ErrInvalidResource is returned by Client.CreateResource and Client.AlterResource if path or settings of resource
+	// are invalid, such as negative speed limit
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ErrInvalidResource = xerrors.Wrap(errors.New("invalid ratelimiter resource"))
+
+	// ErrParentResourceNotFound is returned by Client.CreateResource if parent resource of created resource
+	// does not exist. Parent resources must be created before their children
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ErrParentResourceNotFound = xerrors.Wrap(errors.New("parent ratelimiter resource not found"))
+)
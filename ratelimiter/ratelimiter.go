@@ -51,6 +51,14 @@ func WithReport() options.AcquireOption {
 	return options.WithReport()
 }
 
+// WithAcquireRetry makes AcquireResource retry rejected acquire requests with slow backoff until the ctx is done.
+// Without this option rejected acquire request returns AcquireError immediately
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAcquireRetry() options.AcquireOption {
+	return options.WithRetry()
+}
+
 func WithOperationTimeout(operationTimeout time.Duration) options.AcquireOption {
 	return options.WithOperationTimeout(operationTimeout)
 }
@@ -1,5 +1,14 @@
 package ratelimiter
 
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
 type Resource struct {
 	ResourcePath    string
 	HierarchicalDrr HierarchicalDrrSettings
@@ -11,3 +20,132 @@ type HierarchicalDrrSettings struct {
 	PrefetchCoefficient     float64
 	PrefetchWatermark       float64
 }
+
+// NewResource makes resource with given path inside coordination node. Elements of path are separated by slash,
+// the first element is a name of root resource. Zero settings are not set, so server uses defaults or inherits
+// settings of parent resource. Settings are changed with builder methods:
+//
+//	ratelimiter.NewResource("root/child").WithMaxUnitsPerSecond(100).WithMaxBurstSizeCoefficient(2)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewResource(resourcePath string) Resource {
+	return Resource{
+		ResourcePath: resourcePath,
+	}
+}
+
+// WithMaxUnitsPerSecond returns copy of resource with given speed limit of resource consumption.
+// Speed limit is required for root resource and must be nonnegative
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r Resource) WithMaxUnitsPerSecond(maxUnitsPerSecond float64) Resource {
+	r.HierarchicalDrr.MaxUnitsPerSecond = maxUnitsPerSecond
+
+	return r
+}
+
+// WithMaxBurstSizeCoefficient returns copy of resource with given maximum burst size of resource consumption
+// divided by max units per second. Coefficient must be nonnegative, server default is 1
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r Resource) WithMaxBurstSizeCoefficient(maxBurstSizeCoefficient float64) Resource {
+	r.HierarchicalDrr.MaxBurstSizeCoefficient = maxBurstSizeCoefficient
+
+	return r
+}
+
+// WithPrefetchCoefficient returns copy of resource which prefetches in local bucket up to
+// prefetchCoefficient*maxUnitsPerSecond units. Negative coefficient disables prefetching
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r Resource) WithPrefetchCoefficient(prefetchCoefficient float64) Resource {
+	r.HierarchicalDrr.PrefetchCoefficient = prefetchCoefficient
+
+	return r
+}
+
+// WithPrefetchWatermark returns copy of resource which starts prefetching if there is less than
+// prefetchWatermark fraction of full local bucket left. Watermark must be in range [0, 1]
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r Resource) WithPrefetchWatermark(prefetchWatermark float64) Resource {
+	r.HierarchicalDrr.PrefetchWatermark = prefetchWatermark
+
+	return r
+}
+
+// Validate checks path and settings of resource on client side.
+// Client.CreateResource and Client.AlterResource reject invalid resource with ErrInvalidResource
+// without request to server
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r Resource) Validate() error {
+	if r.ResourcePath == "" || strings.HasPrefix(r.ResourcePath, "/") {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: path %q must be non-empty and must not start with slash",
+			ErrInvalidResource, r.ResourcePath,
+		))
+	}
+	settings := r.HierarchicalDrr
+	for _, s := range []struct {
+		name string
+		v    float64
+	}{
+		{"max units per second", settings.MaxUnitsPerSecond},
+		{"max burst size coefficient", settings.MaxBurstSizeCoefficient},
+		{"prefetch watermark", settings.PrefetchWatermark},
+	} {
+		if s.v < 0 || math.IsNaN(s.v) || math.IsInf(s.v, 0) {
+			return xerrors.WithStackTrace(fmt.Errorf("%w: %s of %q must be nonnegative, got %v",
+				ErrInvalidResource, s.name, r.ResourcePath, s.v,
+			))
+		}
+	}
+	if math.IsNaN(settings.PrefetchCoefficient) || math.IsInf(settings.PrefetchCoefficient, 0) {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: prefetch coefficient of %q must be finite, got %v",
+			ErrInvalidResource, r.ResourcePath, settings.PrefetchCoefficient,
+		))
+	}
+	if settings.PrefetchWatermark > 1 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: prefetch watermark of %q must be less than or equal to 1, got %v",
+			ErrInvalidResource, r.ResourcePath, settings.PrefetchWatermark,
+		))
+	}
+
+	return nil
+}
+
+// ParentPath returns path of parent resource or empty string for root resource
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r Resource) ParentPath() string {
+	if i := strings.LastIndex(r.ResourcePath, "/"); i > 0 {
+		return r.ResourcePath[:i]
+	}
+
+	return ""
+}
+
+// ListResourceSettings returns resources with their settings under given resourcePath of coordination node.
+// Empty resourcePath lists root resources, recursive lists all descendants of resources
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ListResourceSettings(
+	ctx context.Context,
+	c Client,
+	coordinationNodePath string,
+	resourcePath string,
+	recursive bool,
+) ([]Resource, error) {
+	if cc, has := c.(interface {
+		ListResourceSettings(
+			ctx context.Context,
+			coordinationNodePath string,
+			resourcePath string,
+			recursive bool,
+		) (_ []Resource, err error)
+	}); has {
+		return cc.ListResourceSettings(ctx, coordinationNodePath, resourcePath, recursive)
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("client %T not supported listing of resource settings", c))
+}
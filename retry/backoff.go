@@ -6,11 +6,24 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 )
 
-// Backoff makes backoff object with custom params
-func Backoff(slotDuration time.Duration, ceiling uint, jitterLimit float64) backoff.Backoff {
-	return backoff.New(
+// BackoffOption is an additional option of Backoff
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type BackoffOption = backoff.Option
+
+// WithBackoffMaxDelay limits delay of backoff regardless of attempt and ceiling, limit is applied after jitter
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithBackoffMaxDelay(maxDelay time.Duration) BackoffOption {
+	return backoff.WithMaxDelay(maxDelay)
+}
+
+// Backoff makes backoff object with custom params.
+// Delay(attempt) of returned backoff allows to precompute delays of attempts
+func Backoff(slotDuration time.Duration, ceiling uint, jitterLimit float64, opts ...BackoffOption) backoff.Backoff {
+	return backoff.New(append([]backoff.Option{
 		backoff.WithSlotDuration(slotDuration),
 		backoff.WithCeiling(ceiling),
 		backoff.WithJitterLimit(jitterLimit),
-	)
+	}, opts...)...)
 }
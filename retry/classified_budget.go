@@ -0,0 +1,181 @@
+package retry
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+)
+
+// BackoffType is a class of retryable errors by backoff which applies before the next attempt
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type BackoffType = backoff.Type
+
+// Classes of retryable errors
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+const (
+	BackoffTypeNoBackoff = backoff.TypeNoBackoff
+	BackoffTypeFast      = backoff.TypeFast
+	BackoffTypeSlow      = backoff.TypeSlow
+)
+
+// Limit defines the maximum frequency of retry attempts in attempts per second.
+// Semantic of Limit is the same as rate.Limit from golang.org/x/time/rate
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Limit float64
+
+// Inf is the infinite retry limit, it allows all retry attempts
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+const Inf = Limit(math.MaxFloat64)
+
+type (
+	classifiedBudget struct {
+		clock        clockwork.Clock
+		limiters     map[BackoffType]*limiter
+		unclassified *limiter
+	}
+	// ClassifiedBudgetOption is an option of ClassifiedBudget
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ClassifiedBudgetOption func(b *classifiedBudget)
+	limiter                struct {
+		mu     sync.Mutex
+		limit  Limit
+		burst  float64
+		tokens float64
+		last   time.Time
+		denied atomic.Uint64
+	}
+	backoffTypeCtxKey struct{}
+)
+
+var _ budget.Budget = (*classifiedBudget)(nil)
+
+// WithUnclassifiedLimit defines limit of retry attempts for errors with backoff type which not defined
+// in limits of ClassifiedBudget. By default retry attempts of unclassified errors are not limited
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithUnclassifiedLimit(limit Limit) ClassifiedBudgetOption {
+	return func(b *classifiedBudget) {
+		b.unclassified = newLimiter(limit)
+	}
+}
+
+func withClassifiedBudgetClock(clock clockwork.Clock) ClassifiedBudgetOption {
+	return func(b *classifiedBudget) {
+		b.clock = clock
+	}
+}
+
+// ClassifiedBudget makes retry budget which draws quota of retry attempt from limiter of the backoff type
+// of the error being retried. Limiters are token buckets with burst equals to one second of limit.
+// Retry attempt is denied (not waited) if limiter has no quota
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ClassifiedBudget(limits map[BackoffType]Limit, opts ...ClassifiedBudgetOption) *classifiedBudget {
+	b := &classifiedBudget{
+		clock:        clockwork.NewRealClock(),
+		limiters:     make(map[BackoffType]*limiter, len(limits)),
+		unclassified: newLimiter(Inf),
+	}
+	for t, limit := range limits {
+		b.limiters[t] = newLimiter(limit)
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(b)
+		}
+	}
+	now := b.clock.Now()
+	for _, l := range b.limiters {
+		l.last = now
+	}
+	b.unclassified.last = now
+
+	return b
+}
+
+// Acquire implements budget.Budget
+func (b *classifiedBudget) Acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	l := b.unclassified
+	if t, has := ctx.Value(backoffTypeCtxKey{}).(BackoffType); has {
+		if typed, has := b.limiters[t]; has {
+			l = typed
+		}
+	}
+	if !l.allow(b.clock.Now()) {
+		return xerrors.WithStackTrace(budget.ErrNoQuota)
+	}
+
+	return nil
+}
+
+// Denied returns counters of denied retry attempts per backoff type defined in limits
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (b *classifiedBudget) Denied() map[BackoffType]uint64 {
+	denied := make(map[BackoffType]uint64, len(b.limiters))
+	for t, l := range b.limiters {
+		denied[t] = l.denied.Load()
+	}
+
+	return denied
+}
+
+// DeniedUnclassified returns counter of denied retry attempts of errors with backoff type
+// which not defined in limits
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (b *classifiedBudget) DeniedUnclassified() uint64 {
+	return b.unclassified.denied.Load()
+}
+
+func newLimiter(limit Limit) *limiter {
+	burst := math.Max(1, math.Ceil(float64(limit)))
+
+	return &limiter{
+		limit:  limit,
+		burst:  burst,
+		tokens: burst,
+	}
+}
+
+func (l *limiter) allow(now time.Time) bool {
+	if l.limit == Inf {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed.Seconds()*float64(l.limit))
+		l.last = now
+	}
+	if l.tokens < 1 {
+		l.denied.Add(1)
+
+		return false
+	}
+	l.tokens--
+
+	return true
+}
+
+func withBackoffType(ctx context.Context, t BackoffType) context.Context {
+	return context.WithValue(ctx, backoffTypeCtxKey{}, t)
+}
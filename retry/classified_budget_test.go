@@ -0,0 +1,79 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
+)
+
+func TestClassifiedBudget(t *testing.T) {
+	ctx := context.Background()
+	clock := clockwork.NewFakeClock()
+	b := ClassifiedBudget(map[BackoffType]Limit{
+		BackoffTypeFast: 2,
+		BackoffTypeSlow: 0.5,
+	}, WithUnclassifiedLimit(1), withClassifiedBudgetClock(clock))
+
+	fast := withBackoffType(ctx, BackoffTypeFast)
+	slow := withBackoffType(ctx, BackoffTypeSlow)
+
+	require.NoError(t, b.Acquire(fast))
+	require.NoError(t, b.Acquire(fast))
+	require.ErrorIs(t, b.Acquire(fast), budget.ErrNoQuota)
+
+	require.NoError(t, b.Acquire(slow))
+	require.ErrorIs(t, b.Acquire(slow), budget.ErrNoQuota)
+
+	require.NoError(t, b.Acquire(ctx))
+	require.ErrorIs(t, b.Acquire(withBackoffType(ctx, BackoffTypeNoBackoff)), budget.ErrNoQuota)
+
+	clock.Advance(time.Second)
+
+	require.NoError(t, b.Acquire(fast))
+	require.NoError(t, b.Acquire(fast))
+	require.ErrorIs(t, b.Acquire(fast), budget.ErrNoQuota)
+	require.ErrorIs(t, b.Acquire(slow), budget.ErrNoQuota)
+	require.NoError(t, b.Acquire(ctx))
+
+	clock.Advance(time.Second)
+
+	require.NoError(t, b.Acquire(slow))
+
+	require.Equal(t, map[BackoffType]uint64{
+		BackoffTypeFast: 2,
+		BackoffTypeSlow: 2,
+	}, b.Denied())
+	require.EqualValues(t, 1, b.DeniedUnclassified())
+}
+
+func TestClassifiedBudgetUnlimitedByDefault(t *testing.T) {
+	ctx := context.Background()
+	b := ClassifiedBudget(nil, withClassifiedBudgetClock(clockwork.NewFakeClock()))
+	for i := 0; i < 100; i++ {
+		require.NoError(t, b.Acquire(withBackoffType(ctx, BackoffTypeSlow)))
+	}
+	require.Empty(t, b.Denied())
+	require.Zero(t, b.DeniedUnclassified())
+}
+
+func TestRetryWithClassifiedBudget(t *testing.T) {
+	b := ClassifiedBudget(map[BackoffType]Limit{
+		BackoffTypeFast: 0,
+	}, withClassifiedBudgetClock(clockwork.NewFakeClock()))
+	attempts := 0
+	err := Retry(context.Background(), func(ctx context.Context) error {
+		attempts++
+
+		return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+	}, WithBudget(b), WithFastBackoff(Backoff(time.Microsecond, 0, 0)))
+	require.ErrorIs(t, err, budget.ErrNoQuota)
+	require.Equal(t, 2, attempts)
+	require.EqualValues(t, 1, b.Denied()[BackoffTypeFast])
+}
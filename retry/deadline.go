@@ -0,0 +1,171 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrInvalidDeadlineFraction returns from retry functions if WithDeadlineFraction got fraction out of (0, 1]
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrInvalidDeadlineFraction = xerrors.Wrap(errors.New("deadline fraction must be in range (0, 1]"))
+
+// defaultMinAttemptDuration is a minimal duration of attempt with trimmed deadline if WithMinAttemptDuration
+// is not defined. Without this floor trimmed attempts shrink endlessly and no attempt gets all remaining time
+const defaultMinAttemptDuration = 100 * time.Millisecond
+
+var _ Option = attemptTimeoutOption(0)
+
+type attemptTimeoutOption time.Duration
+
+func (timeout attemptTimeoutOption) ApplyRetryOption(opts *retryOptions) {
+	opts.attemptTimeout = time.Duration(timeout)
+}
+
+func (timeout attemptTimeoutOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, timeout)
+}
+
+func (timeout attemptTimeoutOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, timeout)
+}
+
+// WithAttemptTimeout limits duration of every attempt, zero value means no limit.
+// Attempt interrupted by timeout is retried only for idempotent operation
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAttemptTimeout(timeout time.Duration) attemptTimeoutOption {
+	return attemptTimeoutOption(timeout)
+}
+
+var _ Option = deadlineFractionOption(0)
+
+type deadlineFractionOption float64
+
+func (f deadlineFractionOption) ApplyRetryOption(opts *retryOptions) {
+	if f <= 0 || f > 1 {
+		opts.err = xerrors.WithStackTrace(fmt.Errorf("%w: %v", ErrInvalidDeadlineFraction, float64(f)))
+
+		return
+	}
+	opts.deadlineFraction = float64(f)
+}
+
+func (f deadlineFractionOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, f)
+}
+
+func (f deadlineFractionOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, f)
+}
+
+// WithDeadlineFraction trims deadline of every attempt to fraction f of remaining time of context deadline,
+// so time for next attempts is left if attempt hangs. Attempt which would leave less than minimal attempt
+// duration (see WithMinAttemptDuration) and the last attempt of WithMaxAttempts use all remaining time.
+// Context without deadline is not trimmed. Combined with WithAttemptTimeout the least of both is used.
+// Attempt interrupted by trimmed deadline is retried only for idempotent operation
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithDeadlineFraction(f float64) deadlineFractionOption {
+	return deadlineFractionOption(f)
+}
+
+var _ Option = minAttemptDurationOption(0)
+
+type minAttemptDurationOption time.Duration
+
+func (d minAttemptDurationOption) ApplyRetryOption(opts *retryOptions) {
+	opts.minAttemptDuration = time.Duration(d)
+}
+
+func (d minAttemptDurationOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, d)
+}
+
+func (d minAttemptDurationOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, d)
+}
+
+// WithMinAttemptDuration defines minimal duration of attempt with deadline trimmed by WithDeadlineFraction.
+// Attempt which would leave less than minimal attempt duration for next attempts is the last one and uses
+// all remaining time of context deadline. Default minimal attempt duration is 100ms
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMinAttemptDuration(d time.Duration) minAttemptDurationOption {
+	return minAttemptDurationOption(d)
+}
+
+type clockOption struct {
+	clock clockwork.Clock
+}
+
+func (o clockOption) ApplyRetryOption(opts *retryOptions) {
+	opts.clock = o.clock
+}
+
+func withClock(clock clockwork.Clock) clockOption {
+	return clockOption{clock: clock}
+}
+
+// attemptTimeoutOf returns timeout of attempt No.attempt or zero if attempt is not limited
+func (opts *retryOptions) attemptTimeoutOf(ctx context.Context, attempt int) time.Duration {
+	timeout := opts.attemptTimeout
+	if opts.deadlineFraction <= 0 {
+		return timeout
+	}
+	if opts.maxAttempts > 0 && attempt >= opts.maxAttempts {
+		return timeout
+	}
+	deadline, has := ctx.Deadline()
+	if !has {
+		return timeout
+	}
+	remaining := deadline.Sub(opts.clock.Now())
+	trimmed := time.Duration(float64(remaining) * opts.deadlineFraction)
+	if trimmed < opts.minAttemptDuration {
+		trimmed = opts.minAttemptDuration
+	}
+	if remaining-trimmed < opts.minAttemptDuration {
+		// next attempt would not fit into rest of deadline, so this attempt is the last one
+		return timeout
+	}
+	if timeout > 0 && timeout < trimmed {
+		return timeout
+	}
+
+	return trimmed
+}
+
+// attemptContext returns context of attempt No.attempt with trimmed deadline
+func (opts *retryOptions) attemptContext(ctx context.Context, attempt int) (context.Context, context.CancelFunc) {
+	timeout := opts.attemptTimeoutOf(ctx, attempt)
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	return xcontext.WithNamedDeadline(ctx, xerrors.DeadlineSourceAttemptTimeout, timeout, opts.clock.Now().Add(timeout))
+}
+
+// attemptError tags error of attempt interrupted by own deadline with attempt timeout
+// and makes it retryable for idempotent operation
+func (opts *retryOptions) attemptError(ctx, attemptCtx context.Context, err error) error {
+	if err == nil || ctx.Err() != nil || !errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	if cause, ok := xerrors.DeadlineCause(attemptCtx.Err()); ok {
+		err = xerrors.WithDeadlineCause(err, cause)
+	}
+	if !opts.idempotent {
+		return err
+	}
+
+	return xerrors.Retryable(err, xerrors.WithBackoff(backoff.TypeFast), xerrors.WithName("AttemptTimeout"))
+}
@@ -0,0 +1,258 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestAttemptDeadlines(t *testing.T) {
+	// zero value of deadline means that attempt context has no deadline
+	for _, tt := range []struct {
+		name      string
+		timeout   time.Duration
+		opts      []Option
+		elapsed   time.Duration
+		deadlines []time.Duration
+	}{
+		{
+			name:    "DeadlineFraction",
+			timeout: 10 * time.Second,
+			opts: []Option{
+				WithDeadlineFraction(0.5),
+				WithMaxAttempts(4),
+			},
+			elapsed: 2 * time.Second,
+			deadlines: []time.Duration{
+				5 * time.Second,  // 10s * 0.5
+				6 * time.Second,  // 2s + 8s * 0.5
+				7 * time.Second,  // 4s + 6s * 0.5
+				10 * time.Second, // last attempt uses all remaining time
+			},
+		},
+		{
+			name:    "MinAttemptDuration",
+			timeout: 10 * time.Second,
+			opts: []Option{
+				WithDeadlineFraction(0.1),
+				WithMinAttemptDuration(3 * time.Second),
+				WithMaxAttempts(5),
+			},
+			elapsed: 3 * time.Second,
+			deadlines: []time.Duration{
+				3 * time.Second,  // 10s * 0.1 < 3s
+				6 * time.Second,  // 3s + 7s * 0.1 < 3s
+				10 * time.Second, // 3s leaves 1s < 3s for next attempts, so all remaining time is used
+				10 * time.Second, // 3s > remaining 1s, so all remaining time is used
+				10 * time.Second, // last attempt uses all remaining time
+			},
+		},
+		{
+			name:    "DeadlineFractionWithAttemptTimeout",
+			timeout: 10 * time.Second,
+			opts: []Option{
+				WithDeadlineFraction(0.5),
+				WithAttemptTimeout(3 * time.Second),
+				WithMaxAttempts(4),
+			},
+			elapsed: 2 * time.Second,
+			deadlines: []time.Duration{
+				3 * time.Second, // min(10s * 0.5, 3s)
+				5 * time.Second, // 2s + min(8s * 0.5, 3s)
+				7 * time.Second, // 4s + min(6s * 0.5, 3s)
+				9 * time.Second, // last attempt is limited by attempt timeout only
+			},
+		},
+		{
+			name: "AttemptTimeoutWithoutDeadline",
+			opts: []Option{
+				WithDeadlineFraction(0.5),
+				WithAttemptTimeout(3 * time.Second),
+				WithMaxAttempts(2),
+			},
+			elapsed: time.Second,
+			deadlines: []time.Duration{
+				3 * time.Second,
+				4 * time.Second,
+			},
+		},
+		{
+			name: "DeadlineFractionWithoutDeadline",
+			opts: []Option{
+				WithDeadlineFraction(0.5),
+				WithMaxAttempts(2),
+			},
+			elapsed:   time.Second,
+			deadlines: []time.Duration{0, 0},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			clock := clockwork.NewFakeClockAt(start)
+			ctx := context.Background()
+			if tt.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithDeadline(ctx, start.Add(tt.timeout))
+				defer cancel()
+			}
+			var deadlines []time.Duration
+			err := Retry(ctx, func(ctx context.Context) error {
+				if deadline, has := ctx.Deadline(); has {
+					deadlines = append(deadlines, deadline.Sub(start))
+				} else {
+					deadlines = append(deadlines, 0)
+				}
+				clock.Advance(tt.elapsed)
+
+				return RetryableError(errors.New("test"), WithBackoff(backoff.TypeNoBackoff))
+			}, append(tt.opts, withClock(clock))...)
+			require.Error(t, err)
+			require.Equal(t, tt.deadlines, deadlines)
+		})
+	}
+}
+
+func TestLastAttemptDeadlineWithoutMaxAttempts(t *testing.T) {
+	start := time.Now()
+	clock := clockwork.NewFakeClockAt(start)
+	ctx, cancel := context.WithDeadline(context.Background(), start.Add(10*time.Second))
+	defer cancel()
+	var deadlines []time.Duration
+	err := Retry(ctx, func(ctx context.Context) error {
+		deadline, _ := ctx.Deadline()
+		deadlines = append(deadlines, deadline.Sub(start))
+		clock.Advance(2 * time.Second)
+		if deadline.Equal(start.Add(10 * time.Second)) {
+			return errors.New("test")
+		}
+
+		return RetryableError(errors.New("test"), WithBackoff(backoff.TypeNoBackoff))
+	}, WithDeadlineFraction(0.5), WithMinAttemptDuration(1500*time.Millisecond), withClock(clock))
+	require.Error(t, err)
+	require.Equal(t, []time.Duration{
+		5 * time.Second,  // 10s * 0.5
+		6 * time.Second,  // 2s + 8s * 0.5
+		7 * time.Second,  // 4s + 6s * 0.5
+		8 * time.Second,  // 6s + 4s * 0.5
+		10 * time.Second, // 8s + 1.5s leaves 0.5s < 1.5s for next attempts, so all remaining time is used
+	}, deadlines)
+}
+
+func TestAttemptTimeoutRetry(t *testing.T) {
+	op := func(attempts *int) retryOperation {
+		return func(ctx context.Context) error {
+			*attempts++
+			if *attempts == 1 {
+				<-ctx.Done()
+
+				return ctx.Err()
+			}
+
+			return nil
+		}
+	}
+	t.Run("Idempotent", func(t *testing.T) {
+		var attempts int
+		err := Retry(context.Background(), op(&attempts),
+			WithIdempotent(true),
+			WithAttemptTimeout(time.Millisecond),
+			WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Microsecond))),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, attempts)
+	})
+	t.Run("NonIdempotent", func(t *testing.T) {
+		var attempts int
+		err := Retry(context.Background(), op(&attempts),
+			WithAttemptTimeout(time.Millisecond),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Equal(t, 1, attempts)
+	})
+}
+
+func TestDeadlineFractionValidation(t *testing.T) {
+	for _, f := range []float64{0, -0.5, 1.5} {
+		err := Retry(context.Background(), func(ctx context.Context) error {
+			return nil
+		}, WithDeadlineFraction(f))
+		require.ErrorIs(t, err, ErrInvalidDeadlineFraction)
+	}
+	require.NoError(t, Retry(context.Background(), func(ctx context.Context) error {
+		return nil
+	}, WithDeadlineFraction(1)))
+}
+
+func TestAttemptTimeoutDeadlineCause(t *testing.T) {
+	wait := func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	}
+	t.Run("AttemptTimeout", func(t *testing.T) {
+		var done trace.RetryLoopDoneInfo
+		err := Retry(context.Background(), wait,
+			WithAttemptTimeout(time.Millisecond),
+			WithTrace(&trace.Retry{
+				OnRetry: func(trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+					return func(info trace.RetryLoopDoneInfo) {
+						done = info
+					}
+				},
+			}),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		cause, ok := xerrors.DeadlineCause(err)
+		require.True(t, ok)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceAttemptTimeout, Timeout: time.Millisecond}, cause)
+		require.Equal(t, "attempt timeout", done.DeadlineSource)
+		require.Equal(t, time.Millisecond, done.DeadlineTimeout)
+	})
+	t.Run("Caller", func(t *testing.T) {
+		// deadline of caller expires before attempt timeout
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		var done trace.RetryLoopDoneInfo
+		err := Retry(ctx, wait,
+			WithIdempotent(true),
+			WithAttemptTimeout(time.Hour),
+			WithTrace(&trace.Retry{
+				OnRetry: func(trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+					return func(info trace.RetryLoopDoneInfo) {
+						done = info
+					}
+				},
+			}),
+		)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		cause, ok := xerrors.DeadlineCause(err)
+		require.True(t, ok)
+		require.Equal(t, xerrors.Deadline{Source: xerrors.DeadlineSourceCaller}, cause)
+		require.Equal(t, "caller", done.DeadlineSource)
+		require.Zero(t, done.DeadlineTimeout)
+	})
+	t.Run("NoDeadline", func(t *testing.T) {
+		var done trace.RetryLoopDoneInfo
+		err := Retry(context.Background(), func(ctx context.Context) error {
+			return errors.New("test")
+		}, WithTrace(&trace.Retry{
+			OnRetry: func(trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+				return func(info trace.RetryLoopDoneInfo) {
+					done = info
+				}
+			},
+		}))
+		require.Error(t, err)
+		_, ok := xerrors.DeadlineCause(err)
+		require.False(t, ok)
+		require.Empty(t, done.DeadlineSource)
+	})
+}
@@ -3,6 +3,10 @@ package retry
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
+	"os"
+	"syscall"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
 	"google.golang.org/grpc"
@@ -32,6 +36,7 @@ var errsToCheck = []struct {
 	err           error        // given error
 	backoff       backoff.Type // no backoff (=== no operationStatus), fast backoff, slow backoff
 	deleteSession bool         // close session and delete from pool
+	busySession   bool         // return session to pool and use another session for next attempt
 	canRetry      map[idempotency]bool
 }{
 	{
@@ -462,10 +467,117 @@ var errsToCheck = []struct {
 			xerrors.WithStatusCode(Ydb.StatusIds_SESSION_BUSY),
 		),
 		backoff:       backoff.TypeFast,
-		deleteSession: true,
+		deleteSession: false,
+		busySession:   true,
 		canRetry: map[idempotency]bool{
 			idempotent:    true,
 			nonIdempotent: true,
 		},
 	},
+	{
+		// connection reset while reading of stream, grpc-go wraps it into Unavailable
+		err: xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable,
+			"error reading from server: read tcp 127.0.0.1:50000->127.0.0.1:2135: read: connection reset by peer",
+		)),
+		backoff:       backoff.TypeFast,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
+	},
+	{
+		// stream reset by server
+		err: xerrors.Transport(grpcStatus.Error(grpcCodes.Internal,
+			"stream terminated by RST_STREAM with error code: INTERNAL_ERROR",
+		)),
+		backoff:       backoff.TypeFast,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
+	},
+	{
+		// server sent GOAWAY
+		err: xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable,
+			"the connection is draining due to receipt of GOAWAY",
+		)),
+		backoff:       backoff.TypeFast,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
+	},
+	{
+		// stream closed by server before the end of data
+		err:           xerrors.Transport(grpcStatus.Error(grpcCodes.Internal, "server closed the stream without sending trailers")),
+		backoff:       backoff.TypeFast,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
+	},
+	{
+		// grpc status error of stream without wrapping of errors
+		err:           grpcStatus.Error(grpcCodes.Unavailable, "transport is closing"),
+		backoff:       backoff.TypeFast,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
+	},
+	{
+		// unexpected EOF of stream
+		err:           xerrors.Transport(io.ErrUnexpectedEOF),
+		backoff:       backoff.TypeFast,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
+	},
+	{
+		// unexpected EOF of stream without wrapping of errors
+		err:           fmt.Errorf("read stream: %w", io.ErrUnexpectedEOF),
+		backoff:       backoff.TypeFast,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
+	},
+	{
+		// connection reset without grpc status
+		err:           xerrors.Transport(&net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}),
+		backoff:       backoff.TypeFast,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
+	},
+	{
+		// broken pipe without wrapping of errors
+		err:           &net.OpError{Op: "write", Net: "tcp", Err: os.NewSyscallError("write", syscall.EPIPE)},
+		backoff:       backoff.TypeFast,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    true,
+			nonIdempotent: false,
+		},
+	},
+	{
+		// regular end of stream is not a termination
+		err:           xerrors.Transport(io.EOF),
+		backoff:       backoff.TypeNoBackoff,
+		deleteSession: true,
+		canRetry: map[idempotency]bool{
+			idempotent:    false,
+			nonIdempotent: false,
+		},
+	},
 }
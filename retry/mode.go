@@ -11,6 +11,7 @@ type retryMode struct {
 	errType            xerrors.Type
 	backoff            backoff.Type
 	isRetryObjectValid bool
+	isRetryObjectBusy  bool
 }
 
 func (m retryMode) MustRetry(isOperationIdempotent bool) bool {
@@ -36,3 +37,7 @@ func (m retryMode) BackoffType() backoff.Type { return m.backoff }
 func (m retryMode) MustDeleteSession() bool { return !m.isRetryObjectValid }
 
 func (m retryMode) IsRetryObjectValid() bool { return m.isRetryObjectValid }
+
+// IsRetryObjectBusy reports whether retry object (such as session) is busy now.
+// Busy object must not be used by next attempt, but it is valid for reuse later
+func (m retryMode) IsRetryObjectBusy() bool { return m.isRetryObjectBusy }
@@ -0,0 +1,87 @@
+package retry
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrDangerousNonIdempotentCode returns from retry functions if WithRetryOnNonIdempotentCodes contains code
+// which result of operation is unknown for (operation may be already applied) without ForceDangerous
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrDangerousNonIdempotentCode = xerrors.Wrap(errors.New("dangerous code for retry of non-idempotent operation"))
+
+// dangerousNonIdempotentCodes are codes of errors after which non-idempotent operation may be already applied
+var dangerousNonIdempotentCodes = map[int64]struct{}{
+	int64(Ydb.StatusIds_ABORTED):      {},
+	int64(Ydb.StatusIds_UNDETERMINED): {},
+	int64(Ydb.StatusIds_TIMEOUT):      {},
+	int64(Ydb.StatusIds_CANCELLED):    {},
+	int64(grpcCodes.Canceled):         {},
+	int64(grpcCodes.DeadlineExceeded): {},
+	int64(grpcCodes.Internal):         {},
+}
+
+var _ Option = nonIdempotentCodesOption{}
+
+type nonIdempotentCodesOption struct {
+	codes []int64
+	force bool
+}
+
+// ForceDangerous allows codes after which non-idempotent operation may be already applied
+func (o nonIdempotentCodesOption) ForceDangerous() nonIdempotentCodesOption {
+	o.force = true
+
+	return o
+}
+
+func (o nonIdempotentCodesOption) ApplyRetryOption(opts *retryOptions) {
+	if opts.nonIdempotentCodes == nil {
+		opts.nonIdempotentCodes = make(map[int64]struct{}, len(o.codes))
+	}
+	for _, code := range o.codes {
+		if _, dangerous := dangerousNonIdempotentCodes[code]; dangerous && !o.force {
+			opts.err = xerrors.WithStackTrace(fmt.Errorf("%w: %d", ErrDangerousNonIdempotentCode, code))
+
+			return
+		}
+		opts.nonIdempotentCodes[code] = struct{}{}
+	}
+}
+
+func (o nonIdempotentCodesOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+func (o nonIdempotentCodesOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, o)
+}
+
+// WithRetryOnNonIdempotentCodes allows retries of non-idempotent operation on errors with given status codes
+// of operation errors (Ydb.StatusIds_StatusCode) or transport errors (grpc codes.Code).
+// It is applied after default verdict of retry, so errors which are not retryable for idempotent operation
+// are not retried too. Codes after which operation may be already applied (such as ABORTED, UNDETERMINED,
+// grpc DeadlineExceeded) make retry functions return ErrDangerousNonIdempotentCode without ForceDangerous
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithRetryOnNonIdempotentCodes(codes ...int64) nonIdempotentCodesOption {
+	return nonIdempotentCodesOption{codes: codes}
+}
+
+// mustRetry reports whether the error of mode m must be retried with options
+func (opts *retryOptions) mustRetry(m retryMode) bool {
+	if m.MustRetry(opts.idempotent) {
+		return true
+	}
+	if _, has := opts.nonIdempotentCodes[m.StatusCode()]; has {
+		return m.MustRetry(true)
+	}
+
+	return false
+}
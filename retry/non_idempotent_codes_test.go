@@ -0,0 +1,90 @@
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+func TestRetryOnNonIdempotentCodes(t *testing.T) {
+	unavailable := xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""))
+	for _, tt := range []struct {
+		name     string
+		err      error
+		opt      Option
+		attempts int
+		optErr   error
+	}{
+		{
+			name:     "Default",
+			err:      unavailable,
+			attempts: 1,
+		},
+		{
+			name:     "Whitelisted",
+			err:      unavailable,
+			opt:      WithRetryOnNonIdempotentCodes(int64(grpcCodes.Unavailable)),
+			attempts: 3,
+		},
+		{
+			name:     "OtherCode",
+			err:      unavailable,
+			opt:      WithRetryOnNonIdempotentCodes(int64(Ydb.StatusIds_UNAVAILABLE)),
+			attempts: 1,
+		},
+		{
+			name:     "NotRetryableForIdempotent",
+			err:      xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_REQUEST)),
+			opt:      WithRetryOnNonIdempotentCodes(int64(Ydb.StatusIds_BAD_REQUEST)),
+			attempts: 1,
+		},
+		{
+			name:     "Dangerous",
+			err:      xerrors.Transport(grpcStatus.Error(grpcCodes.DeadlineExceeded, "")),
+			opt:      WithRetryOnNonIdempotentCodes(int64(grpcCodes.DeadlineExceeded)),
+			attempts: 0,
+			optErr:   ErrDangerousNonIdempotentCode,
+		},
+		{
+			name:     "ForceDangerous",
+			err:      xerrors.Transport(grpcStatus.Error(grpcCodes.DeadlineExceeded, "")),
+			opt:      WithRetryOnNonIdempotentCodes(int64(grpcCodes.DeadlineExceeded)).ForceDangerous(),
+			attempts: 3,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			err := Retry(xtest.Context(t), func(ctx context.Context) error {
+				attempts++
+				if attempts == 3 {
+					return nil
+				}
+
+				return tt.err
+			},
+				tt.opt,
+				WithIdempotent(false),
+				WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+				WithSlowBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+			)
+			require.Equal(t, tt.attempts, attempts)
+			switch {
+			case tt.optErr != nil:
+				require.ErrorIs(t, err, tt.optErr)
+			case tt.attempts == 3:
+				require.NoError(t, err)
+			default:
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jonboulle/clockwork"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
@@ -27,8 +29,20 @@ type retryOptions struct {
 	fastBackoff backoff.Backoff
 	slowBackoff backoff.Backoff
 	budget      budget.Budget
+	maxAttempts int
+
+	attemptTimeout     time.Duration
+	deadlineFraction   float64
+	minAttemptDuration time.Duration
+	clock              clockwork.Clock
+
+	nonIdempotentCodes map[int64]struct{}
+	err                error // error of options
 
 	panicCallback func(e interface{})
+	beforeRetry   func(ctx context.Context, attempt int, err error) error
+
+	wakeUp <-chan struct{}
 }
 
 type Option interface {
@@ -172,6 +186,30 @@ func WithIdempotent(idempotent bool) idempotentOption {
 	return idempotentOption(idempotent)
 }
 
+var _ Option = maxAttemptsOption(0)
+
+type maxAttemptsOption int
+
+func (maxAttempts maxAttemptsOption) ApplyRetryOption(opts *retryOptions) {
+	opts.maxAttempts = int(maxAttempts)
+}
+
+func (maxAttempts maxAttemptsOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithMaxAttempts(int(maxAttempts)))
+}
+
+func (maxAttempts maxAttemptsOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithMaxAttempts(int(maxAttempts)))
+}
+
+// WithMaxAttempts limits number of attempts, zero value means unlimited attempts.
+// Error of last attempt is returned as is after limit of attempts, so WithMaxAttempts(1) disables retries
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxAttempts(maxAttempts int) maxAttemptsOption {
+	return maxAttemptsOption(maxAttempts)
+}
+
 var _ Option = fastBackoffOption{}
 
 type fastBackoffOption struct {
@@ -246,6 +284,33 @@ func WithPanicCallback(panicCallback func(e interface{})) panicCallbackOption {
 	return panicCallbackOption{callback: panicCallback}
 }
 
+var _ Option = beforeRetryOption(nil)
+
+type beforeRetryOption func(ctx context.Context, attempt int, err error) error
+
+func (f beforeRetryOption) ApplyRetryOption(opts *retryOptions) {
+	opts.beforeRetry = f
+}
+
+func (f beforeRetryOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithBeforeRetry(f))
+}
+
+func (f beforeRetryOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithBeforeRetry(f))
+}
+
+// WithBeforeRetry sets callback which is called when error of attempt is retryable, before backoff wait
+// and next attempt. Callback receives number of next attempt (2 and more, callback is never called before
+// the first attempt) and error of failed attempt, so it may be used for logging of attempts without trace.
+// Non-nil error of callback stops retries, Retry returns it joined with error of failed attempt.
+// Table client Do and DoTx accept callback with table.WithRetryOptions
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithBeforeRetry(beforeRetry func(ctx context.Context, attempt int, err error) error) beforeRetryOption {
+	return beforeRetry
+}
+
 // Retry provide the best effort fo retrying operation
 //
 // Retry implements internal busy loop until one of the following conditions is met:
@@ -266,15 +331,22 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 		budget:      budget.Limited(-1),
 		fastBackoff: backoff.Fast,
 		slowBackoff: backoff.Slow,
+		clock:       clockwork.NewRealClock(),
+
+		minAttemptDuration: defaultMinAttemptDuration,
 	}
 	for _, opt := range opts {
 		if opt != nil {
 			opt.ApplyRetryOption(options)
 		}
 	}
+	if options.err != nil {
+		return options.err
+	}
 	if options.idempotent {
 		ctx = xcontext.WithIdempotent(ctx, options.idempotent)
 	}
+	ctx = xcontext.WithCredentialsRecovery(ctx)
 
 	defer func() {
 		if finalErr != nil && options.stackTrace {
@@ -287,32 +359,66 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 	var (
 		i        int
 		attempts int
+		tli      int
 
-		code   = int64(0)
-		onDone = trace.RetryOnRetry(options.trace, &ctx,
+		code    = int64(0)
+		m       retryMode
+		reason  trace.RetryStopReason
+		lastErr error
+		onDone  = trace.RetryOnRetry(options.trace, &ctx,
 			options.call, options.label, options.idempotent, xcontext.IsNestedCall(ctx),
 		)
 	)
 	defer func() {
-		onDone(attempts, finalErr)
+		if finalErr == nil {
+			onDone(attempts, tli, nil, "", 0, "", false, "", 0)
+
+			return
+		}
+		stopErr := &stopError{err: finalErr, reason: reason}
+		if reason == trace.RetryStopReasonContextDone {
+			stopErr.lastErr = lastErr
+		}
+		finalErr = stopErr
+		var deadlineSource string
+		deadline, hasDeadline := xerrors.DeadlineCause(finalErr)
+		if hasDeadline {
+			deadlineSource = deadline.Source.String()
+		}
+		// IsRetryObjectValid is true for errors of invalid session (such as BAD_SESSION)
+		onDone(attempts, tli, finalErr, reason, m.StatusCode(), m.BackoffType().String(), m.IsRetryObjectValid(),
+			deadlineSource, deadline.Timeout,
+		)
 	}()
 	for {
 		i++
 		attempts++
 		select {
 		case <-ctx.Done():
+			reason = trace.RetryStopReasonContextDone
+
+			// error of the last attempt (with the node of failed call) is kept in stopError
+			// out of the chain of final error, so final error is classified as context error
 			return xerrors.WithStackTrace(
-				fmt.Errorf("retry failed on attempt No.%d: %w", attempts, ctx.Err()),
+				fmt.Errorf("retry failed on attempt No.%d: %w", attempts, contextError(ctx)),
 			)
 
 		default:
-			err := opWithRecover(ctx, options, op)
+			attemptCtx, cancel := options.attemptContext(ctx, attempts)
+			err := options.attemptError(ctx, attemptCtx, opWithRecover(attemptCtx, options, op))
+			cancel()
 
 			if err == nil {
 				return nil
 			}
 
-			m := Check(err)
+			lastErr = err
+
+			if xerrors.IsOperationErrorTransactionLocksInvalidated(err) {
+				tli++
+			}
+
+			m = Check(err)
 
 			if m.StatusCode() != code {
 				i = 0
@@ -320,45 +426,79 @@ func Retry(ctx context.Context, op retryOperation, opts ...Option) (finalErr err
 
 			code = m.StatusCode()
 
-			if !m.MustRetry(options.idempotent) {
+			if !options.mustRetry(m) {
+				reason = trace.RetryStopReasonNonRetryableError
+				if m.MustRetry(true) {
+					reason = trace.RetryStopReasonNonIdempotent
+				}
+
 				return xerrors.WithStackTrace(
 					fmt.Errorf("non-retryable error occurred on attempt No.%d (idempotent=%v): %w",
 						attempts, options.idempotent, err),
 				)
 			}
 
-			t := time.NewTimer(backoff.Delay(m.BackoffType(), i,
+			if options.maxAttempts > 0 && attempts >= options.maxAttempts {
+				reason = trace.RetryStopReasonMaxAttempts
+
+				return xerrors.WithStackTrace(err)
+			}
+
+			if options.beforeRetry != nil {
+				if beforeRetryErr := options.beforeRetry(ctx, attempts+1, err); beforeRetryErr != nil {
+					reason = trace.RetryStopReasonBeforeRetry
+
+					return xerrors.WithStackTrace(
+						xerrors.Join(
+							fmt.Errorf("retry stopped before attempt No.%d: %w", attempts+1, beforeRetryErr),
+							err,
+						),
+					)
+				}
+			}
+
+			delay := backoff.Delay(m.BackoffType(), i,
 				backoff.WithFastBackoff(options.fastBackoff),
 				backoff.WithSlowBackoff(options.slowBackoff),
-			))
+			)
 
-			select {
-			case <-ctx.Done():
-				t.Stop()
+			if sleepErr := options.sleep(ctx, attempts, delay); sleepErr != nil {
+				reason = trace.RetryStopReasonContextDone
 
 				return xerrors.WithStackTrace(
 					xerrors.Join(
-						fmt.Errorf("attempt No.%d: %w", attempts, ctx.Err()),
+						fmt.Errorf("attempt No.%d: %w", attempts, contextError(ctx)),
 						err,
 					),
 				)
-			case <-t.C:
-				t.Stop()
+			}
 
-				if acquireErr := options.budget.Acquire(ctx); acquireErr != nil {
-					return xerrors.WithStackTrace(
-						xerrors.Join(
-							fmt.Errorf("attempt No.%d: %w", attempts, budget.ErrNoQuota),
-							acquireErr,
-							err,
-						),
-					)
-				}
+			if acquireErr := options.budget.Acquire(withBackoffType(ctx, m.BackoffType())); acquireErr != nil {
+				reason = trace.RetryStopReasonBudgetExhausted
+
+				return xerrors.WithStackTrace(
+					xerrors.Join(
+						fmt.Errorf("attempt No.%d: %w", attempts, budget.ErrNoQuota),
+						acquireErr,
+						err,
+					),
+				)
 			}
 		}
 	}
 }
 
+// contextError returns error of done ctx. Expired deadline is tagged explicitly, so the deadline of retry loop
+// is reported by DeadlineCause instead of deadline of the last attempt
+func contextError(ctx context.Context) error {
+	err := ctx.Err()
+	if cause, ok := xerrors.DeadlineCause(err); ok {
+		return xerrors.WithDeadlineCause(err, cause)
+	}
+
+	return err
+}
+
 func opWithRecover(ctx context.Context, options *retryOptions, op retryOperation) (err error) {
 	if options.panicCallback != nil {
 		defer func() {
@@ -383,5 +523,6 @@ func Check(err error) (m retryMode) {
 		errType:            errType,
 		backoff:            backoffType,
 		isRetryObjectValid: deleteSession,
+		isRetryObjectBusy:  xerrors.IsRetryObjectBusy(err),
 	}
 }
@@ -12,9 +12,11 @@ import (
 	grpcCodes "google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestRetryModes(t *testing.T) {
@@ -54,6 +56,13 @@ func TestRetryModes(t *testing.T) {
 							tt.deleteSession,
 						)
 					}
+					if m.IsRetryObjectBusy() != tt.busySession {
+						t.Errorf(
+							"unexpected busy session status: %v, want: %v",
+							m.IsRetryObjectBusy(),
+							tt.busySession,
+						)
+					}
 				})
 			}
 		})
@@ -210,6 +219,296 @@ func TestRetryWithBudget(t *testing.T) {
 	})
 }
 
+// cancelingBudget cancels context of retry loop before next attempt
+type cancelingBudget struct {
+	cancel context.CancelFunc
+}
+
+func (b cancelingBudget) Acquire(ctx context.Context) error {
+	b.cancel()
+
+	return nil
+}
+
+func TestRetryLastAttemptErrorOnContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(xtest.Context(t))
+	defer cancel()
+	transportErr := xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, ""),
+		xerrors.WithAddress("localhost:2135"),
+		xerrors.WithNodeID(7),
+	)
+	err := Retry(ctx, func(ctx context.Context) (err error) {
+		return transportErr
+	},
+		WithIdempotent(true),
+		WithBudget(cancelingBudget{cancel: cancel}),
+		WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+	)
+	require.ErrorIs(t, err, context.Canceled)
+	// retryable error of the last attempt does not make error of canceled retries retryable
+	require.NotErrorIs(t, err, transportErr)
+	require.False(t, Check(err).MustRetry(true))
+	require.ErrorIs(t, LastAttemptError(err), transportErr)
+	nodeID, ok := xerrors.NodeID(err)
+	require.True(t, ok)
+	require.EqualValues(t, 7, nodeID)
+}
+
+func TestRetryWithMaxAttempts(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		maxAttempts int
+		attempts    int
+	}{
+		{
+			name:        "NoRetries",
+			maxAttempts: 1,
+			attempts:    1,
+		},
+		{
+			name:        "ThreeAttempts",
+			maxAttempts: 3,
+			attempts:    3,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				attempts       int
+				tracedAttempts int
+				opErr          = xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED))
+			)
+			err := Retry(xtest.Context(t), func(ctx context.Context) error {
+				attempts++
+
+				return opErr
+			},
+				WithMaxAttempts(tt.maxAttempts),
+				WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+				WithSlowBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+				WithTrace(&trace.Retry{
+					OnRetry: func(info trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+						return func(info trace.RetryLoopDoneInfo) {
+							tracedAttempts = info.Attempts
+						}
+					},
+				}),
+			)
+			require.ErrorIs(t, err, opErr)
+			require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_OVERLOADED))
+			require.True(t, Check(err).MustRetry(false))
+			require.Equal(t, tt.attempts, attempts)
+			require.Equal(t, tt.attempts, tracedAttempts)
+		})
+	}
+}
+
+func TestRetryStopReason(t *testing.T) {
+	fastBackoff := WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond)))
+	for _, tt := range []struct {
+		name          string
+		ctx           func(t *testing.T) context.Context
+		err           error
+		opts          []Option
+		reason        trace.RetryStopReason
+		code          int64
+		backoffType   string
+		deleteSession bool
+	}{
+		{
+			name:        "NonRetryableError",
+			err:         xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR)),
+			reason:      trace.RetryStopReasonNonRetryableError,
+			code:        int64(Ydb.StatusIds_SCHEME_ERROR),
+			backoffType: backoff.TypeNoBackoff.String(),
+		},
+		{
+			name:        "NonIdempotent",
+			err:         xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNDETERMINED)),
+			reason:      trace.RetryStopReasonNonIdempotent,
+			code:        int64(Ydb.StatusIds_UNDETERMINED),
+			backoffType: backoff.TypeFast.String(),
+		},
+		{
+			name:          "BudgetExhausted",
+			err:           xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_BAD_SESSION)),
+			opts:          []Option{WithBudget(noQuota{})},
+			reason:        trace.RetryStopReasonBudgetExhausted,
+			code:          int64(Ydb.StatusIds_BAD_SESSION),
+			backoffType:   backoff.TypeNoBackoff.String(),
+			deleteSession: true,
+		},
+		{
+			name: "ContextDoneBeforeAttempt",
+			ctx: func(t *testing.T) context.Context {
+				ctx, cancel := context.WithCancel(xtest.Context(t))
+				cancel()
+
+				return ctx
+			},
+			err:         xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED)),
+			reason:      trace.RetryStopReasonContextDone,
+			code:        0,
+			backoffType: backoff.TypeNoBackoff.String(),
+		},
+		{
+			name: "ContextDoneDuringWait",
+			ctx: func(t *testing.T) context.Context {
+				ctx, cancel := context.WithTimeout(xtest.Context(t), 10*time.Millisecond)
+				t.Cleanup(cancel)
+
+				return ctx
+			},
+			err:         xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_OVERLOADED)),
+			opts:        []Option{WithSlowBackoff(backoff.New(backoff.WithSlotDuration(time.Hour)))},
+			reason:      trace.RetryStopReasonContextDone,
+			code:        int64(Ydb.StatusIds_OVERLOADED),
+			backoffType: backoff.TypeSlow.String(),
+		},
+		{
+			name:        "MaxAttempts",
+			err:         xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE)),
+			opts:        []Option{WithMaxAttempts(3), fastBackoff},
+			reason:      trace.RetryStopReasonMaxAttempts,
+			code:        int64(Ydb.StatusIds_UNAVAILABLE),
+			backoffType: backoff.TypeFast.String(),
+		},
+		{
+			name:        "NonRetryableErrorOnLastAttempt",
+			err:         xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR)),
+			opts:        []Option{WithMaxAttempts(1)},
+			reason:      trace.RetryStopReasonNonRetryableError,
+			code:        int64(Ydb.StatusIds_SCHEME_ERROR),
+			backoffType: backoff.TypeNoBackoff.String(),
+		},
+		{
+			name:        "NonIdempotentOnLastAttempt",
+			err:         xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNDETERMINED)),
+			opts:        []Option{WithMaxAttempts(1)},
+			reason:      trace.RetryStopReasonNonIdempotent,
+			code:        int64(Ydb.StatusIds_UNDETERMINED),
+			backoffType: backoff.TypeFast.String(),
+		},
+		{
+			name: "BeforeRetry",
+			err:  xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE)),
+			opts: []Option{WithBeforeRetry(func(ctx context.Context, attempt int, err error) error {
+				return errors.New("test")
+			})},
+			reason:      trace.RetryStopReasonBeforeRetry,
+			code:        int64(Ydb.StatusIds_UNAVAILABLE),
+			backoffType: backoff.TypeFast.String(),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := xtest.Context(t)
+			if tt.ctx != nil {
+				ctx = tt.ctx(t)
+			}
+			var done trace.RetryLoopDoneInfo
+			err := Retry(ctx, func(ctx context.Context) error {
+				return tt.err
+			}, append(tt.opts, WithTrace(&trace.Retry{
+				OnRetry: func(info trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+					return func(info trace.RetryLoopDoneInfo) {
+						done = info
+					}
+				},
+			}))...)
+			require.Error(t, err)
+			require.Equal(t, tt.reason, ReasonFromError(err))
+			require.ErrorIs(t, done.Error, err)
+			require.Equal(t, tt.reason, done.Reason)
+			require.Equal(t, tt.code, done.Code)
+			require.Equal(t, tt.backoffType, done.BackoffType)
+			require.Equal(t, tt.deleteSession, done.DeleteSession)
+		})
+	}
+	t.Run("Success", func(t *testing.T) {
+		var done trace.RetryLoopDoneInfo
+		require.NoError(t, Retry(xtest.Context(t), func(ctx context.Context) error {
+			return nil
+		}, WithTrace(&trace.Retry{
+			OnRetry: func(info trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+				return func(info trace.RetryLoopDoneInfo) {
+					done = info
+				}
+			},
+		})))
+		require.Equal(t, trace.RetryLoopDoneInfo{Attempts: 1}, done)
+	})
+	t.Run("NotRetryError", func(t *testing.T) {
+		require.Empty(t, ReasonFromError(errors.New("test")))
+	})
+}
+
+func TestWithBeforeRetry(t *testing.T) {
+	fastBackoff := WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond)))
+	errUnavailable := xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+	t.Run("FirstAttemptSucceeded", func(t *testing.T) {
+		require.NoError(t, Retry(xtest.Context(t), func(ctx context.Context) error {
+			return nil
+		}, WithBeforeRetry(func(ctx context.Context, attempt int, err error) error {
+			t.Fatal("unexpected call of callback")
+
+			return nil
+		})))
+	})
+	t.Run("Attempts", func(t *testing.T) {
+		var (
+			attempts []int
+			errs     []error
+			i        int
+		)
+		require.NoError(t, Retry(xtest.Context(t), func(ctx context.Context) error {
+			i++
+			if i < 3 {
+				return fmt.Errorf("attempt %d: %w", i, errUnavailable)
+			}
+
+			return nil
+		}, fastBackoff, WithBeforeRetry(func(ctx context.Context, attempt int, err error) error {
+			attempts = append(attempts, attempt)
+			errs = append(errs, err)
+
+			return nil
+		})))
+		require.Equal(t, []int{2, 3}, attempts)
+		require.Len(t, errs, 2)
+		require.EqualError(t, errs[0], "attempt 1: "+errUnavailable.Error())
+		require.EqualError(t, errs[1], "attempt 2: "+errUnavailable.Error())
+	})
+	t.Run("Veto", func(t *testing.T) {
+		errVeto := errors.New("veto")
+		i := 0
+		err := Retry(xtest.Context(t), func(ctx context.Context) error {
+			i++
+
+			return errUnavailable
+		}, fastBackoff, WithBeforeRetry(func(ctx context.Context, attempt int, err error) error {
+			if attempt == 3 {
+				return errVeto
+			}
+
+			return nil
+		}))
+		require.ErrorIs(t, err, errVeto)
+		require.ErrorIs(t, err, errUnavailable)
+		require.Equal(t, 2, i)
+		require.Equal(t, trace.RetryStopReasonBeforeRetry, ReasonFromError(err))
+	})
+	t.Run("NonRetryableError", func(t *testing.T) {
+		err := Retry(xtest.Context(t), func(ctx context.Context) error {
+			return xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_SCHEME_ERROR))
+		}, WithBeforeRetry(func(ctx context.Context, attempt int, err error) error {
+			t.Fatal("unexpected call of callback")
+
+			return nil
+		}))
+		require.Error(t, err)
+		require.Equal(t, trace.RetryStopReasonNonRetryableError, ReasonFromError(err))
+	})
+}
+
 type MockPanicCallback struct {
 	called   bool
 	received interface{}
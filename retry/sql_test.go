@@ -7,10 +7,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/stack"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql/badconn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 type mockConnector struct {
@@ -239,3 +244,49 @@ func TestDoTx(t *testing.T) {
 		})
 	}
 }
+
+func TestDoTxTransactionLocksInvalidated(t *testing.T) {
+	tli := xerrors.Operation(
+		xerrors.WithStatusCode(Ydb.StatusIds_ABORTED),
+		xerrors.WithIssues([]*Ydb_Issue.IssueMessage{{
+			Message:   "Transaction locks invalidated",
+			IssueCode: 2001,
+		}}),
+	)
+	m := &mockConnector{
+		t:       t,
+		execErr: badconn.Map(xerrors.WithStackTrace(tli)),
+	}
+	db := sql.OpenDB(m)
+	var (
+		attempts int
+		done     trace.RetryLoopDoneInfo
+	)
+	err := DoTx(context.Background(), db,
+		func(ctx context.Context, tx *sql.Tx) error {
+			attempts++
+			if attempts > 2 {
+				return nil
+			}
+			_, err := tx.ExecContext(ctx, "UPSERT INTO t (a) VALUES (1)")
+			require.True(t, xerrors.IsOperationErrorTransactionLocksInvalidated(err))
+
+			return err
+		},
+		// DoTx is not idempotent by default, but aborted transaction is safe for retry with new transaction
+		WithTrace(&trace.Retry{
+			OnRetry: func(info trace.RetryLoopStartInfo) func(trace.RetryLoopDoneInfo) {
+				require.False(t, info.Idempotent)
+
+				return func(info trace.RetryLoopDoneInfo) {
+					done = info
+				}
+			},
+		}),
+		WithFastBackoff(backoff.New(backoff.WithSlotDuration(time.Nanosecond))),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 3, attempts)
+	require.Equal(t, 3, done.Attempts)
+	require.Equal(t, 2, done.TransactionLocksInvalidated)
+}
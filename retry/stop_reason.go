@@ -0,0 +1,56 @@
+package retry
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// stopError keeps reason of stop of retries with error of Retry call
+type stopError struct {
+	err    error
+	reason trace.RetryStopReason
+
+	// lastErr is an error of the last failed attempt of retries stopped by context.
+	// It is not unwrapped, so context error of err is the only classified error
+	lastErr error
+}
+
+func (e *stopError) Error() string {
+	return e.err.Error()
+}
+
+func (e *stopError) Unwrap() error {
+	return e.err
+}
+
+func (e *stopError) LastAttemptError() error {
+	return e.lastErr
+}
+
+// ReasonFromError returns reason of stop of retries from error of Retry (and also Do and DoTx of clients).
+// Reason is empty if err is not an error of Retry or Retry was stopped by an error of options
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReasonFromError(err error) trace.RetryStopReason {
+	var e *stopError
+	if xerrors.As(err, &e) {
+		return e.reason
+	}
+
+	return ""
+}
+
+// LastAttemptError returns error of the last failed attempt of Retry (and also Do and DoTx of clients)
+// stopped by cancellation or deadline of context. Error of Retry wraps only error of context in this case,
+// so error of the last attempt does not change classification of error (such as retryability).
+// LastAttemptError returns nil if err is not an error of Retry stopped by context or no attempt failed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func LastAttemptError(err error) error {
+	var e *stopError
+	if xerrors.As(err, &e) {
+		return e.lastErr
+	}
+
+	return nil
+}
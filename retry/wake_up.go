@@ -0,0 +1,80 @@
+package retry
+
+import (
+	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xrand"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// maxWakeUpJitter is a limit of random delay after wake-up signal which spreads
+// next attempts of retry loops woken up by the same signal
+const maxWakeUpJitter = 100 * time.Millisecond
+
+var wakeUpJitter = xrand.New(xrand.WithLock())
+
+var _ Option = wakeUpSignalOption{}
+
+type wakeUpSignalOption struct {
+	ch <-chan struct{}
+}
+
+func (o wakeUpSignalOption) ApplyRetryOption(opts *retryOptions) {
+	opts.wakeUp = o.ch
+}
+
+func (o wakeUpSignalOption) ApplyDoOption(opts *doOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithWakeUpSignal(o.ch))
+}
+
+func (o wakeUpSignalOption) ApplyDoTxOption(opts *doTxOptions) {
+	opts.retryOptions = append(opts.retryOptions, WithWakeUpSignal(o.ch))
+}
+
+// WithWakeUpSignal interrupts backoff sleep between attempts when ch is signaled, for example when
+// previously unavailable endpoint becomes available again. Sleep is interrupted at most once per attempt
+// and next attempt starts after small random delay, so retry loops woken up by the same signal
+// do not retry at the same moment.
+// Value sent to ch wakes up one retry loop, closed ch wakes up all retry loops
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWakeUpSignal(ch <-chan struct{}) wakeUpSignalOption {
+	return wakeUpSignalOption{ch: ch}
+}
+
+// sleep waits backoff delay before next attempt and returns error of context if context is done during sleep
+func (opts *retryOptions) sleep(ctx context.Context, attempt int, delay time.Duration) error {
+	var (
+		start  = time.Now()
+		t      = time.NewTimer(delay)
+		wakeUp = opts.wakeUp
+	)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			return nil
+		case <-wakeUp:
+			// nil channel disables wake-up until end of sleep
+			wakeUp = nil
+
+			slept := time.Since(start)
+			jitter := time.Duration(wakeUpJitter.Int64(int64(maxWakeUpJitter)))
+			if slept+jitter >= delay {
+				continue
+			}
+			if !t.Stop() {
+				<-t.C
+			}
+			t.Reset(jitter)
+
+			trace.RetryOnSleepShortened(opts.trace, ctx, opts.call, opts.label,
+				attempt, delay, slept, jitter, trace.RetrySleepShortenedReasonWakeUpSignal,
+			)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+type constantBackoff time.Duration
+
+func (b constantBackoff) Delay(int) time.Duration {
+	return time.Duration(b)
+}
+
+func TestRetryWithWakeUpSignal(t *testing.T) {
+	const slowDelay = time.Minute
+
+	var (
+		mu       sync.Mutex
+		shortens []trace.RetrySleepShortenedInfo
+	)
+	retryTrace := &trace.Retry{
+		OnSleepShortened: func(info trace.RetrySleepShortenedInfo) {
+			mu.Lock()
+			defer mu.Unlock()
+			shortens = append(shortens, info)
+		},
+	}
+
+	t.Run("Signal", func(t *testing.T) {
+		shortens = nil
+		wakeUp := make(chan struct{})
+		attempts := 0
+		start := time.Now()
+		err := Retry(xtest.Context(t), func(ctx context.Context) error {
+			attempts++
+			if attempts == 1 {
+				go func() {
+					time.Sleep(50 * time.Millisecond)
+					wakeUp <- struct{}{}
+				}()
+
+				return RetryableError(errors.New("endpoint is down"), WithBackoff(backoff.TypeSlow))
+			}
+
+			return nil
+		},
+			WithLabel("test"),
+			WithSlowBackoff(constantBackoff(slowDelay)),
+			WithWakeUpSignal(wakeUp),
+			WithTrace(retryTrace),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, attempts)
+		require.Less(t, time.Since(start), 50*time.Millisecond+maxWakeUpJitter+time.Second)
+		require.Len(t, shortens, 1)
+		require.Equal(t, "test", shortens[0].Label)
+		require.Equal(t, 1, shortens[0].Attempt)
+		require.Equal(t, slowDelay, shortens[0].Delay)
+		require.GreaterOrEqual(t, shortens[0].Slept, 50*time.Millisecond)
+		require.Less(t, shortens[0].Jitter, maxWakeUpJitter)
+		require.Equal(t, trace.RetrySleepShortenedReasonWakeUpSignal, shortens[0].Reason)
+	})
+	t.Run("OncePerAttempt", func(t *testing.T) {
+		shortens = nil
+		// closed channel signals every sleep
+		wakeUp := make(chan struct{})
+		close(wakeUp)
+		attempts := 0
+		err := Retry(xtest.Context(t), func(ctx context.Context) error {
+			attempts++
+			if attempts <= 3 {
+				return RetryableError(errors.New("endpoint is down"), WithBackoff(backoff.TypeSlow))
+			}
+
+			return nil
+		},
+			WithSlowBackoff(constantBackoff(slowDelay)),
+			WithWakeUpSignal(wakeUp),
+			WithTrace(retryTrace),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 4, attempts)
+		require.Len(t, shortens, 3)
+		for i, info := range shortens {
+			require.Equal(t, i+1, info.Attempt)
+		}
+	})
+	t.Run("ShortDelay", func(t *testing.T) {
+		shortens = nil
+		wakeUp := make(chan struct{})
+		close(wakeUp)
+		options := &retryOptions{trace: retryTrace, wakeUp: wakeUp}
+		// jitter after wake-up is not shorter than delay, so sleep is not shortened
+		require.NoError(t, options.sleep(context.Background(), 1, 0))
+		require.Empty(t, shortens)
+	})
+	t.Run("ContextDone", func(t *testing.T) {
+		shortens = nil
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		options := &retryOptions{trace: retryTrace, wakeUp: make(chan struct{})}
+		require.ErrorIs(t, options.sleep(ctx, 1, slowDelay), context.DeadlineExceeded)
+		require.Empty(t, shortens)
+	})
+}
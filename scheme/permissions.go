@@ -0,0 +1,164 @@
+package scheme
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Names of access rights of YDB scheme objects
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+const (
+	PermissionSelectRow       = "ydb.granular.select_row"
+	PermissionUpdateRow       = "ydb.granular.update_row"
+	PermissionEraseRow        = "ydb.granular.erase_row"
+	PermissionReadAttributes  = "ydb.granular.read_attributes"
+	PermissionWriteAttributes = "ydb.granular.write_attributes"
+	PermissionCreateDirectory = "ydb.granular.create_directory"
+	PermissionCreateTable     = "ydb.granular.create_table"
+	PermissionCreateQueue     = "ydb.granular.create_queue"
+	PermissionRemoveSchema    = "ydb.granular.remove_schema"
+	PermissionDescribeSchema  = "ydb.granular.describe_schema"
+	PermissionAlterSchema     = "ydb.granular.alter_schema"
+	PermissionCreateDatabase  = "ydb.granular.create_database"
+	PermissionDropDatabase    = "ydb.granular.drop_database"
+	PermissionGrantAccess     = "ydb.access.grant"
+	PermissionConnect         = "ydb.database.connect"
+
+	PermissionTablesRead     = "ydb.tables.read"
+	PermissionTablesModify   = "ydb.tables.modify"
+	PermissionList           = "ydb.generic.list"
+	PermissionRead           = "ydb.generic.read"
+	PermissionWrite          = "ydb.generic.write"
+	PermissionUseLegacy      = "ydb.generic.use_legacy"
+	PermissionUse            = "ydb.generic.use"
+	PermissionManage         = "ydb.generic.manage"
+	PermissionFullLegacy     = "ydb.generic.full_legacy"
+	PermissionFull           = "ydb.generic.full"
+	PermissionDatabaseCreate = "ydb.database.create"
+	PermissionDatabaseDrop   = "ydb.database.drop"
+)
+
+// SubjectAllUsers is a well-known group which contains all users
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+const SubjectAllUsers = "all-users@well-known"
+
+// compositePermissions maps composite access right to included access rights
+var compositePermissions = map[string][]string{
+	PermissionTablesRead:     {PermissionSelectRow, PermissionReadAttributes},
+	PermissionTablesModify:   {PermissionUpdateRow, PermissionEraseRow},
+	PermissionList:           {PermissionDescribeSchema},
+	PermissionRead:           {PermissionSelectRow, PermissionReadAttributes, PermissionDescribeSchema},
+	PermissionDatabaseCreate: {PermissionCreateDatabase},
+	PermissionDatabaseDrop:   {PermissionDropDatabase},
+	PermissionWrite: {
+		PermissionUpdateRow, PermissionEraseRow, PermissionWriteAttributes, PermissionCreateDirectory,
+		PermissionCreateTable, PermissionCreateQueue, PermissionRemoveSchema, PermissionAlterSchema,
+	},
+	PermissionUseLegacy:  {PermissionRead, PermissionWrite, PermissionGrantAccess},
+	PermissionUse:        {PermissionUseLegacy, PermissionConnect},
+	PermissionManage:     {PermissionCreateDatabase, PermissionDropDatabase},
+	PermissionFullLegacy: {PermissionUseLegacy, PermissionManage},
+	PermissionFull:       {PermissionUse, PermissionManage},
+}
+
+// permissionImplies reports whether granted access right includes access right permission
+func permissionImplies(granted, permission string) bool {
+	if granted == permission {
+		return true
+	}
+	for _, p := range compositePermissions[granted] {
+		if permissionImplies(p, permission) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EffectivePermissions is a set of access rights of scheme object including access rights
+// inherited from parent directories
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type EffectivePermissions struct {
+	// Owner of scheme object, owner has all access rights implicitly
+	Owner string
+
+	// Permissions are merged access rights of subjects, every subject is listed once
+	Permissions []Permissions
+}
+
+// Allowed reports whether subject has access right permission on scheme object.
+// Access rights in YDB are only granted (there are no denying entries), so permission is allowed
+// if it is granted to subject (or to SubjectAllUsers) directly or as part of composite access right
+// (for example ydb.generic.read includes ydb.granular.select_row).
+// Membership of subject in groups other than SubjectAllUsers is not resolved
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (p *EffectivePermissions) Allowed(subject, permission string) bool {
+	if subject == p.Owner {
+		return true
+	}
+	for _, permissions := range p.Permissions {
+		if permissions.Subject != subject && permissions.Subject != SubjectAllUsers {
+			continue
+		}
+		for _, granted := range permissions.PermissionNames {
+			if permissionImplies(granted, permission) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Merge appends access rights to effective permissions, duplicated access rights are skipped
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (p *EffectivePermissions) Merge(permissions ...Permissions) {
+	for _, src := range permissions {
+		i := 0
+		for i < len(p.Permissions) && p.Permissions[i].Subject != src.Subject {
+			i++
+		}
+		if i == len(p.Permissions) {
+			p.Permissions = append(p.Permissions, Permissions{Subject: src.Subject})
+		}
+		dst := &p.Permissions[i]
+		for _, name := range src.PermissionNames {
+			if !containsPermission(dst.PermissionNames, name) {
+				dst.PermissionNames = append(dst.PermissionNames, name)
+			}
+		}
+	}
+}
+
+func containsPermission(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DescribeEffectivePermissions returns access rights of scheme object at path including access rights
+// inherited from parent directories
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DescribeEffectivePermissions(ctx context.Context, c Client, path string) (EffectivePermissions, error) {
+	if cc, has := c.(interface {
+		EffectivePermissions(ctx context.Context, path string) (p EffectivePermissions, err error)
+	}); has {
+		return cc.EffectivePermissions(ctx, path)
+	}
+
+	return EffectivePermissions{}, xerrors.WithStackTrace(
+		fmt.Errorf("client %T not supported effective permissions", c),
+	)
+}
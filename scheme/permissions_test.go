@@ -0,0 +1,68 @@
+package scheme
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectivePermissionsAllowed(t *testing.T) {
+	p := EffectivePermissions{
+		Owner: "owner",
+		Permissions: []Permissions{
+			{Subject: "reader", PermissionNames: []string{PermissionRead}},
+			{Subject: "user", PermissionNames: []string{PermissionUse}},
+			{Subject: "tables", PermissionNames: []string{PermissionTablesRead, PermissionTablesModify}},
+			{Subject: SubjectAllUsers, PermissionNames: []string{PermissionList}},
+		},
+	}
+	for _, tt := range []struct {
+		subject    string
+		permission string
+		allowed    bool
+	}{
+		{subject: "owner", permission: PermissionFull, allowed: true},
+		{subject: "owner", permission: PermissionDropDatabase, allowed: true},
+		{subject: "reader", permission: PermissionRead, allowed: true},
+		{subject: "reader", permission: PermissionSelectRow, allowed: true},
+		{subject: "reader", permission: PermissionUpdateRow, allowed: false},
+		{subject: "reader", permission: PermissionFull, allowed: false},
+		{subject: "user", permission: PermissionSelectRow, allowed: true},
+		{subject: "user", permission: PermissionCreateTable, allowed: true},
+		{subject: "user", permission: PermissionGrantAccess, allowed: true},
+		{subject: "user", permission: PermissionConnect, allowed: true},
+		{subject: "user", permission: PermissionCreateDatabase, allowed: false},
+		{subject: "tables", permission: PermissionEraseRow, allowed: true},
+		{subject: "tables", permission: PermissionDescribeSchema, allowed: true},
+		{subject: "tables", permission: PermissionAlterSchema, allowed: false},
+		{subject: "anyone", permission: PermissionDescribeSchema, allowed: true},
+		{subject: "anyone", permission: PermissionSelectRow, allowed: false},
+	} {
+		t.Run(tt.subject+"/"+tt.permission, func(t *testing.T) {
+			require.Equal(t, tt.allowed, p.Allowed(tt.subject, tt.permission))
+		})
+	}
+}
+
+func TestEffectivePermissionsMerge(t *testing.T) {
+	var p EffectivePermissions
+	p.Merge(
+		Permissions{Subject: "a", PermissionNames: []string{PermissionRead}},
+		Permissions{Subject: "b", PermissionNames: []string{PermissionWrite}},
+	)
+	p.Merge(
+		Permissions{Subject: "a", PermissionNames: []string{PermissionWrite, PermissionRead}},
+		Permissions{Subject: "c", PermissionNames: []string{PermissionFull}},
+	)
+	require.Equal(t, []Permissions{
+		{Subject: "a", PermissionNames: []string{PermissionRead, PermissionWrite}},
+		{Subject: "b", PermissionNames: []string{PermissionWrite}},
+		{Subject: "c", PermissionNames: []string{PermissionFull}},
+	}, p.Permissions)
+}
+
+func TestDescribeEffectivePermissionsNotSupported(t *testing.T) {
+	_, err := DescribeEffectivePermissions(context.Background(), struct{ Client }{}, "/local")
+	require.Error(t, err)
+}
@@ -35,6 +35,16 @@ const (
 	EntryTopic
 	EntryColumnStore
 	EntryColumnTable
+	EntryExternalTable
+	EntryExternalDataSource
+	EntryView
+)
+
+// entry types which are not defined yet in Ydb_Scheme protos of ydb-go-genproto
+const (
+	entryExternalTable      = Ydb_Scheme.Entry_Type(18)
+	entryExternalDataSource = Ydb_Scheme.Entry_Type(19)
+	entryView               = Ydb_Scheme.Entry_Type(20)
 )
 
 func (t EntryType) String() string {
@@ -61,6 +71,12 @@ func (t EntryType) String() string {
 		return "ColumnStore"
 	case EntryColumnTable:
 		return "ColumnTable"
+	case EntryExternalTable:
+		return "ExternalTable"
+	case EntryExternalDataSource:
+		return "ExternalDataSource"
+	case EntryView:
+		return "View"
 	}
 }
 
@@ -108,6 +124,18 @@ func (e *Entry) IsTopic() bool {
 	return e.Type == EntryTopic
 }
 
+func (e *Entry) IsExternalTable() bool {
+	return e.Type == EntryExternalTable
+}
+
+func (e *Entry) IsExternalDataSource() bool {
+	return e.Type == EntryExternalDataSource
+}
+
+func (e *Entry) IsView() bool {
+	return e.Type == EntryView
+}
+
 func (e *Entry) From(y *Ydb_Scheme.Entry) {
 	*e = Entry{
 		Name:                 y.GetName(),
@@ -140,6 +168,12 @@ func entryType(t Ydb_Scheme.Entry_Type) EntryType {
 		return EntryColumnStore
 	case Ydb_Scheme.Entry_COLUMN_TABLE:
 		return EntryColumnTable
+	case entryExternalTable:
+		return EntryExternalTable
+	case entryExternalDataSource:
+		return EntryExternalDataSource
+	case entryView:
+		return EntryView
 	default:
 		return EntryTypeUnknown
 	}
@@ -0,0 +1,30 @@
+package scheme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+)
+
+func TestEntryFrom(t *testing.T) {
+	for _, tt := range []struct {
+		src Ydb_Scheme.Entry_Type
+		dst EntryType
+		str string
+	}{
+		{src: Ydb_Scheme.Entry_DIRECTORY, dst: EntryDirectory, str: "Directory"},
+		{src: Ydb_Scheme.Entry_COLUMN_TABLE, dst: EntryColumnTable, str: "ColumnTable"},
+		{src: 18, dst: EntryExternalTable, str: "ExternalTable"},
+		{src: 19, dst: EntryExternalDataSource, str: "ExternalDataSource"},
+		{src: 20, dst: EntryView, str: "View"},
+		{src: 100, dst: EntryTypeUnknown, str: "Unknown"},
+	} {
+		t.Run(tt.str, func(t *testing.T) {
+			var e Entry
+			e.From(&Ydb_Scheme.Entry{Name: "test", Type: tt.src})
+			require.Equal(t, tt.dst, e.Type)
+			require.Equal(t, tt.str, e.Type.String())
+		})
+	}
+}
@@ -2,8 +2,11 @@ package scripting
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
 )
@@ -35,3 +38,18 @@ type Client interface {
 		params *params.Parameters,
 	) (result.StreamResult, error)
 }
+
+// Validate checks syntax and semantics of query with explain in validate mode without execution of query.
+// Issues of query (errors and warnings) are returned with positions in query text.
+// Error is not nil if query is invalid, such error also contains issues of query (see ydb.Issues)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Validate(ctx context.Context, c Client, query string) ([]issues.Issue, error) {
+	if cc, has := c.(interface {
+		Validate(ctx context.Context, query string) ([]issues.Issue, error)
+	}); has {
+		return cc.Validate(ctx, query)
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("client %T not supported validation of queries", c))
+}
@@ -0,0 +1,94 @@
+package ydb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrFeatureNotSupportedByDatabase returns from calls of service client (topic, coordination, etc.)
+// if database rejects call as unimplemented or unsupported and discovered endpoints of database
+// do not advertise required service. For example, topics may be disabled in database.
+// Error of call is joined with ErrFeatureNotSupportedByDatabase, so original error is available too
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrFeatureNotSupportedByDatabase = xerrors.Wrap(errors.New("feature not supported by database"))
+
+// names of services which advertised by endpoints in discovery
+const (
+	serviceTopic        = "topic"
+	serviceCoordination = "kesus"
+	serviceRatelimiter  = "rate_limiter"
+	serviceScripting    = "scripting"
+	serviceQuery        = "query_service"
+	serviceExport       = "export"
+	serviceImport       = "import"
+	serviceMonitoring   = "monitoring"
+)
+
+// serviceCheckedBalancer explains failed calls of service which is not advertised by discovered endpoints.
+// Calls are always sent to database because list of services may be missing or contain names which are
+// unknown to SDK, so database is the only source of truth about supported services
+type serviceCheckedBalancer struct {
+	driverBalancer
+
+	service string
+}
+
+func withServiceCheck(b driverBalancer, service string) *serviceCheckedBalancer {
+	return &serviceCheckedBalancer{
+		driverBalancer: b,
+		service:        service,
+	}
+}
+
+func (b *serviceCheckedBalancer) check(err error) error {
+	if !xerrors.IsTransportError(err, grpcCodes.Unimplemented) &&
+		!xerrors.IsOperationError(err, Ydb.StatusIds_UNSUPPORTED) {
+		return err
+	}
+	if b.HasService(b.service) {
+		return err
+	}
+
+	return xerrors.Join(
+		fmt.Errorf("%w: discovered endpoints do not advertise %q service",
+			ErrFeatureNotSupportedByDatabase, b.service,
+		),
+		err,
+	)
+}
+
+func (b *serviceCheckedBalancer) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	if err := b.driverBalancer.Invoke(ctx, method, args, reply, opts...); err != nil {
+		return xerrors.WithStackTrace(b.check(err))
+	}
+
+	return nil
+}
+
+func (b *serviceCheckedBalancer) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	stream, err := b.driverBalancer.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(b.check(err))
+	}
+
+	return stream, nil
+}
@@ -0,0 +1,114 @@
+package ydb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+)
+
+// servicesBalancerStub is a balancer which advertises given services and fails all calls with given error
+type servicesBalancerStub struct {
+	driverBalancer
+
+	services map[string]bool
+	err      error
+	calls    int
+}
+
+func (b *servicesBalancerStub) HasService(service string) bool {
+	if b.services == nil {
+		return true
+	}
+
+	return b.services[service]
+}
+
+func (b *servicesBalancerStub) Invoke(context.Context, string, interface{}, interface{}, ...grpc.CallOption) error {
+	b.calls++
+
+	return b.err
+}
+
+func (b *servicesBalancerStub) NewStream(
+	context.Context, *grpc.StreamDesc, string, ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	b.calls++
+
+	return nil, b.err
+}
+
+func TestServiceCheckedBalancer(t *testing.T) {
+	unimplemented := xerrors.Transport(grpcStatus.Error(grpcCodes.Unimplemented, "unknown service"))
+	for _, tt := range []struct {
+		name         string
+		services     map[string]bool
+		err          error
+		notSupported bool
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:         "NotAdvertisedUnimplemented",
+			services:     map[string]bool{"table_service": true},
+			err:          unimplemented,
+			notSupported: true,
+		},
+		{
+			name:         "NotAdvertisedUnsupported",
+			services:     map[string]bool{"table_service": true},
+			err:          xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNSUPPORTED)),
+			notSupported: true,
+		},
+		{
+			name:     "NotAdvertisedOtherError",
+			services: map[string]bool{"table_service": true},
+			err:      xerrors.Transport(grpcStatus.Error(grpcCodes.Unavailable, "")),
+		},
+		{
+			name:     "AdvertisedUnimplemented",
+			services: map[string]bool{serviceTopic: true},
+			err:      unimplemented,
+		},
+		{
+			name: "UnknownServicesUnimplemented",
+			err:  unimplemented,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &servicesBalancerStub{
+				services: tt.services,
+				err:      tt.err,
+			}
+			b := withServiceCheck(stub, serviceTopic)
+			ctx := xtest.Context(t)
+
+			invokeErr := b.Invoke(ctx, "/Ydb.Topic.V1.TopicService/DropTopic", nil, nil)
+			_, streamErr := b.NewStream(ctx, &grpc.StreamDesc{}, "/Ydb.Topic.V1.TopicService/StreamRead")
+
+			// call is always sent to database regardless of advertised services
+			require.Equal(t, 2, stub.calls)
+			for _, err := range []error{invokeErr, streamErr} {
+				if tt.err == nil {
+					require.NoError(t, err)
+
+					continue
+				}
+				require.ErrorIs(t, err, tt.err)
+				require.Equal(t, tt.notSupported, errors.Is(err, ErrFeatureNotSupportedByDatabase))
+				if tt.notSupported {
+					require.Contains(t, err.Error(), serviceTopic)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package ydb
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/balancer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+var errBalancerClosed = xerrors.Wrap(errors.New("balancer closed"))
+
+// driverBalancer is a balancer of driver clients
+type driverBalancer interface {
+	grpc.ClientConnInterface
+
+	HasNode(id uint32) bool
+	HasService(service string) bool
+	Snapshot() []balancer.EndpointSnapshot
+	Ready() bool
+	Close(ctx context.Context) error
+}
+
+var (
+	_ driverBalancer = (*sharedBalancer)(nil)
+	_ driverBalancer = (*databaseBalancer)(nil)
+)
+
+// sharedBalancer is a reference-counted balancer which is shared between driver and
+// child drivers of other databases (see Driver.WithDatabase).
+// Balancer closes on release of last reference
+type sharedBalancer struct {
+	*balancer.Balancer
+
+	refs atomic.Int64
+}
+
+func newSharedBalancer(b *balancer.Balancer) *sharedBalancer {
+	s := &sharedBalancer{
+		Balancer: b,
+	}
+	s.refs.Store(1)
+
+	return s
+}
+
+func (b *sharedBalancer) acquire() error {
+	for {
+		refs := b.refs.Load()
+		if refs <= 0 {
+			return xerrors.WithStackTrace(errBalancerClosed)
+		}
+		if b.refs.CompareAndSwap(refs, refs+1) {
+			return nil
+		}
+	}
+}
+
+func (b *sharedBalancer) Close(ctx context.Context) error {
+	if b.refs.Add(-1) != 0 {
+		return nil
+	}
+
+	return b.Balancer.Close(ctx)
+}
+
+// databaseBalancer makes calls over shared balancer with overridden database header
+type databaseBalancer struct {
+	*sharedBalancer
+
+	database string
+}
+
+func (b *databaseBalancer) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	return b.sharedBalancer.Invoke(meta.WithDatabase(ctx, b.database), method, args, reply, opts...)
+}
+
+func (b *databaseBalancer) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return b.sharedBalancer.NewStream(meta.WithDatabase(ctx, b.database), desc, method, opts...)
+}
@@ -82,6 +82,24 @@ func (d *sqlDriver) detach(c *xsql.Connector) {
 
 type QueryMode = xsql.QueryMode
 
+// ErrQueryModeInTx returns from database/sql calls with SchemeQueryMode or ScriptingQueryMode inside
+// transaction. Scheme queries and scripts must be executed outside of transaction (or with WithFakeTx)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrQueryModeInTx = xsql.ErrQueryModeInTx
+
+// ErrRowsUsedAfterTxFinished returns from database/sql rows which were queried inside transaction
+// and are iterated after commit or rollback of transaction. Driver closes such rows at commit and rollback
+//
+// Note: database/sql closes rows of transaction at commit and rollback too (asynchronously, with
+// cancellation of transaction context), so Rows.Next of *sql.Rows returns false and Rows.Err
+// reports context.Canceled. ErrRowsUsedAfterTxFinished is reported by Rows.Err additionally only
+// if rows are iterated before database/sql closed them. Always check errors.Is(err, context.Canceled)
+// for rows of finished transaction
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrRowsUsedAfterTxFinished = xsql.ErrRowsUsedAfterTxFinished
+
 const (
 	DataQueryMode      = xsql.DataQueryMode
 	ExplainQueryMode   = xsql.ExplainQueryMode
@@ -90,6 +108,24 @@ const (
 	ScriptingQueryMode = xsql.ScriptingQueryMode
 )
 
+// JSONArg is a database/sql query arg of Json or JsonDocument type
+type JSONArg = bind.JSON
+
+// JSON wraps Go value v for passing as database/sql query arg of Json type
+//
+// Value v is marshaled with json.Marshal. Strings, bytes and json.RawMessage are treated
+// as already encoded JSON and only validated, so invalid UTF-8 or invalid JSON fails on the client side.
+func JSON(v interface{}) JSONArg {
+	return bind.NewJSON(v, false)
+}
+
+// JSONDocument wraps Go value v for passing as database/sql query arg of JsonDocument type
+//
+// Value v is marshaled same as in JSON.
+func JSONDocument(v interface{}) JSONArg {
+	return bind.NewJSON(v, true)
+}
+
 func WithQueryMode(ctx context.Context, mode QueryMode) context.Context {
 	return xsql.WithQueryMode(ctx, mode)
 }
@@ -168,6 +204,7 @@ func Connector(parent *Driver, opts ...ConnectorOption) (SQLConnector, error) {
 			xsql.WithOnClose(d.detach),
 			xsql.WithTraceRetry(parent.config.TraceRetry()),
 			xsql.WithretryBudget(parent.config.RetryBudget()),
+			xsql.WithQueryTextRedactor(parent.config.RedactQueryText),
 		)...,
 	)
 	if err != nil {
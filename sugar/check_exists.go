@@ -8,6 +8,8 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
 )
 
+// IsTableExists checks that row table with absolute path absTablePath exists.
+// It returns false without error only if the table is not found, access and transport errors are returned as is
 func IsTableExists(ctx context.Context, c scheme.Client, absTablePath string) (exists bool, _ error) {
 	exists, err := helpers.IsEntryExists(ctx, c, absTablePath, scheme.EntryTable)
 	if err != nil {
@@ -17,6 +19,8 @@ func IsTableExists(ctx context.Context, c scheme.Client, absTablePath string) (e
 	return exists, nil
 }
 
+// IsColumnTableExists checks that column table with absolute path absTablePath exists.
+// It returns false without error only if the table is not found, access and transport errors are returned as is
 func IsColumnTableExists(ctx context.Context, c scheme.Client, absTablePath string) (exists bool, _ error) {
 	exists, err := helpers.IsEntryExists(ctx, c, absTablePath, scheme.EntryColumnTable)
 	if err != nil {
@@ -26,6 +30,9 @@ func IsColumnTableExists(ctx context.Context, c scheme.Client, absTablePath stri
 	return exists, nil
 }
 
+// IsEntryExists checks that scheme entry with absolute path absPath and one of entryTypes exists.
+// It returns false without error only if the entry is not found, access and transport errors are returned as is.
+// Error is also returned if the entry exists but its type is not one of entryTypes
 func IsEntryExists(ctx context.Context, c scheme.Client, absPath string, entryTypes ...scheme.EntryType) (
 	exists bool, _ error,
 ) {
@@ -37,6 +44,8 @@ func IsEntryExists(ctx context.Context, c scheme.Client, absPath string, entryTy
 	return exists, nil
 }
 
+// IsDirectoryExists checks that directory with absolute path absTablePath exists.
+// It returns false without error only if the directory is not found, access and transport errors are returned as is
 func IsDirectoryExists(ctx context.Context, c scheme.Client, absTablePath string) (exists bool, _ error) {
 	exists, err := helpers.IsDirectoryExists(ctx, c, absTablePath)
 	if err != nil {
@@ -1,6 +1,13 @@
 package sugar
 
-import "net/url"
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xsql"
+)
 
 // Usage of this package
 //
@@ -8,20 +15,293 @@ import "net/url"
 //   sugar.DSN("endpoint", "database", false),
 // )
 
-// DSN makes connection string (data source name) by endpoint, database and secure
-func DSN(endpoint, database string, secure bool) (s string) {
+// names of query params of connection string are shared with parser of connection string
+// of ydb.Open and database/sql driver
+const (
+	dsnParamDatabase       = xsql.DSNParamDatabase
+	dsnParamToken          = xsql.DSNParamToken
+	dsnParamBalancer       = xsql.DSNParamBalancer
+	dsnParamBalancerAlias  = xsql.DSNParamBalancerAlias
+	dsnParamQueryMode      = xsql.DSNParamQueryMode
+	dsnParamQueryModeAlias = xsql.DSNParamQueryModeAlias
+	dsnParamFakeTx         = xsql.DSNParamFakeTx
+	dsnParamQueryBind      = xsql.DSNParamQueryBind
+
+	tablePathPrefixBind = xsql.TablePathPrefixBinder
+)
+
+var tablePathPrefixBindRe = regexp.MustCompile("^" + tablePathPrefixBind + `\((.*)\)$`)
+
+// DSNParam describes query param of connection string which is recognized by ydb-go-sdk
+type DSNParam struct {
+	Name        string
+	Aliases     []string
+	Description string
+}
+
+// DSNUsage returns description of query params of connection string which are recognized by ydb-go-sdk.
+// Result can be used for printing help of connection string in command line tools
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DSNUsage() []DSNParam {
+	return []DSNParam{
+		{
+			Name:        dsnParamDatabase,
+			Description: "database path, overrides path of connection string",
+		},
+		{
+			Name:        dsnParamToken,
+			Description: "access token for authentication",
+		},
+		{
+			Name:        dsnParamBalancer,
+			Aliases:     []string{dsnParamBalancerAlias},
+			Description: "balancer type (round_robin, random_choice, single, disable) or balancer config in JSON",
+		},
+		{
+			Name:        dsnParamQueryMode,
+			Aliases:     []string{dsnParamQueryModeAlias},
+			Description: "default query mode of database/sql driver (data, scan, explain, scheme, scripting)",
+		},
+		{
+			Name:        dsnParamFakeTx,
+			Description: "comma-separated query modes of database/sql driver with fake transactions",
+		},
+		{
+			Name: dsnParamQueryBind,
+			Description: "comma-separated query binders of database/sql driver " +
+				"(declare, positional, numeric, " + tablePathPrefixBind + "(path))",
+		},
+	}
+}
+
+// DSNInfo is a typed representation of connection string
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type DSNInfo struct {
+	Endpoint string
+	Database string
+	Secure   bool
+
+	User     string
+	Password string
+	Token    string
+
+	// Balancer is a balancer type or balancer config in JSON
+	Balancer string
+
+	// QueryMode is a default query mode of database/sql driver
+	QueryMode string
+
+	// FakeTx is a list of query modes of database/sql driver with fake transactions
+	FakeTx []string
+
+	// QueryBind is a list of query binders of database/sql driver except table path prefix
+	QueryBind []string
+
+	// TablePathPrefix is a prefix of table paths of database/sql driver
+	TablePathPrefix string
+
+	// Params contains query params of connection string which are not recognized by ydb-go-sdk
+	Params url.Values
+}
+
+// String makes connection string (data source name) from info
+func (info DSNInfo) String() string {
 	qp := url.Values{}
+	for k, v := range info.Params {
+		qp[k] = append([]string(nil), v...)
+	}
+	if info.Token != "" {
+		qp.Set(dsnParamToken, info.Token)
+	}
+	if info.Balancer != "" {
+		qp.Set(dsnParamBalancer, info.Balancer)
+	}
+	if info.QueryMode != "" {
+		qp.Set(dsnParamQueryMode, info.QueryMode)
+	}
+	if len(info.FakeTx) > 0 {
+		qp.Set(dsnParamFakeTx, strings.Join(info.FakeTx, ","))
+	}
+	binders := append([]string(nil), info.QueryBind...)
+	if info.TablePathPrefix != "" {
+		binders = append(binders, tablePathPrefixBind+"("+info.TablePathPrefix+")")
+	}
+	if len(binders) > 0 {
+		qp.Set(dsnParamQueryBind, strings.Join(binders, ","))
+	}
 
 	dsn := url.URL{
 		Scheme:   "grpc",
-		Host:     endpoint,
-		Path:     database,
+		Host:     info.Endpoint,
+		Path:     info.Database,
 		RawQuery: qp.Encode(),
 	}
 
-	if secure {
+	if info.Secure {
 		dsn.Scheme = "grpcs"
 	}
 
+	if info.User != "" {
+		if info.Password != "" {
+			dsn.User = url.UserPassword(info.User, info.Password)
+		} else {
+			dsn.User = url.User(info.User)
+		}
+	}
+
 	return dsn.String()
 }
+
+type dsnOption func(info *DSNInfo)
+
+// WithUserPassword adds user and password to connection string
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithUserPassword(user, password string) dsnOption {
+	return func(info *DSNInfo) {
+		info.User = user
+		info.Password = password
+	}
+}
+
+// WithToken adds access token to connection string
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithToken(token string) dsnOption {
+	return func(info *DSNInfo) {
+		info.Token = token
+	}
+}
+
+// WithBalancer adds balancer type or balancer config in JSON to connection string
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithBalancer(balancer string) dsnOption {
+	return func(info *DSNInfo) {
+		info.Balancer = balancer
+	}
+}
+
+// WithQueryMode adds default query mode of database/sql driver to connection string
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryMode(mode string) dsnOption {
+	return func(info *DSNInfo) {
+		info.QueryMode = mode
+	}
+}
+
+// WithFakeTx adds query modes of database/sql driver with fake transactions to connection string
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFakeTx(modes ...string) dsnOption {
+	return func(info *DSNInfo) {
+		info.FakeTx = append(info.FakeTx, modes...)
+	}
+}
+
+// WithQueryBind adds query binders (declare, positional, numeric) of database/sql driver to connection string
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithQueryBind(binders ...string) dsnOption {
+	return func(info *DSNInfo) {
+		info.QueryBind = append(info.QueryBind, binders...)
+	}
+}
+
+// WithTablePathPrefix adds table path prefix of database/sql driver to connection string.
+// Prefix must not contain commas because query binders are separated by commas
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithTablePathPrefix(prefix string) dsnOption {
+	return func(info *DSNInfo) {
+		info.TablePathPrefix = prefix
+	}
+}
+
+// WithParam adds query param which is not recognized by ydb-go-sdk to connection string
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithParam(name, value string) dsnOption {
+	return func(info *DSNInfo) {
+		if info.Params == nil {
+			info.Params = url.Values{}
+		}
+		info.Params.Add(name, value)
+	}
+}
+
+// DSN makes connection string (data source name) by endpoint, database, secure and options
+func DSN(endpoint, database string, secure bool, opts ...dsnOption) (s string) {
+	info := DSNInfo{
+		Endpoint: endpoint,
+		Database: database,
+		Secure:   secure,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&info)
+		}
+	}
+
+	return info.String()
+}
+
+// ParseDSN parses connection string (data source name) into typed info.
+// Connection string is validated with the same rules as in ydb.Open and database/sql driver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ParseDSN(dsn string) (info *DSNInfo, _ error) {
+	if _, _, err := xsql.Parse(dsn); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	uri, err := url.Parse(dsn)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	info = &DSNInfo{
+		Endpoint: uri.Host,
+		Database: uri.Path,
+		Secure:   uri.Scheme != "grpc",
+	}
+	if uri.User != nil {
+		info.User = uri.User.Username()
+		info.Password, _ = uri.User.Password()
+	}
+	qp := uri.Query()
+	pop := func(names ...string) (value string) {
+		for _, name := range names {
+			if v := qp.Get(name); v != "" && value == "" {
+				value = v
+			}
+			delete(qp, name)
+		}
+
+		return value
+	}
+	if database := pop(dsnParamDatabase); database != "" {
+		info.Database = database
+	}
+	info.Token = pop(dsnParamToken)
+	info.Balancer = pop(dsnParamBalancer, dsnParamBalancerAlias)
+	info.QueryMode = pop(dsnParamQueryMode, dsnParamQueryModeAlias)
+	if fakeTx := pop(dsnParamFakeTx); fakeTx != "" {
+		info.FakeTx = strings.Split(fakeTx, ",")
+	}
+	if binders := pop(dsnParamQueryBind); binders != "" {
+		for _, binder := range strings.Split(binders, ",") {
+			if ss := tablePathPrefixBindRe.FindStringSubmatch(binder); len(ss) == 2 {
+				info.TablePathPrefix = ss[1]
+			} else {
+				info.QueryBind = append(info.QueryBind, binder)
+			}
+		}
+	}
+	if len(qp) > 0 {
+		info.Params = qp
+	}
+
+	return info, nil
+}
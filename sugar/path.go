@@ -44,6 +44,14 @@ type dbFoRemoveRecursive interface {
 	dbTopic
 }
 
+// Path returns absolute path of scheme entry inside database.
+// Components of relative path are joined with database root with cleaning of repeated, leading and trailing slashes
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Path(db dbName, relative ...string) string {
+	return path.Join(append([]string{db.Name()}, relative...)...)
+}
+
 // MakeRecursive creates path inside database
 // pathToCreate is a database root relative path
 // MakeRecursive method equal bash command `mkdir -p ~/path/to/create`
@@ -119,6 +127,8 @@ func RemoveRecursive(ctx context.Context, db dbFoRemoveRecursive, pathToRemove s
 			)
 		}
 
+		// external data sources must be removed after external tables, which use them
+		var externalDataSources []string
 		for j := range dir.Children {
 			pt := path.Join(p, dir.Children[j].Name)
 			if pt == fullSysTablePath {
@@ -150,6 +160,23 @@ func RemoveRecursive(ctx context.Context, db dbFoRemoveRecursive, pathToRemove s
 					)
 				}
 
+			case scheme.EntryExternalTable:
+				if err = dropSchemeObject(ctx, db, "EXTERNAL TABLE", pt); err != nil {
+					return xerrors.WithStackTrace(
+						fmt.Errorf("removing external table %q failed: %w", pt, err),
+					)
+				}
+
+			case scheme.EntryView:
+				if err = dropSchemeObject(ctx, db, "VIEW", pt); err != nil {
+					return xerrors.WithStackTrace(
+						fmt.Errorf("removing view %q failed: %w", pt, err),
+					)
+				}
+
+			case scheme.EntryExternalDataSource:
+				externalDataSources = append(externalDataSources, pt)
+
 			default:
 				return xerrors.WithStackTrace(
 					fmt.Errorf("unknown entry type: %s", t.String()),
@@ -157,6 +184,14 @@ func RemoveRecursive(ctx context.Context, db dbFoRemoveRecursive, pathToRemove s
 			}
 		}
 
+		for _, pt := range externalDataSources {
+			if err = dropSchemeObject(ctx, db, "EXTERNAL DATA SOURCE", pt); err != nil {
+				return xerrors.WithStackTrace(
+					fmt.Errorf("removing external data source %q failed: %w", pt, err),
+				)
+			}
+		}
+
 		if entry.Type != scheme.EntryDirectory {
 			return nil
 		}
@@ -176,3 +211,9 @@ func RemoveRecursive(ctx context.Context, db dbFoRemoveRecursive, pathToRemove s
 
 	return rmPath(0, pathToRemove)
 }
+
+func dropSchemeObject(ctx context.Context, db dbFoRemoveRecursive, objectType, objectPath string) error {
+	return db.Table().Do(ctx, func(ctx context.Context, session table.Session) (err error) {
+		return session.ExecuteSchemeQuery(ctx, fmt.Sprintf("DROP %s `%s`", objectType, objectPath))
+	}, table.WithIdempotent())
+}
@@ -0,0 +1,46 @@
+package sugar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type dbNameStub string
+
+func (n dbNameStub) Name() string {
+	return string(n)
+}
+
+func TestPath(t *testing.T) {
+	for _, tt := range []struct {
+		database string
+		relative []string
+		path     string
+	}{
+		{
+			database: "/local",
+			relative: nil,
+			path:     "/local",
+		},
+		{
+			database: "/local",
+			relative: []string{"table"},
+			path:     "/local/table",
+		},
+		{
+			database: "/local/",
+			relative: []string{"/dir/", "table"},
+			path:     "/local/dir/table",
+		},
+		{
+			database: "/local",
+			relative: []string{"dir//subdir", "", "table/"},
+			path:     "/local/dir/subdir/table",
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			require.Equal(t, tt.path, Path(dbNameStub(tt.database), tt.relative...))
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
+)
+
+const defaultValidateConcurrency = 4
+
+// ErrInvalidYQLFiles is returned by ValidateYQLFiles if validation of at least one file failed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrInvalidYQLFiles = xerrors.Wrap(errors.New("invalid YQL files"))
+
+var tablePathPrefixPragma = regexp.MustCompile(
+	`(?i)PRAGMA[ \t]+TablePathPrefix[ \t]*(?:\([ \t]*(?:"[^"\n]*"|'[^'\n]*')[ \t]*\)|=[ \t]*(?:"[^"\n]*"|'[^'\n]*'))`,
+)
+
+// YQLFileValidation is a result of validation of YQL file
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type YQLFileValidation struct {
+	Path string
+	// Issues are errors and warnings of query with positions in query text
+	Issues []issues.Issue
+	// Err is not nil if file cannot be read or query is invalid
+	Err error
+}
+
+type validateYQLFilesOptions struct {
+	tablePathPrefix string
+	concurrency     int
+}
+
+// ValidateYQLFilesOption is an option of ValidateYQLFiles
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ValidateYQLFilesOption func(o *validateYQLFilesOptions)
+
+// WithValidateTablePathPrefix replaces value of PRAGMA TablePathPrefix of files with prefix.
+// Pragma is added to files without it
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithValidateTablePathPrefix(prefix string) ValidateYQLFilesOption {
+	return func(o *validateYQLFilesOptions) {
+		o.tablePathPrefix = prefix
+	}
+}
+
+// WithValidateConcurrency limits number of files which are validated concurrently. Default is 4
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithValidateConcurrency(n int) ValidateYQLFilesOption {
+	return func(o *validateYQLFilesOptions) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// ValidateYQLFiles validates YQL files with scripting.Validate without execution of queries,
+// for example in CI with db.Scripting() client. Results are returned in order of paths.
+// Error is not nil if at least one file is invalid or cannot be read: it is ErrInvalidYQLFiles
+// joined with errors of such files prefixed by path
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ValidateYQLFiles(ctx context.Context, c scripting.Client, paths []string, opts ...ValidateYQLFilesOption) (
+	[]YQLFileValidation, error,
+) {
+	options := validateYQLFilesOptions{concurrency: defaultValidateConcurrency}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	var (
+		results = make([]YQLFileValidation, len(paths))
+		sem     = make(chan struct{}, options.concurrency)
+		wg      sync.WaitGroup
+	)
+	for i, path := range paths {
+		results[i].Path = path
+		if err := ctx.Err(); err != nil {
+			results[i].Err = xerrors.WithStackTrace(err)
+
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			results[i].Err = xerrors.WithStackTrace(ctx.Err())
+
+			continue
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(result *YQLFileValidation) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result.Issues, result.Err = validateYQLFile(ctx, c, result.Path, options.tablePathPrefix)
+		}(&results[i])
+	}
+	wg.Wait()
+
+	errs := []error{ErrInvalidYQLFiles}
+	for i := range results {
+		if results[i].Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", results[i].Path, results[i].Err))
+		}
+	}
+	if len(errs) > 1 {
+		return results, xerrors.WithStackTrace(xerrors.Join(errs...))
+	}
+
+	return results, nil
+}
+
+func validateYQLFile(ctx context.Context, c scripting.Client, path, tablePathPrefix string) ([]issues.Issue, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+	query, addedLines := withTablePathPrefix(string(content), tablePathPrefix)
+	queryIssues, err := scripting.Validate(ctx, c, query)
+	shiftIssueRows(queryIssues, addedLines)
+	if err != nil {
+		return queryIssues, xerrors.WithStackTrace(err)
+	}
+
+	return queryIssues, nil
+}
+
+// withTablePathPrefix replaces value of PRAGMA TablePathPrefix of query or adds such pragma
+// if query has no one. It returns number of lines which are added before query text
+func withTablePathPrefix(query, tablePathPrefix string) (_ string, addedLines uint32) {
+	if tablePathPrefix == "" {
+		return query, 0
+	}
+	pragma := fmt.Sprintf("PRAGMA TablePathPrefix(%q);", tablePathPrefix)
+	if tablePathPrefixPragma.MatchString(query) {
+		return tablePathPrefixPragma.ReplaceAllLiteralString(query, strings.TrimSuffix(pragma, ";")), 0
+	}
+
+	return pragma + "\n" + query, 1
+}
+
+// shiftIssueRows moves positions of issues back to rows of file after lines added by withTablePathPrefix
+func shiftIssueRows(queryIssues []issues.Issue, addedLines uint32) {
+	if addedLines == 0 {
+		return
+	}
+	for i := range queryIssues {
+		if queryIssues[i].Position.Row > addedLines {
+			queryIssues[i].Position.Row -= addedLines
+		}
+		shiftIssueRows(queryIssues[i].Issues, addedLines)
+	}
+}
@@ -0,0 +1,157 @@
+package sugar
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scripting"
+)
+
+var errSyntax = errors.New("syntax error")
+
+type validateStub struct {
+	scripting.Client
+
+	mu       sync.Mutex
+	queries  []string
+	active   int
+	maxLimit int
+}
+
+func (s *validateStub) Validate(ctx context.Context, query string) ([]issues.Issue, error) {
+	s.mu.Lock()
+	s.queries = append(s.queries, query)
+	s.active++
+	if s.active > s.maxLimit {
+		s.maxLimit = s.active
+	}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.active--
+		s.mu.Unlock()
+	}()
+
+	if strings.Contains(query, "SELCT") {
+		return []issues.Issue{{
+			Message:  "syntax error",
+			Position: issues.Position{Row: 2, Column: 1},
+			Issues: []issues.Issue{{
+				Message:  "unexpected token",
+				Position: issues.Position{Row: 2, Column: 1},
+			}},
+		}}, errSyntax
+	}
+
+	return []issues.Issue{{Message: "warning", Position: issues.Position{Row: 1, Column: 1}}}, nil
+}
+
+func TestValidateYQLFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		return path
+	}
+	valid := writeFile("valid.yql", "SELECT 1;")
+	invalid := writeFile("invalid.yql", "-- comment\nSELCT 1;")
+	missing := filepath.Join(dir, "missing.yql")
+
+	t.Run("Valid", func(t *testing.T) {
+		c := &validateStub{}
+		results, err := ValidateYQLFiles(context.Background(), c, []string{valid, valid})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, valid, results[0].Path)
+		require.Len(t, results[0].Issues, 1)
+		require.NoError(t, results[0].Err)
+		require.Equal(t, []string{"SELECT 1;", "SELECT 1;"}, c.queries)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		c := &validateStub{}
+		results, err := ValidateYQLFiles(context.Background(), c, []string{valid, invalid, missing},
+			WithValidateTablePathPrefix("/local/prefix"),
+		)
+		require.ErrorIs(t, err, ErrInvalidYQLFiles)
+		require.ErrorIs(t, err, errSyntax)
+		require.ErrorIs(t, err, os.ErrNotExist)
+		require.Contains(t, err.Error(), invalid)
+		require.Len(t, results, 3)
+		require.NoError(t, results[0].Err)
+		require.ErrorIs(t, results[1].Err, errSyntax)
+		// issue rows are rows of file without added pragma
+		require.Equal(t, uint32(1), results[1].Issues[0].Position.Row)
+		require.Equal(t, uint32(1), results[1].Issues[0].Issues[0].Position.Row)
+		require.ErrorIs(t, results[2].Err, os.ErrNotExist)
+		require.Len(t, c.queries, 2)
+	})
+	t.Run("Concurrency", func(t *testing.T) {
+		c := &validateStub{}
+		paths := make([]string, 20)
+		for i := range paths {
+			paths[i] = valid
+		}
+		_, err := ValidateYQLFiles(context.Background(), c, paths, WithValidateConcurrency(2))
+		require.NoError(t, err)
+		require.Len(t, c.queries, len(paths))
+		require.LessOrEqual(t, c.maxLimit, 2)
+	})
+	t.Run("CanceledContext", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		c := &validateStub{}
+		results, err := ValidateYQLFiles(ctx, c, []string{valid}, WithValidateConcurrency(1))
+		require.ErrorIs(t, err, context.Canceled)
+		require.ErrorIs(t, results[0].Err, context.Canceled)
+	})
+}
+
+func TestWithTablePathPrefix(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		query      string
+		prefix     string
+		result     string
+		addedLines uint32
+	}{
+		{
+			name:   "NoPrefix",
+			query:  "SELECT 1;",
+			result: "SELECT 1;",
+		},
+		{
+			name:       "AddPragma",
+			query:      "SELECT 1;",
+			prefix:     "/local",
+			result:     "PRAGMA TablePathPrefix(\"/local\");\nSELECT 1;",
+			addedLines: 1,
+		},
+		{
+			name:   "ReplacePragma",
+			query:  "pragma TablePathPrefix('/prod');\nSELECT 1;",
+			prefix: "/local",
+			result: "PRAGMA TablePathPrefix(\"/local\");\nSELECT 1;",
+		},
+		{
+			name:   "ReplacePragmaWithAssignment",
+			query:  "PRAGMA TablePathPrefix = \"/prod\";\nSELECT 1;",
+			prefix: "/local",
+			result: "PRAGMA TablePathPrefix(\"/local\");\nSELECT 1;",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			result, addedLines := withTablePathPrefix(tt.query, tt.prefix)
+			require.Equal(t, tt.result, result)
+			require.Equal(t, tt.addedLines, addedLines)
+		})
+	}
+}
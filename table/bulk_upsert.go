@@ -0,0 +1,38 @@
+package table
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+// BulkUpsertArrow uploads Apache Arrow record batch to the table with session s.
+// schema is serialized Arrow schema, data is serialized Arrow record batch (in IPC format)
+// with columns of schema. Arrow format avoids encoding of rows into YDB values on client-side,
+// use Session.BulkUpsert with list of structs for row format
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func BulkUpsertArrow(
+	ctx context.Context,
+	s Session,
+	table string,
+	schema []byte,
+	data []byte,
+	opts ...options.BulkUpsertOption,
+) error {
+	if ss, has := s.(interface {
+		BulkUpsertArrow(
+			ctx context.Context,
+			table string,
+			schema []byte,
+			data []byte,
+			opts ...options.BulkUpsertOption,
+		) (err error)
+	}); has {
+		return ss.BulkUpsertArrow(ctx, table, schema, data, opts...)
+	}
+
+	return xerrors.WithStackTrace(fmt.Errorf("session %T not supported bulk upsert of arrow record batches", s))
+}
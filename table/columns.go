@@ -0,0 +1,230 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+var (
+	// ErrStructMismatch returns from ValidateStruct if table description differs from Go struct
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ErrStructMismatch = xerrors.Wrap(errors.New("table does not match struct"))
+
+	errNotStruct         = errors.New("value is not a struct")
+	errUnsupportedColumn = errors.New("unsupported column type")
+	errUnknownTagOption  = errors.New("unknown tag option")
+	errDuplicateColumn   = errors.New("duplicate column")
+)
+
+const primaryTagOption = "primary"
+
+type structColumn struct {
+	name    string
+	typ     types.Type
+	primary bool
+}
+
+// ColumnsFromStruct returns options of CreateTable with columns and primary key of table defined by Go struct
+//
+// Columns are named by `ydb` struct tags (or by Go field names if tag is empty) same as
+// types.ValueFromGo, fields with tag "-" and unexported fields are skipped.
+// Column types are inferred from Go types: pointer fields are mapped to Optional columns and
+// non-pointer fields are mapped to NOT NULL columns. Primary key is declared with "primary" tag
+// option and consists of primary columns in order of struct fields.
+// Indexes and other settings of table can be declared with supplementary options:
+//
+//	type user struct {
+//		ID    uint64  `ydb:"id,primary"`
+//		Email string  `ydb:"email"`
+//		Name  *string `ydb:"name"`
+//	}
+//	opts, err := table.ColumnsFromStruct(user{})
+//	if err != nil {
+//		return err
+//	}
+//	err = s.CreateTable(ctx, path, append(opts,
+//		options.WithIndex("email_idx", options.WithIndexColumns("email")),
+//	)...)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ColumnsFromStruct(v interface{}) ([]options.CreateTableOption, error) {
+	columns, err := structColumns(reflect.TypeOf(v))
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	var (
+		opts       = make([]options.CreateTableOption, 0, len(columns)+1)
+		primaryKey []string
+	)
+	for _, c := range columns {
+		opts = append(opts, options.WithColumn(c.name, c.typ))
+		if c.primary {
+			primaryKey = append(primaryKey, c.name)
+		}
+	}
+	if len(primaryKey) > 0 {
+		opts = append(opts, options.WithPrimaryKeyColumn(primaryKey...))
+	}
+
+	return opts, nil
+}
+
+// ValidateStruct checks that columns and primary key of table at given path match Go struct
+//
+// Struct is mapped to columns same as in ColumnsFromStruct. ValidateStruct returns error
+// which wraps ErrStructMismatch with list of all mismatches, such as columns missing in table or in struct
+// and columns with different types. Primary key is compared only if struct declares primary key.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ValidateStruct(ctx context.Context, s Session, path string, v interface{}) error {
+	columns, err := structColumns(reflect.TypeOf(v))
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	desc, err := s.DescribeTable(ctx, path)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	if mismatches := structMismatches(columns, &desc); len(mismatches) > 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w '%s': %s",
+			ErrStructMismatch, path, strings.Join(mismatches, "; "),
+		))
+	}
+
+	return nil
+}
+
+func structMismatches(columns []structColumn, desc *options.Description) (mismatches []string) {
+	tableColumns := make(map[string]types.Type, len(desc.Columns))
+	for _, c := range desc.Columns {
+		tableColumns[c.Name] = c.Type
+	}
+
+	var (
+		structColumns = make(map[string]struct{}, len(columns))
+		primaryKey    []string
+	)
+	for _, c := range columns {
+		structColumns[c.name] = struct{}{}
+		if c.primary {
+			primaryKey = append(primaryKey, c.name)
+		}
+		t, has := tableColumns[c.name]
+		if !has {
+			mismatches = append(mismatches, fmt.Sprintf("column '%s' not found in table", c.name))
+
+			continue
+		}
+		if !types.Equal(t, c.typ) {
+			mismatches = append(mismatches, fmt.Sprintf("column '%s' has type '%s' in table instead of '%s'",
+				c.name, t.Yql(), c.typ.Yql(),
+			))
+		}
+	}
+	for _, c := range desc.Columns {
+		if _, has := structColumns[c.Name]; !has {
+			mismatches = append(mismatches, fmt.Sprintf("column '%s' not found in struct", c.Name))
+		}
+	}
+	if len(primaryKey) > 0 && !equalStrings(primaryKey, desc.PrimaryKey) {
+		mismatches = append(mismatches, fmt.Sprintf("primary key is (%s) in table instead of (%s)",
+			strings.Join(desc.PrimaryKey, ", "), strings.Join(primaryKey, ", "),
+		))
+	}
+
+	return mismatches
+}
+
+func equalStrings(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i] != rhs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func structColumns(rt reflect.Type) ([]structColumn, error) {
+	for rt != nil && rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: '%v'", errNotStruct, rt))
+	}
+
+	var (
+		columns = make([]structColumn, 0, rt.NumField())
+		names   = make(map[string]struct{}, rt.NumField())
+	)
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name, tagOptions, _ := strings.Cut(f.Tag.Get(value.DefaultFromGoTagName), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if _, has := names[name]; has {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w '%s'", errDuplicateColumn, name))
+		}
+		names[name] = struct{}{}
+
+		c := structColumn{
+			name: name,
+		}
+		for _, opt := range strings.Split(tagOptions, ",") {
+			switch opt {
+			case "":
+			case primaryTagOption:
+				c.primary = true
+			default:
+				return nil, xerrors.WithStackTrace(fmt.Errorf("%w '%s' of field '%s'", errUnknownTagOption, opt, f.Name))
+			}
+		}
+
+		t, err := value.TypeFromGo(f.Type, value.DefaultFromGoTagName)
+		if err != nil {
+			return nil, xerrors.WithStackTrace(err)
+		}
+		if !isColumnType(t) {
+			return nil, xerrors.WithStackTrace(fmt.Errorf("%w '%s' of field '%s'", errUnsupportedColumn, t.Yql(), f.Name))
+		}
+		c.typ = t
+
+		columns = append(columns, c)
+	}
+
+	return columns, nil
+}
+
+func isColumnType(t types.Type) bool {
+	if optional, ok := t.(types.Optional); ok {
+		t = optional.InnerType()
+	}
+	switch t.(type) {
+	case types.Primitive, *types.Decimal:
+		return true
+	default:
+		return false
+	}
+}
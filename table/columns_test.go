@@ -0,0 +1,164 @@
+package table_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+type user struct {
+	ID        uint64    `ydb:"id,primary"`
+	Tenant    string    `ydb:"tenant,primary"`
+	Name      *string   `ydb:"name"`
+	Data      []byte    `ydb:"data"`
+	CreatedAt time.Time `ydb:"created_at"`
+	Comment   string    `ydb:"-"`
+	secret    string    //nolint:unused
+}
+
+func TestColumnsFromStruct(t *testing.T) {
+	for _, v := range []interface{}{user{}, &user{}} {
+		opts, err := table.ColumnsFromStruct(v)
+		require.NoError(t, err)
+		a := allocator.New()
+		var request Ydb_Table.CreateTableRequest
+		for _, opt := range append(opts, options.WithIndex("name_idx", options.WithIndexColumns("name"))) {
+			opt.ApplyCreateTableOption((*options.CreateTableDesc)(&request), a)
+		}
+		require.Equal(t, []string{"id", "tenant"}, request.GetPrimaryKey())
+		require.Len(t, request.GetColumns(), 5)
+		for i, exp := range []struct {
+			name string
+			typ  *Ydb.Type
+		}{
+			{"id", &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}}},
+			{"tenant", &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}}},
+			{"name", &Ydb.Type{Type: &Ydb.Type_OptionalType{OptionalType: &Ydb.OptionalType{
+				Item: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UTF8}},
+			}}}},
+			{"data", &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_STRING}}},
+			{"created_at", &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_TIMESTAMP}}},
+		} {
+			require.Equal(t, exp.name, request.GetColumns()[i].GetName())
+			require.Equal(t, exp.typ.String(), request.GetColumns()[i].GetType().String())
+		}
+		require.Len(t, request.GetIndexes(), 1)
+		a.Free()
+	}
+}
+
+func TestColumnsFromStructErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		v    interface{}
+	}{
+		{
+			name: "NotStruct",
+			v:    1,
+		},
+		{
+			name: "Nil",
+			v:    nil,
+		},
+		{
+			name: "UnsupportedType",
+			v: struct {
+				Tags []string `ydb:"tags"`
+			}{},
+		},
+		{
+			name: "UnknownTagOption",
+			v: struct {
+				ID uint64 `ydb:"id,primery"`
+			}{},
+		},
+		{
+			name: "DuplicateColumn",
+			v: struct {
+				ID  uint64 `ydb:"id"`
+				ID2 uint64 `ydb:"id"`
+			}{},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := table.ColumnsFromStruct(tt.v)
+			require.Error(t, err)
+		})
+	}
+}
+
+type describeTableSession struct {
+	table.Session
+
+	desc options.Description
+}
+
+func (s *describeTableSession) DescribeTable(
+	ctx context.Context, path string, opts ...options.DescribeTableOption,
+) (options.Description, error) {
+	return s.desc, nil
+}
+
+func TestValidateStruct(t *testing.T) {
+	validColumns := []options.Column{
+		{Name: "id", Type: types.TypeUint64},
+		{Name: "tenant", Type: types.TypeText},
+		{Name: "name", Type: types.Optional(types.TypeText)},
+		{Name: "data", Type: types.TypeBytes},
+		{Name: "created_at", Type: types.TypeTimestamp},
+	}
+	for _, tt := range []struct {
+		name       string
+		desc       options.Description
+		mismatches []string
+	}{
+		{
+			name: "Valid",
+			desc: options.Description{
+				Columns:    validColumns,
+				PrimaryKey: []string{"id", "tenant"},
+			},
+		},
+		{
+			name: "Mismatches",
+			desc: options.Description{
+				Columns: []options.Column{
+					{Name: "id", Type: types.Optional(types.TypeUint64)},
+					{Name: "tenant", Type: types.TypeText},
+					{Name: "data", Type: types.TypeBytes},
+					{Name: "created_at", Type: types.TypeTimestamp},
+					{Name: "email", Type: types.TypeText},
+				},
+				PrimaryKey: []string{"id"},
+			},
+			mismatches: []string{
+				"column 'id' has type 'Optional<Uint64>' in table instead of 'Uint64'",
+				"column 'name' not found in table",
+				"column 'email' not found in struct",
+				"primary key is (id) in table instead of (id, tenant)",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := table.ValidateStruct(context.Background(), &describeTableSession{desc: tt.desc}, "/local/users", user{})
+			if len(tt.mismatches) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+			require.ErrorIs(t, err, table.ErrStructMismatch)
+			for _, mismatch := range tt.mismatches {
+				require.Contains(t, err.Error(), mismatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package table
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// Explain explains data query with plan, AST and types of declared parameters without execution of query.
+// Types of parameters are taken from prepared query, so Explain calls Session.Explain and Session.Prepare.
+// Explain manages sessions of client c itself and retries on retryable errors like Client.Do with
+// WithIdempotent option
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Explain(ctx context.Context, c Client, query string, opts ...Option) (DataQueryExplanation, error) {
+	if cc, has := c.(interface {
+		Explain(ctx context.Context, query string, opts ...Option) (DataQueryExplanation, error)
+	}); has {
+		return cc.Explain(ctx, query, opts...)
+	}
+
+	return DataQueryExplanation{}, xerrors.WithStackTrace(fmt.Errorf("client %T not supported explain", c))
+}
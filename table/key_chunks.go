@@ -0,0 +1,344 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+var (
+	errWrongChunkSize = xerrors.Wrap(errors.New("chunk size must be positive"))
+	errNotListOfKeys  = xerrors.Wrap(errors.New("keys must be a list"))
+)
+
+type (
+	keyChunksOptions struct {
+		parallelism     int
+		ordered         bool
+		continueOnError bool
+		txControl       *TransactionControl
+		executeOptions  []options.ExecuteDataQueryOption
+		doOptions       []Option
+	}
+
+	// KeyChunksOption is an option of QueryWithKeyChunks
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	KeyChunksOption func(o *keyChunksOptions)
+)
+
+// WithKeyChunksParallelism executes up to n chunks concurrently, every concurrent worker uses own
+// retry-managed session. By default chunks are executed one by one within single session
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithKeyChunksParallelism(n int) KeyChunksOption {
+	return func(o *keyChunksOptions) {
+		o.parallelism = n
+	}
+}
+
+// WithKeyChunksOrdered makes calls of handler in order of chunks also with parallel execution of chunks.
+// Without this option parallel chunks are handled in order of completion and order of rows is guaranteed
+// only within single chunk
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithKeyChunksOrdered() KeyChunksOption {
+	return func(o *keyChunksOptions) {
+		o.ordered = true
+	}
+}
+
+// WithKeyChunksContinueOnError continues execution of next chunks after non-retryable error of chunk
+// (error of query or error of handler). Errors of all failed chunks are returned together after execution
+// of all chunks. By default, first error stops execution of chunks
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithKeyChunksContinueOnError() KeyChunksOption {
+	return func(o *keyChunksOptions) {
+		o.continueOnError = true
+	}
+}
+
+// WithKeyChunksTxControl defines transaction control of every chunk query (DefaultTxControl by default)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithKeyChunksTxControl(tx *TransactionControl) KeyChunksOption {
+	return func(o *keyChunksOptions) {
+		o.txControl = tx
+	}
+}
+
+// WithKeyChunksExecuteOptions defines options of execution of every chunk query
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithKeyChunksExecuteOptions(opts ...options.ExecuteDataQueryOption) KeyChunksOption {
+	return func(o *keyChunksOptions) {
+		o.executeOptions = append(o.executeOptions, opts...)
+	}
+}
+
+// WithKeyChunksDoOptions defines options of Client.Do of every session (such as WithIdempotent)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithKeyChunksDoOptions(opts ...Option) KeyChunksOption {
+	return func(o *keyChunksOptions) {
+		o.doOptions = append(o.doOptions, opts...)
+	}
+}
+
+// QueryWithKeyChunks executes query repeatedly with parameter param bound to successive chunks of list
+// keys (up to chunkSize items per chunk) and calls handler with result of every chunk. It replaces single
+// query with huge IN-list of literals which blows size of query text and compiled queries cache of server.
+// Query must declare param as List, for example:
+//
+//	DECLARE $ids AS List<Uint64>; SELECT id, title FROM series WHERE id IN $ids
+//
+// Chunks are executed within Client.Do. Retry of Do continues from the first not completed chunk, so
+// handler is called once for every successfully handled chunk. Handler must be safe for concurrent calls
+// if WithKeyChunksParallelism is used without WithKeyChunksOrdered.
+// Returned error of failed chunk contains index of chunk
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func QueryWithKeyChunks(
+	ctx context.Context,
+	c Client,
+	query string,
+	param string,
+	keys value.Value,
+	chunkSize int,
+	handler func(ctx context.Context, chunk int, res result.Result) error,
+	opts ...KeyChunksOption,
+) error {
+	if chunkSize <= 0 {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: %d", errWrongChunkSize, chunkSize))
+	}
+	list, has := keys.(interface {
+		ListItems() []value.Value
+	})
+	if !has {
+		return xerrors.WithStackTrace(fmt.Errorf("%w: '%s'", errNotListOfKeys, keys.Type().Yql()))
+	}
+
+	o := keyChunksOptions{
+		parallelism: 1,
+		txControl:   DefaultTxControl(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+
+	items := list.ListItems()
+	ex := &keyChunksExecutor{
+		client:  c,
+		query:   query,
+		param:   param,
+		handler: handler,
+		options: o,
+		chunks:  make([][]value.Value, 0, (len(items)+chunkSize-1)/chunkSize),
+	}
+	for len(items) > 0 {
+		n := chunkSize
+		if n > len(items) {
+			n = len(items)
+		}
+		ex.chunks = append(ex.chunks, items[:n])
+		items = items[n:]
+	}
+
+	return ex.execute(ctx)
+}
+
+type keyChunksExecutor struct {
+	client  Client
+	query   string
+	param   string
+	handler func(ctx context.Context, chunk int, res result.Result) error
+	options keyChunksOptions
+	chunks  [][]value.Value
+
+	// turns[i] is closed after finish of chunk i, it orders handling of chunks with WithKeyChunksOrdered
+	turns []chan struct{}
+
+	mu     sync.Mutex
+	next   int
+	errs   []error
+	cancel context.CancelFunc
+}
+
+func (ex *keyChunksExecutor) execute(ctx context.Context) error {
+	if len(ex.chunks) == 0 {
+		return nil
+	}
+
+	parentCtx := ctx
+	ctx, ex.cancel = context.WithCancel(ctx)
+	defer ex.cancel()
+
+	if ex.options.ordered {
+		ex.turns = make([]chan struct{}, len(ex.chunks))
+		for i := range ex.turns {
+			ex.turns[i] = make(chan struct{})
+		}
+	}
+
+	workers := ex.options.parallelism
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(ex.chunks) {
+		workers = len(ex.chunks)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			ex.work(ctx)
+		}()
+	}
+	wg.Wait()
+
+	if len(ex.errs) == 0 {
+		if ex.next < len(ex.chunks) {
+			// workers are stopped by context before execution of all chunks
+			return xerrors.WithStackTrace(parentCtx.Err())
+		}
+
+		return nil
+	}
+	if !ex.options.continueOnError {
+		return xerrors.WithStackTrace(ex.errs[0])
+	}
+
+	return xerrors.WithStackTrace(xerrors.Join(ex.errs...))
+}
+
+// nextChunk returns index of next chunk for execution or false if all chunks are taken
+func (ex *keyChunksExecutor) nextChunk() (int, bool) {
+	ex.mu.Lock()
+	defer ex.mu.Unlock()
+
+	if ex.next >= len(ex.chunks) {
+		return 0, false
+	}
+	ex.next++
+
+	return ex.next - 1, true
+}
+
+// finish marks chunk as completed (successfully or with error)
+func (ex *keyChunksExecutor) finish(chunk int, err error) {
+	if err != nil {
+		ex.fail(err)
+	}
+	if ex.turns != nil {
+		close(ex.turns[chunk])
+	}
+}
+
+func (ex *keyChunksExecutor) fail(err error) {
+	ex.mu.Lock()
+	defer ex.mu.Unlock()
+
+	// with stop on first error only first error is meaningful, other chunks are cancelled by it
+	if ex.options.continueOnError || len(ex.errs) == 0 {
+		ex.errs = append(ex.errs, err)
+	}
+	if !ex.options.continueOnError {
+		ex.cancel()
+	}
+}
+
+// work executes chunks within retry-managed sessions until all chunks are taken
+func (ex *keyChunksExecutor) work(ctx context.Context) {
+	var (
+		idempotent = isIdempotent(ex.options.doOptions)
+		current    = -1
+	)
+	for ctx.Err() == nil {
+		err := ex.client.Do(ctx, func(ctx context.Context, s Session) error {
+			for {
+				if current < 0 {
+					var has bool
+					if current, has = ex.nextChunk(); !has {
+						current = -1
+
+						return nil
+					}
+				}
+				if err := ex.executeChunk(ctx, s, current); err != nil {
+					// not retryable errors of chunk does not break processing of next chunks in session
+					if !ex.options.continueOnError || retry.Check(err).MustRetry(idempotent) {
+						return xerrors.WithStackTrace(err)
+					}
+					ex.finish(current, fmt.Errorf("chunk %d: %w", current, err))
+				} else {
+					ex.finish(current, nil)
+				}
+				current = -1
+			}
+		}, ex.options.doOptions...)
+		if err == nil {
+			return
+		}
+		if current < 0 {
+			// error is not related to chunk (such as error of session creation), next attempts fail same
+			ex.fail(err)
+
+			return
+		}
+		ex.finish(current, fmt.Errorf("chunk %d: %w", current, err))
+		current = -1
+		if !ex.options.continueOnError {
+			return
+		}
+	}
+}
+
+func (ex *keyChunksExecutor) executeChunk(ctx context.Context, s Session, chunk int) error {
+	_, res, err := s.Execute(ctx, ex.options.txControl, ex.query,
+		NewQueryParameters(ValueParam(ex.param, value.ListValue(ex.chunks[chunk]...))),
+		ex.options.executeOptions...,
+	)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	defer func() {
+		_ = res.Close()
+	}()
+
+	if ex.turns != nil && chunk > 0 {
+		select {
+		case <-ctx.Done():
+			return xerrors.WithStackTrace(ctx.Err())
+		case <-ex.turns[chunk-1]:
+		}
+	}
+
+	if err = ex.handler(ctx, chunk, res); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func isIdempotent(opts []Option) bool {
+	var o Options
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyTableOption(&o)
+		}
+	}
+
+	return o.Idempotent
+}
@@ -0,0 +1,170 @@
+package table_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/tabletest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+const keyChunksQuery = "DECLARE $ids AS List<Uint64>; SELECT id FROM series WHERE id IN $ids"
+
+func uint64List(from, to uint64) types.Value {
+	items := make([]types.Value, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		items = append(items, types.Uint64Value(i))
+	}
+
+	return types.ListValue(items...)
+}
+
+type chunksRecorder struct {
+	mu     sync.Mutex
+	chunks []int
+}
+
+func (r *chunksRecorder) handler(failAt ...int) func(context.Context, int, result.Result) error {
+	return func(_ context.Context, chunk int, _ result.Result) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		for _, i := range failAt {
+			if i == chunk {
+				return errHandler
+			}
+		}
+		r.chunks = append(r.chunks, chunk)
+
+		return nil
+	}
+}
+
+var errHandler = errors.New("handler failed")
+
+func TestQueryWithKeyChunks(t *testing.T) {
+	t.Run("Sequential", func(t *testing.T) {
+		c := tabletest.NewClient()
+		defer func() {
+			_ = c.Close(context.Background())
+		}()
+		c.AddResult(keyChunksQuery)
+		c.AddError(keyChunksQuery, Ydb.StatusIds_UNAVAILABLE)
+
+		var r chunksRecorder
+		err := table.QueryWithKeyChunks(xtest.Context(t), c, keyChunksQuery, "$ids", uint64List(1, 5), 2,
+			r.handler(),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 1, 2}, r.chunks)
+
+		executed := c.Executed()
+		require.Len(t, executed, 4)
+		for i, ids := range []types.Value{
+			uint64List(1, 2),
+			uint64List(3, 4), // failed attempt
+			uint64List(3, 4),
+			uint64List(5, 5),
+		} {
+			require.Equal(t, map[string]types.Value{"$ids": ids}, executed[i].Params)
+		}
+	})
+	t.Run("StopOnError", func(t *testing.T) {
+		c := tabletest.NewClient()
+		defer func() {
+			_ = c.Close(context.Background())
+		}()
+		c.AddResult(keyChunksQuery)
+		c.AddError(keyChunksQuery, Ydb.StatusIds_SCHEME_ERROR)
+
+		var r chunksRecorder
+		err := table.QueryWithKeyChunks(xtest.Context(t), c, keyChunksQuery, "$ids", uint64List(1, 5), 2,
+			r.handler(),
+		)
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR))
+		require.Contains(t, err.Error(), "chunk 1")
+		require.Equal(t, []int{0}, r.chunks)
+		require.Len(t, c.Executed(), 2)
+	})
+	t.Run("ContinueOnError", func(t *testing.T) {
+		c := tabletest.NewClient()
+		defer func() {
+			_ = c.Close(context.Background())
+		}()
+		c.AddResult(keyChunksQuery)
+		c.AddError(keyChunksQuery, Ydb.StatusIds_SCHEME_ERROR)
+
+		var r chunksRecorder
+		err := table.QueryWithKeyChunks(xtest.Context(t), c, keyChunksQuery, "$ids", uint64List(1, 7), 2,
+			r.handler(3),
+			table.WithKeyChunksContinueOnError(),
+		)
+		require.Error(t, err)
+		require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR))
+		require.ErrorIs(t, err, errHandler)
+		require.Contains(t, err.Error(), "chunk 1")
+		require.Contains(t, err.Error(), "chunk 3")
+		require.Equal(t, []int{0, 2}, r.chunks)
+		require.Len(t, c.Executed(), 4)
+	})
+	t.Run("ParallelOrdered", func(t *testing.T) {
+		c := tabletest.NewClient()
+		defer func() {
+			_ = c.Close(context.Background())
+		}()
+
+		var r chunksRecorder
+		err := table.QueryWithKeyChunks(xtest.Context(t), c, keyChunksQuery, "$ids", uint64List(1, 20), 1,
+			r.handler(),
+			table.WithKeyChunksParallelism(4),
+			table.WithKeyChunksOrdered(),
+		)
+		require.NoError(t, err)
+		require.Len(t, r.chunks, 20)
+		require.True(t, sort.IntsAreSorted(r.chunks))
+		require.Len(t, c.Executed(), 20)
+	})
+	t.Run("ParallelUnordered", func(t *testing.T) {
+		c := tabletest.NewClient()
+		defer func() {
+			_ = c.Close(context.Background())
+		}()
+
+		var r chunksRecorder
+		err := table.QueryWithKeyChunks(xtest.Context(t), c, keyChunksQuery, "$ids", uint64List(1, 20), 3,
+			r.handler(),
+			table.WithKeyChunksParallelism(4),
+		)
+		require.NoError(t, err)
+		sort.Ints(r.chunks)
+		require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, r.chunks)
+	})
+	t.Run("WrongArguments", func(t *testing.T) {
+		c := tabletest.NewClient()
+		defer func() {
+			_ = c.Close(context.Background())
+		}()
+
+		var r chunksRecorder
+		err := table.QueryWithKeyChunks(xtest.Context(t), c, keyChunksQuery, "$ids", uint64List(1, 2), 0,
+			r.handler(),
+		)
+		require.Error(t, err)
+		err = table.QueryWithKeyChunks(xtest.Context(t), c, keyChunksQuery, "$ids", types.Uint64Value(1), 1,
+			r.handler(),
+		)
+		require.Error(t, err)
+		require.Empty(t, c.Executed())
+	})
+}
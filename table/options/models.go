@@ -61,6 +61,22 @@ type Description struct {
 	Tiering              string
 }
 
+// SplitPoints returns split points of table partitions from key ranges.
+// Key ranges of table returns from DescribeTable with WithShardKeyBounds option
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d Description) SplitPoints() []value.Value {
+	if len(d.KeyRanges) < 2 { //nolint:gomnd
+		return nil
+	}
+	splitPoints := make([]value.Value, 0, len(d.KeyRanges)-1)
+	for _, kr := range d.KeyRanges[:len(d.KeyRanges)-1] {
+		splitPoints = append(splitPoints, kr.To)
+	}
+
+	return splitPoints
+}
+
 type TableStats struct {
 	PartitionStats   []PartitionStats
 	RowsEstimate     uint64
@@ -439,6 +455,10 @@ type TimeToLiveSettings struct {
 
 	// ColumnUnit valid with Mode = TimeToLiveModeValueSinceUnixEpoch
 	ColumnUnit *TimeToLiveUnit
+
+	// RunIntervalSeconds specified how often server runs background removal of expired rows on the same partition.
+	// Server does not run removal more often, but may run it less often. Zero value means default interval of server
+	RunIntervalSeconds uint32
 }
 
 type TimeToLiveMode byte
@@ -503,6 +523,13 @@ func (ttl TimeToLiveSettings) ExpireAfter(expireAfter time.Duration) TimeToLiveS
 	return ttl
 }
 
+// RunInterval sets how often server runs background removal of expired rows on the same partition
+func (ttl TimeToLiveSettings) RunInterval(runInterval time.Duration) TimeToLiveSettings {
+	ttl.RunIntervalSeconds = uint32(runInterval.Seconds())
+
+	return ttl
+}
+
 func (ttl *TimeToLiveSettings) ToYDB() *Ydb_Table.TtlSettings {
 	if ttl == nil {
 		return nil
@@ -517,6 +544,7 @@ func (ttl *TimeToLiveSettings) ToYDB() *Ydb_Table.TtlSettings {
 					ExpireAfterSeconds: ttl.ExpireAfterSeconds,
 				},
 			},
+			RunIntervalSeconds: ttl.RunIntervalSeconds,
 		}
 	default: // currently use TimeToLiveModeDateType mode as default
 		return &Ydb_Table.TtlSettings{
@@ -526,6 +554,7 @@ func (ttl *TimeToLiveSettings) ToYDB() *Ydb_Table.TtlSettings {
 					ExpireAfterSeconds: ttl.ExpireAfterSeconds,
 				},
 			},
+			RunIntervalSeconds: ttl.RunIntervalSeconds,
 		}
 	}
 }
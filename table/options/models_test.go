@@ -66,6 +66,17 @@ func TestTimeToLiveSettingsFluentModifiers(t *testing.T) {
 				ColumnUnit:         unitToPointer(TimeToLiveUnitNanoseconds),
 			},
 		},
+		{
+			fluentSettings: NewTTLSettings().
+				ColumnDateType("a").
+				ExpireAfter(0).
+				RunInterval(time.Minute),
+			expectedSettings: TimeToLiveSettings{
+				ColumnName:         "a",
+				Mode:               TimeToLiveModeDateType,
+				RunIntervalSeconds: uint32(time.Minute.Seconds()),
+			},
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			require.Equal(t, tt.expectedSettings, tt.fluentSettings)
@@ -1,13 +1,18 @@
 package options
 
 import (
+	"time"
+
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 )
 
 func WithShardKeyBounds() DescribeTableOption {
@@ -290,6 +295,36 @@ func WithKeyBloomFilter(f FeatureFlag) CreateTableOption {
 	return keyBloomFilter(f)
 }
 
+// StoreType is a type of table storage
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type StoreType int32
+
+const (
+	StoreTypeUnspecified StoreType = iota
+	StoreTypeRow
+	StoreTypeColumn
+)
+
+// storeTypeFieldNumber is number of store_type field of Ydb.Table.CreateTableRequest (field 19 is temporary).
+// Pinned ydb-go-genproto has no store_type field yet, so field is encoded into unknown fields of request
+// until dependency is bumped
+const storeTypeFieldNumber protowire.Number = 20
+
+func (t StoreType) ApplyCreateTableOption(d *CreateTableDesc, a *allocator.Allocator) {
+	m := (*Ydb_Table.CreateTableRequest)(d).ProtoReflect()
+	unknown := protowire.AppendTag(m.GetUnknown(), storeTypeFieldNumber, protowire.VarintType)
+	m.SetUnknown(protowire.AppendVarint(unknown, uint64(t)))
+}
+
+// WithStoreType defines type of table storage. Use StoreTypeColumn for column-oriented (OLAP) tables.
+// Column families with compression (WithColumnFamilies) are applicable to column tables too
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStoreType(t StoreType) CreateTableOption {
+	return t
+}
+
 func WithPartitions(p Partitions) CreateTableOption {
 	return p
 }
@@ -324,6 +359,10 @@ func (e explicitPartitions) ApplyCreateTableOption(d *CreateTableDesc, a *alloca
 
 func (e explicitPartitions) isPartitions() {}
 
+// WithExplicitPartitions defines initial partitions of table by split points.
+// Split point must be a tuple of values with types of primary key prefix columns
+// (or single value of first primary key column type).
+// CreateTable validates split points and combination of uniform and explicit partitions on client-side
 func WithExplicitPartitions(splitPoints ...value.Value) Partitions {
 	return explicitPartitions(splitPoints)
 }
@@ -608,10 +647,36 @@ func (opts partitioningSettings) ApplyCreateTableOption(d *CreateTableDesc, a *a
 	d.PartitioningSettings = (*Ydb_Table.PartitioningSettings)(settings)
 }
 
+func (opts partitioningSettings) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	settings := &ydbPartitioningSettings{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyPartitioningSettingsOption(settings)
+		}
+	}
+	d.AlterPartitioningSettings = (*Ydb_Table.PartitioningSettings)(settings)
+}
+
 func WithPartitioningSettings(opts ...PartitioningSettingsOption) CreateTableOption {
 	return partitioningSettings(opts)
 }
 
+// WithAlterPartitioningSettings changes partitioning settings of table in AlterTable request.
+// Settings which not defined by opts stay unchanged
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAlterPartitioningSettings(opts ...PartitioningSettingsOption) AlterTableOption {
+	return partitioningSettings(opts)
+}
+
+// WithAlterPartitioningSettingsObject changes partitioning settings of table in AlterTable request
+// with typed settings (for example, received from Description.PartitioningSettings)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAlterPartitioningSettingsObject(ps PartitioningSettings) AlterTableOption {
+	return partitioningSettingsObject(ps)
+}
+
 type (
 	ydbPartitioningSettings    Ydb_Table.PartitioningSettings
 	PartitioningSettingsOption interface {
@@ -790,6 +855,57 @@ func WithDropTimeToLive() AlterTableOption {
 	return dropTimeToLive{}
 }
 
+type timeToLiveRunInterval time.Duration
+
+func (interval timeToLiveRunInterval) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	if settings := (*Ydb_Table.AlterTableRequest)(d).GetSetTtlSettings(); settings != nil {
+		settings.RunIntervalSeconds = uint32(time.Duration(interval).Seconds())
+	}
+}
+
+func (interval timeToLiveRunInterval) TimeToLiveRunInterval() time.Duration {
+	return time.Duration(interval)
+}
+
+// WithSetTimeToLiveRunInterval changes how often server runs background removal of expired rows of table
+//
+// If AlterTable request has no TTL settings from WithSetTimeToLiveSettings then current TTL settings of table
+// are read with DescribeTable and set again with new run interval. AlterTable returns error if table has no TTL.
+// Server has no call for immediate removal of expired rows, so short run interval is the closest equivalent of it
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSetTimeToLiveRunInterval(runInterval time.Duration) AlterTableOption {
+	return timeToLiveRunInterval(runInterval)
+}
+
+type asyncOperation struct {
+	operationID *string
+}
+
+func (o asyncOperation) ApplyAlterTableOption(d *AlterTableDesc, a *allocator.Allocator) {
+	if o.operationID == nil {
+		return
+	}
+	if d.OperationParams == nil {
+		d.OperationParams = &Ydb_Operations.OperationParams{}
+	}
+	d.OperationParams.OperationMode = Ydb_Operations.OperationParams_ASYNC
+}
+
+// OperationID returns destination for id of long-running operation
+func (o asyncOperation) OperationID() *string {
+	return o.operationID
+}
+
+// WithAsyncOperation makes AlterTable request in async mode. AlterTable returns without waiting
+// of long-running operation (such as build of added index) and writes id of operation into operationID.
+// Operation can be awaited with Driver.Operation().Wait
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithAsyncOperation(operationID *string) AlterTableOption {
+	return asyncOperation{operationID: operationID}
+}
+
 type (
 	CopyTableDesc   Ydb_Table.CopyTableRequest
 	CopyTableOption func(*CopyTableDesc)
@@ -835,6 +951,11 @@ type (
 		*Ydb_Table.ExecuteDataQueryRequest
 
 		IgnoreTruncated bool
+		ValidateParams  bool
+
+		// MaxQueryTextSize overrides limit of size of query text of table client config.
+		// Zero value means limit of config, negative value disables the check
+		MaxQueryTextSize int
 	}
 	ExecuteDataQueryOption interface {
 		ApplyExecuteDataQueryOption(d *ExecuteDataQueryDesc, a *allocator.Allocator) []grpc.CallOption
@@ -908,6 +1029,49 @@ func WithIgnoreTruncated() ExecuteDataQueryOption {
 	})
 }
 
+// WithParamsValidation checks types of query parameters against DECLARE statements of query before execute
+//
+// Declared types are requested from server with preparing of query and cached per query text.
+// Execute returns error table.ErrParamsMismatch with list of declared and provided types of
+// mismatched parameters instead of server type inference error
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithParamsValidation() ExecuteDataQueryOption {
+	return executeDataQueryOptionFunc(func(desc *ExecuteDataQueryDesc, a *allocator.Allocator) []grpc.CallOption {
+		desc.ValidateParams = true
+
+		return nil
+	})
+}
+
+type withMaxQueryTextSize int
+
+func (n withMaxQueryTextSize) ApplyExecuteDataQueryOption(
+	d *ExecuteDataQueryDesc, a *allocator.Allocator,
+) []grpc.CallOption {
+	d.MaxQueryTextSize = int(n)
+
+	return nil
+}
+
+func (n withMaxQueryTextSize) ApplyExecuteScanQueryOption(d *ExecuteScanQueryDesc) []grpc.CallOption {
+	d.MaxQueryTextSize = int(n)
+
+	return nil
+}
+
+// WithMaxQueryTextSize overrides limit of size of query text in bytes (see ydb.WithMaxQueryTextSize)
+// for single data or scan query. Zero or negative value disables the check for the call
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxQueryTextSize(n int) withMaxQueryTextSize {
+	if n <= 0 {
+		return withMaxQueryTextSize(-1)
+	}
+
+	return withMaxQueryTextSize(n)
+}
+
 // WithQueryCachePolicyKeepInCache manages keep-in-cache policy
 //
 // Deprecated: data queries always executes with enabled keep-in-cache policy.
@@ -960,6 +1124,42 @@ func WithCommitCollectStatsModeBasic() CommitTransactionOption {
 	}
 }
 
+// CollectStatsMode is a mode of query statistics collection
+type CollectStatsMode uint32
+
+const (
+	CollectStatsModeNone = CollectStatsMode(iota)
+	CollectStatsModeBasic
+	CollectStatsModeFull
+	CollectStatsModeProfile
+)
+
+func (mode CollectStatsMode) toYDB() Ydb_Table.QueryStatsCollection_Mode {
+	switch mode {
+	case CollectStatsModeNone:
+		return Ydb_Table.QueryStatsCollection_STATS_COLLECTION_NONE
+	case CollectStatsModeBasic:
+		return Ydb_Table.QueryStatsCollection_STATS_COLLECTION_BASIC
+	case CollectStatsModeFull:
+		return Ydb_Table.QueryStatsCollection_STATS_COLLECTION_FULL
+	case CollectStatsModeProfile:
+		return Ydb_Table.QueryStatsCollection_STATS_COLLECTION_PROFILE
+	default:
+		return Ydb_Table.QueryStatsCollection_STATS_COLLECTION_UNSPECIFIED
+	}
+}
+
+// WithCommitCollectStats defines query statistics mode of commit.
+// Statistics of commit are available from Stats() of CommitTx result and from
+// trace.TableTxCommitDoneInfo and trace.TableDoTxDoneInfo
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCommitCollectStats(mode CollectStatsMode) CommitTransactionOption {
+	return func(d *CommitTransactionDesc) {
+		d.CollectStats = mode.toYDB()
+	}
+}
+
 func WithCollectStatsModeNone() ExecuteDataQueryOption {
 	return executeDataQueryOptionFunc(func(d *ExecuteDataQueryDesc, a *allocator.Allocator) []grpc.CallOption {
 		d.CollectStats = Ydb_Table.QueryStatsCollection_STATS_COLLECTION_NONE
@@ -983,7 +1183,16 @@ type (
 )
 
 type (
-	ExecuteScanQueryDesc   Ydb_Table.ExecuteScanQueryRequest
+	ExecuteScanQueryDesc struct {
+		*Ydb_Table.ExecuteScanQueryRequest
+
+		// IntermediateStatsHandler is called for each part of stream which contains query stats
+		IntermediateStatsHandler func(stats.QueryStats)
+
+		// MaxQueryTextSize overrides limit of size of query text of table client config.
+		// Zero value means limit of config, negative value disables the check
+		MaxQueryTextSize int
+	}
 	ExecuteScanQueryOption interface {
 		ApplyExecuteScanQueryOption(d *ExecuteScanQueryDesc) []grpc.CallOption
 	}
@@ -1036,6 +1245,20 @@ func WithExecuteScanQueryStats(stats ExecuteScanQueryStatsType) ExecuteScanQuery
 	})
 }
 
+// WithIntermediateStatsHandler defines handler of intermediate query stats which server sends
+// in parts of scan query stream during execution. Handler is called synchronously on receiving of part
+// (from NextResultSet). Stats collection mode switches to ExecuteScanQueryStatsTypeFull if stats mode
+// is not defined with WithExecuteScanQueryStats
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithIntermediateStatsHandler(handler func(stats.QueryStats)) ExecuteScanQueryOption {
+	return executeScanQueryOptionFunc(func(desc *ExecuteScanQueryDesc) []grpc.CallOption {
+		desc.IntermediateStatsHandler = handler
+
+		return nil
+	})
+}
+
 var (
 	_ ReadRowsOption  = readColumnsOption{}
 	_ ReadTableOption = readOrderedOption{}
@@ -1053,7 +1276,12 @@ type (
 		ApplyReadRowsOption(desc *ReadRowsDesc, a *allocator.Allocator)
 	}
 
-	ReadTableDesc   Ydb_Table.ReadTableRequest
+	ReadTableDesc struct {
+		*Ydb_Table.ReadTableRequest
+
+		// KeyColumns are primary key columns of table for result.StreamResult.LastKey()
+		KeyColumns []string
+	}
 	ReadTableOption interface {
 		ApplyReadTableOption(desc *ReadTableDesc, a *allocator.Allocator)
 	}
@@ -1067,8 +1295,13 @@ type (
 	readLessOption           struct{ value.Value }
 	readGreaterOption        struct{ value.Value }
 	readRowLimitOption       uint64
+	readTrackLastKeyOption   []string
 )
 
+func (columns readTrackLastKeyOption) ApplyReadTableOption(desc *ReadTableDesc, a *allocator.Allocator) {
+	desc.KeyColumns = append(desc.KeyColumns, columns...)
+}
+
 func (n readRowLimitOption) ApplyReadTableOption(desc *ReadTableDesc, a *allocator.Allocator) {
 	desc.RowLimit = uint64(n)
 }
@@ -1179,6 +1412,17 @@ func ReadRowLimit(n uint64) ReadTableOption {
 	return readRowLimitOption(n)
 }
 
+// ReadTrackLastKey returns ReadTableOption which makes result.StreamResult.LastKey() returns
+// tuple of values of keyColumns from the last fetched row.
+// keyColumns must be primary key columns of table in order of primary key and must be read
+// (see ReadColumns). Use LastKey() with ReadOrdered() and ReadGreater() for resume of
+// broken read table stream
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadTrackLastKey(keyColumns ...string) ReadTableOption {
+	return readTrackLastKeyOption(keyColumns)
+}
+
 func (d *ReadTableDesc) initKeyRange() {
 	if d.KeyRange == nil {
 		d.KeyRange = new(Ydb_Table.KeyRange)
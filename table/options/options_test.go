@@ -2,10 +2,14 @@ package options
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/feature"
@@ -150,6 +154,34 @@ func TestStoragePolicyOptions(t *testing.T) {
 	}
 }
 
+func TestStoreTypeOption(t *testing.T) {
+	a := allocator.New()
+	defer a.Free()
+	req := Ydb_Table.CreateTableRequest{Path: abc}
+	WithStoreType(StoreTypeColumn).ApplyCreateTableOption((*CreateTableDesc)(&req), a)
+	b, err := proto.Marshal(&req)
+	require.NoError(t, err)
+	var storeType *uint64
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+		// field 19 of Ydb.Table.CreateTableRequest is temporary
+		require.NotEqual(t, protowire.Number(19), num)
+		if num == 20 {
+			require.Equal(t, protowire.VarintType, typ)
+			v, n := protowire.ConsumeVarint(b)
+			require.GreaterOrEqual(t, n, 0)
+			storeType = &v
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		require.GreaterOrEqual(t, n, 0)
+		b = b[n:]
+	}
+	require.NotNil(t, storeType)
+	require.EqualValues(t, StoreTypeColumn, *storeType)
+}
+
 func TestAlterTableOptions(t *testing.T) {
 	a := allocator.New()
 	defer a.Free()
@@ -251,4 +283,67 @@ func TestAlterTableOptions(t *testing.T) {
 			t.Errorf("Alter table storage settings options is not as expected")
 		}
 	}
+	{
+		var operationID string
+		opt := WithAsyncOperation(&operationID)
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		require.Equal(t, Ydb_Operations.OperationParams_ASYNC, req.GetOperationParams().GetOperationMode())
+		require.Equal(t, &operationID, opt.(interface{ OperationID() *string }).OperationID())
+	}
+	{
+		opt := WithAsyncOperation(nil)
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		require.Nil(t, req.GetOperationParams())
+	}
+	{
+		opt := WithSetTimeToLiveRunInterval(time.Minute)
+		req := Ydb_Table.AlterTableRequest{}
+		WithSetTimeToLiveSettings(NewTTLSettings().ColumnDateType("a")).ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		require.EqualValues(t, 60, req.GetSetTtlSettings().GetRunIntervalSeconds())
+		require.Equal(t, time.Minute, opt.(interface{ TimeToLiveRunInterval() time.Duration }).TimeToLiveRunInterval())
+	}
+	{
+		opt := WithAlterPartitioningSettings(
+			WithPartitioningByLoad(FeatureEnabled),
+			WithMaxPartitionsCount(8),
+		)
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		require.Equal(t, PartitioningSettings{
+			PartitioningByLoad: FeatureEnabled,
+			MaxPartitionsCount: 8,
+		}, NewPartitioningSettings(req.GetAlterPartitioningSettings()))
+	}
+	{
+		ps := PartitioningSettings{
+			PartitioningBySize: FeatureDisabled,
+			MinPartitionsCount: 2,
+		}
+		opt := WithAlterPartitioningSettingsObject(ps)
+		req := Ydb_Table.AlterTableRequest{}
+		opt.ApplyAlterTableOption((*AlterTableDesc)(&req), a)
+		require.Equal(t, ps, NewPartitioningSettings(req.GetAlterPartitioningSettings()))
+	}
+}
+
+func TestCommitCollectStatsOptions(t *testing.T) {
+	for _, tt := range []struct {
+		mode CollectStatsMode
+		exp  Ydb_Table.QueryStatsCollection_Mode
+	}{
+		{CollectStatsModeNone, Ydb_Table.QueryStatsCollection_STATS_COLLECTION_NONE},
+		{CollectStatsModeBasic, Ydb_Table.QueryStatsCollection_STATS_COLLECTION_BASIC},
+		{CollectStatsModeFull, Ydb_Table.QueryStatsCollection_STATS_COLLECTION_FULL},
+		{CollectStatsModeProfile, Ydb_Table.QueryStatsCollection_STATS_COLLECTION_PROFILE},
+		{CollectStatsMode(100), Ydb_Table.QueryStatsCollection_STATS_COLLECTION_UNSPECIFIED},
+	} {
+		t.Run(tt.exp.String(), func(t *testing.T) {
+			req := Ydb_Table.CommitTransactionRequest{}
+			WithCommitCollectStats(tt.mode)((*CommitTransactionDesc)(&req))
+			require.Equal(t, tt.exp, req.GetCollectStats())
+		})
+	}
 }
@@ -0,0 +1,99 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+var errLastKeyNotRead = xerrors.Wrap(errors.New("last key is not available"))
+
+// ReadTableWithResume reads table with ordered StreamReadTable and calls handler for each row of table.
+// Handler must scan current row of res and must not advance res with NextResultSet or NextRow.
+//
+// Reading is retried with Client.Do. On every retry ReadTableWithResume continues reading from
+// primary key next to the last row successfully processed by handler instead of reading table from scratch.
+// Row limit (options.ReadRowLimit) is applied to whole reading, not to single attempt.
+// Primary key columns must be read if options.ReadColumns is used
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadTableWithResume(
+	ctx context.Context,
+	c Client,
+	path string,
+	handler func(ctx context.Context, res result.StreamResult) error,
+	opts ...options.ReadTableOption,
+) error {
+	var (
+		desc       = options.ReadTableDesc{ReadTableRequest: &Ydb_Table.ReadTableRequest{}}
+		a          = allocator.New()
+		keyColumns []string
+		lastKey    value.Value
+		processed  uint64
+	)
+	for _, opt := range opts {
+		if opt != nil {
+			opt.ApplyReadTableOption(&desc, a)
+		}
+	}
+	a.Free()
+
+	rowLimit := desc.GetRowLimit()
+
+	err := c.Do(ctx, func(ctx context.Context, s Session) (err error) {
+		if keyColumns == nil {
+			description, err := s.DescribeTable(ctx, path)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			keyColumns = description.PrimaryKey
+		}
+
+		readOpts := append(make([]options.ReadTableOption, 0, len(opts)+4), opts...) //nolint:gomnd
+		readOpts = append(readOpts, options.ReadOrdered(), options.ReadTrackLastKey(keyColumns...))
+		if lastKey != nil {
+			readOpts = append(readOpts, options.ReadGreater(lastKey))
+		}
+		if rowLimit > 0 {
+			if processed >= rowLimit {
+				return nil
+			}
+			readOpts = append(readOpts, options.ReadRowLimit(rowLimit-processed))
+		}
+
+		res, err := s.StreamReadTable(ctx, path, readOpts...)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		defer func() {
+			_ = res.Close()
+		}()
+
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				if err = handler(ctx, res); err != nil {
+					return xerrors.WithStackTrace(err)
+				}
+				if lastKey = res.LastKey(); lastKey == nil {
+					return xerrors.WithStackTrace(fmt.Errorf("%w: key columns %v must be read", errLastKeyNotRead, keyColumns))
+				}
+				processed++
+			}
+		}
+
+		return xerrors.WithStackTrace(res.Err())
+	}, WithIdempotent())
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
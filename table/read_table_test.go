@@ -0,0 +1,160 @@
+package table_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_TableStats"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/scanner"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result"
+)
+
+type readTableClientStub struct {
+	table.Client
+
+	session *readTableSessionStub
+}
+
+func (c *readTableClientStub) Do(ctx context.Context, op table.Operation, opts ...table.Option) error {
+	return retry.Retry(ctx, func(ctx context.Context) error {
+		return op(ctx, c.session)
+	}, retry.WithIdempotent(true))
+}
+
+type readTableSessionStub struct {
+	table.Session
+
+	rows      uint64 // table contains rows with keys from 1 to rows
+	breakAt   []int  // number of rows after which stream of next attempts breaks
+	describes int
+	requests  []*Ydb_Table.ReadTableRequest
+}
+
+func (s *readTableSessionStub) DescribeTable(
+	context.Context, string, ...options.DescribeTableOption,
+) (options.Description, error) {
+	s.describes++
+
+	return options.Description{PrimaryKey: []string{"id"}}, nil
+}
+
+func (s *readTableSessionStub) StreamReadTable(
+	ctx context.Context, path string, opts ...options.ReadTableOption,
+) (result.StreamResult, error) {
+	a := allocator.New()
+	defer a.Free()
+	desc := options.ReadTableDesc{ReadTableRequest: &Ydb_Table.ReadTableRequest{}}
+	for _, opt := range opts {
+		opt.ApplyReadTableOption(&desc, a)
+	}
+	s.requests = append(s.requests, desc.ReadTableRequest)
+
+	from := uint64(1)
+	if greater := desc.GetKeyRange().GetGreater(); greater != nil {
+		from = greater.GetValue().GetItems()[0].GetUint64Value() + 1
+	}
+	breakAt := -1
+	if len(s.breakAt) > 0 {
+		breakAt, s.breakAt = s.breakAt[0], s.breakAt[1:]
+	}
+	sent := 0
+
+	return scanner.NewStream(ctx,
+		func(ctx context.Context) (*Ydb.ResultSet, *Ydb_TableStats.QueryStats, error) {
+			id := from + uint64(sent)
+			if id > s.rows || (desc.GetRowLimit() > 0 && uint64(sent) >= desc.GetRowLimit()) {
+				return nil, nil, io.EOF
+			}
+			if sent == breakAt {
+				return nil, nil, xerrors.Operation(xerrors.WithStatusCode(Ydb.StatusIds_UNAVAILABLE))
+			}
+			sent++
+
+			return &Ydb.ResultSet{
+				Columns: []*Ydb.Column{{
+					Name: "id",
+					Type: &Ydb.Type{Type: &Ydb.Type_TypeId{TypeId: Ydb.Type_UINT64}},
+				}},
+				Rows: []*Ydb.Value{{
+					Items: []*Ydb.Value{{Value: &Ydb.Value_Uint64Value{Uint64Value: id}}},
+				}},
+			}, nil, nil
+		},
+		func(err error) error {
+			return err
+		},
+		scanner.WithKeyColumns(desc.KeyColumns...),
+	)
+}
+
+func TestReadTableWithResume(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		rows      uint64
+		breakAt   []int
+		opts      []options.ReadTableOption
+		expected  []uint64
+		attempts  int
+		rowLimits []uint64
+	}{
+		{
+			name:      "WithoutErrors",
+			rows:      3,
+			expected:  []uint64{1, 2, 3},
+			attempts:  1,
+			rowLimits: []uint64{0},
+		},
+		{
+			name:      "Resume",
+			rows:      5,
+			breakAt:   []int{2, 0, 1},
+			expected:  []uint64{1, 2, 3, 4, 5},
+			attempts:  4,
+			rowLimits: []uint64{0, 0, 0, 0},
+		},
+		{
+			name:      "RowLimit",
+			rows:      10,
+			breakAt:   []int{2},
+			opts:      []options.ReadTableOption{options.ReadRowLimit(4)},
+			expected:  []uint64{1, 2, 3, 4},
+			attempts:  2,
+			rowLimits: []uint64{4, 2},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &readTableSessionStub{rows: tt.rows, breakAt: tt.breakAt}
+			var ids []uint64
+			err := table.ReadTableWithResume(context.Background(), &readTableClientStub{session: s}, "table",
+				func(ctx context.Context, res result.StreamResult) error {
+					var id uint64
+					if err := res.Scan(&id); err != nil {
+						return err
+					}
+					ids = append(ids, id)
+
+					return nil
+				},
+				tt.opts...,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ids)
+			require.Equal(t, 1, s.describes)
+			require.Len(t, s.requests, tt.attempts)
+			for i, r := range s.requests {
+				require.True(t, r.GetOrdered())
+				require.Equal(t, tt.rowLimits[i], r.GetRowLimit())
+			}
+		})
+	}
+}
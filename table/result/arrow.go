@@ -0,0 +1,83 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/arrow"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrArrowUnsupportedType is returned by ToArrow for columns of types which have no mapping to Arrow types
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrArrowUnsupportedType = arrow.ErrUnsupportedType
+
+type arrowRecordBatchWriter interface {
+	WriteArrowRecordBatch(w *arrow.Writer) error
+}
+
+// ToArrow reads all result sets of res and writes them as Apache Arrow IPC streams
+// (https://arrow.apache.org/docs/format/Columnar.html#ipc-streaming-format), one stream per result set.
+// Stream of result set is written into writer which returned by newStream for index of result set.
+// Every part of stream result (such as result of scan query) is written as record batch of single stream
+// as soon as part is received, so result is not buffered in memory.
+// Rows are converted on client side directly from protobuf values, without decoding into Go values.
+//
+// Columns of primitive types, Optional and Decimal types are supported:
+//   - Bool, Int*, Uint*, Float and Double as Arrow types of the same width
+//   - Date as Date32, Datetime and Timestamp as Timestamp (with seconds and microseconds, UTC),
+//     Interval as Duration (microseconds)
+//   - Utf8, Json, JsonDocument, DyNumber and Tz* types as Utf8, String and Yson as Binary
+//   - Uuid as FixedSizeBinary(16), Decimal(p,s) as Decimal128(p,s)
+//   - Optional<T> as nullable field of type T
+//
+// Streams are written according to Arrow columnar format version 1.0 (metadata version V5),
+// for example they can be read with ipc.NewReader of Arrow Go module.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ToArrow(ctx context.Context, res BaseResult, newStream func(resultSetIndex int) (io.Writer, error)) error {
+	r, ok := res.(arrowRecordBatchWriter)
+	if !ok {
+		return xerrors.WithStackTrace(fmt.Errorf("conversion of %T to arrow is not supported", res))
+	}
+
+	var (
+		w     *arrow.Writer
+		index = -1
+	)
+	for {
+		err := res.NextResultSetErr(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if res.ResultSetIndex() != index {
+			if w != nil {
+				if err = w.Close(); err != nil {
+					return xerrors.WithStackTrace(err)
+				}
+			}
+			index = res.ResultSetIndex()
+			stream, err := newStream(index)
+			if err != nil {
+				return xerrors.WithStackTrace(err)
+			}
+			w = arrow.NewWriter(stream)
+		}
+		if err = r.WriteArrowRecordBatch(w); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	if w != nil {
+		if err := w.Close(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
@@ -1,5 +1,10 @@
 package named
 
+import (
+	"fmt"
+	"reflect"
+)
+
 type Type uint8
 
 const (
@@ -19,11 +24,9 @@ type Value struct {
 //
 // # If column value is NULL, then ScanNamed will write a nil into destination
 //
-// Warning: value must double-pointed data destination
+// Warning: value must double-pointed data destination (or sql.Scanner which receives nil for NULL value)
 func Optional(columnName string, destination interface{}) Value {
-	if columnName == "" {
-		panic("columnName must be not empty")
-	}
+	checkArgs(columnName, destination)
 
 	return Value{
 		Name:  columnName,
@@ -34,11 +37,10 @@ func Optional(columnName string, destination interface{}) Value {
 
 // Required makes an object with destination address for column value with name columnName
 //
+// If scanned YDB value is NULL - ScanNamed returns error
 // Warning: value must single-pointed data destination
 func Required(columnName string, destinationValueReference interface{}) Value {
-	if columnName == "" {
-		panic("columnName must be not empty")
-	}
+	checkArgs(columnName, destinationValueReference)
 
 	return Value{
 		Name:  columnName,
@@ -52,9 +54,7 @@ func Required(columnName string, destinationValueReference interface{}) Value {
 // If scanned YDB value is NULL - default type value will be applied to value destination
 // Warning: value must single-pointed data destination
 func OptionalWithDefault(columnName string, destinationValueReference interface{}) Value {
-	if columnName == "" {
-		panic("columnName must be not empty")
-	}
+	checkArgs(columnName, destinationValueReference)
 
 	return Value{
 		Name:  columnName,
@@ -62,3 +62,12 @@ func OptionalWithDefault(columnName string, destinationValueReference interface{
 		Type:  TypeOptionalWithUseDefault,
 	}
 }
+
+func checkArgs(columnName string, destination interface{}) {
+	if columnName == "" {
+		panic("columnName must be not empty")
+	}
+	if t := reflect.TypeOf(destination); t == nil || t.Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("destination of column %q must be a pointer, got %T", columnName, destination))
+	}
+}
@@ -3,6 +3,8 @@ package result
 import (
 	"context"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/issues"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/indexed"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
@@ -55,6 +57,19 @@ type BaseResult interface {
 	// CurrentResultSet get current result set to use ColumnCount(), RowCount() and other methods
 	CurrentResultSet() Set
 
+	// ResultSetIndex returns index of current result set in the result or -1 if no result set selected yet.
+	// All parts of stream result of scan query or read table have index 0 because they belong to single result set
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ResultSetIndex() int
+
+	// TotalRowsScanned returns number of rows of all result sets (or all parts of stream result)
+	// received up to and including current one.
+	// Use it instead of summing CurrentResultSet().RowCount() of stream parts
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	TotalRowsScanned() int
+
 	// HasNextRow reports whether result row may be advanced.
 	// It may be useful to call HasNextRow() instead of NextRow() to look ahead
 	// without advancing the result rows.
@@ -70,7 +85,12 @@ type BaseResult interface {
 	// If some value implements ydb.table.types.Scanner then will be called
 	// value.(ydb.table.types.Scanner).UnmarshalYDB(raw) where raw may be null.
 	// In this case client-side implementation UnmarshalYDB must check raw.IsNull() and
-	// applied default value or nothing to do
+	// applied default value or nothing to do.
+	// NULL lists, sets and dicts are scanned into empty (not nil) slices and maps, NULL Decimal into zero
+	// decimal with precision and scale of column type, NULL Json into empty json.RawMessage.
+	// NULL structs are scanned into zero-valued structs only with ydb.WithZeroStructForNull option.
+	// Pointer destinations (such as **int64 or **[]string) are scanned as with Scan, so NULL values
+	// leave pointer nil instead of default value
 	ScanWithDefaults(values ...indexed.Required) error
 
 	// Scan values.
@@ -125,8 +145,28 @@ type Result interface {
 	// ResultSetCount returns number of result sets.
 	// Note that it does not work if r is the BaseResult of streaming operation.
 	ResultSetCount() int
+
+	// Issues returns non-fatal issues (such as warnings) which server attached to successful response.
+	// It returns nil if response has no issues
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	Issues() []issues.Issue
 }
 
 type StreamResult interface {
 	BaseResult
+
+	// LastKey returns primary key of the last row fetched by NextRow as tuple of key columns values.
+	// LastKey returns nil if key columns are not defined with options.ReadTrackLastKey
+	// (for example, for stream results of scan queries) or no rows were fetched yet
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	LastKey() value.Value
+
+	// SetColumnsOfInterest defines columns for Scan and ScanWithDefaults in current and next parts of stream
+	// (same as columns of NextResultSet, which override columns of interest). Rows of stream are decoded lazily,
+	// so only items of scanned columns are decoded, items of other columns are skipped
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	SetColumnsOfInterest(names ...string)
 }
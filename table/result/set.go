@@ -9,12 +9,15 @@ type Set interface {
 	// Columns allows to iterate over all columns of the current result set.
 	Columns(it func(options.Column))
 
-	// RowCount returns number of rows in the result set.
+	// RowCount returns number of rows in the current result set.
+	// For stream results it returns number of rows in the current part of result set only,
+	// use BaseResult.TotalRowsScanned() for running counter of rows
 	RowCount() int
 
 	// ItemCount returns number of items in the current row.
 	ItemCount() int
 
-	// Truncated returns true if current result set has been truncated by server
+	// Truncated returns true if current result set has been truncated by server.
+	// For stream results it reports flag of current part. Server does not truncate results of scan query
 	Truncated() bool
 }
@@ -1,23 +1,57 @@
 package stats
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
 
 // QueryPhase holds query execution phase statistics.
 type QueryPhase interface {
 	// NextTableAccess returns next accessed table within query execution phase.
 	// If ok flag is false, then there are no more accessed tables and t is invalid.
 	NextTableAccess() (t *TableAccess, ok bool)
+
 	Duration() time.Duration
+
+	// CPUTime is a total CPU time of query execution phase
 	CPUTime() time.Duration
+
+	// AffectedShards is a number of shards affected by query execution phase
 	AffectedShards() uint64
+
+	// IsLiteralPhase reports whether query execution phase is computed without access to tables
+	// (for example, SELECT 1)
 	IsLiteralPhase() bool
 }
 
+// AllTableAccess returns all accessed tables within query execution phase.
+// AllTableAccess does not depend on iteration with QueryPhase.NextTableAccess.
+// Literal phase (see QueryPhase.IsLiteralPhase) is computed without access to tables, so it has no accessed tables
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func AllTableAccess(phase QueryPhase) ([]TableAccess, error) {
+	if p, has := phase.(interface {
+		TableAccess() []TableAccess
+	}); has {
+		return p.TableAccess(), nil
+	}
+
+	return nil, xerrors.WithStackTrace(fmt.Errorf("query phase %T not supported listing of accessed tables", phase))
+}
+
 // QueryStats holds query execution statistics.
 type QueryStats interface {
 	ProcessCPUTime() time.Duration
 	Compilation() (c *CompilationStats)
+
+	// QueryPlan returns raw query plan in JSON format.
+	// Query plan is present only if stats are collected with options.CollectStatsModeFull or options.CollectStatsModeProfile
 	QueryPlan() string
+
+	// QueryAST returns raw query AST.
+	// Query AST is present only if stats are collected with options.CollectStatsModeFull or options.CollectStatsModeProfile
 	QueryAST() string
 	TotalCPUTime() time.Duration
 	TotalDuration() time.Duration
@@ -40,8 +74,12 @@ type TableAccess struct {
 	Reads   OperationStats
 	Updates OperationStats
 	Deletes OperationStats
+
+	// PartitionsCount is a number of partitions of table accessed by query execution phase
+	PartitionsCount uint64
 }
 
+// OperationStats contains number of rows and size in bytes of operation on table
 type OperationStats struct {
 	Rows  uint64
 	Bytes uint64
@@ -2,6 +2,7 @@ package table
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
@@ -10,6 +11,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry"
 	"github.com/ydb-platform/ydb-go-sdk/v3/retry/budget"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
@@ -17,6 +19,18 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
+// ErrParamsMismatch returns from Execute with options.WithParamsValidation if provided query parameters
+// differ from parameters declared in query, also returns from ydb.ParamsFromMap
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrParamsMismatch = params.ErrParamsMismatch
+
+// ErrQueryTextTooLarge returns from Execute, Prepare and scan queries if size of query text exceeds
+// limit of ydb.WithMaxQueryTextSize or options.WithMaxQueryTextSize. Such query is not sent to server
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrQueryTextTooLarge = xerrors.Wrap(errors.New("query text is too large"))
+
 // Operation is the interface that holds an operation for retry.
 // if Operation returns not nil - operation will retry
 // if Operation returns nil - retry loop will break
@@ -231,6 +245,12 @@ type DataQueryExplanation struct {
 	Explanation
 
 	AST string
+
+	// ParameterTypes are types of parameters declared in query by names (such as "$id").
+	// ParameterTypes are filled by Explain only, Session.Explain does not prepare query
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	ParameterTypes map[string]types.Type
 }
 
 // DataQuery only for tracers
@@ -497,6 +517,17 @@ type Options struct {
 	TxCommitOptions []options.CommitTransactionOption
 	RetryOptions    []retry.Option
 	Trace           *trace.Table
+
+	// CompilationTimeoutGrowthFactor and CompilationTimeoutMax are set with WithCompilationTimeoutGrowth
+	CompilationTimeoutGrowthFactor float64
+	CompilationTimeoutMax          time.Duration
+
+	// HedgeDelay and MaxHedges are set with WithHedgeDelay and WithMaxHedges
+	HedgeDelay time.Duration
+	MaxHedges  int
+
+	// SessionAffinityKey is set with WithSessionAffinityKey
+	SessionAffinityKey string
 }
 
 type Option interface {
@@ -516,6 +547,33 @@ func WithLabel(label string) labelOption {
 	return labelOption(label)
 }
 
+var _ Option = compilationTimeoutGrowthOption{}
+
+type compilationTimeoutGrowthOption struct {
+	factor float64
+	max    time.Duration
+}
+
+func (opt compilationTimeoutGrowthOption) ApplyTableOption(opts *Options) {
+	opts.CompilationTimeoutGrowthFactor = opt.factor
+	opts.CompilationTimeoutMax = opt.max
+}
+
+// WithCompilationTimeoutGrowth makes retry of attempts failed with query compilation timeout.
+// Operation timeout of next attempt grows by factor up to max, so cold query which compiles longer
+// than operation timeout is compiled eventually. Compilation timeout is recognized by status TIMEOUT with
+// timeout issue code, attempt failed with it is retried only for idempotent operations (see WithIdempotent)
+// because server may report timeout after start of execution. Growing stops after attempt with max timeout.
+// Growing is not applied if operation timeout is not set in client config or context
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithCompilationTimeoutGrowth(factor float64, max time.Duration) compilationTimeoutGrowthOption {
+	return compilationTimeoutGrowthOption{
+		factor: factor,
+		max:    max,
+	}
+}
+
 var _ Option = retryOptionsOption{}
 
 type retryOptionsOption []retry.Option
@@ -529,15 +587,89 @@ func WithRetryBudget(b budget.Budget) retryOptionsOption {
 	return []retry.Option{retry.WithBudget(b)}
 }
 
-// Deprecated: redundant option
-// Will be removed after Oct 2024.
-// Read about versioning policy: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#deprecated
+// WithRetryOptions passes retry options into Do and DoTx,
+// for example WithRetryOptions([]retry.Option{retry.WithMaxAttempts(1)}) runs operation once on pooled session
+// and returns error of operation without retries
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
 func WithRetryOptions(retryOptions []retry.Option) retryOptionsOption {
 	return retryOptions
 }
 
-func WithIdempotent() retryOptionsOption {
-	return []retry.Option{retry.WithIdempotent(true)}
+var _ Option = idempotentOption{}
+
+type idempotentOption struct{}
+
+func (idempotentOption) ApplyTableOption(opts *Options) {
+	opts.Idempotent = true
+	opts.RetryOptions = append(opts.RetryOptions, retry.WithIdempotent(true))
+}
+
+func WithIdempotent() idempotentOption {
+	return idempotentOption{}
+}
+
+var _ Option = hedgeDelayOption(0)
+
+type hedgeDelayOption time.Duration
+
+func (d hedgeDelayOption) ApplyTableOption(opts *Options) {
+	opts.HedgeDelay = time.Duration(d)
+}
+
+// WithHedgeDelay enables hedging of idempotent operation in Do: if operation is not completed within delay,
+// it is started again on another session (preferably on another node). First successful result is taken
+// and other calls are canceled. Hedging is applied only with WithIdempotent: idempotency which is passed
+// with WithRetryOptions([]retry.Option{retry.WithIdempotent(true)}) does not enable hedging.
+// Operation is called concurrently on different sessions, so op must be safe for concurrent execution
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithHedgeDelay(d time.Duration) hedgeDelayOption {
+	return hedgeDelayOption(d)
+}
+
+var _ Option = maxHedgesOption(0)
+
+type maxHedgesOption int
+
+func (n maxHedgesOption) ApplyTableOption(opts *Options) {
+	opts.MaxHedges = int(n)
+}
+
+// WithMaxHedges limits number of hedged calls of operation started with WithHedgeDelay. Default is 1
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxHedges(n int) maxHedgesOption {
+	return maxHedgesOption(n)
+}
+
+// DoHedged executes idempotent operation with Client.Do and hedging (see WithHedgeDelay and WithMaxHedges).
+// Operation is called concurrently on different sessions, so op must be safe for concurrent execution.
+// DoHedged returns after completion of all started calls of operation. Without WithIdempotent and
+// WithHedgeDelay DoHedged is equal to Client.Do
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DoHedged(ctx context.Context, c Client, op Operation, opts ...Option) error {
+	return c.Do(ctx, op, opts...)
+}
+
+var _ Option = sessionAffinityKeyOption("")
+
+type sessionAffinityKeyOption string
+
+func (key sessionAffinityKeyOption) ApplyTableOption(opts *Options) {
+	opts.SessionAffinityKey = string(key)
+}
+
+// WithSessionAffinityKey makes Do and DoTx prefer the session which was used with the same key before,
+// for example for reusing of server-side caches of prepared statements by the same user.
+// Affinity is best-effort: if that session is busy or closed, any other session is used without waiting.
+// Pool remembers a bounded number of the most recently used keys. Hits and misses are reported
+// with trace.Table.OnPoolSessionAffinity
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithSessionAffinityKey(key string) sessionAffinityKeyOption {
+	return sessionAffinityKeyOption(key)
 }
 
 var _ Option = txSettingsOption{}
@@ -0,0 +1,360 @@
+// Package tabletest provides in-memory implementation of table.Client for unit tests of code which uses
+// table.Client (Do, DoTx and sessions) without YDB server.
+//
+// Client executes all queries with real table client (with retries, session pool and transactions) over
+// stubbed table service. Stubbed table service returns programmed results and errors of queries and
+// records executed queries for assertions.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package tabletest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"google.golang.org/protobuf/proto"
+
+	internalTable "github.com/ydb-platform/ydb-go-sdk/v3/internal/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/table/config"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+// Query is a data query executed on Client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Query struct {
+	// Query is a text of query
+	Query string
+
+	// Params are parameters of query by names (such as "$id")
+	Params map[string]types.Value
+
+	// TxID is an identifier of transaction of query
+	TxID string
+
+	// Commit reports that transaction was committed with query
+	Commit bool
+}
+
+type response struct {
+	sets []*Ydb.ResultSet
+	err  error
+}
+
+// Client is in-memory table.Client for unit tests. Client must be closed after usage
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Client struct {
+	table.Client
+
+	client *internalTable.Client
+
+	onBegin    func(txID string)
+	onCommit   func(txID string)
+	onRollback func(txID string)
+
+	mu            sync.Mutex
+	responses     map[string][]response
+	commitErrors  []error
+	executed      []Query
+	transactions  map[string]struct{}
+	lastSessionID uint64
+	lastTxID      uint64
+}
+
+// Option is an option of Client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Option func(c *Client)
+
+// WithOnBegin defines callback called on begin of every transaction
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithOnBegin(onBegin func(txID string)) Option {
+	return func(c *Client) {
+		c.onBegin = onBegin
+	}
+}
+
+// WithOnCommit defines callback called on successful commit of every transaction
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithOnCommit(onCommit func(txID string)) Option {
+	return func(c *Client) {
+		c.onCommit = onCommit
+	}
+}
+
+// WithOnRollback defines callback called on rollback of every transaction
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithOnRollback(onRollback func(txID string)) Option {
+	return func(c *Client) {
+		c.onRollback = onRollback
+	}
+}
+
+// NewClient makes in-memory table.Client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		responses:    make(map[string][]response),
+		transactions: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
+	c.client = internalTable.New(context.Background(), testutil.NewBalancer(
+		testutil.WithInvokeHandlers(
+			testutil.InvokeHandlers{
+				testutil.TableCreateSession:       c.createSession,
+				testutil.TableDeleteSession:       ok,
+				testutil.TableKeepAlive:           keepAlive,
+				testutil.TableBeginTransaction:    c.beginTransaction,
+				testutil.TableExecuteDataQuery:    c.executeDataQuery,
+				testutil.TableCommitTransaction:   c.commitTransaction,
+				testutil.TableRollbackTransaction: c.rollbackTransaction,
+			},
+		),
+	), config.New(config.WithIdleThreshold(-1)))
+	c.Client = c.client
+
+	return c
+}
+
+// Close closes sessions of client
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) Close(ctx context.Context) error {
+	return c.client.Close(ctx)
+}
+
+// AddResult appends response of next execution of query with text query. Responses of query are returned
+// in order of adding, query without queued responses returns empty result
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) AddResult(query string, sets ...*Ydb.ResultSet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.responses[query] = append(c.responses[query], response{sets: sets})
+}
+
+// AddError appends error with YDB status code as response of next execution of query with text query.
+// Retryable status codes (such as ABORTED or UNAVAILABLE) make retry of Do and DoTx
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) AddError(query string, code Ydb.StatusIds_StatusCode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.responses[query] = append(c.responses[query], response{err: operationError(code)})
+}
+
+// AddCommitError appends error with YDB status code as response of next commit of transaction
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) AddCommitError(code Ydb.StatusIds_StatusCode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.commitErrors = append(c.commitErrors, operationError(code))
+}
+
+// Executed returns all executed queries in order of execution including failed attempts
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (c *Client) Executed() []Query {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	executed := make([]Query, len(c.executed))
+	copy(executed, c.executed)
+
+	return executed
+}
+
+func operationError(code Ydb.StatusIds_StatusCode) error {
+	return xerrors.Operation(xerrors.WithStatusCode(code))
+}
+
+func ok(interface{}) (proto.Message, error) {
+	return &Ydb_Table.DeleteSessionResponse{}, nil
+}
+
+func keepAlive(interface{}) (proto.Message, error) {
+	return &Ydb_Table.KeepAliveResult{
+		SessionStatus: Ydb_Table.KeepAliveResult_SESSION_STATUS_READY,
+	}, nil
+}
+
+func (c *Client) createSession(interface{}) (proto.Message, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastSessionID++
+
+	return &Ydb_Table.CreateSessionResult{
+		SessionId: testutil.SessionID(testutil.WithNodeID(uint32(c.lastSessionID))),
+	}, nil
+}
+
+// notifications are callbacks which are called after unlock of client, so callbacks can use client
+type notifications []func()
+
+func (n *notifications) add(callback func(txID string), txID string) {
+	if callback != nil {
+		*n = append(*n, func() {
+			callback(txID)
+		})
+	}
+}
+
+func (n *notifications) call() {
+	for _, notify := range *n {
+		notify()
+	}
+}
+
+// beginTx starts new transaction, must be called under lock
+func (c *Client) beginTx(n *notifications) string {
+	c.lastTxID++
+	txID := "tx-" + strconv.FormatUint(c.lastTxID, 10)
+	c.transactions[txID] = struct{}{}
+	n.add(c.onBegin, txID)
+
+	return txID
+}
+
+// commitTx finishes transaction after commit, must be called under lock
+func (c *Client) commitTx(n *notifications, txID string) {
+	delete(c.transactions, txID)
+	n.add(c.onCommit, txID)
+}
+
+func (c *Client) beginTransaction(interface{}) (proto.Message, error) {
+	var n notifications
+	defer n.call()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &Ydb_Table.BeginTransactionResult{
+		TxMeta: &Ydb_Table.TransactionMeta{
+			Id: c.beginTx(&n),
+		},
+	}, nil
+}
+
+func (c *Client) executeDataQuery(request interface{}) (proto.Message, error) {
+	r, has := request.(*Ydb_Table.ExecuteDataQueryRequest)
+	if !has {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("tabletest: unexpected request type %T", request))
+	}
+
+	var n notifications
+	defer n.call()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	query := Query{
+		Query:  r.GetQuery().GetYqlText(),
+		Params: make(map[string]types.Value, len(r.GetParameters())),
+		Commit: r.GetTxControl().GetCommitTx(),
+	}
+	for name, v := range r.GetParameters() {
+		query.Params[name] = value.FromYDB(v.GetType(), v.GetValue())
+	}
+
+	if txID := r.GetTxControl().GetTxId(); txID != "" {
+		query.TxID = txID
+		if _, has := c.transactions[txID]; !has {
+			c.executed = append(c.executed, query)
+
+			return nil, operationError(Ydb.StatusIds_NOT_FOUND)
+		}
+	} else {
+		query.TxID = c.beginTx(&n)
+	}
+	c.executed = append(c.executed, query)
+
+	var resp response
+	if queue := c.responses[query.Query]; len(queue) > 0 {
+		resp, c.responses[query.Query] = queue[0], queue[1:]
+	}
+	if resp.err != nil {
+		// failed query breaks transaction such as YDB server does
+		delete(c.transactions, query.TxID)
+
+		return nil, resp.err
+	}
+
+	if query.Commit {
+		c.commitTx(&n, query.TxID)
+	}
+
+	return &Ydb_Table.ExecuteQueryResult{
+		ResultSets: resp.sets,
+		TxMeta: &Ydb_Table.TransactionMeta{
+			Id: query.TxID,
+		},
+	}, nil
+}
+
+func (c *Client) commitTransaction(request interface{}) (proto.Message, error) {
+	r, has := request.(*Ydb_Table.CommitTransactionRequest)
+	if !has {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("tabletest: unexpected request type %T", request))
+	}
+
+	var n notifications
+	defer n.call()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, has := c.transactions[r.GetTxId()]; !has {
+		return nil, operationError(Ydb.StatusIds_NOT_FOUND)
+	}
+	if len(c.commitErrors) > 0 {
+		err := c.commitErrors[0]
+		c.commitErrors = c.commitErrors[1:]
+		delete(c.transactions, r.GetTxId())
+
+		return nil, err
+	}
+	c.commitTx(&n, r.GetTxId())
+
+	return &Ydb_Table.CommitTransactionResult{}, nil
+}
+
+func (c *Client) rollbackTransaction(request interface{}) (proto.Message, error) {
+	r, has := request.(*Ydb_Table.RollbackTransactionRequest)
+	if !has {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("tabletest: unexpected request type %T", request))
+	}
+
+	var n notifications
+	defer n.call()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.transactions, r.GetTxId())
+	n.add(c.onRollback, r.GetTxId())
+
+	return &Ydb_Table.RollbackTransactionResponse{}, nil
+}
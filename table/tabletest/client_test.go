@@ -0,0 +1,138 @@
+package tabletest
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+func newClient(t *testing.T, opts ...Option) *Client {
+	c := NewClient(opts...)
+	t.Cleanup(func() {
+		_ = c.Close(context.Background())
+	})
+
+	return c
+}
+
+func TestClientDo(t *testing.T) {
+	const query = "DECLARE $id AS Uint64; SELECT id, title FROM series WHERE id = $id"
+	c := newClient(t)
+	c.AddError(query, Ydb.StatusIds_UNAVAILABLE)
+	c.AddResult(query, testutil.NewResultSet(
+		[]testutil.Column{
+			{Name: "id", Type: types.TypeUint64},
+			{Name: "title", Type: types.Optional(types.TypeText)},
+		},
+		[]types.Value{types.Uint64Value(1), types.OptionalValue(types.TextValue("IT Crowd"))},
+		[]types.Value{types.Uint64Value(2), types.NullValue(types.TypeText)},
+	))
+
+	var (
+		ids    []uint64
+		titles []*string
+	)
+	err := c.Do(xtest.Context(t), func(ctx context.Context, s table.Session) error {
+		_, res, err := s.Execute(ctx, table.DefaultTxControl(), query,
+			table.NewQueryParameters(table.ValueParam("$id", types.Uint64Value(1))),
+		)
+		if err != nil {
+			return err
+		}
+		defer res.Close()
+		ids, titles = nil, nil
+		for res.NextResultSet(ctx) {
+			for res.NextRow() {
+				var (
+					id    uint64
+					title *string
+				)
+				if err = res.Scan(&id, &title); err != nil {
+					return err
+				}
+				ids = append(ids, id)
+				titles = append(titles, title)
+			}
+		}
+
+		return res.Err()
+	}, table.WithIdempotent())
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, ids)
+	require.Len(t, titles, 2)
+	require.Equal(t, "IT Crowd", *titles[0])
+	require.Nil(t, titles[1])
+
+	executed := c.Executed()
+	require.Len(t, executed, 2)
+	for _, q := range executed {
+		require.Equal(t, query, q.Query)
+		require.Equal(t, map[string]types.Value{"$id": types.Uint64Value(1)}, q.Params)
+		require.True(t, q.Commit)
+	}
+}
+
+func TestClientDoNonRetryableError(t *testing.T) {
+	const query = "SELECT 1"
+	c := newClient(t)
+	c.AddError(query, Ydb.StatusIds_SCHEME_ERROR)
+	err := c.Do(xtest.Context(t), func(ctx context.Context, s table.Session) error {
+		_, _, err := s.Execute(ctx, table.DefaultTxControl(), query, nil)
+
+		return err
+	})
+	require.Error(t, err)
+	require.True(t, xerrors.IsOperationError(err, Ydb.StatusIds_SCHEME_ERROR))
+	require.Len(t, c.Executed(), 1)
+}
+
+func TestClientDoTx(t *testing.T) {
+	const (
+		selectQuery = "SELECT 1"
+		upsertQuery = "UPSERT INTO t (id) VALUES (1)"
+	)
+	var begins, commits, rollbacks atomic.Int32
+	c := newClient(t,
+		WithOnBegin(func(string) { begins.Add(1) }),
+		WithOnCommit(func(string) { commits.Add(1) }),
+		WithOnRollback(func(string) { rollbacks.Add(1) }),
+	)
+	c.AddError(upsertQuery, Ydb.StatusIds_ABORTED)
+	c.AddCommitError(Ydb.StatusIds_ABORTED)
+
+	var attempts int
+	err := c.DoTx(xtest.Context(t), func(ctx context.Context, tx table.TransactionActor) error {
+		attempts++
+		if _, err := tx.Execute(ctx, selectQuery, nil); err != nil {
+			return err
+		}
+		_, err := tx.Execute(ctx, upsertQuery, nil)
+
+		return err
+	}, table.WithIdempotent())
+	require.NoError(t, err)
+	// first attempt fails on upsert, second attempt fails on commit
+	require.Equal(t, 3, attempts)
+	require.EqualValues(t, 3, begins.Load())
+	require.EqualValues(t, 1, commits.Load())
+	require.GreaterOrEqual(t, rollbacks.Load(), int32(1))
+
+	executed := c.Executed()
+	require.Len(t, executed, 6)
+	for i := 0; i < len(executed); i += 2 {
+		require.Equal(t, selectQuery, executed[i].Query)
+		require.Equal(t, upsertQuery, executed[i+1].Query)
+		require.Equal(t, executed[i].TxID, executed[i+1].TxID)
+		require.False(t, executed[i+1].Commit)
+	}
+	require.NotEqual(t, executed[0].TxID, executed[2].TxID)
+}
@@ -0,0 +1,65 @@
+package tabletest_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/tabletest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/testutil"
+)
+
+// seriesTitle is a code under test which uses table.Client
+func seriesTitle(ctx context.Context, c table.Client, id uint64) (title string, err error) {
+	err = c.Do(ctx, func(ctx context.Context, s table.Session) error {
+		_, res, err := s.Execute(ctx, table.DefaultTxControl(),
+			"DECLARE $id AS Uint64; SELECT title FROM series WHERE series_id = $id",
+			table.NewQueryParameters(table.ValueParam("$id", types.Uint64Value(id))),
+		)
+		if err != nil {
+			return err
+		}
+		defer res.Close()
+		if res.NextResultSet(ctx) && res.NextRow() {
+			if err = res.Scan(&title); err != nil {
+				return err
+			}
+		}
+
+		return res.Err()
+	}, table.WithIdempotent())
+
+	return title, err
+}
+
+func Example() {
+	ctx := context.Background()
+	c := tabletest.NewClient()
+	defer func() {
+		_ = c.Close(ctx)
+	}()
+
+	const query = "DECLARE $id AS Uint64; SELECT title FROM series WHERE series_id = $id"
+	// first attempt fails with retryable error
+	c.AddError(query, Ydb.StatusIds_UNAVAILABLE)
+	c.AddResult(query, testutil.NewResultSet(
+		[]testutil.Column{{Name: "title", Type: types.TypeText}},
+		[]types.Value{types.TextValue("IT Crowd")},
+	))
+
+	title, err := seriesTitle(ctx, c, 1)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(title)
+	for _, q := range c.Executed() {
+		fmt.Println(q.Params["$id"].Yql())
+	}
+	// Output:
+	// IT Crowd
+	// 1ul
+	// 1ul
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
@@ -11,6 +12,14 @@ import (
 var errNilValue = errors.New("nil value")
 
 // CastTo try cast value to destination type value
+//
+// Destinations are checked in the following order:
+//  1. destinations natively supported by value type (such as *string for Text values
+//     or encoding.TextUnmarshaler for UUID values)
+//  2. sql.Scanner, which receives the same Go representation of value as database/sql driver provides
+//     (nil for Null values)
+//  3. encoding.TextUnmarshaler, which receives text representation of value
+//  4. encoding.BinaryUnmarshaler, which receives bytes representation of value
 func CastTo(v Value, dst interface{}) error {
 	if v == nil {
 		return xerrors.WithStackTrace(errNilValue)
@@ -44,6 +53,65 @@ func ToDecimal(v Value) (*Decimal, error) {
 	return nil, xerrors.WithStackTrace(fmt.Errorf("value type '%s' is not decimal type", v.Type().Yql()))
 }
 
+// ToTime returns time.Time from abstract Value of Date, Datetime, Timestamp, TzDate, TzDatetime
+// or TzTimestamp types
+//
+// Date, Datetime and Timestamp values are returned in UTC location (Date is a midnight of day in UTC).
+// Tz* values are returned in location from value.
+// Optional values are unwrapped, Null values are returned as zero time.Time.
+func ToTime(v Value) (t time.Time, _ error) {
+	if v == nil {
+		return t, xerrors.WithStackTrace(errNilValue)
+	}
+	switch innerType(v.Type()) {
+	case TypeDate, TypeDatetime, TypeTimestamp:
+		if err := value.CastTo(v, &t); err != nil {
+			return t, xerrors.WithStackTrace(err)
+		}
+		if t.IsZero() {
+			return t, nil
+		}
+
+		return t.UTC(), nil
+	case TypeTzDate, TypeTzDatetime, TypeTzTimestamp:
+		if err := value.CastTo(v, &t); err != nil {
+			return t, xerrors.WithStackTrace(err)
+		}
+
+		return t, nil
+	default:
+		return t, xerrors.WithStackTrace(fmt.Errorf("value type '%s' is not temporal type", v.Type().Yql()))
+	}
+}
+
+// ToDuration returns time.Duration from abstract Value of Interval type
+//
+// Optional values are unwrapped, Null values are returned as zero time.Duration.
+func ToDuration(v Value) (d time.Duration, _ error) {
+	if v == nil {
+		return d, xerrors.WithStackTrace(errNilValue)
+	}
+	if innerType(v.Type()) != TypeInterval {
+		return d, xerrors.WithStackTrace(fmt.Errorf("value type '%s' is not interval type", v.Type().Yql()))
+	}
+	if err := value.CastTo(v, &d); err != nil {
+		return d, xerrors.WithStackTrace(err)
+	}
+
+	return d, nil
+}
+
+// innerType returns type under all Optional wrappers
+func innerType(t Type) Type {
+	for {
+		isOptional, inner := IsOptional(t)
+		if !isOptional {
+			return t
+		}
+		t = inner
+	}
+}
+
 // ListItems returns list items from abstract Value
 func ListItems(v Value) ([]Value, error) {
 	if vv, has := v.(interface {
@@ -77,7 +145,8 @@ func StructFields(v Value) (map[string]Value, error) {
 	return nil, xerrors.WithStackTrace(fmt.Errorf("cannot get struct fields from '%s'", v.Type().Yql()))
 }
 
-// VariantValue returns variant value from abstract Value
+// VariantValue returns name (for struct-based variants), index of alternative and inner value
+// from abstract Value of Variant type
 func VariantValue(v Value) (name string, idx uint32, _ Value, _ error) {
 	if vv, has := v.(interface {
 		Variant() (name string, index uint32)
@@ -91,6 +160,18 @@ func VariantValue(v Value) (name string, idx uint32, _ Value, _ error) {
 	return "", 0, nil, xerrors.WithStackTrace(fmt.Errorf("cannot get variant value from '%s'", v.Type().Yql()))
 }
 
+// TaggedItem returns tag and tagged value from abstract Value of Tagged type
+func TaggedItem(v Value) (tag string, _ Value, _ error) {
+	if vv, has := v.(interface {
+		Tag() string
+		Value() Value
+	}); has {
+		return vv.Tag(), vv.Value(), nil
+	}
+
+	return "", nil, xerrors.WithStackTrace(fmt.Errorf("cannot get tagged value from '%s'", v.Type().Yql()))
+}
+
 // DictFields returns dict values from abstract Value
 //
 // Deprecated: use DictValues instead.
@@ -3,6 +3,7 @@ package types
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -87,3 +88,50 @@ func TestToDecimal(t *testing.T) {
 		})
 	}
 }
+
+func TestToTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	ts := time.Date(2020, time.May, 29, 11, 22, 54, 123456000, berlin)
+	for _, tt := range []struct {
+		v   Value
+		exp time.Time
+	}{
+		{
+			v:   DateValueFromTime(ts),
+			exp: time.Date(2020, time.May, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			v:   DatetimeValueFromTime(ts),
+			exp: ts.Truncate(time.Second).UTC(),
+		},
+		{
+			v:   OptionalValue(TimestampValueFromTime(ts)),
+			exp: ts.UTC(),
+		},
+		{
+			v:   TzTimestampValueFromTime(ts),
+			exp: ts,
+		},
+		{
+			v:   NullValue(TypeTimestamp),
+			exp: time.Time{},
+		},
+	} {
+		t.Run(tt.v.Yql(), func(t *testing.T) {
+			v, err := ToTime(tt.v)
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, v)
+		})
+	}
+	_, err = ToTime(Int64Value(1))
+	require.Error(t, err)
+}
+
+func TestToDuration(t *testing.T) {
+	d, err := ToDuration(IntervalValueFromDuration(-time.Hour - time.Microsecond))
+	require.NoError(t, err)
+	require.Equal(t, -time.Hour-time.Microsecond, d)
+	_, err = ToDuration(TimestampValue(1))
+	require.Error(t, err)
+}
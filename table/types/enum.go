@@ -0,0 +1,96 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrUnknownEnumValue is returned by EnumMapper for Go values and YDB values which are not mapped
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrUnknownEnumValue = xerrors.Wrap(errors.New("unknown enum value"))
+
+// ValueMapper is an interface of custom Go types which control their encoding into YDB values
+// (for query parameters) and decoding from YDB values (for Scan, ScanNamed and ScanStruct).
+// ScanYDBValue receives value as is, including Optional and NULL values.
+// Usually YDBValue is implemented with value receiver and ScanYDBValue with pointer receiver
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ValueMapper = value.Mapper
+
+// EnumMapper maps Go constants of enum type E to YDB values and back, for example for storing of enums
+// as Int32 or Utf8 columns. YDB values are compared with types, so Int32Value(1) and Int64Value(1) differ
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type EnumMapper[E comparable] struct {
+	values   map[E]Value
+	enums    map[string]E
+	fallback *E
+}
+
+// EnumMapperOption is an option of NewEnumMapper
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type EnumMapperOption[E comparable] func(m *EnumMapper[E])
+
+// WithEnumFallback makes EnumMapper.Scan return fallback instead of error for unknown and NULL values
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithEnumFallback[E comparable](fallback E) EnumMapperOption[E] {
+	return func(m *EnumMapper[E]) {
+		m.fallback = &fallback
+	}
+}
+
+// NewEnumMapper makes EnumMapper from mapping of Go constants to YDB values. Mapping must be one-to-one
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func NewEnumMapper[E comparable](values map[E]Value, opts ...EnumMapperOption[E]) *EnumMapper[E] {
+	m := &EnumMapper[E]{
+		values: make(map[E]Value, len(values)),
+		enums:  make(map[string]E, len(values)),
+	}
+	for e, v := range values {
+		m.values[e] = v
+		m.enums[v.Yql()] = e
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(m)
+		}
+	}
+
+	return m
+}
+
+// Value returns YDB value of e
+func (m *EnumMapper[E]) Value(e E) (Value, error) {
+	v, has := m.values[e]
+	if !has {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %v", ErrUnknownEnumValue, e))
+	}
+
+	return v, nil
+}
+
+// Scan returns Go constant of YDB value v. Optional values are unwrapped.
+// Unknown and NULL values are returned as fallback value (see WithEnumFallback) or as error with raw value
+func (m *EnumMapper[E]) Scan(v Value) (e E, _ error) {
+	if v == nil {
+		return e, xerrors.WithStackTrace(errNilValue)
+	}
+	inner := value.UnwrapOptional(v)
+	if inner != nil {
+		if e, has := m.enums[inner.Yql()]; has {
+			return e, nil
+		}
+	}
+	if m.fallback != nil {
+		return *m.fallback, nil
+	}
+
+	return e, xerrors.WithStackTrace(fmt.Errorf("%w: %s", ErrUnknownEnumValue, v.Yql()))
+}
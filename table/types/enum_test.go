@@ -0,0 +1,106 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testColor int
+
+const (
+	testColorUnknown testColor = iota
+	testColorRed
+	testColorGreen
+)
+
+var testColors = NewEnumMapper(map[testColor]Value{
+	testColorRed:   Int32Value(1),
+	testColorGreen: Int32Value(2),
+})
+
+func (c testColor) YDBValue() (Value, error) {
+	return testColors.Value(c)
+}
+
+func (c *testColor) ScanYDBValue(v Value) (err error) {
+	*c, err = testColors.Scan(v)
+
+	return err
+}
+
+var _ ValueMapper = (*testColor)(nil)
+
+func TestEnumMapper(t *testing.T) {
+	t.Run("Value", func(t *testing.T) {
+		v, err := testColors.Value(testColorGreen)
+		require.NoError(t, err)
+		require.Equal(t, Int32Value(2), v)
+		_, err = testColors.Value(testColorUnknown)
+		require.ErrorIs(t, err, ErrUnknownEnumValue)
+	})
+	t.Run("Scan", func(t *testing.T) {
+		for _, tt := range []struct {
+			name string
+			v    Value
+			e    testColor
+			err  error
+		}{
+			{
+				name: "Value",
+				v:    Int32Value(1),
+				e:    testColorRed,
+			},
+			{
+				name: "Optional",
+				v:    OptionalValue(OptionalValue(Int32Value(2))),
+				e:    testColorGreen,
+			},
+			{
+				name: "Unknown",
+				v:    Int32Value(3),
+				err:  ErrUnknownEnumValue,
+			},
+			{
+				name: "OtherType",
+				v:    Int64Value(1),
+				err:  ErrUnknownEnumValue,
+			},
+			{
+				name: "Null",
+				v:    NullValue(TypeInt32),
+				err:  ErrUnknownEnumValue,
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				e, err := testColors.Scan(tt.v)
+				require.ErrorIs(t, err, tt.err)
+				require.Equal(t, tt.e, e)
+			})
+		}
+	})
+	t.Run("UnknownRawValue", func(t *testing.T) {
+		_, err := testColors.Scan(Int32Value(42))
+		require.ErrorContains(t, err, "unknown enum value: 42")
+	})
+	t.Run("Fallback", func(t *testing.T) {
+		m := NewEnumMapper(map[string]Value{
+			"active": TextValue("active"),
+		}, WithEnumFallback("unknown"))
+		e, err := m.Scan(TextValue("deleted"))
+		require.NoError(t, err)
+		require.Equal(t, "unknown", e)
+		e, err = m.Scan(NullValue(TypeText))
+		require.NoError(t, err)
+		require.Equal(t, "unknown", e)
+		e, err = m.Scan(OptionalValue(TextValue("active")))
+		require.NoError(t, err)
+		require.Equal(t, "active", e)
+	})
+	t.Run("CastTo", func(t *testing.T) {
+		var c testColor
+		require.NoError(t, CastTo(OptionalValue(Int32Value(2)), &c))
+		require.Equal(t, testColorGreen, c)
+		require.ErrorIs(t, CastTo(Int32Value(3), &c), ErrUnknownEnumValue)
+	})
+}
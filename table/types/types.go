@@ -73,6 +73,11 @@ func VariantTuple(elems ...Type) Type {
 	return types.NewVariantTuple(elems...)
 }
 
+// Tagged returns Tagged<t,tag> type
+func Tagged(tag string, t Type) Type {
+	return types.NewTagged(tag, t)
+}
+
 func Void() Type {
 	return types.NewVoid()
 }
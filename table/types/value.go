@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/decimal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/params"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xstring"
 )
 
@@ -63,38 +65,121 @@ func TzTimestampValue(v string) Value { return value.TzTimestampValue(v) }
 
 // DateValueFromTime makes Date value from time.Time
 //
+// Time of day is truncated, date is calculated in UTC.
+// Dates out of range [1970-01-01, 2105-12-31] are not checked, use DateValueFromTimeChecked for it.
+//
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
 func DateValueFromTime(t time.Time) Value {
 	return value.DateValueFromTime(t)
 }
 
+// DateValueFromTimeChecked makes Date value from time.Time or returns *OutOfRangeError
+// if date is out of range [1970-01-01, 2105-12-31]
+//
+// Time of day is truncated, date is calculated in UTC.
+func DateValueFromTimeChecked(t time.Time) (Value, error) {
+	v, err := value.DateValueFromTimeChecked(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
 // DatetimeValueFromTime makes Datetime value from time.Time
 //
+// Fractional seconds are truncated.
+// Times out of range [1970-01-01T00:00:00Z, 2106-01-01T00:00:00Z) are not checked,
+// use DatetimeValueFromTimeChecked for it.
+//
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
 func DatetimeValueFromTime(t time.Time) Value {
 	return value.DatetimeValueFromTime(t)
 }
 
+// DatetimeValueFromTimeChecked makes Datetime value from time.Time or returns *OutOfRangeError
+// if time is out of range [1970-01-01T00:00:00Z, 2106-01-01T00:00:00Z)
+//
+// Fractional seconds are truncated.
+func DatetimeValueFromTimeChecked(t time.Time) (Value, error) {
+	v, err := value.DatetimeValueFromTimeChecked(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
 // TimestampValueFromTime makes Timestamp value from time.Time
 //
+// Nanoseconds are truncated to microseconds.
+// Times out of range [1970-01-01T00:00:00Z, 2106-01-01T00:00:00Z) are not checked,
+// use TimestampValueFromTimeChecked for it.
+//
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
 func TimestampValueFromTime(t time.Time) Value {
 	return value.TimestampValueFromTime(t)
 }
 
+// TimestampValueFromTimeChecked makes Timestamp value from time.Time or returns *OutOfRangeError
+// if time is out of range [1970-01-01T00:00:00Z, 2106-01-01T00:00:00Z)
+//
+// Nanoseconds are truncated to microseconds.
+func TimestampValueFromTimeChecked(t time.Time) (Value, error) {
+	v, err := value.TimestampValueFromTimeChecked(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
 // IntervalValueFromDuration makes Interval value from time.Duration
 //
+// Nanoseconds are truncated to microseconds toward zero, sign of duration is saved.
+//
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
 func IntervalValueFromDuration(v time.Duration) Value {
 	return value.IntervalValueFromDuration(v)
 }
 
+// Interval makes Interval value from days, hours, minutes, seconds and microseconds or returns
+// *OutOfRangeError if sum of parts is out of range of Interval type (136 years, range of Timestamp type)
+//
+// Parts may have different signs, for example Interval(1, -1, 0, 0, 0) is 23 hours.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Interval(days, hours, minutes, seconds, microseconds int64) (Value, error) {
+	v, err := value.IntervalValueFromParts(days, hours, minutes, seconds, microseconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// IntervalValueFromDurationChecked makes Interval value from time.Duration or returns *OutOfRangeError
+// if absolute value of duration is not less than 136 years (range of Timestamp type)
+//
+// Nanoseconds are truncated to microseconds toward zero, sign of duration is saved.
+func IntervalValueFromDurationChecked(v time.Duration) (Value, error) {
+	vv, err := value.IntervalValueFromDurationChecked(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return vv, nil
+}
+
 // TzDateValueFromTime makes TzDate value from time.Time
 //
+// Location of t is saved as IANA name. Time in time.Local location is converted to UTC
+// because YDB cannot interpret local location of client.
+//
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
 func TzDateValueFromTime(t time.Time) Value {
@@ -103,6 +188,9 @@ func TzDateValueFromTime(t time.Time) Value {
 
 // TzDatetimeValueFromTime makes TzDatetime value from time.Time
 //
+// Fractional seconds are truncated. Location of t is saved as IANA name.
+// Time in time.Local location is converted to UTC because YDB cannot interpret local location of client.
+//
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
 func TzDatetimeValueFromTime(t time.Time) Value {
@@ -111,12 +199,23 @@ func TzDatetimeValueFromTime(t time.Time) Value {
 
 // TzTimestampValueFromTime makes TzTimestamp value from time.Time
 //
+// Nanoseconds are truncated to microseconds. Location of t is saved as IANA name.
+// Time in time.Local location is converted to UTC because YDB cannot interpret local location of client.
+//
 // Warning: all *From* helpers will be removed at next major release
 // (functional will be implements with go1.18 type lists)
 func TzTimestampValueFromTime(t time.Time) Value {
 	return value.TzTimestampValueFromTime(t)
 }
 
+// OutOfRangeError is the error for time values which cannot be represented by YDB temporal type
+//
+// OutOfRangeError matches ErrOutOfRange with errors.Is
+type OutOfRangeError = value.OutOfRangeError
+
+// ErrOutOfRange is the sentinel error for checking out of range errors with errors.Is
+var ErrOutOfRange = value.ErrOutOfRange
+
 // StringValue returns bytes value
 //
 // Deprecated: use BytesValue instead.
@@ -154,10 +253,63 @@ func JSONValue(v string) Value { return value.JSONValue(v) }
 // (functional will be implements with go1.18 type lists)
 func JSONValueFromBytes(v []byte) Value { return value.JSONValue(xstring.FromBytes(v)) }
 
+// UUIDValue makes UUID value from bytes in RFC 4122 order (compatible with github.com/google/uuid.UUID)
 func UUIDValue(v [16]byte) Value { return value.UUIDValue(v) }
 
+// UUIDValueFromString makes UUID value from canonical text form such as "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+func UUIDValueFromString(s string) (Value, error) {
+	v, err := value.UUIDFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.UUIDValue(v), nil
+}
+
+// UUIDWithLegacyByteOrderValue makes UUID value from bytes in byte order of ydb-go-sdk before v3.67.0
+//
+// Use it only for addressing rows which were written by old versions of ydb-go-sdk
+// and were looked up by the same bytes. New code must use UUIDValue.
+func UUIDWithLegacyByteOrderValue(v [16]byte) Value { return value.UUIDWithLegacyByteOrderValue(v) }
+
+// LegacyUUIDBytes is a scan destination for UUID values which returns bytes in byte order
+// of ydb-go-sdk before v3.67.0
+//
+// Scan into *LegacyUUIDBytes returns the same bytes which were written by old versions of ydb-go-sdk.
+// New code must scan UUID into [16]byte, string or github.com/google/uuid.UUID.
+type LegacyUUIDBytes = value.LegacyUUIDBytes
+
 func JSONDocumentValue(v string) Value { return value.JSONDocumentValue(v) }
 
+// JSONValueFromObject makes JSON value from Go value v marshaled with json.Marshal
+//
+// Strings, bytes and json.RawMessage are treated as already encoded JSON and only validated,
+// so invalid UTF-8 or invalid JSON fails on the client side.
+func JSONValueFromObject(v interface{}) (Value, error) {
+	jsonValue, err := value.JSONValueFromObject(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return jsonValue, nil
+}
+
+// JSONDocumentValueFromObject makes JSONDocument value from Go value v marshaled with json.Marshal
+//
+// Strings, bytes and json.RawMessage are treated as already encoded JSON and only validated,
+// so invalid UTF-8 or invalid JSON fails on the client side.
+func JSONDocumentValueFromObject(v interface{}) (Value, error) {
+	jsonValue, err := value.JSONDocumentValueFromObject(v)
+	if err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	return jsonValue, nil
+}
+
+// ErrInvalidJSON returns from JSONValueFromObject and JSONDocumentValueFromObject for invalid JSON
+var ErrInvalidJSON = value.ErrInvalidJSON
+
 // JSONDocumentValueFromBytes makes JSONDocument value from bytes
 //
 // Warning: all *From* helpers will be removed at next major release
@@ -255,6 +407,13 @@ func VariantValueTuple(v Value, i uint32, variantT Type) Value {
 	return value.VariantValueTuple(v, i, variantT)
 }
 
+// TaggedValue creates value of Tagged<T,tag> type
+//
+// Tags are transparent for YDB wire protocol, so tagged value is sent as value v.
+func TaggedValue(tag string, v Value) Value {
+	return value.TaggedValue(tag, v)
+}
+
 func NullableBoolValue(v *bool) Value {
 	return value.NullableBoolValue(v)
 }
@@ -428,3 +587,110 @@ func NullableDyNumberValue(v *string) Value {
 func Nullable(t Type, v interface{}) Value {
 	return value.Nullable(t, v)
 }
+
+// EqualValues checks values a and b for equality of types and contents
+//
+// Items order of Dict and Set values are not significant.
+// Struct fields are compared by names. Optional values are compared with all levels of nesting,
+// so Optional<Optional<T>> with Null inner value is not equal to Null of Optional<T>.
+func EqualValues(a, b Value) bool {
+	return value.Equal(a, b)
+}
+
+// Hash returns hash of value which is consistent with EqualValues (equal values have equal hashes)
+//
+// Hash is stable within process. Hash may be changed between versions of ydb-go-sdk,
+// so it must not be persisted or sent to other processes.
+func Hash(v Value) uint64 {
+	return value.Hash(v)
+}
+
+type valueFromGoOptions struct {
+	t       Type
+	tagName string
+}
+
+// ValueFromGoOption is an option for ValueFromGo
+type ValueFromGoOption func(o *valueFromGoOptions)
+
+// WithTargetType defines explicit YDB type of value built by ValueFromGo
+//
+// Explicit type disambiguates mapping of Go values, such as string to Bytes instead of Text
+// or time.Time to Date instead of Timestamp.
+func WithTargetType(t Type) ValueFromGoOption {
+	return func(o *valueFromGoOptions) {
+		o.t = t
+	}
+}
+
+// WithTagName defines name of struct tag with YDB struct field names (default "ydb")
+func WithTagName(name string) ValueFromGoOption {
+	return func(o *valueFromGoOptions) {
+		o.tagName = name
+	}
+}
+
+// FromGoError describes failure of ValueFromGo with path of failed item, such as "$.users[2].name"
+type FromGoError = value.FromGoError
+
+// ErrUnsupportedGoType returns from ValueFromGo if Go type cannot be mapped to YDB type
+var ErrUnsupportedGoType = value.ErrUnsupportedGoType
+
+// ValueFromGo builds Value from Go value using reflection
+//
+// Without WithTargetType option YDB type is inferred from Go type: structs are mapped to Struct
+// (field names from `ydb` tags), pointers to Optional, slices to List, maps to Dict,
+// string to Text, []byte to Bytes, time.Time to Timestamp, time.Duration to Interval
+// and [16]byte (such as uuid.UUID) to UUID.
+// Value items are used as is, driver.Valuer items are mapped by result of Value() call.
+//
+// Example:
+//
+//	type row struct {
+//		ID    uint64  `ydb:"id"`
+//		Title *string `ydb:"title"`
+//	}
+//	v, err := types.ValueFromGo([]row{{ID: 1}, {ID: 2}}) // List<Struct<id:Uint64,title:Optional<Utf8>>>
+func ValueFromGo(v interface{}, opts ...ValueFromGoOption) (Value, error) {
+	options := valueFromGoOptions{
+		tagName: value.DefaultFromGoTagName,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&options)
+		}
+	}
+
+	return value.FromGo(v, options.t, options.tagName)
+}
+
+// ValueToJSON returns JSON representation of v, for example for debugging and HTTP APIs.
+// Values also implement json.Marshaler with the same representation.
+//
+// Optional NULL and Void are null, Date is "2006-01-02", Datetime and Timestamp are RFC 3339 strings in UTC,
+// Interval is a string of time.Duration, Bytes and Yson are base64 strings, Decimal, DyNumber and UUID are
+// strings, Json is embedded as is, Struct is an object, Dict with Text keys is an object and other Dict is
+// an array of [key, value] pairs. NaN and infinities of Float and Double are strings "NaN", "+Inf" and "-Inf".
+//
+// The representation is lossy:
+//   - Just(NULL) of nested Optional is the same null as NULL
+//   - invalid UTF-8 of Text is replaced by U+FFFD
+//   - Int64 and Uint64 above 2^53 lose precision in JSON decoders which decode numbers into float64
+//   - Tagged is represented by inner value without tag
+//   - Variant is an object with single field named as variant name (or index for tuple variant)
+//   - Json is re-formatted without insignificant whitespaces
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ValueToJSON(v Value) ([]byte, error) {
+	return value.ToJSON(v)
+}
+
+// ValueFromJSON makes value of targetType from JSON representation of ValueToJSON.
+// Integer numbers are also accepted as strings, Dict with Text keys is also accepted as array of pairs.
+// Variant, Tz-types and Pg types are not supported, Tagged is made with tag of targetType.
+// Errors wrap ErrInvalidJSON
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ValueFromJSON(data []byte, targetType Type) (Value, error) {
+	return params.ValueFromJSON(data, targetType)
+}
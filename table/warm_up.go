@@ -0,0 +1,23 @@
+package table
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// WaitUntilWarm waits until sessions pool of client c contains at least n sessions.
+// It is useful for readiness gating together with ydb.WithSessionPoolWarmUp.
+// WaitUntilWarm returns error immediately if n is greater than size limit of sessions pool
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WaitUntilWarm(ctx context.Context, c Client, n int) error {
+	if cc, has := c.(interface {
+		WaitUntilWarm(ctx context.Context, n int) error
+	}); has {
+		return cc.WaitUntilWarm(ctx, n)
+	}
+
+	return xerrors.WithStackTrace(fmt.Errorf("client %T not supported waiting of warmed up sessions", c))
+}
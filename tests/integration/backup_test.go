@@ -0,0 +1,104 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/backup"
+	"github.com/ydb-platform/ydb-go-sdk/v3/operation"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestBackupExportImportS3(t *testing.T) {
+	endpoint := os.Getenv("YDB_BACKUP_S3_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("require YDB_BACKUP_S3_ENDPOINT env")
+	}
+
+	scope := newScope(t)
+	db := scope.Driver()
+	tablePath := scope.TablePath()
+	restoredPath := path.Join(scope.Folder(), "restored")
+
+	err := db.Table().Do(scope.Ctx, func(ctx context.Context, s table.Session) error {
+		return s.BulkUpsert(ctx, tablePath, types.ListValue(
+			types.StructValue(
+				types.StructFieldValue("id", types.Int64Value(1)),
+				types.StructFieldValue("val", types.TextValue("one")),
+			),
+			types.StructValue(
+				types.StructFieldValue("id", types.Int64Value(2)),
+				types.StructFieldValue("val", types.TextValue("two")),
+			),
+		))
+	})
+	scope.Require.NoError(err)
+
+	s3 := backup.S3{
+		Endpoint: endpoint,
+		Scheme:   backup.SchemeHTTP,
+		Bucket:   os.Getenv("YDB_BACKUP_S3_BUCKET"),
+		Credentials: func(ctx context.Context) (accessKey, secretKey string, err error) {
+			return os.Getenv("YDB_BACKUP_S3_ACCESS_KEY"), os.Getenv("YDB_BACKUP_S3_SECRET_KEY"), nil
+		},
+	}
+	prefix := fmt.Sprintf("%s/%d", t.Name(), time.Now().UnixNano())
+
+	exportID, err := db.Export().ExportToS3(scope.Ctx, backup.ExportToS3Settings{
+		S3: s3,
+		Items: []backup.ExportItem{
+			{SourcePath: tablePath, DestinationPrefix: prefix},
+		},
+		Compression: backup.CompressionZstd(0),
+	})
+	scope.Require.NoError(err)
+	scope.Require.NoError(db.Export().Wait(scope.Ctx, exportID, time.Second,
+		func(metadata *operation.ExportMetadata) {
+			scope.Logf("export progress: %v", metadata.Progress)
+		},
+	))
+	scope.Require.NoError(db.Operation().Forget(scope.Ctx, exportID))
+
+	importID, err := db.Import().ImportFromS3(scope.Ctx, backup.ImportFromS3Settings{
+		S3: s3,
+		Items: []backup.ImportItem{
+			{SourcePrefix: prefix, DestinationPath: restoredPath},
+		},
+	})
+	scope.Require.NoError(err)
+	scope.Require.NoError(db.Import().Wait(scope.Ctx, importID, time.Second,
+		func(metadata *operation.ImportMetadata) {
+			scope.Logf("import progress: %v", metadata.Progress)
+		},
+	))
+	scope.Require.NoError(db.Operation().Forget(scope.Ctx, importID))
+
+	var count uint64
+	err = db.Table().Do(scope.Ctx, func(ctx context.Context, s table.Session) error {
+		_, res, err := s.Execute(ctx, table.DefaultTxControl(),
+			fmt.Sprintf("SELECT COUNT(*) FROM `%s`", restoredPath), nil,
+		)
+		if err != nil {
+			return err
+		}
+		defer res.Close()
+		if err = res.NextResultSetErr(ctx); err != nil {
+			return err
+		}
+		if !res.NextRow() {
+			return fmt.Errorf("no rows")
+		}
+
+		return res.Scan(&count)
+	})
+	scope.Require.NoError(err)
+	scope.Require.EqualValues(2, count)
+}
@@ -0,0 +1,120 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/result/named"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestTableTimeToLive(t *testing.T) {
+	scope := newScope(t)
+	db := scope.Driver()
+	tablePath := scope.TablePath(
+		withTableName("ttl"),
+		withCreateTableOptions(
+			options.WithColumn("id", types.TypeUint64),
+			options.WithColumn("expire_at", types.Optional(types.TypeUint64)),
+			options.WithPrimaryKeyColumn("id"),
+			options.WithTimeToLiveSettings(
+				options.NewTTLSettings().ColumnSeconds("expire_at").ExpireAfter(0),
+			),
+		),
+	)
+
+	const rowsCount = 10
+	rows := make([]types.Value, 0, rowsCount)
+	for i := 0; i < rowsCount; i++ {
+		rows = append(rows, types.StructValue(
+			types.StructFieldValue("id", types.Uint64Value(uint64(i))),
+			types.StructFieldValue("expire_at", types.OptionalValue(
+				types.Uint64Value(uint64(time.Now().Add(-time.Hour).Unix())),
+			)),
+		))
+	}
+	err := db.Table().Do(scope.Ctx, func(ctx context.Context, s table.Session) error {
+		return s.BulkUpsert(ctx, tablePath, types.ListValue(rows...))
+	})
+	scope.Require.NoError(err)
+
+	err = db.Table().Do(scope.Ctx, func(ctx context.Context, s table.Session) error {
+		desc, err := s.DescribeTable(ctx, tablePath)
+		if err != nil {
+			return err
+		}
+		scope.Require.NotNil(desc.TimeToLiveSettings)
+		scope.Require.Equal("expire_at", desc.TimeToLiveSettings.ColumnName)
+		scope.Require.Equal(options.TimeToLiveModeValueSinceUnixEpoch, desc.TimeToLiveSettings.Mode)
+		scope.Require.Equal(options.TimeToLiveUnitSeconds, *desc.TimeToLiveSettings.ColumnUnit)
+		scope.Require.EqualValues(0, desc.TimeToLiveSettings.ExpireAfterSeconds)
+
+		return nil
+	})
+	scope.Require.NoError(err)
+
+	// server has no call for immediate removal of expired rows, so short run interval is used instead
+	err = db.Table().Do(scope.Ctx, func(ctx context.Context, s table.Session) error {
+		return s.AlterTable(ctx, tablePath, options.WithSetTimeToLiveRunInterval(time.Second))
+	})
+	if ydb.IsOperationError(err, Ydb.StatusIds_BAD_REQUEST) {
+		t.Skipf("server does not allow short run interval of TTL: %v", err)
+	}
+	scope.Require.NoError(err)
+
+	err = db.Table().Do(scope.Ctx, func(ctx context.Context, s table.Session) error {
+		desc, err := s.DescribeTable(ctx, tablePath)
+		if err != nil {
+			return err
+		}
+		scope.Require.EqualValues(1, desc.TimeToLiveSettings.RunIntervalSeconds)
+
+		return nil
+	})
+	scope.Require.NoError(err)
+
+	ctx, cancel := context.WithTimeout(scope.Ctx, 2*time.Minute)
+	defer cancel()
+	for {
+		var count uint64
+		err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			_, res, err := s.Execute(ctx, table.DefaultTxControl(),
+				fmt.Sprintf("SELECT COUNT(*) AS cnt FROM `%s`", tablePath), nil,
+			)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = res.Close()
+			}()
+			if err = res.NextResultSetErr(ctx); err != nil {
+				return err
+			}
+			if !res.NextRow() {
+				return fmt.Errorf("no rows")
+			}
+
+			return res.ScanNamed(named.Required("cnt", &count))
+		})
+		scope.Require.NoError(err)
+		scope.Logf("rows count: %d", count)
+		if count < rowsCount {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expired rows not removed: %v", ctx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
@@ -136,6 +137,56 @@ func TestTopicDescribe(t *testing.T) {
 	require.Equal(t, expected, res)
 }
 
+func TestTopicDescribeConsumer(t *testing.T) {
+	ctx := xtest.Context(t)
+	db := connect(t)
+	topicPath := db.Name() + "/" + t.Name()
+
+	consumer := topictypes.Consumer{
+		Name:            "c1",
+		Important:       true,
+		SupportedCodecs: []topictypes.Codec{topictypes.CodecRaw, topictypes.CodecGzip},
+		ReadFrom:        time.Date(2022, 9, 11, 10, 1, 2, 0, time.UTC),
+		Attributes:      map[string]string{"_service_type": "data-streams"},
+	}
+
+	_ = db.Topic().Drop(ctx, topicPath)
+	err := db.Topic().Create(ctx, topicPath,
+		topicoptions.CreateWithConsumer(consumer),
+		topicoptions.CreateWithConsumer(topictypes.Consumer{Name: "c2"}),
+	)
+	require.NoError(t, err)
+
+	res, err := topic.DescribeConsumer(ctx, db.Topic(), topicPath, consumer.Name)
+	require.NoError(t, err)
+	require.Equal(t, consumer.Name, res.Consumer.Name)
+	require.Equal(t, consumer.Important, res.Consumer.Important)
+	require.Equal(t, consumer.SupportedCodecs, res.Consumer.SupportedCodecs)
+	require.Equal(t, consumer.ReadFrom, res.Consumer.ReadFrom)
+	require.Equal(t, "data-streams", res.Consumer.Attributes["_service_type"])
+
+	_, err = topic.DescribeConsumer(ctx, db.Topic(), topicPath, "c2")
+	require.NoError(t, err)
+
+	readFrom := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	err = db.Topic().Alter(ctx, topicPath,
+		topicoptions.AlterConsumerWithImportant(consumer.Name, false),
+		topicoptions.AlterConsumerWithReadFrom(consumer.Name, readFrom),
+	)
+	require.NoError(t, err)
+
+	res, err = topic.DescribeConsumer(ctx, db.Topic(), topicPath, consumer.Name)
+	require.NoError(t, err)
+	require.False(t, res.Consumer.Important)
+	require.Equal(t, readFrom, res.Consumer.ReadFrom)
+
+	// read from time in the future is rejected by server
+	err = db.Topic().Alter(ctx, topicPath,
+		topicoptions.AlterConsumerWithReadFrom(consumer.Name, time.Now().Add(time.Hour)),
+	)
+	require.Error(t, err)
+}
+
 func TestSchemeList(t *testing.T) {
 	ctx := xtest.Context(t)
 	db := connect(t)
@@ -0,0 +1,97 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/yql"
+)
+
+func TestYQLLiterals(t *testing.T) {
+	scope := newScope(t)
+	db := scope.Driver()
+
+	ts := time.Date(2024, time.February, 29, 23, 59, 59, 123456000, time.UTC)
+	interval, err := types.Interval(1, -1, 30, 15, 42)
+	scope.Require.NoError(err)
+
+	for _, tt := range []struct {
+		name    string
+		literal func() (string, error)
+		param   types.Value
+	}{
+		{
+			name:    "Date",
+			literal: func() (string, error) { return yql.Date(ts) },
+			param:   types.DateValueFromTime(ts),
+		},
+		{
+			name:    "Datetime",
+			literal: func() (string, error) { return yql.Datetime(ts) },
+			param:   types.DatetimeValueFromTime(ts),
+		},
+		{
+			name:    "Timestamp",
+			literal: func() (string, error) { return yql.Timestamp(ts) },
+			param:   types.TimestampValueFromTime(ts),
+		},
+		{
+			name: "Interval",
+			literal: func() (string, error) {
+				return yql.Interval(23*time.Hour + 30*time.Minute + 15*time.Second + 42*time.Microsecond)
+			},
+			param: interval,
+		},
+		{
+			name:    "NegativeInterval",
+			literal: func() (string, error) { return yql.Interval(-36*time.Hour - time.Microsecond) },
+			param:   types.IntervalValueFromDuration(-36*time.Hour - time.Microsecond),
+		},
+		{
+			name:    "ZeroInterval",
+			literal: func() (string, error) { return yql.Interval(0) },
+			param:   types.IntervalValueFromDuration(0),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			literal, err := tt.literal()
+			scope.Require.NoError(err)
+
+			var equal bool
+			err = db.Table().DoTx(scope.Ctx, func(ctx context.Context, tx table.TransactionActor) error {
+				res, err := tx.Execute(ctx, fmt.Sprintf(`
+					DECLARE $p AS %s;
+					SELECT %s == $p;`, tt.param.Type().Yql(), literal,
+				), table.NewQueryParameters(
+					table.ValueParam("$p", tt.param),
+				))
+				if err != nil {
+					return err
+				}
+				defer func() {
+					_ = res.Close()
+				}()
+				if err = res.NextResultSetErr(ctx); err != nil {
+					return err
+				}
+				if !res.NextRow() {
+					return fmt.Errorf("unexpected no rows in result set (err = %w)", res.Err())
+				}
+				if err = res.Scan(&equal); err != nil {
+					return err
+				}
+
+				return res.Err()
+			}, table.WithIdempotent())
+			scope.Require.NoError(err)
+			scope.Require.True(equal, literal)
+		})
+	}
+}
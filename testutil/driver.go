@@ -58,6 +58,7 @@ const (
 	TableDescribeTableOptions
 	TableStreamReadTable
 	TableStreamExecuteScanQuery
+	TableBulkUpsert
 )
 
 var grpcMethodToCode = map[Method]MethodCode{
@@ -79,6 +80,7 @@ var grpcMethodToCode = map[Method]MethodCode{
 	"/Ydb.Table.V1.TableService/DescribeTableOptions":   TableDescribeTableOptions,
 	"/Ydb.Table.V1.TableService/StreamReadTable":        TableStreamReadTable,
 	"/Ydb.Table.V1.TableService/StreamExecuteScanQuery": TableStreamExecuteScanQuery,
+	"/Ydb.Table.V1.TableService/BulkUpsert":             TableBulkUpsert,
 }
 
 var codeToString = map[MethodCode]string{
@@ -100,6 +102,7 @@ var codeToString = map[MethodCode]string{
 	TableDescribeTableOptions:   lastSegment("/Ydb.Table.V1.TableService/DescribeTableOptions"),
 	TableStreamReadTable:        lastSegment("/Ydb.Table.V1.TableService/StreamReadTable"),
 	TableStreamExecuteScanQuery: lastSegment("/Ydb.Table.V1.TableService/StreamExecuteScanQuery"),
+	TableBulkUpsert:             lastSegment("/Ydb.Table.V1.TableService/BulkUpsert"),
 }
 
 func setField(name string, dst, value interface{}) {
@@ -0,0 +1,53 @@
+package testutil
+
+import (
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/allocator"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/types"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+// Column describes column of result set built with NewResultSet
+type Column struct {
+	Name string
+	Type types.Type
+}
+
+// NewResultSet builds result set with given columns and rows for responses of stubbed table service.
+// Every row must have value for every column, values of row must have types of columns (NULL is
+// types.NullValue of optional type of column)
+func NewResultSet(columns []Column, rows ...[]value.Value) *Ydb.ResultSet {
+	// protos of result set are not returned to pools because result set is owned by caller
+	a := allocator.New()
+
+	set := &Ydb.ResultSet{
+		Columns: make([]*Ydb.Column, len(columns)),
+		Rows:    make([]*Ydb.Value, len(rows)),
+	}
+	for i, c := range columns {
+		set.Columns[i] = &Ydb.Column{
+			Name: c.Name,
+			Type: c.Type.ToYDB(a),
+		}
+	}
+	for i, row := range rows {
+		if len(row) != len(columns) {
+			panic(fmt.Sprintf("row %d has %d values, expected %d values of columns", i, len(row), len(columns)))
+		}
+		items := make([]*Ydb.Value, len(row))
+		for j, v := range row {
+			if t := v.Type().Yql(); t != columns[j].Type.Yql() {
+				panic(fmt.Sprintf("value of column %q in row %d has type %s, expected %s",
+					columns[j].Name, i, t, columns[j].Type.Yql(),
+				))
+			}
+			items[j] = value.ToYDB(v, a).GetValue()
+		}
+		set.Rows[i] = &Ydb.Value{Items: items}
+	}
+
+	return set
+}
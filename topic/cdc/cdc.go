@@ -0,0 +1,183 @@
+// Package cdc contains typed events of table changefeeds
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package cdc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+)
+
+// ErrUnexpectedRecord returns if changefeed record has unknown format
+// client side must check error with errors.Is
+var ErrUnexpectedRecord = xerrors.Wrap(errors.New("ydb: unexpected changefeed record"))
+
+type (
+	// Event is changefeed event: Change or Resolved
+	Event interface {
+		isEvent()
+	}
+
+	// Change is change of table row
+	Change struct {
+		Operation Operation
+
+		// Key contains values of primary key columns in order of primary key.
+		// Key is nil for records of DebeziumJSON format, primary key columns are part of images in this format
+		Key []interface{}
+
+		// Update contains new values of changed columns (changefeed mode UPDATES), nil for other modes
+		Update map[string]interface{}
+
+		// OldImage contains row before change (changefeed modes OLD_IMAGE and NEW_AND_OLD_IMAGES), nil for other modes
+		OldImage map[string]interface{}
+
+		// NewImage contains row after change (changefeed modes NEW_IMAGE and NEW_AND_OLD_IMAGES), nil for other modes
+		NewImage map[string]interface{}
+
+		// Timestamp is zero if virtual timestamps are disabled for changefeed
+		Timestamp VirtualTimestamp
+	}
+
+	// Resolved is heartbeat record of changefeed: all changes before Timestamp were already sent
+	Resolved struct {
+		Timestamp VirtualTimestamp
+	}
+
+	// VirtualTimestamp is global order of changes of the table
+	VirtualTimestamp struct {
+		Step uint64
+		TxID uint64
+	}
+
+	// Operation is kind of change
+	Operation int
+)
+
+const (
+	OperationUpdate = Operation(iota + 1) // insert, update or replace of row
+	OperationErase                        // delete of row
+)
+
+func (op Operation) String() string {
+	switch op {
+	case OperationUpdate:
+		return "update"
+	case OperationErase:
+		return "erase"
+	default:
+		return fmt.Sprintf("Operation(%d)", int(op))
+	}
+}
+
+func (Change) isEvent() {}
+
+func (Resolved) isEvent() {}
+
+type (
+	record struct {
+		Key      []interface{}          `json:"key"`
+		Update   map[string]interface{} `json:"update"`
+		Erase    map[string]interface{} `json:"erase"`
+		OldImage map[string]interface{} `json:"oldImage"`
+		NewImage map[string]interface{} `json:"newImage"`
+		Ts       []uint64               `json:"ts"`
+		Resolved []uint64               `json:"resolved"`
+
+		Payload *debeziumPayload `json:"payload"`
+	}
+	debeziumPayload struct {
+		Op     string                 `json:"op"`
+		Before map[string]interface{} `json:"before"`
+		After  map[string]interface{} `json:"after"`
+		Source struct {
+			Step uint64 `json:"step"`
+			TxID uint64 `json:"txId"`
+		} `json:"source"`
+	}
+)
+
+// Parse decodes changefeed record of JSON or DebeziumJSON format
+//
+// Values of columns are decoded with encoding/json: numbers are decoded as json.Number
+// for keep precision of Uint64 and Int64 values. Parse does not keep data after return
+func Parse(data []byte) (Event, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var r record
+	if err := decoder.Decode(&r); err != nil {
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: %w", ErrUnexpectedRecord, err))
+	}
+
+	switch {
+	case r.Payload != nil:
+		return r.Payload.event()
+	case r.Resolved != nil:
+		ts, err := virtualTimestamp(r.Resolved)
+		if err != nil {
+			return nil, err
+		}
+
+		return Resolved{Timestamp: ts}, nil
+	case r.Update != nil || r.Erase != nil:
+		ts, err := virtualTimestamp(r.Ts)
+		if err != nil {
+			return nil, err
+		}
+		change := Change{
+			Operation: OperationUpdate,
+			Key:       r.Key,
+			OldImage:  r.OldImage,
+			NewImage:  r.NewImage,
+			Timestamp: ts,
+		}
+		if r.Erase != nil {
+			change.Operation = OperationErase
+		} else if len(r.Update) > 0 {
+			change.Update = r.Update
+		}
+
+		return change, nil
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: no update, erase or resolved fields", ErrUnexpectedRecord))
+	}
+}
+
+func (p *debeziumPayload) event() (Event, error) {
+	change := Change{
+		OldImage: p.Before,
+		NewImage: p.After,
+		Timestamp: VirtualTimestamp{
+			Step: p.Source.Step,
+			TxID: p.Source.TxID,
+		},
+	}
+	switch p.Op {
+	case "c", "u", "r":
+		change.Operation = OperationUpdate
+	case "d":
+		change.Operation = OperationErase
+	default:
+		return nil, xerrors.WithStackTrace(fmt.Errorf("%w: unknown debezium operation %q", ErrUnexpectedRecord, p.Op))
+	}
+
+	return change, nil
+}
+
+func virtualTimestamp(ts []uint64) (VirtualTimestamp, error) {
+	switch len(ts) {
+	case 0:
+		return VirtualTimestamp{}, nil
+	case 2: //nolint:gomnd
+		return VirtualTimestamp{Step: ts[0], TxID: ts[1]}, nil
+	default:
+		return VirtualTimestamp{}, xerrors.WithStackTrace(
+			fmt.Errorf("%w: bad virtual timestamp %v", ErrUnexpectedRecord, ts),
+		)
+	}
+}
@@ -0,0 +1,103 @@
+package cdc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		data  string
+		event Event
+		err   error
+	}{
+		{
+			name: "Updates",
+			data: `{"update":{"value":"a"},"key":[1,"b"],"ts":[1000,2]}`,
+			event: Change{
+				Operation: OperationUpdate,
+				Key:       []interface{}{json.Number("1"), "b"},
+				Update:    map[string]interface{}{"value": "a"},
+				Timestamp: VirtualTimestamp{Step: 1000, TxID: 2},
+			},
+		},
+		{
+			name: "UpdateWithoutVirtualTimestamp",
+			data: `{"update":{},"key":[18446744073709551615],"newImage":{"value":null}}`,
+			event: Change{
+				Operation: OperationUpdate,
+				Key:       []interface{}{json.Number("18446744073709551615")},
+				NewImage:  map[string]interface{}{"value": nil},
+			},
+		},
+		{
+			name: "EraseWithOldImage",
+			data: `{"erase":{},"key":[1],"oldImage":{"value":"a"},"ts":[1000,2]}`,
+			event: Change{
+				Operation: OperationErase,
+				Key:       []interface{}{json.Number("1")},
+				OldImage:  map[string]interface{}{"value": "a"},
+				Timestamp: VirtualTimestamp{Step: 1000, TxID: 2},
+			},
+		},
+		{
+			name:  "Resolved",
+			data:  `{"resolved":[1000,2]}`,
+			event: Resolved{Timestamp: VirtualTimestamp{Step: 1000, TxID: 2}},
+		},
+		{
+			name: "DebeziumUpdate",
+			data: `{"payload":{"op":"u","before":{"id":1,"value":"a"},"after":{"id":1,"value":"b"},` +
+				`"source":{"version":"1.0.0","connector":"ydb","ts_ms":1,"step":1000,"txId":2,"snapshot":false}}}`,
+			event: Change{
+				Operation: OperationUpdate,
+				OldImage:  map[string]interface{}{"id": json.Number("1"), "value": "a"},
+				NewImage:  map[string]interface{}{"id": json.Number("1"), "value": "b"},
+				Timestamp: VirtualTimestamp{Step: 1000, TxID: 2},
+			},
+		},
+		{
+			name: "DebeziumErase",
+			data: `{"payload":{"op":"d","before":{"id":1},"source":{"step":1000,"txId":2}}}`,
+			event: Change{
+				Operation: OperationErase,
+				OldImage:  map[string]interface{}{"id": json.Number("1")},
+				Timestamp: VirtualTimestamp{Step: 1000, TxID: 2},
+			},
+		},
+		{
+			name: "DebeziumUnknownOperation",
+			data: `{"payload":{"op":"t"}}`,
+			err:  ErrUnexpectedRecord,
+		},
+		{
+			name: "BadJSON",
+			data: `{"update":`,
+			err:  ErrUnexpectedRecord,
+		},
+		{
+			name: "UnknownRecord",
+			data: `{"key":[1]}`,
+			err:  ErrUnexpectedRecord,
+		},
+		{
+			name: "BadVirtualTimestamp",
+			data: `{"resolved":[1000]}`,
+			err:  ErrUnexpectedRecord,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := Parse([]byte(tt.data))
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.event, event)
+		})
+	}
+}
@@ -13,9 +13,13 @@ import (
 // Attention: the interface may be extended in the future.
 type Client interface {
 	// Alter change topic options
+	// If both min active partitions and partition count limit are changed, Alter checks on client side that
+	// min active partitions is not greater than limit (ErrMinActivePartitionsExceedsLimit otherwise)
 	Alter(ctx context.Context, path string, opts ...topicoptions.AlterOption) error
 
 	// Create topic
+	// Create checks on client side that min active partitions is not greater than non-zero partition count limit
+	// (ErrMinActivePartitionsExceedsLimit otherwise)
 	Create(ctx context.Context, path string, opts ...topicoptions.CreateOption) error
 
 	// Describe topic
@@ -0,0 +1,59 @@
+package topic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
+)
+
+// DescribeConsumer returns description of the consumer of topic: important flag, read from time,
+// supported codecs, attributes and partitions of topic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DescribeConsumer(
+	ctx context.Context,
+	c Client,
+	path string,
+	consumer string,
+	opts ...topicoptions.DescribeConsumerOption,
+) (topictypes.TopicConsumerDescription, error) {
+	if cc, has := c.(interface {
+		DescribeConsumer(
+			ctx context.Context,
+			path string,
+			consumer string,
+			opts ...topicoptions.DescribeConsumerOption,
+		) (topictypes.TopicConsumerDescription, error)
+	}); has {
+		return cc.DescribeConsumer(ctx, path, consumer, opts...)
+	}
+
+	return topictypes.TopicConsumerDescription{}, xerrors.WithStackTrace(
+		fmt.Errorf("client %T not supported description of consumer", c),
+	)
+}
+
+// DescribePartition returns description of partition of topic with given id: node which hosts
+// the partition and range of offsets of its messages.
+// If topic has no partition with given id, returned error is ErrPartitionNotFound
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func DescribePartition(
+	ctx context.Context,
+	c Client,
+	path string,
+	partitionID int64,
+) (topictypes.PartitionDescription, error) {
+	if cc, has := c.(interface {
+		DescribePartition(ctx context.Context, path string, partitionID int64) (topictypes.PartitionDescription, error)
+	}); has {
+		return cc.DescribePartition(ctx, path, partitionID)
+	}
+
+	return topictypes.PartitionDescription{}, xerrors.WithStackTrace(
+		fmt.Errorf("client %T not supported description of partition", c),
+	)
+}
@@ -0,0 +1,17 @@
+package topic
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicclientinternal"
+)
+
+// ErrPartitionNotFound is returned if topic has no requested partition: by DescribePartition
+// and by reader with partitions selected by topicoptions.ReadSelectors.WithPartitions
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrPartitionNotFound = topicclientinternal.ErrPartitionNotFound
+
+// ErrMinActivePartitionsExceedsLimit is returned by Client.Create and Client.Alter if min active partitions
+// of topic is greater than partition count limit
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+var ErrMinActivePartitionsExceedsLimit = topicclientinternal.ErrMinActivePartitionsExceedsLimit
@@ -127,17 +127,22 @@ func (attributes withAttributes) ApplyAlterOption(req *rawtopic.AlterTopicReques
 type withAddConsumers []topictypes.Consumer
 
 func (consumers withAddConsumers) ApplyCreateOption(request *rawtopic.CreateTopicRequest) {
-	request.Consumers = make([]rawtopic.Consumer, len(consumers))
-	for i := range consumers {
-		consumers[i].ToRaw(&request.Consumers[i])
-	}
+	request.Consumers = consumers.appendRaw(request.Consumers)
 }
 
 func (consumers withAddConsumers) ApplyAlterOption(req *rawtopic.AlterTopicRequest) {
-	req.AddConsumers = make([]rawtopic.Consumer, len(consumers))
+	req.AddConsumers = consumers.appendRaw(req.AddConsumers)
+}
+
+// appendRaw appends consumers to consumers of request, so consumers of previous options are kept
+func (consumers withAddConsumers) appendRaw(raw []rawtopic.Consumer) []rawtopic.Consumer {
 	for i := range consumers {
-		consumers[i].ToRaw(&req.AddConsumers[i])
+		var consumer rawtopic.Consumer
+		consumers[i].ToRaw(&consumer)
+		raw = append(raw, consumer)
 	}
+
+	return raw
 }
 
 type withDropConsumers []string
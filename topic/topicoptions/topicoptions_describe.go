@@ -4,3 +4,8 @@ import "github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
 
 // DescribeOption type for options of describe method. Not used now.
 type DescribeOption func(req *rawtopic.DescribeTopicRequest)
+
+// DescribeConsumerOption type for options of describe consumer method. Not used now.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type DescribeConsumerOption func(req *rawtopic.DescribeConsumerRequest)
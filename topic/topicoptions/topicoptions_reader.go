@@ -3,6 +3,8 @@ package topicoptions
 import (
 	"time"
 
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/backoff"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/clone"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
@@ -21,6 +23,26 @@ func ReadTopic(path string) ReadSelectors {
 	return ReadSelectors{{Path: path}}
 }
 
+// WithPartitions returns copy of selectors restricted to partitions with given ids: read session
+// receives messages of these partitions only, for example
+//
+//	topicoptions.ReadTopic("topic").WithPartitions(1, 2)
+//
+// Reader checks that selected partitions exist on every connection (reader fails with
+// topic.ErrPartitionNotFound otherwise) and routes read stream to node which hosts selected
+// partitions if all of them are hosted by one node
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (selectors ReadSelectors) WithPartitions(ids ...int64) ReadSelectors {
+	res := make(ReadSelectors, len(selectors))
+	for i := range selectors {
+		res[i] = *selectors[i].Clone()
+		res[i].Partitions = clone.Int64Slice(ids)
+	}
+
+	return res
+}
+
 // ReaderOption options for topic reader
 type ReaderOption = topicreaderinternal.PublicReaderOption
 
@@ -42,6 +64,56 @@ func WithReaderStartTimeout(timeout time.Duration) ReaderOption {
 	}
 }
 
+// WithReaderConnectionBackoff overrides backoff of reconnections to reader stream after retriable errors
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderConnectionBackoff(b backoff.Backoff) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.RetrySettings.Backoff = b
+	}
+}
+
+// WithReaderReconnectTimeout limits time of reconnections to reader stream since first failed reconnection.
+// After timeout exceeded reader methods returns terminal error topicreader.ErrReconnectTimeout
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderReconnectTimeout(timeout time.Duration) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.ReconnectTimeout = timeout
+	}
+}
+
+// WithReaderStartTimestamp set start time for read messages from all topics of the reader:
+// server skip messages, written before the time, in every partition session.
+// If the time older than retention period of the topic - messages will be read from first available offset.
+// Selectors with own ReadFrom value keep it.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderStartTimestamp(t time.Time) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		for _, selector := range cfg.ReadSelectors {
+			if selector.ReadFrom.IsZero() {
+				selector.ReadFrom = t
+			}
+		}
+	}
+}
+
+// WithReaderMaxLag set max lag of messages for all topics of the reader:
+// server skip messages, written earlier than maxLag ago at start of every partition session.
+// Selectors with own MaxTimeLag value keep it.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderMaxLag(maxLag time.Duration) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		for _, selector := range cfg.ReadSelectors {
+			if selector.MaxTimeLag == 0 {
+				selector.MaxTimeLag = maxLag
+			}
+		}
+	}
+}
+
 // WithReaderCheckRetryErrorFunction can override default error retry policy
 // use CheckErrorRetryDecisionDefault for use default behavior for the error
 // callback func must be fast and deterministic: always result same result for same error - it can be called
@@ -153,12 +225,38 @@ func WithMessagesBufferSize(size int) ReaderOption {
 }
 
 // WithReaderBufferSizeBytes set size of internal buffer for read ahead messages.
+// Buffer is shared by all partition sessions of reader: reader requests data from server only for free
+// space of buffer.
 func WithReaderBufferSizeBytes(size int) ReaderOption {
 	return func(cfg *topicreaderinternal.ReaderConfig) {
 		cfg.BufferSizeProtoBytes = size
 	}
 }
 
+// WithReaderMaxMessagesInFlight stops requesting more data from server while count of received and not
+// read messages is not less than count. Server sends data by size in bytes, so count of buffered
+// messages can exceed count by messages of last response. Zero count (default) means no limit
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderMaxMessagesInFlight(count int) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.MaxMessagesInFlight = count
+	}
+}
+
+// WithReaderBufferWatermarks stops requesting more data from server when size of received and not read
+// messages reaches high bytes and resumes requests when buffer is freed to low bytes. Watermarks smooth
+// out small data requests of fast consumer. High must not exceed buffer size (WithReaderBufferSizeBytes),
+// zero high (default) requests data on every read of messages
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithReaderBufferWatermarks(low, high int) ReaderOption {
+	return func(cfg *topicreaderinternal.ReaderConfig) {
+		cfg.BufferLowWatermarkBytes = low
+		cfg.BufferHighWatermarkBytes = high
+	}
+}
+
 // CreateDecoderFunc interface for fabric of message decoders
 type CreateDecoderFunc = topicreaderinternal.PublicCreateDecoderFunc
 
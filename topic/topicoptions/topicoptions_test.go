@@ -5,6 +5,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawoptional"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/grpcwrapper/rawtopic/rawtopiccommon"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 )
 
 func TestEqualAlterOptions(t *testing.T) {
@@ -98,3 +105,83 @@ func TestEqualCreateOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestReaderStartTimestampAndMaxLag(t *testing.T) {
+	start := time.Unix(100, 0)
+	cfg := topicreaderinternal.ReaderConfig{}
+	cfg.ReadSelectors = []*topicreaderinternal.PublicReadSelector{
+		{Path: "a"},
+		{Path: "b", ReadFrom: time.Unix(200, 0), MaxTimeLag: time.Second},
+	}
+
+	WithReaderStartTimestamp(start)(&cfg)
+	WithReaderMaxLag(time.Minute)(&cfg)
+
+	require.Equal(t, []*topicreaderinternal.PublicReadSelector{
+		{Path: "a", ReadFrom: start, MaxTimeLag: time.Minute},
+		{Path: "b", ReadFrom: time.Unix(200, 0), MaxTimeLag: time.Second},
+	}, cfg.ReadSelectors)
+}
+
+func TestCreateWithConsumer(t *testing.T) {
+	readFrom := time.Date(2022, time.March, 8, 12, 12, 12, 0, time.UTC)
+	req := &rawtopic.CreateTopicRequest{}
+	for _, opt := range []CreateOption{
+		CreateWithConsumer(topictypes.Consumer{
+			Name:            "c1",
+			Important:       true,
+			SupportedCodecs: []topictypes.Codec{topictypes.CodecRaw},
+			ReadFrom:        readFrom,
+			Attributes:      map[string]string{"k": "v"},
+		}),
+		CreateWithConsumer(topictypes.Consumer{Name: "c2"}),
+	} {
+		opt.ApplyCreateOption(req)
+	}
+
+	require.Equal(t, []rawtopic.Consumer{
+		{
+			Name:            "c1",
+			Important:       true,
+			SupportedCodecs: rawtopiccommon.SupportedCodecs{rawtopiccommon.CodecRaw},
+			ReadFrom:        rawoptional.Time{Value: readFrom, HasValue: true},
+			Attributes:      map[string]string{"k": "v"},
+		},
+		{
+			Name:            "c2",
+			SupportedCodecs: rawtopiccommon.SupportedCodecs{},
+		},
+	}, req.Consumers)
+}
+
+func TestAlterWithAddConsumers(t *testing.T) {
+	req := &rawtopic.AlterTopicRequest{}
+	AlterWithAddConsumers(topictypes.Consumer{Name: "c1", Important: true}).ApplyAlterOption(req)
+	AlterWithAddConsumers(topictypes.Consumer{Name: "c2"}).ApplyAlterOption(req)
+	AlterConsumerWithImportant("c3", true).ApplyAlterOption(req)
+	AlterConsumerWithReadFrom("c3", time.Unix(100, 0)).ApplyAlterOption(req)
+
+	require.Len(t, req.AddConsumers, 2)
+	require.Equal(t, "c1", req.AddConsumers[0].Name)
+	require.True(t, req.AddConsumers[0].Important)
+	require.Equal(t, "c2", req.AddConsumers[1].Name)
+	require.Equal(t, []rawtopic.AlterConsumer{
+		{
+			Name:         "c3",
+			SetImportant: rawoptional.Bool{Value: true, HasValue: true},
+			SetReadFrom:  rawoptional.Time{Value: time.Unix(100, 0), HasValue: true},
+		},
+	}, req.AlterConsumers)
+}
+
+func TestReadSelectorsWithPartitions(t *testing.T) {
+	selectors := ReadSelectors{{Path: "a", MaxTimeLag: time.Minute}, {Path: "b", Partitions: []int64{3}}}
+	restricted := selectors.WithPartitions(1, 2)
+	require.Equal(t, ReadSelectors{
+		{Path: "a", Partitions: []int64{1, 2}, MaxTimeLag: time.Minute},
+		{Path: "b", Partitions: []int64{1, 2}},
+	}, restricted)
+	// source selectors are not changed
+	require.Empty(t, selectors[0].Partitions)
+	require.Equal(t, []int64{3}, selectors[1].Partitions)
+}
@@ -47,3 +47,15 @@ func WithOperationCancelAfter(operationCancelAfter time.Duration) TopicOption {
 		config.SetOperationCancelAfter(&c.Common, operationCancelAfter)
 	}
 }
+
+// WithStreamInactivityTimeout overrides stream inactivity timeout of driver (see ydb.WithStreamInactivityTimeout)
+// for streams of topic readers and writers. Reader and writer reconnect after stream inactivity timeout.
+// Timeout must be greater than expected pauses between writes to topic, because readers and idle writers
+// receive no messages during such pauses. Zero timeout disables checking of inactivity of topic streams
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithStreamInactivityTimeout(timeout time.Duration) TopicOption {
+	return func(c *topic.Config) {
+		config.SetStreamInactivityTimeout(&c.Common, timeout)
+	}
+}
@@ -182,11 +182,25 @@ func WithWriterCodecAutoSelect() WriterOption {
 
 // WithWriterSetAutoSeqNo set messages SeqNo by SDK
 // enabled by default
-// if enabled - Message.SeqNo field must be zero
+// if enabled - Message.SeqNo field must be zero, writer continues from last seqno of producer received from server
+// and Write returns error for messages with non-zero SeqNo.
+// if disabled - every Message.SeqNo must be set by caller and greater than SeqNo of previous messages
+// of the producer, messages with already written SeqNo are deduplicated (skipped) by server.
+// Use WithWriterGetLastSeqNo and Writer.WaitInitInfo for continue numeration after restart
 func WithWriterSetAutoSeqNo(val bool) WriterOption {
 	return topicwriterinternal.WithAutoSetSeqNo(val)
 }
 
+// WithWriterGetLastSeqNo requests last written seqno of producer from server on writer init
+// enabled by default, the seqno is available by Writer.WaitInitInfo.
+// Auto set seqno mode requires the option, writer returns error on create with both
+// WithWriterSetAutoSeqNo(true) and WithWriterGetLastSeqNo(false)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithWriterGetLastSeqNo(val bool) WriterOption {
+	return topicwriterinternal.WithGetLastSeqNo(val)
+}
+
 // WithWriterSetAutoCreatedAt set messages CreatedAt by SDK
 // enabled by default
 // if enabled - Message.CreatedAt field must be zero
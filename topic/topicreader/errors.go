@@ -18,3 +18,14 @@ var ErrConcurrencyCall = xerrors.Wrap(errors.New("ydb: concurrency call denied")
 // ErrCommitToExpiredSession it is not fatal error and reader can continue work
 // client side must check error with errors.Is
 var ErrCommitToExpiredSession = topicreaderinternal.PublicErrCommitSessionToExpiredSession
+
+// ErrPartitionSessionClosed returns on commit of messages of closed partition session
+// (for example, messages which were read before reconnect of reader) in all commit modes.
+// ErrPartitionSessionClosed is ErrCommitToExpiredSession too, it is not fatal error and reader can continue work
+// client side must check error with errors.Is
+var ErrPartitionSessionClosed = topicreaderinternal.PublicErrPartitionSessionClosed
+
+// ErrReconnectTimeout returns from reader methods if reader could not reconnect
+// during timeout defined with topicoptions.WithReaderReconnectTimeout. It is terminal error for reader
+// client side must check error with errors.Is
+var ErrReconnectTimeout = topicreaderinternal.PublicErrReconnectTimeout
@@ -71,6 +71,36 @@ func (r *Reader) Commit(ctx context.Context, obj CommitRangeGetter) error {
 	return r.reader.Commit(ctx, obj)
 }
 
+// CommitWithAck commits Message or Batch and waits ack of commit from server independent of commit mode,
+// for example for build pipelines "process -> commit -> ack upstream" with default async commit mode.
+//
+// The method returns ErrCommitToExpiredSession if partition session was stopped or connection was broken
+// before ack of commit. Commits of one partition session with ack have to be in order of offsets:
+// the method waits ack until all previous offsets of the partition session are committed
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) CommitWithAck(ctx context.Context, obj CommitRangeGetter) error {
+	if err := r.inCall(&r.commitInFlyght); err != nil {
+		return err
+	}
+	defer r.outCall(&r.commitInFlyght)
+
+	return r.reader.CommitWithAck(ctx, obj)
+}
+
+// Stats returns state of local buffer of reader (buffered bytes and messages and flow control pause).
+// Stats can be called concurrently with any other method of reader
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (r *Reader) Stats() ReaderStats {
+	return r.reader.Stats()
+}
+
+// ReaderStats is a state of local buffer of reader
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ReaderStats = topicreaderinternal.PublicReaderStats
+
 // CommitRangeGetter interface for get commit offsets
 type CommitRangeGetter = topicreaderinternal.PublicCommitRangeGetter
 
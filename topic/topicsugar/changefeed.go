@@ -0,0 +1,58 @@
+package topicsugar
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/cdc"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+type changefeedReader interface {
+	ReadMessage(ctx context.Context) (*topicreader.Message, error)
+	Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error
+}
+
+// ReadChangefeed read records of table changefeed from reader, decode them and call f for every event.
+// Changefeed records may have JSON or DebeziumJSON format. Message is committed after f returns nil.
+// ReadChangefeed returns first error of read, decode, f call or commit.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ReadChangefeed(
+	ctx context.Context,
+	reader *topicreader.Reader,
+	f func(ctx context.Context, event cdc.Event) error,
+) error {
+	return readChangefeed(ctx, reader, f)
+}
+
+func readChangefeed(
+	ctx context.Context,
+	reader changefeedReader,
+	f func(ctx context.Context, event cdc.Event) error,
+) error {
+	for {
+		msg, err := reader.ReadMessage(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		var event cdc.Event
+		err = ReadMessageDataWithCallback(msg, func(data []byte) (err error) {
+			event, err = cdc.Parse(data)
+
+			return err
+		})
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+
+		if err = f(ctx, event); err != nil {
+			return err
+		}
+
+		if err = reader.Commit(msg.Context(), msg); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package topicsugar_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/cdc"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicsugar"
+)
+
+// ExampleReadChangefeed shows invalidation of cache of table rows by changefeed of the table.
+//
+// Changefeed and consumer must be created before, for example:
+//
+//	ALTER TABLE `users` ADD CHANGEFEED `updates` WITH (FORMAT = 'JSON', MODE = 'KEYS_ONLY');
+//	ALTER TOPIC `users/updates` ADD CONSUMER `cache`;
+func ExampleReadChangefeed() {
+	ctx := context.TODO()
+	db, err := ydb.Open(ctx, "grpc://localhost:2136/local")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() { _ = db.Close(ctx) }()
+
+	var cache sync.Map // primary key of row -> cached row
+
+	reader, err := db.Topic().StartReader("cache", topicoptions.ReadTopic("users/updates"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() { _ = reader.Close(ctx) }()
+
+	err = topicsugar.ReadChangefeed(ctx, reader, func(ctx context.Context, event cdc.Event) error {
+		switch event := event.(type) {
+		case cdc.Change:
+			// row was changed or erased, cached row must be read from table again
+			cache.Delete(fmt.Sprint(event.Key...))
+		case cdc.Resolved:
+			// all changes before event.Timestamp are already received
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+}
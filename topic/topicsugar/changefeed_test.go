@@ -0,0 +1,91 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/cdc"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+type changefeedReaderMock struct {
+	messages  []*topicreader.Message
+	committed []*topicreader.Message
+}
+
+func (r *changefeedReaderMock) ReadMessage(ctx context.Context) (*topicreader.Message, error) {
+	if len(r.messages) == 0 {
+		return nil, io.EOF
+	}
+	msg := r.messages[0]
+	r.messages = r.messages[1:]
+
+	return msg, nil
+}
+
+func (r *changefeedReaderMock) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	r.committed = append(r.committed, obj.(*topicreader.Message))
+
+	return nil
+}
+
+func TestReadChangefeed(t *testing.T) {
+	newMessage := func(data string) *topicreader.Message {
+		return topicreaderinternal.NewPublicMessageBuilder().DataAndUncompressedSize([]byte(data)).Build()
+	}
+
+	t.Run("Events", func(t *testing.T) {
+		reader := &changefeedReaderMock{messages: []*topicreader.Message{
+			newMessage(`{"update":{"value":"a"},"key":["b"]}`),
+			newMessage(`{"resolved":[1000,2]}`),
+		}}
+		messages := reader.messages
+
+		var events []cdc.Event
+		err := readChangefeed(context.Background(), reader, func(ctx context.Context, event cdc.Event) error {
+			events = append(events, event)
+
+			return nil
+		})
+		require.ErrorIs(t, err, io.EOF)
+		require.Equal(t, []cdc.Event{
+			cdc.Change{
+				Operation: cdc.OperationUpdate,
+				Key:       []interface{}{"b"},
+				Update:    map[string]interface{}{"value": "a"},
+			},
+			cdc.Resolved{Timestamp: cdc.VirtualTimestamp{Step: 1000, TxID: 2}},
+		}, events)
+		require.Equal(t, messages, reader.committed)
+	})
+
+	t.Run("CallbackError", func(t *testing.T) {
+		reader := &changefeedReaderMock{messages: []*topicreader.Message{
+			newMessage(`{"resolved":[1000,2]}`),
+		}}
+		testErr := errors.New("test")
+		err := readChangefeed(context.Background(), reader, func(ctx context.Context, event cdc.Event) error {
+			return testErr
+		})
+		require.ErrorIs(t, err, testErr)
+		require.Empty(t, reader.committed)
+	})
+
+	t.Run("BadRecord", func(t *testing.T) {
+		reader := &changefeedReaderMock{messages: []*topicreader.Message{
+			newMessage(`{}`),
+		}}
+		err := readChangefeed(context.Background(), reader, func(ctx context.Context, event cdc.Event) error {
+			t.Fatal("unexpected call")
+
+			return nil
+		})
+		require.ErrorIs(t, err, cdc.ErrUnexpectedRecord)
+		require.Empty(t, reader.committed)
+	})
+}
@@ -0,0 +1,277 @@
+package topicsugar
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+// PartitionErrorPolicy defines behaviour of ProcessPartitionsConcurrently on handler error
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PartitionErrorPolicy int
+
+const (
+	// PartitionErrorPolicyStopPartition stops worker of partition session with failed message only.
+	// Failed message and next messages of the partition session are not committed and skipped,
+	// they will be read again after the partition session is restarted by server.
+	// Other partitions are processed as usual. It is default policy
+	PartitionErrorPolicyStopPartition = PartitionErrorPolicy(iota)
+
+	// PartitionErrorPolicyFailAll stops all workers and ProcessPartitionsConcurrently returns the handler error
+	PartitionErrorPolicyFailAll
+)
+
+// PartitionHandler handles message of partition. Messages of one partition session are handled sequentially
+// in order of offsets, messages of different partitions are handled concurrently.
+// ctx is done after stop of processing, msg.Context() is done after stop of partition session
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PartitionHandler func(ctx context.Context, msg *topicreader.Message) error
+
+// ProcessPartitionsOption is an option of ProcessPartitionsConcurrently
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type ProcessPartitionsOption func(cfg *processPartitionsConfig)
+
+type processPartitionsConfig struct {
+	maxWorkers  int
+	errorPolicy PartitionErrorPolicy
+	onError     func(msg *topicreader.Message, err error)
+}
+
+// WithMaxPartitionWorkers limits number of concurrently handled partition sessions.
+// Reading of messages waits for free worker if all workers are busy. Zero value means one worker per partition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxPartitionWorkers(n int) ProcessPartitionsOption {
+	return func(cfg *processPartitionsConfig) {
+		cfg.maxWorkers = n
+	}
+}
+
+// WithPartitionErrorPolicy sets behaviour on handler error, PartitionErrorPolicyStopPartition by default
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPartitionErrorPolicy(policy PartitionErrorPolicy) ProcessPartitionsOption {
+	return func(cfg *processPartitionsConfig) {
+		cfg.errorPolicy = policy
+	}
+}
+
+// WithPartitionErrorHandler sets callback for handler errors, for example for logging of errors
+// skipped with PartitionErrorPolicyStopPartition
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithPartitionErrorHandler(f func(msg *topicreader.Message, err error)) ProcessPartitionsOption {
+	return func(cfg *processPartitionsConfig) {
+		cfg.onError = f
+	}
+}
+
+// ProcessPartitionsConcurrently reads messages from reader and calls handler in worker per partition session.
+// Message is committed after handler returns nil, so commits of partitions are independent.
+// Worker of partition session drops received messages after the partition session stopped.
+// ProcessPartitionsConcurrently returns after ctx cancel or read error, when all handlers in progress finished.
+// Received messages are dropped without commit on ctx cancel and handled before return on read error.
+// It returns first error of read, commit or handler error with PartitionErrorPolicyFailAll
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func ProcessPartitionsConcurrently(
+	ctx context.Context,
+	reader *topicreader.Reader,
+	handler PartitionHandler,
+	opts ...ProcessPartitionsOption,
+) error {
+	return processPartitionsConcurrently(ctx, reader, handler, opts...)
+}
+
+type partitionsReader interface {
+	ReadMessage(ctx context.Context) (*topicreader.Message, error)
+	Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error
+}
+
+// partitionWorker handles messages of one partition session
+type partitionWorker struct {
+	queue   []*topicreader.Message
+	running bool
+	stopped bool // handler failed, messages of partition session are skipped
+}
+
+type partitionsDispatcher struct {
+	cfg     processPartitionsConfig
+	reader  partitionsReader
+	handler PartitionHandler
+
+	ctx    context.Context //nolint:containedctx
+	cancel context.CancelFunc
+	slots  chan struct{} // nil if number of workers is not limited
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	workers map[context.Context]*partitionWorker // by context of partition session
+	err     error
+}
+
+func processPartitionsConcurrently(
+	ctx context.Context,
+	reader partitionsReader,
+	handler PartitionHandler,
+	opts ...ProcessPartitionsOption,
+) error {
+	d := &partitionsDispatcher{
+		reader:  reader,
+		handler: handler,
+		workers: make(map[context.Context]*partitionWorker),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&d.cfg)
+		}
+	}
+	if d.cfg.maxWorkers > 0 {
+		d.slots = make(chan struct{}, d.cfg.maxWorkers)
+	}
+	d.ctx, d.cancel = context.WithCancel(ctx)
+	defer d.cancel()
+
+	// received messages are handled after read error, cancel of ctx or failure stops workers
+	err := d.readLoop()
+	d.wg.Wait()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.err != nil {
+		return d.err
+	}
+	if ctx.Err() != nil {
+		return xerrors.WithStackTrace(ctx.Err())
+	}
+
+	return err
+}
+
+func (d *partitionsDispatcher) readLoop() error {
+	for {
+		msg, err := d.reader.ReadMessage(d.ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if err = d.dispatch(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *partitionsDispatcher) dispatch(msg *topicreader.Message) error {
+	partition := msg.Context()
+
+	d.mu.Lock()
+	d.removeStoppedPartitions()
+	w, has := d.workers[partition]
+	if !has {
+		w = &partitionWorker{}
+		d.workers[partition] = w
+	}
+	if w.stopped {
+		d.mu.Unlock()
+
+		return nil
+	}
+	w.queue = append(w.queue, msg)
+	needStart := !w.running
+	w.running = true
+	d.mu.Unlock()
+
+	if !needStart {
+		return nil
+	}
+
+	if d.slots != nil {
+		select {
+		case d.slots <- struct{}{}:
+		case <-d.ctx.Done():
+			return xerrors.WithStackTrace(d.ctx.Err())
+		}
+	}
+
+	d.wg.Add(1)
+	go d.work(partition, w)
+
+	return nil
+}
+
+// removeStoppedPartitions forgets workers of finished partition sessions, must be called under d.mu
+func (d *partitionsDispatcher) removeStoppedPartitions() {
+	for partition, w := range d.workers {
+		if !w.running && partition.Err() != nil {
+			delete(d.workers, partition)
+		}
+	}
+}
+
+func (d *partitionsDispatcher) work(partition context.Context, w *partitionWorker) {
+	defer d.wg.Done()
+	defer func() {
+		if d.slots != nil {
+			<-d.slots
+		}
+	}()
+
+	for {
+		d.mu.Lock()
+		if len(w.queue) == 0 || w.stopped || d.ctx.Err() != nil {
+			w.queue = nil
+			w.running = false
+			d.mu.Unlock()
+
+			return
+		}
+		msg := w.queue[0]
+		w.queue = w.queue[1:]
+		d.mu.Unlock()
+
+		if partition.Err() != nil {
+			// partition session stopped, messages will be read again by next session of the partition
+			continue
+		}
+
+		if err := d.handler(d.ctx, msg); err != nil {
+			d.onHandlerError(w, msg, err)
+
+			continue
+		}
+
+		if err := d.reader.Commit(partition, msg); err != nil && partition.Err() == nil {
+			d.fail(xerrors.WithStackTrace(err))
+		}
+	}
+}
+
+func (d *partitionsDispatcher) onHandlerError(w *partitionWorker, msg *topicreader.Message, err error) {
+	if d.cfg.onError != nil {
+		d.cfg.onError(msg, err)
+	}
+
+	if d.cfg.errorPolicy == PartitionErrorPolicyFailAll {
+		d.fail(err)
+
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	w.stopped = true
+}
+
+func (d *partitionsDispatcher) fail(err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.err == nil {
+		d.err = err
+	}
+	d.cancel()
+}
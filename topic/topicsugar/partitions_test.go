@@ -0,0 +1,167 @@
+package topicsugar
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+type partitionsReaderMock struct {
+	mu        sync.Mutex
+	messages  []*topicreader.Message
+	committed map[int64][]int64 // offsets by partition id
+}
+
+func (r *partitionsReaderMock) ReadMessage(ctx context.Context) (*topicreader.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if len(r.messages) == 0 {
+		return nil, io.EOF
+	}
+	msg := r.messages[0]
+	r.messages = r.messages[1:]
+
+	return msg, nil
+}
+
+func (r *partitionsReaderMock) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	msg := obj.(*topicreader.Message)
+	if r.committed == nil {
+		r.committed = make(map[int64][]int64)
+	}
+	r.committed[msg.PartitionID()] = append(r.committed[msg.PartitionID()], msg.Offset)
+
+	return nil
+}
+
+func newPartitionMessages(partitions map[int64]context.Context, count int) []*topicreader.Message {
+	var messages []*topicreader.Message
+	for offset := 0; offset < count; offset++ {
+		for partitionID := int64(0); partitionID < int64(len(partitions)); partitionID++ {
+			b := topicreaderinternal.NewPublicMessageBuilder().Offset(int64(offset))
+			b.Context(partitions[partitionID])
+			b.PartitionID(partitionID)
+			messages = append(messages, b.Build())
+		}
+	}
+
+	return messages
+}
+
+func TestProcessPartitionsConcurrently(t *testing.T) {
+	errHandler := errors.New("test handler error")
+	partitions := map[int64]context.Context{
+		0: context.Background(),
+		1: context.WithValue(context.Background(), "partition", 1), //nolint:staticcheck,revive
+		2: context.WithValue(context.Background(), "partition", 2), //nolint:staticcheck,revive
+	}
+	t.Run("OrderPerPartition", func(t *testing.T) {
+		reader := &partitionsReaderMock{messages: newPartitionMessages(partitions, 10)}
+		var (
+			mu      sync.Mutex
+			handled = make(map[int64][]int64)
+		)
+		err := processPartitionsConcurrently(context.Background(), reader,
+			func(ctx context.Context, msg *topicreader.Message) error {
+				mu.Lock()
+				defer mu.Unlock()
+				handled[msg.PartitionID()] = append(handled[msg.PartitionID()], msg.Offset)
+
+				return nil
+			},
+			WithMaxPartitionWorkers(2),
+		)
+		require.ErrorIs(t, err, io.EOF)
+		expected := []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		for partitionID := range partitions {
+			require.Equal(t, expected, handled[partitionID])
+			require.Equal(t, expected, reader.committed[partitionID])
+		}
+	})
+	t.Run("StopPartition", func(t *testing.T) {
+		reader := &partitionsReaderMock{messages: newPartitionMessages(partitions, 5)}
+		var skipped []int64
+		err := processPartitionsConcurrently(context.Background(), reader,
+			func(ctx context.Context, msg *topicreader.Message) error {
+				if msg.PartitionID() == 1 && msg.Offset == 2 {
+					return errHandler
+				}
+
+				return nil
+			},
+			WithPartitionErrorHandler(func(msg *topicreader.Message, err error) {
+				require.ErrorIs(t, err, errHandler)
+				skipped = append(skipped, msg.Offset)
+			}),
+		)
+		require.ErrorIs(t, err, io.EOF)
+		require.Equal(t, []int64{2}, skipped)
+		require.Equal(t, []int64{0, 1, 2, 3, 4}, reader.committed[0])
+		require.Equal(t, []int64{0, 1}, reader.committed[1])
+		require.Equal(t, []int64{0, 1, 2, 3, 4}, reader.committed[2])
+	})
+	t.Run("FailAll", func(t *testing.T) {
+		reader := &partitionsReaderMock{messages: newPartitionMessages(partitions, 5)}
+		err := processPartitionsConcurrently(context.Background(), reader,
+			func(ctx context.Context, msg *topicreader.Message) error {
+				if msg.PartitionID() == 1 && msg.Offset == 2 {
+					return errHandler
+				}
+
+				return nil
+			},
+			WithPartitionErrorPolicy(PartitionErrorPolicyFailAll),
+		)
+		require.ErrorIs(t, err, errHandler)
+		require.NotContains(t, reader.committed[1], int64(2))
+	})
+	t.Run("StoppedPartitionSession", func(t *testing.T) {
+		stopped, cancel := context.WithCancel(context.Background())
+		cancel()
+		reader := &partitionsReaderMock{messages: newPartitionMessages(map[int64]context.Context{
+			0: context.Background(),
+			1: stopped,
+		}, 3)}
+		err := processPartitionsConcurrently(context.Background(), reader,
+			func(ctx context.Context, msg *topicreader.Message) error {
+				require.EqualValues(t, 0, msg.PartitionID())
+
+				return nil
+			},
+		)
+		require.ErrorIs(t, err, io.EOF)
+		require.Equal(t, []int64{0, 1, 2}, reader.committed[0])
+		require.Empty(t, reader.committed[1])
+	})
+	t.Run("ContextCancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		reader := &partitionsReaderMock{messages: newPartitionMessages(map[int64]context.Context{
+			0: context.Background(),
+		}, 5)}
+		err := processPartitionsConcurrently(ctx, reader,
+			func(ctx context.Context, msg *topicreader.Message) error {
+				if msg.Offset == 1 {
+					cancel()
+				}
+
+				return nil
+			},
+		)
+		require.ErrorIs(t, err, context.Canceled)
+		require.NotContains(t, reader.committed[0], int64(4))
+	})
+}
@@ -0,0 +1,519 @@
+package topicsugar
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xcontext"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xerrors"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+const (
+	defaultStreamOrderWindow   = 64
+	defaultStreamMaxDelay      = time.Second
+	defaultStreamFlushInterval = time.Second
+)
+
+// Format is a format of messages written by StreamToWriter
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type Format int
+
+const (
+	// JSONLines writes every message as JSON object on separate line
+	JSONLines Format = iota
+
+	// CSV writes messages as CSV records with header
+	CSV
+)
+
+const (
+	dataEncodingUTF8   = "utf8"
+	dataEncodingBase64 = "base64"
+)
+
+type streamReader interface {
+	ReadMessage(ctx context.Context) (*topicreader.Message, error)
+	Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error
+}
+
+type streamConfig struct {
+	format          Format
+	limit           int
+	includeMetadata bool
+	noCommit        bool
+	orderWindow     int
+	maxDelay        time.Duration
+	flushInterval   time.Duration
+	clock           clockwork.Clock
+}
+
+// StreamOption is an option for StreamToWriter
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type StreamOption func(c *streamConfig)
+
+// WithFormat defines format of written messages. JSONLines is used by default
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFormat(format Format) StreamOption {
+	return func(c *streamConfig) {
+		c.format = format
+	}
+}
+
+// WithLimit defines maximum number of written messages. Zero value means no limit
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithLimit(n int) StreamOption {
+	return func(c *streamConfig) {
+		c.limit = n
+	}
+}
+
+// WithIncludeMetadata adds written-at time, message group id, write session metadata and
+// message metadata to written messages
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithIncludeMetadata() StreamOption {
+	return func(c *streamConfig) {
+		c.includeMetadata = true
+	}
+}
+
+// WithNoCommit disables commit of written messages (peek semantic)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithNoCommit() StreamOption {
+	return func(c *streamConfig) {
+		c.noCommit = true
+	}
+}
+
+// WithOrderWindow defines number of buffered messages which are ordered by written-at time
+// before write. Messages from different partitions are interleaved deterministically within window,
+// messages of one partition are always written in order of offsets.
+// Value less than or equal to 1 disables ordering
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithOrderWindow(n int) StreamOption {
+	return func(c *streamConfig) {
+		c.orderWindow = n
+	}
+}
+
+// WithMaxDelay defines maximum time of waiting of message in order window. Messages which wait longer
+// are written (together with preceding messages of window) and flushed even if window is not full and
+// no new messages are read. Value less than or equal to zero disables writing by time, so not full
+// window is written on next read messages only
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithMaxDelay(d time.Duration) StreamOption {
+	return func(c *streamConfig) {
+		c.maxDelay = d
+	}
+}
+
+// WithFlushInterval defines interval of flushes of written messages to writer
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func WithFlushInterval(interval time.Duration) StreamOption {
+	return func(c *streamConfig) {
+		c.flushInterval = interval
+	}
+}
+
+// StreamToWriter reads messages from reader and writes them to w in given format (JSONLines by default)
+// with offset, seqno, created-at time, producer id and payload of every message.
+// Payload is written as is if it is valid UTF-8 string and as base64 otherwise.
+// StreamToWriter stops after limit of messages is reached (returns nil) or
+// on first error of read or write (ctx cancel error included).
+// Messages are committed after flush to w unless WithNoCommit is used, messages of one partition
+// are committed in order of offsets.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func StreamToWriter(ctx context.Context, reader *topicreader.Reader, w io.Writer, opts ...StreamOption) error {
+	return streamToWriter(ctx, reader, w, opts...)
+}
+
+func streamToWriter(ctx context.Context, reader streamReader, w io.Writer, opts ...StreamOption) (finalErr error) {
+	cfg := streamConfig{
+		format:        JSONLines,
+		orderWindow:   defaultStreamOrderWindow,
+		maxDelay:      defaultStreamMaxDelay,
+		flushInterval: defaultStreamFlushInterval,
+		clock:         clockwork.NewRealClock(),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	s := &messagesStream{
+		cfg:       cfg,
+		reader:    reader,
+		buf:       bufio.NewWriter(w),
+		writtenAt: make(map[int64]time.Time),
+		lastFlush: cfg.clock.Now(),
+	}
+	switch cfg.format {
+	case JSONLines:
+		s.format = s.writeJSON
+	case CSV:
+		s.csv = csv.NewWriter(s.buf)
+		s.format = s.writeCSV
+		if err := s.writeCSVHeader(); err != nil {
+			return err
+		}
+	default:
+		return xerrors.WithStackTrace(errors.New("ydb: unknown format of topic messages stream"))
+	}
+
+	defer func() {
+		// write buffered messages on stop
+		if err := s.close(); err != nil {
+			if finalErr != nil {
+				finalErr = xerrors.Join(finalErr, err)
+			} else {
+				finalErr = err
+			}
+		}
+	}()
+
+	for read := 0; cfg.limit <= 0 || read < cfg.limit; {
+		msg, err := s.read(ctx)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		if msg != nil {
+			read++
+			if err = s.push(msg); err != nil {
+				return err
+			}
+			if len(s.window) >= cfg.orderWindow {
+				if err = s.writeFirst(); err != nil {
+					return err
+				}
+			}
+		}
+		expired, err := s.writeExpired()
+		if err != nil {
+			return err
+		}
+		if expired || (s.dirty && s.cfg.clock.Since(s.lastFlush) >= cfg.flushInterval) {
+			if err = s.flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type streamMessage struct {
+	msg  *topicreader.Message
+	data []byte
+	// writtenAt is a written-at time of message for ordering, it is not less than written-at time
+	// of previous messages of partition
+	writtenAt time.Time
+	// received is a time of read of message
+	received time.Time
+}
+
+type messagesStream struct {
+	cfg    streamConfig
+	reader streamReader
+	buf    *bufio.Writer
+	csv    *csv.Writer
+	format func(m *streamMessage) error
+
+	window    []*streamMessage
+	writtenAt map[int64]time.Time
+	written   []*topicreader.Message
+	dirty     bool
+	lastFlush time.Time
+}
+
+// read reads next message. Read is interrupted (nil message is returned without error) if messages of
+// window wait longer than max delay or written messages wait for flush longer than flush interval
+func (s *messagesStream) read(ctx context.Context) (*topicreader.Message, error) {
+	wait, ok := s.nextDeadline()
+	if !ok {
+		return s.reader.ReadMessage(ctx)
+	}
+	if wait <= 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	readCtx, cancel := xcontext.WithCancel(ctx)
+	defer cancel()
+	timer := s.cfg.clock.NewTimer(wait)
+	defer timer.Stop()
+	var expired atomic.Bool
+	go func() {
+		select {
+		case <-readCtx.Done():
+		case <-timer.Chan():
+			expired.Store(true)
+			cancel()
+		}
+	}()
+
+	msg, err := s.reader.ReadMessage(readCtx)
+	if err != nil && expired.Load() && ctx.Err() == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	return msg, err
+}
+
+// nextDeadline returns time until next write of expired messages of window or flush of written messages
+func (s *messagesStream) nextDeadline() (wait time.Duration, ok bool) {
+	now := s.cfg.clock.Now()
+	if s.cfg.maxDelay > 0 {
+		for _, m := range s.window {
+			if d := m.received.Add(s.cfg.maxDelay).Sub(now); !ok || d < wait {
+				wait, ok = d, true
+			}
+		}
+	}
+	if s.dirty {
+		if d := s.lastFlush.Add(s.cfg.flushInterval).Sub(now); !ok || d < wait {
+			wait, ok = d, true
+		}
+	}
+
+	return wait, ok
+}
+
+func (s *messagesStream) push(msg *topicreader.Message) error {
+	m := &streamMessage{msg: msg, writtenAt: msg.WrittenAt, received: s.cfg.clock.Now()}
+	// messages of one partition are kept in order of read (offsets) even if written-at time of message
+	// is less than written-at time of previous message of partition, so commits of partition are ordered
+	if last, has := s.writtenAt[msg.PartitionID()]; has && m.writtenAt.Before(last) {
+		m.writtenAt = last
+	}
+	s.writtenAt[msg.PartitionID()] = m.writtenAt
+
+	err := ReadMessageDataWithCallback(msg, func(data []byte) error {
+		m.data = append([]byte(nil), data...)
+
+		return nil
+	})
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	// keep window sorted by written-at time, messages with same written-at time ordered by
+	// partition and offset, so interleaving of partitions is deterministic
+	i := sort.Search(len(s.window), func(i int) bool {
+		return streamMessageLess(m, s.window[i])
+	})
+	s.window = append(s.window, nil)
+	copy(s.window[i+1:], s.window[i:])
+	s.window[i] = m
+
+	return nil
+}
+
+func streamMessageLess(lhs, rhs *streamMessage) bool {
+	if !lhs.writtenAt.Equal(rhs.writtenAt) {
+		return lhs.writtenAt.Before(rhs.writtenAt)
+	}
+	if lhs.msg.PartitionID() != rhs.msg.PartitionID() {
+		return lhs.msg.PartitionID() < rhs.msg.PartitionID()
+	}
+
+	return lhs.msg.Offset < rhs.msg.Offset
+}
+
+func (s *messagesStream) writeFirst() error {
+	m := s.window[0]
+	s.window = s.window[1:]
+	if err := s.format(m); err != nil {
+		return err
+	}
+	s.written = append(s.written, m.msg)
+	s.dirty = true
+
+	return nil
+}
+
+// writeExpired writes messages of window up to last message which waits longer than max delay
+func (s *messagesStream) writeExpired() (expired bool, _ error) {
+	if s.cfg.maxDelay <= 0 {
+		return false, nil
+	}
+	now := s.cfg.clock.Now()
+	last := -1
+	for i, m := range s.window {
+		if now.Sub(m.received) >= s.cfg.maxDelay {
+			last = i
+		}
+	}
+	for i := 0; i <= last; i++ {
+		if err := s.writeFirst(); err != nil {
+			return false, err
+		}
+	}
+
+	return last >= 0, nil
+}
+
+func (s *messagesStream) close() error {
+	for len(s.window) > 0 {
+		if err := s.writeFirst(); err != nil {
+			return err
+		}
+	}
+
+	return s.flush()
+}
+
+func (s *messagesStream) flush() error {
+	if s.csv != nil {
+		s.csv.Flush()
+		if err := s.csv.Error(); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+	if err := s.buf.Flush(); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	s.lastFlush = s.cfg.clock.Now()
+	s.dirty = false
+
+	written := s.written
+	s.written = nil
+	if s.cfg.noCommit {
+		return nil
+	}
+	for _, msg := range written {
+		if err := s.reader.Commit(msg.Context(), msg); err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+func encodeStreamData(data []byte) (value, encoding string) {
+	if utf8.Valid(data) {
+		return string(data), dataEncodingUTF8
+	}
+
+	return base64.StdEncoding.EncodeToString(data), dataEncodingBase64
+}
+
+type streamJSONMessage struct {
+	PartitionID          int64             `json:"partition_id"`
+	Offset               int64             `json:"offset"`
+	SeqNo                int64             `json:"seq_no"`
+	CreatedAt            time.Time         `json:"created_at"`
+	ProducerID           string            `json:"producer_id"`
+	WrittenAt            *time.Time        `json:"written_at,omitempty"`
+	MessageGroupID       string            `json:"message_group_id,omitempty"`
+	WriteSessionMetadata map[string]string `json:"write_session_metadata,omitempty"`
+	Metadata             map[string]string `json:"metadata,omitempty"`
+	Data                 string            `json:"data"`
+	DataEncoding         string            `json:"data_encoding"`
+}
+
+func (s *messagesStream) writeJSON(m *streamMessage) error {
+	v := streamJSONMessage{
+		PartitionID: m.msg.PartitionID(),
+		Offset:      m.msg.Offset,
+		SeqNo:       m.msg.SeqNo,
+		CreatedAt:   m.msg.CreatedAt,
+		ProducerID:  m.msg.ProducerID,
+	}
+	v.Data, v.DataEncoding = encodeStreamData(m.data)
+	if s.cfg.includeMetadata {
+		v.WrittenAt = &m.msg.WrittenAt
+		v.MessageGroupID = m.msg.MessageGroupID
+		v.WriteSessionMetadata = m.msg.WriteSessionMetadata
+		v.Metadata = streamMetadata(m.msg.Metadata)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+	b = append(b, '\n')
+	if _, err = s.buf.Write(b); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func streamMetadata(metadata map[string][]byte) map[string]string {
+	if len(metadata) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		m[k], _ = encodeStreamData(v)
+	}
+
+	return m
+}
+
+func (s *messagesStream) writeCSVHeader() error {
+	header := []string{"partition_id", "offset", "seq_no", "created_at", "producer_id", "data", "data_encoding"}
+	if s.cfg.includeMetadata {
+		header = append(header, "written_at", "message_group_id", "write_session_metadata", "metadata")
+	}
+	if err := s.csv.Write(header); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (s *messagesStream) writeCSV(m *streamMessage) error {
+	data, encoding := encodeStreamData(m.data)
+	record := []string{
+		strconv.FormatInt(m.msg.PartitionID(), 10),
+		strconv.FormatInt(m.msg.Offset, 10),
+		strconv.FormatInt(m.msg.SeqNo, 10),
+		m.msg.CreatedAt.Format(time.RFC3339Nano),
+		m.msg.ProducerID,
+		data,
+		encoding,
+	}
+	if s.cfg.includeMetadata {
+		writeSessionMetadata, err := json.Marshal(m.msg.WriteSessionMetadata)
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		metadata, err := json.Marshal(streamMetadata(m.msg.Metadata))
+		if err != nil {
+			return xerrors.WithStackTrace(err)
+		}
+		record = append(record,
+			m.msg.WrittenAt.Format(time.RFC3339Nano),
+			m.msg.MessageGroupID,
+			string(writeSessionMetadata),
+			string(metadata),
+		)
+	}
+	if err := s.csv.Write(record); err != nil {
+		return xerrors.WithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package topicsugar
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/topic/topicreaderinternal"
+	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicreader"
+)
+
+// streamReaderMock is a reader which waits for messages until context is done
+type streamReaderMock struct {
+	messages  chan *topicreader.Message
+	committed chan *topicreader.Message
+}
+
+func (r *streamReaderMock) ReadMessage(ctx context.Context) (*topicreader.Message, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case msg := <-r.messages:
+		return msg, nil
+	}
+}
+
+func (r *streamReaderMock) Commit(ctx context.Context, obj topicreader.CommitRangeGetter) error {
+	r.committed <- obj.(*topicreader.Message)
+
+	return nil
+}
+
+// syncBuffer is a buffer which is safe for concurrent write and read
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func TestStreamToWriter(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	newMessage := func(partitionID, offset int64, writtenAt time.Duration, data string) *topicreader.Message {
+		b := topicreaderinternal.NewPublicMessageBuilder().
+			Offset(offset).
+			Seqno(offset + 100).
+			CreatedAt(createdAt).
+			WrittenAt(createdAt.Add(writtenAt)).
+			ProducerID("producer").
+			MessageGroupID("group").
+			Metadata(map[string][]byte{"key": []byte("value")}).
+			DataAndUncompressedSize([]byte(data))
+		b.PartitionID(partitionID)
+
+		return b.Build()
+	}
+	newMessages := func() []*topicreader.Message {
+		return []*topicreader.Message{
+			newMessage(1, 10, 2*time.Second, "b"),
+			newMessage(0, 20, time.Second, "a"),
+			newMessage(0, 21, 2*time.Second, "\xff\xfe"),
+			newMessage(1, 11, 3*time.Second, "c"),
+		}
+	}
+
+	for _, tt := range []struct {
+		name      string
+		opts      []StreamOption
+		err       error
+		output    string
+		committed []int
+	}{
+		{
+			name: "JSONLines",
+			opts: []StreamOption{WithLimit(3)},
+			output: `{"partition_id":0,"offset":20,"seq_no":120,"created_at":"2024-01-02T03:04:05Z","producer_id":"producer","data":"a","data_encoding":"utf8"}
+{"partition_id":0,"offset":21,"seq_no":121,"created_at":"2024-01-02T03:04:05Z","producer_id":"producer","data":"//4=","data_encoding":"base64"}
+{"partition_id":1,"offset":10,"seq_no":110,"created_at":"2024-01-02T03:04:05Z","producer_id":"producer","data":"b","data_encoding":"utf8"}
+`,
+			committed: []int{1, 2, 0},
+		},
+		{
+			name: "JSONLinesWithMetadata",
+			opts: []StreamOption{WithLimit(1), WithIncludeMetadata(), WithNoCommit()},
+			output: `{"partition_id":1,"offset":10,"seq_no":110,"created_at":"2024-01-02T03:04:05Z","producer_id":"producer","written_at":"2024-01-02T03:04:07Z","message_group_id":"group","metadata":{"key":"value"},"data":"b","data_encoding":"utf8"}
+`,
+		},
+		{
+			name: "CSV",
+			opts: []StreamOption{WithFormat(CSV), WithOrderWindow(2), WithNoCommit()},
+			err:  io.EOF,
+			output: `partition_id,offset,seq_no,created_at,producer_id,data,data_encoding
+0,20,120,2024-01-02T03:04:05Z,producer,a,utf8
+0,21,121,2024-01-02T03:04:05Z,producer,//4=,base64
+1,10,110,2024-01-02T03:04:05Z,producer,b,utf8
+1,11,111,2024-01-02T03:04:05Z,producer,c,utf8
+`,
+		},
+		{
+			name: "CSVWithMetadata",
+			opts: []StreamOption{WithFormat(CSV), WithLimit(1), WithIncludeMetadata()},
+			output: `partition_id,offset,seq_no,created_at,producer_id,data,data_encoding,written_at,message_group_id,write_session_metadata,metadata
+1,10,110,2024-01-02T03:04:05Z,producer,b,utf8,2024-01-02T03:04:07Z,group,null,"{""key"":""value""}"
+`,
+			committed: []int{0},
+		},
+		{
+			name: "WithoutOrder",
+			opts: []StreamOption{WithOrderWindow(0), WithLimit(2)},
+			output: `{"partition_id":1,"offset":10,"seq_no":110,"created_at":"2024-01-02T03:04:05Z","producer_id":"producer","data":"b","data_encoding":"utf8"}
+{"partition_id":0,"offset":20,"seq_no":120,"created_at":"2024-01-02T03:04:05Z","producer_id":"producer","data":"a","data_encoding":"utf8"}
+`,
+			committed: []int{0, 1},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := newMessages()
+			reader := &changefeedReaderMock{messages: messages}
+			var buf bytes.Buffer
+			err := streamToWriter(context.Background(), reader, &buf, tt.opts...)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.output, buf.String())
+			committed := make([]*topicreader.Message, 0, len(tt.committed))
+			for _, i := range tt.committed {
+				committed = append(committed, messages[i])
+			}
+			require.Equal(t, committed, append(make([]*topicreader.Message, 0), reader.committed...))
+		})
+	}
+	t.Run("OffsetOrderInPartition", func(t *testing.T) {
+		// written-at time of message of partition is less than written-at time of previous message
+		messages := []*topicreader.Message{
+			newMessage(0, 20, 2*time.Second, "a"),
+			newMessage(0, 21, time.Second, "b"),
+			newMessage(1, 10, time.Second+time.Second/2, "c"),
+		}
+		reader := &changefeedReaderMock{messages: append([]*topicreader.Message(nil), messages...)}
+		var buf bytes.Buffer
+		require.ErrorIs(t, streamToWriter(context.Background(), reader, &buf, WithFormat(CSV)), io.EOF)
+		require.Equal(t, `partition_id,offset,seq_no,created_at,producer_id,data,data_encoding
+1,10,110,2024-01-02T03:04:05Z,producer,c,utf8
+0,20,120,2024-01-02T03:04:05Z,producer,a,utf8
+0,21,121,2024-01-02T03:04:05Z,producer,b,utf8
+`, buf.String())
+		require.Equal(t, []*topicreader.Message{messages[2], messages[0], messages[1]}, reader.committed)
+	})
+	t.Run("MaxDelayOfIdleWindow", func(t *testing.T) {
+		const maxDelay = time.Second
+		clock := clockwork.NewFakeClock()
+		reader := &streamReaderMock{
+			messages:  make(chan *topicreader.Message),
+			committed: make(chan *topicreader.Message, 2),
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var buf syncBuffer
+		done := make(chan error, 1)
+		go func() {
+			done <- streamToWriter(ctx, reader, &buf,
+				WithMaxDelay(maxDelay), WithFlushInterval(time.Hour),
+				func(c *streamConfig) {
+					c.clock = clock
+				},
+			)
+		}()
+		messages := []*topicreader.Message{
+			newMessage(0, 20, time.Second, "a"),
+			newMessage(1, 10, time.Second, "b"),
+		}
+		for _, msg := range messages {
+			reader.messages <- msg
+		}
+		// window is not full and no new messages are read
+		require.Empty(t, buf.String())
+
+		// timers of reads after first and second messages
+		clock.BlockUntil(2)
+		clock.Advance(maxDelay)
+		require.Equal(t, messages[0], <-reader.committed)
+		require.Equal(t, messages[1], <-reader.committed)
+		require.Equal(t, `{"partition_id":0,"offset":20,"seq_no":120,"created_at":"2024-01-02T03:04:05Z","producer_id":"producer","data":"a","data_encoding":"utf8"}
+{"partition_id":1,"offset":10,"seq_no":110,"created_at":"2024-01-02T03:04:05Z","producer_id":"producer","data":"b","data_encoding":"utf8"}
+`, buf.String())
+
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+	})
+}
@@ -43,7 +43,6 @@ type Consumer struct {
 func (c *Consumer) ToRaw(raw *rawtopic.Consumer) {
 	raw.Name = c.Name
 	raw.Important = c.Important
-	raw.Attributes = c.Attributes
 
 	raw.SupportedCodecs = make(rawtopiccommon.SupportedCodecs, len(c.SupportedCodecs))
 	for index, codec := range c.SupportedCodecs {
@@ -178,3 +177,72 @@ func (p *PartitionInfo) FromRaw(raw *rawtopic.PartitionInfo) {
 	p.ChildPartitionIDs = clone.Int64Slice(raw.ChildPartitionIDs)
 	p.ParentPartitionIDs = clone.Int64Slice(raw.ParentPartitionIDs)
 }
+
+// PartitionDescription contains info about location of partition of topic and range of its messages
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type PartitionDescription struct {
+	PartitionID        int64
+	Active             bool
+	ChildPartitionIDs  []int64
+	ParentPartitionIDs []int64
+
+	// NodeID is an id of node which hosts the partition now, 0 if location of partition is unknown
+	NodeID uint32
+
+	// StartOffset and EndOffset are range [StartOffset, EndOffset) of offsets of messages in the partition
+	StartOffset int64
+	EndOffset   int64
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (p *PartitionDescription) FromRaw(raw *rawtopic.PartitionInfo) {
+	p.PartitionID = raw.PartitionID
+	p.Active = raw.Active
+
+	p.ChildPartitionIDs = clone.Int64Slice(raw.ChildPartitionIDs)
+	p.ParentPartitionIDs = clone.Int64Slice(raw.ParentPartitionIDs)
+
+	p.NodeID = uint32(raw.PartitionStats.PartitionNodeID)
+	p.StartOffset = raw.PartitionStats.PartitionsOffset.Start.ToInt64()
+	p.EndOffset = raw.PartitionStats.PartitionsOffset.End.ToInt64()
+}
+
+// TopicConsumerDescription contains info about consumer of topic
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type TopicConsumerDescription struct {
+	Path       string
+	Consumer   Consumer
+	Partitions []DescribeConsumerPartitionInfo
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (d *TopicConsumerDescription) FromRaw(raw *rawtopic.DescribeConsumerResult) {
+	d.Path = raw.Self.Name
+	d.Consumer.FromRaw(&raw.Consumer)
+
+	d.Partitions = make([]DescribeConsumerPartitionInfo, len(raw.Partitions))
+	for i := range raw.Partitions {
+		d.Partitions[i].FromRaw(&raw.Partitions[i])
+	}
+}
+
+// DescribeConsumerPartitionInfo contains info about partition of topic read by the consumer
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+type DescribeConsumerPartitionInfo struct {
+	PartitionID        int64
+	Active             bool
+	ChildPartitionIDs  []int64
+	ParentPartitionIDs []int64
+}
+
+// FromRaw convert from internal format to public. Used internally only.
+func (p *DescribeConsumerPartitionInfo) FromRaw(raw *rawtopic.DescribeConsumerPartitionInfo) {
+	p.PartitionID = raw.PartitionID
+	p.Active = raw.Active
+
+	p.ChildPartitionIDs = clone.Int64Slice(raw.ChildPartitionIDs)
+	p.ParentPartitionIDs = clone.Int64Slice(raw.ParentPartitionIDs)
+}
@@ -162,3 +162,58 @@ func TestTopicDescriptionFromRaw(t *testing.T) {
 		})
 	}
 }
+
+func TestTopicConsumerDescriptionFromRaw(t *testing.T) {
+	readFrom := time.Date(2022, time.March, 8, 12, 12, 12, 0, time.UTC)
+	raw := &rawtopic.DescribeConsumerResult{
+		Self: rawscheme.Entry{
+			Name: "consumer",
+		},
+		Consumer: rawtopic.Consumer{
+			Name:      "consumer",
+			Important: true,
+			SupportedCodecs: rawtopiccommon.SupportedCodecs{
+				rawtopiccommon.CodecRaw,
+			},
+			ReadFrom: rawoptional.Time{
+				Value:    readFrom,
+				HasValue: true,
+			},
+			Attributes: map[string]string{
+				"hello": "world",
+			},
+		},
+		Partitions: []rawtopic.DescribeConsumerPartitionInfo{
+			{
+				PartitionID:       1,
+				Active:            true,
+				ChildPartitionIDs: []int64{2},
+			},
+		},
+	}
+	expected := TopicConsumerDescription{
+		Path: "consumer",
+		Consumer: Consumer{
+			Name:            "consumer",
+			Important:       true,
+			SupportedCodecs: []Codec{CodecRaw},
+			ReadFrom:        readFrom,
+			Attributes: map[string]string{
+				"hello": "world",
+			},
+		},
+		Partitions: []DescribeConsumerPartitionInfo{
+			{
+				PartitionID:       1,
+				Active:            true,
+				ChildPartitionIDs: []int64{2},
+			},
+		},
+	}
+
+	var d TopicConsumerDescription
+	d.FromRaw(raw)
+	if !reflect.DeepEqual(d, expected) {
+		t.Errorf("got\n%+v\nexpected\n %+v", d, expected)
+	}
+}
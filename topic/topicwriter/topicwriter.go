@@ -8,6 +8,17 @@ import (
 
 type (
 	Message = topicwriterinternal.PublicMessage
+
+	// WriteAck is an acknowledgement of written message from server with seqno, offset and partition of message.
+	// Deduplicated is true if server skipped the message as already written.
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	WriteAck = topicwriterinternal.PublicWriteAck
+
+	// WriteAckResult is a result of Writer.WriteWithAckAsync
+	//
+	// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+	WriteAckResult = topicwriterinternal.PublicWriteAckResult
 )
 
 var ErrQueueLimitExceed = topicwriterinternal.PublicErrQueueIsFull
@@ -20,7 +31,13 @@ type Writer struct {
 
 // PublicInitialInfo is an information about writer after initialize
 type PublicInitialInfo struct {
+	// LastSeqNum is a last written seqno of producer or zero if writer created with
+	// topicoptions.WithWriterGetLastSeqNo(false)
 	LastSeqNum int64
+
+	// PartitionID is an id of partition of writer session
+	// It is useful for writers with partitioning by message group (producer id)
+	PartitionID int64
 }
 
 // NewWriter create new writer from internal type. Used internally only.
@@ -43,6 +60,30 @@ func (w *Writer) Write(ctx context.Context, messages ...Message) error {
 	return w.inner.Write(ctx, messages...)
 }
 
+// WriteWithAck send messages to topic and waits acks of all the messages from server (or ctx done)
+// independent of sync write mode. It returns acks in order of messages in the call.
+//
+// Messages of sequential calls of Write, WriteWithAck and WriteWithAckAsync are sent in order of calls
+// and server acks messages in order of seqno, so acks of messages of earlier call are received
+// not later than acks of messages of next call. Cancel of ctx stops waiting of acks only, messages
+// which were put to internal buffer will be sent.
+//
+// It returns ErrQueueLimitExceed (must be checked by errors.Is) same as Write.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (w *Writer) WriteWithAck(ctx context.Context, messages ...Message) ([]WriteAck, error) {
+	return w.inner.WriteWithAck(ctx, messages...)
+}
+
+// WriteWithAckAsync is non-blocking variant of WriteWithAck. It returns after put messages to internal buffer
+// (same as Write in async mode) and returns channel which receives one WriteAckResult with acks of messages
+// (or error of waiting, for example ctx.Err()) and then is closed. Order of acks is the same as WriteWithAck.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (w *Writer) WriteWithAckAsync(ctx context.Context, messages ...Message) (<-chan WriteAckResult, error) {
+	return w.inner.WriteWithAckAsync(ctx, messages...)
+}
+
 // WaitInit waits until the reader is initialized
 // or an error occurs, return PublicInitialInfo and err
 func (w *Writer) WaitInit(ctx context.Context) (err error) {
@@ -61,7 +102,10 @@ func (w *Writer) WaitInitInfo(ctx context.Context) (info PublicInitialInfo, err
 	if err != nil {
 		return PublicInitialInfo{}, err
 	}
-	publicInfo := PublicInitialInfo{LastSeqNum: privateInfo.LastSeqNum}
+	publicInfo := PublicInitialInfo{
+		LastSeqNum:  privateInfo.LastSeqNum,
+		PartitionID: privateInfo.PartitionID,
+	}
 
 	return publicInfo, nil
 }
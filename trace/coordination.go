@@ -48,6 +48,15 @@ type (
 		OnSessionServerExpire func(CoordinationSessionServerExpireInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnSessionServerError func(CoordinationSessionServerErrorInfo)
+		// OnSessionRestored is called when the session is restored on the new gRPC stream after reconnect
+		//
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnSessionRestored func(CoordinationSessionRestoredInfo)
+		// OnSessionExpired is called when the session is lost and its context is canceled
+		// because of server-side expiration or client timeout
+		//
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnSessionExpired func(CoordinationSessionExpiredInfo)
 
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnSessionReceive func(CoordinationSessionReceiveStartInfo) func(CoordinationSessionReceiveDoneInfo)
@@ -203,6 +212,16 @@ type (
 		Failure *Ydb_Coordination.SessionResponse_Failure
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	CoordinationSessionRestoredInfo struct {
+		SessionID  uint64
+		Reconnects int // number of gRPC stream reconnects since the session was available last time
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	CoordinationSessionExpiredInfo struct {
+		SessionID uint64
+		Reason    error
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	CoordinationSessionReceiveStartInfo struct{}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	CoordinationSessionReceiveDoneInfo struct {
@@ -468,6 +468,44 @@ func (t *Coordination) Compose(x *Coordination, opts ...CoordinationComposeOptio
 			}
 		}
 	}
+	{
+		h1 := t.OnSessionRestored
+		h2 := x.OnSessionRestored
+		ret.OnSessionRestored = func(c CoordinationSessionRestoredInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
+	{
+		h1 := t.OnSessionExpired
+		h2 := x.OnSessionExpired
+		ret.OnSessionExpired = func(c CoordinationSessionExpiredInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(c)
+			}
+			if h2 != nil {
+				h2(c)
+			}
+		}
+	}
 	{
 		h1 := t.OnSessionReceive
 		h2 := x.OnSessionReceive
@@ -789,6 +827,20 @@ func (t *Coordination) onSessionServerError(c CoordinationSessionServerErrorInfo
 	}
 	fn(c)
 }
+func (t *Coordination) onSessionRestored(c CoordinationSessionRestoredInfo) {
+	fn := t.OnSessionRestored
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
+func (t *Coordination) onSessionExpired(c CoordinationSessionExpiredInfo) {
+	fn := t.OnSessionExpired
+	if fn == nil {
+		return
+	}
+	fn(c)
+}
 func (t *Coordination) onSessionReceive(c CoordinationSessionReceiveStartInfo) func(CoordinationSessionReceiveDoneInfo) {
 	fn := t.OnSessionReceive
 	if fn == nil {
@@ -999,6 +1051,20 @@ func CoordinationOnSessionServerError(t *Coordination, failure *Ydb_Coordination
 	t.onSessionServerError(p)
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func CoordinationOnSessionRestored(t *Coordination, sessionID uint64, reconnects int) {
+	var p CoordinationSessionRestoredInfo
+	p.SessionID = sessionID
+	p.Reconnects = reconnects
+	t.onSessionRestored(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func CoordinationOnSessionExpired(t *Coordination, sessionID uint64, reason error) {
+	var p CoordinationSessionExpiredInfo
+	p.SessionID = sessionID
+	p.Reason = reason
+	t.onSessionExpired(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func CoordinationOnSessionReceive(t *Coordination) func(response *Ydb_Coordination.SessionResponse, _ error) {
 	var p CoordinationSessionReceiveStartInfo
 	res := t.onSessionReceive(p)
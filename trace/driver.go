@@ -49,6 +49,17 @@ type (
 		OnConnStreamCloseSend func(DriverConnStreamCloseSendStartInfo) func(DriverConnStreamCloseSendDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnConnStreamFinish func(info DriverConnStreamFinishInfo)
+		// OnConnStreamMessageSize called for sampled messages of stream (see config.WithStreamMessageSizeSampling)
+		//
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnConnStreamMessageSize func(info DriverConnStreamMessageSizeInfo)
+		// OnConnStreamInactivityTimeout called when stream is canceled because no messages were received
+		// during stream inactivity timeout (see config.WithStreamInactivityTimeout)
+		//
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnConnStreamInactivityTimeout func(info DriverConnStreamInactivityTimeoutInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnConnInFlightChange func(info DriverConnInFlightChangeInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnConnDial func(DriverConnDialStartInfo) func(DriverConnDialDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
@@ -353,6 +364,8 @@ type (
 		Call     call
 		Endpoint EndpointInfo
 		Method   Method
+		// Deadline is an effective deadline of call with applied default timeout, zero if not set.
+		Deadline time.Time
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DriverConnInvokeDoneInfo struct {
@@ -361,6 +374,15 @@ type (
 		OpID     string
 		State    ConnState
 		Metadata map[string][]string
+		// RequestSize is a size of request message on wire in bytes
+		RequestSize int
+		// ResponseSize is a size of response message on wire in bytes
+		ResponseSize int
+		// DeadlineSource is a source of expired deadline if Error is caused by expired deadline
+		// (see ydb.DeadlineCause), empty otherwise
+		DeadlineSource string
+		// DeadlineTimeout is a configured timeout of expired deadline, zero for deadline of caller
+		DeadlineTimeout time.Duration
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DriverConnNewStreamStartInfo struct {
@@ -372,11 +394,18 @@ type (
 		Call     call
 		Endpoint EndpointInfo
 		Method   Method
+		// Deadline is an effective deadline of call with applied default timeout, zero if not set.
+		Deadline time.Time
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DriverConnNewStreamDoneInfo struct {
 		Error error
 		State ConnState
+		// DeadlineSource is a source of expired deadline if Error is caused by expired deadline
+		// (see ydb.DeadlineCause), empty otherwise
+		DeadlineSource string
+		// DeadlineTimeout is a configured timeout of expired deadline, zero for deadline of caller
+		DeadlineTimeout time.Duration
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DriverConnStreamRecvMsgStartInfo struct {
@@ -426,6 +455,58 @@ type (
 		Context context.Context //nolint:containedctx
 		Call    call
 		Error   error
+		// SentBytes is a total size of sent messages of stream on wire in bytes
+		SentBytes int
+		// ReceivedBytes is a total size of received messages of stream on wire in bytes
+		ReceivedBytes int
+		// DeadlineSource is a source of expired deadline if Error is caused by expired deadline
+		// (see ydb.DeadlineCause), empty otherwise
+		DeadlineSource string
+		// DeadlineTimeout is a configured timeout of expired deadline, zero for deadline of caller
+		DeadlineTimeout time.Duration
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	DriverConnStreamMessageSizeInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context  context.Context //nolint:containedctx
+		Call     call
+		Endpoint EndpointInfo
+		Method   Method
+		// Sent is true for sent message and false for received message
+		Sent bool
+		// Size is a size of message on wire in bytes
+		Size int
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	DriverConnStreamInactivityTimeoutInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context  context.Context //nolint:containedctx
+		Call     call
+		Endpoint EndpointInfo
+		Method   Method
+		// LastActivity is a time of the last received message of stream or time of opening of stream
+		LastActivity time.Time
+		// Timeout is a configured stream inactivity timeout
+		Timeout time.Duration
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	DriverConnInFlightChangeInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context  context.Context //nolint:containedctx
+		Call     call
+		Endpoint EndpointInfo
+
+		// InFlight is a number of in-flight unary calls and opened streams of endpoint
+		InFlight int
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DriverBalancerInitStartInfo struct {
@@ -517,11 +598,12 @@ type (
 		// Pointer to context provide replacement of context in trace callback function.
 		// Warning: concurrent access to pointer on client side must be excluded.
 		// Safe replacement of context are provided only inside callback function
-		Context  *context.Context
-		Call     call
-		Endpoint string
-		Database string
-		Secure   bool
+		Context         *context.Context
+		Call            call
+		Endpoint        string
+		Database        string
+		Secure          bool
+		ApplicationName string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DriverInitDoneInfo struct {
@@ -4,6 +4,7 @@ package trace
 
 import (
 	"context"
+	"time"
 )
 
 // driverComposeOptions is a holder of options
@@ -472,6 +473,63 @@ func (t *Driver) Compose(x *Driver, opts ...DriverComposeOption) *Driver {
 			}
 		}
 	}
+	{
+		h1 := t.OnConnStreamMessageSize
+		h2 := x.OnConnStreamMessageSize
+		ret.OnConnStreamMessageSize = func(info DriverConnStreamMessageSizeInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(info)
+			}
+			if h2 != nil {
+				h2(info)
+			}
+		}
+	}
+	{
+		h1 := t.OnConnStreamInactivityTimeout
+		h2 := x.OnConnStreamInactivityTimeout
+		ret.OnConnStreamInactivityTimeout = func(info DriverConnStreamInactivityTimeoutInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(info)
+			}
+			if h2 != nil {
+				h2(info)
+			}
+		}
+	}
+	{
+		h1 := t.OnConnInFlightChange
+		h2 := x.OnConnInFlightChange
+		ret.OnConnInFlightChange = func(info DriverConnInFlightChangeInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(info)
+			}
+			if h2 != nil {
+				h2(info)
+			}
+		}
+	}
 	{
 		h1 := t.OnConnDial
 		h2 := x.OnConnDial
@@ -1081,6 +1139,27 @@ func (t *Driver) onConnStreamFinish(info DriverConnStreamFinishInfo) {
 	}
 	fn(info)
 }
+func (t *Driver) onConnStreamMessageSize(info DriverConnStreamMessageSizeInfo) {
+	fn := t.OnConnStreamMessageSize
+	if fn == nil {
+		return
+	}
+	fn(info)
+}
+func (t *Driver) onConnStreamInactivityTimeout(info DriverConnStreamInactivityTimeoutInfo) {
+	fn := t.OnConnStreamInactivityTimeout
+	if fn == nil {
+		return
+	}
+	fn(info)
+}
+func (t *Driver) onConnInFlightChange(info DriverConnInFlightChangeInfo) {
+	fn := t.OnConnInFlightChange
+	if fn == nil {
+		return
+	}
+	fn(info)
+}
 func (t *Driver) onConnDial(d DriverConnDialStartInfo) func(DriverConnDialDoneInfo) {
 	fn := t.OnConnDial
 	if fn == nil {
@@ -1262,13 +1341,14 @@ func (t *Driver) onGetCredentials(d DriverGetCredentialsStartInfo) func(DriverGe
 	return res
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DriverOnInit(t *Driver, c *context.Context, call call, endpoint string, database string, secure bool) func(error) {
+func DriverOnInit(t *Driver, c *context.Context, call call, endpoint string, database string, secure bool, applicationName string) func(error) {
 	var p DriverInitStartInfo
 	p.Context = c
 	p.Call = call
 	p.Endpoint = endpoint
 	p.Database = database
 	p.Secure = secure
+	p.ApplicationName = applicationName
 	res := t.onInit(p)
 	return func(e error) {
 		var p DriverInitDoneInfo
@@ -1354,35 +1434,43 @@ func DriverOnConnStateChange(t *Driver, c *context.Context, call call, endpoint
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DriverOnConnInvoke(t *Driver, c *context.Context, call call, endpoint EndpointInfo, m Method) func(_ error, issues []Issue, opID string, state ConnState, metadata map[string][]string) {
+func DriverOnConnInvoke(t *Driver, c *context.Context, call call, endpoint EndpointInfo, m Method, deadline time.Time) func(_ error, issues []Issue, opID string, state ConnState, metadata map[string][]string, requestSize int, responseSize int, deadlineSource string, deadlineTimeout time.Duration) {
 	var p DriverConnInvokeStartInfo
 	p.Context = c
 	p.Call = call
 	p.Endpoint = endpoint
 	p.Method = m
+	p.Deadline = deadline
 	res := t.onConnInvoke(p)
-	return func(e error, issues []Issue, opID string, state ConnState, metadata map[string][]string) {
+	return func(e error, issues []Issue, opID string, state ConnState, metadata map[string][]string, requestSize int, responseSize int, deadlineSource string, deadlineTimeout time.Duration) {
 		var p DriverConnInvokeDoneInfo
 		p.Error = e
 		p.Issues = issues
 		p.OpID = opID
 		p.State = state
 		p.Metadata = metadata
+		p.RequestSize = requestSize
+		p.ResponseSize = responseSize
+		p.DeadlineSource = deadlineSource
+		p.DeadlineTimeout = deadlineTimeout
 		res(p)
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DriverOnConnNewStream(t *Driver, c *context.Context, call call, endpoint EndpointInfo, m Method) func(_ error, state ConnState) {
+func DriverOnConnNewStream(t *Driver, c *context.Context, call call, endpoint EndpointInfo, m Method, deadline time.Time) func(_ error, state ConnState, deadlineSource string, deadlineTimeout time.Duration) {
 	var p DriverConnNewStreamStartInfo
 	p.Context = c
 	p.Call = call
 	p.Endpoint = endpoint
 	p.Method = m
+	p.Deadline = deadline
 	res := t.onConnNewStream(p)
-	return func(e error, state ConnState) {
+	return func(e error, state ConnState, deadlineSource string, deadlineTimeout time.Duration) {
 		var p DriverConnNewStreamDoneInfo
 		p.Error = e
 		p.State = state
+		p.DeadlineSource = deadlineSource
+		p.DeadlineTimeout = deadlineTimeout
 		res(p)
 	}
 }
@@ -1423,14 +1511,49 @@ func DriverOnConnStreamCloseSend(t *Driver, c *context.Context, call call) func(
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DriverOnConnStreamFinish(t *Driver, c context.Context, call call, e error) {
+func DriverOnConnStreamFinish(t *Driver, c context.Context, call call, e error, sentBytes int, receivedBytes int, deadlineSource string, deadlineTimeout time.Duration) {
 	var p DriverConnStreamFinishInfo
 	p.Context = c
 	p.Call = call
 	p.Error = e
+	p.SentBytes = sentBytes
+	p.ReceivedBytes = receivedBytes
+	p.DeadlineSource = deadlineSource
+	p.DeadlineTimeout = deadlineTimeout
 	t.onConnStreamFinish(p)
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func DriverOnConnStreamMessageSize(t *Driver, c context.Context, call call, endpoint EndpointInfo, m Method, sent bool, size int) {
+	var p DriverConnStreamMessageSizeInfo
+	p.Context = c
+	p.Call = call
+	p.Endpoint = endpoint
+	p.Method = m
+	p.Sent = sent
+	p.Size = size
+	t.onConnStreamMessageSize(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func DriverOnConnStreamInactivityTimeout(t *Driver, c context.Context, call call, endpoint EndpointInfo, m Method, lastActivity time.Time, timeout time.Duration) {
+	var p DriverConnStreamInactivityTimeoutInfo
+	p.Context = c
+	p.Call = call
+	p.Endpoint = endpoint
+	p.Method = m
+	p.LastActivity = lastActivity
+	p.Timeout = timeout
+	t.onConnStreamInactivityTimeout(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func DriverOnConnInFlightChange(t *Driver, c context.Context, call call, endpoint EndpointInfo, inFlight int) {
+	var p DriverConnInFlightChangeInfo
+	p.Context = c
+	p.Call = call
+	p.Endpoint = endpoint
+	p.InFlight = inFlight
+	t.onConnInFlightChange(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func DriverOnConnDial(t *Driver, c *context.Context, call call, endpoint EndpointInfo) func(error) {
 	var p DriverConnDialStartInfo
 	p.Context = c
@@ -6,6 +6,7 @@ package trace
 
 import (
 	"context"
+	"time"
 )
 
 type (
@@ -15,6 +16,8 @@ type (
 	Retry struct {
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnRetry func(RetryLoopStartInfo) func(RetryLoopDoneInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnSleepShortened func(RetrySleepShortenedInfo)
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	RetryLoopStartInfo struct {
@@ -33,6 +36,92 @@ type (
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	RetryLoopDoneInfo struct {
 		Attempts int
-		Error    error
+
+		// TransactionLocksInvalidated is a number of attempts failed with transaction locks invalidated (TLI) error
+		TransactionLocksInvalidated int
+
+		Error error
+
+		// Reason is a reason of stop of retries, it is empty if Error is nil
+		Reason RetryStopReason
+
+		// Code is a status code of error of last attempt
+		Code int64
+
+		// BackoffType is a type of backoff of error of last attempt (such as "fast backoff")
+		BackoffType string
+
+		// DeleteSession reports that error of last attempt requires delete of session
+		DeleteSession bool
+
+		// DeadlineSource is a source of expired deadline if Error is caused by expired deadline
+		// (see ydb.DeadlineCause), empty otherwise
+		DeadlineSource string
+
+		// DeadlineTimeout is a configured timeout of expired deadline, zero for deadline of caller
+		DeadlineTimeout time.Duration
 	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	RetrySleepShortenedInfo struct {
+		Context context.Context
+		Call    call
+		Label   string
+
+		// Attempt is a number of failed attempt before sleep
+		Attempt int
+
+		// Delay is a backoff delay before next attempt
+		Delay time.Duration
+
+		// Slept is a duration of sleep before its interruption
+		Slept time.Duration
+
+		// Jitter is a random delay after interruption of sleep which protects from thundering herd
+		Jitter time.Duration
+
+		Reason RetrySleepShortenedReason
+	}
+)
+
+// RetrySleepShortenedReason is a reason of interruption of backoff sleep between attempts
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+type RetrySleepShortenedReason string
+
+// RetrySleepShortenedReasonWakeUpSignal means that sleep was interrupted by signal of retry.WithWakeUpSignal
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+const RetrySleepShortenedReasonWakeUpSignal = RetrySleepShortenedReason("wake-up-signal")
+
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (r RetrySleepShortenedReason) String() string {
+	return string(r)
+}
+
+// RetryStopReason is a reason of stop of retries on error
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+type RetryStopReason string
+
+const (
+	// RetryStopReasonNonRetryableError means that error of last attempt is not retryable by its status code
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	RetryStopReasonNonRetryableError = RetryStopReason("non-retryable-error")
+	// RetryStopReasonNonIdempotent means that error of last attempt is retryable for idempotent operations only
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	RetryStopReasonNonIdempotent = RetryStopReason("non-idempotent")
+	// RetryStopReasonBudgetExhausted means that retry budget has no quota for next attempt
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	RetryStopReasonBudgetExhausted = RetryStopReason("budget-exhausted")
+	// RetryStopReasonContextDone means that context was done before attempt or during wait of backoff
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	RetryStopReasonContextDone = RetryStopReason("context-done")
+	// RetryStopReasonMaxAttempts means that limit of attempts was reached
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	RetryStopReasonMaxAttempts = RetryStopReason("max-attempts")
+	// RetryStopReasonBeforeRetry means that callback of retry.WithBeforeRetry returned error before next attempt
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	RetryStopReasonBeforeRetry = RetryStopReason("before-retry")
 )
+
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (r RetryStopReason) String() string {
+	return string(r)
+}
@@ -4,6 +4,7 @@ package trace
 
 import (
 	"context"
+	"time"
 )
 
 // retryComposeOptions is a holder of options
@@ -68,6 +69,25 @@ func (t *Retry) Compose(x *Retry, opts ...RetryComposeOption) *Retry {
 			}
 		}
 	}
+	{
+		h1 := t.OnSleepShortened
+		h2 := x.OnSleepShortened
+		ret.OnSleepShortened = func(r RetrySleepShortenedInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(r)
+			}
+			if h2 != nil {
+				h2(r)
+			}
+		}
+	}
 	return &ret
 }
 func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopDoneInfo) {
@@ -85,8 +105,15 @@ func (t *Retry) onRetry(r RetryLoopStartInfo) func(RetryLoopDoneInfo) {
 	}
 	return res
 }
+func (t *Retry) onSleepShortened(r RetrySleepShortenedInfo) {
+	fn := t.OnSleepShortened
+	if fn == nil {
+		return
+	}
+	fn(r)
+}
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func RetryOnRetry(t *Retry, c *context.Context, call call, label string, idempotent bool, nestedCall bool) func(attempts int, _ error) {
+func RetryOnRetry(t *Retry, c *context.Context, call call, label string, idempotent bool, nestedCall bool) func(attempts int, transactionLocksInvalidated int, _ error, reason RetryStopReason, code int64, backoffType string, deleteSession bool, deadlineSource string, deadlineTimeout time.Duration) {
 	var p RetryLoopStartInfo
 	p.Context = c
 	p.Call = call
@@ -94,10 +121,30 @@ func RetryOnRetry(t *Retry, c *context.Context, call call, label string, idempot
 	p.Idempotent = idempotent
 	p.NestedCall = nestedCall
 	res := t.onRetry(p)
-	return func(attempts int, e error) {
+	return func(attempts int, transactionLocksInvalidated int, e error, reason RetryStopReason, code int64, backoffType string, deleteSession bool, deadlineSource string, deadlineTimeout time.Duration) {
 		var p RetryLoopDoneInfo
 		p.Attempts = attempts
+		p.TransactionLocksInvalidated = transactionLocksInvalidated
 		p.Error = e
+		p.Reason = reason
+		p.Code = code
+		p.BackoffType = backoffType
+		p.DeleteSession = deleteSession
+		p.DeadlineSource = deadlineSource
+		p.DeadlineTimeout = deadlineTimeout
 		res(p)
 	}
 }
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func RetryOnSleepShortened(t *Retry, c context.Context, call call, label string, attempt int, delay time.Duration, slept time.Duration, jitter time.Duration, reason RetrySleepShortenedReason) {
+	var p RetrySleepShortenedInfo
+	p.Context = c
+	p.Call = call
+	p.Label = label
+	p.Attempt = attempt
+	p.Delay = delay
+	p.Slept = slept
+	p.Jitter = jitter
+	p.Reason = reason
+	t.onSleepShortened(p)
+}
@@ -154,6 +154,41 @@ func (t *Scripting) Compose(x *Scripting, opts ...ScriptingComposeOption) *Scrip
 			}
 		}
 	}
+	{
+		h1 := t.OnValidate
+		h2 := x.OnValidate
+		ret.OnValidate = func(s ScriptingValidateStartInfo) func(ScriptingValidateDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(ScriptingValidateDoneInfo)
+			if h1 != nil {
+				r = h1(s)
+			}
+			if h2 != nil {
+				r1 = h2(s)
+			}
+			return func(s ScriptingValidateDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(s)
+				}
+				if r1 != nil {
+					r1(s)
+				}
+			}
+		}
+	}
 	{
 		h1 := t.OnClose
 		h2 := x.OnClose
@@ -248,6 +283,21 @@ func (t *Scripting) onExplain(s ScriptingExplainStartInfo) func(ScriptingExplain
 	}
 	return res
 }
+func (t *Scripting) onValidate(s ScriptingValidateStartInfo) func(ScriptingValidateDoneInfo) {
+	fn := t.OnValidate
+	if fn == nil {
+		return func(ScriptingValidateDoneInfo) {
+			return
+		}
+	}
+	res := fn(s)
+	if res == nil {
+		return func(ScriptingValidateDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func (t *Scripting) onClose(s ScriptingCloseStartInfo) func(ScriptingCloseDoneInfo) {
 	fn := t.OnClose
 	if fn == nil {
@@ -312,6 +362,20 @@ func ScriptingOnExplain(t *Scripting, c *context.Context, call call, query strin
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func ScriptingOnValidate(t *Scripting, c *context.Context, call call, query string) func(issues []Issue, _ error) {
+	var p ScriptingValidateStartInfo
+	p.Context = c
+	p.Call = call
+	p.Query = query
+	res := t.onValidate(p)
+	return func(issues []Issue, e error) {
+		var p ScriptingValidateDoneInfo
+		p.Issues = issues
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func ScriptingOnClose(t *Scripting, c *context.Context, call call) func(error) {
 	var p ScriptingCloseStartInfo
 	p.Context = c
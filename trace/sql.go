@@ -88,6 +88,8 @@ type (
 		Context *context.Context
 		Call    call
 		Query   string
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DatabaseSQLConnPrepareDoneInfo struct {
@@ -104,6 +106,8 @@ type (
 		TxContext context.Context //nolint:containedctx
 		Tx        tableTransactionInfo
 		Query     string
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DatabaseSQLTxPrepareDoneInfo struct {
@@ -148,6 +152,8 @@ type (
 		Mode       string
 		Idempotent bool
 		IdleTime   time.Duration
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DatabaseSQLConnQueryDoneInfo struct {
@@ -165,6 +171,8 @@ type (
 		Mode       string
 		Idempotent bool
 		IdleTime   time.Duration
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DatabaseSQLConnExecDoneInfo struct {
@@ -196,6 +204,8 @@ type (
 		TxContext context.Context //nolint:containedctx
 		Tx        tableTransactionInfo
 		Query     string
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DatabaseSQLTxQueryDoneInfo struct {
@@ -212,6 +222,8 @@ type (
 		TxContext context.Context //nolint:containedctx
 		Tx        tableTransactionInfo
 		Query     string
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DatabaseSQLTxExecDoneInfo struct {
@@ -264,6 +276,8 @@ type (
 		Call        call
 		StmtContext context.Context //nolint:containedctx
 		Query       string
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DatabaseSQLStmtQueryDoneInfo struct {
@@ -279,6 +293,8 @@ type (
 		Call        call
 		StmtContext context.Context //nolint:containedctx
 		Query       string
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	DatabaseSQLStmtExecDoneInfo struct {
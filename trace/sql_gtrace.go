@@ -940,11 +940,12 @@ func DatabaseSQLOnConnPing(t *DatabaseSQL, c *context.Context, call call) func(e
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DatabaseSQLOnConnPrepare(t *DatabaseSQL, c *context.Context, call call, query string) func(error) {
+func DatabaseSQLOnConnPrepare(t *DatabaseSQL, c *context.Context, call call, query string, queryFingerprint string) func(error) {
 	var p DatabaseSQLConnPrepareStartInfo
 	p.Context = c
 	p.Call = call
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onConnPrepare(p)
 	return func(e error) {
 		var p DatabaseSQLConnPrepareDoneInfo
@@ -978,7 +979,7 @@ func DatabaseSQLOnConnBegin(t *DatabaseSQL, c *context.Context, call call) func(
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DatabaseSQLOnConnQuery(t *DatabaseSQL, c *context.Context, call call, query string, mode string, idempotent bool, idleTime time.Duration) func(error) {
+func DatabaseSQLOnConnQuery(t *DatabaseSQL, c *context.Context, call call, query string, mode string, idempotent bool, idleTime time.Duration, queryFingerprint string) func(error) {
 	var p DatabaseSQLConnQueryStartInfo
 	p.Context = c
 	p.Call = call
@@ -986,6 +987,7 @@ func DatabaseSQLOnConnQuery(t *DatabaseSQL, c *context.Context, call call, query
 	p.Mode = mode
 	p.Idempotent = idempotent
 	p.IdleTime = idleTime
+	p.QueryFingerprint = queryFingerprint
 	res := t.onConnQuery(p)
 	return func(e error) {
 		var p DatabaseSQLConnQueryDoneInfo
@@ -994,7 +996,7 @@ func DatabaseSQLOnConnQuery(t *DatabaseSQL, c *context.Context, call call, query
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DatabaseSQLOnConnExec(t *DatabaseSQL, c *context.Context, call call, query string, mode string, idempotent bool, idleTime time.Duration) func(error) {
+func DatabaseSQLOnConnExec(t *DatabaseSQL, c *context.Context, call call, query string, mode string, idempotent bool, idleTime time.Duration, queryFingerprint string) func(error) {
 	var p DatabaseSQLConnExecStartInfo
 	p.Context = c
 	p.Call = call
@@ -1002,6 +1004,7 @@ func DatabaseSQLOnConnExec(t *DatabaseSQL, c *context.Context, call call, query
 	p.Mode = mode
 	p.Idempotent = idempotent
 	p.IdleTime = idleTime
+	p.QueryFingerprint = queryFingerprint
 	res := t.onConnExec(p)
 	return func(e error) {
 		var p DatabaseSQLConnExecDoneInfo
@@ -1024,13 +1027,14 @@ func DatabaseSQLOnConnIsTableExists(t *DatabaseSQL, c *context.Context, call cal
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DatabaseSQLOnTxQuery(t *DatabaseSQL, c *context.Context, call call, txContext context.Context, tx tableTransactionInfo, query string) func(error) {
+func DatabaseSQLOnTxQuery(t *DatabaseSQL, c *context.Context, call call, txContext context.Context, tx tableTransactionInfo, query string, queryFingerprint string) func(error) {
 	var p DatabaseSQLTxQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.TxContext = txContext
 	p.Tx = tx
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxQuery(p)
 	return func(e error) {
 		var p DatabaseSQLTxQueryDoneInfo
@@ -1039,13 +1043,14 @@ func DatabaseSQLOnTxQuery(t *DatabaseSQL, c *context.Context, call call, txConte
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DatabaseSQLOnTxExec(t *DatabaseSQL, c *context.Context, call call, txContext context.Context, tx tableTransactionInfo, query string) func(error) {
+func DatabaseSQLOnTxExec(t *DatabaseSQL, c *context.Context, call call, txContext context.Context, tx tableTransactionInfo, query string, queryFingerprint string) func(error) {
 	var p DatabaseSQLTxExecStartInfo
 	p.Context = c
 	p.Call = call
 	p.TxContext = txContext
 	p.Tx = tx
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxExec(p)
 	return func(e error) {
 		var p DatabaseSQLTxExecDoneInfo
@@ -1054,13 +1059,14 @@ func DatabaseSQLOnTxExec(t *DatabaseSQL, c *context.Context, call call, txContex
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DatabaseSQLOnTxPrepare(t *DatabaseSQL, c *context.Context, call call, txContext context.Context, tx tableTransactionInfo, query string) func(error) {
+func DatabaseSQLOnTxPrepare(t *DatabaseSQL, c *context.Context, call call, txContext context.Context, tx tableTransactionInfo, query string, queryFingerprint string) func(error) {
 	var p DatabaseSQLTxPrepareStartInfo
 	p.Context = c
 	p.Call = call
 	p.TxContext = txContext
 	p.Tx = tx
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxPrepare(p)
 	return func(e error) {
 		var p DatabaseSQLTxPrepareDoneInfo
@@ -1095,12 +1101,13 @@ func DatabaseSQLOnTxRollback(t *DatabaseSQL, c *context.Context, call call, tx t
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DatabaseSQLOnStmtQuery(t *DatabaseSQL, c *context.Context, call call, stmtContext context.Context, query string) func(error) {
+func DatabaseSQLOnStmtQuery(t *DatabaseSQL, c *context.Context, call call, stmtContext context.Context, query string, queryFingerprint string) func(error) {
 	var p DatabaseSQLStmtQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.StmtContext = stmtContext
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onStmtQuery(p)
 	return func(e error) {
 		var p DatabaseSQLStmtQueryDoneInfo
@@ -1109,12 +1116,13 @@ func DatabaseSQLOnStmtQuery(t *DatabaseSQL, c *context.Context, call call, stmtC
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func DatabaseSQLOnStmtExec(t *DatabaseSQL, c *context.Context, call call, stmtContext context.Context, query string) func(error) {
+func DatabaseSQLOnStmtExec(t *DatabaseSQL, c *context.Context, call call, stmtContext context.Context, query string, queryFingerprint string) func(error) {
 	var p DatabaseSQLStmtExecStartInfo
 	p.Context = c
 	p.Call = call
 	p.StmtContext = stmtContext
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onStmtExec(p)
 	return func(e error) {
 		var p DatabaseSQLStmtExecDoneInfo
@@ -3,6 +3,8 @@ package trace
 import (
 	"context"
 	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 )
 
 // tool gtrace used from ./internal/cmd/gtrace
@@ -24,6 +26,8 @@ type (
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnDoTx func(TableDoTxStartInfo) func(TableDoTxDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnDoHedged func(TableDoHedgedStartInfo) func(TableDoHedgedDoneInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnCreateSession func(TableCreateSessionStartInfo) func(TableCreateSessionDoneInfo)
 		// Session events
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
@@ -41,6 +45,10 @@ type (
 		OnSessionQueryExecute func(TableExecuteDataQueryStartInfo) func(TableExecuteDataQueryDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnSessionQueryExplain func(TableExplainQueryStartInfo) func(TableExplainQueryDoneInfo)
+		// OnSessionQueryWarnings is called when successful response of Execute, CommitTx or ExecuteSchemeQuery
+		// contains non-fatal issues
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnSessionQueryWarnings func(info TableSessionQueryWarningsInfo)
 		// Stream events
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnSessionQueryStreamExecute func(TableSessionQueryStreamExecuteStartInfo) func(TableSessionQueryStreamExecuteDoneInfo)
@@ -74,6 +82,10 @@ type (
 		OnPoolSessionAdd func(info TablePoolSessionAddInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnPoolSessionRemove func(info TablePoolSessionRemoveInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnPoolSessionQuarantine func(info TablePoolSessionQuarantineInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnPoolSessionAffinity func(info TablePoolSessionAffinityInfo)
 
 		// Pool common API events
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
@@ -176,6 +188,8 @@ type (
 		Call    call
 		Session tableSessionInfo
 		Query   string
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TablePrepareDataQueryDoneInfo struct {
@@ -194,6 +208,11 @@ type (
 		Query       tableDataQuery
 		Parameters  tableQueryParameters
 		KeepInCache bool
+		// OperationTimeout is an operation timeout of query execution sent to server, zero if not set.
+		// It grows in next attempts after compilation timeout with table.WithCompilationTimeoutGrowth
+		OperationTimeout time.Duration
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableTransactionExecuteStartInfo struct {
@@ -207,6 +226,11 @@ type (
 		Tx         tableTransactionInfo
 		Query      tableDataQuery
 		Parameters tableQueryParameters
+		// CommitTx reports that transaction is committed with query execution (see options.WithCommit)
+		// without separate commit call
+		CommitTx bool
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableTransactionExecuteStatementStartInfo struct {
@@ -220,6 +244,11 @@ type (
 		Tx             tableTransactionInfo
 		StatementQuery tableDataQuery
 		Parameters     tableQueryParameters
+		// CommitTx reports that transaction is committed with statement execution (see options.WithCommit)
+		// without separate commit call
+		CommitTx bool
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableExplainQueryStartInfo struct {
@@ -231,6 +260,8 @@ type (
 		Call    call
 		Session tableSessionInfo
 		Query   string
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableExplainQueryDoneInfo struct {
@@ -243,7 +274,10 @@ type (
 		Tx       tableTransactionInfo
 		Prepared bool
 		Result   tableResult
-		Error    error
+		// CompilationFromCache reports that query was not compiled by server because compiled query was found in
+		// server query cache. It is reported only if compilation stats are collected (see options.WithCollectStatsModeBasic)
+		CompilationFromCache bool
+		Error                error
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableTransactionExecuteDoneInfo struct {
@@ -270,6 +304,19 @@ type (
 		Error error
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TableSessionQueryWarningsInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+		Session tableSessionInfo
+		// QueryFingerprint is a short stable identifier of query text, empty for CommitTx
+		QueryFingerprint string
+		Issues           []Issue
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableSessionQueryStreamExecuteStartInfo struct {
 		// Context make available context in trace callback function.
 		// Pointer to context provide replacement of context in trace callback function.
@@ -280,6 +327,8 @@ type (
 		Session    tableSessionInfo
 		Query      tableDataQuery
 		Parameters tableQueryParameters
+		// QueryFingerprint is a short stable identifier of query text (see ydb.QueryFingerprint)
+		QueryFingerprint string
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableSessionQueryStreamExecuteDoneInfo struct {
@@ -313,7 +362,10 @@ type (
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableTxCommitDoneInfo struct {
-		Error error
+		// QueryStats is a statistics of commit or nil if statistics collection is not enabled
+		// with options.WithCommitCollectStats
+		QueryStats stats.QueryStats
+		Error      error
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableTxRollbackStartInfo struct {
@@ -429,6 +481,23 @@ type (
 		Session tableSessionInfo
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TablePoolSessionQuarantineInfo struct {
+		Session           tableSessionInfo
+		ConsecutiveErrors int
+		Error             error
+	}
+	// TablePoolSessionAffinityInfo is emitted on getting of session from pool with affinity key.
+	// Hit is true if session which was used with key before is returned.
+	// Hits and Misses are counters of pool since creation
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TablePoolSessionAffinityInfo struct {
+		Session tableSessionInfo
+		Key     string
+		Hit     bool
+		Hits    uint64
+		Misses  uint64
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableCloseStartInfo struct {
 		// Context make available context in trace callback function.
 		// Pointer to context provide replacement of context in trace callback function.
@@ -457,7 +526,11 @@ type (
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableDoDoneInfo struct {
 		Attempts int
-		Error    error
+
+		// TransactionLocksInvalidated is a number of attempts failed with transaction locks invalidated (TLI) error
+		TransactionLocksInvalidated int
+
+		Error error
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableDoTxStartInfo struct {
@@ -475,7 +548,39 @@ type (
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableDoTxDoneInfo struct {
 		Attempts int
-		Error    error
+
+		// TransactionLocksInvalidated is a number of attempts failed with transaction locks invalidated (TLI) error
+		TransactionLocksInvalidated int
+
+		// CommitQueryStats is a statistics of successful commit or nil if statistics collection is not enabled
+		// with options.WithCommitCollectStats in table.WithTxCommitOptions
+		CommitQueryStats stats.QueryStats
+
+		Error error
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TableDoHedgedStartInfo struct {
+		// Context make available context in trace callback function.
+		// Pointer to context provide replacement of context in trace callback function.
+		// Warning: concurrent access to pointer on client side must be excluded.
+		// Safe replacement of context are provided only inside callback function
+		Context *context.Context
+		Call    call
+
+		Label      string
+		HedgeDelay time.Duration
+		MaxHedges  int
+	}
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TableDoHedgedDoneInfo struct {
+		// Hedges is a number of fired hedged calls of operation
+		Hedges int
+
+		// Winner is an index of successful call of operation: 0 for primary call, 1 and greater for hedged calls
+		// and -1 if all calls failed
+		Winner int
+
+		Error error
 	}
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	TableCreateSessionStartInfo struct {
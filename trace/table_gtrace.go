@@ -4,6 +4,9 @@ package trace
 
 import (
 	"context"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/stats"
 )
 
 // tableComposeOptions is a holder of options
@@ -173,6 +176,41 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 			}
 		}
 	}
+	{
+		h1 := t.OnDoHedged
+		h2 := x.OnDoHedged
+		ret.OnDoHedged = func(t TableDoHedgedStartInfo) func(TableDoHedgedDoneInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			var r, r1 func(TableDoHedgedDoneInfo)
+			if h1 != nil {
+				r = h1(t)
+			}
+			if h2 != nil {
+				r1 = h2(t)
+			}
+			return func(t TableDoHedgedDoneInfo) {
+				if options.panicCallback != nil {
+					defer func() {
+						if e := recover(); e != nil {
+							options.panicCallback(e)
+						}
+					}()
+				}
+				if r != nil {
+					r(t)
+				}
+				if r1 != nil {
+					r1(t)
+				}
+			}
+		}
+	}
 	{
 		h1 := t.OnCreateSession
 		h2 := x.OnCreateSession
@@ -453,6 +491,25 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 			}
 		}
 	}
+	{
+		h1 := t.OnSessionQueryWarnings
+		h2 := x.OnSessionQueryWarnings
+		ret.OnSessionQueryWarnings = func(info TableSessionQueryWarningsInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(info)
+			}
+			if h2 != nil {
+				h2(info)
+			}
+		}
+	}
 	{
 		h1 := t.OnSessionQueryStreamExecute
 		h2 := x.OnSessionQueryStreamExecute
@@ -755,6 +812,44 @@ func (t *Table) Compose(x *Table, opts ...TableComposeOption) *Table {
 			}
 		}
 	}
+	{
+		h1 := t.OnPoolSessionQuarantine
+		h2 := x.OnPoolSessionQuarantine
+		ret.OnPoolSessionQuarantine = func(info TablePoolSessionQuarantineInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(info)
+			}
+			if h2 != nil {
+				h2(info)
+			}
+		}
+	}
+	{
+		h1 := t.OnPoolSessionAffinity
+		h2 := x.OnPoolSessionAffinity
+		ret.OnPoolSessionAffinity = func(info TablePoolSessionAffinityInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(info)
+			}
+			if h2 != nil {
+				h2(info)
+			}
+		}
+	}
 	{
 		h1 := t.OnPoolPut
 		h2 := x.OnPoolPut
@@ -922,6 +1017,21 @@ func (t *Table) onDoTx(t1 TableDoTxStartInfo) func(TableDoTxDoneInfo) {
 	}
 	return res
 }
+func (t *Table) onDoHedged(t1 TableDoHedgedStartInfo) func(TableDoHedgedDoneInfo) {
+	fn := t.OnDoHedged
+	if fn == nil {
+		return func(TableDoHedgedDoneInfo) {
+			return
+		}
+	}
+	res := fn(t1)
+	if res == nil {
+		return func(TableDoHedgedDoneInfo) {
+			return
+		}
+	}
+	return res
+}
 func (t *Table) onCreateSession(t1 TableCreateSessionStartInfo) func(TableCreateSessionDoneInfo) {
 	fn := t.OnCreateSession
 	if fn == nil {
@@ -1042,6 +1152,13 @@ func (t *Table) onSessionQueryExplain(t1 TableExplainQueryStartInfo) func(TableE
 	}
 	return res
 }
+func (t *Table) onSessionQueryWarnings(info TableSessionQueryWarningsInfo) {
+	fn := t.OnSessionQueryWarnings
+	if fn == nil {
+		return
+	}
+	fn(info)
+}
 func (t *Table) onSessionQueryStreamExecute(t1 TableSessionQueryStreamExecuteStartInfo) func(TableSessionQueryStreamExecuteDoneInfo) {
 	fn := t.OnSessionQueryStreamExecute
 	if fn == nil {
@@ -1168,6 +1285,20 @@ func (t *Table) onPoolSessionRemove(info TablePoolSessionRemoveInfo) {
 	}
 	fn(info)
 }
+func (t *Table) onPoolSessionQuarantine(info TablePoolSessionQuarantineInfo) {
+	fn := t.OnPoolSessionQuarantine
+	if fn == nil {
+		return
+	}
+	fn(info)
+}
+func (t *Table) onPoolSessionAffinity(info TablePoolSessionAffinityInfo) {
+	fn := t.OnPoolSessionAffinity
+	if fn == nil {
+		return
+	}
+	fn(info)
+}
 func (t *Table) onPoolPut(t1 TablePoolPutStartInfo) func(TablePoolPutDoneInfo) {
 	fn := t.OnPoolPut
 	if fn == nil {
@@ -1238,7 +1369,7 @@ func TableOnClose(t *Table, c *context.Context, call call) func(error) {
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnDo(t *Table, c *context.Context, call call, label string, idempotent bool, nestedCall bool) func(attempts int, _ error) {
+func TableOnDo(t *Table, c *context.Context, call call, label string, idempotent bool, nestedCall bool) func(attempts int, transactionLocksInvalidated int, _ error) {
 	var p TableDoStartInfo
 	p.Context = c
 	p.Call = call
@@ -1246,15 +1377,16 @@ func TableOnDo(t *Table, c *context.Context, call call, label string, idempotent
 	p.Idempotent = idempotent
 	p.NestedCall = nestedCall
 	res := t.onDo(p)
-	return func(attempts int, e error) {
+	return func(attempts int, transactionLocksInvalidated int, e error) {
 		var p TableDoDoneInfo
 		p.Attempts = attempts
+		p.TransactionLocksInvalidated = transactionLocksInvalidated
 		p.Error = e
 		res(p)
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnDoTx(t *Table, c *context.Context, call call, label string, idempotent bool, nestedCall bool) func(attempts int, _ error) {
+func TableOnDoTx(t *Table, c *context.Context, call call, label string, idempotent bool, nestedCall bool) func(attempts int, transactionLocksInvalidated int, commitQueryStats stats.QueryStats, _ error) {
 	var p TableDoTxStartInfo
 	p.Context = c
 	p.Call = call
@@ -1262,9 +1394,28 @@ func TableOnDoTx(t *Table, c *context.Context, call call, label string, idempote
 	p.Idempotent = idempotent
 	p.NestedCall = nestedCall
 	res := t.onDoTx(p)
-	return func(attempts int, e error) {
+	return func(attempts int, transactionLocksInvalidated int, commitQueryStats stats.QueryStats, e error) {
 		var p TableDoTxDoneInfo
 		p.Attempts = attempts
+		p.TransactionLocksInvalidated = transactionLocksInvalidated
+		p.CommitQueryStats = commitQueryStats
+		p.Error = e
+		res(p)
+	}
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func TableOnDoHedged(t *Table, c *context.Context, call call, label string, hedgeDelay time.Duration, maxHedges int) func(hedges int, winner int, _ error) {
+	var p TableDoHedgedStartInfo
+	p.Context = c
+	p.Call = call
+	p.Label = label
+	p.HedgeDelay = hedgeDelay
+	p.MaxHedges = maxHedges
+	res := t.onDoHedged(p)
+	return func(hedges int, winner int, e error) {
+		var p TableDoHedgedDoneInfo
+		p.Hedges = hedges
+		p.Winner = winner
 		p.Error = e
 		res(p)
 	}
@@ -1336,12 +1487,13 @@ func TableOnSessionBulkUpsert(t *Table, c *context.Context, call call, session t
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryPrepare(t *Table, c *context.Context, call call, session tableSessionInfo, query string) func(result tableDataQuery, _ error) {
+func TableOnSessionQueryPrepare(t *Table, c *context.Context, call call, session tableSessionInfo, query string, queryFingerprint string) func(result tableDataQuery, _ error) {
 	var p TablePrepareDataQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryPrepare(p)
 	return func(result tableDataQuery, e error) {
 		var p TablePrepareDataQueryDoneInfo
@@ -1351,7 +1503,7 @@ func TableOnSessionQueryPrepare(t *Table, c *context.Context, call call, session
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session tableSessionInfo, query tableDataQuery, parameters tableQueryParameters, keepInCache bool) func(tx tableTransactionInfo, prepared bool, result tableResult, _ error) {
+func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session tableSessionInfo, query tableDataQuery, parameters tableQueryParameters, keepInCache bool, operationTimeout time.Duration, queryFingerprint string) func(tx tableTransactionInfo, prepared bool, result tableResult, compilationFromCache bool, _ error) {
 	var p TableExecuteDataQueryStartInfo
 	p.Context = c
 	p.Call = call
@@ -1359,23 +1511,27 @@ func TableOnSessionQueryExecute(t *Table, c *context.Context, call call, session
 	p.Query = query
 	p.Parameters = parameters
 	p.KeepInCache = keepInCache
+	p.OperationTimeout = operationTimeout
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryExecute(p)
-	return func(tx tableTransactionInfo, prepared bool, result tableResult, e error) {
+	return func(tx tableTransactionInfo, prepared bool, result tableResult, compilationFromCache bool, e error) {
 		var p TableExecuteDataQueryDoneInfo
 		p.Tx = tx
 		p.Prepared = prepared
 		p.Result = result
+		p.CompilationFromCache = compilationFromCache
 		p.Error = e
 		res(p)
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryExplain(t *Table, c *context.Context, call call, session tableSessionInfo, query string) func(aST string, plan string, _ error) {
+func TableOnSessionQueryExplain(t *Table, c *context.Context, call call, session tableSessionInfo, query string, queryFingerprint string) func(aST string, plan string, _ error) {
 	var p TableExplainQueryStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryExplain(p)
 	return func(aST string, plan string, e error) {
 		var p TableExplainQueryDoneInfo
@@ -1386,13 +1542,24 @@ func TableOnSessionQueryExplain(t *Table, c *context.Context, call call, session
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnSessionQueryStreamExecute(t *Table, c *context.Context, call call, session tableSessionInfo, query tableDataQuery, parameters tableQueryParameters) func(error) {
+func TableOnSessionQueryWarnings(t *Table, c *context.Context, call call, session tableSessionInfo, queryFingerprint string, issues []Issue) {
+	var p TableSessionQueryWarningsInfo
+	p.Context = c
+	p.Call = call
+	p.Session = session
+	p.QueryFingerprint = queryFingerprint
+	p.Issues = issues
+	t.onSessionQueryWarnings(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func TableOnSessionQueryStreamExecute(t *Table, c *context.Context, call call, session tableSessionInfo, query tableDataQuery, parameters tableQueryParameters, queryFingerprint string) func(error) {
 	var p TableSessionQueryStreamExecuteStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Query = query
 	p.Parameters = parameters
+	p.QueryFingerprint = queryFingerprint
 	res := t.onSessionQueryStreamExecute(p)
 	return func(e error) {
 		var p TableSessionQueryStreamExecuteDoneInfo
@@ -1428,7 +1595,7 @@ func TableOnTxBegin(t *Table, c *context.Context, call call, session tableSessio
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnTxExecute(t *Table, c *context.Context, call call, session tableSessionInfo, tx tableTransactionInfo, query tableDataQuery, parameters tableQueryParameters) func(result tableResult, _ error) {
+func TableOnTxExecute(t *Table, c *context.Context, call call, session tableSessionInfo, tx tableTransactionInfo, query tableDataQuery, parameters tableQueryParameters, commitTx bool, queryFingerprint string) func(result tableResult, _ error) {
 	var p TableTransactionExecuteStartInfo
 	p.Context = c
 	p.Call = call
@@ -1436,6 +1603,8 @@ func TableOnTxExecute(t *Table, c *context.Context, call call, session tableSess
 	p.Tx = tx
 	p.Query = query
 	p.Parameters = parameters
+	p.CommitTx = commitTx
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxExecute(p)
 	return func(result tableResult, e error) {
 		var p TableTransactionExecuteDoneInfo
@@ -1445,7 +1614,7 @@ func TableOnTxExecute(t *Table, c *context.Context, call call, session tableSess
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnTxExecuteStatement(t *Table, c *context.Context, call call, session tableSessionInfo, tx tableTransactionInfo, statementQuery tableDataQuery, parameters tableQueryParameters) func(result tableResult, _ error) {
+func TableOnTxExecuteStatement(t *Table, c *context.Context, call call, session tableSessionInfo, tx tableTransactionInfo, statementQuery tableDataQuery, parameters tableQueryParameters, commitTx bool, queryFingerprint string) func(result tableResult, _ error) {
 	var p TableTransactionExecuteStatementStartInfo
 	p.Context = c
 	p.Call = call
@@ -1453,6 +1622,8 @@ func TableOnTxExecuteStatement(t *Table, c *context.Context, call call, session
 	p.Tx = tx
 	p.StatementQuery = statementQuery
 	p.Parameters = parameters
+	p.CommitTx = commitTx
+	p.QueryFingerprint = queryFingerprint
 	res := t.onTxExecuteStatement(p)
 	return func(result tableResult, e error) {
 		var p TableTransactionExecuteStatementDoneInfo
@@ -1462,15 +1633,16 @@ func TableOnTxExecuteStatement(t *Table, c *context.Context, call call, session
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
-func TableOnTxCommit(t *Table, c *context.Context, call call, session tableSessionInfo, tx tableTransactionInfo) func(error) {
+func TableOnTxCommit(t *Table, c *context.Context, call call, session tableSessionInfo, tx tableTransactionInfo) func(stats.QueryStats, error) {
 	var p TableTxCommitStartInfo
 	p.Context = c
 	p.Call = call
 	p.Session = session
 	p.Tx = tx
 	res := t.onTxCommit(p)
-	return func(e error) {
+	return func(q stats.QueryStats, e error) {
 		var p TableTxCommitDoneInfo
+		p.QueryStats = q
 		p.Error = e
 		res(p)
 	}
@@ -1509,6 +1681,24 @@ func TableOnPoolSessionRemove(t *Table, session tableSessionInfo) {
 	t.onPoolSessionRemove(p)
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func TableOnPoolSessionQuarantine(t *Table, session tableSessionInfo, consecutiveErrors int, e error) {
+	var p TablePoolSessionQuarantineInfo
+	p.Session = session
+	p.ConsecutiveErrors = consecutiveErrors
+	p.Error = e
+	t.onPoolSessionQuarantine(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func TableOnPoolSessionAffinity(t *Table, session tableSessionInfo, key string, hit bool, hits uint64, misses uint64) {
+	var p TablePoolSessionAffinityInfo
+	p.Session = session
+	p.Key = key
+	p.Hit = hit
+	p.Hits = hits
+	p.Misses = misses
+	t.onPoolSessionAffinity(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func TableOnPoolPut(t *Table, c *context.Context, call call, session tableSessionInfo) func(error) {
 	var p TablePoolPutStartInfo
 	p.Context = c
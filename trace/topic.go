@@ -2,6 +2,7 @@ package trace
 
 import (
 	"context"
+	"time"
 )
 
 // tool gtrace used from ./internal/cmd/gtrace
@@ -72,6 +73,10 @@ type (
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnReaderReadMessages func(TopicReaderReadMessagesStartInfo) func(TopicReaderReadMessagesDoneInfo)
 		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnReaderFlowControlPause func(TopicReaderFlowControlPauseInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+		OnReaderFlowControlResume func(TopicReaderFlowControlResumeInfo)
+		// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 		OnReaderUnknownGrpcMessage func(OnReadUnknownGrpcMessageInfo)
 
 		// TopicWriterStreamLifeCycleEvents
@@ -215,6 +220,23 @@ type (
 		Error              error
 	}
 
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TopicReaderFlowControlPauseInfo struct {
+		ReaderConnectionID string
+		Reason             TopicReaderFlowControlPauseReason
+		BufferedBytes      int
+		BufferedMessages   int
+	}
+
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TopicReaderFlowControlResumeInfo struct {
+		ReaderConnectionID string
+		BufferedBytes      int
+		BufferedMessages   int
+		RequestBytes       int
+		PauseDuration      time.Duration
+	}
+
 	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 	OnReadUnknownGrpcMessageInfo struct {
 		ReaderConnectionID string
@@ -375,6 +397,21 @@ type (
 	}
 )
 
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+type TopicReaderFlowControlPauseReason string
+
+const (
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TopicReaderFlowControlPauseReasonHighWatermark = TopicReaderFlowControlPauseReason("buffer-high-watermark")
+	// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+	TopicReaderFlowControlPauseReasonMaxMessages = TopicReaderFlowControlPauseReason("max-messages-in-flight")
+)
+
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func (r TopicReaderFlowControlPauseReason) String() string {
+	return string(r)
+}
+
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 type TopicWriterCompressMessagesReason string
 
@@ -4,6 +4,7 @@ package trace
 
 import (
 	"context"
+	"time"
 )
 
 // topicComposeOptions is a holder of options
@@ -494,6 +495,44 @@ func (t *Topic) Compose(x *Topic, opts ...TopicComposeOption) *Topic {
 			}
 		}
 	}
+	{
+		h1 := t.OnReaderFlowControlPause
+		h2 := x.OnReaderFlowControlPause
+		ret.OnReaderFlowControlPause = func(t TopicReaderFlowControlPauseInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(t)
+			}
+			if h2 != nil {
+				h2(t)
+			}
+		}
+	}
+	{
+		h1 := t.OnReaderFlowControlResume
+		h2 := x.OnReaderFlowControlResume
+		ret.OnReaderFlowControlResume = func(t TopicReaderFlowControlResumeInfo) {
+			if options.panicCallback != nil {
+				defer func() {
+					if e := recover(); e != nil {
+						options.panicCallback(e)
+					}
+				}()
+			}
+			if h1 != nil {
+				h1(t)
+			}
+			if h2 != nil {
+				h2(t)
+			}
+		}
+	}
 	{
 		h1 := t.OnReaderUnknownGrpcMessage
 		h2 := x.OnReaderUnknownGrpcMessage
@@ -906,6 +945,20 @@ func (t *Topic) onReaderReadMessages(t1 TopicReaderReadMessagesStartInfo) func(T
 	}
 	return res
 }
+func (t *Topic) onReaderFlowControlPause(t1 TopicReaderFlowControlPauseInfo) {
+	fn := t.OnReaderFlowControlPause
+	if fn == nil {
+		return
+	}
+	fn(t1)
+}
+func (t *Topic) onReaderFlowControlResume(t1 TopicReaderFlowControlResumeInfo) {
+	fn := t.OnReaderFlowControlResume
+	if fn == nil {
+		return
+	}
+	fn(t1)
+}
 func (t *Topic) onReaderUnknownGrpcMessage(o OnReadUnknownGrpcMessageInfo) {
 	fn := t.OnReaderUnknownGrpcMessage
 	if fn == nil {
@@ -1183,6 +1236,25 @@ func TopicOnReaderReadMessages(t *Topic, requestContext *context.Context, minCou
 	}
 }
 // Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func TopicOnReaderFlowControlPause(t *Topic, readerConnectionID string, reason TopicReaderFlowControlPauseReason, bufferedBytes int, bufferedMessages int) {
+	var p TopicReaderFlowControlPauseInfo
+	p.ReaderConnectionID = readerConnectionID
+	p.Reason = reason
+	p.BufferedBytes = bufferedBytes
+	p.BufferedMessages = bufferedMessages
+	t.onReaderFlowControlPause(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
+func TopicOnReaderFlowControlResume(t *Topic, readerConnectionID string, bufferedBytes int, bufferedMessages int, requestBytes int, pauseDuration time.Duration) {
+	var p TopicReaderFlowControlResumeInfo
+	p.ReaderConnectionID = readerConnectionID
+	p.BufferedBytes = bufferedBytes
+	p.BufferedMessages = bufferedMessages
+	p.RequestBytes = requestBytes
+	p.PauseDuration = pauseDuration
+	t.onReaderFlowControlResume(p)
+}
+// Internals: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#internals
 func TopicOnReaderUnknownGrpcMessage(t *Topic, readerConnectionID string, e error) {
 	var p OnReadUnknownGrpcMessageInfo
 	p.ReaderConnectionID = readerConnectionID
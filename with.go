@@ -67,3 +67,65 @@ func (d *Driver) With(ctx context.Context, opts ...Option) (*Driver, error) {
 
 	return child, nil
 }
+
+func withSharedBalancer(b *sharedBalancer) Option {
+	return func(ctx context.Context, c *Driver) error {
+		c.shared = b
+
+		return nil
+	}
+}
+
+// WithDatabase makes child Driver of other database in the same cluster with the same options
+// and another options (for example, options of session pools).
+// Child Driver shares conns, credentials and balancer (discovered endpoints) of parent Driver and
+// overrides database of calls, so number of conns depends on number of cluster hosts only.
+// Child Driver closes on close of parent Driver (shared balancer and conns close after close of all children)
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func (d *Driver) WithDatabase(ctx context.Context, database string, opts ...Option) (_ *Driver, err error) {
+	onDone := trace.DriverOnWith(
+		d.trace(), &ctx,
+		stack.FunctionID("github.com/ydb-platform/ydb-go-sdk/3/ydb.(*Driver).WithDatabase"),
+		d.config.Endpoint(), database, d.config.Secure(),
+	)
+	defer func() {
+		onDone(err)
+	}()
+
+	d.mtx.Lock()
+	shared := d.shared
+	d.mtx.Unlock()
+
+	if shared == nil {
+		return nil, xerrors.WithStackTrace(errNoBalancer)
+	}
+
+	if err = shared.acquire(); err != nil {
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	child, id, err := d.with(ctx, append([]Option{
+		WithDatabase(database),
+		withSharedBalancer(shared),
+	}, opts...)...)
+	if err != nil {
+		_ = shared.Close(ctx)
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	if err = child.connect(ctx); err != nil {
+		_ = shared.Close(ctx)
+		_ = child.pool.Release(ctx)
+
+		return nil, xerrors.WithStackTrace(err)
+	}
+
+	d.childrenMtx.Lock()
+	defer d.childrenMtx.Unlock()
+
+	d.children[id] = child
+
+	return child, nil
+}
@@ -10,15 +10,26 @@ import (
 	"encoding/pem"
 	"math/big"
 	"os"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/rekby/fixenv"
+	"github.com/rekby/fixenv/sf"
 	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Topic_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Operations"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Topic"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/ydb-platform/ydb-go-sdk/v3/config"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/certificates"
 	"github.com/ydb-platform/ydb-go-sdk/v3/internal/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/meta"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/xtest"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
 )
 
 func TestWithCertificatesCached(t *testing.T) { //nolint:funlen
@@ -151,3 +162,89 @@ func TestWithCertificatesCached(t *testing.T) { //nolint:funlen
 		})
 	}
 }
+
+func TestRedactAll(t *testing.T) {
+	const query = "SELECT * FROM users WHERE password = 'secret'"
+	redacted := RedactAll(query)
+	require.NotContains(t, redacted, "secret")
+	require.Equal(t, redacted, RedactAll(query))
+	require.NotEqual(t, redacted, RedactAll("SELECT 1"))
+}
+
+type databaseRecordingTopicService struct {
+	Ydb_Topic_V1.UnimplementedTopicServiceServer
+
+	mu        sync.Mutex
+	databases []string
+}
+
+func (s *databaseRecordingTopicService) DropTopic(
+	ctx context.Context, request *Ydb_Topic.DropTopicRequest,
+) (*Ydb_Topic.DropTopicResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.databases = append(s.databases, md.Get(meta.HeaderDatabase)...)
+
+	return &Ydb_Topic.DropTopicResponse{
+		Operation: &Ydb_Operations.Operation{
+			Ready:  true,
+			Status: Ydb.StatusIds_SUCCESS,
+		},
+	}, nil
+}
+
+func TestDriverWithDatabase(t *testing.T) {
+	e := fixenv.New(t)
+	service := &databaseRecordingTopicService{}
+	connString := xtest.GrpcMockTopicConnString(e, service)
+
+	var dials atomic.Int64
+	db, err := Open(sf.Context(e), connString, WithTraceDriver(trace.Driver{
+		OnConnDial: func(trace.DriverConnDialStartInfo) func(trace.DriverConnDialDoneInfo) {
+			dials.Add(1)
+
+			return nil
+		},
+	}))
+	require.NoError(t, err)
+	require.NoError(t, db.Topic().Drop(sf.Context(e), "topic"))
+	parentDials := dials.Load()
+	require.Positive(t, parentDials)
+
+	children := make([]*Driver, 0, 3)
+	for _, database := range []string{"/db1", "/db2", "/db3"} {
+		child, err := db.WithDatabase(sf.Context(e), database)
+		require.NoError(t, err)
+		require.Equal(t, database, child.Name())
+		children = append(children, child)
+	}
+
+	for _, child := range children {
+		require.NoError(t, child.Topic().Drop(sf.Context(e), "topic"))
+	}
+	require.Equal(t, []string{"/local", "/db1", "/db2", "/db3"}, service.databases)
+
+	// conns and balancer of parent driver are shared between children
+	for _, child := range children {
+		require.Same(t, db.pool, child.pool)
+		require.Same(t, db.shared, child.shared)
+	}
+	// children make calls over conns of parent driver
+	require.Equal(t, parentDials, dials.Load())
+
+	require.NoError(t, children[0].Close(sf.Context(e)))
+	require.EqualValues(t, 3, db.shared.refs.Load())
+	require.NoError(t, db.Topic().Drop(sf.Context(e), "topic"))
+
+	require.NoError(t, db.Close(sf.Context(e)))
+	require.Zero(t, db.shared.refs.Load())
+	for _, child := range children[1:] {
+		require.Error(t, child.Topic().Drop(sf.Context(e), "topic"))
+	}
+
+	_, err = db.WithDatabase(sf.Context(e), "/db4")
+	require.Error(t, err)
+}
@@ -0,0 +1,73 @@
+// Package yql contains helpers for building of YQL query text with literals of YDB values.
+//
+// Prefer query parameters for passing of values to queries. Literals are useful for
+// constant parts of queries such as date arithmetic or default values of columns.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+package yql
+
+import (
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/value"
+)
+
+// Date returns YQL literal of Date value (such as `Date("2024-01-02")`) or *types.OutOfRangeError
+// if date is out of range [1970-01-01, 2105-12-31]
+//
+// Time of day is truncated, date is calculated in UTC.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Date(t time.Time) (string, error) {
+	v, err := value.DateValueFromTimeChecked(t)
+	if err != nil {
+		return "", err
+	}
+
+	return v.Yql(), nil
+}
+
+// Datetime returns YQL literal of Datetime value (such as `Datetime("2024-01-02T03:04:05Z")`)
+// or *types.OutOfRangeError if time is out of range [1970-01-01T00:00:00Z, 2106-01-01T00:00:00Z)
+//
+// Fractional seconds are truncated.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Datetime(t time.Time) (string, error) {
+	v, err := value.DatetimeValueFromTimeChecked(t)
+	if err != nil {
+		return "", err
+	}
+
+	return v.Yql(), nil
+}
+
+// Timestamp returns YQL literal of Timestamp value (such as `Timestamp("2024-01-02T03:04:05.000006Z")`)
+// or *types.OutOfRangeError if time is out of range [1970-01-01T00:00:00Z, 2106-01-01T00:00:00Z)
+//
+// Nanoseconds are truncated to microseconds.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Timestamp(t time.Time) (string, error) {
+	v, err := value.TimestampValueFromTimeChecked(t)
+	if err != nil {
+		return "", err
+	}
+
+	return v.Yql(), nil
+}
+
+// Interval returns YQL literal of Interval value (such as `Interval("P1DT2H")`) or *types.OutOfRangeError
+// if absolute value of duration is not less than 136 years (range of Timestamp type)
+//
+// Nanoseconds are truncated to microseconds toward zero, sign of duration is saved.
+//
+// Experimental: https://github.com/ydb-platform/ydb-go-sdk/blob/master/VERSIONING.md#experimental
+func Interval(d time.Duration) (string, error) {
+	v, err := value.IntervalValueFromDurationChecked(d)
+	if err != nil {
+		return "", err
+	}
+
+	return v.Yql(), nil
+}
@@ -0,0 +1,93 @@
+package yql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestLiterals(t *testing.T) {
+	ts := time.Date(2024, time.January, 2, 3, 4, 5, 6007, time.UTC)
+	for _, tt := range []struct {
+		name    string
+		literal func() (string, error)
+		exp     string
+	}{
+		{
+			name:    "Date",
+			literal: func() (string, error) { return Date(ts) },
+			exp:     `Date("2024-01-02")`,
+		},
+		{
+			name: "DateInLocation",
+			literal: func() (string, error) {
+				return Date(time.Date(2024, time.January, 2, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)))
+			},
+			exp: `Date("2024-01-01")`,
+		},
+		{
+			name:    "Datetime",
+			literal: func() (string, error) { return Datetime(ts) },
+			exp:     `Datetime("2024-01-02T03:04:05Z")`,
+		},
+		{
+			name:    "Timestamp",
+			literal: func() (string, error) { return Timestamp(ts) },
+			exp:     `Timestamp("2024-01-02T03:04:05.000006Z")`,
+		},
+		{
+			name:    "Interval",
+			literal: func() (string, error) { return Interval(26*time.Hour + 1500*time.Millisecond) },
+			exp:     `Interval("P1DT2H1.500000S")`,
+		},
+		{
+			name:    "NegativeInterval",
+			literal: func() (string, error) { return Interval(-time.Minute) },
+			exp:     `Interval("-PT1M")`,
+		},
+		{
+			name:    "ZeroInterval",
+			literal: func() (string, error) { return Interval(0) },
+			exp:     `Interval("PT0S")`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			literal, err := tt.literal()
+			require.NoError(t, err)
+			require.Equal(t, tt.exp, literal)
+		})
+	}
+}
+
+func TestLiteralsOutOfRange(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		literal func() (string, error)
+	}{
+		{
+			name:    "Date",
+			literal: func() (string, error) { return Date(time.Unix(-1, 0)) },
+		},
+		{
+			name:    "Datetime",
+			literal: func() (string, error) { return Datetime(time.Date(2106, time.January, 1, 0, 0, 0, 0, time.UTC)) },
+		},
+		{
+			name:    "Timestamp",
+			literal: func() (string, error) { return Timestamp(time.Date(2200, time.January, 1, 0, 0, 0, 0, time.UTC)) },
+		},
+		{
+			name:    "Interval",
+			literal: func() (string, error) { return Interval(200 * 365 * 24 * time.Hour) },
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			literal, err := tt.literal()
+			require.ErrorIs(t, err, types.ErrOutOfRange)
+			require.Empty(t, literal)
+		})
+	}
+}